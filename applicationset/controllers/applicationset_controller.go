@@ -16,10 +16,13 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -27,13 +30,16 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -52,6 +58,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
 	"github.com/argoproj/argo-cd/v3/applicationset/metrics"
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/applicationset/status"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/common"
@@ -73,6 +80,11 @@ const (
 	ReconcileRequeueOnValidationError = time.Minute * 3
 	ReverseDeletionOrder              = "Reverse"
 	AllAtOnceDeletionOrder            = "AllAtOnce"
+	// CorrelationIDAnnotationKey is the annotation of the events recorded during a reconciliation holding its
+	// correlation ID
+	CorrelationIDAnnotationKey = "applicationset.argoproj.io/correlation-id"
+	// MaxGenerationHistory is the number of generation runs kept in the generation history of an ApplicationSet
+	MaxGenerationHistory = 10
 )
 
 var defaultPreservedFinalizers = []string{
@@ -110,6 +122,19 @@ type ApplicationSetReconciler struct {
 	GlobalPreservedLabels      []string
 	Metrics                    *metrics.ApplicationsetMetrics
 	MaxResourcesStatusCount    int
+	// DefaultTemplate is merged beneath the template of every ApplicationSet
+	DefaultTemplate *argov1alpha1.ApplicationSetTemplate
+	// UseCachedParametersOnError caches the parameters generated by the generators in the ApplicationSet status, and
+	// uses the cached parameters of the generators which fail to generate parameters
+	UseCachedParametersOnError bool
+	// Sharding restricts the reconciled ApplicationSets to the shard of this replica of the controller, if not nil
+	Sharding *utils.ApplicationSetSharding
+	// StepAnalysisPrometheusAddresses are the addresses of Prometheus which the analyses of RollingSync steps may query
+	StepAnalysisPrometheusAddresses []string
+
+	// clusterDecisionResourceWatcher watches the decision resources of the ClusterDecisionResource generators, it is
+	// set up with the manager
+	clusterDecisionResourceWatcher *clusterDecisionResourceWatcher
 }
 
 // +kubebuilder:rbac:groups=argoproj.io,resources=applicationsets,verbs=get;list;watch;create;update;patch;delete
@@ -117,7 +142,9 @@ type ApplicationSetReconciler struct {
 
 func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
 	startReconcile := time.Now()
-	logCtx := log.WithField("applicationset", req.NamespacedName)
+	correlationID := reconcileCorrelationID(ctx)
+	ctx = services.ContextWithCorrelationID(ctx, correlationID)
+	logCtx := log.WithFields(log.Fields{"applicationset": req.NamespacedName, "correlationId": correlationID})
 
 	defer func() {
 		if rec := recover(); rec != nil {
@@ -141,6 +168,11 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if !r.Sharding.IsInShard(&applicationSetInfo) {
+		logCtx.Debugf("ApplicationSet is not assigned to shard %d", r.Sharding.Shard)
+		return ctrl.Result{}, nil
+	}
+
 	defer func() {
 		r.Metrics.ObserveReconcile(&applicationSetInfo, time.Since(startTime))
 	}()
@@ -194,8 +226,13 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	// Log a warning if there are unrecognized generators
 	_ = utils.CheckInvalidGenerators(&applicationSetInfo)
+	if r.clusterDecisionResourceWatcher != nil {
+		if err := r.clusterDecisionResourceWatcher.watchDecisionResources(ctx, &applicationSetInfo); err != nil {
+			logCtx.WithError(err).Warn("unable to watch the cluster decision resources, their changes are detected on requeue only")
+		}
+	}
 	// desiredApplications is the main list of all expected Applications from all generators in this appset.
-	generatedApplications, applicationSetReason, err := template.GenerateApplications(logCtx, applicationSetInfo, r.Generators, r.Renderer, r.Client)
+	generatedApplications, missingTemplateKeys, applicationSetReason, err := r.generateApplications(ctx, logCtx, &applicationSetInfo)
 	if err != nil {
 		logCtx.Errorf("unable to generate applications: %v", err)
 		_ = r.setApplicationSetStatusCondition(ctx,
@@ -208,7 +245,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			}, parametersGenerated,
 		)
 		// In order for the controller SDK to respect RequeueAfter, the error must be nil
-		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+		return ctrl.Result{RequeueAfter: getRequeueAfterFailure(logCtx, &applicationSetInfo, err)}, nil
 	}
 
 	parametersGenerated = true
@@ -246,19 +283,39 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, fmt.Errorf("failed to get update resources status for application set: %w", err)
 	}
 
+	err = r.updateGenerationHistory(ctx, logCtx, &applicationSetInfo, generatedApplications, currentApplications)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update generation history for application set: %w", err)
+	}
+
+	syncWindowsBlocked, err := r.updateSyncWindowsStatus(ctx, logCtx, &applicationSetInfo, time.Now())
+	if err != nil {
+		logCtx.Errorf("unable to evaluate the sync windows: %v", err)
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSetInfo,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionErrorOccurred,
+				Message: err.Error(),
+				Reason:  argov1alpha1.ApplicationSetReasonInvalidSyncWindows,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, parametersGenerated,
+		)
+		return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
+	}
+
 	// appSyncMap tracks which apps will be synced during this reconciliation.
 	appSyncMap := map[string]bool{}
 
 	if r.EnableProgressiveSyncs {
-		if !isRollingSyncStrategy(&applicationSetInfo) && len(applicationSetInfo.Status.ApplicationStatus) > 0 {
-			// If an appset was previously syncing with a `RollingSync` strategy but it has switched to the default strategy, clean up the progressive sync application statuses
+		if !isProgressiveSyncStrategy(&applicationSetInfo) && len(applicationSetInfo.Status.ApplicationStatus) > 0 {
+			// If an appset was previously syncing with a `RollingSync` or `Canary` strategy but it has switched to the default strategy, clean up the progressive sync application statuses
 			logCtx.Infof("Removing %v unnecessary AppStatus entries from ApplicationSet %v", len(applicationSetInfo.Status.ApplicationStatus), applicationSetInfo.Name)
 
 			err := r.setAppSetApplicationStatus(ctx, logCtx, &applicationSetInfo, []argov1alpha1.ApplicationSetApplicationStatus{})
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to clear previous AppSet application statuses for %v: %w", applicationSetInfo.Name, err)
 			}
-		} else if isRollingSyncStrategy(&applicationSetInfo) {
+		} else if isProgressiveSyncStrategy(&applicationSetInfo) {
 			appSyncMap, err = r.performProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, generatedApplications)
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to perform progressive sync reconciliation for application set: %w", err)
@@ -311,8 +368,8 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	if r.EnableProgressiveSyncs {
-		// trigger appropriate application syncs if RollingSync strategy is enabled
-		if progressiveSyncsRollingSyncStrategyEnabled(&applicationSetInfo) {
+		// trigger appropriate application syncs if RollingSync or Canary strategy is enabled
+		if progressiveSyncsEnabled(&applicationSetInfo) {
 			validApps = r.syncDesiredApplications(logCtx, &applicationSetInfo, appSyncMap, validApps)
 		}
 	}
@@ -322,7 +379,9 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return validApps[i].Name < validApps[j].Name
 	})
 
-	if utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowUpdate() {
+	if syncWindowsBlocked {
+		logCtx.Info("sync windows deny the changes of the applications, deferring them")
+	} else if utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowUpdate() {
 		err = r.createOrUpdateInCluster(ctx, logCtx, applicationSetInfo, validApps)
 		if err != nil {
 			_ = r.setApplicationSetStatusCondition(ctx,
@@ -352,7 +411,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	if utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowDelete() {
+	if !syncWindowsBlocked && utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowDelete() {
 		// Delete the generatedApplications instead of the validApps because we want to be able to delete applications in error/invalid state
 		err = r.deleteInCluster(ctx, logCtx, applicationSetInfo, generatedApplications)
 		if err != nil {
@@ -369,6 +428,11 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
+	err = r.updateStaleApplicationsStatus(ctx, logCtx, &applicationSetInfo, generatedApplications)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update stale applications status for application set: %w", err)
+	}
+
 	if applicationSetInfo.RefreshRequired() {
 		delete(applicationSetInfo.Annotations, common.AnnotationApplicationSetRefresh)
 		err := r.Update(ctx, &applicationSetInfo)
@@ -387,18 +451,40 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
+	requeueAfter := r.getMinRequeueAfter(logCtx, &applicationSetInfo)
+	if syncWindowsRequeueAfter := getSyncWindowsRequeueAfter(&applicationSetInfo, time.Now()); syncWindowsRequeueAfter > 0 && (requeueAfter == 0 || syncWindowsRequeueAfter < requeueAfter) {
+		requeueAfter = syncWindowsRequeueAfter
+	}
+
+	if r.EnableProgressiveSyncs {
+		// ensure the steps of Degraded Applications are rolled back, Applications time out in their step, the rollout
+		// proceeds after the canary soak time, running step analyses are checked, and rollout cluster locks are renewed
+		now := time.Now()
+		for _, progressiveSyncRequeueAfter := range []time.Duration{
+			getRollbackRequeueAfter(logCtx, &applicationSetInfo, currentApplications, now),
+			getRolloutStepTimeoutRequeueAfter(logCtx, &applicationSetInfo, now),
+			getCanarySoakRequeueAfter(&applicationSetInfo, now),
+			getStepAnalysisRequeueAfter(&applicationSetInfo),
+			getRolloutClusterLockRequeueAfter(&applicationSetInfo),
+		} {
+			if progressiveSyncRequeueAfter > 0 && (requeueAfter == 0 || progressiveSyncRequeueAfter < requeueAfter) {
+				requeueAfter = progressiveSyncRequeueAfter
+			}
+		}
+	}
 
 	if len(validateErrors) == 0 {
-		if err := r.setApplicationSetStatusCondition(ctx,
-			&applicationSetInfo,
-			argov1alpha1.ApplicationSetCondition{
-				Type:    argov1alpha1.ApplicationSetConditionResourcesUpToDate,
-				Message: "All applications have been generated successfully",
-				Reason:  argov1alpha1.ApplicationSetReasonApplicationSetUpToDate,
-				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
-			}, parametersGenerated,
-		); err != nil {
+		upToDateCondition := argov1alpha1.ApplicationSetCondition{
+			Type:    argov1alpha1.ApplicationSetConditionResourcesUpToDate,
+			Message: "All applications have been generated successfully",
+			Reason:  argov1alpha1.ApplicationSetReasonApplicationSetUpToDate,
+			Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+		}
+		if len(missingTemplateKeys) > 0 {
+			upToDateCondition.Message = missingTemplateKeysMessage(missingTemplateKeys)
+			upToDateCondition.Reason = argov1alpha1.ApplicationSetReasonMissingTemplateKeys
+		}
+		if err := r.setApplicationSetStatusCondition(ctx, &applicationSetInfo, upToDateCondition, parametersGenerated); err != nil {
 			return ctrl.Result{}, err
 		}
 	} else if requeueAfter == time.Duration(0) {
@@ -413,6 +499,41 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}, nil
 }
 
+// maxMissingTemplateKeysInMessage is the maximum number of missing template keys listed in the status of an
+// ApplicationSet, to keep its size reasonable
+const maxMissingTemplateKeysInMessage = 10
+
+// missingTemplateKeysMessage returns the message of the status condition warning about the given missing template keys
+func missingTemplateKeysMessage(keys []string) string {
+	listed := keys
+	if len(listed) > maxMissingTemplateKeysInMessage {
+		listed = listed[:maxMissingTemplateKeysInMessage]
+	}
+	message := "All applications have been generated successfully, but the templates reference missing parameters which have been rendered as empty strings: " + strings.Join(listed, ", ")
+	if len(keys) > len(listed) {
+		message = fmt.Sprintf("%s (and %d more)", message, len(keys)-len(listed))
+	}
+	return message
+}
+
+// reconcileCorrelationID returns the ID correlating the log lines, events and requests of a reconciliation. The
+// reconcile ID assigned by controller-runtime is used if available, so it also matches its own log lines.
+func reconcileCorrelationID(ctx context.Context) string {
+	if id := controller.ReconcileIDFromContext(ctx); id != "" {
+		return string(id)
+	}
+	return uuid.NewString()
+}
+
+// recordEvent records an event annotated with the correlation ID of the reconciliation
+func (r *ApplicationSetReconciler) recordEvent(ctx context.Context, object runtime.Object, eventType, reason, messageFmt string, args ...any) {
+	if correlationID := services.CorrelationIDFromContext(ctx); correlationID != "" {
+		r.Recorder.AnnotatedEventf(object, map[string]string{CorrelationIDAnnotationKey: correlationID}, eventType, reason, messageFmt, args...)
+		return
+	}
+	r.Recorder.Eventf(object, eventType, reason, messageFmt, args...)
+}
+
 func (r *ApplicationSetReconciler) performReverseDeletion(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, currentApps []argov1alpha1.Application) (time.Duration, error) {
 	requeueTime := 10 * time.Second
 	stepLength := len(appset.Spec.Strategy.RollingSync.Steps)
@@ -494,7 +615,7 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 	evaluatedTypes[condition.Type] = true
 	newConditions := []argov1alpha1.ApplicationSetCondition{condition}
 
-	if !isRollingSyncStrategy(applicationSet) {
+	if !isProgressiveSyncStrategy(applicationSet) {
 		// Progressing sync is always evaluated so conditions are removed when it is not enabled
 		evaluatedTypes[argov1alpha1.ApplicationSetConditionRolloutProgressing] = true
 	}
@@ -529,7 +650,7 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 			})
 		}
 	case argov1alpha1.ApplicationSetConditionRolloutProgressing:
-		if !isRollingSyncStrategy(applicationSet) {
+		if !isProgressiveSyncStrategy(applicationSet) {
 			// if the condition is a rolling sync and it is disabled, ignore it
 			evaluatedTypes[condition.Type] = false
 		}
@@ -589,10 +710,14 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 	for i := range desiredApplications {
 		app := &desiredApplications[i]
 		if namesSet[app.Name] {
-			errorsByApp[app.QualifiedName()] = fmt.Errorf("ApplicationSet %s contains applications with duplicate name: %s", applicationSetInfo.Name, app.Name)
+			errorsByApp[app.QualifiedName()] = fmt.Errorf("ApplicationSet %s contains applications with duplicate name: %s (if the names are truncated, use hashSuffix to keep them unique)", applicationSetInfo.Name, app.Name)
 			continue
 		}
 		namesSet[app.Name] = true
+		if errs := validation.IsDNS1123Subdomain(app.Name); len(errs) > 0 {
+			errorsByApp[app.QualifiedName()] = fmt.Errorf("application name %q is invalid: %s (use dns1123 or hashSuffix to sanitize and shorten generated names)", app.Name, strings.Join(errs, ", "))
+			continue
+		}
 		appProject := &argov1alpha1.AppProject{}
 		err := r.Get(ctx, types.NamespacedName{Name: app.Spec.Project, Namespace: r.ArgoCDNamespace}, appProject)
 		if err != nil {
@@ -609,26 +734,166 @@ func (r *ApplicationSetReconciler) validateGeneratedApplications(ctx context.Con
 		}
 	}
 
+	var namespaceApplications argov1alpha1.ApplicationList
+	if err := r.List(ctx, &namespaceApplications, client.InNamespace(applicationSetInfo.Namespace)); err != nil {
+		return nil, fmt.Errorf("error listing applications: %w", err)
+	}
+	existingByName := make(map[string]*argov1alpha1.Application, len(namespaceApplications.Items))
+	for i := range namespaceApplications.Items {
+		existingByName[namespaceApplications.Items[i].Name] = &namespaceApplications.Items[i]
+	}
+	for i := range desiredApplications {
+		app := &desiredApplications[i]
+		existing, ok := existingByName[app.Name]
+		if !ok || errorsByApp[app.QualifiedName()] != nil {
+			continue
+		}
+		if err := r.validateExistingApplication(ctx, applicationSetInfo, app, existing); err != nil {
+			errorsByApp[app.QualifiedName()] = err
+		}
+	}
+
+	if len(applicationSetInfo.Spec.UniqueAnnotations) > 0 {
+		var existingApplications argov1alpha1.ApplicationList
+		if err := r.List(ctx, &existingApplications); err != nil {
+			return nil, fmt.Errorf("error listing applications: %w", err)
+		}
+		for appName, err := range validateUniqueAnnotations(applicationSetInfo, desiredApplications, existingApplications.Items) {
+			if errorsByApp[appName] == nil {
+				errorsByApp[appName] = err
+			}
+		}
+	}
+
 	return errorsByApp, nil
 }
 
-func (r *ApplicationSetReconciler) getMinRequeueAfter(applicationSetInfo *argov1alpha1.ApplicationSet) time.Duration {
-	var res time.Duration
-	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
-		relevantGenerators := generators.GetRelevantGenerators(&requestedGenerator, r.Generators)
+// validateExistingApplication returns an error if the name of the given generated application is taken by an existing
+// application which is not managed by the ApplicationSet. An existing application which is not managed by any
+// ApplicationSet is adopted if adoptExistingApplications is enabled and its destination matches the destination of the
+// generated application.
+func (r *ApplicationSetReconciler) validateExistingApplication(ctx context.Context, applicationSetInfo argov1alpha1.ApplicationSet, app *argov1alpha1.Application, existing *argov1alpha1.Application) error {
+	if metav1.IsControlledBy(existing, &applicationSetInfo) {
+		return nil
+	}
+	if owner := metav1.GetControllerOf(existing); owner != nil {
+		return fmt.Errorf("application %s already exists and is managed by %s %s", app.QualifiedName(), owner.Kind, owner.Name)
+	}
+	if applicationSetInfo.Spec.SyncPolicy == nil || !applicationSetInfo.Spec.SyncPolicy.AdoptExistingApplications {
+		return fmt.Errorf("application %s already exists and is not managed by the ApplicationSet (enable syncPolicy.adoptExistingApplications to adopt it)", app.QualifiedName())
+	}
+
+	existingCluster, err := argoutil.GetDestinationCluster(ctx, existing.Spec.Destination, r.ArgoDB)
+	if err != nil {
+		return fmt.Errorf("application %s already exists and cannot be adopted, its destination is invalid: %w", app.QualifiedName(), err)
+	}
+	cluster, err := argoutil.GetDestinationCluster(ctx, app.Spec.Destination, r.ArgoDB)
+	if err != nil {
+		return fmt.Errorf("application destination spec is invalid: %w", err)
+	}
+	if existingCluster.Server != cluster.Server || existing.Spec.Destination.Namespace != app.Spec.Destination.Namespace {
+		return fmt.Errorf("application %s already exists and cannot be adopted, its destination (%s, namespace %q) differs from the generated destination (%s, namespace %q)",
+			app.QualifiedName(), existingCluster.Server, existing.Spec.Destination.Namespace, cluster.Server, app.Spec.Destination.Namespace)
+	}
+	return nil
+}
+
+// getApplicationSpecChanges returns the dot-separated paths of the fields of the live application spec which are
+// changed by the desired application spec, e.g. spec.source.targetRevision
+func getApplicationSpecChanges(live *argov1alpha1.ApplicationSpec, desired *argov1alpha1.ApplicationSpec) ([]string, error) {
+	var liveValue, desiredValue map[string]any
+	for _, v := range []struct {
+		spec  *argov1alpha1.ApplicationSpec
+		value *map[string]any
+	}{{live, &liveValue}, {desired, &desiredValue}} {
+		data, err := json.Marshal(argoutil.NormalizeApplicationSpec(v.spec))
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, v.value); err != nil {
+			return nil, err
+		}
+	}
+	var changes []string
+	collectChangedPaths("spec", liveValue, desiredValue, &changes)
+	return changes, nil
+}
+
+func collectChangedPaths(path string, live, desired any, changes *[]string) {
+	liveMap, liveIsMap := live.(map[string]any)
+	desiredMap, desiredIsMap := desired.(map[string]any)
+	if !liveIsMap || !desiredIsMap {
+		if !reflect.DeepEqual(live, desired) {
+			*changes = append(*changes, path)
+		}
+		return
+	}
+	keys := slices.Collect(maps.Keys(liveMap))
+	for key := range desiredMap {
+		if _, ok := liveMap[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		collectChangedPaths(path+"."+key, liveMap[key], desiredMap[key], changes)
+	}
+}
 
-		for _, g := range relevantGenerators {
-			t := g.GetRequeueAfter(&requestedGenerator)
+// validateUniqueAnnotations returns an error for each generated application which has a value of one of the unique
+// annotations of the ApplicationSet, e.g. a hostname, that is also used by another generated application or by an
+// existing application which is not managed by the ApplicationSet.
+func validateUniqueAnnotations(applicationSetInfo argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application, existingApplications []argov1alpha1.Application) map[string]error {
+	errorsByApp := map[string]error{}
+	for _, key := range applicationSetInfo.Spec.UniqueAnnotations {
+		appsByValue := map[string][]string{}
+		for i := range existingApplications {
+			if metav1.IsControlledBy(&existingApplications[i], &applicationSetInfo) {
+				// applications of the ApplicationSet are replaced by the generated applications
+				continue
+			}
+			for _, value := range uniqueAnnotationValues(&existingApplications[i], key) {
+				appsByValue[value] = append(appsByValue[value], existingApplications[i].QualifiedName())
+			}
+		}
+		for i := range desiredApplications {
+			for _, value := range uniqueAnnotationValues(&desiredApplications[i], key) {
+				appsByValue[value] = append(appsByValue[value], desiredApplications[i].QualifiedName())
+			}
+		}
 
-			if res == 0 {
-				res = t
-			} else if t != 0 && t < res {
-				res = t
+		for i := range desiredApplications {
+			appName := desiredApplications[i].QualifiedName()
+			if errorsByApp[appName] != nil {
+				continue
+			}
+			for _, value := range uniqueAnnotationValues(&desiredApplications[i], key) {
+				var conflicts []string
+				for _, other := range appsByValue[value] {
+					if other != appName && !slices.Contains(conflicts, other) {
+						conflicts = append(conflicts, other)
+					}
+				}
+				if len(conflicts) > 0 {
+					errorsByApp[appName] = fmt.Errorf("application %s has value %q of annotation %s which must be unique, but is also used by applications: %s", appName, value, key, strings.Join(conflicts, ", "))
+					break
+				}
 			}
 		}
 	}
+	return errorsByApp
+}
 
-	return res
+// uniqueAnnotationValues returns the normalized, comma-separated values of the given annotation of an application
+func uniqueAnnotationValues(app *argov1alpha1.Application, key string) []string {
+	var values []string
+	for _, value := range strings.Split(app.Annotations[key], ",") {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value != "" && !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+	return values
 }
 
 func ignoreNotAllowedNamespaces(namespaces []string) predicate.Predicate {
@@ -637,6 +902,15 @@ func ignoreNotAllowedNamespaces(namespaces []string) predicate.Predicate {
 	})
 }
 
+// ignoreOtherShards ignores the events of the ApplicationSets which are not assigned to the given shard. The events of
+// the Applications are left to the reconciliation of their ApplicationSet, which may be sharded by label.
+func ignoreOtherShards(sharding *utils.ApplicationSetSharding) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(object client.Object) bool {
+		appset, ok := object.(*argov1alpha1.ApplicationSet)
+		return !ok || sharding.IsInShard(appset)
+	})
+}
+
 func appControllerIndexer(rawObj client.Object) []string {
 	// grab the job object, extract the owner...
 	app := rawObj.(*argov1alpha1.Application)
@@ -661,11 +935,12 @@ func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager, enableProg
 	appOwnsHandler := getApplicationOwnsHandler(enableProgressiveSyncs)
 	appSetOwnsHandler := getApplicationSetOwnsHandler(enableProgressiveSyncs)
 
-	return ctrl.NewControllerManagedBy(mgr).WithOptions(controller.Options{
+	c, err := ctrl.NewControllerManagedBy(mgr).WithOptions(controller.Options{
 		MaxConcurrentReconciles: maxConcurrentReconciliations,
 	}).For(&argov1alpha1.ApplicationSet{}, builder.WithPredicates(appSetOwnsHandler)).
 		Owns(&argov1alpha1.Application{}, builder.WithPredicates(appOwnsHandler)).
 		WithEventFilter(ignoreNotAllowedNamespaces(r.ApplicationSetNamespaces)).
+		WithEventFilter(ignoreOtherShards(r.Sharding)).
 		Watches(
 			&corev1.Secret{},
 			&clusterSecretEventHandler{
@@ -673,7 +948,61 @@ func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager, enableProg
 				Log:                      log.WithField("type", "createSecretEventHandler"),
 				ApplicationSetNamespaces: r.ApplicationSetNamespaces,
 			}).
-		Complete(r)
+		Build(r)
+	if err != nil {
+		return fmt.Errorf("error setting up with manager: %w", err)
+	}
+
+	r.clusterDecisionResourceWatcher = &clusterDecisionResourceWatcher{
+		Log:                      log.WithField("type", "clusterDecisionResourceWatcher"),
+		Client:                   mgr.GetClient(),
+		KubeClientset:            r.KubeClientset,
+		Controller:               c,
+		Cache:                    mgr.GetCache(),
+		RESTMapper:               mgr.GetRESTMapper(),
+		ArgoCDNamespace:          r.ArgoCDNamespace,
+		ApplicationSetNamespaces: r.ApplicationSetNamespaces,
+		watched:                  map[schema.GroupVersionKind]bool{},
+		kinds:                    map[string]schema.GroupVersionKind{},
+	}
+	return nil
+}
+
+// generateApplications renders the Applications of the given ApplicationSet, with its template merged with the default
+// template of the controller. The generated parameters are cached in the ApplicationSet status if enabled.
+func (r *ApplicationSetReconciler) generateApplications(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet) ([]argov1alpha1.Application, []string, argov1alpha1.ApplicationSetReasonType, error) {
+	appSetWithDefaults, err := template.ApplyDefaultTemplate(applicationSet, r.DefaultTemplate)
+	if err != nil {
+		return nil, nil, argov1alpha1.ApplicationSetReasonRenderTemplateParamsError, err
+	}
+	var cache *template.ParametersCache
+	if r.UseCachedParametersOnError {
+		cache = &template.ParametersCache{Entries: applicationSet.Status.CachedParameters}
+	} else if err := r.updateCachedParameters(ctx, logCtx, applicationSet, nil); err != nil {
+		return nil, nil, argov1alpha1.ApplicationSetReasonApplicationParamsGenerationError, err
+	}
+
+	apps, missingKeys, reason, err := template.GenerateApplicationsWithCache(ctx, logCtx, *appSetWithDefaults, r.Generators, r.Renderer, r.Client, cache, r.Metrics)
+	var failedGenerators []int
+	if cache != nil {
+		for _, used := range cache.Used {
+			r.recordEvent(ctx, applicationSet, corev1.EventTypeWarning, "CachedParametersUsed", "Generator %d failed to generate parameters, using the parameters generated at %s: %v", used.Generator, used.GeneratedAt.UTC().Format(time.RFC3339), used.Err)
+			failedGenerators = append(failedGenerators, used.Generator)
+		}
+		if cache.Changed {
+			if updateErr := r.updateCachedParameters(ctx, logCtx, applicationSet, cache.Entries); updateErr != nil {
+				return nil, nil, argov1alpha1.ApplicationSetReasonApplicationParamsGenerationError, updateErr
+			}
+		}
+	}
+	var generatorsErr *template.GeneratorsFailedError
+	if errors.As(err, &generatorsErr) {
+		failedGenerators = append(failedGenerators, generatorsErr.Generators...)
+	}
+	if updateErr := r.updateGeneratorFailures(ctx, logCtx, applicationSet, failedGenerators); updateErr != nil {
+		return nil, nil, argov1alpha1.ApplicationSetReasonApplicationParamsGenerationError, updateErr
+	}
+	return apps, missingKeys, reason, err
 }
 
 // createOrUpdateInCluster will create / update application resources in the cluster.
@@ -682,6 +1011,7 @@ func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager, enableProg
 // The function also adds owner reference to all applications, and uses it to delete them.
 func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context, logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) error {
 	var firstError error
+	var adoptedApps []string
 	// Creates or updates the application in appList
 	for _, generatedApp := range desiredApplications {
 		appLog := logCtx.WithFields(applog.GetAppLogFields(&generatedApp))
@@ -700,9 +1030,36 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 			},
 		}
 
+		// adoption is the description of the adoption of an existing Application which is not managed by an
+		// ApplicationSet yet, which is only allowed by the validation if adoptExistingApplications is enabled
+		adoption := ""
 		action, err := utils.CreateOrUpdate(ctx, appLog, r.Client, applicationSet.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, found, func() error {
 			// Copy only the Application/ObjectMeta fields that are significant, from the generatedApp
+			liveSpec := found.Spec.DeepCopy()
 			found.Spec = generatedApp.Spec
+			reapplyTemplate := utils.IsReapplyTemplateRequested(found)
+			if reapplyTemplate {
+				appLog.Info("Reapplying template to Application as requested by annotation")
+			}
+
+			// Preserved spec paths, e.g. a targetRevision pinned manually on the Application, are only set from the
+			// template when the Application is created
+			if found.ResourceVersion != "" && applicationSet.Spec.PreservedFields != nil && !reapplyTemplate {
+				if err := utils.PreserveSpecPaths(liveSpec, &found.Spec, applicationSet.Spec.PreservedFields.Paths); err != nil {
+					return fmt.Errorf("failed to preserve application spec fields: %w", err)
+				}
+			}
+
+			if found.ResourceVersion != "" && metav1.GetControllerOf(found) == nil && applicationSet.Spec.SyncPolicy != nil && applicationSet.Spec.SyncPolicy.AdoptExistingApplications {
+				changes, err := getApplicationSpecChanges(liveSpec, &found.Spec)
+				if err != nil {
+					return fmt.Errorf("failed to compare the spec of the adopted application: %w", err)
+				}
+				adoption = generatedApp.Name + " (unchanged spec)"
+				if len(changes) > 0 {
+					adoption = fmt.Sprintf("%s (changed %s)", generatedApp.Name, strings.Join(changes, ", "))
+				}
+			}
 
 			// allow setting the Operation field to trigger a sync operation on an Application
 			if generatedApp.Operation != nil {
@@ -758,6 +1115,9 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 				}
 			}
 
+			// The reapply request is fulfilled by this update
+			delete(generatedApp.Annotations, common.AnnotationApplicationSetReapplyTemplate)
+
 			found.Annotations = generatedApp.Annotations
 			found.Labels = generatedApp.Labels
 			found.Finalizers = generatedApp.Finalizers
@@ -772,9 +1132,15 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 			continue
 		}
 
+		if adoption != "" {
+			r.recordEvent(ctx, &applicationSet, corev1.EventTypeNormal, "Adopted", "Adopted existing Application %s", adoption)
+			appLog.Infof("Adopted existing Application %s", adoption)
+			adoptedApps = append(adoptedApps, adoption)
+		}
+
 		if action != controllerutil.OperationResultNone {
 			// Don't pollute etcd with "unchanged Application" events
-			r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, fmt.Sprint(action), "%s Application %q", action, generatedApp.Name)
+			r.recordEvent(ctx, &applicationSet, corev1.EventTypeNormal, fmt.Sprint(action), "%s Application %q", action, generatedApp.Name)
 			appLog.Logf(log.InfoLevel, "%s Application", action)
 		} else {
 			// "unchanged Application" can be inferred by Reconcile Complete with no action being listed
@@ -782,11 +1148,23 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 			appLog.Logf(log.DebugLevel, "%s Application", action)
 		}
 	}
+
+	if len(adoptedApps) > 0 {
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSet,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionApplicationsAdopted,
+				Message: "Adopted existing Applications: " + strings.Join(adoptedApps, "; "),
+				Reason:  argov1alpha1.ApplicationSetReasonApplicationsAdopted,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, true,
+		)
+	}
 	return firstError
 }
 
-// createInCluster will filter from the desiredApplications only the application that needs to be created
-// Then it will call createOrUpdateInCluster to do the actual create
+// createInCluster will filter from the desiredApplications only the application that needs to be created, or whose template
+// is requested to be reapplied. Then it will call createOrUpdateInCluster to do the actual create
 func (r *ApplicationSetReconciler) createInCluster(ctx context.Context, logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) error {
 	var createApps []argov1alpha1.Application
 	current, err := r.getCurrentApplications(ctx, applicationSet)
@@ -794,17 +1172,17 @@ func (r *ApplicationSetReconciler) createInCluster(ctx context.Context, logCtx *
 		return fmt.Errorf("error getting current applications: %w", err)
 	}
 
-	m := make(map[string]bool) // Will holds the app names that are current in the cluster
+	m := make(map[string]bool) // Will holds the app names that are current in the cluster, mapped to whether the template must be reapplied
 
 	for _, app := range current {
-		m[app.Name] = true
+		m[app.Name] = utils.IsReapplyTemplateRequested(&app)
 	}
 
 	// filter applications that are not in m[string]bool (new to the cluster)
 	for _, app := range desiredApplications {
-		_, exists := m[app.Name]
+		reapplyTemplate, exists := m[app.Name]
 
-		if !exists {
+		if !exists || reapplyTemplate {
 			createApps = append(createApps, app)
 		}
 	}
@@ -844,11 +1222,19 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, logCtx *
 
 	// Delete apps that are not in m[string]bool
 	var firstError error
+	var protectedApps []string
 	for _, app := range current {
 		logCtx = logCtx.WithFields(applog.GetAppLogFields(&app))
 		_, exists := m[app.Name]
 
 		if !exists {
+			if isApplicationDeleteProtected(&app) {
+				protectedApps = append(protectedApps, app.Name)
+				r.recordEvent(ctx, &applicationSet, corev1.EventTypeWarning, "DeletionProtected", "Skipped the deletion of protected Application %q", app.Name)
+				logCtx.Warn("Application is no longer generated, but is protected from deletion")
+				continue
+			}
+
 			// Removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
 			err := r.removeFinalizerOnInvalidDestination(ctx, applicationSet, &app, clusterList, logCtx)
 			if err != nil {
@@ -867,13 +1253,52 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, logCtx *
 				}
 				continue
 			}
-			r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, "Deleted", "Deleted Application %q", app.Name)
+			r.recordEvent(ctx, &applicationSet, corev1.EventTypeNormal, "Deleted", "Deleted Application %q", app.Name)
 			logCtx.Log(log.InfoLevel, "Deleted application")
 		}
 	}
+
+	if len(protectedApps) > 0 {
+		sort.Strings(protectedApps)
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSet,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionDeletionProtected,
+				Message: "Skipped the deletion of Applications which are no longer generated, but are protected from deletion: " + strings.Join(protectedApps, ", "),
+				Reason:  argov1alpha1.ApplicationSetReasonApplicationDeletionProtected,
+				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			}, true,
+		)
+	} else if hasApplicationSetCondition(applicationSet, argov1alpha1.ApplicationSetConditionDeletionProtected, argov1alpha1.ApplicationSetConditionStatusTrue) {
+		_ = r.setApplicationSetStatusCondition(ctx,
+			&applicationSet,
+			argov1alpha1.ApplicationSetCondition{
+				Type:    argov1alpha1.ApplicationSetConditionDeletionProtected,
+				Message: "No protected Application is pending deletion",
+				Reason:  argov1alpha1.ApplicationSetReasonNoApplicationDeletionProtected,
+				Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+			}, true,
+		)
+	}
 	return firstError
 }
 
+// isApplicationDeleteProtected returns true if the Application must not be deleted by the ApplicationSet controller when it is no
+// longer generated
+func isApplicationDeleteProtected(app *argov1alpha1.Application) bool {
+	return app.Annotations[common.AnnotationApplicationSetDeleteProtection] == common.AnnotationValueApplicationSetDeleteProtectionEnabled
+}
+
+// hasApplicationSetCondition returns true if the ApplicationSet has a condition of the given type and status
+func hasApplicationSetCondition(applicationSet argov1alpha1.ApplicationSet, conditionType argov1alpha1.ApplicationSetConditionType, status argov1alpha1.ApplicationSetConditionStatus) bool {
+	for _, condition := range applicationSet.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
 // removeFinalizerOnInvalidDestination removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
 func (r *ApplicationSetReconciler) removeFinalizerOnInvalidDestination(ctx context.Context, applicationSet argov1alpha1.ApplicationSet, app *argov1alpha1.Application, clusterList []utils.ClusterSpecifier, appLog *log.Entry) error {
 	// Only check if the finalizers need to be removed IF there are finalizers to remove
@@ -932,7 +1357,7 @@ func (r *ApplicationSetReconciler) removeFinalizerOnInvalidDestination(ctx conte
 			// Application must have updated list of finalizers
 			updated.DeepCopyInto(app)
 
-			r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, "Updated", "Updated Application %q finalizer before deletion, because application has an invalid destination", app.Name)
+			r.recordEvent(ctx, &applicationSet, corev1.EventTypeNormal, "Updated", "Updated Application %q finalizer before deletion, because application has an invalid destination", app.Name)
 			appLog.Log(log.InfoLevel, "Updating application finalizer before deletion, because application has an invalid destination")
 		}
 	}
@@ -947,8 +1372,10 @@ func (r *ApplicationSetReconciler) removeOwnerReferencesOnDeleteAppSet(ctx conte
 	}
 
 	for _, app := range applications {
+		// Patch rather than update the Application to keep fields which are unknown to this controller
+		patch := client.MergeFrom(app.DeepCopy())
 		app.SetOwnerReferences([]metav1.OwnerReference{})
-		err := r.Update(ctx, &app)
+		err := r.Patch(ctx, &app, patch)
 		if err != nil {
 			return fmt.Errorf("error updating application: %w", err)
 		}
@@ -965,12 +1392,29 @@ func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context,
 		return nil, fmt.Errorf("failed to update applicationset app status: %w", err)
 	}
 
+	err = r.resumeRolloutStep(ctx, logCtx, &appset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume applicationset rollout step: %w", err)
+	}
+
 	logCtx.Infof("ApplicationSet %v step list:", appset.Name)
 	for stepIndex, applicationNames := range appDependencyList {
 		logCtx.Infof("step %v: %+v", stepIndex+1, applicationNames)
 	}
 
-	appsToSync := r.getAppsToSync(appset, appDependencyList, applications)
+	stepAppDependencyList := withoutSuspendedApps(appDependencyList, desiredApplications)
+	err = r.updateStepAnalyses(ctx, logCtx, &appset, stepAppDependencyList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update applicationset step analyses: %w", err)
+	}
+
+	appsToSync := r.getAppsToSync(appset, stepAppDependencyList, applications)
+	deferRolloutPromotions(logCtx, &appset, appsToSync)
+
+	err = r.updateRolloutClusterLocks(ctx, logCtx, &appset, desiredApplications, appsToSync)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update applicationset rollout cluster locks: %w", err)
+	}
 	logCtx.Infof("Application allowed to sync before maxUpdate?: %+v", appsToSync)
 
 	_, err = r.updateApplicationSetApplicationStatusProgress(ctx, logCtx, &appset, appsToSync, appStepMap)
@@ -983,12 +1427,68 @@ func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context,
 	return appsToSync, nil
 }
 
+// resumeRolloutStep marks the Applications of the RollingSync step named by the resume step annotation as resumed, and
+// removes the annotation
+func (r *ApplicationSetReconciler) resumeRolloutStep(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet) error {
+	value, ok := appset.Annotations[common.AnnotationApplicationSetResumeStep]
+	if !ok {
+		return nil
+	}
+
+	if step, err := strconv.Atoi(value); err != nil || step < 1 {
+		logCtx.Warnf("ignoring invalid %s annotation %q", common.AnnotationApplicationSetResumeStep, value)
+	} else {
+		appStatuses := slices.Clone(appset.Status.ApplicationStatus)
+		for i := range appStatuses {
+			if appStatuses[i].Step == value {
+				appStatuses[i].Resumed = true
+			}
+		}
+		if err := r.setAppSetApplicationStatus(ctx, logCtx, appset, appStatuses); err != nil {
+			return err
+		}
+		logCtx.Infof("Resumed the rollout after step %d", step)
+	}
+
+	resumed := appset.DeepCopy()
+	delete(resumed.Annotations, common.AnnotationApplicationSetResumeStep)
+	if err := r.Patch(ctx, resumed, client.MergeFrom(appset)); err != nil {
+		return fmt.Errorf("error removing the %s annotation: %w", common.AnnotationApplicationSetResumeStep, err)
+	}
+	return nil
+}
+
+// withoutSuspendedApps removes the suspended Applications from the given steps, so they are neither promoted nor hold
+// back the following steps
+func withoutSuspendedApps(appDependencyList [][]string, desiredApplications []argov1alpha1.Application) [][]string {
+	suspended := map[string]bool{}
+	for i := range desiredApplications {
+		if template.IsSuspended(&desiredApplications[i]) {
+			suspended[desiredApplications[i].Name] = true
+		}
+	}
+	if len(suspended) == 0 {
+		return appDependencyList
+	}
+	steps := make([][]string, 0, len(appDependencyList))
+	for _, appNames := range appDependencyList {
+		steps = append(steps, slices.DeleteFunc(slices.Clone(appNames), func(appName string) bool {
+			return suspended[appName]
+		}))
+	}
+	return steps
+}
+
 // this list tracks which Applications belong to each RollingUpdate step
 func (r *ApplicationSetReconciler) buildAppDependencyList(logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, applications []argov1alpha1.Application) ([][]string, map[string]int) {
 	if applicationSet.Spec.Strategy == nil || applicationSet.Spec.Strategy.Type == "" || applicationSet.Spec.Strategy.Type == "AllAtOnce" {
 		return [][]string{}, map[string]int{}
 	}
 
+	if isCanaryStrategy(&applicationSet) {
+		return buildCanaryAppDependencyList(logCtx, applicationSet, applications)
+	}
+
 	steps := []argov1alpha1.ApplicationSetRolloutStep{}
 	if progressiveSyncsRollingSyncStrategyEnabled(&applicationSet) {
 		steps = applicationSet.Spec.Strategy.RollingSync.Steps
@@ -1003,10 +1503,12 @@ func (r *ApplicationSetReconciler) buildAppDependencyList(logCtx *log.Entry, app
 
 	// use applicationLabelSelectors to filter generated Applications into steps and status by name
 	for _, app := range applications {
-		for i, step := range steps {
+		// the templated values of the matchExpressions are resolved for each Application
+		stepMatchExpressions := template.RollingSyncMatchExpressions(&applicationSet, &app)
+		for i := range steps {
 			selected := true // default to true, assuming the current Application is a match for the given step matchExpression
 
-			for _, matchExpression := range step.MatchExpressions {
+			for _, matchExpression := range stepMatchExpressions[i] {
 				if val, ok := app.Labels[matchExpression.Key]; ok {
 					valueMatched := labelMatchedExpression(logCtx, val, matchExpression)
 
@@ -1034,6 +1536,33 @@ func (r *ApplicationSetReconciler) buildAppDependencyList(logCtx *log.Entry, app
 	return appDependencyList, appStepMap
 }
 
+// buildCanaryAppDependencyList splits the Applications in two steps: the canary Applications, which are the first
+// Applications by name, and the remaining Applications
+func buildCanaryAppDependencyList(logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, applications []argov1alpha1.Application) ([][]string, map[string]int) {
+	appNames := make([]string, 0, len(applications))
+	for _, app := range applications {
+		appNames = append(appNames, app.Name)
+	}
+	sort.Strings(appNames)
+
+	weight := applicationSet.Spec.Strategy.Canary.GetWeight()
+	canaryCount, err := intstr.GetScaledValueFromIntOrPercent(&weight, len(appNames), true)
+	if err != nil {
+		logCtx.Warnf("AppSet has a invalid canary weight '%v', defaulting to %v: %v", weight.String(), argov1alpha1.DefaultCanaryWeight.String(), err)
+		canaryCount, _ = intstr.GetScaledValueFromIntOrPercent(&argov1alpha1.DefaultCanaryWeight, len(appNames), true)
+	}
+	canaryCount = min(max(canaryCount, 0), len(appNames))
+
+	appDependencyList := [][]string{appNames[:canaryCount], appNames[canaryCount:]}
+	appStepMap := map[string]int{}
+	for step, stepAppNames := range appDependencyList {
+		for _, appName := range stepAppNames {
+			appStepMap[appName] = step
+		}
+	}
+	return appDependencyList, appStepMap
+}
+
 func labelMatchedExpression(logCtx *log.Entry, val string, matchExpression argov1alpha1.ApplicationMatchExpression) bool {
 	if matchExpression.Operator != "In" && matchExpression.Operator != "NotIn" {
 		logCtx.Errorf("skipping AppSet rollingUpdate step Application selection, invalid matchExpression operator provided: %q ", matchExpression.Operator)
@@ -1087,7 +1616,7 @@ func (r *ApplicationSetReconciler) getAppsToSync(applicationSet argov1alpha1.App
 			}
 
 			appStatus := applicationSet.Status.ApplicationStatus[idx]
-			if appStatus.Status != argov1alpha1.ProgressiveSyncHealthy {
+			if appStatus.Status != argov1alpha1.ProgressiveSyncHealthy && !isApplicationFailureTolerated(&applicationSet, appStatus) {
 				// At least one application in this wave is not yet healthy. We cannot proceed to the next wave
 				syncNextWave = false
 				break
@@ -1096,11 +1625,62 @@ func (r *ApplicationSetReconciler) getAppsToSync(applicationSet argov1alpha1.App
 		if !syncNextWave {
 			break
 		}
+
+		if slices.ContainsFunc(appDependencyList[stepIndex], func(appName string) bool {
+			idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+			return !isApplicationStepCompleted(&applicationSet, applicationSet.Status.ApplicationStatus[idx])
+		}) {
+			// the next step is synced once the analysis of the Healthy Applications of this step passed, and the step
+			// was resumed if it pauses
+			break
+		}
+
+		if isCanaryStrategy(&applicationSet) && stepIndex == 0 {
+			// the remaining Applications are synced once the canary Applications have soaked
+			if _, soaked := getCanarySoakRemaining(&applicationSet, time.Now()); !soaked {
+				break
+			}
+		}
 	}
 
 	return appSyncMap
 }
 
+// getCanarySoakRemaining returns the remaining soak time of the canary Applications of the given ApplicationSet, and
+// whether the canary Applications are Healthy and have soaked for the configured duration
+func getCanarySoakRemaining(appset *argov1alpha1.ApplicationSet, now time.Time) (time.Duration, bool) {
+	soakDuration, err := appset.Spec.Strategy.Canary.GetSoakDuration()
+	if err != nil {
+		log.WithField("applicationset", appset.QualifiedName()).Warnf("%v, ignoring the soak duration", err)
+	}
+	var healthySince time.Time
+	for _, appStatus := range appset.Status.ApplicationStatus {
+		if appStatus.Step != "1" {
+			continue
+		}
+		if appStatus.Status != argov1alpha1.ProgressiveSyncHealthy {
+			return 0, false
+		}
+		if appStatus.LastTransitionTime != nil && appStatus.LastTransitionTime.After(healthySince) {
+			healthySince = appStatus.LastTransitionTime.Time
+		}
+	}
+	if remaining := healthySince.Add(soakDuration).Sub(now); remaining > 0 {
+		return remaining, false
+	}
+	return 0, true
+}
+
+// getCanarySoakRequeueAfter returns the duration after which the canary Applications of the given ApplicationSet have
+// soaked, so the remaining Applications are synced even if no Application changes anymore
+func getCanarySoakRequeueAfter(appset *argov1alpha1.ApplicationSet, now time.Time) time.Duration {
+	if !isCanaryStrategy(appset) {
+		return 0
+	}
+	remaining, _ := getCanarySoakRemaining(appset, now)
+	return remaining
+}
+
 func isRollingSyncStrategy(appset *argov1alpha1.ApplicationSet) bool {
 	// It's only RollingSync if the type specifically sets it
 	return appset.Spec.Strategy != nil && appset.Spec.Strategy.Type == "RollingSync" && appset.Spec.Strategy.RollingSync != nil
@@ -1111,6 +1691,31 @@ func progressiveSyncsRollingSyncStrategyEnabled(appset *argov1alpha1.Application
 	return isRollingSyncStrategy(appset) && len(appset.Spec.Strategy.RollingSync.Steps) > 0
 }
 
+func isCanaryStrategy(appset *argov1alpha1.ApplicationSet) bool {
+	return appset.Spec.Strategy != nil && appset.Spec.Strategy.Type == "Canary" && appset.Spec.Strategy.Canary != nil
+}
+
+func isProgressiveSyncStrategy(appset *argov1alpha1.ApplicationSet) bool {
+	return isRollingSyncStrategy(appset) || isCanaryStrategy(appset)
+}
+
+// getProgressiveSyncStepCount returns the number of steps of the progressive sync strategy of the given ApplicationSet
+func getProgressiveSyncStepCount(appset *argov1alpha1.ApplicationSet) int {
+	switch {
+	case progressiveSyncsRollingSyncStrategyEnabled(appset):
+		return len(appset.Spec.Strategy.RollingSync.Steps)
+	case isCanaryStrategy(appset):
+		// the canary Applications and the remaining Applications
+		return 2
+	default:
+		return 0
+	}
+}
+
+func progressiveSyncsEnabled(appset *argov1alpha1.ApplicationSet) bool {
+	return getProgressiveSyncStepCount(appset) > 0
+}
+
 func isApplicationWithError(app argov1alpha1.Application) bool {
 	for _, condition := range app.Status.Conditions {
 		if condition.Type == argov1alpha1.ApplicationConditionInvalidSpecError {
@@ -1128,12 +1733,59 @@ func isProgressiveSyncDeletionOrderReversed(appset *argov1alpha1.ApplicationSet)
 	return progressiveSyncsRollingSyncStrategyEnabled(appset) && strings.EqualFold(appset.Spec.Strategy.DeletionOrder, ReverseDeletionOrder)
 }
 
-func getAppStep(appName string, appStepMap map[string]int) int {
-	// if an application is not selected by any match expression, it defaults to step -1
-	step := -1
-	if appStep, ok := appStepMap[appName]; ok {
-		// 1-based indexing
-		step = appStep + 1
+// isApplicationStepCompleted returns true if the Application of the given status is Healthy, the analysis of its step,
+// if any, passed, and its step was resumed, if it pauses. A Failed Application is completed if the failure policy of
+// its step is Continue.
+func isApplicationStepCompleted(appset *argov1alpha1.ApplicationSet, appStatus argov1alpha1.ApplicationSetApplicationStatus) bool {
+	if isApplicationFailureTolerated(appset, appStatus) {
+		return true
+	}
+	if appStatus.Status != argov1alpha1.ProgressiveSyncHealthy {
+		return false
+	}
+	step, err := strconv.Atoi(appStatus.Step)
+	if err != nil || !progressiveSyncsRollingSyncStrategyEnabled(appset) || step < 1 || step > len(appset.Spec.Strategy.RollingSync.Steps) {
+		return true
+	}
+	rolloutStep := appset.Spec.Strategy.RollingSync.Steps[step-1]
+	if rolloutStep.Analysis != nil && (appStatus.StepAnalysis == nil || appStatus.StepAnalysis.Phase != argov1alpha1.ApplicationSetStepAnalysisSuccessful) {
+		return false
+	}
+	return !rolloutStep.PauseAfter || appStatus.Resumed
+}
+
+// isRollingSyncStepPausing returns true if the given 1-based RollingSync step pauses after its Applications are Healthy
+func isRollingSyncStepPausing(appset *argov1alpha1.ApplicationSet, step string) bool {
+	stepIndex, err := strconv.Atoi(step)
+	return err == nil && progressiveSyncsRollingSyncStrategyEnabled(appset) && stepIndex >= 1 && stepIndex <= len(appset.Spec.Strategy.RollingSync.Steps) &&
+		appset.Spec.Strategy.RollingSync.Steps[stepIndex-1].PauseAfter
+}
+
+// getRollingSyncStep returns the given 1-based RollingSync step, or nil if the ApplicationSet has no such step
+func getRollingSyncStep(appset *argov1alpha1.ApplicationSet, step string) *argov1alpha1.ApplicationSetRolloutStep {
+	stepIndex, err := strconv.Atoi(step)
+	if err != nil || !progressiveSyncsRollingSyncStrategyEnabled(appset) || stepIndex < 1 || stepIndex > len(appset.Spec.Strategy.RollingSync.Steps) {
+		return nil
+	}
+	return &appset.Spec.Strategy.RollingSync.Steps[stepIndex-1]
+}
+
+// isApplicationFailureTolerated returns true if the Application of the given status is Failed and the failure policy
+// of its step is Continue, so the Application does not hold back the following steps
+func isApplicationFailureTolerated(appset *argov1alpha1.ApplicationSet, appStatus argov1alpha1.ApplicationSetApplicationStatus) bool {
+	if appStatus.Status != argov1alpha1.ProgressiveSyncFailed {
+		return false
+	}
+	rolloutStep := getRollingSyncStep(appset, appStatus.Step)
+	return rolloutStep != nil && rolloutStep.FailurePolicy == argov1alpha1.ApplicationSetRolloutFailurePolicyContinue
+}
+
+func getAppStep(appName string, appStepMap map[string]int) int {
+	// if an application is not selected by any match expression, it defaults to step -1
+	step := -1
+	if appStep, ok := appStepMap[appName]; ok {
+		// 1-based indexing
+		step = appStep + 1
 	}
 	return step
 }
@@ -1142,6 +1794,9 @@ func getAppStep(appName string, appStepMap map[string]int) int {
 func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, appStepMap map[string]int) ([]argov1alpha1.ApplicationSetApplicationStatus, error) {
 	now := metav1.Now()
 	appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, 0, len(applications))
+	rollbackTimeout, rollbackEnabled := getRollbackOnFailureTimeout(logCtx, applicationSet)
+	// failedSteps maps the steps to roll back to the name of the Application which failed them
+	failedSteps := map[string]string{}
 
 	for _, app := range applications {
 		appHealthStatus := app.Status.Health.Status
@@ -1179,7 +1834,13 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatus(ctx con
 
 		if !reflect.DeepEqual(currentAppStatus.TargetRevisions, app.Status.GetRevisions()) {
 			// A new version is available in the application and we need to re-sync the application
+			if currentAppStatus.Status == argov1alpha1.ProgressiveSyncHealthy {
+				// keep track of the revisions the application was healthy with, in case the rollout has to be rolled back
+				newAppStatus.LastHealthyTargetRevisions = currentAppStatus.TargetRevisions
+			}
 			newAppStatus.TargetRevisions = app.Status.GetRevisions()
+			newAppStatus.StepAnalysis = nil
+			newAppStatus.Resumed = false
 			newAppStatus.Message = "Application has pending changes, setting status to Waiting"
 			newAppStatus.Status = argov1alpha1.ProgressiveSyncWaiting
 			newAppStatus.LastTransitionTime = &now
@@ -1239,6 +1900,38 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatus(ctx con
 					newAppStatus.Message = "Application resource became Healthy, updating status from Progressing to Healthy"
 				}
 			}
+
+			if currentAppStatus.Status == argov1alpha1.ProgressiveSyncFailed {
+				// The Application may still become Healthy after its step timed out, which unblocks the rollout
+				if appHealthStatus == health.HealthStatusHealthy && appSyncStatus == argov1alpha1.SyncStatusCodeSynced {
+					newAppStatus.LastTransitionTime = &now
+					newAppStatus.Status = argov1alpha1.ProgressiveSyncHealthy
+					newAppStatus.Message = "Application resource became Healthy, updating status from Failed to Healthy"
+				}
+			}
+
+			if currentAppStatus.Status == argov1alpha1.ProgressiveSyncRollingBack {
+				// Validate that the rollback sync was trigerred after the rolling back transition time
+				if app.Status.OperationState != nil && app.Status.OperationState.StartedAt.After(currentAppStatus.LastTransitionTime.Time) {
+					newAppStatus.LastTransitionTime = &now
+					newAppStatus.Status = argov1alpha1.ProgressiveSyncRolledBack
+					newAppStatus.Message = "Application resource was synced to its previously healthy revisions, updating status from RollingBack to RolledBack"
+				}
+			}
+		}
+
+		if rollbackEnabled {
+			if deadline, ok := getRollbackDeadline(&app, newAppStatus, rollbackTimeout); ok && !now.Time.Before(deadline) {
+				failedSteps[newAppStatus.Step] = app.Name
+			}
+		}
+
+		if stepTimeout, ok := getRolloutStepTimeout(logCtx, applicationSet, newAppStatus.Step); ok {
+			if deadline, ok := getRolloutStepDeadline(newAppStatus, stepTimeout); ok && !now.Time.Before(deadline) {
+				newAppStatus.Message = fmt.Sprintf("Application resource stayed %s for longer than the timeout of step %s of %v, updating status to Failed", newAppStatus.Status, newAppStatus.Step, stepTimeout)
+				newAppStatus.Status = argov1alpha1.ProgressiveSyncFailed
+				newAppStatus.LastTransitionTime = &now
+			}
 		}
 
 		if newAppStatus.LastTransitionTime == &now {
@@ -1252,6 +1945,27 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatus(ctx con
 		appStatuses = append(appStatuses, *newAppStatus)
 	}
 
+	for i := range appStatuses {
+		if failedApp, ok := failedSteps[appStatuses[i].Step]; ok && rollbackApplicationStatus(&appStatuses[i], failedApp, rollbackTimeout, &now) {
+			logCtx.WithFields(log.Fields{
+				"app.name":                   appStatuses[i].Application,
+				"new_status.status":          appStatuses[i].Status,
+				"new_status.message":         appStatuses[i].Message,
+				"new_status.step":            appStatuses[i].Step,
+				"new_status.targetRevisions": strings.Join(appStatuses[i].LastHealthyTargetRevisions, ","),
+			}).Info("Progressive sync application changed status")
+		}
+	}
+
+	// the previously healthy revisions are only relevant for the rollout in progress
+	if !slices.ContainsFunc(appStatuses, func(appStatus argov1alpha1.ApplicationSetApplicationStatus) bool {
+		return appStatus.Status != argov1alpha1.ProgressiveSyncHealthy
+	}) {
+		for i := range appStatuses {
+			appStatuses[i].LastHealthyTargetRevisions = nil
+		}
+	}
+
 	err := r.setAppSetApplicationStatus(ctx, logCtx, applicationSet, appStatuses)
 	if err != nil {
 		return nil, fmt.Errorf("failed to set AppSet application statuses: %w", err)
@@ -1260,6 +1974,128 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatus(ctx con
 	return appStatuses, nil
 }
 
+// getRollbackOnFailureTimeout returns the duration an Application of a step may stay Degraded before the step is rolled
+// back, or false if rollback on failure is not enabled for the given ApplicationSet
+func getRollbackOnFailureTimeout(logCtx *log.Entry, appset *argov1alpha1.ApplicationSet) (time.Duration, bool) {
+	if !progressiveSyncsRollingSyncStrategyEnabled(appset) || appset.Spec.Strategy.RollingSync.RollbackOnFailure == nil {
+		return 0, false
+	}
+	timeout, err := appset.Spec.Strategy.RollingSync.RollbackOnFailure.GetTimeout()
+	if err != nil {
+		logCtx.Warnf("%v, defaulting to %v", err, argov1alpha1.DefaultRollbackOnFailureTimeout)
+		return argov1alpha1.DefaultRollbackOnFailureTimeout, true
+	}
+	return timeout, true
+}
+
+// getRollbackDeadline returns the time at which the step of the given Application is rolled back if the Application
+// stays Degraded, or false if the Application is not Degraded while progressing
+func getRollbackDeadline(app *argov1alpha1.Application, appStatus *argov1alpha1.ApplicationSetApplicationStatus, timeout time.Duration) (time.Time, bool) {
+	if appStatus.Status != argov1alpha1.ProgressiveSyncProgressing || app.Status.Health.Status != health.HealthStatusDegraded {
+		return time.Time{}, false
+	}
+	degradedSince := appStatus.LastTransitionTime
+	if healthTransition := app.Status.Health.LastTransitionTime; healthTransition != nil && (degradedSince == nil || healthTransition.After(degradedSince.Time)) {
+		degradedSince = healthTransition
+	}
+	if degradedSince == nil {
+		return time.Time{}, false
+	}
+	return degradedSince.Add(timeout), true
+}
+
+// getRollbackRequeueAfter returns the duration after which the step of a Degraded Application is due to be rolled
+// back, so the ApplicationSet is reconciled even if the Application does not change anymore
+func getRollbackRequeueAfter(logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, now time.Time) time.Duration {
+	timeout, enabled := getRollbackOnFailureTimeout(logCtx, appset)
+	if !enabled {
+		return 0
+	}
+	var requeueAfter time.Duration
+	for i := range applications {
+		idx := findApplicationStatusIndex(appset.Status.ApplicationStatus, applications[i].Name)
+		if idx == -1 {
+			continue
+		}
+		if deadline, ok := getRollbackDeadline(&applications[i], &appset.Status.ApplicationStatus[idx], timeout); ok {
+			if remaining := max(deadline.Sub(now), time.Second); requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+		}
+	}
+	return requeueAfter
+}
+
+// getRolloutStepTimeout returns the duration an Application of the given step may stay Pending or Progressing before
+// it is Failed, or false if the step has no timeout
+func getRolloutStepTimeout(logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, step string) (time.Duration, bool) {
+	rolloutStep := getRollingSyncStep(appset, step)
+	if rolloutStep == nil {
+		return 0, false
+	}
+	timeout, err := rolloutStep.GetTimeout()
+	if err != nil {
+		logCtx.Warnf("%v, ignoring the timeout of step %s", err, step)
+		return 0, false
+	}
+	return timeout, timeout > 0
+}
+
+// getRolloutStepDeadline returns the time at which the Application of the given status is Failed if it stays Pending
+// or Progressing, or false if the Application is neither Pending nor Progressing
+func getRolloutStepDeadline(appStatus *argov1alpha1.ApplicationSetApplicationStatus, timeout time.Duration) (time.Time, bool) {
+	if (appStatus.Status != argov1alpha1.ProgressiveSyncPending && appStatus.Status != argov1alpha1.ProgressiveSyncProgressing) || appStatus.LastTransitionTime == nil {
+		return time.Time{}, false
+	}
+	return appStatus.LastTransitionTime.Add(timeout), true
+}
+
+// getRolloutStepTimeoutRequeueAfter returns the duration after which an Application is due to time out in its step,
+// so the ApplicationSet is reconciled even if the Application does not change anymore
+func getRolloutStepTimeoutRequeueAfter(logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, now time.Time) time.Duration {
+	var requeueAfter time.Duration
+	for i := range appset.Status.ApplicationStatus {
+		appStatus := &appset.Status.ApplicationStatus[i]
+		timeout, ok := getRolloutStepTimeout(logCtx, appset, appStatus.Step)
+		if !ok {
+			continue
+		}
+		if deadline, ok := getRolloutStepDeadline(appStatus, timeout); ok {
+			if remaining := max(deadline.Sub(now), time.Second); requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+		}
+	}
+	return requeueAfter
+}
+
+// rollbackApplicationStatus moves an Application of a failed step to RollingBack, so it is synced to its previously
+// healthy revisions, or to RolledBack if it has no revisions to roll back to but must not be promoted anymore. It
+// returns false if the status is unchanged.
+func rollbackApplicationStatus(appStatus *argov1alpha1.ApplicationSetApplicationStatus, failedApp string, timeout time.Duration, now *metav1.Time) bool {
+	reason := fmt.Sprintf("Application %s of step %s stayed Degraded for longer than %v", failedApp, appStatus.Step, timeout)
+	switch appStatus.Status {
+	case argov1alpha1.ProgressiveSyncPending, argov1alpha1.ProgressiveSyncProgressing, argov1alpha1.ProgressiveSyncHealthy, argov1alpha1.ProgressiveSyncFailed:
+		if len(appStatus.LastHealthyTargetRevisions) == 0 {
+			if appStatus.Status == argov1alpha1.ProgressiveSyncHealthy {
+				return false
+			}
+			appStatus.Status = argov1alpha1.ProgressiveSyncRolledBack
+			appStatus.Message = reason + ", but there are no previously healthy revisions to roll back to. Pausing the rollout until the target revisions change"
+		} else {
+			appStatus.Status = argov1alpha1.ProgressiveSyncRollingBack
+			appStatus.Message = reason + ", rolling back to the previously healthy revisions"
+		}
+	case argov1alpha1.ProgressiveSyncWaiting:
+		appStatus.Status = argov1alpha1.ProgressiveSyncRolledBack
+		appStatus.Message = reason + ", pausing the rollout until the target revisions change"
+	default:
+		return false
+	}
+	appStatus.LastTransitionTime = now
+	return true
+}
+
 // check Applications that are in Waiting status and promote them to Pending if needed
 func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusProgress(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appsToSync map[string]bool, appStepMap map[string]int) ([]argov1alpha1.ApplicationSetApplicationStatus, error) {
 	now := metav1.Now()
@@ -1267,8 +2103,8 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusProgress
 	appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, 0, len(applicationSet.Status.ApplicationStatus))
 
 	// if we have no RollingUpdate steps, clear out the existing ApplicationStatus entries
-	if progressiveSyncsRollingSyncStrategyEnabled(applicationSet) {
-		length := len(applicationSet.Spec.Strategy.RollingSync.Steps)
+	if progressiveSyncsEnabled(applicationSet) {
+		length := getProgressiveSyncStepCount(applicationSet)
 
 		updateCountMap := make([]int, length)
 		totalCountMap := make([]int, length)
@@ -1292,7 +2128,7 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusProgress
 			})
 
 			maxUpdateAllowed := true
-			maxUpdate := &intstr.IntOrString{}
+			var maxUpdate *intstr.IntOrString
 			if progressiveSyncsRollingSyncStrategyEnabled(applicationSet) {
 				maxUpdate = applicationSet.Spec.Strategy.RollingSync.Steps[appStepMap[appStatus.Application]].MaxUpdate
 			}
@@ -1343,22 +2179,22 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusProgress
 }
 
 func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditions(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet) []argov1alpha1.ApplicationSetCondition {
-	if !isRollingSyncStrategy(applicationSet) {
+	if !isProgressiveSyncStrategy(applicationSet) {
 		return applicationSet.Status.Conditions
 	}
 
 	completedWaves := map[string]bool{}
 	for _, appStatus := range applicationSet.Status.ApplicationStatus {
 		if v, ok := completedWaves[appStatus.Step]; !ok {
-			completedWaves[appStatus.Step] = appStatus.Status == argov1alpha1.ProgressiveSyncHealthy
+			completedWaves[appStatus.Step] = isApplicationStepCompleted(applicationSet, appStatus)
 		} else {
-			completedWaves[appStatus.Step] = v && appStatus.Status == argov1alpha1.ProgressiveSyncHealthy
+			completedWaves[appStatus.Step] = v && isApplicationStepCompleted(applicationSet, appStatus)
 		}
 	}
 
 	isProgressing := false
 	progressingStep := ""
-	for i := range applicationSet.Spec.Strategy.RollingSync.Steps {
+	for i := range getProgressiveSyncStepCount(applicationSet) {
 		step := strconv.Itoa(i + 1)
 		isCompleted, ok := completedWaves[step]
 		if !ok {
@@ -1372,23 +2208,63 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditio
 		}
 	}
 
+	// failedApps maps the steps to the names of their Applications which timed out
+	failedApps := map[string][]string{}
+	var allFailedApps []string
+	for _, appStatus := range applicationSet.Status.ApplicationStatus {
+		if appStatus.Status == argov1alpha1.ProgressiveSyncFailed {
+			failedApps[appStatus.Step] = append(failedApps[appStatus.Step], appStatus.Application)
+			allFailedApps = append(allFailedApps, appStatus.Application)
+		}
+	}
+
 	if isProgressing {
+		message := "ApplicationSet is performing rollout of step " + progressingStep
+		reason := argov1alpha1.ApplicationSetReasonApplicationSetModified
+		progressingRolloutStep := getRollingSyncStep(applicationSet, progressingStep)
+		switch {
+		case slices.ContainsFunc(applicationSet.Status.ApplicationStatus, func(appStatus argov1alpha1.ApplicationSetApplicationStatus) bool {
+			return appStatus.Step == progressingStep && (appStatus.Status == argov1alpha1.ProgressiveSyncRollingBack || appStatus.Status == argov1alpha1.ProgressiveSyncRolledBack)
+		}):
+			message = "ApplicationSet rolled back step " + progressingStep + " and paused the rollout until the target revisions change"
+		case len(failedApps[progressingStep]) > 0 && (progressingRolloutStep == nil || progressingRolloutStep.FailurePolicy != argov1alpha1.ApplicationSetRolloutFailurePolicyContinue):
+			message = fmt.Sprintf("ApplicationSet halted the rollout of step %s because Applications timed out: %s", progressingStep, strings.Join(failedApps[progressingStep], ", "))
+			reason = argov1alpha1.ApplicationSetReasonApplicationRolloutFailed
+		case slices.ContainsFunc(applicationSet.Status.ApplicationStatus, func(appStatus argov1alpha1.ApplicationSetApplicationStatus) bool {
+			return appStatus.Step == progressingStep && appStatus.StepAnalysis != nil && appStatus.StepAnalysis.Phase == argov1alpha1.ApplicationSetStepAnalysisFailed
+		}):
+			message = "ApplicationSet paused the rollout because the analysis of step " + progressingStep + " failed, until the target revisions change"
+		case slices.ContainsFunc(applicationSet.Status.ApplicationStatus, func(appStatus argov1alpha1.ApplicationSetApplicationStatus) bool {
+			return appStatus.Step == progressingStep && appStatus.StepAnalysis != nil && appStatus.StepAnalysis.Phase == argov1alpha1.ApplicationSetStepAnalysisRunning
+		}):
+			message = "ApplicationSet is running the analysis of step " + progressingStep
+		case isRollingSyncStepPausing(applicationSet, progressingStep) && !slices.ContainsFunc(applicationSet.Status.ApplicationStatus, func(appStatus argov1alpha1.ApplicationSetApplicationStatus) bool {
+			return appStatus.Step == progressingStep && appStatus.Status != argov1alpha1.ProgressiveSyncHealthy
+		}):
+			message = "ApplicationSet paused the rollout after step " + progressingStep + " until the step is resumed"
+		}
 		_ = r.setApplicationSetStatusCondition(ctx,
 			applicationSet,
 			argov1alpha1.ApplicationSetCondition{
 				Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
-				Message: "ApplicationSet is performing rollout of step " + progressingStep,
-				Reason:  argov1alpha1.ApplicationSetReasonApplicationSetModified,
+				Message: message,
+				Reason:  reason,
 				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
 			}, true,
 		)
 	} else {
+		message := "ApplicationSet Rollout has completed"
+		reason := argov1alpha1.ApplicationSetReasonApplicationSetRolloutComplete
+		if len(allFailedApps) > 0 {
+			message = "ApplicationSet Rollout has completed, but Applications timed out: " + strings.Join(allFailedApps, ", ")
+			reason = argov1alpha1.ApplicationSetReasonApplicationRolloutFailed
+		}
 		_ = r.setApplicationSetStatusCondition(ctx,
 			applicationSet,
 			argov1alpha1.ApplicationSetCondition{
 				Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
-				Message: "ApplicationSet Rollout has completed",
-				Reason:  argov1alpha1.ApplicationSetReasonApplicationSetRolloutComplete,
+				Message: message,
+				Reason:  reason,
 				Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
 			}, true,
 		)
@@ -1450,6 +2326,7 @@ func (r *ApplicationSetReconciler) migrateStatus(ctx context.Context, appset *ar
 func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, apps []argov1alpha1.Application) error {
 	statusMap := status.GetResourceStatusMap(appset)
 	statusMap = status.BuildResourceStatus(statusMap, apps)
+	summary := status.BuildSummary(statusMap)
 
 	statuses := []argov1alpha1.ResourceStatus{}
 	for _, status := range statusMap {
@@ -1465,6 +2342,7 @@ func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, lo
 	}
 	appset.Status.Resources = statuses
 	appset.Status.ResourcesCount = resourcesCount
+	appset.Status.Summary = &summary
 	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
@@ -1478,6 +2356,7 @@ func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, lo
 
 		updatedAppset.Status.Resources = appset.Status.Resources
 		updatedAppset.Status.ResourcesCount = resourcesCount
+		updatedAppset.Status.Summary = &summary
 
 		// Update the newly fetched object with new status resources
 		err := r.Client.Status().Update(ctx, updatedAppset)
@@ -1494,6 +2373,264 @@ func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, lo
 	return nil
 }
 
+// updateStaleApplicationsStatus records the Applications whose spec deviates from the latest rendered template in the
+// ApplicationSet status
+func (r *ApplicationSetReconciler) updateStaleApplicationsStatus(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) error {
+	currentApplications, err := r.getCurrentApplications(ctx, *appset)
+	if err != nil {
+		return err
+	}
+	desiredByName := make(map[string]*argov1alpha1.Application, len(desiredApplications))
+	for i := range desiredApplications {
+		desiredByName[desiredApplications[i].Name] = &desiredApplications[i]
+	}
+	staleApplications := []string{}
+	for i := range currentApplications {
+		desired, ok := desiredByName[currentApplications[i].Name]
+		if !ok {
+			continue
+		}
+		stale, err := utils.IsApplicationStale(&currentApplications[i], desired, appset.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{})
+		if err != nil {
+			logCtx.WithField("app", currentApplications[i].QualifiedName()).Warnf("failed to check whether the application is stale: %v", err)
+			continue
+		}
+		if stale {
+			staleApplications = append(staleApplications, currentApplications[i].Name)
+		}
+	}
+	sort.Strings(staleApplications)
+	staleApplicationsCount := int64(len(staleApplications))
+	if r.MaxResourcesStatusCount > 0 && len(staleApplications) > r.MaxResourcesStatusCount {
+		logCtx.Warnf("Truncating ApplicationSet %s stale applications status from %d to max allowed %d entries", appset.Name, len(staleApplications), r.MaxResourcesStatusCount)
+		staleApplications = staleApplications[:r.MaxResourcesStatusCount]
+	}
+	if len(staleApplications) == 0 {
+		staleApplications = nil
+	}
+	if reflect.DeepEqual(appset.Status.StaleApplications, staleApplications) && appset.Status.StaleApplicationsCount == staleApplicationsCount {
+		return nil
+	}
+	if staleApplicationsCount > 0 {
+		logCtx.Infof("%d applications deviate from the latest rendered template", staleApplicationsCount)
+	}
+
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.StaleApplications = staleApplications
+		updatedAppset.Status.StaleApplicationsCount = staleApplicationsCount
+
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(appset)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set status: %v", err)
+		return fmt.Errorf("unable to set application set status: %w", err)
+	}
+	return nil
+}
+
+// newGenerationRecord returns the record of a generation run which rendered the given desired Applications, while the
+// given current Applications existed. Nil is returned if the run neither added nor removed Applications.
+func newGenerationRecord(appset *argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application, currentApplications []argov1alpha1.Application, maxApplications int, now time.Time) *argov1alpha1.ApplicationSetGenerationRecord {
+	desiredNames := map[string]bool{}
+	for _, app := range desiredApplications {
+		desiredNames[app.Name] = true
+	}
+	currentNames := map[string]bool{}
+	for _, app := range currentApplications {
+		currentNames[app.Name] = true
+	}
+	added := []string{}
+	for name := range desiredNames {
+		if !currentNames[name] {
+			added = append(added, name)
+		}
+	}
+	removed := []string{}
+	for name := range currentNames {
+		if !desiredNames[name] {
+			removed = append(removed, name)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	record := &argov1alpha1.ApplicationSetGenerationRecord{
+		GeneratedAt:              metav1.NewTime(now),
+		ApplicationSetGeneration: appset.Generation,
+		ParameterSetCount:        int64(len(desiredApplications)),
+		AddedApplicationsCount:   int64(len(added)),
+		RemovedApplicationsCount: int64(len(removed)),
+	}
+	if maxApplications > 0 && len(added) > maxApplications {
+		added = added[:maxApplications]
+	}
+	if maxApplications > 0 && len(removed) > maxApplications {
+		removed = removed[:maxApplications]
+	}
+	if len(added) > 0 {
+		record.AddedApplications = added
+	}
+	if len(removed) > 0 {
+		record.RemovedApplications = removed
+	}
+	return record
+}
+
+// isSameGenerationChange returns true if both records add and remove the same Applications from the same parameter sets,
+// e.g. because the deletion of Applications is not allowed by the policy or creating them failed
+func isSameGenerationChange(a, b *argov1alpha1.ApplicationSetGenerationRecord) bool {
+	return a.ApplicationSetGeneration == b.ApplicationSetGeneration &&
+		a.ParameterSetCount == b.ParameterSetCount &&
+		a.AddedApplicationsCount == b.AddedApplicationsCount &&
+		a.RemovedApplicationsCount == b.RemovedApplicationsCount &&
+		reflect.DeepEqual(a.AddedApplications, b.AddedApplications) &&
+		reflect.DeepEqual(a.RemovedApplications, b.RemovedApplications)
+}
+
+// updateGenerationHistory records the generation run in the ApplicationSet status if it adds or removes Applications.
+// Only the most recent MaxGenerationHistory runs are kept.
+func (r *ApplicationSetReconciler) updateGenerationHistory(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application, currentApplications []argov1alpha1.Application) error {
+	record := newGenerationRecord(appset, desiredApplications, currentApplications, r.MaxResourcesStatusCount, time.Now())
+	if record == nil {
+		return nil
+	}
+	if len(appset.Status.GenerationHistory) > 0 && isSameGenerationChange(&appset.Status.GenerationHistory[0], record) {
+		return nil
+	}
+	logCtx.WithFields(log.Fields{"added": record.AddedApplicationsCount, "removed": record.RemovedApplicationsCount}).Info("generated applications changed")
+
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		history := append([]argov1alpha1.ApplicationSetGenerationRecord{*record}, updatedAppset.Status.GenerationHistory...)
+		if len(history) > MaxGenerationHistory {
+			history = history[:MaxGenerationHistory]
+		}
+		updatedAppset.Status.GenerationHistory = history
+
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(appset)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set status: %v", err)
+		return fmt.Errorf("unable to set application set status: %w", err)
+	}
+	return nil
+}
+
+// updateCachedParameters stores the given parameters cached for the generators in the ApplicationSet status
+func (r *ApplicationSetReconciler) updateCachedParameters(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, cachedParameters []argov1alpha1.ApplicationSetCachedParameters) error {
+	if len(cachedParameters) == 0 && len(appset.Status.CachedParameters) == 0 {
+		return nil
+	}
+
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.CachedParameters = cachedParameters
+
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(appset)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set status: %v", err)
+		return fmt.Errorf("unable to set application set status: %w", err)
+	}
+	return nil
+}
+
+// updateGeneratorFailures records the consecutive failures of the generators with a requeue backoff in the status of
+// the given ApplicationSet. The failures of the generators which are not among the given failed generators are reset.
+func (r *ApplicationSetReconciler) updateGeneratorFailures(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, failedGenerators []int) error {
+	now := metav1.Now()
+	var generatorFailures []argov1alpha1.ApplicationSetGeneratorFailures
+	for i, generator := range appset.Spec.Generators {
+		if generator.RequeueStrategy == nil || generator.RequeueStrategy.Backoff == nil || !slices.Contains(failedGenerators, i) {
+			continue
+		}
+		count := int64(1)
+		if previous := findGeneratorFailures(appset, i); previous != nil {
+			count = previous.Count + 1
+		}
+		generatorFailures = append(generatorFailures, argov1alpha1.ApplicationSetGeneratorFailures{
+			Generator:    int64(i),
+			Count:        count,
+			LastFailedAt: now,
+		})
+	}
+	if len(generatorFailures) == 0 && len(appset.Status.GeneratorFailures) == 0 {
+		return nil
+	}
+
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.GeneratorFailures = generatorFailures
+
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(appset)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set status: %v", err)
+		return fmt.Errorf("unable to set application set status: %w", err)
+	}
+	return nil
+}
+
 // setAppSetApplicationStatus updates the ApplicationSet's status field
 // with any new/changed Application statuses.
 func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, applicationStatuses []argov1alpha1.ApplicationSetApplicationStatus) error {
@@ -1518,8 +2655,11 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 			statusChanged := currentStatus.Status != appStatus.Status
 			stepChanged := currentStatus.Step != appStatus.Step
 			messageChanged := currentStatus.Message != appStatus.Message
+			lastHealthyTargetRevisionsChanged := !slices.Equal(currentStatus.LastHealthyTargetRevisions, appStatus.LastHealthyTargetRevisions)
+			stepAnalysisChanged := !reflect.DeepEqual(currentStatus.StepAnalysis, appStatus.StepAnalysis)
+			resumedChanged := currentStatus.Resumed != appStatus.Resumed
 
-			if statusChanged || stepChanged || messageChanged {
+			if statusChanged || stepChanged || messageChanged || lastHealthyTargetRevisionsChanged || stepAnalysisChanged || resumedChanged {
 				if statusChanged {
 					logCtx.WithFields(log.Fields{"application": appStatus.Application, "previous_status": currentStatus.Status, "new_status": appStatus.Status}).
 						Debug("application status changed")
@@ -1531,6 +2671,15 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 				if messageChanged {
 					logCtx.WithFields(log.Fields{"application": appStatus.Application}).Debug("application message changed")
 				}
+				if lastHealthyTargetRevisionsChanged {
+					logCtx.WithFields(log.Fields{"application": appStatus.Application}).Debug("application last healthy target revisions changed")
+				}
+				if stepAnalysisChanged {
+					logCtx.WithFields(log.Fields{"application": appStatus.Application}).Debug("application step analysis changed")
+				}
+				if resumedChanged {
+					logCtx.WithFields(log.Fields{"application": appStatus.Application, "resumed": appStatus.Resumed}).Debug("application step resumed changed")
+				}
 				needToUpdateStatus = true
 				break
 			}
@@ -1538,6 +2687,7 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 	}
 
 	if needToUpdateStatus {
+		previousStatuses := applicationSet.Status.ApplicationStatus
 		// sort to make sure the array is always in the same order
 		applicationSet.Status.ApplicationStatus = make([]argov1alpha1.ApplicationSetApplicationStatus, len(applicationStatuses))
 		copy(applicationSet.Status.ApplicationStatus, applicationStatuses)
@@ -1569,11 +2719,30 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 			logCtx.Errorf("unable to set application set status: %v", err)
 			return fmt.Errorf("unable to set application set status: %w", err)
 		}
+		r.recordApplicationStatusTransitions(ctx, applicationSet, previousStatuses, applicationStatuses)
 	}
 
 	return nil
 }
 
+// recordApplicationStatusTransitions records an event for each Application whose progressive sync status changed from
+// the given previous statuses, e.g. from Pending to Progressing, so that the rollouts can be audited
+func (r *ApplicationSetReconciler) recordApplicationStatusTransitions(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet, previousStatuses []argov1alpha1.ApplicationSetApplicationStatus, applicationStatuses []argov1alpha1.ApplicationSetApplicationStatus) {
+	for _, appStatus := range applicationStatuses {
+		idx := findApplicationStatusIndex(previousStatuses, appStatus.Application)
+		if idx == -1 || previousStatuses[idx].Status == appStatus.Status {
+			continue
+		}
+		eventType := corev1.EventTypeNormal
+		switch appStatus.Status {
+		case argov1alpha1.ProgressiveSyncFailed, argov1alpha1.ProgressiveSyncRollingBack, argov1alpha1.ProgressiveSyncRolledBack:
+			eventType = corev1.EventTypeWarning
+		}
+		r.recordEvent(ctx, applicationSet, eventType, "RollingSync"+string(appStatus.Status),
+			"Application %q changed from %s to %s in step %s: %s", appStatus.Application, previousStatuses[idx].Status, appStatus.Status, appStatus.Step, appStatus.Message)
+	}
+}
+
 func (r *ApplicationSetReconciler) syncDesiredApplications(logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appsToSync map[string]bool, desiredApplications []argov1alpha1.Application) []argov1alpha1.Application {
 	rolloutApps := []argov1alpha1.Application{}
 	for i := range desiredApplications {
@@ -1598,6 +2767,13 @@ func (r *ApplicationSetReconciler) syncDesiredApplications(logCtx *log.Entry, ap
 			desiredApplications[i] = syncApplication(desiredApplications[i], pruneEnabled)
 		}
 
+		// Applications of a failed step are synced to their previously healthy revisions, regardless of appsToSync
+		if idx > -1 && applicationSet.Status.ApplicationStatus[idx].Status == argov1alpha1.ProgressiveSyncRollingBack {
+			revisions := applicationSet.Status.ApplicationStatus[idx].LastHealthyTargetRevisions
+			logCtx.Infof("triggering rollback of application: %v to revisions %v, prune enabled: %v", desiredApplications[i].Name, revisions, pruneEnabled)
+			desiredApplications[i] = rollbackApplication(desiredApplications[i], revisions, pruneEnabled)
+		}
+
 		rolloutApps = append(rolloutApps, desiredApplications[i])
 	}
 	return rolloutApps
@@ -1637,6 +2813,19 @@ func syncApplication(application argov1alpha1.Application, prune bool) argov1alp
 	return application
 }
 
+// used by the RollingSync Progressive Sync strategy to sync a particular Application resource to the given revisions,
+// when its step failed
+func rollbackApplication(application argov1alpha1.Application, revisions []string, prune bool) argov1alpha1.Application {
+	application = syncApplication(application, prune)
+	application.Operation.Info[0].Value = "ApplicationSet RollingSync triggered a rollback of this Application resource to its previously healthy revisions"
+	if application.Spec.HasMultipleSources() {
+		application.Operation.Sync.Revisions = revisions
+	} else if len(revisions) > 0 {
+		application.Operation.Sync.Revision = revisions[0]
+	}
+	return application
+}
+
 func getApplicationOwnsHandler(enableProgressiveSyncs bool) predicate.Funcs {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
@@ -1,10 +1,12 @@
 package controllers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -32,6 +35,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators/mocks"
 	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
@@ -723,6 +727,164 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Ensure that configured preserved spec paths are preserved from an existing app",
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "namespace",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Template: v1alpha1.ApplicationSetTemplate{
+						Spec: v1alpha1.ApplicationSpec{
+							Project: "project",
+						},
+					},
+					PreservedFields: &v1alpha1.ApplicationPreservedFields{
+						Paths: []string{"spec.source.targetRevision"},
+					},
+				},
+			},
+			existingApps: []v1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Application",
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app1",
+						Namespace:       "namespace",
+						ResourceVersion: "2",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+						Source:  &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "old", TargetRevision: "hotfix"},
+					},
+				},
+			},
+			desiredApps: []v1alpha1.Application{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "app1",
+						Namespace: "namespace",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+						Source:  &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "new", TargetRevision: "main"},
+					},
+				},
+			},
+			expected: []v1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Application",
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app1",
+						Namespace:       "namespace",
+						ResourceVersion: "3",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+						Source:  &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "new", TargetRevision: "hotfix"},
+					},
+				},
+			},
+		},
+		{
+			name: "Ensure that preserved spec paths are not overwritten nor set again on an existing app",
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "namespace",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Template: v1alpha1.ApplicationSetTemplate{
+						Spec: v1alpha1.ApplicationSpec{
+							Project: "project",
+						},
+					},
+					PreservedFields: &v1alpha1.ApplicationPreservedFields{
+						Paths: []string{"spec.source.targetRevision", "spec.syncPolicy"},
+					},
+				},
+			},
+			existingApps: []v1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Application",
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app1",
+						Namespace:       "namespace",
+						ResourceVersion: "2",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+						Source:  &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "old", TargetRevision: "hotfix"},
+					},
+				},
+			},
+			desiredApps: []v1alpha1.Application{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "app1",
+						Namespace: "namespace",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project:    "project",
+						Source:     &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "new", TargetRevision: "main"},
+						SyncPolicy: &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{Prune: true}},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "app2",
+						Namespace: "namespace",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project:    "project",
+						Source:     &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "new", TargetRevision: "main"},
+						SyncPolicy: &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{Prune: true}},
+					},
+				},
+			},
+			expected: []v1alpha1.Application{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Application",
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app1",
+						Namespace:       "namespace",
+						ResourceVersion: "3",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "project",
+						Source:  &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "new", TargetRevision: "hotfix"},
+					},
+				},
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Application",
+						APIVersion: "argoproj.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "app2",
+						Namespace:       "namespace",
+						ResourceVersion: "1",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project:    "project",
+						Source:     &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "new", TargetRevision: "main"},
+						SyncPolicy: &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{Prune: true}},
+					},
+				},
+			},
+		},
 		{
 			name: "Ensure that the app spec is normalized before applying",
 			appSet: v1alpha1.ApplicationSet{
@@ -1895,6 +2057,77 @@ func TestDeleteInCluster(t *testing.T) {
 	}
 }
 
+func TestDeleteInClusterDeleteProtection(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	appSet := &v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"}}
+	newApp := func(name string, annotations map[string]string) *v1alpha1.Application {
+		app := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "namespace", Annotations: annotations},
+			Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+		}
+		require.NoError(t, controllerutil.SetControllerReference(appSet, app, scheme))
+		return app
+	}
+	protected := map[string]string{argocommon.AnnotationApplicationSetDeleteProtection: argocommon.AnnotationValueApplicationSetDeleteProtectionEnabled}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(appSet, newApp("keep", nil), newApp("delete", nil), newApp("protected", protected), newApp("disabled", map[string]string{argocommon.AnnotationApplicationSetDeleteProtection: "disabled"})).
+		WithStatusSubresource(appSet).
+		WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).
+		Build()
+	r := ApplicationSetReconciler{
+		Client:        fakeClient,
+		Scheme:        scheme,
+		Recorder:      record.NewFakeRecorder(10),
+		KubeClientset: kubefake.NewSimpleClientset(),
+		Metrics:       appsetmetrics.NewFakeAppsetMetrics(),
+	}
+	getDeletionProtectedCondition := func() *v1alpha1.ApplicationSetCondition {
+		updated := &v1alpha1.ApplicationSet{}
+		require.NoError(t, fakeClient.Get(t.Context(), crtclient.ObjectKeyFromObject(appSet), updated))
+		updated.DeepCopyInto(appSet)
+		for _, condition := range updated.Status.Conditions {
+			if condition.Type == v1alpha1.ApplicationSetConditionDeletionProtected {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	err := r.deleteInCluster(t.Context(), log.NewEntry(log.StandardLogger()), *appSet, []v1alpha1.Application{*newApp("keep", nil)})
+	require.NoError(t, err)
+
+	var apps v1alpha1.ApplicationList
+	require.NoError(t, fakeClient.List(t.Context(), &apps))
+	var names []string
+	for _, app := range apps.Items {
+		names = append(names, app.Name)
+	}
+	assert.ElementsMatch(t, []string{"keep", "protected"}, names)
+
+	condition := getDeletionProtectedCondition()
+	require.NotNil(t, condition)
+	assert.Equal(t, v1alpha1.ApplicationSetConditionStatusTrue, condition.Status)
+	assert.Equal(t, v1alpha1.ApplicationSetReasonApplicationDeletionProtected, condition.Reason)
+	assert.Equal(t, "Skipped the deletion of Applications which are no longer generated, but are protected from deletion: protected", condition.Message)
+	events := r.Recorder.(*record.FakeRecorder).Events
+	var recorded []string
+	for len(events) > 0 {
+		recorded = append(recorded, <-events)
+	}
+	assert.Contains(t, recorded, `Warning DeletionProtected Skipped the deletion of protected Application "protected"`)
+
+	// the condition is cleared once the protected application is generated again
+	err = r.deleteInCluster(t.Context(), log.NewEntry(log.StandardLogger()), *appSet, []v1alpha1.Application{*newApp("keep", nil), *newApp("protected", protected)})
+	require.NoError(t, err)
+
+	condition = getDeletionProtectedCondition()
+	require.NotNil(t, condition)
+	assert.Equal(t, v1alpha1.ApplicationSetConditionStatusFalse, condition.Status)
+}
+
 func TestGetMinRequeueAfter(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -1933,7 +2166,7 @@ func TestGetMinRequeueAfter(t *testing.T) {
 		},
 	}
 
-	got := r.getMinRequeueAfter(&v1alpha1.ApplicationSet{
+	got := r.getMinRequeueAfter(log.NewEntry(log.StandardLogger()), &v1alpha1.ApplicationSet{
 		Spec: v1alpha1.ApplicationSetSpec{
 			Generators: []v1alpha1.ApplicationSetGenerator{generator},
 		},
@@ -1969,7 +2202,7 @@ func TestRequeueGeneratorFails(t *testing.T) {
 	generatorMock := &mocks.Generator{}
 	generatorMock.EXPECT().GetTemplate(&generator).
 		Return(&v1alpha1.ApplicationSetTemplate{})
-	generatorMock.EXPECT().GenerateParams(&generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+	generatorMock.EXPECT().GenerateParams(mock.Anything, &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
 		Return([]map[string]any{}, errors.New("Simulated error generating params that could be related to an external service/API call"))
 
 	metrics := appsetmetrics.NewFakeAppsetMetrics()
@@ -1996,6 +2229,78 @@ func TestRequeueGeneratorFails(t *testing.T) {
 	assert.Equal(t, ReconcileRequeueOnValidationError, res.RequeueAfter)
 }
 
+func TestRequeueGeneratorFailsWithBackoff(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				PullRequest: &v1alpha1.PullRequestGenerator{},
+				RequeueStrategy: &v1alpha1.ApplicationSetGeneratorRequeueStrategy{
+					Backoff: &v1alpha1.Backoff{Duration: "1m"},
+				},
+			}},
+		},
+		Status: v1alpha1.ApplicationSetStatus{
+			GeneratorFailures: []v1alpha1.ApplicationSetGeneratorFailures{{Generator: 0, Count: 2}},
+		},
+	}
+	crtClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithStatusSubresource(&appSet).
+		WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+
+	generatorErr := errors.New("Simulated error generating params that could be related to an external service/API call")
+	generatorMock := &mocks.Generator{}
+	generatorMock.EXPECT().GetTemplate(mock.Anything).
+		Return(&v1alpha1.ApplicationSetTemplate{})
+	generatorMock.EXPECT().GenerateParams(mock.Anything, mock.Anything, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+		Return([]map[string]any{}, generatorErr).Once()
+	generatorMock.EXPECT().GenerateParams(mock.Anything, mock.Anything, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+		Return([]map[string]any{}, nil)
+	generatorMock.EXPECT().GetRequeueAfter(mock.Anything).Return(generators.NoRequeueAfter)
+
+	r := ApplicationSetReconciler{
+		Client:   crtClient,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+		Generators: map[string]generators.Generator{
+			"PullRequest": generatorMock,
+		},
+		Metrics:       appsetmetrics.NewFakeAppsetMetrics(),
+		KubeClientset: kubefake.NewClientset(),
+	}
+
+	req := ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "argocd",
+			Name:      "name",
+		},
+	}
+
+	// the third consecutive failure is requeued after 1m * 2^2
+	res, err := r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, 4*time.Minute, res.RequeueAfter)
+
+	var retrievedAppSet v1alpha1.ApplicationSet
+	err = crtClient.Get(t.Context(), req.NamespacedName, &retrievedAppSet)
+	require.NoError(t, err)
+	require.Len(t, retrievedAppSet.Status.GeneratorFailures, 1)
+	assert.Equal(t, int64(3), retrievedAppSet.Status.GeneratorFailures[0].Count)
+
+	// the failures are reset once the generator succeeds
+	_, err = r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+	err = crtClient.Get(t.Context(), req.NamespacedName, &retrievedAppSet)
+	require.NoError(t, err)
+	assert.Empty(t, retrievedAppSet.Status.GeneratorFailures)
+}
+
 func TestValidateGeneratedApplications(t *testing.T) {
 	t.Parallel()
 
@@ -2148,6 +2453,69 @@ func TestValidateGeneratedApplications(t *testing.T) {
 			},
 			validationErrors: map[string]error{"app": errors.New("application destination spec is invalid: there are no clusters with this name: nonexistent-cluster")},
 		},
+		{
+			name: "name must be a valid DNS subdomain",
+			apps: []v1alpha1.Application{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "My_App",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "default",
+						Source: &v1alpha1.ApplicationSource{
+							RepoURL:        "https://url",
+							Path:           "/",
+							TargetRevision: "HEAD",
+						},
+						Destination: v1alpha1.ApplicationDestination{
+							Namespace: "namespace",
+							Name:      "my-cluster",
+						},
+					},
+				},
+			},
+			validationErrors: map[string]error{"My_App": errors.New(`application name "My_App" is invalid: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*') (use dns1123 or hashSuffix to sanitize and shorten generated names)`)},
+		},
+		{
+			name: "names must be unique",
+			apps: []v1alpha1.Application{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "app",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "default",
+						Source: &v1alpha1.ApplicationSource{
+							RepoURL:        "https://url",
+							Path:           "/",
+							TargetRevision: "HEAD",
+						},
+						Destination: v1alpha1.ApplicationDestination{
+							Namespace: "namespace",
+							Name:      "my-cluster",
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "app",
+					},
+					Spec: v1alpha1.ApplicationSpec{
+						Project: "default",
+						Source: &v1alpha1.ApplicationSource{
+							RepoURL:        "https://url",
+							Path:           "/",
+							TargetRevision: "HEAD",
+						},
+						Destination: v1alpha1.ApplicationDestination{
+							Namespace: "namespace",
+							Name:      "my-cluster",
+						},
+					},
+				},
+			},
+			validationErrors: map[string]error{"app": errors.New("ApplicationSet  contains applications with duplicate name: app (if the names are truncated, use hashSuffix to keep them unique)")},
+		},
 	} {
 		t.Run(cc.name, func(t *testing.T) {
 			t.Parallel()
@@ -2189,6 +2557,195 @@ func TestValidateGeneratedApplications(t *testing.T) {
 	}
 }
 
+func TestValidateExistingApplications(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	project := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-secret",
+			Namespace: "argocd",
+			Labels:    map[string]string{argocommon.LabelKeySecretType: argocommon.LabelValueSecretTypeCluster},
+		},
+		Data: map[string][]byte{
+			"name":   []byte("my-cluster"),
+			"server": []byte("https://my-cluster.example.com"),
+			"config": []byte("{\"username\":\"foo\",\"password\":\"foo\"}"),
+		},
+	}
+	newApp := func(name string, destination v1alpha1.ApplicationDestination, owners ...metav1.OwnerReference) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd", OwnerReferences: owners},
+			Spec:       v1alpha1.ApplicationSpec{Project: "default", Destination: destination},
+		}
+	}
+	controller := func(kind, name, uid string) metav1.OwnerReference {
+		return metav1.OwnerReference{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: kind, Name: name, UID: types.UID(uid), Controller: ptr.To(true)}
+	}
+	destination := v1alpha1.ApplicationDestination{Server: "https://my-cluster.example.com", Namespace: "guestbook"}
+
+	existing := []crtclient.Object{
+		project,
+		newApp("owned", destination, controller("ApplicationSet", "guestbook", "appset-uid")),
+		newApp("other", destination, controller("ApplicationSet", "other", "other-uid")),
+		newApp("orphan", v1alpha1.ApplicationDestination{Name: "my-cluster", Namespace: "guestbook"}),
+		newApp("elsewhere", v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"}),
+	}
+	desired := []v1alpha1.Application{
+		*newApp("new", destination),
+		*newApp("owned", destination),
+		*newApp("other", destination),
+		*newApp("orphan", destination),
+		*newApp("elsewhere", destination),
+	}
+
+	kubeclientset := getDefaultTestClientSet(secret)
+	r := ApplicationSetReconciler{
+		Client:          fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing...).Build(),
+		Scheme:          scheme,
+		ArgoDB:          db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset),
+		ArgoCDNamespace: "argocd",
+		KubeClientset:   kubeclientset,
+	}
+	appSet := v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd", UID: "appset-uid"}}
+
+	validationErrors, err := r.validateGeneratedApplications(t.Context(), desired, appSet)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]error{
+		"argocd/other":     errors.New("application argocd/other already exists and is managed by ApplicationSet other"),
+		"argocd/orphan":    errors.New("application argocd/orphan already exists and is not managed by the ApplicationSet (enable syncPolicy.adoptExistingApplications to adopt it)"),
+		"argocd/elsewhere": errors.New("application argocd/elsewhere already exists and is not managed by the ApplicationSet (enable syncPolicy.adoptExistingApplications to adopt it)"),
+	}, validationErrors)
+
+	// the orphaned application has the same destination, referenced by the name of the cluster
+	appSet.Spec.SyncPolicy = &v1alpha1.ApplicationSetSyncPolicy{AdoptExistingApplications: true}
+	validationErrors, err = r.validateGeneratedApplications(t.Context(), desired, appSet)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]error{
+		"argocd/other":     errors.New("application argocd/other already exists and is managed by ApplicationSet other"),
+		"argocd/elsewhere": errors.New(`application argocd/elsewhere already exists and cannot be adopted, its destination (https://kubernetes.default.svc, namespace "guestbook") differs from the generated destination (https://my-cluster.example.com, namespace "guestbook")`),
+	}, validationErrors)
+}
+
+func TestCreateOrUpdateInClusterAdoption(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd", UID: "appset-uid"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			SyncPolicy: &v1alpha1.ApplicationSetSyncPolicy{AdoptExistingApplications: true},
+		},
+	}
+	orphan := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     "default",
+			Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/example/guestbook.git", Path: "guestbook", TargetRevision: "v1"},
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"},
+		},
+	}
+	desired := orphan.DeepCopy()
+	desired.Spec.Source.TargetRevision = "HEAD"
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet, orphan).WithStatusSubresource(appSet).Build()
+	r := ApplicationSetReconciler{
+		Client:   fakeClient,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+		Metrics:  appsetmetrics.NewFakeAppsetMetrics(),
+	}
+
+	err := r.createOrUpdateInCluster(t.Context(), log.NewEntry(log.StandardLogger()), *appSet, []v1alpha1.Application{*desired})
+	require.NoError(t, err)
+
+	adopted := &v1alpha1.Application{}
+	require.NoError(t, fakeClient.Get(t.Context(), crtclient.ObjectKeyFromObject(orphan), adopted))
+	assert.True(t, metav1.IsControlledBy(adopted, appSet))
+	assert.Equal(t, "HEAD", adopted.Spec.Source.TargetRevision)
+
+	updatedAppSet := &v1alpha1.ApplicationSet{}
+	require.NoError(t, fakeClient.Get(t.Context(), crtclient.ObjectKeyFromObject(appSet), updatedAppSet))
+	var adoptedCondition *v1alpha1.ApplicationSetCondition
+	for i := range updatedAppSet.Status.Conditions {
+		if updatedAppSet.Status.Conditions[i].Type == v1alpha1.ApplicationSetConditionApplicationsAdopted {
+			adoptedCondition = &updatedAppSet.Status.Conditions[i]
+		}
+	}
+	require.NotNil(t, adoptedCondition)
+	assert.Equal(t, "Adopted existing Applications: orphan (changed spec.source.targetRevision)", adoptedCondition.Message)
+
+	// the adopted application is not adopted again
+	err = r.createOrUpdateInCluster(t.Context(), log.NewEntry(log.StandardLogger()), *updatedAppSet, []v1alpha1.Application{*desired})
+	require.NoError(t, err)
+	events := r.Recorder.(*record.FakeRecorder).Events
+	assert.Len(t, events, 2)
+	assert.Equal(t, "Normal Adopted Adopted existing Application orphan (changed spec.source.targetRevision)", <-events)
+}
+
+func TestValidateUniqueAnnotations(t *testing.T) {
+	t.Parallel()
+
+	const hostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "previews", Namespace: "argocd", UID: "appset-uid"},
+		Spec:       v1alpha1.ApplicationSetSpec{UniqueAnnotations: []string{hostnameAnnotation}},
+	}
+	newApp := func(name, hostnames string) v1alpha1.Application {
+		return v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{hostnameAnnotation: hostnames},
+		}}
+	}
+	ownedApp := newApp("pr-1", "pr-1.example.com")
+	ownedApp.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: v1alpha1.SchemeGroupVersion.String(),
+		Kind:       "ApplicationSet",
+		Name:       appSet.Name,
+		UID:        appSet.UID,
+		Controller: ptr.To(true),
+	}}
+
+	for _, cc := range []struct {
+		name             string
+		desired          []v1alpha1.Application
+		existing         []v1alpha1.Application
+		validationErrors map[string]error
+	}{
+		{
+			name:             "unique hostnames",
+			desired:          []v1alpha1.Application{newApp("pr-1", "pr-1.example.com"), newApp("pr-2", "pr-2.example.com, api.pr-2.example.com")},
+			existing:         []v1alpha1.Application{ownedApp, newApp("prod", "example.com")},
+			validationErrors: map[string]error{},
+		},
+		{
+			name:     "duplicate hostname of generated applications",
+			desired:  []v1alpha1.Application{newApp("pr-1", "pr-1.example.com"), newApp("pr-2", "pr-2.example.com"), newApp("pr-3", "api.example.com,PR-2.example.com")},
+			existing: []v1alpha1.Application{ownedApp},
+			validationErrors: map[string]error{
+				"pr-2": errors.New(`application pr-2 has value "pr-2.example.com" of annotation external-dns.alpha.kubernetes.io/hostname which must be unique, but is also used by applications: pr-3`),
+				"pr-3": errors.New(`application pr-3 has value "pr-2.example.com" of annotation external-dns.alpha.kubernetes.io/hostname which must be unique, but is also used by applications: pr-2`),
+			},
+		},
+		{
+			name:     "hostname of an existing application",
+			desired:  []v1alpha1.Application{newApp("pr-1", "pr-1.example.com"), newApp("pr-2", "example.com")},
+			existing: []v1alpha1.Application{ownedApp, newApp("prod", "example.com")},
+			validationErrors: map[string]error{
+				"pr-2": errors.New(`application pr-2 has value "example.com" of annotation external-dns.alpha.kubernetes.io/hostname which must be unique, but is also used by applications: prod`),
+			},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, cc.validationErrors, validateUniqueAnnotations(appSet, cc.desired, cc.existing))
+		})
+	}
+}
+
 func TestReconcilerValidationProjectErrorBehaviour(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -2854,70 +3411,180 @@ func applicationsUpdateSyncPolicyTest(t *testing.T, applicationsSyncPolicy v1alp
 	retrievedApplicationSet.Spec.Template.Annotations = map[string]string{"annotation-key": "annotation-value"}
 	retrievedApplicationSet.Spec.Template.Labels = map[string]string{"label-key": "label-value"}
 
-	retrievedApplicationSet.Spec.Template.Spec.Source.Helm = &v1alpha1.ApplicationSourceHelm{
-		Values: "global.test: test",
+	retrievedApplicationSet.Spec.Template.Spec.Source.Helm = &v1alpha1.ApplicationSourceHelm{
+		Values: "global.test: test",
+	}
+
+	err = r.Update(t.Context(), &retrievedApplicationSet)
+	require.NoError(t, err)
+
+	resUpdate, err := r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+
+	err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "good-cluster"}, &app)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), resUpdate.RequeueAfter)
+	assert.Equal(t, "good-cluster", app.Name)
+
+	return app
+}
+
+func TestUpdateNotPerformedWithSyncPolicyCreateOnly(t *testing.T) {
+	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicyCreateOnly
+
+	app := applicationsUpdateSyncPolicyTest(t, applicationsSyncPolicy, 1, true)
+
+	assert.Nil(t, app.Spec.Source.Helm)
+	assert.Nil(t, app.Annotations)
+}
+
+func TestUpdateNotPerformedWithSyncPolicyCreateDelete(t *testing.T) {
+	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicyCreateDelete
+
+	app := applicationsUpdateSyncPolicyTest(t, applicationsSyncPolicy, 1, true)
+
+	assert.Nil(t, app.Spec.Source.Helm)
+	assert.Nil(t, app.Annotations)
+}
+
+func TestUpdatePerformedWithSyncPolicyCreateUpdate(t *testing.T) {
+	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicyCreateUpdate
+
+	app := applicationsUpdateSyncPolicyTest(t, applicationsSyncPolicy, 2, true)
+
+	assert.Equal(t, "global.test: test", app.Spec.Source.Helm.Values)
+	assert.Equal(t, map[string]string{"annotation-key": "annotation-value"}, app.Annotations)
+	assert.Equal(t, map[string]string{"label-key": "label-value"}, app.Labels)
+}
+
+func TestUpdatePerformedWithSyncPolicySync(t *testing.T) {
+	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicySync
+
+	app := applicationsUpdateSyncPolicyTest(t, applicationsSyncPolicy, 2, true)
+
+	assert.Equal(t, "global.test: test", app.Spec.Source.Helm.Values)
+	assert.Equal(t, map[string]string{"annotation-key": "annotation-value"}, app.Annotations)
+	assert.Equal(t, map[string]string{"label-key": "label-value"}, app.Labels)
+}
+
+func TestUpdatePerformedWithSyncPolicyCreateOnlyAndAllowPolicyOverrideFalse(t *testing.T) {
+	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicyCreateOnly
+
+	app := applicationsUpdateSyncPolicyTest(t, applicationsSyncPolicy, 2, false)
+
+	assert.Equal(t, "global.test: test", app.Spec.Source.Helm.Values)
+	assert.Equal(t, map[string]string{"annotation-key": "annotation-value"}, app.Annotations)
+	assert.Equal(t, map[string]string{"label-key": "label-value"}, app.Labels)
+}
+
+func TestStaleApplicationsWithSyncPolicyCreateOnly(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicyCreateOnly
+	defaultProject := v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}, Destinations: []v1alpha1.ApplicationDestination{{Namespace: "*", Server: "https://good-cluster"}}},
+	}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{
+				{
+					List: &v1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{{
+							Raw: []byte(`{"cluster": "good-cluster","url": "https://good-cluster"}`),
+						}},
+					},
+				},
+			},
+			SyncPolicy: &v1alpha1.ApplicationSetSyncPolicy{
+				ApplicationsSync: &applicationsSyncPolicy,
+			},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name:      "{{cluster}}",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+					Project:     "default",
+					Destination: v1alpha1.ApplicationDestination{Server: "{{url}}"},
+				},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "argocd",
+			Labels: map[string]string{
+				argocommon.LabelKeySecretType: argocommon.LabelValueSecretTypeCluster,
+			},
+		},
+		Data: map[string][]byte{
+			"name":   []byte("good-cluster"),
+			"server": []byte("https://good-cluster"),
+			"config": []byte("{\"username\":\"foo\",\"password\":\"foo\"}"),
+		},
+	}
+	kubeclientset := getDefaultTestClientSet(secret)
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, &defaultProject).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Renderer: &utils.Render{},
+		Recorder: record.NewFakeRecorder(10),
+		Generators: map[string]generators.Generator{
+			"List": generators.NewListGenerator(),
+		},
+		ArgoDB:               db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset),
+		ArgoCDNamespace:      "argocd",
+		KubeClientset:        kubeclientset,
+		Policy:               v1alpha1.ApplicationsSyncPolicySync,
+		EnablePolicyOverride: true,
+		Metrics:              appsetmetrics.NewFakeAppsetMetrics(),
 	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}}
+	appKey := crtclient.ObjectKey{Namespace: "argocd", Name: "good-cluster"}
 
-	err = r.Update(t.Context(), &retrievedApplicationSet)
+	_, err = r.Reconcile(t.Context(), req)
 	require.NoError(t, err)
 
-	resUpdate, err := r.Reconcile(t.Context(), req)
-	require.NoError(t, err)
+	var retrievedApplicationSet v1alpha1.ApplicationSet
+	require.NoError(t, r.Get(t.Context(), req.NamespacedName, &retrievedApplicationSet))
+	assert.Empty(t, retrievedApplicationSet.Status.StaleApplications)
+	retrievedApplicationSet.Spec.Template.Spec.Source.Path = "helm-guestbook"
+	require.NoError(t, r.Update(t.Context(), &retrievedApplicationSet))
 
-	err = r.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "good-cluster"}, &app)
+	// the application is not updated because of the policy, but is reported as stale
+	_, err = r.Reconcile(t.Context(), req)
 	require.NoError(t, err)
-	assert.Equal(t, time.Duration(0), resUpdate.RequeueAfter)
-	assert.Equal(t, "good-cluster", app.Name)
-
-	return app
-}
-
-func TestUpdateNotPerformedWithSyncPolicyCreateOnly(t *testing.T) {
-	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicyCreateOnly
-
-	app := applicationsUpdateSyncPolicyTest(t, applicationsSyncPolicy, 1, true)
-
-	assert.Nil(t, app.Spec.Source.Helm)
-	assert.Nil(t, app.Annotations)
-}
-
-func TestUpdateNotPerformedWithSyncPolicyCreateDelete(t *testing.T) {
-	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicyCreateDelete
-
-	app := applicationsUpdateSyncPolicyTest(t, applicationsSyncPolicy, 1, true)
-
-	assert.Nil(t, app.Spec.Source.Helm)
-	assert.Nil(t, app.Annotations)
-}
-
-func TestUpdatePerformedWithSyncPolicyCreateUpdate(t *testing.T) {
-	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicyCreateUpdate
-
-	app := applicationsUpdateSyncPolicyTest(t, applicationsSyncPolicy, 2, true)
-
-	assert.Equal(t, "global.test: test", app.Spec.Source.Helm.Values)
-	assert.Equal(t, map[string]string{"annotation-key": "annotation-value"}, app.Annotations)
-	assert.Equal(t, map[string]string{"label-key": "label-value"}, app.Labels)
-}
-
-func TestUpdatePerformedWithSyncPolicySync(t *testing.T) {
-	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicySync
-
-	app := applicationsUpdateSyncPolicyTest(t, applicationsSyncPolicy, 2, true)
-
-	assert.Equal(t, "global.test: test", app.Spec.Source.Helm.Values)
-	assert.Equal(t, map[string]string{"annotation-key": "annotation-value"}, app.Annotations)
-	assert.Equal(t, map[string]string{"label-key": "label-value"}, app.Labels)
-}
+	require.NoError(t, r.Get(t.Context(), req.NamespacedName, &retrievedApplicationSet))
+	assert.Equal(t, []string{"good-cluster"}, retrievedApplicationSet.Status.StaleApplications)
+	assert.Equal(t, int64(1), retrievedApplicationSet.Status.StaleApplicationsCount)
 
-func TestUpdatePerformedWithSyncPolicyCreateOnlyAndAllowPolicyOverrideFalse(t *testing.T) {
-	applicationsSyncPolicy := v1alpha1.ApplicationsSyncPolicyCreateOnly
+	var app v1alpha1.Application
+	require.NoError(t, r.Get(t.Context(), appKey, &app))
+	assert.Equal(t, "guestbook", app.Spec.Source.Path)
 
-	app := applicationsUpdateSyncPolicyTest(t, applicationsSyncPolicy, 2, false)
+	// requesting to reapply the template updates the application regardless of the policy
+	app.Annotations = map[string]string{argocommon.AnnotationApplicationSetReapplyTemplate: "true"}
+	require.NoError(t, r.Update(t.Context(), &app))
+	_, err = r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
 
-	assert.Equal(t, "global.test: test", app.Spec.Source.Helm.Values)
-	assert.Equal(t, map[string]string{"annotation-key": "annotation-value"}, app.Annotations)
-	assert.Equal(t, map[string]string{"label-key": "label-value"}, app.Labels)
+	require.NoError(t, r.Get(t.Context(), appKey, &app))
+	assert.Equal(t, "helm-guestbook", app.Spec.Source.Path)
+	assert.NotContains(t, app.Annotations, argocommon.AnnotationApplicationSetReapplyTemplate)
+	require.NoError(t, r.Get(t.Context(), req.NamespacedName, &retrievedApplicationSet))
+	assert.Empty(t, retrievedApplicationSet.Status.StaleApplications)
+	assert.Zero(t, retrievedApplicationSet.Status.StaleApplicationsCount)
 }
 
 func applicationsDeleteSyncPolicyTest(t *testing.T, applicationsSyncPolicy v1alpha1.ApplicationsSyncPolicy, recordBuffer int, allowPolicyOverride bool) v1alpha1.ApplicationList {
@@ -3381,7 +4048,7 @@ func TestSetApplicationSetApplicationStatus(t *testing.T) {
 				Client:   client,
 				Scheme:   scheme,
 				Renderer: &utils.Render{},
-				Recorder: record.NewFakeRecorder(1),
+				Recorder: record.NewFakeRecorder(100),
 				Generators: map[string]generators.Generator{
 					"List": generators.NewListGenerator(),
 				},
@@ -3398,6 +4065,48 @@ func TestSetApplicationSetApplicationStatus(t *testing.T) {
 	}
 }
 
+func TestSetApplicationSetApplicationStatusRecordsTransitions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+		Status: v1alpha1.ApplicationSetStatus{
+			ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncWaiting, Step: "1"},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncProgressing, Step: "1"},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncHealthy, Step: "2"},
+			},
+		},
+	}
+	crtClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithStatusSubresource(&appSet).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := ApplicationSetReconciler{
+		Client:   crtClient,
+		Scheme:   scheme,
+		Recorder: recorder,
+	}
+
+	err = r.setAppSetApplicationStatus(t.Context(), log.NewEntry(log.StandardLogger()), &appSet, []v1alpha1.ApplicationSetApplicationStatus{
+		{Application: "app1", Status: v1alpha1.ProgressiveSyncPending, Step: "1", Message: "Application moved to Pending status."},
+		{Application: "app2", Status: v1alpha1.ProgressiveSyncFailed, Step: "1", Message: "Application timed out."},
+		{Application: "app3", Status: v1alpha1.ProgressiveSyncHealthy, Step: "2"},
+		{Application: "app4", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+	})
+	require.NoError(t, err)
+
+	close(recorder.Events)
+	var events []string
+	for event := range recorder.Events {
+		events = append(events, event)
+	}
+	assert.Equal(t, []string{
+		`Normal RollingSyncPending Application "app1" changed from Waiting to Pending in step 1: Application moved to Pending status.`,
+		`Warning RollingSyncFailed Application "app2" changed from Progressing to Failed in step 1: Application timed out.`,
+	}, events)
+}
+
 func TestBuildAppDependencyList(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -4131,6 +4840,74 @@ func TestBuildAppDependencyList(t *testing.T) {
 				"app-qa2": 0,
 			},
 		},
+		{
+			name: "handles templated values resolved for each application",
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Strategy: &v1alpha1.ApplicationSetStrategy{
+						Type: "RollingSync",
+						RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+							Steps: []v1alpha1.ApplicationSetRolloutStep{
+								{
+									MatchExpressions: []v1alpha1.ApplicationMatchExpression{
+										{
+											Key:      "region",
+											Operator: "In",
+											Values:   []string{"{{ .primaryRegion }}"},
+										},
+									},
+								},
+								{
+									MatchExpressions: []v1alpha1.ApplicationMatchExpression{
+										{
+											Key:      "region",
+											Operator: "NotIn",
+											Values:   []string{"{{ .primaryRegion }}"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			apps: []v1alpha1.Application{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "app-us-east",
+						Labels:      map[string]string{"region": "us-east"},
+						Annotations: map[string]string{argocommon.AnnotationApplicationSetRollingSyncMatchValues: `[[["us-east"]],[["us-east"]]]`},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "app-us-west",
+						Labels:      map[string]string{"region": "us-west"},
+						Annotations: map[string]string{argocommon.AnnotationApplicationSetRollingSyncMatchValues: `[[["us-east"]],[["us-east"]]]`},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "app-eu-west",
+						Labels:      map[string]string{"region": "eu-west"},
+						Annotations: map[string]string{argocommon.AnnotationApplicationSetRollingSyncMatchValues: `[[["eu-west"]],[["eu-west"]]]`},
+					},
+				},
+			},
+			expectedList: [][]string{
+				{"app-us-east", "app-eu-west"},
+				{"app-us-west"},
+			},
+			expectedStepMap: map[string]int{
+				"app-us-east": 0,
+				"app-eu-west": 0,
+				"app-us-west": 1,
+			},
+		},
 	} {
 		t.Run(cc.name, func(t *testing.T) {
 			kubeclientset := kubefake.NewSimpleClientset([]runtime.Object{}...)
@@ -4565,27 +5342,264 @@ func TestGetAppsToSync(t *testing.T) {
 				"app1": true,
 				"app2": true,
 			},
-		},
-	} {
-		t.Run(cc.name, func(t *testing.T) {
-			kubeclientset := kubefake.NewSimpleClientset([]runtime.Object{}...)
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			kubeclientset := kubefake.NewSimpleClientset([]runtime.Object{}...)
+
+			argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+			r := ApplicationSetReconciler{
+				Client:        client,
+				Scheme:        scheme,
+				Recorder:      record.NewFakeRecorder(1),
+				Generators:    map[string]generators.Generator{},
+				ArgoDB:        argodb,
+				KubeClientset: kubeclientset,
+				Metrics:       metrics,
+			}
+
+			appsToSync := r.getAppsToSync(cc.appSet, cc.appDependencyList, cc.currentApps)
+			assert.Equal(t, cc.expectedMap, appsToSync, "expected map did not match actual")
+		})
+	}
+}
+
+func TestGetAppsToSyncSuspendedApps(t *testing.T) {
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Status: v1alpha1.ApplicationSetStatus{
+			ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting},
+			},
+		},
+	}
+	currentApps := []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app3"}},
+	}
+	desiredApps := []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2", Annotations: map[string]string{argocommon.AnnotationApplicationSetSuspend: "true"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app3"}},
+	}
+	appDependencyList := [][]string{{"app1"}, {"app2"}, {"app3"}}
+
+	r := ApplicationSetReconciler{}
+	appsToSync := r.getAppsToSync(appSet, withoutSuspendedApps(appDependencyList, desiredApps), currentApps)
+	// the suspended app is neither promoted nor holds back the following step
+	assert.Equal(t, map[string]bool{"app1": true, "app3": true}, appsToSync)
+	assert.Equal(t, [][]string{{"app1"}, {"app2"}, {"app3"}}, appDependencyList)
+}
+
+func TestBuildCanaryAppDependencyList(t *testing.T) {
+	apps := []v1alpha1.Application{}
+	for _, name := range []string{"app-c", "app-a", "app-e", "app-b", "app-d"} {
+		apps = append(apps, v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+	newAppSet := func(weight *intstr.IntOrString) v1alpha1.ApplicationSet {
+		return v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type:   "Canary",
+					Canary: &v1alpha1.ApplicationSetCanaryStrategy{Weight: weight},
+				},
+			},
+		}
+	}
+
+	for _, cc := range []struct {
+		name         string
+		weight       *intstr.IntOrString
+		expectedList [][]string
+	}{
+		{
+			name:         "defaults to 10% rounded up",
+			weight:       nil,
+			expectedList: [][]string{{"app-a"}, {"app-b", "app-c", "app-d", "app-e"}},
+		},
+		{
+			name:         "percentage",
+			weight:       ptr.To(intstr.FromString("40%")),
+			expectedList: [][]string{{"app-a", "app-b"}, {"app-c", "app-d", "app-e"}},
+		},
+		{
+			name:         "number",
+			weight:       ptr.To(intstr.FromInt32(3)),
+			expectedList: [][]string{{"app-a", "app-b", "app-c"}, {"app-d", "app-e"}},
+		},
+		{
+			name:         "number larger than the Applications",
+			weight:       ptr.To(intstr.FromInt32(10)),
+			expectedList: [][]string{{"app-a", "app-b", "app-c", "app-d", "app-e"}, {}},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			r := ApplicationSetReconciler{}
+			appDependencyList, appStepMap := r.buildAppDependencyList(log.NewEntry(log.StandardLogger()), newAppSet(cc.weight), apps)
+			assert.Equal(t, cc.expectedList, appDependencyList)
+			assert.Len(t, appStepMap, len(apps))
+			for step, appNames := range cc.expectedList {
+				for _, appName := range appNames {
+					assert.Equal(t, step, appStepMap[appName])
+				}
+			}
+		})
+	}
+}
+
+func TestGetAppsToSyncCanarySoak(t *testing.T) {
+	healthySince := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+	newAppSet := func(soakDuration string, canaryStatus v1alpha1.ProgressiveSyncStatusCode) v1alpha1.ApplicationSet {
+		return v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type:   "Canary",
+					Canary: &v1alpha1.ApplicationSetCanaryStrategy{SoakDuration: soakDuration},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{
+				ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+					{Application: "app1", Status: canaryStatus, Step: "1", LastTransitionTime: &healthySince},
+					{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+				},
+			},
+		}
+	}
+	currentApps := []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2"}},
+	}
+	appDependencyList := [][]string{{"app1"}, {"app2"}}
+	r := ApplicationSetReconciler{}
+
+	t.Run("canary not healthy", func(t *testing.T) {
+		appSet := newAppSet("", v1alpha1.ProgressiveSyncProgressing)
+		assert.Equal(t, map[string]bool{"app1": true}, r.getAppsToSync(appSet, appDependencyList, currentApps))
+		assert.Zero(t, getCanarySoakRequeueAfter(&appSet, time.Now()))
+	})
+
+	t.Run("canary soaking", func(t *testing.T) {
+		appSet := newAppSet("10m", v1alpha1.ProgressiveSyncHealthy)
+		assert.Equal(t, map[string]bool{"app1": true}, r.getAppsToSync(appSet, appDependencyList, currentApps))
+		assert.InDelta(t, 5*time.Minute, getCanarySoakRequeueAfter(&appSet, time.Now()), float64(time.Second))
+	})
+
+	t.Run("canary soaked", func(t *testing.T) {
+		appSet := newAppSet("1m", v1alpha1.ProgressiveSyncHealthy)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": true}, r.getAppsToSync(appSet, appDependencyList, currentApps))
+		assert.Zero(t, getCanarySoakRequeueAfter(&appSet, time.Now()))
+	})
+}
+
+func TestResumeRolloutStep(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+					Steps: []v1alpha1.ApplicationSetRolloutStep{{PauseAfter: true}, {}},
+				},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{
+			ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet).WithStatusSubresource(appSet).Build()
+	r := ApplicationSetReconciler{Client: client, Scheme: scheme}
+	logCtx := log.NewEntry(log.StandardLogger())
+	appDependencyList := [][]string{{"app1"}, {"app2"}}
+	currentApps := []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2"}},
+	}
+
+	// the rollout pauses after the first step
+	assert.Equal(t, map[string]bool{"app1": true}, r.getAppsToSync(*appSet, appDependencyList, currentApps))
+	assert.Equal(t, "ApplicationSet paused the rollout after step 1 until the step is resumed", getRolloutProgressingMessage(t.Context(), &r, appSet))
+
+	appSet.Annotations = map[string]string{argocommon.AnnotationApplicationSetResumeStep: "1"}
+	require.NoError(t, r.resumeRolloutStep(t.Context(), logCtx, appSet))
+	assert.True(t, appSet.Status.ApplicationStatus[0].Resumed)
+	assert.False(t, appSet.Status.ApplicationStatus[1].Resumed)
+	assert.Equal(t, map[string]bool{"app1": true, "app2": true}, r.getAppsToSync(*appSet, appDependencyList, currentApps))
+
+	updated := &v1alpha1.ApplicationSet{}
+	require.NoError(t, client.Get(t.Context(), crtclient.ObjectKeyFromObject(appSet), updated))
+	assert.NotContains(t, updated.Annotations, argocommon.AnnotationApplicationSetResumeStep)
+	assert.True(t, updated.Status.ApplicationStatus[0].Resumed)
+}
+
+func TestGetAppsToSyncFailurePolicy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newAppSet := func(failurePolicy v1alpha1.ApplicationSetRolloutFailurePolicy) *v1alpha1.ApplicationSet {
+		return &v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type: "RollingSync",
+					RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+						Steps: []v1alpha1.ApplicationSetRolloutStep{{Timeout: "10m", FailurePolicy: failurePolicy}, {}},
+					},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{
+				ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+					{Application: "app1", Status: v1alpha1.ProgressiveSyncFailed, Step: "1"},
+					{Application: "app2", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+					{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+				},
+			},
+		}
+	}
+	appDependencyList := [][]string{{"app1", "app2"}, {"app3"}}
+	currentApps := []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app3"}},
+	}
 
-			argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+	for _, failurePolicy := range []v1alpha1.ApplicationSetRolloutFailurePolicy{"", v1alpha1.ApplicationSetRolloutFailurePolicyHalt} {
+		appSet := newAppSet(failurePolicy)
+		r := ApplicationSetReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet).WithStatusSubresource(appSet).Build(), Scheme: scheme}
+		assert.Equal(t, map[string]bool{"app1": true, "app2": true}, r.getAppsToSync(*appSet, appDependencyList, currentApps))
+		assert.Equal(t, "ApplicationSet halted the rollout of step 1 because Applications timed out: app1", getRolloutProgressingMessage(t.Context(), &r, appSet))
+		assert.Equal(t, v1alpha1.ApplicationSetReasonApplicationRolloutFailed, appSet.Status.Conditions[len(appSet.Status.Conditions)-1].Reason)
+	}
 
-			r := ApplicationSetReconciler{
-				Client:        client,
-				Scheme:        scheme,
-				Recorder:      record.NewFakeRecorder(1),
-				Generators:    map[string]generators.Generator{},
-				ArgoDB:        argodb,
-				KubeClientset: kubeclientset,
-				Metrics:       metrics,
-			}
+	appSet := newAppSet(v1alpha1.ApplicationSetRolloutFailurePolicyContinue)
+	r := ApplicationSetReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet).WithStatusSubresource(appSet).Build(), Scheme: scheme}
+	assert.Equal(t, map[string]bool{"app1": true, "app2": true, "app3": true}, r.getAppsToSync(*appSet, appDependencyList, currentApps))
+	assert.Equal(t, "ApplicationSet is performing rollout of step 2", getRolloutProgressingMessage(t.Context(), &r, appSet))
 
-			appsToSync := r.getAppsToSync(cc.appSet, cc.appDependencyList, cc.currentApps)
-			assert.Equal(t, cc.expectedMap, appsToSync, "expected map did not match actual")
-		})
+	appSet.Status.ApplicationStatus[2].Status = v1alpha1.ProgressiveSyncHealthy
+	assert.Equal(t, "ApplicationSet Rollout has completed, but Applications timed out: app1", getRolloutProgressingMessage(t.Context(), &r, appSet))
+}
+
+// getRolloutProgressingMessage returns the message of the RolloutProgressing condition of the given ApplicationSet
+func getRolloutProgressingMessage(ctx context.Context, r *ApplicationSetReconciler, appSet *v1alpha1.ApplicationSet) string {
+	for _, condition := range r.updateApplicationSetApplicationStatusConditions(ctx, appSet) {
+		if condition.Type == v1alpha1.ApplicationSetConditionRolloutProgressing {
+			return condition.Message
+		}
 	}
+	return ""
 }
 
 func TestUpdateApplicationSetApplicationStatus(t *testing.T) {
@@ -4805,18 +5819,20 @@ func TestUpdateApplicationSetApplicationStatus(t *testing.T) {
 			},
 			expectedAppStatus: []v1alpha1.ApplicationSetApplicationStatus{
 				{
-					Application:     "app1",
-					Message:         "Application has pending changes, setting status to Waiting",
-					Status:          v1alpha1.ProgressiveSyncWaiting,
-					Step:            "1",
-					TargetRevisions: []string{"next"},
+					Application:                "app1",
+					Message:                    "Application has pending changes, setting status to Waiting",
+					Status:                     v1alpha1.ProgressiveSyncWaiting,
+					Step:                       "1",
+					TargetRevisions:            []string{"next"},
+					LastHealthyTargetRevisions: []string{"previous"},
 				},
 				{
-					Application:     "app2-multisource",
-					Message:         "Application has pending changes, setting status to Waiting",
-					Status:          v1alpha1.ProgressiveSyncWaiting,
-					Step:            "1",
-					TargetRevisions: []string{"next"},
+					Application:                "app2-multisource",
+					Message:                    "Application has pending changes, setting status to Waiting",
+					Status:                     v1alpha1.ProgressiveSyncWaiting,
+					Step:                       "1",
+					TargetRevisions:            []string{"next"},
+					LastHealthyTargetRevisions: []string{"previous", "removed-source"},
 				},
 			},
 		},
@@ -5265,7 +6281,7 @@ func TestUpdateApplicationSetApplicationStatus(t *testing.T) {
 			r := ApplicationSetReconciler{
 				Client:        client,
 				Scheme:        scheme,
-				Recorder:      record.NewFakeRecorder(1),
+				Recorder:      record.NewFakeRecorder(100),
 				Generators:    map[string]generators.Generator{},
 				ArgoDB:        argodb,
 				KubeClientset: kubeclientset,
@@ -5288,6 +6304,302 @@ func TestUpdateApplicationSetApplicationStatus(t *testing.T) {
 	}
 }
 
+func TestUpdateApplicationSetApplicationStatusRollback(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	nowMinus10 := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+	nowMinus1 := metav1.NewTime(time.Now().Add(-1 * time.Minute))
+
+	newAppSet := func(rollback *v1alpha1.ApplicationSetRollbackOnFailure, status []v1alpha1.ApplicationSetApplicationStatus) v1alpha1.ApplicationSet {
+		return v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type: "RollingSync",
+					RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+						Steps:             []v1alpha1.ApplicationSetRolloutStep{{}, {}},
+						RollbackOnFailure: rollback,
+					},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: status},
+		}
+	}
+	newApp := func(name string, healthStatus health.HealthStatusCode, healthTransition *metav1.Time, opState *v1alpha1.OperationState) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v1alpha1.ApplicationStatus{
+				ReconciledAt:   &metav1.Time{Time: time.Now()},
+				Health:         v1alpha1.AppHealthStatus{Status: healthStatus, LastTransitionTime: healthTransition},
+				Sync:           v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: "next"},
+				OperationState: opState,
+			},
+		}
+	}
+	progressing := func(name, step string, lastHealthy []string) v1alpha1.ApplicationSetApplicationStatus {
+		return v1alpha1.ApplicationSetApplicationStatus{
+			Application:                name,
+			Status:                     v1alpha1.ProgressiveSyncProgressing,
+			Step:                       step,
+			TargetRevisions:            []string{"next"},
+			LastHealthyTargetRevisions: lastHealthy,
+			LastTransitionTime:         &nowMinus10,
+		}
+	}
+
+	for _, cc := range []struct {
+		name             string
+		appSet           v1alpha1.ApplicationSet
+		apps             []v1alpha1.Application
+		appStepMap       map[string]int
+		expectedStatuses []v1alpha1.ProgressiveSyncStatusCode
+	}{
+		{
+			name: "does not roll back if rollback on failure is disabled",
+			appSet: newAppSet(nil, []v1alpha1.ApplicationSetApplicationStatus{
+				progressing("app1", "1", []string{"previous"}),
+			}),
+			apps:             []v1alpha1.Application{newApp("app1", health.HealthStatusDegraded, &nowMinus10, nil)},
+			appStepMap:       map[string]int{"app1": 0},
+			expectedStatuses: []v1alpha1.ProgressiveSyncStatusCode{v1alpha1.ProgressiveSyncProgressing},
+		},
+		{
+			name: "does not roll back before the timeout",
+			appSet: newAppSet(&v1alpha1.ApplicationSetRollbackOnFailure{Timeout: "5m"}, []v1alpha1.ApplicationSetApplicationStatus{
+				progressing("app1", "1", []string{"previous"}),
+			}),
+			apps:             []v1alpha1.Application{newApp("app1", health.HealthStatusDegraded, &nowMinus1, nil)},
+			appStepMap:       map[string]int{"app1": 0},
+			expectedStatuses: []v1alpha1.ProgressiveSyncStatusCode{v1alpha1.ProgressiveSyncProgressing},
+		},
+		{
+			name: "rolls back the step of an application Degraded past the timeout",
+			appSet: newAppSet(&v1alpha1.ApplicationSetRollbackOnFailure{Timeout: "5m"}, []v1alpha1.ApplicationSetApplicationStatus{
+				progressing("app1", "1", []string{"previous"}),
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", TargetRevisions: []string{"next"}, LastHealthyTargetRevisions: []string{"previous"}, LastTransitionTime: &nowMinus10},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "1", TargetRevisions: []string{"next"}, LastTransitionTime: &nowMinus10},
+				{Application: "app4", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2", TargetRevisions: []string{"next"}, LastTransitionTime: &nowMinus10},
+			}),
+			apps: []v1alpha1.Application{
+				newApp("app1", health.HealthStatusDegraded, &nowMinus10, nil),
+				newApp("app2", health.HealthStatusHealthy, &nowMinus10, nil),
+				{ObjectMeta: metav1.ObjectMeta{Name: "app3"}, Status: v1alpha1.ApplicationStatus{Sync: v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: "next"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "app4"}, Status: v1alpha1.ApplicationStatus{Sync: v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: "next"}}},
+			},
+			appStepMap: map[string]int{"app1": 0, "app2": 0, "app3": 0, "app4": 1},
+			expectedStatuses: []v1alpha1.ProgressiveSyncStatusCode{
+				v1alpha1.ProgressiveSyncRollingBack,
+				v1alpha1.ProgressiveSyncRollingBack,
+				v1alpha1.ProgressiveSyncRolledBack,
+				v1alpha1.ProgressiveSyncWaiting,
+			},
+		},
+		{
+			name: "marks an application without healthy revisions as rolled back",
+			appSet: newAppSet(&v1alpha1.ApplicationSetRollbackOnFailure{}, []v1alpha1.ApplicationSetApplicationStatus{
+				progressing("app1", "1", nil),
+			}),
+			apps:             []v1alpha1.Application{newApp("app1", health.HealthStatusDegraded, &nowMinus10, nil)},
+			appStepMap:       map[string]int{"app1": 0},
+			expectedStatuses: []v1alpha1.ProgressiveSyncStatusCode{v1alpha1.ProgressiveSyncRolledBack},
+		},
+		{
+			name: "moves a rolling back application to rolled back once the rollback sync started",
+			appSet: newAppSet(&v1alpha1.ApplicationSetRollbackOnFailure{}, []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncRollingBack, Step: "1", TargetRevisions: []string{"next"}, LastHealthyTargetRevisions: []string{"previous"}, LastTransitionTime: &nowMinus10},
+			}),
+			apps: []v1alpha1.Application{newApp("app1", health.HealthStatusDegraded, &nowMinus10, &v1alpha1.OperationState{
+				Phase:     common.OperationRunning,
+				StartedAt: nowMinus1,
+			})},
+			appStepMap:       map[string]int{"app1": 0},
+			expectedStatuses: []v1alpha1.ProgressiveSyncStatusCode{v1alpha1.ProgressiveSyncRolledBack},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			kubeclientset := kubefake.NewSimpleClientset()
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&cc.appSet).WithStatusSubresource(&cc.appSet).Build()
+
+			r := ApplicationSetReconciler{
+				Client:        client,
+				Scheme:        scheme,
+				Recorder:      record.NewFakeRecorder(100),
+				Generators:    map[string]generators.Generator{},
+				ArgoDB:        db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset),
+				KubeClientset: kubeclientset,
+				Metrics:       appsetmetrics.NewFakeAppsetMetrics(),
+			}
+
+			appStatuses, err := r.updateApplicationSetApplicationStatus(t.Context(), log.NewEntry(log.StandardLogger()), &cc.appSet, cc.apps, cc.appStepMap)
+			require.NoError(t, err)
+
+			statuses := make([]v1alpha1.ProgressiveSyncStatusCode, 0, len(appStatuses))
+			for _, appStatus := range appStatuses {
+				statuses = append(statuses, appStatus.Status)
+			}
+			assert.Equal(t, cc.expectedStatuses, statuses)
+		})
+	}
+}
+
+func TestGetRollbackRequeueAfter(t *testing.T) {
+	now := time.Now()
+	degradedSince := metav1.NewTime(now.Add(-2 * time.Minute))
+	appSet := &v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+					Steps:             []v1alpha1.ApplicationSetRolloutStep{{}},
+					RollbackOnFailure: &v1alpha1.ApplicationSetRollbackOnFailure{Timeout: "5m"},
+				},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+			{Application: "app1", Status: v1alpha1.ProgressiveSyncProgressing, Step: "1", LastTransitionTime: &degradedSince},
+		}},
+	}
+	apps := []v1alpha1.Application{{
+		ObjectMeta: metav1.ObjectMeta{Name: "app1"},
+		Status:     v1alpha1.ApplicationStatus{Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusDegraded}},
+	}}
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	assert.Equal(t, 3*time.Minute, getRollbackRequeueAfter(logCtx, appSet, apps, now))
+
+	apps[0].Status.Health.Status = health.HealthStatusHealthy
+	assert.Zero(t, getRollbackRequeueAfter(logCtx, appSet, apps, now))
+
+	apps[0].Status.Health.Status = health.HealthStatusDegraded
+	appSet.Spec.Strategy.RollingSync.RollbackOnFailure = nil
+	assert.Zero(t, getRollbackRequeueAfter(logCtx, appSet, apps, now))
+}
+
+func TestUpdateApplicationSetApplicationStatusStepTimeout(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	nowMinus10 := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+	newApp := func(healthStatus health.HealthStatusCode) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app1"},
+			Status: v1alpha1.ApplicationStatus{
+				ReconciledAt: &nowMinus10,
+				Health:       v1alpha1.AppHealthStatus{Status: healthStatus},
+				Sync:         v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: "next"},
+			},
+		}
+	}
+
+	for _, cc := range []struct {
+		name            string
+		timeout         string
+		status          v1alpha1.ProgressiveSyncStatusCode
+		app             v1alpha1.Application
+		expectedStatus  v1alpha1.ProgressiveSyncStatusCode
+		expectedMessage string
+	}{
+		{
+			name:           "does not time out without a timeout",
+			status:         v1alpha1.ProgressiveSyncProgressing,
+			app:            newApp(health.HealthStatusProgressing),
+			expectedStatus: v1alpha1.ProgressiveSyncProgressing,
+		},
+		{
+			name:           "does not time out before the timeout",
+			timeout:        "15m",
+			status:         v1alpha1.ProgressiveSyncProgressing,
+			app:            newApp(health.HealthStatusProgressing),
+			expectedStatus: v1alpha1.ProgressiveSyncProgressing,
+		},
+		{
+			name:            "times out a progressing application",
+			timeout:         "5m",
+			status:          v1alpha1.ProgressiveSyncProgressing,
+			app:             newApp(health.HealthStatusProgressing),
+			expectedStatus:  v1alpha1.ProgressiveSyncFailed,
+			expectedMessage: "Application resource stayed Progressing for longer than the timeout of step 1 of 5m0s, updating status to Failed",
+		},
+		{
+			name:            "times out a pending application",
+			timeout:         "5m",
+			status:          v1alpha1.ProgressiveSyncPending,
+			app:             newApp(health.HealthStatusProgressing),
+			expectedStatus:  v1alpha1.ProgressiveSyncFailed,
+			expectedMessage: "Application resource stayed Pending for longer than the timeout of step 1 of 5m0s, updating status to Failed",
+		},
+		{
+			name:           "ignores an invalid timeout",
+			timeout:        "soon",
+			status:         v1alpha1.ProgressiveSyncProgressing,
+			app:            newApp(health.HealthStatusProgressing),
+			expectedStatus: v1alpha1.ProgressiveSyncProgressing,
+		},
+		{
+			name:            "moves a failed application to healthy once it is healthy",
+			timeout:         "5m",
+			status:          v1alpha1.ProgressiveSyncFailed,
+			app:             newApp(health.HealthStatusHealthy),
+			expectedStatus:  v1alpha1.ProgressiveSyncHealthy,
+			expectedMessage: "Application resource became Healthy, updating status from Failed to Healthy",
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			appSet := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Strategy: &v1alpha1.ApplicationSetStrategy{
+						Type: "RollingSync",
+						RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+							Steps: []v1alpha1.ApplicationSetRolloutStep{{Timeout: cc.timeout}},
+						},
+					},
+				},
+				Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+					{Application: "app1", Status: cc.status, Step: "1", TargetRevisions: []string{"next"}, LastTransitionTime: &nowMinus10},
+				}},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithStatusSubresource(&appSet).Build()
+			r := ApplicationSetReconciler{Client: client, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+
+			appStatuses, err := r.updateApplicationSetApplicationStatus(t.Context(), log.NewEntry(log.StandardLogger()), &appSet, []v1alpha1.Application{cc.app}, map[string]int{"app1": 0})
+			require.NoError(t, err)
+			require.Len(t, appStatuses, 1)
+			assert.Equal(t, cc.expectedStatus, appStatuses[0].Status)
+			if cc.expectedMessage != "" {
+				assert.Equal(t, cc.expectedMessage, appStatuses[0].Message)
+			}
+		})
+	}
+}
+
+func TestGetRolloutStepTimeoutRequeueAfter(t *testing.T) {
+	now := time.Now()
+	progressingSince := metav1.NewTime(now.Add(-2 * time.Minute))
+	appSet := &v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+					Steps: []v1alpha1.ApplicationSetRolloutStep{{Timeout: "5m"}, {}},
+				},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+			{Application: "app1", Status: v1alpha1.ProgressiveSyncProgressing, Step: "1", LastTransitionTime: &progressingSince},
+			{Application: "app2", Status: v1alpha1.ProgressiveSyncProgressing, Step: "2", LastTransitionTime: &progressingSince},
+		}},
+	}
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	assert.Equal(t, 3*time.Minute, getRolloutStepTimeoutRequeueAfter(logCtx, appSet, now))
+
+	appSet.Status.ApplicationStatus[0].Status = v1alpha1.ProgressiveSyncHealthy
+	assert.Zero(t, getRolloutStepTimeoutRequeueAfter(logCtx, appSet, now))
+}
+
 func TestUpdateApplicationSetApplicationStatusProgress(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -6018,7 +7330,7 @@ func TestUpdateApplicationSetApplicationStatusProgress(t *testing.T) {
 			r := ApplicationSetReconciler{
 				Client:        client,
 				Scheme:        scheme,
-				Recorder:      record.NewFakeRecorder(1),
+				Recorder:      record.NewFakeRecorder(100),
 				Generators:    map[string]generators.Generator{},
 				ArgoDB:        argodb,
 				KubeClientset: kubeclientset,
@@ -6307,6 +7619,136 @@ func TestUpdateResourceStatus(t *testing.T) {
 	}
 }
 
+func TestUpdateResourceStatusSummary(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newApp := func(name string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v1alpha1.ApplicationStatus{
+				Sync:   v1alpha1.SyncStatus{Status: syncStatus},
+				Health: v1alpha1.AppHealthStatus{Status: healthStatus},
+			},
+		}
+	}
+	apps := []v1alpha1.Application{
+		newApp("app1", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		newApp("app2", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		newApp("app3", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusDegraded),
+		newApp("app4", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusProgressing),
+		newApp("app5", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing),
+		newApp("app6", v1alpha1.SyncStatusCodeUnknown, health.HealthStatusUnknown),
+	}
+	appSet := v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"}}
+
+	r := ApplicationSetReconciler{
+		Client:                  fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&appSet).WithObjects(&appSet).Build(),
+		Scheme:                  scheme,
+		Metrics:                 appsetmetrics.NewFakeAppsetMetrics(),
+		MaxResourcesStatusCount: 1,
+	}
+
+	err := r.updateResourcesStatus(t.Context(), log.NewEntry(log.StandardLogger()), &appSet, apps)
+	require.NoError(t, err)
+
+	// the summary accounts for all the applications, even when the resources status is truncated
+	assert.Len(t, appSet.Status.Resources, 1)
+	assert.Equal(t, &v1alpha1.ApplicationSetSummary{
+		Healthy:     2,
+		Degraded:    1,
+		Progressing: 1,
+		Missing:     1,
+		OutOfSync:   3,
+	}, appSet.Status.Summary)
+}
+
+func TestNewGenerationRecord(t *testing.T) {
+	appset := &v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd", Generation: 3}}
+	apps := func(names ...string) []v1alpha1.Application {
+		var res []v1alpha1.Application
+		for _, name := range names {
+			res = append(res, v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"}})
+		}
+		return res
+	}
+	now := time.Now()
+
+	t.Run("Unchanged", func(t *testing.T) {
+		assert.Nil(t, newGenerationRecord(appset, apps("app1", "app2"), apps("app2", "app1"), 0, now))
+	})
+
+	t.Run("AddedAndRemoved", func(t *testing.T) {
+		record := newGenerationRecord(appset, apps("app1", "app3", "app4"), apps("app1", "app2"), 0, now)
+		require.NotNil(t, record)
+		assert.Equal(t, &v1alpha1.ApplicationSetGenerationRecord{
+			GeneratedAt:              metav1.NewTime(now),
+			ApplicationSetGeneration: 3,
+			ParameterSetCount:        3,
+			AddedApplications:        []string{"app3", "app4"},
+			AddedApplicationsCount:   2,
+			RemovedApplications:      []string{"app2"},
+			RemovedApplicationsCount: 1,
+		}, record)
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		record := newGenerationRecord(appset, nil, apps("app3", "app1", "app2"), 2, now)
+		require.NotNil(t, record)
+		assert.Equal(t, []string{"app1", "app2"}, record.RemovedApplications)
+		assert.Equal(t, int64(3), record.RemovedApplicationsCount)
+		assert.Nil(t, record.AddedApplications)
+		assert.Zero(t, record.ParameterSetCount)
+	})
+}
+
+func TestUpdateGenerationHistory(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd", Generation: 1}}
+	for i := 0; i < MaxGenerationHistory; i++ {
+		appSet.Status.GenerationHistory = append(appSet.Status.GenerationHistory, v1alpha1.ApplicationSetGenerationRecord{
+			ApplicationSetGeneration: 1,
+			AddedApplications:        []string{fmt.Sprintf("old-%d", i)},
+			AddedApplicationsCount:   1,
+		})
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&appSet).WithObjects(&appSet).Build()
+	r := ApplicationSetReconciler{
+		Client:  client,
+		Scheme:  scheme,
+		Metrics: appsetmetrics.NewFakeAppsetMetrics(),
+	}
+	desired := []v1alpha1.Application{{ObjectMeta: metav1.ObjectMeta{Name: "app1", Namespace: "argocd"}}}
+	current := []v1alpha1.Application{{ObjectMeta: metav1.ObjectMeta{Name: "app2", Namespace: "argocd"}}}
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	err = r.updateGenerationHistory(t.Context(), logCtx, &appSet, desired, current)
+	require.NoError(t, err)
+	history := appSet.Status.GenerationHistory
+	require.Len(t, history, MaxGenerationHistory)
+	assert.Equal(t, []string{"app1"}, history[0].AddedApplications)
+	assert.Equal(t, []string{"app2"}, history[0].RemovedApplications)
+	assert.Equal(t, "old-0", history[1].AddedApplications[0])
+	assert.Equal(t, fmt.Sprintf("old-%d", MaxGenerationHistory-2), history[MaxGenerationHistory-1].AddedApplications[0])
+
+	// once the application is created, only the removal is left, which is recorded once, e.g. if the policy does not
+	// allow to delete the removed application
+	for i := 0; i < 2; i++ {
+		err = r.updateGenerationHistory(t.Context(), logCtx, &appSet, desired, append(current, desired...))
+		require.NoError(t, err)
+	}
+	assert.Nil(t, appSet.Status.GenerationHistory[0].AddedApplications)
+	assert.Equal(t, []string{"app2"}, appSet.Status.GenerationHistory[0].RemovedApplications)
+	assert.Equal(t, []string{"app1"}, appSet.Status.GenerationHistory[1].AddedApplications)
+
+	var retrieved v1alpha1.ApplicationSet
+	require.NoError(t, client.Get(t.Context(), crtclient.ObjectKeyFromObject(&appSet), &retrieved))
+	assert.Equal(t, appSet.Status.GenerationHistory, retrieved.Status.GenerationHistory)
+}
+
 func generateNAppResourceStatuses(n int) []v1alpha1.ResourceStatus {
 	var r []v1alpha1.ResourceStatus
 	for i := 0; i < n; i++ {
@@ -7100,6 +8542,65 @@ func TestIgnoreNotAllowedNamespaces(t *testing.T) {
 	}
 }
 
+func TestIgnoreOtherShards(t *testing.T) {
+	sharding := &utils.ApplicationSetSharding{Shards: 2, Shard: 1, Method: utils.ShardingMethodLabel}
+	inShard := &v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{
+		Name:      "in-shard",
+		Namespace: "argocd",
+		Labels:    map[string]string{argocommon.LabelKeyApplicationSetShard: "1"},
+	}}
+	otherShard := &v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "other-shard", Namespace: "argocd"}}
+	app := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"}}
+
+	assert.True(t, ignoreOtherShards(sharding).Create(event.CreateEvent{Object: inShard}))
+	assert.False(t, ignoreOtherShards(sharding).Create(event.CreateEvent{Object: otherShard}))
+	assert.False(t, ignoreOtherShards(sharding).Update(event.UpdateEvent{ObjectNew: otherShard}))
+	assert.True(t, ignoreOtherShards(sharding).Update(event.UpdateEvent{ObjectNew: app}))
+	assert.True(t, ignoreOtherShards(nil).Create(event.CreateEvent{Object: otherShard}))
+}
+
+func TestReconcileIgnoresOtherShards(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Template: v1alpha1.ApplicationSetTemplate{},
+		},
+	}
+	kubeclientset := kubefake.NewClientset()
+	crtClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithStatusSubresource(&appSet).Build()
+	metrics := appsetmetrics.NewFakeAppsetMetrics()
+	argodb := db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset)
+
+	r := ApplicationSetReconciler{
+		Client:        crtClient,
+		Scheme:        scheme,
+		Recorder:      record.NewFakeRecorder(1),
+		Generators:    map[string]generators.Generator{},
+		ArgoDB:        argodb,
+		KubeClientset: kubeclientset,
+		Metrics:       metrics,
+		Sharding:      &utils.ApplicationSetSharding{Shards: 2, Shard: 1, Method: utils.ShardingMethodLabel},
+	}
+
+	res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, res)
+
+	var retrievedAppSet v1alpha1.ApplicationSet
+	err = crtClient.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: "name"}, &retrievedAppSet)
+	require.NoError(t, err)
+	// the ApplicationSet of the other shard is left untouched
+	assert.Empty(t, retrievedAppSet.Finalizers)
+	assert.Empty(t, retrievedAppSet.Status.Conditions)
+}
+
 func TestIsRollingSyncStrategy(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -7247,6 +8748,24 @@ func TestSyncApplication(t *testing.T) {
 	}
 }
 
+func TestRollbackApplication(t *testing.T) {
+	singleSource := v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git"}}}
+	result := rollbackApplication(singleSource, []string{"previous"}, true)
+	require.NotNil(t, result.Operation)
+	assert.Equal(t, "previous", result.Operation.Sync.Revision)
+	assert.Empty(t, result.Operation.Sync.Revisions)
+	assert.Equal(t, "ApplicationSet RollingSync triggered a rollback of this Application resource to its previously healthy revisions", result.Operation.Info[0].Value)
+
+	multiSource := v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{Sources: v1alpha1.ApplicationSources{
+		{RepoURL: "https://example.com/repo.git"},
+		{RepoURL: "https://example.com/values.git"},
+	}}}
+	result = rollbackApplication(multiSource, []string{"previous", "previous-values"}, false)
+	require.NotNil(t, result.Operation)
+	assert.Empty(t, result.Operation.Sync.Revision)
+	assert.Equal(t, []string{"previous", "previous-values"}, result.Operation.Sync.Revisions)
+}
+
 func TestIsRollingSyncDeletionReversed(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -7649,3 +9168,90 @@ func TestReconcileProgressiveSyncDisabled(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordEventWithCorrelationID(t *testing.T) {
+	recorder := record.NewFakeRecorder(2)
+	r := ApplicationSetReconciler{Recorder: recorder}
+	appSet := &v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"}}
+
+	r.recordEvent(t.Context(), appSet, corev1.EventTypeNormal, "Deleted", "Deleted Application %q", "app")
+	assert.Equal(t, `Normal Deleted Deleted Application "app"`, <-recorder.Events)
+
+	ctx := services.ContextWithCorrelationID(t.Context(), "abc")
+	r.recordEvent(ctx, appSet, corev1.EventTypeNormal, "Deleted", "Deleted Application %q", "app")
+	assert.Equal(t, `Normal Deleted Deleted Application "app" map[applicationset.argoproj.io/correlation-id:abc]`, <-recorder.Events)
+}
+
+func TestReconcileCorrelationID(t *testing.T) {
+	first := reconcileCorrelationID(t.Context())
+	second := reconcileCorrelationID(t.Context())
+	assert.NotEmpty(t, first)
+	assert.NotEqual(t, first, second)
+}
+
+func TestMissingTemplateKeysMessage(t *testing.T) {
+	assert.Equal(t, "All applications have been generated successfully, but the templates reference missing parameters which have been rendered as empty strings: a, b", missingTemplateKeysMessage([]string{"a", "b"}))
+
+	keys := make([]string, 12)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key%02d", i)
+	}
+	message := missingTemplateKeysMessage(keys)
+	assert.True(t, strings.HasSuffix(message, "key09 (and 2 more)"), message)
+}
+
+func TestGenerateApplicationsCachedParameters(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{{List: &v1alpha1.ListGenerator{}}},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{Name: "{{.cluster}}-app"},
+			},
+		},
+	}
+	generatorMock := &mocks.Generator{}
+	generatorMock.EXPECT().GetTemplate(mock.Anything).Return(&v1alpha1.ApplicationSetTemplate{})
+	generatorMock.EXPECT().GenerateParams(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]map[string]any{{"cluster": "dev"}}, nil).Once()
+	generatorMock.EXPECT().GenerateParams(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("plugin unavailable"))
+
+	recorder := record.NewFakeRecorder(1)
+	r := ApplicationSetReconciler{
+		Client:                     fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&appSet).WithObjects(&appSet).Build(),
+		Scheme:                     scheme,
+		Recorder:                   recorder,
+		Generators:                 map[string]generators.Generator{"List": generatorMock},
+		Renderer:                   &utils.Render{},
+		Metrics:                    appsetmetrics.NewFakeAppsetMetrics(),
+		UseCachedParametersOnError: true,
+	}
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	// the generated parameters are cached in the status
+	apps, _, _, err := r.generateApplications(t.Context(), logCtx, &appSet)
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	require.Len(t, appSet.Status.CachedParameters, 1)
+	assert.Equal(t, int64(1), appSet.Status.CachedParameters[0].ParameterSetCount)
+
+	// the cached parameters are used while the generator fails
+	apps, _, _, err = r.generateApplications(t.Context(), logCtx, &appSet)
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "dev-app", apps[0].Name)
+	event := <-recorder.Events
+	assert.Contains(t, event, "Warning CachedParametersUsed Generator 0 failed to generate parameters")
+	assert.Contains(t, event, "plugin unavailable")
+
+	// the cached parameters are removed once disabled
+	r.UseCachedParametersOnError = false
+	_, _, _, err = r.generateApplications(t.Context(), logCtx, &appSet)
+	require.Error(t, err)
+	assert.Empty(t, appSet.Status.CachedParameters)
+}
@@ -0,0 +1,207 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// clusterDecisionResourceWatcher watches the decision resources of the ClusterDecisionResource generators, and requeues
+// the ApplicationSets whose generators select a decision resource which changed. A kind of decision resource is
+// watched once an ApplicationSet with a generator referencing it is reconciled, since the kinds are only known from the
+// ConfigMaps referenced by the generators.
+type clusterDecisionResourceWatcher struct {
+	Log                      log.FieldLogger
+	Client                   client.Client
+	KubeClientset            kubernetes.Interface
+	Controller               controller.Controller
+	Cache                    cache.Cache
+	RESTMapper               meta.RESTMapper
+	ArgoCDNamespace          string
+	ApplicationSetNamespaces []string
+
+	lock sync.Mutex
+	// watched are the kinds of the decision resources which are watched
+	watched map[schema.GroupVersionKind]bool
+	// kinds are the kinds of the decision resources, keyed by the name of the ConfigMap defining them
+	kinds map[string]schema.GroupVersionKind
+}
+
+// watchDecisionResources starts watching the kinds of the decision resources of the ClusterDecisionResource
+// generators of the given ApplicationSet, if they are not watched yet
+func (w *clusterDecisionResourceWatcher) watchDecisionResources(ctx context.Context, appset *argov1alpha1.ApplicationSet) error {
+	for _, generator := range getClusterDecisionResourceGenerators(appset.Spec.Generators) {
+		gvk, err := w.getKind(ctx, generator.ConfigMapRef)
+		if err != nil {
+			return err
+		}
+
+		w.lock.Lock()
+		watched := w.watched[gvk]
+		w.lock.Unlock()
+		if watched {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		src := source.Kind[client.Object](w.Cache, obj, handler.EnqueueRequestsFromMapFunc(w.mapDecisionResource(gvk)), decisionResourceChanged())
+		if err := w.Controller.Watch(src); err != nil {
+			return fmt.Errorf("error watching %s: %w", gvk.String(), err)
+		}
+		w.Log.WithField("kind", gvk.String()).Info("watching cluster decision resources")
+
+		w.lock.Lock()
+		w.watched[gvk] = true
+		w.lock.Unlock()
+	}
+	return nil
+}
+
+// getKind returns the kind of the decision resources defined by the given ConfigMap
+func (w *clusterDecisionResourceWatcher) getKind(ctx context.Context, configMapRef string) (schema.GroupVersionKind, error) {
+	cm, err := w.KubeClientset.CoreV1().ConfigMaps(w.ArgoCDNamespace).Get(ctx, configMapRef, metav1.GetOptions{})
+	if err != nil {
+		return schema.GroupVersionKind{}, fmt.Errorf("error reading configMapRef: %w", err)
+	}
+	gvr, err := generators.GetDuckTypeResource(cm)
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+	gvk, err := w.RESTMapper.KindFor(gvr)
+	if err != nil {
+		return schema.GroupVersionKind{}, fmt.Errorf("error getting the kind of %s: %w", gvr.String(), err)
+	}
+
+	w.lock.Lock()
+	w.kinds[configMapRef] = gvk
+	w.lock.Unlock()
+	return gvk, nil
+}
+
+// mapDecisionResource returns the requests of the ApplicationSets with a ClusterDecisionResource generator selecting a
+// decision resource of the given kind
+func (w *clusterDecisionResourceWatcher) mapDecisionResource(gvk schema.GroupVersionKind) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		// The decision resources are only listed in the Argo CD namespace
+		if obj.GetNamespace() != w.ArgoCDNamespace {
+			return nil
+		}
+
+		appSetList := &argov1alpha1.ApplicationSetList{}
+		if err := w.Client.List(ctx, appSetList); err != nil {
+			w.Log.WithError(err).Error("unable to list ApplicationSets")
+			return nil
+		}
+
+		w.lock.Lock()
+		defer w.lock.Unlock()
+
+		var requests []reconcile.Request
+		for _, appSet := range appSetList.Items {
+			if !utils.IsNamespaceAllowed(w.ApplicationSetNamespaces, appSet.GetNamespace()) {
+				continue
+			}
+			for _, generator := range getClusterDecisionResourceGenerators(appSet.Spec.Generators) {
+				if w.kinds[generator.ConfigMapRef] != gvk || !decisionResourceSelected(generator, obj) {
+					continue
+				}
+				requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: appSet.Namespace, Name: appSet.Name}})
+				break
+			}
+		}
+		return requests
+	}
+}
+
+// decisionResourceSelected returns whether the given decision resource is selected by the given generator, by name or
+// by labels
+func decisionResourceSelected(generator *argov1alpha1.DuckTypeGenerator, obj client.Object) bool {
+	if generator.Name != "" {
+		return generator.Name == obj.GetName()
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&generator.LabelSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// decisionResourceChanged ignores the updates of the decision resources which change neither their status nor their
+// labels, e.g. the periodic resyncs
+func decisionResourceChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldObj, isOldUnstructured := e.ObjectOld.(*unstructured.Unstructured)
+			newObj, isNewUnstructured := e.ObjectNew.(*unstructured.Unstructured)
+			if !isOldUnstructured || !isNewUnstructured {
+				return true
+			}
+			return !reflect.DeepEqual(oldObj.Object["status"], newObj.Object["status"]) ||
+				!reflect.DeepEqual(oldObj.GetLabels(), newObj.GetLabels())
+		},
+	}
+}
+
+// getClusterDecisionResourceGenerators returns the ClusterDecisionResource generators of the given generators, including
+// the nested generators of the matrix and merge generators
+func getClusterDecisionResourceGenerators(appSetGenerators []argov1alpha1.ApplicationSetGenerator) []*argov1alpha1.DuckTypeGenerator {
+	var res []*argov1alpha1.DuckTypeGenerator
+	for _, generator := range appSetGenerators {
+		if generator.ClusterDecisionResource != nil {
+			res = append(res, generator.ClusterDecisionResource)
+		}
+		if generator.Matrix != nil {
+			res = append(res, getNestedClusterDecisionResourceGenerators(generator.Matrix.Generators)...)
+		}
+		if generator.Merge != nil {
+			res = append(res, getNestedClusterDecisionResourceGenerators(generator.Merge.Generators)...)
+		}
+	}
+	return res
+}
+
+// getNestedClusterDecisionResourceGenerators returns the ClusterDecisionResource generators of the given nested
+// generators. The nested generators which cannot be decoded are skipped.
+func getNestedClusterDecisionResourceGenerators(nestedGenerators []argov1alpha1.ApplicationSetNestedGenerator) []*argov1alpha1.DuckTypeGenerator {
+	var res []*argov1alpha1.DuckTypeGenerator
+	for _, nested := range nestedGenerators {
+		if nested.ClusterDecisionResource != nil {
+			res = append(res, nested.ClusterDecisionResource)
+		}
+		if nested.Matrix != nil {
+			if nestedMatrix, err := argov1alpha1.ToNestedMatrixGenerator(nested.Matrix); err == nil && nestedMatrix != nil {
+				res = append(res, getNestedClusterDecisionResourceGenerators(nestedMatrix.ToMatrixGenerator().Generators)...)
+			}
+		}
+		if nested.Merge != nil {
+			if nestedMerge, err := argov1alpha1.ToNestedMergeGenerator(nested.Merge); err == nil && nestedMerge != nil {
+				res = append(res, getNestedClusterDecisionResourceGenerators(nestedMerge.ToMergeGenerator().Generators)...)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var duckGVK = schema.GroupVersionKind{Group: "mallard.io", Version: "v1", Kind: "Duck"}
+
+// fakeWatchController records the sources watched by the controller
+type fakeWatchController struct {
+	controller.Controller
+	sources []source.Source
+}
+
+func (c *fakeWatchController) Watch(src source.Source) error {
+	c.sources = append(c.sources, src)
+	return nil
+}
+
+func newTestClusterDecisionResourceWatcher(t *testing.T, appSets ...argov1alpha1.ApplicationSet) (*clusterDecisionResourceWatcher, *fakeWatchController) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, argov1alpha1.AddToScheme(scheme))
+
+	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{duckGVK.GroupVersion()})
+	restMapper.Add(duckGVK, meta.RESTScopeNamespace)
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-configmap", Namespace: "argocd"},
+		Data: map[string]string{
+			"apiVersion":    "mallard.io/v1",
+			"kind":          "ducks",
+			"statusListKey": "decisions",
+			"matchKey":      "clusterName",
+		},
+	}
+
+	c := &fakeWatchController{}
+	return &clusterDecisionResourceWatcher{
+		Log:                      log.WithField("type", "clusterDecisionResourceWatcher"),
+		Client:                   fake.NewClientBuilder().WithScheme(scheme).WithLists(&argov1alpha1.ApplicationSetList{Items: appSets}).Build(),
+		KubeClientset:            kubefake.NewClientset(configMap),
+		Controller:               c,
+		RESTMapper:               restMapper,
+		ArgoCDNamespace:          "argocd",
+		ApplicationSetNamespaces: []string{"argocd"},
+		watched:                  map[schema.GroupVersionKind]bool{},
+		kinds:                    map[string]schema.GroupVersionKind{},
+	}, c
+}
+
+func newDuck(name, namespace string, labels map[string]string) *unstructured.Unstructured {
+	duck := &unstructured.Unstructured{}
+	duck.SetGroupVersionKind(duckGVK)
+	duck.SetName(name)
+	duck.SetNamespace(namespace)
+	duck.SetLabels(labels)
+	return duck
+}
+
+func TestClusterDecisionResourceWatcher(t *testing.T) {
+	byName := argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "by-name", Namespace: "argocd"},
+		Spec: argov1alpha1.ApplicationSetSpec{
+			Generators: []argov1alpha1.ApplicationSetGenerator{
+				{ClusterDecisionResource: &argov1alpha1.DuckTypeGenerator{ConfigMapRef: "my-configmap", Name: "quak"}},
+			},
+		},
+	}
+	byLabels := argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "by-labels", Namespace: "argocd"},
+		Spec: argov1alpha1.ApplicationSetSpec{
+			Generators: []argov1alpha1.ApplicationSetGenerator{
+				{
+					Matrix: &argov1alpha1.MatrixGenerator{
+						Generators: []argov1alpha1.ApplicationSetNestedGenerator{
+							{List: &argov1alpha1.ListGenerator{}},
+							{ClusterDecisionResource: &argov1alpha1.DuckTypeGenerator{
+								ConfigMapRef:  "my-configmap",
+								LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"duck": "spotted"}},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+	noDecisionResource := argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "list", Namespace: "argocd"},
+		Spec: argov1alpha1.ApplicationSetSpec{
+			Generators: []argov1alpha1.ApplicationSetGenerator{
+				{List: &argov1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{}}},
+			},
+		},
+	}
+
+	t.Run("watches each kind once", func(t *testing.T) {
+		watcher, c := newTestClusterDecisionResourceWatcher(t)
+
+		require.NoError(t, watcher.watchDecisionResources(t.Context(), &noDecisionResource))
+		assert.Empty(t, c.sources)
+
+		require.NoError(t, watcher.watchDecisionResources(t.Context(), &byName))
+		require.NoError(t, watcher.watchDecisionResources(t.Context(), &byLabels))
+		assert.Len(t, c.sources, 1)
+		assert.Equal(t, map[string]schema.GroupVersionKind{"my-configmap": duckGVK}, watcher.kinds)
+	})
+
+	t.Run("missing ConfigMap", func(t *testing.T) {
+		watcher, c := newTestClusterDecisionResourceWatcher(t)
+		appSet := byName.DeepCopy()
+		appSet.Spec.Generators[0].ClusterDecisionResource.ConfigMapRef = "missing"
+
+		require.ErrorContains(t, watcher.watchDecisionResources(t.Context(), appSet), "error reading configMapRef")
+		assert.Empty(t, c.sources)
+	})
+
+	t.Run("requeues the ApplicationSets selecting the decision resource", func(t *testing.T) {
+		watcher, _ := newTestClusterDecisionResourceWatcher(t, byName, byLabels, noDecisionResource)
+		require.NoError(t, watcher.watchDecisionResources(t.Context(), &byName))
+		mapFunc := watcher.mapDecisionResource(duckGVK)
+
+		requests := func(names ...string) []reconcile.Request {
+			var res []reconcile.Request
+			for _, name := range names {
+				res = append(res, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: name}})
+			}
+			return res
+		}
+
+		assert.ElementsMatch(t, requests("by-name", "by-labels"), mapFunc(t.Context(), newDuck("quak", "argocd", map[string]string{"duck": "spotted"})))
+		assert.ElementsMatch(t, requests("by-name"), mapFunc(t.Context(), newDuck("quak", "argocd", nil)))
+		assert.ElementsMatch(t, requests("by-labels"), mapFunc(t.Context(), newDuck("other", "argocd", map[string]string{"duck": "spotted"})))
+		assert.Empty(t, mapFunc(t.Context(), newDuck("quak", "other-namespace", nil)))
+		assert.Empty(t, watcher.mapDecisionResource(schema.GroupVersionKind{Group: "mallard.io", Version: "v1", Kind: "Goose"})(t.Context(), newDuck("quak", "argocd", nil)))
+	})
+}
+
+func TestDecisionResourceChanged(t *testing.T) {
+	oldDuck := newDuck("quak", "argocd", map[string]string{"duck": "spotted"})
+	require.NoError(t, unstructured.SetNestedSlice(oldDuck.Object, []any{map[string]any{"clusterName": "cluster-01"}}, "status", "decisions"))
+
+	resynced := oldDuck.DeepCopy()
+	resynced.SetResourceVersion("2")
+
+	statusChanged := oldDuck.DeepCopy()
+	require.NoError(t, unstructured.SetNestedSlice(statusChanged.Object, []any{map[string]any{"clusterName": "cluster-02"}}, "status", "decisions"))
+
+	labelsChanged := oldDuck.DeepCopy()
+	labelsChanged.SetLabels(map[string]string{"duck": "canvasback"})
+
+	p := decisionResourceChanged()
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: oldDuck, ObjectNew: resynced}))
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: oldDuck, ObjectNew: statusChanged}))
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: oldDuck, ObjectNew: labelsChanged}))
+	assert.True(t, p.Create(event.CreateEvent{Object: oldDuck}))
+	assert.True(t, p.Delete(event.DeleteEvent{Object: oldDuck}))
+}
@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
+	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// getMinRequeueAfter returns the delay after which the given ApplicationSet is reconciled again because of its
+// generators. The generators which failed and whose cached parameters were used are requeued after their backoff.
+func (r *ApplicationSetReconciler) getMinRequeueAfter(logCtx *log.Entry, applicationSetInfo *argov1alpha1.ApplicationSet) time.Duration {
+	var res time.Duration
+	for i, requestedGenerator := range applicationSetInfo.Spec.Generators {
+		if findGeneratorFailures(applicationSetInfo, i) != nil {
+			if t := getGeneratorRequeueAfterFailure(logCtx, applicationSetInfo, i); res == 0 || t < res {
+				res = t
+			}
+			continue
+		}
+
+		relevantGenerators := generators.GetRelevantGenerators(&requestedGenerator, r.Generators)
+
+		for _, g := range relevantGenerators {
+			t := requestedGenerator.RequeueStrategy.AddJitter(g.GetRequeueAfter(&requestedGenerator))
+
+			if res == 0 {
+				res = t
+			} else if t != 0 && t < res {
+				res = t
+			}
+		}
+	}
+
+	return res
+}
+
+// getRequeueAfterFailure returns the delay after which the given ApplicationSet is reconciled again after the given
+// error of the generation of its Applications. If generators failed, it is the shortest delay of the failed generators.
+func getRequeueAfterFailure(logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, err error) time.Duration {
+	var generatorsErr *template.GeneratorsFailedError
+	if !errors.As(err, &generatorsErr) {
+		return ReconcileRequeueOnValidationError
+	}
+	var res time.Duration
+	for _, i := range generatorsErr.Generators {
+		if t := getGeneratorRequeueAfterFailure(logCtx, appset, i); res == 0 || t < res {
+			res = t
+		}
+	}
+	if res == 0 {
+		return ReconcileRequeueOnValidationError
+	}
+	return res
+}
+
+// getGeneratorRequeueAfterFailure returns the delay of the requeue after the consecutive failures of the generator of
+// the given index. Without a backoff, it is ReconcileRequeueOnValidationError.
+func getGeneratorRequeueAfterFailure(logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, index int) time.Duration {
+	if index < 0 || index >= len(appset.Spec.Generators) {
+		return ReconcileRequeueOnValidationError
+	}
+	strategy := appset.Spec.Generators[index].RequeueStrategy
+	delay := ReconcileRequeueOnValidationError
+	if strategy != nil && strategy.Backoff != nil {
+		failures := int64(1)
+		if generatorFailures := findGeneratorFailures(appset, index); generatorFailures != nil {
+			failures = generatorFailures.Count
+		}
+		backoff, err := strategy.BackoffDuration(failures)
+		if err != nil {
+			logCtx.WithError(err).WithField("generator", index).Warn("invalid requeue backoff of the generator")
+		} else {
+			delay = backoff
+		}
+	}
+	return strategy.AddJitter(delay)
+}
+
+// findGeneratorFailures returns the consecutive failures of the generator of the given index, if it failed
+func findGeneratorFailures(appset *argov1alpha1.ApplicationSet, index int) *argov1alpha1.ApplicationSetGeneratorFailures {
+	for i := range appset.Status.GeneratorFailures {
+		if appset.Status.GeneratorFailures[i].Generator == int64(index) {
+			return &appset.Status.GeneratorFailures[i]
+		}
+	}
+	return nil
+}
@@ -1,10 +1,13 @@
 package controllers
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -13,9 +16,12 @@ import (
 	dynfake "k8s.io/client-go/dynamic/fake"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	generatormocks "github.com/argoproj/argo-cd/v3/applicationset/generators/mocks"
 	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
 	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
 	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -56,7 +62,7 @@ func TestRequeueAfter(t *testing.T) {
 		},
 	}
 	fakeDynClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrToListKind, duckType)
-	scmConfig := generators.NewSCMConfig("", []string{""}, true, true, nil, true)
+	scmConfig := generators.NewSCMConfig("", []string{""}, true, true, nil, true, false, 1, nil)
 	terminalGenerators := map[string]generators.Generator{
 		"List":                    generators.NewListGenerator(),
 		"Clusters":                generators.NewClusterGenerator(ctx, k8sClient, appClientset, "argocd"),
@@ -203,7 +209,75 @@ func TestRequeueAfter(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Setenv("ARGOCD_APPLICATIONSET_CONTROLLER_REQUEUE_AFTER", tt.args.requeueAfterOverride)
-			assert.Equalf(t, tt.want, r.getMinRequeueAfter(tt.args.appset), "getMinRequeueAfter(%v)", tt.args.appset)
+			assert.Equalf(t, tt.want, r.getMinRequeueAfter(log.NewEntry(log.StandardLogger()), tt.args.appset), "getMinRequeueAfter(%v)", tt.args.appset)
 		})
 	}
 }
+
+func TestGetRequeueAfterFailure(t *testing.T) {
+	logCtx := log.NewEntry(log.StandardLogger())
+	appset := &argov1alpha1.ApplicationSet{
+		Spec: argov1alpha1.ApplicationSetSpec{
+			Generators: []argov1alpha1.ApplicationSetGenerator{
+				{List: &argov1alpha1.ListGenerator{}},
+				{
+					Clusters: &argov1alpha1.ClusterGenerator{},
+					RequeueStrategy: &argov1alpha1.ApplicationSetGeneratorRequeueStrategy{
+						Backoff: &argov1alpha1.Backoff{Duration: "10s"},
+					},
+				},
+				{
+					Git: &argov1alpha1.GitGenerator{},
+					RequeueStrategy: &argov1alpha1.ApplicationSetGeneratorRequeueStrategy{
+						Backoff: &argov1alpha1.Backoff{Duration: "invalid"},
+					},
+				},
+			},
+		},
+		Status: argov1alpha1.ApplicationSetStatus{
+			GeneratorFailures: []argov1alpha1.ApplicationSetGeneratorFailures{{Generator: 1, Count: 4}},
+		},
+	}
+
+	assert.Equal(t, ReconcileRequeueOnValidationError, getRequeueAfterFailure(logCtx, appset, errors.New("render error")))
+	assert.Equal(t, ReconcileRequeueOnValidationError, getRequeueAfterFailure(logCtx, appset, &template.GeneratorsFailedError{Generators: []int{0}, Err: errors.New("error")}))
+	assert.Equal(t, 80*time.Second, getRequeueAfterFailure(logCtx, appset, &template.GeneratorsFailedError{Generators: []int{1}, Err: errors.New("error")}))
+	assert.Equal(t, 80*time.Second, getRequeueAfterFailure(logCtx, appset, &template.GeneratorsFailedError{Generators: []int{0, 1}, Err: errors.New("error")}))
+	// an invalid backoff falls back to the default requeue
+	assert.Equal(t, ReconcileRequeueOnValidationError, getRequeueAfterFailure(logCtx, appset, &template.GeneratorsFailedError{Generators: []int{2}, Err: errors.New("error")}))
+}
+
+func TestGetMinRequeueAfterWithRequeueStrategy(t *testing.T) {
+	logCtx := log.NewEntry(log.StandardLogger())
+	generatorMock := &generatormocks.Generator{}
+	generatorMock.EXPECT().GetTemplate(mock.Anything).Return(&argov1alpha1.ApplicationSetTemplate{})
+	generatorMock.EXPECT().GetRequeueAfter(mock.Anything).Return(time.Minute)
+	r := ApplicationSetReconciler{
+		Generators: map[string]generators.Generator{"List": generatorMock},
+	}
+	appset := &argov1alpha1.ApplicationSet{
+		Spec: argov1alpha1.ApplicationSetSpec{
+			Generators: []argov1alpha1.ApplicationSetGenerator{{
+				List: &argov1alpha1.ListGenerator{},
+				RequeueStrategy: &argov1alpha1.ApplicationSetGeneratorRequeueStrategy{
+					Backoff:       &argov1alpha1.Backoff{Duration: "5m"},
+					JitterPercent: ptr.To(int64(50)),
+				},
+			}},
+		},
+	}
+
+	for range 20 {
+		requeueAfter := r.getMinRequeueAfter(logCtx, appset)
+		assert.GreaterOrEqual(t, requeueAfter, time.Minute)
+		assert.LessOrEqual(t, requeueAfter, 90*time.Second)
+	}
+
+	// a failed generator whose cached parameters were used is requeued after its backoff
+	appset.Status.GeneratorFailures = []argov1alpha1.ApplicationSetGeneratorFailures{{Generator: 0, Count: 2}}
+	for range 20 {
+		requeueAfter := r.getMinRequeueAfter(logCtx, appset)
+		assert.GreaterOrEqual(t, requeueAfter, 10*time.Minute)
+		assert.LessOrEqual(t, requeueAfter, 15*time.Minute)
+	}
+}
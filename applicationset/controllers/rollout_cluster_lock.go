@@ -0,0 +1,232 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argoutil "github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+const (
+	// rolloutClusterLocksConfigMapName is the name of the ConfigMap, in the Argo CD namespace, recording the
+	// ApplicationSets rolling out to each destination cluster
+	rolloutClusterLocksConfigMapName = "argocd-applicationset-rollout-locks"
+	// rolloutClusterLockTTL is the duration after which the lock of an ApplicationSet which stopped renewing it, e.g.
+	// because it was deleted, is released
+	rolloutClusterLockTTL = 10 * time.Minute
+	// rolloutClusterLockRequeueAfter is the interval at which ApplicationSets renew their locks and wait for the
+	// locks of other ApplicationSets to be released
+	rolloutClusterLockRequeueAfter = 30 * time.Second
+)
+
+// rolloutClusterLock is the lock of a destination cluster, held by the ApplicationSets rolling out to it
+type rolloutClusterLock struct {
+	// Server is the URL of the destination cluster
+	Server string `json:"server"`
+	// Holders are the time each ApplicationSet holding the lock, by namespace/name, last renewed it
+	Holders map[string]metav1.Time `json:"holders,omitempty"`
+}
+
+// getMaxConcurrentPerCluster returns the maximum number of ApplicationSets which may roll out to a destination cluster
+// at the same time, or 0 if it is unlimited
+func getMaxConcurrentPerCluster(appset *argov1alpha1.ApplicationSet) int {
+	if !progressiveSyncsRollingSyncStrategyEnabled(appset) {
+		return 0
+	}
+	return int(appset.Spec.Strategy.RollingSync.MaxConcurrentPerCluster)
+}
+
+// rolloutClusterLockKey returns the key of the lock of a destination cluster in the ConfigMap, as server URLs are not
+// valid ConfigMap keys
+func rolloutClusterLockKey(server string) string {
+	h := sha256.Sum256([]byte(server))
+	return hex.EncodeToString(h[:])[:32]
+}
+
+// isRolloutClusterLockHeld returns true if the Application holds the lock of its destination cluster, i.e. it is
+// rolling out or rolling back
+func isRolloutClusterLockHeld(status argov1alpha1.ProgressiveSyncStatusCode) bool {
+	return status == argov1alpha1.ProgressiveSyncPending || status == argov1alpha1.ProgressiveSyncProgressing || status == argov1alpha1.ProgressiveSyncRollingBack
+}
+
+// updateRolloutClusterLocks coordinates the rollouts of the ApplicationSets limiting their concurrency per cluster. The
+// locks of the destination clusters of the Applications which are rolling out, or are allowed to sync, are acquired or
+// renewed, and the other locks of the ApplicationSet are released. The Applications whose destination cluster could not
+// be locked are removed from appsToSync, and keep waiting.
+func (r *ApplicationSetReconciler) updateRolloutClusterLocks(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application, appsToSync map[string]bool) error {
+	maxConcurrent := getMaxConcurrentPerCluster(appset)
+	if maxConcurrent <= 0 {
+		return nil
+	}
+	holder := appset.Namespace + "/" + appset.Name
+
+	// the clusters of the Applications which are rolling out must stay locked, those of the Applications which are
+	// allowed to sync must be locked
+	appServers := map[string]string{}
+	heldServers := map[string]bool{}
+	wantedServers := map[string]bool{}
+	for i := range desiredApplications {
+		app := &desiredApplications[i]
+		idx := findApplicationStatusIndex(appset.Status.ApplicationStatus, app.Name)
+		if idx == -1 {
+			continue
+		}
+		status := appset.Status.ApplicationStatus[idx].Status
+		if !isRolloutClusterLockHeld(status) && (status != argov1alpha1.ProgressiveSyncWaiting || !appsToSync[app.Name]) {
+			continue
+		}
+		cluster, err := argoutil.GetDestinationCluster(ctx, app.Spec.Destination, r.ArgoDB)
+		if err != nil {
+			logCtx.WithField("app", app.Name).Warnf("unable to resolve the destination cluster of the application, its rollout is not limited per cluster: %v", err)
+			continue
+		}
+		appServers[app.Name] = cluster.Server
+		if isRolloutClusterLockHeld(status) {
+			heldServers[cluster.Server] = true
+		} else {
+			wantedServers[cluster.Server] = true
+		}
+	}
+
+	var blockedServers map[string]bool
+	configMaps := r.KubeClientset.CoreV1().ConfigMaps(r.ArgoCDNamespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := configMaps.Get(ctx, rolloutClusterLocksConfigMapName, metav1.GetOptions{})
+		exists := err == nil
+		if apierrors.IsNotFound(err) {
+			if len(heldServers) == 0 && len(wantedServers) == 0 {
+				blockedServers = nil
+				return nil
+			}
+			cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: rolloutClusterLocksConfigMapName, Namespace: r.ArgoCDNamespace}}
+		} else if err != nil {
+			return fmt.Errorf("error getting the rollout cluster locks: %w", err)
+		}
+
+		var changed bool
+		blockedServers, changed = updateRolloutClusterLockData(cm, holder, maxConcurrent, heldServers, wantedServers, time.Now())
+		if !changed {
+			return nil
+		}
+		if !exists {
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				// retry against the ConfigMap created concurrently
+				return apierrors.NewConflict(corev1.Resource("configmaps"), rolloutClusterLocksConfigMapName, err)
+			}
+		} else {
+			_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error updating the rollout cluster locks: %w", err)
+	}
+
+	if len(blockedServers) == 0 {
+		return nil
+	}
+	appStatuses := slices.Clone(appset.Status.ApplicationStatus)
+	for _, appName := range slices.Sorted(maps.Keys(appServers)) {
+		server := appServers[appName]
+		if !blockedServers[server] {
+			continue
+		}
+		delete(appsToSync, appName)
+		idx := findApplicationStatusIndex(appStatuses, appName)
+		appStatuses[idx].Message = fmt.Sprintf("Application is waiting for the rollouts of other ApplicationSets to cluster %s to complete (maxConcurrentPerCluster: %d)", server, maxConcurrent)
+		logCtx.WithField("app", appName).Infof("Application is not allowed to sync yet, %d ApplicationSets already rolling out to cluster %s", maxConcurrent, server)
+	}
+	return r.setAppSetApplicationStatus(ctx, logCtx, appset, appStatuses)
+}
+
+// updateRolloutClusterLockData updates the locks of the ConfigMap for the given holder: the locks of the held servers
+// are renewed, the locks of the wanted servers are acquired if less than maxConcurrent other ApplicationSets hold them,
+// and the other locks of the holder are released. Locks which were not renewed within rolloutClusterLockTTL are
+// released as well. It returns the wanted servers whose lock could not be acquired, and whether the ConfigMap changed.
+func updateRolloutClusterLockData(cm *corev1.ConfigMap, holder string, maxConcurrent int, heldServers map[string]bool, wantedServers map[string]bool, now time.Time) (map[string]bool, bool) {
+	locks := map[string]*rolloutClusterLock{}
+	for key, value := range cm.Data {
+		lock := &rolloutClusterLock{}
+		if err := json.Unmarshal([]byte(value), lock); err != nil {
+			log.WithField("configmap", rolloutClusterLocksConfigMapName).Warnf("ignoring the invalid rollout cluster lock %s: %v", key, err)
+			continue
+		}
+		for name, renewedAt := range lock.Holders {
+			if now.Sub(renewedAt.Time) > rolloutClusterLockTTL {
+				delete(lock.Holders, name)
+			}
+		}
+		locks[key] = lock
+	}
+
+	blocked := map[string]bool{}
+	servers := map[string]bool{}
+	maps.Copy(servers, heldServers)
+	maps.Copy(servers, wantedServers)
+	for server := range servers {
+		key := rolloutClusterLockKey(server)
+		lock, ok := locks[key]
+		if !ok {
+			lock = &rolloutClusterLock{Server: server}
+			locks[key] = lock
+		}
+		if lock.Holders == nil {
+			lock.Holders = map[string]metav1.Time{}
+		}
+		_, holding := lock.Holders[holder]
+		if !holding && !heldServers[server] && len(lock.Holders) >= maxConcurrent {
+			blocked[server] = true
+			continue
+		}
+		lock.Holders[holder] = metav1.NewTime(now)
+	}
+	for _, lock := range locks {
+		if !servers[lock.Server] {
+			delete(lock.Holders, holder)
+		}
+	}
+
+	data := map[string]string{}
+	for key, lock := range locks {
+		if len(lock.Holders) == 0 {
+			continue
+		}
+		value, err := json.Marshal(lock)
+		if err != nil {
+			continue
+		}
+		data[key] = string(value)
+	}
+	changed := !maps.Equal(data, cm.Data)
+	cm.Data = data
+	return blocked, changed
+}
+
+// getRolloutClusterLockRequeueAfter returns the interval at which the ApplicationSet must be requeued to renew its
+// rollout cluster locks and to acquire the locks it waits for, or 0 if it does not limit its rollouts per cluster or
+// has no Application left to roll out
+func getRolloutClusterLockRequeueAfter(appset *argov1alpha1.ApplicationSet) time.Duration {
+	if getMaxConcurrentPerCluster(appset) <= 0 {
+		return 0
+	}
+	for _, appStatus := range appset.Status.ApplicationStatus {
+		if appStatus.Status == argov1alpha1.ProgressiveSyncWaiting || isRolloutClusterLockHeld(appStatus.Status) {
+			return rolloutClusterLockRequeueAfter
+		}
+	}
+	return 0
+}
@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func TestUpdateRolloutClusterLockData(t *testing.T) {
+	now := time.Now()
+	lockData := func(server string, holders map[string]time.Time) string {
+		lock := rolloutClusterLock{Server: server, Holders: map[string]metav1.Time{}}
+		for holder, renewedAt := range holders {
+			lock.Holders[holder] = metav1.NewTime(renewedAt)
+		}
+		value, err := json.Marshal(lock)
+		require.NoError(t, err)
+		return string(value)
+	}
+	keyA := rolloutClusterLockKey("https://a.example.com")
+	keyB := rolloutClusterLockKey("https://b.example.com")
+
+	for _, cc := range []struct {
+		name            string
+		data            map[string]string
+		heldServers     map[string]bool
+		wantedServers   map[string]bool
+		expectedBlocked map[string]bool
+		expectedData    map[string]string
+	}{
+		{
+			name:            "acquires a free lock",
+			wantedServers:   map[string]bool{"https://a.example.com": true},
+			expectedBlocked: map[string]bool{},
+			expectedData:    map[string]string{keyA: lockData("https://a.example.com", map[string]time.Time{"argocd/appset": now})},
+		},
+		{
+			name:            "waits for a lock held by other ApplicationSets",
+			data:            map[string]string{keyA: lockData("https://a.example.com", map[string]time.Time{"argocd/other": now})},
+			wantedServers:   map[string]bool{"https://a.example.com": true},
+			expectedBlocked: map[string]bool{"https://a.example.com": true},
+			expectedData:    map[string]string{keyA: lockData("https://a.example.com", map[string]time.Time{"argocd/other": now})},
+		},
+		{
+			name:            "renews a held lock and releases the others",
+			data:            map[string]string{keyA: lockData("https://a.example.com", map[string]time.Time{"argocd/appset": now.Add(-time.Minute), "argocd/other": now}), keyB: lockData("https://b.example.com", map[string]time.Time{"argocd/appset": now})},
+			heldServers:     map[string]bool{"https://a.example.com": true},
+			expectedBlocked: map[string]bool{},
+			expectedData:    map[string]string{keyA: lockData("https://a.example.com", map[string]time.Time{"argocd/appset": now, "argocd/other": now})},
+		},
+		{
+			name:            "acquires a lock whose holder did not renew it",
+			data:            map[string]string{keyA: lockData("https://a.example.com", map[string]time.Time{"argocd/other": now.Add(-rolloutClusterLockTTL - time.Minute)})},
+			wantedServers:   map[string]bool{"https://a.example.com": true},
+			expectedBlocked: map[string]bool{},
+			expectedData:    map[string]string{keyA: lockData("https://a.example.com", map[string]time.Time{"argocd/appset": now})},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			cm := &corev1.ConfigMap{Data: cc.data}
+			blocked, _ := updateRolloutClusterLockData(cm, "argocd/appset", 1, cc.heldServers, cc.wantedServers, now)
+			assert.Equal(t, cc.expectedBlocked, blocked)
+			assert.Equal(t, cc.expectedData, cm.Data)
+		})
+	}
+}
+
+func TestUpdateRolloutClusterLocks(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newAppSet := func(name string, appStatus v1alpha1.ProgressiveSyncStatusCode) *v1alpha1.ApplicationSet {
+		return &v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type: "RollingSync",
+					RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+						Steps:                   []v1alpha1.ApplicationSetRolloutStep{{}},
+						MaxConcurrentPerCluster: 1,
+					},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: name + "-app", Status: appStatus, Step: "1"},
+			}},
+		}
+	}
+	newApps := func(appset *v1alpha1.ApplicationSet) []v1alpha1.Application {
+		return []v1alpha1.Application{{
+			ObjectMeta: metav1.ObjectMeta{Name: appset.Name + "-app", Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: v1alpha1.KubernetesInternalAPIServerAddr, Namespace: "guestbook"}},
+		}}
+	}
+	first := newAppSet("first", v1alpha1.ProgressiveSyncWaiting)
+	second := newAppSet("second", v1alpha1.ProgressiveSyncWaiting)
+
+	kubeclientset := getDefaultTestClientSet()
+	r := ApplicationSetReconciler{
+		Client:          fake.NewClientBuilder().WithScheme(scheme).WithObjects(first, second).WithStatusSubresource(first, second).Build(),
+		Scheme:          scheme,
+		KubeClientset:   kubeclientset,
+		ArgoDB:          db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset),
+		ArgoCDNamespace: "argocd",
+		Recorder:        record.NewFakeRecorder(10),
+	}
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	// the first ApplicationSet locks the cluster
+	appsToSync := map[string]bool{"first-app": true}
+	require.NoError(t, r.updateRolloutClusterLocks(t.Context(), logCtx, first, newApps(first), appsToSync))
+	assert.Equal(t, map[string]bool{"first-app": true}, appsToSync)
+
+	// the second one waits for it
+	appsToSync = map[string]bool{"second-app": true}
+	require.NoError(t, r.updateRolloutClusterLocks(t.Context(), logCtx, second, newApps(second), appsToSync))
+	assert.Empty(t, appsToSync)
+	updated := &v1alpha1.ApplicationSet{}
+	require.NoError(t, r.Get(t.Context(), crtclient.ObjectKeyFromObject(second), updated))
+	assert.Equal(t, "Application is waiting for the rollouts of other ApplicationSets to cluster https://kubernetes.default.svc to complete (maxConcurrentPerCluster: 1)", updated.Status.ApplicationStatus[0].Message)
+
+	// the first one keeps the lock while its Application is progressing
+	first.Status.ApplicationStatus[0].Status = v1alpha1.ProgressiveSyncProgressing
+	require.NoError(t, r.updateRolloutClusterLocks(t.Context(), logCtx, first, newApps(first), map[string]bool{"first-app": true}))
+	appsToSync = map[string]bool{"second-app": true}
+	require.NoError(t, r.updateRolloutClusterLocks(t.Context(), logCtx, updated, newApps(second), appsToSync))
+	assert.Empty(t, appsToSync)
+
+	// and releases it once the Application is Healthy
+	first.Status.ApplicationStatus[0].Status = v1alpha1.ProgressiveSyncHealthy
+	require.NoError(t, r.updateRolloutClusterLocks(t.Context(), logCtx, first, newApps(first), map[string]bool{"first-app": true}))
+	appsToSync = map[string]bool{"second-app": true}
+	require.NoError(t, r.updateRolloutClusterLocks(t.Context(), logCtx, updated, newApps(second), appsToSync))
+	assert.Equal(t, map[string]bool{"second-app": true}, appsToSync)
+
+	cm, err := kubeclientset.CoreV1().ConfigMaps("argocd").Get(t.Context(), rolloutClusterLocksConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, cm.Data, 1)
+	assert.Contains(t, cm.Data[rolloutClusterLockKey(v1alpha1.KubernetesInternalAPIServerAddr)], `"argocd/second"`)
+	assert.NotContains(t, cm.Data[rolloutClusterLockKey(v1alpha1.KubernetesInternalAPIServerAddr)], `"argocd/first"`)
+}
+
+func TestGetRolloutClusterLockRequeueAfter(t *testing.T) {
+	appset := &v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type:        "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{Steps: []v1alpha1.ApplicationSetRolloutStep{{}}},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+			{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy},
+			{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting},
+		}},
+	}
+	assert.Equal(t, time.Duration(0), getRolloutClusterLockRequeueAfter(appset))
+
+	appset.Spec.Strategy.RollingSync.MaxConcurrentPerCluster = 1
+	assert.Equal(t, rolloutClusterLockRequeueAfter, getRolloutClusterLockRequeueAfter(appset))
+
+	appset.Status.ApplicationStatus[1].Status = v1alpha1.ProgressiveSyncHealthy
+	assert.Equal(t, time.Duration(0), getRolloutClusterLockRequeueAfter(appset))
+}
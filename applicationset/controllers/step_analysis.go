@@ -0,0 +1,360 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// stepAnalysisRequeueAfter is the interval at which running step analyses are checked
+	stepAnalysisRequeueAfter = 30 * time.Second
+	// maxStepAnalysisAppSetNameLength keeps the names of analysis runs, which are also the names of analysis Jobs,
+	// within the length allowed for label values
+	maxStepAnalysisAppSetNameLength = 40
+)
+
+// prometheusAnalysisClient is the client Prometheus step analyses are evaluated with. Redirects are not followed, since
+// they could lead to addresses which are not permitted by the allowed Prometheus addresses.
+var prometheusAnalysisClient = &http.Client{
+	Timeout: 30 * time.Second,
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// getStepAnalysis returns the analysis of the given 0-based RollingSync step of the ApplicationSet, or nil if the step
+// has no analysis
+func getStepAnalysis(appset *argov1alpha1.ApplicationSet, stepIndex int) *argov1alpha1.ApplicationSetStepAnalysis {
+	if !progressiveSyncsRollingSyncStrategyEnabled(appset) || stepIndex < 0 || stepIndex >= len(appset.Spec.Strategy.RollingSync.Steps) {
+		return nil
+	}
+	return appset.Spec.Strategy.RollingSync.Steps[stepIndex].Analysis
+}
+
+// getStepAnalysisRequeueAfter returns the interval at which the given ApplicationSet must be reconciled to check its
+// running step analyses, or 0 if no step analysis is running
+func getStepAnalysisRequeueAfter(appset *argov1alpha1.ApplicationSet) time.Duration {
+	if !progressiveSyncsRollingSyncStrategyEnabled(appset) {
+		return 0
+	}
+	for _, appStatus := range appset.Status.ApplicationStatus {
+		if appStatus.StepAnalysis != nil && !appStatus.StepAnalysis.IsCompleted() {
+			return stepAnalysisRequeueAfter
+		}
+	}
+	return 0
+}
+
+// updateStepAnalyses runs the analysis of every RollingSync step whose Applications are all Healthy, and records its
+// outcome in the statuses of the Applications of the step
+func (r *ApplicationSetReconciler) updateStepAnalyses(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, appDependencyList [][]string) error {
+	if !progressiveSyncsRollingSyncStrategyEnabled(appset) {
+		return nil
+	}
+	appStatuses := slices.Clone(appset.Status.ApplicationStatus)
+
+	for stepIndex, appNames := range appDependencyList {
+		analysis := getStepAnalysis(appset, stepIndex)
+		if analysis == nil || len(appNames) == 0 {
+			continue
+		}
+
+		stepHealthy := true
+		indexes := make([]int, 0, len(appNames))
+		for _, appName := range appNames {
+			idx := findApplicationStatusIndex(appStatuses, appName)
+			if idx != -1 && isApplicationFailureTolerated(appset, appStatuses[idx]) {
+				// the Failed Applications of a step which continues on failure are not analysed
+				continue
+			}
+			if idx == -1 || appStatuses[idx].Status != argov1alpha1.ProgressiveSyncHealthy {
+				stepHealthy = false
+				break
+			}
+			indexes = append(indexes, idx)
+		}
+		if !stepHealthy || len(indexes) == 0 {
+			continue
+		}
+
+		name := getStepAnalysisRunName(appset, stepIndex, appStatuses, indexes)
+		current := appStatuses[indexes[0]].StepAnalysis
+		if current != nil && current.Name == name && current.IsCompleted() {
+			continue
+		}
+
+		analysisStatus := r.runStepAnalysis(ctx, appset, analysis, name, current, appNames)
+		if current == nil || current.Phase != analysisStatus.Phase || current.Message != analysisStatus.Message {
+			logCtx.WithFields(log.Fields{
+				"step":           stepIndex + 1,
+				"analysis.name":  analysisStatus.Name,
+				"analysis.phase": analysisStatus.Phase,
+			}).Infof("Progressive sync step analysis changed: %s", analysisStatus.Message)
+		}
+		for _, idx := range indexes {
+			appStatuses[idx].StepAnalysis = analysisStatus.DeepCopy()
+		}
+	}
+
+	return r.setAppSetApplicationStatus(ctx, logCtx, appset, appStatuses)
+}
+
+// getStepAnalysisRunName returns the name of the analysis run of the given step, which changes whenever the
+// Applications of the step or their target revisions change
+func getStepAnalysisRunName(appset *argov1alpha1.ApplicationSet, stepIndex int, appStatuses []argov1alpha1.ApplicationSetApplicationStatus, indexes []int) string {
+	lines := make([]string, 0, len(indexes))
+	for _, idx := range indexes {
+		lines = append(lines, appStatuses[idx].Application+"="+strings.Join(appStatuses[idx].TargetRevisions, ","))
+	}
+	slices.Sort(lines)
+	hash := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+
+	prefix := appset.Name
+	if len(prefix) > maxStepAnalysisAppSetNameLength {
+		prefix = strings.TrimRight(prefix[:maxStepAnalysisAppSetNameLength], "-.")
+	}
+	return fmt.Sprintf("%s-step%d-%s", prefix, stepIndex+1, hex.EncodeToString(hash[:])[:8])
+}
+
+// runStepAnalysis runs or checks the given analysis run of the step of the given Applications and returns its status.
+// Errors are reported in the message of a Running status, so the analysis is retried.
+func (r *ApplicationSetReconciler) runStepAnalysis(ctx context.Context, appset *argov1alpha1.ApplicationSet, analysis *argov1alpha1.ApplicationSetStepAnalysis, name string, current *argov1alpha1.ApplicationSetStepAnalysisStatus, appNames []string) *argov1alpha1.ApplicationSetStepAnalysisStatus {
+	analysisStatus := &argov1alpha1.ApplicationSetStepAnalysisStatus{
+		Name:      name,
+		Phase:     argov1alpha1.ApplicationSetStepAnalysisRunning,
+		StartedAt: &metav1.Time{Time: time.Now().Truncate(time.Second)},
+	}
+	if current != nil && current.Name == name && current.StartedAt != nil {
+		analysisStatus.StartedAt = current.StartedAt
+	}
+
+	var phase argov1alpha1.ApplicationSetStepAnalysisPhase
+	var message string
+	var err error
+	switch {
+	case (analysis.CronJob == "") == (analysis.Prometheus == nil):
+		phase, message = argov1alpha1.ApplicationSetStepAnalysisFailed, "analysis must configure exactly one of cronJob and prometheus"
+	case analysis.CronJob != "":
+		phase, message, err = r.runJobAnalysis(ctx, appset, analysis.CronJob, name, appNames)
+	default:
+		phase, message, err = runPrometheusAnalysis(ctx, analysis.Prometheus, r.StepAnalysisPrometheusAddresses)
+	}
+	if err != nil {
+		analysisStatus.Message = fmt.Sprintf("error running analysis, retrying: %v", err)
+		return analysisStatus
+	}
+	analysisStatus.Phase = phase
+	analysisStatus.Message = message
+	return analysisStatus
+}
+
+// runJobAnalysis creates the analysis Job of the given name from the job template of the given CronJob, if it does
+// not exist yet, and returns its phase. The Job is created in the namespace of the ApplicationSet, which the projects
+// of all the given Applications of the step have to permit.
+func (r *ApplicationSetReconciler) runJobAnalysis(ctx context.Context, appset *argov1alpha1.ApplicationSet, cronJobName string, name string, appNames []string) (argov1alpha1.ApplicationSetStepAnalysisPhase, string, error) {
+	jobs := r.KubeClientset.BatchV1().Jobs(appset.Namespace)
+	job, err := jobs.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if message, err := r.checkJobAnalysisPermitted(ctx, appset, cronJobName, appNames); err != nil || message != "" {
+			return argov1alpha1.ApplicationSetStepAnalysisFailed, message, err
+		}
+		cronJob, err := r.KubeClientset.BatchV1().CronJobs(appset.Namespace).Get(ctx, cronJobName, metav1.GetOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("error getting CronJob %q: %w", cronJobName, err)
+		}
+		job = &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       appset.Namespace,
+				Labels:          maps.Clone(cronJob.Spec.JobTemplate.Labels),
+				Annotations:     maps.Clone(cronJob.Spec.JobTemplate.Annotations),
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(appset, argov1alpha1.ApplicationSetSchemaGroupVersionKind)},
+			},
+			Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+		}
+		job, err = jobs.Create(ctx, job, metav1.CreateOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("error creating Job %q: %w", name, err)
+		}
+	} else if err != nil {
+		return "", "", fmt.Errorf("error getting Job %q: %w", name, err)
+	} else if !metav1.IsControlledBy(job, appset) {
+		return argov1alpha1.ApplicationSetStepAnalysisFailed, fmt.Sprintf("Job %s exists but is not controlled by the ApplicationSet", name), nil
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return argov1alpha1.ApplicationSetStepAnalysisSuccessful, fmt.Sprintf("Job %s completed", name), nil
+		case batchv1.JobFailed:
+			return argov1alpha1.ApplicationSetStepAnalysisFailed, fmt.Sprintf("Job %s failed: %s", name, condition.Message), nil
+		}
+	}
+	return argov1alpha1.ApplicationSetStepAnalysisRunning, fmt.Sprintf("Job %s is running", name), nil
+}
+
+// checkJobAnalysisPermitted verifies that the projects of the given Applications permit Jobs in the namespace of the
+// ApplicationSet on the local cluster, and returns the reason if they do not
+func (r *ApplicationSetReconciler) checkJobAnalysisPermitted(ctx context.Context, appset *argov1alpha1.ApplicationSet, cronJobName string, appNames []string) (string, error) {
+	jobGroupKind := schema.GroupKind{Group: batchv1.GroupName, Kind: "Job"}
+	destCluster := &argov1alpha1.Cluster{Name: "in-cluster", Server: argov1alpha1.KubernetesInternalAPIServerAddr}
+	checked := map[string]bool{}
+	for _, appName := range appNames {
+		app := &argov1alpha1.Application{}
+		if err := r.Get(ctx, types.NamespacedName{Name: appName, Namespace: appset.Namespace}, app); err != nil {
+			return "", fmt.Errorf("error getting Application %q: %w", appName, err)
+		}
+		if checked[app.Spec.GetProject()] {
+			continue
+		}
+		checked[app.Spec.GetProject()] = true
+
+		proj := &argov1alpha1.AppProject{}
+		if err := r.Get(ctx, types.NamespacedName{Name: app.Spec.GetProject(), Namespace: r.ArgoCDNamespace}, proj); err != nil {
+			return "", fmt.Errorf("error getting project %q: %w", app.Spec.GetProject(), err)
+		}
+		if !proj.IsGroupKindNamePermitted(jobGroupKind, cronJobName, true) {
+			return fmt.Sprintf("Jobs are not permitted in project %q", proj.Name), nil
+		}
+		permitted, err := proj.IsDestinationPermitted(destCluster, appset.Namespace, func(project string) ([]*argov1alpha1.Cluster, error) {
+			return r.ArgoDB.GetProjectClusters(ctx, project)
+		})
+		if err != nil {
+			return "", fmt.Errorf("error verifying the destinations of project %q: %w", proj.Name, err)
+		}
+		if !permitted {
+			return fmt.Sprintf("namespace %q of the local cluster is not permitted in project %q", appset.Namespace, proj.Name), nil
+		}
+	}
+	return "", nil
+}
+
+// prometheusQueryResponse is the response of the instant query endpoint of Prometheus
+type prometheusQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// runPrometheusAnalysis evaluates the given Prometheus query, which passes if it returns at least one sample and every
+// sample is non-zero. The address of Prometheus has to be one of the allowed addresses.
+func runPrometheusAnalysis(ctx context.Context, analysis *argov1alpha1.ApplicationSetPrometheusAnalysis, allowedAddresses []string) (argov1alpha1.ApplicationSetStepAnalysisPhase, string, error) {
+	if !slices.Contains(allowedAddresses, strings.TrimSuffix(analysis.Address, "/")) && !slices.Contains(allowedAddresses, analysis.Address) {
+		return argov1alpha1.ApplicationSetStepAnalysisFailed, fmt.Sprintf("Prometheus address %q not allowed, must use one of the following: %s", analysis.Address, strings.Join(allowedAddresses, ", ")), nil
+	}
+	queryURL, err := url.Parse(analysis.Address)
+	if err != nil || queryURL.Host == "" {
+		return argov1alpha1.ApplicationSetStepAnalysisFailed, fmt.Sprintf("invalid Prometheus address %q", analysis.Address), nil
+	}
+	queryURL = queryURL.JoinPath("api", "v1", "query")
+	queryURL.RawQuery = url.Values{"query": []string{analysis.Query}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, queryURL.String(), http.NoBody)
+	if err != nil {
+		return "", "", fmt.Errorf("error creating Prometheus request: %w", err)
+	}
+	resp, err := prometheusAnalysisClient.Do(req)
+	if err != nil {
+		return "", "", fmt.Errorf("error querying Prometheus: %w", err)
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode >= http.StatusMultipleChoices && resp.StatusCode < http.StatusBadRequest {
+		return argov1alpha1.ApplicationSetStepAnalysisFailed, fmt.Sprintf("Prometheus responded with redirect status %d, which is not followed", resp.StatusCode), nil
+	}
+
+	var queryResponse prometheusQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&queryResponse); err != nil {
+		return "", "", fmt.Errorf("error decoding Prometheus response with status %d: %w", resp.StatusCode, err)
+	}
+	if queryResponse.Status != "success" {
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return "", "", fmt.Errorf("query to Prometheus failed: %s", queryResponse.Error)
+		}
+		return argov1alpha1.ApplicationSetStepAnalysisFailed, "Prometheus query failed: " + queryResponse.Error, nil
+	}
+
+	values, err := getPrometheusSampleValues(queryResponse.Data.ResultType, queryResponse.Data.Result)
+	if err != nil {
+		return argov1alpha1.ApplicationSetStepAnalysisFailed, err.Error(), nil
+	}
+	if len(values) == 0 {
+		return argov1alpha1.ApplicationSetStepAnalysisFailed, "Prometheus query returned no samples", nil
+	}
+	for _, value := range values {
+		if value == 0 || math.IsNaN(value) {
+			return argov1alpha1.ApplicationSetStepAnalysisFailed, fmt.Sprintf("Prometheus query returned the sample %v", value), nil
+		}
+	}
+	return argov1alpha1.ApplicationSetStepAnalysisSuccessful, fmt.Sprintf("Prometheus query returned %d non-zero samples", len(values)), nil
+}
+
+// getPrometheusSampleValues returns the values of the samples of a vector or scalar query result
+func getPrometheusSampleValues(resultType string, result json.RawMessage) ([]float64, error) {
+	var samples [][]any
+	switch resultType {
+	case "vector":
+		var vector []struct {
+			Value []any `json:"value"`
+		}
+		if err := json.Unmarshal(result, &vector); err != nil {
+			return nil, fmt.Errorf("invalid Prometheus vector result: %w", err)
+		}
+		for _, sample := range vector {
+			samples = append(samples, sample.Value)
+		}
+	case "scalar":
+		var scalar []any
+		if err := json.Unmarshal(result, &scalar); err != nil {
+			return nil, fmt.Errorf("invalid Prometheus scalar result: %w", err)
+		}
+		samples = append(samples, scalar)
+	default:
+		return nil, fmt.Errorf("unsupported Prometheus result type %q, the query must return a vector or a scalar", resultType)
+	}
+
+	values := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		if len(sample) != 2 {
+			return nil, errors.New("invalid Prometheus sample")
+		}
+		s, ok := sample[1].(string)
+		if !ok {
+			return nil, errors.New("invalid Prometheus sample value")
+		}
+		value, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Prometheus sample value %q: %w", s, err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
@@ -0,0 +1,272 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newStepAnalysisAppSet(analysis *v1alpha1.ApplicationSetStepAnalysis, appStatuses ...v1alpha1.ApplicationSetApplicationStatus) *v1alpha1.ApplicationSet {
+	return &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "appset", Namespace: "argocd", UID: "uid"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+					Steps: []v1alpha1.ApplicationSetRolloutStep{{Analysis: analysis}, {}},
+				},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: appStatuses},
+	}
+}
+
+// newStepAnalysisObjects returns the Application of the step analysis tests and its project, which permits Jobs in the
+// namespace of the ApplicationSet if permitted is true
+func newStepAnalysisObjects(permitted bool) []client.Object {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: v1alpha1.KubernetesInternalAPIServerAddr, Namespace: "guestbook"}},
+		},
+	}
+	if permitted {
+		proj.Spec.Destinations = append(proj.Spec.Destinations, v1alpha1.ApplicationDestination{Server: v1alpha1.KubernetesInternalAPIServerAddr, Namespace: "argocd"})
+	}
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app1", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+	}
+	return []client.Object{proj, app}
+}
+
+func TestUpdateStepAnalysesJob(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	appSet := newStepAnalysisAppSet(&v1alpha1.ApplicationSetStepAnalysis{CronJob: "smoke-test"},
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", TargetRevisions: []string{"abc"}},
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2", TargetRevisions: []string{"abc"}},
+	)
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "smoke-test", Namespace: "argocd"},
+		Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "smoke-test"}},
+			Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "test", Image: "curlimages/curl"}},
+			}}},
+		}},
+	}
+	kubeclientset := kubefake.NewClientset(cronJob)
+	r := ApplicationSetReconciler{
+		Client:          fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet).WithObjects(newStepAnalysisObjects(true)...).WithStatusSubresource(appSet).Build(),
+		Scheme:          scheme,
+		KubeClientset:   kubeclientset,
+		Recorder:        record.NewFakeRecorder(10),
+		ArgoCDNamespace: "argocd",
+	}
+	logCtx := log.NewEntry(log.StandardLogger())
+	appDependencyList := [][]string{{"app1"}, {"app2"}}
+
+	require.NoError(t, r.updateStepAnalyses(t.Context(), logCtx, appSet, appDependencyList))
+	stepAnalysis := appSet.Status.ApplicationStatus[0].StepAnalysis
+	require.NotNil(t, stepAnalysis)
+	assert.Equal(t, v1alpha1.ApplicationSetStepAnalysisRunning, stepAnalysis.Phase)
+	assert.Nil(t, appSet.Status.ApplicationStatus[1].StepAnalysis)
+	assert.Equal(t, stepAnalysisRequeueAfter, getStepAnalysisRequeueAfter(appSet))
+	assert.Equal(t, map[string]bool{"app1": true}, r.getAppsToSync(*appSet, appDependencyList, []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2"}},
+	}))
+
+	job, err := kubeclientset.BatchV1().Jobs("argocd").Get(t.Context(), stepAnalysis.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "smoke-test", job.Labels["app"])
+	assert.Equal(t, "curlimages/curl", job.Spec.Template.Spec.Containers[0].Image)
+	require.Len(t, job.OwnerReferences, 1)
+	assert.Equal(t, "appset", job.OwnerReferences[0].Name)
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	_, err = kubeclientset.BatchV1().Jobs("argocd").UpdateStatus(t.Context(), job, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, r.updateStepAnalyses(t.Context(), logCtx, appSet, appDependencyList))
+	stepAnalysis = appSet.Status.ApplicationStatus[0].StepAnalysis
+	require.NotNil(t, stepAnalysis)
+	assert.Equal(t, v1alpha1.ApplicationSetStepAnalysisSuccessful, stepAnalysis.Phase)
+	assert.Zero(t, getStepAnalysisRequeueAfter(appSet))
+	assert.Equal(t, map[string]bool{"app1": true, "app2": true}, r.getAppsToSync(*appSet, appDependencyList, []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2"}},
+	}))
+
+	// new target revisions start a new analysis run
+	appSet.Status.ApplicationStatus[0].TargetRevisions = []string{"def"}
+	assert.NotEqual(t, stepAnalysis.Name, getStepAnalysisRunName(appSet, 0, appSet.Status.ApplicationStatus, []int{0}))
+}
+
+func TestUpdateStepAnalysesJobNotPermitted(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	appSet := newStepAnalysisAppSet(&v1alpha1.ApplicationSetStepAnalysis{CronJob: "smoke-test"},
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", TargetRevisions: []string{"abc"}},
+	)
+	cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "smoke-test", Namespace: "argocd"}}
+	kubeclientset := kubefake.NewClientset(cronJob)
+	r := ApplicationSetReconciler{
+		Client:          fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet).WithObjects(newStepAnalysisObjects(false)...).WithStatusSubresource(appSet).Build(),
+		Scheme:          scheme,
+		KubeClientset:   kubeclientset,
+		Recorder:        record.NewFakeRecorder(10),
+		ArgoCDNamespace: "argocd",
+	}
+
+	require.NoError(t, r.updateStepAnalyses(t.Context(), log.NewEntry(log.StandardLogger()), appSet, [][]string{{"app1"}}))
+	stepAnalysis := appSet.Status.ApplicationStatus[0].StepAnalysis
+	require.NotNil(t, stepAnalysis)
+	assert.Equal(t, v1alpha1.ApplicationSetStepAnalysisFailed, stepAnalysis.Phase)
+	assert.Equal(t, `namespace "argocd" of the local cluster is not permitted in project "default"`, stepAnalysis.Message)
+
+	jobs, err := kubeclientset.BatchV1().Jobs("argocd").List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, jobs.Items, "no Job must be created if the project does not permit it")
+}
+
+func TestUpdateStepAnalysesJobNotControlled(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	appSet := newStepAnalysisAppSet(&v1alpha1.ApplicationSetStepAnalysis{CronJob: "smoke-test"},
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", TargetRevisions: []string{"abc"}},
+	)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: getStepAnalysisRunName(appSet, 0, appSet.Status.ApplicationStatus, []int{0}), Namespace: "argocd"},
+		Status:     batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}},
+	}
+	r := ApplicationSetReconciler{
+		Client:          fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet).WithObjects(newStepAnalysisObjects(true)...).WithStatusSubresource(appSet).Build(),
+		Scheme:          scheme,
+		KubeClientset:   kubefake.NewClientset(job),
+		Recorder:        record.NewFakeRecorder(10),
+		ArgoCDNamespace: "argocd",
+	}
+
+	require.NoError(t, r.updateStepAnalyses(t.Context(), log.NewEntry(log.StandardLogger()), appSet, [][]string{{"app1"}}))
+	stepAnalysis := appSet.Status.ApplicationStatus[0].StepAnalysis
+	require.NotNil(t, stepAnalysis)
+	assert.Equal(t, v1alpha1.ApplicationSetStepAnalysisFailed, stepAnalysis.Phase, "a Job which is not controlled by the ApplicationSet must not pass the analysis")
+	assert.Equal(t, "Job "+job.Name+" exists but is not controlled by the ApplicationSet", stepAnalysis.Message)
+}
+
+func TestRunPrometheusAnalysisAddressNotAllowed(t *testing.T) {
+	phase, message, err := runPrometheusAnalysis(t.Context(), &v1alpha1.ApplicationSetPrometheusAnalysis{Address: "http://169.254.169.254", Query: "up"}, []string{"http://prometheus.monitoring:9090"})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.ApplicationSetStepAnalysisFailed, phase)
+	assert.Equal(t, `Prometheus address "http://169.254.169.254" not allowed, must use one of the following: http://prometheus.monitoring:9090`, message)
+}
+
+func TestRunPrometheusAnalysis(t *testing.T) {
+	for _, cc := range []struct {
+		name            string
+		statusCode      int
+		body            string
+		expectedPhase   v1alpha1.ApplicationSetStepAnalysisPhase
+		expectedMessage string
+		expectedErr     string
+	}{
+		{
+			name:            "non-zero vector",
+			statusCode:      http.StatusOK,
+			body:            `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"1"]},{"metric":{},"value":[1700000000,"0.5"]}]}}`,
+			expectedPhase:   v1alpha1.ApplicationSetStepAnalysisSuccessful,
+			expectedMessage: "Prometheus query returned 2 non-zero samples",
+		},
+		{
+			name:            "zero scalar",
+			statusCode:      http.StatusOK,
+			body:            `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"0"]}}`,
+			expectedPhase:   v1alpha1.ApplicationSetStepAnalysisFailed,
+			expectedMessage: "Prometheus query returned the sample 0",
+		},
+		{
+			name:            "empty vector",
+			statusCode:      http.StatusOK,
+			body:            `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			expectedPhase:   v1alpha1.ApplicationSetStepAnalysisFailed,
+			expectedMessage: "Prometheus query returned no samples",
+		},
+		{
+			name:            "invalid query",
+			statusCode:      http.StatusBadRequest,
+			body:            `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			expectedPhase:   v1alpha1.ApplicationSetStepAnalysisFailed,
+			expectedMessage: "Prometheus query failed: parse error",
+		},
+		{
+			name:            "redirect",
+			statusCode:      http.StatusFound,
+			expectedPhase:   v1alpha1.ApplicationSetStepAnalysisFailed,
+			expectedMessage: "Prometheus responded with redirect status 302, which is not followed",
+		},
+		{
+			name:        "unavailable",
+			statusCode:  http.StatusServiceUnavailable,
+			body:        `{"status":"error","errorType":"unavailable","error":"shutting down"}`,
+			expectedErr: "query to Prometheus failed: shutting down",
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/query", r.URL.Path)
+				assert.Equal(t, "up", r.URL.Query().Get("query"))
+				if cc.statusCode == http.StatusFound {
+					w.Header().Set("Location", "http://169.254.169.254/api/v1/query")
+				}
+				w.WriteHeader(cc.statusCode)
+				_, _ = w.Write([]byte(cc.body))
+			}))
+			defer server.Close()
+
+			phase, message, err := runPrometheusAnalysis(t.Context(), &v1alpha1.ApplicationSetPrometheusAnalysis{Address: server.URL + "/", Query: "up"}, []string{server.URL})
+			if cc.expectedErr != "" {
+				require.EqualError(t, err, cc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, cc.expectedPhase, phase)
+			assert.Equal(t, cc.expectedMessage, message)
+		})
+	}
+}
+
+func TestIsApplicationStepCompleted(t *testing.T) {
+	appSet := newStepAnalysisAppSet(&v1alpha1.ApplicationSetStepAnalysis{CronJob: "smoke-test"})
+
+	assert.False(t, isApplicationStepCompleted(appSet, v1alpha1.ApplicationSetApplicationStatus{Status: v1alpha1.ProgressiveSyncProgressing, Step: "2"}))
+	assert.True(t, isApplicationStepCompleted(appSet, v1alpha1.ApplicationSetApplicationStatus{Status: v1alpha1.ProgressiveSyncHealthy, Step: "2"}))
+	assert.False(t, isApplicationStepCompleted(appSet, v1alpha1.ApplicationSetApplicationStatus{Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"}))
+	assert.False(t, isApplicationStepCompleted(appSet, v1alpha1.ApplicationSetApplicationStatus{
+		Status: v1alpha1.ProgressiveSyncHealthy, Step: "1",
+		StepAnalysis: &v1alpha1.ApplicationSetStepAnalysisStatus{Phase: v1alpha1.ApplicationSetStepAnalysisFailed},
+	}))
+	assert.True(t, isApplicationStepCompleted(appSet, v1alpha1.ApplicationSetApplicationStatus{
+		Status: v1alpha1.ProgressiveSyncHealthy, Step: "1",
+		StepAnalysis: &v1alpha1.ApplicationSetStepAnalysisStatus{Phase: v1alpha1.ApplicationSetStepAnalysisSuccessful},
+	}))
+}
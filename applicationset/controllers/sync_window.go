@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// updateSyncWindowsStatus evaluates the sync windows of the ApplicationSet at the given time, and records in its status
+// whether they block the changes of its Applications, and when they next allow them. It returns whether the changes
+// are blocked.
+func (r *ApplicationSetReconciler) updateSyncWindowsStatus(ctx context.Context, logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, now time.Time) (bool, error) {
+	var status *argov1alpha1.ApplicationSetSyncWindowsStatus
+	if len(appset.Spec.SyncWindows) > 0 {
+		allowed, err := appset.Spec.SyncWindowsAllowChanges(now)
+		if err != nil {
+			return false, err
+		}
+		status = &argov1alpha1.ApplicationSetSyncWindowsStatus{Blocked: !allowed}
+		if !allowed {
+			nextWindow, err := appset.Spec.NextSyncWindow(now)
+			if err != nil {
+				return false, err
+			}
+			if nextWindow != nil {
+				status.NextWindow = &metav1.Time{Time: *nextWindow}
+			}
+		}
+	}
+
+	previous := appset.Status.SyncWindows
+	if syncWindowsStatusEqual(previous, status) {
+		return status != nil && status.Blocked, nil
+	}
+
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.SyncWindows = status
+
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(appset)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set status: %v", err)
+		return false, fmt.Errorf("unable to set application set status: %w", err)
+	}
+
+	wasBlocked := previous != nil && previous.Blocked
+	switch {
+	case status != nil && status.Blocked && !wasBlocked:
+		nextWindow := "no upcoming window"
+		if status.NextWindow != nil {
+			nextWindow = "the next window opens at " + status.NextWindow.UTC().Format(time.RFC3339)
+		}
+		r.recordEvent(ctx, appset, corev1.EventTypeNormal, "SyncWindowsBlocked", "The sync windows deny the changes of the Applications, %s", nextWindow)
+	case (status == nil || !status.Blocked) && wasBlocked:
+		r.recordEvent(ctx, appset, corev1.EventTypeNormal, "SyncWindowsAllowed", "The sync windows allow the changes of the Applications")
+	}
+	return status != nil && status.Blocked, nil
+}
+
+func syncWindowsStatusEqual(a, b *argov1alpha1.ApplicationSetSyncWindowsStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Blocked == b.Blocked && a.NextWindow.Equal(b.NextWindow)
+}
+
+// isSyncWindowsBlocked returns whether the sync windows of the ApplicationSet block the changes of its Applications,
+// as recorded in its status
+func isSyncWindowsBlocked(appset *argov1alpha1.ApplicationSet) bool {
+	return appset.Status.SyncWindows != nil && appset.Status.SyncWindows.Blocked
+}
+
+// deferRolloutPromotions removes the Waiting Applications from appsToSync while the sync windows of the ApplicationSet
+// block the changes, so that the RollingSync steps are not promoted. The Applications which are already rolling out
+// keep syncing.
+func deferRolloutPromotions(logCtx *log.Entry, appset *argov1alpha1.ApplicationSet, appsToSync map[string]bool) {
+	if !isSyncWindowsBlocked(appset) {
+		return
+	}
+	for _, appStatus := range appset.Status.ApplicationStatus {
+		if appStatus.Status == argov1alpha1.ProgressiveSyncWaiting && appsToSync[appStatus.Application] {
+			logCtx.WithField("app", appStatus.Application).Info("sync windows deny the promotion of the application")
+			delete(appsToSync, appStatus.Application)
+		}
+	}
+}
+
+// getSyncWindowsRequeueAfter returns the delay after which the ApplicationSet is reconciled again to apply the changes
+// deferred by its sync windows, once the next window opens
+func getSyncWindowsRequeueAfter(appset *argov1alpha1.ApplicationSet, now time.Time) time.Duration {
+	if !isSyncWindowsBlocked(appset) || appset.Status.SyncWindows.NextWindow == nil {
+		return 0
+	}
+	requeueAfter := appset.Status.SyncWindows.NextWindow.Sub(now)
+	if requeueAfter < time.Second {
+		return time.Second
+	}
+	return requeueAfter
+}
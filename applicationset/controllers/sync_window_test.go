@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestUpdateSyncWindowsStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			SyncWindows: []v1alpha1.ApplicationSetSyncWindow{{Kind: "deny", Schedule: "0 2 * * 5", Duration: "4h"}},
+		},
+	}
+	crtClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(appSet).WithStatusSubresource(appSet).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := ApplicationSetReconciler{
+		Client:   crtClient,
+		Scheme:   scheme,
+		Recorder: recorder,
+	}
+	logCtx := log.NewEntry(log.StandardLogger())
+	friday := func(hour int) time.Time {
+		return time.Date(2026, 10, 16, hour, 0, 0, 0, time.UTC)
+	}
+
+	// the changes are blocked during the deny window
+	blocked, err := r.updateSyncWindowsStatus(t.Context(), logCtx, appSet, friday(3))
+	require.NoError(t, err)
+	assert.True(t, blocked)
+	retrievedAppSet := &v1alpha1.ApplicationSet{}
+	require.NoError(t, crtClient.Get(t.Context(), crtclient.ObjectKeyFromObject(appSet), retrievedAppSet))
+	require.NotNil(t, retrievedAppSet.Status.SyncWindows)
+	assert.True(t, retrievedAppSet.Status.SyncWindows.Blocked)
+	require.NotNil(t, retrievedAppSet.Status.SyncWindows.NextWindow)
+	assert.Equal(t, friday(6), retrievedAppSet.Status.SyncWindows.NextWindow.UTC())
+	assert.Equal(t, "Normal SyncWindowsBlocked The sync windows deny the changes of the Applications, the next window opens at 2026-10-16T06:00:00Z", <-recorder.Events)
+
+	// the status is unchanged while the window is active
+	blocked, err = r.updateSyncWindowsStatus(t.Context(), logCtx, appSet, friday(4))
+	require.NoError(t, err)
+	assert.True(t, blocked)
+	assert.Empty(t, recorder.Events)
+
+	// the changes are allowed once the window ends
+	blocked, err = r.updateSyncWindowsStatus(t.Context(), logCtx, appSet, friday(6))
+	require.NoError(t, err)
+	assert.False(t, blocked)
+	require.NoError(t, crtClient.Get(t.Context(), crtclient.ObjectKeyFromObject(appSet), retrievedAppSet))
+	assert.Equal(t, &v1alpha1.ApplicationSetSyncWindowsStatus{Blocked: false}, retrievedAppSet.Status.SyncWindows)
+	assert.Equal(t, "Normal SyncWindowsAllowed The sync windows allow the changes of the Applications", <-recorder.Events)
+
+	// the status is removed with the windows
+	appSet.Spec.SyncWindows = nil
+	blocked, err = r.updateSyncWindowsStatus(t.Context(), logCtx, appSet, friday(3))
+	require.NoError(t, err)
+	assert.False(t, blocked)
+	require.NoError(t, crtClient.Get(t.Context(), crtclient.ObjectKeyFromObject(appSet), retrievedAppSet))
+	assert.Nil(t, retrievedAppSet.Status.SyncWindows)
+	assert.Empty(t, recorder.Events)
+
+	// invalid windows are reported
+	appSet.Spec.SyncWindows = []v1alpha1.ApplicationSetSyncWindow{{Kind: "deny", Schedule: "0 2 * * 5", Duration: "a while"}}
+	_, err = r.updateSyncWindowsStatus(t.Context(), logCtx, appSet, friday(3))
+	require.ErrorContains(t, err, "cannot parse duration 'a while'")
+}
+
+func TestDeferRolloutPromotions(t *testing.T) {
+	appSet := &v1alpha1.ApplicationSet{
+		Status: v1alpha1.ApplicationSetStatus{
+			ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app-waiting", Status: v1alpha1.ProgressiveSyncWaiting},
+				{Application: "app-pending", Status: v1alpha1.ProgressiveSyncPending},
+				{Application: "app-progressing", Status: v1alpha1.ProgressiveSyncProgressing},
+			},
+		},
+	}
+	logCtx := log.NewEntry(log.StandardLogger())
+	appsToSync := func() map[string]bool {
+		return map[string]bool{"app-waiting": true, "app-pending": true, "app-progressing": true}
+	}
+
+	allowed := appsToSync()
+	deferRolloutPromotions(logCtx, appSet, allowed)
+	assert.Equal(t, appsToSync(), allowed)
+
+	appSet.Status.SyncWindows = &v1alpha1.ApplicationSetSyncWindowsStatus{Blocked: true}
+	blocked := appsToSync()
+	deferRolloutPromotions(logCtx, appSet, blocked)
+	assert.Equal(t, map[string]bool{"app-pending": true, "app-progressing": true}, blocked)
+}
+
+func TestGetSyncWindowsRequeueAfter(t *testing.T) {
+	now := time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC)
+	appSet := &v1alpha1.ApplicationSet{}
+	assert.Equal(t, time.Duration(0), getSyncWindowsRequeueAfter(appSet, now))
+
+	appSet.Status.SyncWindows = &v1alpha1.ApplicationSetSyncWindowsStatus{Blocked: true}
+	assert.Equal(t, time.Duration(0), getSyncWindowsRequeueAfter(appSet, now))
+
+	appSet.Status.SyncWindows.NextWindow = &metav1.Time{Time: now.Add(3 * time.Hour)}
+	assert.Equal(t, 3*time.Hour, getSyncWindowsRequeueAfter(appSet, now))
+
+	appSet.Status.SyncWindows.NextWindow = &metav1.Time{Time: now.Add(-time.Minute)}
+	assert.Equal(t, time.Second, getSyncWindowsRequeueAfter(appSet, now))
+
+	appSet.Status.SyncWindows.Blocked = false
+	assert.Equal(t, time.Duration(0), getSyncWindowsRequeueAfter(appSet, now))
+}
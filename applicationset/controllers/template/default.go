@@ -0,0 +1,37 @@
+package template
+
+import (
+	"fmt"
+
+	"dario.cat/mergo"
+	"sigs.k8s.io/yaml"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ParseDefaultTemplate parses the YAML of the default template configured for the ApplicationSet controller. It
+// returns nil if no default template is configured.
+func ParseDefaultTemplate(data string) (*argov1alpha1.ApplicationSetTemplate, error) {
+	if data == "" {
+		return nil, nil
+	}
+	defaultTemplate := &argov1alpha1.ApplicationSetTemplate{}
+	if err := yaml.UnmarshalStrict([]byte(data), defaultTemplate); err != nil {
+		return nil, fmt.Errorf("error parsing default ApplicationSet template: %w", err)
+	}
+	return defaultTemplate, nil
+}
+
+// ApplyDefaultTemplate returns a copy of the given ApplicationSet whose template is merged with the default template.
+// Fields set in the template of the ApplicationSet take precedence, maps like labels are merged key by key and lists
+// like finalizers are only taken from the default template if the ApplicationSet does not set them.
+func ApplyDefaultTemplate(appSet *argov1alpha1.ApplicationSet, defaultTemplate *argov1alpha1.ApplicationSetTemplate) (*argov1alpha1.ApplicationSet, error) {
+	appSet = appSet.DeepCopy()
+	if defaultTemplate == nil {
+		return appSet, nil
+	}
+	if err := mergo.Merge(&appSet.Spec.Template, defaultTemplate.DeepCopy()); err != nil {
+		return nil, fmt.Errorf("error merging default ApplicationSet template: %w", err)
+	}
+	return appSet, nil
+}
@@ -0,0 +1,94 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestParseDefaultTemplate(t *testing.T) {
+	defaultTemplate, err := ParseDefaultTemplate("")
+	require.NoError(t, err)
+	assert.Nil(t, defaultTemplate)
+
+	defaultTemplate, err = ParseDefaultTemplate(`
+metadata:
+  labels:
+    team: platform
+spec:
+  syncPolicy:
+    syncOptions:
+    - CreateNamespace=true
+`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "platform"}, defaultTemplate.Labels)
+	assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=true"}, defaultTemplate.Spec.SyncPolicy.SyncOptions)
+
+	_, err = ParseDefaultTemplate(`
+metadata:
+  lables:
+    team: platform
+`)
+	require.ErrorContains(t, err, "error parsing default ApplicationSet template")
+}
+
+func TestApplyDefaultTemplate(t *testing.T) {
+	defaultTemplate := &v1alpha1.ApplicationSetTemplate{
+		ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+			Labels:     map[string]string{"team": "platform", "env": "default"},
+			Finalizers: []string{v1alpha1.ResourcesFinalizerName},
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			SyncPolicy: &v1alpha1.SyncPolicy{
+				Automated:   &v1alpha1.SyncPolicyAutomated{SelfHeal: true},
+				SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"},
+			},
+		},
+	}
+	appSet := &v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name:   "{{.name}}",
+					Labels: map[string]string{"env": "{{.env}}"},
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					Project: "my-project",
+					SyncPolicy: &v1alpha1.SyncPolicy{
+						Automated: &v1alpha1.SyncPolicyAutomated{Prune: true},
+					},
+				},
+			},
+		},
+	}
+
+	got, err := ApplyDefaultTemplate(appSet, defaultTemplate)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.ApplicationSetTemplate{
+		ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+			Name:       "{{.name}}",
+			Labels:     map[string]string{"team": "platform", "env": "{{.env}}"},
+			Finalizers: []string{v1alpha1.ResourcesFinalizerName},
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "my-project",
+			SyncPolicy: &v1alpha1.SyncPolicy{
+				Automated:   &v1alpha1.SyncPolicyAutomated{Prune: true, SelfHeal: true},
+				SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"},
+			},
+		},
+	}, got.Spec.Template)
+
+	// neither the ApplicationSet nor the default template are modified
+	assert.Equal(t, map[string]string{"env": "{{.env}}"}, appSet.Spec.Template.Labels)
+	assert.Nil(t, appSet.Spec.Template.Spec.SyncPolicy.SyncOptions)
+	assert.Equal(t, map[string]string{"team": "platform", "env": "default"}, defaultTemplate.Labels)
+
+	got, err = ApplyDefaultTemplate(appSet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, appSet.Spec.Template, got.Spec.Template)
+}
@@ -0,0 +1,152 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ParametersCache holds the parameters last successfully generated by the generators of an ApplicationSet. While a
+// generator fails, its cached parameters are used instead, so that transient errors, e.g. of an SCM provider API, do not
+// cause the generated Applications to be changed or deleted.
+type ParametersCache struct {
+	// Entries are the cached parameters, as stored in the status of the ApplicationSet
+	Entries []argov1alpha1.ApplicationSetCachedParameters
+	// Used describes the generators whose cached parameters were used
+	Used []UsedCachedParameters
+	// Changed is true if the entries changed
+	Changed bool
+}
+
+// UsedCachedParameters describes a generator which failed to generate parameters and whose cached parameters were used
+type UsedCachedParameters struct {
+	// Generator is the index of the generator, starting at 0
+	Generator int
+	// GeneratedAt is the time the cached parameters were generated
+	GeneratedAt metav1.Time
+	// Err is the error of the generator
+	Err error
+}
+
+// transform generates the parameters of the requested generator, which is the generator of the given index. The
+// parameters are cached if they are generated successfully, and the cached parameters are returned otherwise, if any.
+func (c *ParametersCache) transform(ctx context.Context, logCtx *log.Entry, index int, requestedGenerator argov1alpha1.ApplicationSetGenerator, allGenerators map[string]generators.Generator, applicationSetInfo *argov1alpha1.ApplicationSet, client client.Client) ([]generators.TransformResult, error) {
+	results, err := generators.Transform(ctx, requestedGenerator, allGenerators, applicationSetInfo.Spec.Template, applicationSetInfo, map[string]any{}, client)
+
+	generatorHash, hashErr := utils.GeneratorHash(requestedGenerator)
+	if hashErr != nil {
+		logCtx.WithError(hashErr).WithField("generator", index).Warn("unable to cache the parameters of the generator")
+		return results, err
+	}
+	if err == nil {
+		if storeErr := c.store(index, generatorHash, results); storeErr != nil {
+			logCtx.WithError(storeErr).WithField("generator", index).Warn("unable to cache the parameters of the generator")
+		}
+		return results, nil
+	}
+
+	entry := c.find(index)
+	if entry == nil || entry.GeneratorHash != generatorHash {
+		return results, err
+	}
+	cached, loadErr := loadCachedResults(entry, requestedGenerator, allGenerators, applicationSetInfo.Spec.Template)
+	if loadErr != nil {
+		logCtx.WithError(loadErr).WithField("generator", index).Warn("unable to use the cached parameters of the generator")
+		return results, err
+	}
+	logCtx.WithError(err).WithFields(log.Fields{"generator": index, "generatedAt": entry.GeneratedAt}).
+		Warn("using the cached parameters of a generator which failed to generate parameters")
+	c.Used = append(c.Used, UsedCachedParameters{Generator: index, GeneratedAt: entry.GeneratedAt, Err: err})
+	return cached, nil
+}
+
+// store caches the parameters of the given results of the generator of the given index, unless they are cached already
+func (c *ParametersCache) store(index int, generatorHash string, results []generators.TransformResult) error {
+	params := make([][]map[string]any, 0, len(results))
+	var count int64
+	for _, result := range results {
+		params = append(params, result.Params)
+		count += int64(len(result.Params))
+	}
+	encoded, hash, err := utils.EncodeParameterSets(params)
+	if err != nil {
+		return err
+	}
+	if entry := c.find(index); entry != nil && entry.GeneratorHash == generatorHash && entry.Hash == hash {
+		return nil
+	}
+
+	entry := argov1alpha1.ApplicationSetCachedParameters{
+		Generator:         int64(index),
+		GeneratorHash:     generatorHash,
+		Hash:              hash,
+		GeneratedAt:       metav1.NewTime(time.Now()),
+		ParameterSetCount: count,
+		Parameters:        encoded,
+	}
+	entries := []argov1alpha1.ApplicationSetCachedParameters{entry}
+	for _, e := range c.Entries {
+		if e.Generator != entry.Generator {
+			entries = append(entries, e)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Generator < entries[j].Generator
+	})
+	c.Entries = entries
+	c.Changed = true
+	return nil
+}
+
+// prune removes the entries of the generators which no longer exist
+func (c *ParametersCache) prune(generatorCount int) {
+	entries := make([]argov1alpha1.ApplicationSetCachedParameters, 0, len(c.Entries))
+	for _, e := range c.Entries {
+		if e.Generator < int64(generatorCount) {
+			entries = append(entries, e)
+		}
+	}
+	if len(entries) != len(c.Entries) {
+		c.Entries = entries
+		c.Changed = true
+	}
+}
+
+func (c *ParametersCache) find(index int) *argov1alpha1.ApplicationSetCachedParameters {
+	for i := range c.Entries {
+		if c.Entries[i].Generator == int64(index) {
+			return &c.Entries[i]
+		}
+	}
+	return nil
+}
+
+// loadCachedResults returns the results of the requested generator with the cached parameters of the given entry
+func loadCachedResults(entry *argov1alpha1.ApplicationSetCachedParameters, requestedGenerator argov1alpha1.ApplicationSetGenerator, allGenerators map[string]generators.Generator, baseTemplate argov1alpha1.ApplicationSetTemplate) ([]generators.TransformResult, error) {
+	params, err := utils.DecodeParameterSets(entry.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := generators.MergeGeneratorTemplates(requestedGenerator, allGenerators, baseTemplate)
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) != len(params) {
+		return nil, fmt.Errorf("the cached parameters are generated by %d generators, but the generator consists of %d generators", len(params), len(templates))
+	}
+	results := make([]generators.TransformResult, 0, len(params))
+	for i := range params {
+		results = append(results, generators.TransformResult{Params: params[i], Template: templates[i]})
+	}
+	return results, nil
+}
@@ -2,9 +2,11 @@ package template
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	kjson "sigs.k8s.io/json"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -21,7 +23,7 @@ func applyTemplatePatch(app *appv1.Application, templatePatch string) (*appv1.Ap
 		return nil, fmt.Errorf("error while converting template to json %q: %w", convertedTemplatePatch, err)
 	}
 
-	if err := json.Unmarshal([]byte(convertedTemplatePatch), &appv1.Application{}); err != nil {
+	if err := validateApplicationJSON([]byte(convertedTemplatePatch)); err != nil {
 		return nil, fmt.Errorf("invalid templatePatch %q: %w", convertedTemplatePatch, err)
 	}
 
@@ -41,3 +43,14 @@ func applyTemplatePatch(app *appv1.Application, templatePatch string) (*appv1.Ap
 
 	return &finalApp, nil
 }
+
+// validateApplicationJSON validates the given Application, or patch of an Application, against the Application schema.
+// Unknown and duplicated fields are reported as errors, since they would otherwise be silently dropped from the
+// generated Application.
+func validateApplicationJSON(data []byte) error {
+	strictErrs, err := kjson.UnmarshalStrict(data, &appv1.Application{}, kjson.DisallowUnknownFields, kjson.DisallowDuplicateFields)
+	if err != nil {
+		return err
+	}
+	return errors.Join(strictErrs...)
+}
@@ -0,0 +1,91 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// patchScriptParamsVariable is the name of the variable holding the parameter set in template patch scripts
+	patchScriptParamsVariable = "params"
+	// patchScriptAppVariable is the name of the variable holding the rendered Application in template patch scripts
+	patchScriptAppVariable = "app"
+	// patchScriptCostLimit limits the cost of evaluating a template patch script, so that an expensive script cannot
+	// block the reconciliation of the ApplicationSet controller
+	patchScriptCostLimit = 1000000
+)
+
+var patchScriptValueType = reflect.TypeOf(&structpb.Value{})
+
+// compileTemplatePatchScript compiles the templatePatchScript CEL expression of an ApplicationSet
+func compileTemplatePatchScript(script string) (cel.Program, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(patchScriptParamsVariable, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(patchScriptAppVariable, cel.MapType(cel.StringType, cel.DynType)),
+		ext.Strings(),
+		ext.Lists(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(script)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	switch ast.OutputType().Kind() {
+	case types.MapKind, types.DynKind, types.NullTypeKind:
+	default:
+		return nil, fmt.Errorf("templatePatchScript must evaluate to a map, but evaluates to %s", ast.OutputType())
+	}
+	return env.Program(ast, cel.CostLimit(patchScriptCostLimit))
+}
+
+// applyTemplatePatchScript evaluates the compiled templatePatchScript for the given Application and parameters, and
+// applies the resulting patch to the Application. The Application is returned unchanged if the script evaluates to
+// null or an empty map.
+func applyTemplatePatchScript(program cel.Program, app *appv1.Application, params map[string]any) (*appv1.Application, error) {
+	appJSON, err := json.Marshal(app)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling Application: %w", err)
+	}
+	var appValue map[string]any
+	if err := json.Unmarshal(appJSON, &appValue); err != nil {
+		return nil, fmt.Errorf("error unmarshalling Application: %w", err)
+	}
+
+	val, _, err := program.Eval(map[string]any{
+		patchScriptParamsVariable: params,
+		patchScriptAppVariable:    appValue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating templatePatchScript: %w", err)
+	}
+	native, err := val.ConvertToNative(patchScriptValueType)
+	if err != nil {
+		return nil, fmt.Errorf("error converting result of templatePatchScript: %w", err)
+	}
+
+	switch patch := native.(*structpb.Value).AsInterface().(type) {
+	case nil:
+		return app, nil
+	case map[string]any:
+		if len(patch) == 0 {
+			return app, nil
+		}
+		patchJSON, err := json.Marshal(patch)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling result of templatePatchScript: %w", err)
+		}
+		return applyTemplatePatch(app, string(patchJSON))
+	default:
+		return nil, fmt.Errorf("templatePatchScript must evaluate to a map, but evaluates to %s", val.Type())
+	}
+}
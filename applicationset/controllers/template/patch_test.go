@@ -247,3 +247,19 @@ func TestError(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, result)
 }
+
+func TestError_UnknownFields(t *testing.T) {
+	app := &appv1.Application{}
+
+	result, err := applyTemplatePatch(app, `
+spec:
+  source:
+    pth: guestbook
+  syncPolicy:
+    automated:
+      prun: true
+`)
+	require.ErrorContains(t, err, `unknown field "spec.source.pth"`)
+	require.ErrorContains(t, err, `unknown field "spec.syncPolicy.automated.prun"`)
+	require.Nil(t, result)
+}
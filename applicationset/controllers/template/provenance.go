@@ -0,0 +1,109 @@
+package template
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// Provenance label names, which are prefixed with the configured prefix
+const (
+	ProvenanceLabelAppSetName      = "appset-name"
+	ProvenanceLabelAppSetNamespace = "appset-namespace"
+	ProvenanceLabelGenerator       = "generator"
+	ProvenanceLabelGeneratorIndex  = "generator-index"
+	ProvenanceLabelParamsHash      = "params-hash"
+)
+
+// paramsHashLength is the number of hex characters of the parameters hash used as label value
+const paramsHashLength = 16
+
+// validateProvenanceLabelPrefix returns an error if the prefix does not result in valid label keys
+func validateProvenanceLabelPrefix(prefix string) error {
+	for _, name := range []string{ProvenanceLabelAppSetName, ProvenanceLabelAppSetNamespace, ProvenanceLabelGenerator, ProvenanceLabelGeneratorIndex, ProvenanceLabelParamsHash} {
+		if errs := validation.IsQualifiedName(prefix + name); len(errs) > 0 {
+			return fmt.Errorf("invalid provenance label prefix %q: %s", prefix, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// provenanceLabels returns the provenance labels of an Application generated by the generator with the given index of
+// the ApplicationSet from the given parameters. Labels whose value is not a valid label value, e.g. the name of an
+// ApplicationSet longer than 63 characters, are omitted.
+func provenanceLabels(logCtx *log.Entry, applicationSetInfo *argov1alpha1.ApplicationSet, generatorIndex int, generator argov1alpha1.ApplicationSetGenerator, params map[string]any) (map[string]string, error) {
+	paramsHash, err := hashParams(params)
+	if err != nil {
+		return nil, err
+	}
+	prefix := applicationSetInfo.Spec.ProvenanceLabels.GetPrefix()
+	values := map[string]string{
+		ProvenanceLabelAppSetName:      applicationSetInfo.Name,
+		ProvenanceLabelAppSetNamespace: applicationSetInfo.Namespace,
+		ProvenanceLabelGenerator:       generatorType(generator),
+		ProvenanceLabelGeneratorIndex:  strconv.Itoa(generatorIndex),
+		ProvenanceLabelParamsHash:      paramsHash,
+	}
+	labels := make(map[string]string, len(values))
+	for name, value := range values {
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			logCtx.Warnf("omitting provenance label %s%s: invalid value %q: %s", prefix, name, value, strings.Join(errs, ", "))
+			continue
+		}
+		labels[prefix+name] = value
+	}
+	return labels, nil
+}
+
+// hashParams returns a hash identifying the parameters an Application was generated from
+func hashParams(params map[string]any) (string, error) {
+	// map keys are sorted when marshaling, so equal parameters result in the same hash
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling params to compute provenance labels: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:paramsHashLength], nil
+}
+
+// generatorType returns the name of the generator as used in the ApplicationSet spec, e.g. `list` or `pullRequest`
+func generatorType(generator argov1alpha1.ApplicationSetGenerator) string {
+	switch {
+	case generator.List != nil:
+		return "list"
+	case generator.Clusters != nil:
+		return "clusters"
+	case generator.Git != nil:
+		return "git"
+	case generator.SCMProvider != nil:
+		return "scmProvider"
+	case generator.ClusterDecisionResource != nil:
+		return "clusterDecisionResource"
+	case generator.PullRequest != nil:
+		return "pullRequest"
+	case generator.Matrix != nil:
+		return "matrix"
+	case generator.Merge != nil:
+		return "merge"
+	case generator.Plugin != nil:
+		return "plugin"
+	case generator.Terraform != nil:
+		return "terraform"
+	case generator.CloudInventory != nil:
+		return "cloudInventory"
+	case generator.KubernetesResource != nil:
+		return "kubernetesResource"
+	case generator.OCI != nil:
+		return "oci"
+	default:
+		return "unknown"
+	}
+}
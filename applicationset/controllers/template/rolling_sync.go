@@ -0,0 +1,122 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// applyRollingSyncMatchValues resolves the templated values of the matchExpressions of the RollingSync steps of the
+// given ApplicationSet against the parameters the given Application is generated from, and records them in an
+// annotation of the Application, so that its step can be determined without the parameters
+func applyRollingSyncMatchValues(renderer utils.Renderer, app *argov1alpha1.Application, appset *argov1alpha1.ApplicationSet, params map[string]any) error {
+	steps := rollingSyncSteps(appset)
+	if !hasTemplatedMatchValues(steps) {
+		return nil
+	}
+
+	resolved := make([][][]string, 0, len(steps))
+	for i, step := range steps {
+		stepValues := make([][]string, 0, len(step.MatchExpressions))
+		for _, matchExpression := range step.MatchExpressions {
+			values := make([]string, 0, len(matchExpression.Values))
+			for _, value := range matchExpression.Values {
+				if isTemplated(value) {
+					var err error
+					value, err = renderer.Replace(value, params, appset.Spec.GoTemplate, appset.Spec.GoTemplateOptions)
+					if err != nil {
+						return fmt.Errorf("error rendering the values of the matchExpression %q of RollingSync step %d: %w", matchExpression.Key, i+1, err)
+					}
+				}
+				values = append(values, value)
+			}
+			stepValues = append(stepValues, values)
+		}
+		resolved = append(resolved, stepValues)
+	}
+
+	data, err := json.Marshal(resolved)
+	if err != nil {
+		return fmt.Errorf("error marshaling the values of the RollingSync matchExpressions: %w", err)
+	}
+	if app.Annotations == nil {
+		app.Annotations = map[string]string{}
+	}
+	app.Annotations[common.AnnotationApplicationSetRollingSyncMatchValues] = string(data)
+	return nil
+}
+
+// RollingSyncMatchExpressions returns the matchExpressions of each RollingSync step of the given ApplicationSet, with
+// the templated values resolved for the given generated Application. The values are left unresolved if the Application
+// does not record valid resolved values, e.g. because it was generated before the steps were changed.
+func RollingSyncMatchExpressions(appset *argov1alpha1.ApplicationSet, app *argov1alpha1.Application) [][]argov1alpha1.ApplicationMatchExpression {
+	steps := rollingSyncSteps(appset)
+	matchExpressions := make([][]argov1alpha1.ApplicationMatchExpression, 0, len(steps))
+	for _, step := range steps {
+		matchExpressions = append(matchExpressions, step.MatchExpressions)
+	}
+	if !hasTemplatedMatchValues(steps) {
+		return matchExpressions
+	}
+
+	annotation, ok := app.Annotations[common.AnnotationApplicationSetRollingSyncMatchValues]
+	if !ok {
+		return matchExpressions
+	}
+	var resolved [][][]string
+	if err := json.Unmarshal([]byte(annotation), &resolved); err != nil || !matchesSteps(resolved, steps) {
+		log.WithField("application", app.Name).Warnf("ignoring the invalid %s annotation", common.AnnotationApplicationSetRollingSyncMatchValues)
+		return matchExpressions
+	}
+
+	for i := range matchExpressions {
+		stepMatchExpressions := slices.Clone(matchExpressions[i])
+		for j := range stepMatchExpressions {
+			stepMatchExpressions[j].Values = resolved[i][j]
+		}
+		matchExpressions[i] = stepMatchExpressions
+	}
+	return matchExpressions
+}
+
+func rollingSyncSteps(appset *argov1alpha1.ApplicationSet) []argov1alpha1.ApplicationSetRolloutStep {
+	if appset.Spec.Strategy == nil || appset.Spec.Strategy.Type != "RollingSync" || appset.Spec.Strategy.RollingSync == nil {
+		return nil
+	}
+	return appset.Spec.Strategy.RollingSync.Steps
+}
+
+func hasTemplatedMatchValues(steps []argov1alpha1.ApplicationSetRolloutStep) bool {
+	for _, step := range steps {
+		for _, matchExpression := range step.MatchExpressions {
+			if slices.ContainsFunc(matchExpression.Values, isTemplated) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isTemplated(value string) bool {
+	return strings.Contains(value, "{{")
+}
+
+// matchesSteps returns true if the given resolved values have the shape of the matchExpressions of the given steps
+func matchesSteps(resolved [][][]string, steps []argov1alpha1.ApplicationSetRolloutStep) bool {
+	if len(resolved) != len(steps) {
+		return false
+	}
+	for i, step := range steps {
+		if len(resolved[i]) != len(step.MatchExpressions) {
+			return false
+		}
+	}
+	return true
+}
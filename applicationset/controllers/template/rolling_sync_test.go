@@ -0,0 +1,90 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newRollingSyncAppSet(goTemplate bool, values ...string) *argov1alpha1.ApplicationSet {
+	return &argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "appset", Namespace: "argocd"},
+		Spec: argov1alpha1.ApplicationSetSpec{
+			GoTemplate: goTemplate,
+			Strategy: &argov1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &argov1alpha1.ApplicationSetRolloutStrategy{
+					Steps: []argov1alpha1.ApplicationSetRolloutStep{
+						{MatchExpressions: []argov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: values}}},
+						{MatchExpressions: []argov1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"prod"}}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestApplyRollingSyncMatchValues(t *testing.T) {
+	t.Run("templated values are resolved", func(t *testing.T) {
+		appset := newRollingSyncAppSet(true, "dev", "{{ .firstEnv }}")
+		app := &argov1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app"}}
+
+		err := applyRollingSyncMatchValues(&utils.Render{}, app, appset, map[string]any{"firstEnv": "qa"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `[[["dev","qa"]],[["prod"]]]`, app.Annotations[common.AnnotationApplicationSetRollingSyncMatchValues])
+
+		matchExpressions := RollingSyncMatchExpressions(appset, app)
+		require.Len(t, matchExpressions, 2)
+		assert.Equal(t, []string{"dev", "qa"}, matchExpressions[0][0].Values)
+		assert.Equal(t, []string{"prod"}, matchExpressions[1][0].Values)
+		// the ApplicationSet is left untouched
+		assert.Equal(t, []string{"dev", "{{ .firstEnv }}"}, appset.Spec.Strategy.RollingSync.Steps[0].MatchExpressions[0].Values)
+	})
+
+	t.Run("fasttemplate values are resolved", func(t *testing.T) {
+		appset := newRollingSyncAppSet(false, "{{firstEnv}}")
+		app := &argov1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app"}}
+
+		err := applyRollingSyncMatchValues(&utils.Render{}, app, appset, map[string]any{"firstEnv": "qa"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"qa"}, RollingSyncMatchExpressions(appset, app)[0][0].Values)
+	})
+
+	t.Run("static values are not recorded", func(t *testing.T) {
+		appset := newRollingSyncAppSet(true, "dev")
+		app := &argov1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app"}}
+
+		err := applyRollingSyncMatchValues(&utils.Render{}, app, appset, map[string]any{})
+		require.NoError(t, err)
+		assert.NotContains(t, app.Annotations, common.AnnotationApplicationSetRollingSyncMatchValues)
+		assert.Equal(t, []string{"dev"}, RollingSyncMatchExpressions(appset, app)[0][0].Values)
+	})
+
+	t.Run("invalid templates fail", func(t *testing.T) {
+		appset := newRollingSyncAppSet(true, "{{ .firstEnv")
+		app := &argov1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app"}}
+
+		err := applyRollingSyncMatchValues(&utils.Render{}, app, appset, map[string]any{})
+		require.ErrorContains(t, err, `error rendering the values of the matchExpression "env" of RollingSync step 1`)
+	})
+}
+
+func TestRollingSyncMatchExpressionsIgnoresInvalidAnnotation(t *testing.T) {
+	appset := newRollingSyncAppSet(true, "{{ .firstEnv }}")
+
+	for _, annotation := range []string{`not json`, `[[["qa"]]]`, `[[["qa"]],[]]`} {
+		app := &argov1alpha1.Application{ObjectMeta: metav1.ObjectMeta{
+			Name:        "app",
+			Annotations: map[string]string{common.AnnotationApplicationSetRollingSyncMatchValues: annotation},
+		}}
+		matchExpressions := RollingSyncMatchExpressions(appset, app)
+		require.Len(t, matchExpressions, 2)
+		assert.Equal(t, []string{"{{ .firstEnv }}"}, matchExpressions[0][0].Values, annotation)
+	}
+}
@@ -0,0 +1,37 @@
+package template
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// SuspendParam is the name of the generator parameter which suspends the Application generated from a parameter set
+const SuspendParam = "suspend"
+
+// IsSuspended returns true if the given generated Application is suspended, i.e. it must not be synced automatically
+// nor promoted by progressive syncs
+func IsSuspended(app *argov1alpha1.Application) bool {
+	return isTrue(app.Annotations[common.AnnotationApplicationSetSuspend])
+}
+
+// applySuspend marks the given Application as suspended if the parameters it is generated from suspend it, and strips
+// the automated sync policy of suspended Applications
+func applySuspend(app *argov1alpha1.Application, params map[string]any) {
+	if value, ok := params[SuspendParam]; ok && isTrue(fmt.Sprint(value)) {
+		if app.Annotations == nil {
+			app.Annotations = map[string]string{}
+		}
+		app.Annotations[common.AnnotationApplicationSetSuspend] = "true"
+	}
+	if IsSuspended(app) && app.Spec.SyncPolicy != nil {
+		app.Spec.SyncPolicy.Automated = nil
+	}
+}
+
+func isTrue(value string) bool {
+	b, err := strconv.ParseBool(value)
+	return err == nil && b
+}
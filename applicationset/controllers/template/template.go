@@ -1,29 +1,115 @@
 package template
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"time"
 
+	"github.com/google/cel-go/cel"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/metrics"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 
 	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
-func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.ApplicationSet, g map[string]generators.Generator, renderer utils.Renderer, client client.Client) ([]argov1alpha1.Application, argov1alpha1.ApplicationSetReasonType, error) {
+// GeneratorsFailedError is returned by GenerateApplications if generators of the ApplicationSet failed to generate
+// parameters. It wraps the first error which occurred.
+type GeneratorsFailedError struct {
+	// Generators are the indexes of the failed generators, starting at 0
+	Generators []int
+	Err        error
+}
+
+func (e *GeneratorsFailedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *GeneratorsFailedError) Unwrap() error {
+	return e.Err
+}
+
+// observeGenerator records the execution of the given generator of the ApplicationSet in the given metrics, if any
+func observeGenerator(appsetMetrics *metrics.ApplicationsetMetrics, applicationSetInfo *argov1alpha1.ApplicationSet, requestedGenerator *argov1alpha1.ApplicationSetGenerator, duration time.Duration, results []generators.TransformResult, err error) {
+	if appsetMetrics == nil {
+		return
+	}
+	parameterSets := 0
+	for _, result := range results {
+		parameterSets += len(result.Params)
+	}
+	appsetMetrics.ObserveGenerator(applicationSetInfo, generators.GetGeneratorKind(requestedGenerator), duration, parameterSets, err)
+}
+
+// GenerateApplications renders the template of the given ApplicationSet with the parameters of its generators. It
+// returns the generated Applications together with the missing parameters the templates reference, which are only
+// tolerated if IgnoreMissingTemplateKeys is enabled.
+func GenerateApplications(ctx context.Context, logCtx *log.Entry, applicationSetInfo argov1alpha1.ApplicationSet, g map[string]generators.Generator, renderer utils.Renderer, client client.Client) ([]argov1alpha1.Application, []string, argov1alpha1.ApplicationSetReasonType, error) {
+	return GenerateApplicationsWithCache(ctx, logCtx, applicationSetInfo, g, renderer, client, nil, nil)
+}
+
+// GenerateApplicationsWithCache is like GenerateApplications, but caches the parameters generated by the generators in
+// the given cache, if any, and uses the cached parameters of the generators which fail to generate parameters. The
+// executions of the generators are recorded in the given metrics, if any.
+func GenerateApplicationsWithCache(ctx context.Context, logCtx *log.Entry, applicationSetInfo argov1alpha1.ApplicationSet, g map[string]generators.Generator, renderer utils.Renderer, client client.Client, cache *ParametersCache, appsetMetrics *metrics.ApplicationsetMetrics) ([]argov1alpha1.Application, []string, argov1alpha1.ApplicationSetReasonType, error) {
 	var res []argov1alpha1.Application
 
 	var firstError error
 	var applicationSetReason argov1alpha1.ApplicationSetReasonType
+	var failedGenerators []int
+	// the errors of the rendered Applications which do not match the Application schema, one per parameter set
+	var invalidApplicationErrs []error
 
-	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
-		t, err := generators.Transform(requestedGenerator, g, applicationSetInfo.Spec.Template, &applicationSetInfo, map[string]any{}, client)
+	if applicationSetInfo.Spec.ProvenanceLabels != nil {
+		if err := validateProvenanceLabelPrefix(applicationSetInfo.Spec.ProvenanceLabels.GetPrefix()); err != nil {
+			return nil, nil, argov1alpha1.ApplicationSetReasonRenderTemplateParamsError, err
+		}
+	}
+
+	var patchScript cel.Program
+	if applicationSetInfo.Spec.TemplatePatchScript != nil {
+		var err error
+		patchScript, err = compileTemplatePatchScript(*applicationSetInfo.Spec.TemplatePatchScript)
+		if err != nil {
+			return nil, nil, argov1alpha1.ApplicationSetReasonRenderTemplateParamsError, fmt.Errorf("invalid templatePatchScript: %w", err)
+		}
+	}
+
+	var missingKeys *utils.MissingKeys
+	if applicationSetInfo.Spec.IgnoreMissingTemplateKeys {
+		// the missing keys are recorded per ApplicationSet, so the template is rendered by a dedicated renderer
+		missingKeys = &utils.MissingKeys{}
+		renderer = &utils.Render{MissingKeys: missingKeys}
+	}
+
+	for i, requestedGenerator := range applicationSetInfo.Spec.Generators {
+		var t []generators.TransformResult
+		var err error
+		startGenerator := time.Now()
+		if cache != nil {
+			used := len(cache.Used)
+			t, err = cache.transform(ctx, logCtx, i, requestedGenerator, g, &applicationSetInfo, client)
+			generatorErr := err
+			if len(cache.Used) > used {
+				// the generator failed, even though its cached parameters are used
+				generatorErr = cache.Used[used].Err
+			}
+			observeGenerator(appsetMetrics, &applicationSetInfo, &requestedGenerator, time.Since(startGenerator), t, generatorErr)
+		} else {
+			t, err = generators.Transform(ctx, requestedGenerator, g, applicationSetInfo.Spec.Template, &applicationSetInfo, map[string]any{}, client)
+			observeGenerator(appsetMetrics, &applicationSetInfo, &requestedGenerator, time.Since(startGenerator), t, err)
+		}
 		if err != nil {
 			logCtx.WithError(err).WithField("generator", requestedGenerator).
 				Error("error generating application from params")
+			failedGenerators = append(failedGenerators, i)
 			if firstError == nil {
 				firstError = err
 				applicationSetReason = argov1alpha1.ApplicationSetReasonApplicationParamsGenerationError
@@ -31,10 +117,12 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 			continue
 		}
 
+		paramSet := -1
 		for _, a := range t {
 			tmplApplication := GetTempApplication(a.Template)
 
 			for _, p := range a.Params {
+				paramSet++
 				app, err := renderer.RenderTemplateParams(tmplApplication, applicationSetInfo.Spec.SyncPolicy, p, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
 				if err != nil {
 					logCtx.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
@@ -53,19 +141,68 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 						log.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
 							Error("error generating application from params")
 
-						if firstError == nil {
-							firstError = err
-							applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
-						}
+						invalidApplicationErrs = append(invalidApplicationErrs, fmt.Errorf("parameter set %d of generator %d: %w", paramSet, i, err))
+						continue
+					}
+
+					app = patchedApplication
+				}
+
+				if patchScript != nil {
+					patchedApplication, err := applyTemplatePatchScript(patchScript, app, p)
+					if err != nil {
+						logCtx.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
+							Error("error generating application from params")
+
+						invalidApplicationErrs = append(invalidApplicationErrs, fmt.Errorf("parameter set %d of generator %d: error applying templatePatchScript to application %q: %w", paramSet, i, app.Name, err))
 						continue
 					}
 
 					app = patchedApplication
 				}
 
+				if err := validateRenderedApplication(app); err != nil {
+					logCtx.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
+						Error("error generating application from params")
+
+					invalidApplicationErrs = append(invalidApplicationErrs, fmt.Errorf("parameter set %d of generator %d: invalid application %q: %w", paramSet, i, app.Name, err))
+					continue
+				}
+
 				// The app's namespace must be the same as the AppSet's namespace to preserve the appsets-in-any-namespace
 				// security boundary.
 				app.Namespace = applicationSetInfo.Namespace
+
+				applySuspend(app, p)
+
+				if err := applyRollingSyncMatchValues(renderer, app, &applicationSetInfo, p); err != nil {
+					logCtx.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
+						Error("error generating application from params")
+
+					if firstError == nil {
+						firstError = err
+						applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
+					}
+					continue
+				}
+
+				if applicationSetInfo.Spec.ProvenanceLabels != nil {
+					labels, err := provenanceLabels(logCtx, &applicationSetInfo, i, requestedGenerator, p)
+					if err != nil {
+						logCtx.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
+							Error("error generating application from params")
+
+						if firstError == nil {
+							firstError = err
+							applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
+						}
+						continue
+					}
+					if app.Labels == nil {
+						app.Labels = map[string]string{}
+					}
+					maps.Copy(app.Labels, labels)
+				}
 				res = append(res, *app)
 			}
 		}
@@ -76,7 +213,30 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 		}
 	}
 
-	return res, applicationSetReason, firstError
+	if cache != nil {
+		cache.prune(len(applicationSetInfo.Spec.Generators))
+	}
+
+	var missing []string
+	if missingKeys != nil {
+		missing = missingKeys.List()
+		if len(missing) > 0 {
+			logCtx.WithField("keys", missing).Warn("templates reference missing parameters, which have been rendered as empty strings")
+		}
+	}
+
+	if len(invalidApplicationErrs) > 0 {
+		// all the invalid Applications are reported, so that each parameter set can be fixed at once
+		if firstError == nil {
+			applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
+		}
+		firstError = errors.Join(append([]error{firstError}, invalidApplicationErrs...)...)
+	}
+
+	if len(failedGenerators) > 0 {
+		firstError = &GeneratorsFailedError{Generators: failedGenerators, Err: firstError}
+	}
+	return res, missing, applicationSetReason, firstError
 }
 
 func renderTemplatePatch(r utils.Renderer, app *argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet, params map[string]any) (*argov1alpha1.Application, error) {
@@ -85,7 +245,21 @@ func renderTemplatePatch(r utils.Renderer, app *argov1alpha1.Application, applic
 		return nil, fmt.Errorf("error replacing values in templatePatch: %w", err)
 	}
 
-	return applyTemplatePatch(app, replacedTemplate)
+	patchedApp, err := applyTemplatePatch(app, replacedTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("error applying templatePatch to application %q: %w", app.Name, err)
+	}
+	return patchedApp, nil
+}
+
+// validateRenderedApplication validates the Application rendered from the template, the templatePatch and the
+// templatePatchScript against the Application schema, whichever of fasttemplate or Go templates rendered it
+func validateRenderedApplication(app *argov1alpha1.Application) error {
+	data, err := json.Marshal(app)
+	if err != nil {
+		return fmt.Errorf("error marshalling application: %w", err)
+	}
+	return validateApplicationJSON(data)
 }
 
 func GetTempApplication(applicationSetTemplate argov1alpha1.ApplicationSetTemplate) *argov1alpha1.Application {
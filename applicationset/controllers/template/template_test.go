@@ -3,6 +3,7 @@ package template
 import (
 	"errors"
 	"maps"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -10,6 +11,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -91,7 +93,7 @@ func TestGenerateApplications(t *testing.T) {
 				List: &v1alpha1.ListGenerator{},
 			}
 
-			generatorMock.EXPECT().GenerateParams(&generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+			generatorMock.EXPECT().GenerateParams(mock.Anything, &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
 				Return(cc.params, cc.generateParamsError)
 
 			generatorMock.EXPECT().GetTemplate(&generator).
@@ -119,7 +121,7 @@ func TestGenerateApplications(t *testing.T) {
 			}
 			renderer := rendererMock
 
-			got, reason, err := GenerateApplications(log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
+			got, _, reason, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "name",
 					Namespace: "namespace",
@@ -205,7 +207,7 @@ func TestMergeTemplateApplications(t *testing.T) {
 				List: &v1alpha1.ListGenerator{},
 			}
 
-			generatorMock.EXPECT().GenerateParams(&generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+			generatorMock.EXPECT().GenerateParams(mock.Anything, &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
 				Return(cc.params, nil)
 
 			generatorMock.EXPECT().GetTemplate(&generator).
@@ -221,7 +223,7 @@ func TestMergeTemplateApplications(t *testing.T) {
 			}
 			renderer := rendererMock
 
-			got, _, _ := GenerateApplications(log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
+			got, _, _, _ := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "name",
 					Namespace: "namespace",
@@ -317,7 +319,7 @@ func TestGenerateAppsUsingPullRequestGenerator(t *testing.T) {
 				PullRequest: &v1alpha1.PullRequestGenerator{},
 			}
 
-			generatorMock.EXPECT().GenerateParams(&generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+			generatorMock.EXPECT().GenerateParams(mock.Anything, &generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
 				Return(cases.params, nil)
 
 			generatorMock.EXPECT().GetTemplate(&generator).
@@ -328,7 +330,7 @@ func TestGenerateAppsUsingPullRequestGenerator(t *testing.T) {
 			}
 			renderer := &utils.Render{}
 
-			gotApp, _, _ := GenerateApplications(log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
+			gotApp, _, _, _ := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), v1alpha1.ApplicationSet{
 				Spec: v1alpha1.ApplicationSetSpec{
 					GoTemplate: true,
 					Generators: []v1alpha1.ApplicationSetGenerator{{
@@ -348,3 +350,354 @@ func TestGenerateAppsUsingPullRequestGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateApplicationsProvenanceLabels(t *testing.T) {
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{
+					{Raw: []byte(`{"cluster": "engineering-dev"}`)},
+					{Raw: []byte(`{"cluster": "engineering-prod"}`)},
+				}},
+			}},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name:   "{{.cluster}}-guestbook",
+					Labels: map[string]string{"team": "engineering"},
+				},
+			},
+			ProvenanceLabels: &v1alpha1.ApplicationSetProvenanceLabels{},
+		},
+	}
+	allGenerators := map[string]generators.Generator{"List": generators.NewListGenerator()}
+
+	apps, _, _, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, allGenerators, &utils.Render{}, nil)
+	require.NoError(t, err)
+	require.Len(t, apps, 2)
+	assert.Equal(t, "engineering", apps[0].Labels["team"])
+	assert.Equal(t, "guestbook", apps[0].Labels["applicationset.argoproj.io/appset-name"])
+	assert.Equal(t, "argocd", apps[0].Labels["applicationset.argoproj.io/appset-namespace"])
+	assert.Equal(t, "list", apps[0].Labels["applicationset.argoproj.io/generator"])
+	assert.Equal(t, "0", apps[0].Labels["applicationset.argoproj.io/generator-index"])
+	assert.Len(t, apps[0].Labels["applicationset.argoproj.io/params-hash"], 16)
+	assert.NotEqual(t, apps[0].Labels["applicationset.argoproj.io/params-hash"], apps[1].Labels["applicationset.argoproj.io/params-hash"])
+
+	// the hash only depends on the parameters
+	regenerated, _, _, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, allGenerators, &utils.Render{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, apps[0].Labels, regenerated[0].Labels)
+
+	t.Run("CustomPrefix", func(t *testing.T) {
+		appSet := appSet.DeepCopy()
+		appSet.Spec.ProvenanceLabels.Prefix = "example.com/"
+		apps, _, _, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), *appSet, allGenerators, &utils.Render{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "guestbook", apps[0].Labels["example.com/appset-name"])
+		assert.NotContains(t, apps[0].Labels, "applicationset.argoproj.io/appset-name")
+	})
+
+	t.Run("InvalidPrefix", func(t *testing.T) {
+		appSet := appSet.DeepCopy()
+		appSet.Spec.ProvenanceLabels.Prefix = "example.com/invalid/"
+		_, _, reason, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), *appSet, allGenerators, &utils.Render{}, nil)
+		require.ErrorContains(t, err, "invalid provenance label prefix")
+		assert.EqualValues(t, v1alpha1.ApplicationSetReasonRenderTemplateParamsError, reason)
+	})
+
+	t.Run("NameTooLong", func(t *testing.T) {
+		appSet := appSet.DeepCopy()
+		appSet.Name = strings.Repeat("a", 64)
+		apps, _, _, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), *appSet, allGenerators, &utils.Render{}, nil)
+		require.NoError(t, err)
+		assert.NotContains(t, apps[0].Labels, "applicationset.argoproj.io/appset-name")
+		assert.Equal(t, "list", apps[0].Labels["applicationset.argoproj.io/generator"])
+	})
+}
+
+func TestGenerateApplicationsIgnoreMissingTemplateKeys(t *testing.T) {
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate:        true,
+			GoTemplateOptions: []string{"missingkey=error"},
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{
+					{Raw: []byte(`{"cluster": "dev", "region": "eu-west-1"}`)},
+					{Raw: []byte(`{"cluster": "prod"}`)},
+				}},
+			}},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name:   "{{.cluster}}-guestbook",
+					Labels: map[string]string{"region": "{{.region}}"},
+				},
+			},
+			IgnoreMissingTemplateKeys: true,
+		},
+	}
+	allGenerators := map[string]generators.Generator{"List": generators.NewListGenerator()}
+
+	apps, missingKeys, _, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, allGenerators, &utils.Render{}, nil)
+	require.NoError(t, err)
+	require.Len(t, apps, 2)
+	assert.Equal(t, "eu-west-1", apps[0].Labels["region"])
+	assert.Empty(t, apps[1].Labels["region"])
+	assert.Equal(t, []string{"region"}, missingKeys)
+
+	appSet.Spec.IgnoreMissingTemplateKeys = false
+	_, missingKeys, reason, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, allGenerators, &utils.Render{}, nil)
+	require.ErrorContains(t, err, `map has no entry for key "region"`)
+	assert.EqualValues(t, v1alpha1.ApplicationSetReasonRenderTemplateParamsError, reason)
+	assert.Empty(t, missingKeys)
+}
+
+func TestGenerateApplicationsSuspend(t *testing.T) {
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{
+					{Raw: []byte(`{"cluster": "dev"}`)},
+					{Raw: []byte(`{"cluster": "staging", "suspend": true}`)},
+					{Raw: []byte(`{"cluster": "prod", "frozen": "true"}`)},
+				}},
+			}},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{.cluster}}-guestbook",
+					Annotations: map[string]string{
+						"argocd.argoproj.io/application-set-suspend": `{{ default "false" .frozen }}`,
+					},
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					SyncPolicy: &v1alpha1.SyncPolicy{
+						Automated:   &v1alpha1.SyncPolicyAutomated{Prune: true},
+						SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"},
+					},
+				},
+			},
+		},
+	}
+	allGenerators := map[string]generators.Generator{"List": generators.NewListGenerator()}
+
+	apps, _, _, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, allGenerators, &utils.Render{}, nil)
+	require.NoError(t, err)
+	require.Len(t, apps, 3)
+
+	assert.False(t, IsSuspended(&apps[0]))
+	assert.NotNil(t, apps[0].Spec.SyncPolicy.Automated)
+
+	// suspended by the parameter
+	assert.True(t, IsSuspended(&apps[1]))
+	assert.Nil(t, apps[1].Spec.SyncPolicy.Automated)
+	assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=true"}, apps[1].Spec.SyncPolicy.SyncOptions)
+
+	// suspended by the template
+	assert.True(t, IsSuspended(&apps[2]))
+	assert.Nil(t, apps[2].Spec.SyncPolicy.Automated)
+}
+
+func TestGenerateApplicationsWithCache(t *testing.T) {
+	generator := v1alpha1.ApplicationSetGenerator{List: &v1alpha1.ListGenerator{}}
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{generator},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{Name: "{{.cluster}}-guestbook"},
+			},
+		},
+	}
+	logCtx := log.NewEntry(log.StandardLogger())
+	generatorErr := errors.New("SCM provider API unavailable")
+
+	generatorMock := &genmock.Generator{}
+	generatorMock.EXPECT().GetTemplate(mock.Anything).Return(&v1alpha1.ApplicationSetTemplate{})
+	generatorMock.EXPECT().GenerateParams(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]map[string]any{{"cluster": "dev"}, {"cluster": "prod"}}, nil).Twice()
+	generatorMock.EXPECT().GenerateParams(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, generatorErr)
+	allGenerators := map[string]generators.Generator{"List": generatorMock}
+
+	// the generated parameters are cached
+	cache := &ParametersCache{}
+	apps, _, _, err := GenerateApplicationsWithCache(t.Context(), logCtx, appSet, allGenerators, &utils.Render{}, nil, cache, nil)
+	require.NoError(t, err)
+	require.Len(t, apps, 2)
+	assert.True(t, cache.Changed)
+	require.Len(t, cache.Entries, 1)
+	cached := cache.Entries[0]
+	assert.Equal(t, int64(0), cached.Generator)
+	assert.Equal(t, int64(2), cached.ParameterSetCount)
+	assert.NotEmpty(t, cached.Hash)
+
+	// unchanged parameters are not cached again
+	cache = &ParametersCache{Entries: []v1alpha1.ApplicationSetCachedParameters{cached}}
+	_, _, _, err = GenerateApplicationsWithCache(t.Context(), logCtx, appSet, allGenerators, &utils.Render{}, nil, cache, nil)
+	require.NoError(t, err)
+	assert.False(t, cache.Changed)
+
+	// the cached parameters are used while the generator fails
+	cache = &ParametersCache{Entries: []v1alpha1.ApplicationSetCachedParameters{cached}}
+	apps, _, _, err = GenerateApplicationsWithCache(t.Context(), logCtx, appSet, allGenerators, &utils.Render{}, nil, cache, nil)
+	require.NoError(t, err)
+	require.Len(t, apps, 2)
+	assert.Equal(t, "dev-guestbook", apps[0].Name)
+	assert.Equal(t, "prod-guestbook", apps[1].Name)
+	require.Len(t, cache.Used, 1)
+	assert.Equal(t, 0, cache.Used[0].Generator)
+	require.ErrorIs(t, cache.Used[0].Err, generatorErr)
+	assert.False(t, cache.Changed)
+
+	// the cached parameters of a changed generator are not used
+	changed := appSet.DeepCopy()
+	changed.Spec.Generators[0].Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"cluster": "dev"}}
+	cache = &ParametersCache{Entries: []v1alpha1.ApplicationSetCachedParameters{cached}}
+	_, _, _, err = GenerateApplicationsWithCache(t.Context(), logCtx, *changed, allGenerators, &utils.Render{}, nil, cache, nil)
+	require.ErrorIs(t, err, generatorErr)
+	var generatorsErr *GeneratorsFailedError
+	require.ErrorAs(t, err, &generatorsErr)
+	assert.Equal(t, []int{0}, generatorsErr.Generators)
+	assert.Empty(t, cache.Used)
+
+	// the cached parameters of removed generators are pruned
+	removed := appSet.DeepCopy()
+	removed.Spec.Generators = nil
+	cache = &ParametersCache{Entries: []v1alpha1.ApplicationSetCachedParameters{cached}}
+	_, _, _, err = GenerateApplicationsWithCache(t.Context(), logCtx, *removed, allGenerators, &utils.Render{}, nil, cache, nil)
+	require.NoError(t, err)
+	assert.True(t, cache.Changed)
+	assert.Empty(t, cache.Entries)
+}
+
+func TestGenerateApplicationsTemplatePatchScript(t *testing.T) {
+	script := `params.cluster == "prod" ? {
+		"metadata": {
+			"finalizers": ["example.com/cleanup"],
+			"annotations": {"example.com/owner": params.owner}
+		},
+		"spec": {"syncPolicy": {"syncOptions": app.spec.syncPolicy.syncOptions + ["PruneLast=true"]}}
+	} : {}`
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{
+					{Raw: []byte(`{"cluster": "dev", "owner": "alice"}`)},
+					{Raw: []byte(`{"cluster": "prod", "owner": "bob"}`)},
+				}},
+			}},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{.cluster}}-guestbook",
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					SyncPolicy: &v1alpha1.SyncPolicy{SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"}},
+				},
+			},
+			TemplatePatchScript: &script,
+		},
+	}
+	allGenerators := map[string]generators.Generator{"List": generators.NewListGenerator()}
+
+	apps, _, _, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, allGenerators, &utils.Render{}, nil)
+	require.NoError(t, err)
+	require.Len(t, apps, 2)
+
+	assert.Equal(t, []string{"resources-finalizer.argocd.argoproj.io"}, apps[0].Finalizers)
+	assert.Empty(t, apps[0].Annotations)
+	assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=true"}, apps[0].Spec.SyncPolicy.SyncOptions)
+
+	assert.ElementsMatch(t, []string{"resources-finalizer.argocd.argoproj.io", "example.com/cleanup"}, apps[1].Finalizers)
+	assert.Equal(t, map[string]string{"example.com/owner": "bob"}, apps[1].Annotations)
+	assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=true", "PruneLast=true"}, apps[1].Spec.SyncPolicy.SyncOptions)
+
+	// the project of the Applications cannot be patched
+	appSet.Spec.Template.Spec.Project = "default"
+	script = `{"spec": {"project": params.cluster}}`
+	apps, _, _, err = GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, allGenerators, &utils.Render{}, nil)
+	require.NoError(t, err)
+	require.Len(t, apps, 2)
+	assert.Equal(t, "default", apps[1].Spec.Project)
+
+	// the patches are validated against the Application schema
+	script = `{"spec": {"unknown": params.cluster}}`
+	_, _, reason, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, allGenerators, &utils.Render{}, nil)
+	require.ErrorContains(t, err, `parameter set 0 of generator 0: error applying templatePatchScript to application "dev-guestbook"`)
+	require.ErrorContains(t, err, `parameter set 1 of generator 0: error applying templatePatchScript to application "prod-guestbook"`)
+	assert.EqualValues(t, v1alpha1.ApplicationSetReasonRenderTemplateParamsError, reason)
+
+	script = `"not a patch"`
+	_, _, reason, err = GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, allGenerators, &utils.Render{}, nil)
+	require.ErrorContains(t, err, "invalid templatePatchScript: templatePatchScript must evaluate to a map, but evaluates to string")
+	assert.EqualValues(t, v1alpha1.ApplicationSetReasonRenderTemplateParamsError, reason)
+}
+
+func TestGenerateApplicationsTemplatePatchValidation(t *testing.T) {
+	templatePatch := `
+spec:
+  source:
+    {{ if eq .cluster "prod" }}pth{{ else }}path{{ end }}: guestbook
+`
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				List: &v1alpha1.ListGenerator{Elements: []apiextensionsv1.JSON{
+					{Raw: []byte(`{"cluster": "dev"}`)},
+					{Raw: []byte(`{"cluster": "prod"}`)},
+					{Raw: []byte(`{"cluster": "prod"}`)},
+				}},
+			}},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{
+					Name: "{{.cluster}}-guestbook",
+				},
+				Spec: v1alpha1.ApplicationSpec{
+					Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps"},
+				},
+			},
+			TemplatePatch: &templatePatch,
+		},
+	}
+	allGenerators := map[string]generators.Generator{"List": generators.NewListGenerator()}
+
+	apps, _, reason, err := GenerateApplications(t.Context(), log.NewEntry(log.StandardLogger()), appSet, allGenerators, &utils.Render{}, nil)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "guestbook", apps[0].Spec.Source.Path)
+	// the unknown field is reported for each parameter set
+	require.ErrorContains(t, err, `parameter set 1 of generator 0: error applying templatePatch to application "prod-guestbook"`)
+	require.ErrorContains(t, err, `parameter set 2 of generator 0: error applying templatePatch to application "prod-guestbook"`)
+	require.ErrorContains(t, err, `unknown field "spec.source.pth"`)
+	assert.EqualValues(t, v1alpha1.ApplicationSetReasonRenderTemplateParamsError, reason)
+}
+
+func TestGeneratorType(t *testing.T) {
+	assert.Equal(t, "pullRequest", generatorType(v1alpha1.ApplicationSetGenerator{PullRequest: &v1alpha1.PullRequestGenerator{}}))
+	assert.Equal(t, "matrix", generatorType(v1alpha1.ApplicationSetGenerator{Matrix: &v1alpha1.MatrixGenerator{}}))
+	assert.Equal(t, "unknown", generatorType(v1alpha1.ApplicationSetGenerator{}))
+}
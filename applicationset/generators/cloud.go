@@ -0,0 +1,42 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// getAWSCredentials returns the static AWS credentials referenced by a generator. The references are required, so
+// that the credentials of the ApplicationSet controller itself, e.g. its pod identity, are never used.
+func getAWSCredentials(ctx context.Context, c client.Client, accessKeyIDRef, secretAccessKeyRef *argoprojiov1alpha1.SecretRef, namespace string, tokenRefStrictMode bool) (string, string, error) {
+	if accessKeyIDRef == nil || secretAccessKeyRef == nil {
+		return "", "", errors.New("accessKeyIDRef and secretAccessKeyRef are required")
+	}
+	accessKeyID, err := utils.GetSecretRef(ctx, c, accessKeyIDRef, namespace, tokenRefStrictMode)
+	if err != nil {
+		return "", "", fmt.Errorf("error fetching access key ID: %w", err)
+	}
+	secretAccessKey, err := utils.GetSecretRef(ctx, c, secretAccessKeyRef, namespace, tokenRefStrictMode)
+	if err != nil {
+		return "", "", fmt.Errorf("error fetching secret access key: %w", err)
+	}
+	return accessKeyID, secretAccessKey, nil
+}
+
+// getGoogleCredentials returns the Google Cloud service account key referenced by a generator. The reference is
+// required, so that the application default credentials of the ApplicationSet controller are never used.
+func getGoogleCredentials(ctx context.Context, c client.Client, credentialsRef *argoprojiov1alpha1.SecretRef, namespace string, tokenRefStrictMode bool) ([]byte, error) {
+	if credentialsRef == nil {
+		return nil, errors.New("credentialsRef is required")
+	}
+	credentials, err := utils.GetSecretRef(ctx, c, credentialsRef, namespace, tokenRefStrictMode)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching service account key: %w", err)
+	}
+	return []byte(credentials), nil
+}
@@ -0,0 +1,182 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/cloudinventory"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*CloudInventoryGenerator)(nil)
+
+const (
+	DefaultCloudInventoryRequeueAfter = 30 * time.Minute
+)
+
+var ErrCloudInventoryGeneratorDisabled = errors.New("the cloudInventory generator is disabled")
+
+// CloudInventoryConfig holds the operator settings of the cloud inventory generator
+type CloudInventoryConfig struct {
+	enabled bool
+}
+
+func NewCloudInventoryConfig(enabled bool) CloudInventoryConfig {
+	return CloudInventoryConfig{
+		enabled: enabled,
+	}
+}
+
+type CloudInventoryGenerator struct {
+	client client.Client
+	SCMConfig
+	CloudInventoryConfig
+	// Testing hooks.
+	selectServiceFunc func(ctx context.Context, generatorConfig *argoprojiov1alpha1.CloudInventoryGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (cloudinventory.InventoryService, string, error)
+}
+
+func NewCloudInventoryGenerator(client client.Client, scmConfig SCMConfig, cloudInventoryConfig CloudInventoryConfig) Generator {
+	g := &CloudInventoryGenerator{
+		client:               client,
+		SCMConfig:            scmConfig,
+		CloudInventoryConfig: cloudInventoryConfig,
+	}
+	g.selectServiceFunc = g.selectService
+	return g
+}
+
+func (g *CloudInventoryGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.CloudInventory.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.CloudInventory.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultCloudInventoryRequeueAfter
+}
+
+func (g *CloudInventoryGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.CloudInventory.Template
+}
+
+func (g *CloudInventoryGenerator) GenerateParams(ctx context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.CloudInventory == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+	if !g.enabled {
+		return nil, ErrCloudInventoryGeneratorDisabled
+	}
+
+	generatorConfig := appSetGenerator.CloudInventory
+
+	service, provider, err := g.selectServiceFunc(ctx, generatorConfig, applicationSetInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select cloud provider: %w", err)
+	}
+
+	accounts, err := service.ListAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing cloud accounts: %w", err)
+	}
+
+	params := make([]map[string]any, 0, len(accounts))
+	for _, account := range accounts {
+		if !account.MatchesTags(generatorConfig.Tags) {
+			continue
+		}
+
+		paramMap := map[string]any{
+			"provider":  provider,
+			"accountId": account.ID,
+			"name":      account.Name,
+		}
+		if applicationSetInfo.Spec.GoTemplate {
+			paramMap["tags"] = account.Tags
+		} else {
+			for k, v := range account.Tags {
+				paramMap["tags."+k] = v
+			}
+		}
+
+		err := appendTemplatedValues(generatorConfig.Values, paramMap, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		params = append(params, paramMap)
+	}
+
+	return params, nil
+}
+
+// selectService returns the inventory service and the name of the provider of the generator. Exactly one provider
+// must be configured. The credentials must be given with secret references: the credentials of the ApplicationSet
+// controller itself, e.g. its pod identity, are never used.
+func (g *CloudInventoryGenerator) selectService(ctx context.Context, generatorConfig *argoprojiov1alpha1.CloudInventoryGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (cloudinventory.InventoryService, string, error) {
+	providers := 0
+	for _, configured := range []bool{generatorConfig.AWS != nil, generatorConfig.GCP != nil, generatorConfig.Azure != nil} {
+		if configured {
+			providers++
+		}
+	}
+	if providers != 1 {
+		return nil, "", errors.New("exactly one of aws, gcp or azure must be specified")
+	}
+
+	switch {
+	case generatorConfig.AWS != nil:
+		providerConfig := generatorConfig.AWS
+		accessKeyID, secretAccessKey, err := getAWSCredentials(ctx, g.client, providerConfig.AccessKeyIDRef, providerConfig.SecretAccessKeyRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, "", fmt.Errorf("error fetching AWS credentials: %w", err)
+		}
+		service, err := cloudinventory.NewAWSService(providerConfig.ParentID, cloudinventory.AWSOptions{
+			Region:          providerConfig.Region,
+			Role:            providerConfig.Role,
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("error initializing AWS service: %w", err)
+		}
+		return service, cloudinventory.ProviderAWS, nil
+	case generatorConfig.GCP != nil:
+		providerConfig := generatorConfig.GCP
+		credentials, err := getGoogleCredentials(ctx, g.client, providerConfig.CredentialsRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, "", fmt.Errorf("error fetching Google Cloud credentials: %w", err)
+		}
+		service, err := cloudinventory.NewGCPService(ctx, providerConfig.Parent, credentials)
+		if err != nil {
+			return nil, "", fmt.Errorf("error initializing Google Cloud service: %w", err)
+		}
+		return service, cloudinventory.ProviderGCP, nil
+	default:
+		providerConfig := generatorConfig.Azure
+		if providerConfig.ClientSecretRef == nil {
+			return nil, "", errors.New("error fetching Azure client secret: clientSecretRef is required")
+		}
+		clientSecret, err := utils.GetSecretRef(ctx, g.client, providerConfig.ClientSecretRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, "", fmt.Errorf("error fetching Azure client secret: %w", err)
+		}
+		service, err := cloudinventory.NewAzureService(cloudinventory.AzureOptions{
+			TenantID:     providerConfig.TenantID,
+			ClientID:     providerConfig.ClientID,
+			ClientSecret: clientSecret,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("error initializing Azure service: %w", err)
+		}
+		return service, cloudinventory.ProviderAzure, nil
+	}
+}
@@ -0,0 +1,141 @@
+package generators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/cloudinventory"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeCloudInventoryService struct {
+	accounts []cloudinventory.Account
+}
+
+func (s *fakeCloudInventoryService) ListAccounts(_ context.Context) ([]cloudinventory.Account, error) {
+	return s.accounts, nil
+}
+
+func TestCloudInventoryGenerateParams(t *testing.T) {
+	service := &fakeCloudInventoryService{accounts: []cloudinventory.Account{
+		{ID: "111111111111", Name: "prod", Tags: map[string]string{"env": "prod", "team": "platform"}},
+		{ID: "222222222222", Name: "sandbox", Tags: map[string]string{"env": "dev"}},
+	}}
+
+	cases := []struct {
+		name       string
+		generator  *argoprojiov1alpha1.CloudInventoryGenerator
+		goTemplate bool
+		expected   []map[string]any
+	}{
+		{
+			name:      "flat params",
+			generator: &argoprojiov1alpha1.CloudInventoryGenerator{Values: map[string]string{"cluster": "{{name}}-{{tags.env}}"}},
+			expected: []map[string]any{
+				{
+					"provider":       cloudinventory.ProviderAWS,
+					"accountId":      "111111111111",
+					"name":           "prod",
+					"tags.env":       "prod",
+					"tags.team":      "platform",
+					"values.cluster": "prod-prod",
+				},
+				{
+					"provider":       cloudinventory.ProviderAWS,
+					"accountId":      "222222222222",
+					"name":           "sandbox",
+					"tags.env":       "dev",
+					"values.cluster": "sandbox-dev",
+				},
+			},
+		},
+		{
+			name:       "go template params filtered by tags",
+			generator:  &argoprojiov1alpha1.CloudInventoryGenerator{Tags: map[string]string{"env": "prod", "team": ""}},
+			goTemplate: true,
+			expected: []map[string]any{
+				{"provider": cloudinventory.ProviderAWS, "accountId": "111111111111", "name": "prod", "tags": service.accounts[0].Tags},
+			},
+		},
+		{
+			name:      "no account matching tags",
+			generator: &argoprojiov1alpha1.CloudInventoryGenerator{Tags: map[string]string{"env": "staging"}},
+			expected:  []map[string]any{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gen := &CloudInventoryGenerator{
+				CloudInventoryConfig: NewCloudInventoryConfig(true),
+				selectServiceFunc: func(context.Context, *argoprojiov1alpha1.CloudInventoryGenerator, *argoprojiov1alpha1.ApplicationSet) (cloudinventory.InventoryService, string, error) {
+					return service, cloudinventory.ProviderAWS, nil
+				},
+			}
+			appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: c.goTemplate}}
+			params, err := gen.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{CloudInventory: c.generator}, appSet, nil)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, params)
+		})
+	}
+}
+
+func TestCloudInventoryGenerateParamsDisabled(t *testing.T) {
+	gen := NewCloudInventoryGenerator(nil, SCMConfig{}, NewCloudInventoryConfig(false))
+	_, err := gen.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{CloudInventory: &argoprojiov1alpha1.CloudInventoryGenerator{}}, &argoprojiov1alpha1.ApplicationSet{}, nil)
+	require.ErrorIs(t, err, ErrCloudInventoryGeneratorDisabled)
+}
+
+func TestCloudInventorySelectService(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "argocd"},
+		Data: map[string][]byte{
+			"accessKeyID":     []byte("AKIAEXAMPLE"),
+			"secretAccessKey": []byte("secret"),
+		},
+	}
+	gen := NewCloudInventoryGenerator(fake.NewClientBuilder().WithObjects(secret).Build(), SCMConfig{}, NewCloudInventoryConfig(true)).(*CloudInventoryGenerator)
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Namespace: "argocd"}}
+
+	_, _, err := gen.selectService(t.Context(), &argoprojiov1alpha1.CloudInventoryGenerator{}, appSet)
+	require.ErrorContains(t, err, "exactly one of aws, gcp or azure must be specified")
+
+	_, _, err = gen.selectService(t.Context(), &argoprojiov1alpha1.CloudInventoryGenerator{
+		AWS: &argoprojiov1alpha1.CloudInventoryGeneratorAWS{},
+		GCP: &argoprojiov1alpha1.CloudInventoryGeneratorGCP{},
+	}, appSet)
+	require.ErrorContains(t, err, "exactly one of aws, gcp or azure must be specified")
+
+	service, provider, err := gen.selectService(t.Context(), &argoprojiov1alpha1.CloudInventoryGenerator{
+		AWS: &argoprojiov1alpha1.CloudInventoryGeneratorAWS{
+			Region:             "us-east-1",
+			AccessKeyIDRef:     &argoprojiov1alpha1.SecretRef{SecretName: "creds", Key: "accessKeyID"},
+			SecretAccessKeyRef: &argoprojiov1alpha1.SecretRef{SecretName: "creds", Key: "secretAccessKey"},
+		},
+	}, appSet)
+	require.NoError(t, err)
+	assert.IsType(t, &cloudinventory.AWSService{}, service)
+	assert.Equal(t, cloudinventory.ProviderAWS, provider)
+
+	// the credentials of the ApplicationSet controller are never used
+	_, _, err = gen.selectService(t.Context(), &argoprojiov1alpha1.CloudInventoryGenerator{
+		AWS: &argoprojiov1alpha1.CloudInventoryGeneratorAWS{Region: "us-east-1", Role: "arn:aws:iam::123456789012:role/inventory"},
+	}, appSet)
+	require.EqualError(t, err, "error fetching AWS credentials: accessKeyIDRef and secretAccessKeyRef are required")
+
+	_, _, err = gen.selectService(t.Context(), &argoprojiov1alpha1.CloudInventoryGenerator{
+		GCP: &argoprojiov1alpha1.CloudInventoryGeneratorGCP{Parent: "folders/123"},
+	}, appSet)
+	require.EqualError(t, err, "error fetching Google Cloud credentials: credentialsRef is required")
+
+	_, _, err = gen.selectService(t.Context(), &argoprojiov1alpha1.CloudInventoryGenerator{
+		Azure: &argoprojiov1alpha1.CloudInventoryGeneratorAzure{TenantID: "tenant", ClientID: "client"},
+	}, appSet)
+	require.EqualError(t, err, "error fetching Azure client secret: clientSecretRef is required")
+}
@@ -3,8 +3,11 @@ package generators
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
 	log "github.com/sirupsen/logrus"
 
 	corev1 "k8s.io/api/core/v1"
@@ -50,7 +53,7 @@ func (g *ClusterGenerator) GetTemplate(appSetGenerator *argoappsetv1alpha1.Appli
 	return &appSetGenerator.Clusters.Template
 }
 
-func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.ApplicationSetGenerator, appSet *argoappsetv1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+func (g *ClusterGenerator) GenerateParams(_ context.Context, appSetGenerator *argoappsetv1alpha1.ApplicationSetGenerator, appSet *argoappsetv1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
 	logCtx := log.WithField("applicationset", appSet.GetName()).WithField("namespace", appSet.GetNamespace())
 	if appSetGenerator == nil {
 		return nil, ErrEmptyAppSetGenerator
@@ -64,6 +67,11 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 	// - Since local clusters do not have secrets, they do not have labels to match against
 	ignoreLocalClusters := len(appSetGenerator.Clusters.Selector.MatchExpressions) > 0 || len(appSetGenerator.Clusters.Selector.MatchLabels) > 0
 
+	selectorExpression, err := compileClusterSelectorExpression(appSetGenerator.Clusters.SelectorExpression)
+	if err != nil {
+		return nil, err
+	}
+
 	// ListCluster will include the local cluster in the list of clusters
 	clustersFromArgoCD, err := utils.ListClusters(g.ctx, g.clientset, g.namespace)
 	if err != nil {
@@ -90,6 +98,14 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 			secretsFound = append(secretsFound, secretForCluster)
 		} else if !ignoreLocalClusters {
 			// If there is no secret for the cluster, it's the local cluster, so handle it here.
+			matched, err := matchesClusterSelectorExpression(selectorExpression, clusterSelectorEnv{Name: cluster.Name, Server: cluster.Server})
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				continue
+			}
+
 			params := map[string]any{}
 			params["name"] = cluster.Name
 			params["nameNormalized"] = cluster.Name
@@ -108,6 +124,19 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 
 	// For each matching cluster secret (non-local clusters only)
 	for _, cluster := range secretsFound {
+		matched, err := matchesClusterSelectorExpression(selectorExpression, clusterSelectorEnv{
+			Name:        string(cluster.Data["name"]),
+			Server:      string(cluster.Data["server"]),
+			Labels:      cluster.Labels,
+			Annotations: cluster.Annotations,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
+
 		params := g.getClusterParameters(cluster, appSet)
 
 		err = appendTemplatedValues(appSetGenerator.Clusters.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
@@ -122,6 +151,40 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 	return paramHolder.consolidate(), nil
 }
 
+// clusterSelectorEnv is the environment the selector expression of the Cluster generator is evaluated against
+type clusterSelectorEnv struct {
+	Name        string            `expr:"name"`
+	Server      string            `expr:"server"`
+	Labels      map[string]string `expr:"labels"`
+	Annotations map[string]string `expr:"annotations"`
+}
+
+// compileClusterSelectorExpression compiles the given selector expression, which is nil if the expression is empty
+func compileClusterSelectorExpression(expression string) (*vm.Program, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+	program, err := expr.Compile(expression, expr.Env(clusterSelectorEnv{}), expr.AsBool())
+	if err != nil {
+		return nil, fmt.Errorf("invalid cluster selector expression: %w", err)
+	}
+	return program, nil
+}
+
+// matchesClusterSelectorExpression returns true if the given compiled selector expression is nil or evaluates to true
+// for the given cluster
+func matchesClusterSelectorExpression(program *vm.Program, env clusterSelectorEnv) (bool, error) {
+	if program == nil {
+		return true, nil
+	}
+	out, err := expr.Run(program, env)
+	if err != nil {
+		return false, fmt.Errorf("error evaluating cluster selector expression for cluster %q: %w", env.Name, err)
+	}
+	matched, _ := out.(bool)
+	return matched, nil
+}
+
 type paramHolder struct {
 	isFlatMode bool
 	params     []map[string]any
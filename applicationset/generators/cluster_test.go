@@ -85,11 +85,12 @@ func TestGenerateParams(t *testing.T) {
 		},
 	}
 	testCases := []struct {
-		name       string
-		selector   metav1.LabelSelector
-		isFlatMode bool
-		values     map[string]string
-		expected   []map[string]any
+		name               string
+		selector           metav1.LabelSelector
+		selectorExpression string
+		isFlatMode         bool
+		values             map[string]string
+		expected           []map[string]any
 		// clientError is true if a k8s client error should be simulated
 		clientError   bool
 		expectedError error
@@ -297,6 +298,33 @@ func TestGenerateParams(t *testing.T) {
 			clientError:   false,
 			expectedError: nil,
 		},
+		{
+			name:               "selector expression on labels and annotations",
+			selectorExpression: `labels.environment matches "^prod" && annotations["foo.argoproj.io"] != "staging"`,
+			expected: []map[string]any{
+				{
+					"name": "production_01/west", "nameNormalized": "production-01-west", "server": "https://production-01.example.com", "metadata.labels.environment": "production", "metadata.labels.org": "bar",
+					"metadata.labels.argocd.argoproj.io/secret-type": "cluster", "metadata.annotations.foo.argoproj.io": "production", "project": "prod-project",
+				},
+			},
+		},
+		{
+			name:               "selector expression on missing label and name",
+			selectorExpression: `labels.tier != "sandbox" && name startsWith "staging"`,
+			expected: []map[string]any{
+				{
+					"name": "staging-01", "nameNormalized": "staging-01", "server": "https://staging-01.example.com", "metadata.labels.environment": "staging", "metadata.labels.org": "foo",
+					"metadata.labels.argocd.argoproj.io/secret-type": "cluster", "metadata.annotations.foo.argoproj.io": "staging", "project": "",
+				},
+			},
+		},
+		{
+			name:               "selector expression on server of local cluster",
+			selectorExpression: `server == "https://kubernetes.default.svc"`,
+			expected: []map[string]any{
+				{"nameNormalized": "in-cluster", "name": "in-cluster", "server": "https://kubernetes.default.svc", "project": ""},
+			},
+		},
 	}
 
 	// convert []client.Object to []runtime.Object, for use by kubefake package
@@ -324,11 +352,12 @@ func TestGenerateParams(t *testing.T) {
 				Spec: argoprojiov1alpha1.ApplicationSetSpec{},
 			}
 
-			got, err := clusterGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+			got, err := clusterGenerator.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{
 				Clusters: &argoprojiov1alpha1.ClusterGenerator{
-					Selector: testCase.selector,
-					Values:   testCase.values,
-					FlatList: testCase.isFlatMode,
+					Selector:           testCase.selector,
+					SelectorExpression: testCase.selectorExpression,
+					Values:             testCase.values,
+					FlatList:           testCase.isFlatMode,
 				},
 			}, &applicationSetInfo, nil)
 
@@ -864,7 +893,7 @@ func TestGenerateParamsGoTemplate(t *testing.T) {
 				},
 			}
 
-			got, err := clusterGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+			got, err := clusterGenerator.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{
 				Clusters: &argoprojiov1alpha1.ClusterGenerator{
 					Selector: testCase.selector,
 					Values:   testCase.values,
@@ -882,6 +911,18 @@ func TestGenerateParamsGoTemplate(t *testing.T) {
 	}
 }
 
+func TestCompileClusterSelectorExpression(t *testing.T) {
+	program, err := compileClusterSelectorExpression("")
+	require.NoError(t, err)
+	assert.Nil(t, program)
+
+	_, err = compileClusterSelectorExpression(`labels.environment ==`)
+	require.ErrorContains(t, err, "invalid cluster selector expression")
+
+	_, err = compileClusterSelectorExpression(`labels.environment`)
+	require.ErrorContains(t, err, "invalid cluster selector expression")
+}
+
 func TestSanitizeClusterName(t *testing.T) {
 	t.Run("valid DNS-1123 subdomain name", func(t *testing.T) {
 		assert.Equal(t, "cluster-name", utils.SanitizeName("cluster-name"))
@@ -1,6 +1,7 @@
 package generators
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -10,12 +11,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/jsonpath"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -55,7 +58,7 @@ func (g *DuckTypeGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.Appl
 	return &appSetGenerator.ClusterDecisionResource.Template
 }
 
-func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+func (g *DuckTypeGenerator) GenerateParams(_ context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
 	if appSetGenerator == nil {
 		return nil, ErrEmptyAppSetGenerator
 	}
@@ -81,18 +84,12 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 		return nil, fmt.Errorf("error reading configMapRef: %w", err)
 	}
 
-	// Extract GVK data for the dynamic client to use
-	versionIdx := strings.Index(cm.Data["apiVersion"], "/")
-	kind := cm.Data["kind"]
 	resourceName := appSetGenerator.ClusterDecisionResource.Name
 	labelSelector := appSetGenerator.ClusterDecisionResource.LabelSelector
 
-	log.WithField("kind.apiVersion", kind+"."+cm.Data["apiVersion"]).Info("Kind.Group/Version Reference")
-
-	// Validate the fields
-	if kind == "" || versionIdx < 1 {
-		log.Warningf("kind=%v, resourceName=%v, versionIdx=%v", kind, resourceName, versionIdx)
-		return nil, errors.New("there is a problem with the apiVersion, kind or resourceName provided")
+	duckGVR, err := GetDuckTypeResource(cm)
+	if err != nil {
+		return nil, err
 	}
 
 	if (resourceName == "" && labelSelector.MatchLabels == nil && labelSelector.MatchExpressions == nil) ||
@@ -101,12 +98,10 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 		return nil, errors.New("there is a problem with the definition of the ClusterDecisionResource generator")
 	}
 
-	// Split up the apiVersion
-	group := cm.Data["apiVersion"][0:versionIdx]
-	version := cm.Data["apiVersion"][versionIdx+1:]
-	log.WithField("kind.group.version", kind+"."+group+"/"+version).Debug("decoded Ref")
-
-	duckGVR := schema.GroupVersionResource{Group: group, Version: version, Resource: kind}
+	statusFields, err := parseJSONPathFields(appSetGenerator.ClusterDecisionResource.StatusFields)
+	if err != nil {
+		return nil, err
+	}
 
 	listOptions := metav1.ListOptions{}
 	if resourceName == "" {
@@ -141,7 +136,10 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 		return nil, nil
 	}
 
-	clusterDecisions := buildClusterDecisions(duckResources, statusListKey)
+	clusterDecisions, err := buildClusterDecisions(duckResources, statusListKey, statusFields)
+	if err != nil {
+		return nil, err
+	}
 	if len(clusterDecisions) == 0 {
 		log.Warningf("clusterDecisionResource status.%s missing", statusListKey)
 		return nil, nil
@@ -149,7 +147,7 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 
 	res := []map[string]any{}
 	for _, clusterDecision := range clusterDecisions {
-		cluster := findCluster(clustersFromArgoCD, clusterDecision, matchKey, statusListKey)
+		cluster := findCluster(clustersFromArgoCD, clusterDecision.decision, matchKey, statusListKey)
 		// if no cluster is found, move to the next cluster
 		if cluster == nil {
 			continue
@@ -161,10 +159,14 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 			"server": cluster.Server,
 		}
 
-		for key, value := range clusterDecision.(map[string]any) {
+		for key, value := range clusterDecision.decision.(map[string]any) {
 			params[key] = value.(string)
 		}
 
+		for key, value := range clusterDecision.statusFields {
+			params[key] = value
+		}
+
 		for key, value := range appSetGenerator.ClusterDecisionResource.Values {
 			collectParams(appSet, params, key, value)
 		}
@@ -175,8 +177,35 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 	return res, nil
 }
 
-func buildClusterDecisions(duckResources *unstructured.UnstructuredList, statusListKey string) []any {
-	clusterDecisions := []any{}
+// GetDuckTypeResource returns the resource of the duck type defined by the apiVersion and kind of the given ConfigMap,
+// referenced by the configMapRef of ClusterDecisionResource generators
+func GetDuckTypeResource(cm *corev1.ConfigMap) (schema.GroupVersionResource, error) {
+	versionIdx := strings.Index(cm.Data["apiVersion"], "/")
+	kind := cm.Data["kind"]
+
+	log.WithField("kind.apiVersion", kind+"."+cm.Data["apiVersion"]).Info("Kind.Group/Version Reference")
+
+	if kind == "" || versionIdx < 1 {
+		log.Warningf("kind=%v, versionIdx=%v", kind, versionIdx)
+		return schema.GroupVersionResource{}, errors.New("there is a problem with the apiVersion, kind or resourceName provided")
+	}
+
+	// Split up the apiVersion
+	group := cm.Data["apiVersion"][0:versionIdx]
+	version := cm.Data["apiVersion"][versionIdx+1:]
+	log.WithField("kind.group.version", kind+"."+group+"/"+version).Debug("decoded Ref")
+
+	return schema.GroupVersionResource{Group: group, Version: version, Resource: kind}, nil
+}
+
+// clusterDecision is an element of the status list of a decision resource, with the status fields of the resource
+type clusterDecision struct {
+	decision     any
+	statusFields map[string]string
+}
+
+func buildClusterDecisions(duckResources *unstructured.UnstructuredList, statusListKey string, statusFields map[string]*jsonpath.JSONPath) ([]clusterDecision, error) {
+	clusterDecisions := []clusterDecision{}
 
 	// Build the decision slice
 	for _, duckResource := range duckResources.Items {
@@ -189,10 +218,22 @@ func buildClusterDecisions(duckResources *unstructured.UnstructuredList, statusL
 
 		log.WithField("duckResourceStatus", duckResource.Object["status"]).Debug("found resource")
 
-		clusterDecisions = append(clusterDecisions, duckResource.Object["status"].(map[string]any)[statusListKey].([]any)...)
+		status := duckResource.Object["status"].(map[string]any)
+		fields := map[string]string{}
+		for name, parser := range statusFields {
+			buf := &bytes.Buffer{}
+			if err := parser.Execute(buf, status); err != nil {
+				return nil, fmt.Errorf("error evaluating status field %q of clusterDecisionResource %q: %w", name, duckResource.GetName(), err)
+			}
+			fields[name] = buf.String()
+		}
+
+		for _, decision := range status[statusListKey].([]any) {
+			clusterDecisions = append(clusterDecisions, clusterDecision{decision: decision, statusFields: fields})
+		}
 	}
 	log.Infof("Number of decisions found: %v", len(clusterDecisions))
-	return clusterDecisions
+	return clusterDecisions, nil
 }
 
 func findCluster(clustersFromArgoCD []utils.ClusterSpecifier, cluster any, matchKey string, statusListKey string) *utils.ClusterSpecifier {
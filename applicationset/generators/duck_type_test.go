@@ -86,6 +86,7 @@ func TestGenerateParamsForDuckType(t *testing.T) {
 				"labels":    map[string]any{"duck": "all-species"},
 			},
 			"status": map[string]any{
+				"placementName": "all-species",
 				"decisions": []any{
 					map[string]any{
 						"clusterName": "staging-01",
@@ -150,6 +151,7 @@ func TestGenerateParamsForDuckType(t *testing.T) {
 		labelSelector metav1.LabelSelector
 		resource      *unstructured.Unstructured
 		values        map[string]string
+		statusFields  map[string]string
 		expected      []map[string]any
 		expectedError error
 	}{
@@ -195,6 +197,29 @@ func TestGenerateParamsForDuckType(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:         "duck type generator statusFields",
+			resourceName: resourceName,
+			resource:     duckType,
+			statusFields: map[string]string{
+				"placement":  "{.placementName}",
+				"selected":   ".decisions[*].clusterName",
+				"unselected": "{.missing}",
+			},
+			expected: []map[string]any{
+				{"clusterName": "production-01", "name": "production-01", "server": "https://production-01.example.com", "placement": "all-species", "selected": "staging-01 production-01", "unselected": ""},
+
+				{"clusterName": "staging-01", "name": "staging-01", "server": "https://staging-01.example.com", "placement": "all-species", "selected": "staging-01 production-01", "unselected": ""},
+			},
+			expectedError: nil,
+		},
+		{
+			name:          "duck type generator invalid statusFields",
+			resourceName:  resourceName,
+			resource:      duckType,
+			statusFields:  map[string]string{"placement": "{.placementName"},
+			expectedError: errors.New(`invalid JSONPath expression of field "placement": unclosed action`),
+		},
 		{
 			name:          "duck type empty status",
 			resourceName:  resourceName,
@@ -301,12 +326,13 @@ func TestGenerateParamsForDuckType(t *testing.T) {
 				Spec: argoprojiov1alpha1.ApplicationSetSpec{},
 			}
 
-			got, err := duckTypeGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+			got, err := duckTypeGenerator.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{
 				ClusterDecisionResource: &argoprojiov1alpha1.DuckTypeGenerator{
 					ConfigMapRef:  "my-configmap",
 					Name:          testCase.resourceName,
 					LabelSelector: testCase.labelSelector,
 					Values:        testCase.values,
+					StatusFields:  testCase.statusFields,
 				},
 			}, &applicationSetInfo, nil)
 
@@ -599,7 +625,7 @@ func TestGenerateParamsForDuckTypeGoTemplate(t *testing.T) {
 				},
 			}
 
-			got, err := duckTypeGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+			got, err := duckTypeGenerator.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{
 				ClusterDecisionResource: &argoprojiov1alpha1.DuckTypeGenerator{
 					ConfigMapRef:  "my-configmap",
 					Name:          testCase.resourceName,
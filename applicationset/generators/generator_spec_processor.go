@@ -1,8 +1,10 @@
 package generators
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/jeremywohl/flatten"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -18,7 +20,9 @@ import (
 )
 
 const (
-	selectorKey = "Selector"
+	selectorKey        = "Selector"
+	transformKey       = "Transform"
+	requeueStrategyKey = "RequeueStrategy"
 )
 
 type TransformResult struct {
@@ -27,7 +31,7 @@ type TransformResult struct {
 }
 
 // Transform a spec generator to list of paramSets and a template
-func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, allGenerators map[string]Generator, baseTemplate argoprojiov1alpha1.ApplicationSetTemplate, appSet *argoprojiov1alpha1.ApplicationSet, genParams map[string]any, client client.Client) ([]TransformResult, error) {
+func Transform(ctx context.Context, requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, allGenerators map[string]Generator, baseTemplate argoprojiov1alpha1.ApplicationSetTemplate, appSet *argoprojiov1alpha1.ApplicationSet, genParams map[string]any, client client.Client) ([]TransformResult, error) {
 	// This is a custom version of the `LabelSelectorAsSelector` that is in k8s.io/apimachinery. This has been copied
 	// verbatim from that package, with the difference that we do not have any restrictions on label values. This is done
 	// so that, among other things, we can match on cluster urls.
@@ -36,6 +40,14 @@ func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, al
 		return nil, fmt.Errorf("error parsing label selector: %w", err)
 	}
 
+	var transformer *paramsTransformer
+	if requestedGenerator.Transform != nil {
+		transformer, err = newParamsTransformer(requestedGenerator.Transform, appSet.Spec.GoTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing generator transform: %w", err)
+		}
+	}
+
 	res := []TransformResult{}
 	var firstError error
 	interpolatedGenerator := requestedGenerator.DeepCopy()
@@ -65,7 +77,7 @@ func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, al
 				continue
 			}
 		}
-		params, err = g.GenerateParams(interpolatedGenerator, appSet, client)
+		params, err = g.GenerateParams(ctx, interpolatedGenerator, appSet, client)
 		if err != nil {
 			log.WithError(err).WithField("generator", g).
 				Error("error generating params")
@@ -74,6 +86,17 @@ func Transform(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, al
 			}
 			continue
 		}
+		if transformer != nil {
+			params, err = transformer.Apply(params)
+			if err != nil {
+				log.WithError(err).WithField("generator", g).
+					Error("error transforming params")
+				if firstError == nil {
+					firstError = err
+				}
+				continue
+			}
+		}
 		var filterParams []map[string]any
 		for _, param := range params {
 			flatParam, err := flattenParameters(param)
@@ -111,7 +134,7 @@ func GetRelevantGenerators(requestedGenerator *argoprojiov1alpha1.ApplicationSet
 			continue
 		}
 		name := v.Type().Field(i).Name
-		if name == selectorKey {
+		if name == selectorKey || name == transformKey || name == requeueStrategyKey {
 			continue
 		}
 
@@ -123,6 +146,29 @@ func GetRelevantGenerators(requestedGenerator *argoprojiov1alpha1.ApplicationSet
 	return res
 }
 
+// GetGeneratorKind returns the kind of the given generator as named in the ApplicationSet spec, e.g. git or
+// scmProvider, or unknown if the generator does not set any generator
+func GetGeneratorKind(requestedGenerator *argoprojiov1alpha1.ApplicationSetGenerator) string {
+	v := reflect.Indirect(reflect.ValueOf(requestedGenerator))
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.CanInterface() {
+			continue
+		}
+		structField := v.Type().Field(i)
+		if structField.Name == selectorKey || structField.Name == transformKey || structField.Name == requeueStrategyKey {
+			continue
+		}
+
+		if !reflect.ValueOf(field.Interface()).IsNil() {
+			name, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+			return name
+		}
+	}
+
+	return "unknown"
+}
+
 func flattenParameters(in map[string]any) (map[string]string, error) {
 	flat, err := flatten.Flatten(in, "", flatten.DotStyle)
 	if err != nil {
@@ -137,6 +183,20 @@ func flattenParameters(in map[string]any) (map[string]string, error) {
 	return out, nil
 }
 
+// MergeGeneratorTemplates returns the templates of the generators relevant to the requested generator merged with the
+// given template, in the order of the results of Transform
+func MergeGeneratorTemplates(requestedGenerator argoprojiov1alpha1.ApplicationSetGenerator, allGenerators map[string]Generator, baseTemplate argoprojiov1alpha1.ApplicationSetTemplate) ([]argoprojiov1alpha1.ApplicationSetTemplate, error) {
+	var res []argoprojiov1alpha1.ApplicationSetTemplate
+	for _, g := range GetRelevantGenerators(&requestedGenerator, allGenerators) {
+		mergedTemplate, err := mergeGeneratorTemplate(g, &requestedGenerator, baseTemplate)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, mergedTemplate)
+	}
+	return res, nil
+}
+
 func mergeGeneratorTemplate(g Generator, requestedGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetTemplate argoprojiov1alpha1.ApplicationSetTemplate) (argoprojiov1alpha1.ApplicationSetTemplate, error) {
 	// Make a copy of the value from `GetTemplate()` before merge, rather than copying directly into
 	// the provided parameter (which will touch the original resource object returned by client-go)
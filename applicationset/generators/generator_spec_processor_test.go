@@ -79,7 +79,7 @@ func TestMatchValues(t *testing.T) {
 				},
 			}
 
-			results, err := Transform(argov1alpha1.ApplicationSetGenerator{
+			results, err := Transform(t.Context(), argov1alpha1.ApplicationSetGenerator{
 				Selector: testCase.selector,
 				List: &argov1alpha1.ListGenerator{
 					Elements: testCase.elements,
@@ -163,7 +163,7 @@ func TestMatchValuesGoTemplate(t *testing.T) {
 				},
 			}
 
-			results, err := Transform(argov1alpha1.ApplicationSetGenerator{
+			results, err := Transform(t.Context(), argov1alpha1.ApplicationSetGenerator{
 				Selector: testCase.selector,
 				List: &argov1alpha1.ListGenerator{
 					Elements: testCase.elements,
@@ -233,7 +233,7 @@ func TestTransForm(t *testing.T) {
 				Spec: argov1alpha1.ApplicationSetSpec{},
 			}
 
-			results, err := Transform(
+			results, err := Transform(t.Context(),
 				argov1alpha1.ApplicationSetGenerator{
 					Selector: testCase.selector,
 					Clusters: &argov1alpha1.ClusterGenerator{
@@ -347,7 +347,7 @@ func getMockClusterGenerator(ctx context.Context) Generator {
 
 func getMockGitGenerator() Generator {
 	argoCDServiceMock := &mocks.Repos{}
-	argoCDServiceMock.EXPECT().GetDirectories(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"app1", "app2", "app_3", "p1/app4"}, nil)
+	argoCDServiceMock.EXPECT().GetDirectories(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"app1", "app2", "app_3", "p1/app4"}, nil)
 	gitGenerator := NewGitGenerator(argoCDServiceMock, "namespace")
 	return gitGenerator
 }
@@ -400,6 +400,24 @@ func TestGetRelevantGenerators(t *testing.T) {
 	assert.IsType(t, &GitGenerator{}, relevantGenerators[0])
 }
 
+func TestGetGeneratorKind(t *testing.T) {
+	assert.Equal(t, "git", GetGeneratorKind(&argov1alpha1.ApplicationSetGenerator{
+		Git: &argov1alpha1.GitGenerator{},
+	}))
+	assert.Equal(t, "scmProvider", GetGeneratorKind(&argov1alpha1.ApplicationSetGenerator{
+		Selector:    &metav1.LabelSelector{},
+		SCMProvider: &argov1alpha1.SCMProviderGenerator{},
+	}))
+	assert.Equal(t, "matrix", GetGeneratorKind(&argov1alpha1.ApplicationSetGenerator{
+		Matrix:          &argov1alpha1.MatrixGenerator{},
+		Transform:       &argov1alpha1.ApplicationSetGeneratorTransform{},
+		RequeueStrategy: &argov1alpha1.ApplicationSetGeneratorRequeueStrategy{},
+	}))
+	assert.Equal(t, "unknown", GetGeneratorKind(&argov1alpha1.ApplicationSetGenerator{
+		Selector: &metav1.LabelSelector{},
+	}))
+}
+
 func TestInterpolateGenerator(t *testing.T) {
 	requestedGenerator := &argov1alpha1.ApplicationSetGenerator{
 		Clusters: &argov1alpha1.ClusterGenerator{
@@ -322,7 +322,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 
 			argoCDServiceMock := mocks.NewRepos(t)
 
-			argoCDServiceMock.EXPECT().GetDirectories(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCaseCopy.repoApps, testCaseCopy.repoError)
+			argoCDServiceMock.EXPECT().GetDirectories(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCaseCopy.repoApps, testCaseCopy.repoError)
 
 			gitGenerator := NewGitGenerator(argoCDServiceMock, "")
 			applicationSetInfo := v1alpha1.ApplicationSet{
@@ -349,7 +349,7 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 
 			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appProject).Build()
 
-			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+			got, err := gitGenerator.GenerateParams(t.Context(), &applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
 
 			if testCaseCopy.expectedError != nil {
 				require.EqualError(t, err, testCaseCopy.expectedError.Error())
@@ -361,6 +361,41 @@ func TestGitGenerateParamsFromDirectories(t *testing.T) {
 	}
 }
 
+func TestGitGenerateParamsFromDirectoriesPassesPaths(t *testing.T) {
+	argoCDServiceMock := mocks.NewRepos(t)
+	argoCDServiceMock.EXPECT().GetDirectories(mock.Anything, "RepoURL", "Revision", mock.Anything, []string{"apps/*", "clusters/*"}, []string{"apps/legacy"}, mock.Anything, mock.Anything).
+		Return([]string{"apps/guestbook", "clusters/prod"}, nil)
+
+	gitGenerator := NewGitGenerator(argoCDServiceMock, "")
+	applicationSetInfo := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "set",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				Git: &v1alpha1.GitGenerator{
+					RepoURL:  "RepoURL",
+					Revision: "Revision",
+					Directories: []v1alpha1.GitDirectoryGeneratorItem{
+						{Path: "apps/*"},
+						{Path: "apps/legacy", Exclude: true},
+						{Path: "clusters/*"},
+					},
+				},
+			}},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.AppProject{}).Build()
+
+	got, err := gitGenerator.GenerateParams(t.Context(), &applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+}
+
 func TestGitGenerateParamsFromDirectoriesGoTemplate(t *testing.T) {
 	t.Parallel()
 
@@ -623,7 +658,7 @@ func TestGitGenerateParamsFromDirectoriesGoTemplate(t *testing.T) {
 
 			argoCDServiceMock := mocks.NewRepos(t)
 
-			argoCDServiceMock.EXPECT().GetDirectories(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCaseCopy.repoApps, testCaseCopy.repoError)
+			argoCDServiceMock.EXPECT().GetDirectories(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCaseCopy.repoApps, testCaseCopy.repoError)
 
 			gitGenerator := NewGitGenerator(argoCDServiceMock, "")
 			applicationSetInfo := v1alpha1.ApplicationSet{
@@ -650,7 +685,7 @@ func TestGitGenerateParamsFromDirectoriesGoTemplate(t *testing.T) {
 
 			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appProject).Build()
 
-			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+			got, err := gitGenerator.GenerateParams(t.Context(), &applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
 
 			if testCaseCopy.expectedError != nil {
 				require.EqualError(t, err, testCaseCopy.expectedError.Error())
@@ -1024,7 +1059,7 @@ cluster:
 
 			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appProject).Build()
 
-			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+			got, err := gitGenerator.GenerateParams(t.Context(), &applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
 
 			if testCaseCopy.expectedError != nil {
 				require.EqualError(t, err, testCaseCopy.expectedError.Error())
@@ -1366,7 +1401,7 @@ env: testing
 
 			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appProject).Build()
 
-			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+			got, err := gitGenerator.GenerateParams(t.Context(), &applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
 
 			if testCaseCopy.expectedError != nil {
 				require.EqualError(t, err, testCaseCopy.expectedError.Error())
@@ -1703,7 +1738,7 @@ env: testing
 
 			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appProject).Build()
 
-			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+			got, err := gitGenerator.GenerateParams(t.Context(), &applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
 
 			if testCaseCopy.expectedError != nil {
 				require.EqualError(t, err, testCaseCopy.expectedError.Error())
@@ -1934,7 +1969,7 @@ func TestGitGeneratorParamsFromFilesWithExcludeOptionGoTemplate(t *testing.T) {
 
 			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appProject).Build()
 
-			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+			got, err := gitGenerator.GenerateParams(t.Context(), &applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
 
 			if testCaseCopy.expectedError != nil {
 				require.EqualError(t, err, testCaseCopy.expectedError.Error())
@@ -2289,7 +2324,7 @@ cluster:
 
 			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appProject).Build()
 
-			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+			got, err := gitGenerator.GenerateParams(t.Context(), &applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
 
 			if testCaseCopy.expectedError != nil {
 				require.EqualError(t, err, testCaseCopy.expectedError.Error())
@@ -2472,7 +2507,7 @@ func TestGitGenerator_GenerateParams(t *testing.T) {
 				project = mock.Anything
 			}
 
-			argoCDServiceMock.EXPECT().GetDirectories(mock.Anything, mock.Anything, mock.Anything, project, mock.Anything, mock.Anything).Return(testCase.repoApps, testCase.repoPathsError)
+			argoCDServiceMock.EXPECT().GetDirectories(mock.Anything, mock.Anything, mock.Anything, project, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(testCase.repoApps, testCase.repoPathsError)
 		}
 		gitGenerator := NewGitGenerator(argoCDServiceMock, "argocd")
 
@@ -2482,7 +2517,7 @@ func TestGitGenerator_GenerateParams(t *testing.T) {
 
 		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&testCase.appProject).Build()
 
-		got, err := gitGenerator.GenerateParams(&testCase.appset.Spec.Generators[0], &testCase.appset, client)
+		got, err := gitGenerator.GenerateParams(t.Context(), &testCase.appset.Spec.Generators[0], &testCase.appset, client)
 
 		if testCase.expectedError != nil {
 			require.EqualError(t, err, testCase.expectedError.Error())
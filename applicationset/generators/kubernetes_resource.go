@@ -0,0 +1,387 @@
+package generators
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+)
+
+var _ Generator = (*KubernetesResourceGenerator)(nil)
+
+var ErrKubernetesResourceGeneratorDisabled = errors.New("the kubernetesResource generator is disabled")
+
+// KubernetesResourceConfig holds the operator settings of the Kubernetes resource generator
+type KubernetesResourceConfig struct {
+	enabled bool
+	// allowedKinds are the kinds which may be listed, formatted as Kind for the core group and as Kind.group otherwise
+	allowedKinds []string
+}
+
+func NewKubernetesResourceConfig(enabled bool, allowedKinds []string) KubernetesResourceConfig {
+	return KubernetesResourceConfig{
+		enabled:      enabled,
+		allowedKinds: allowedKinds,
+	}
+}
+
+// KubernetesResourceGenerator generates parameters from the Kubernetes resources of a kind, listed in the local cluster
+// or in clusters registered with Argo CD.
+type KubernetesResourceGenerator struct {
+	client.Client
+	ctx       context.Context
+	dynClient dynamic.Interface
+	clientset kubernetes.Interface
+	// namespace is the Argo CD namespace
+	namespace string
+	// clusterClients returns the clients of a registered cluster
+	clusterClients func(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error)
+	KubernetesResourceConfig
+}
+
+func NewKubernetesResourceGenerator(ctx context.Context, c client.Client, dynClient dynamic.Interface, clientset kubernetes.Interface, namespace string, config KubernetesResourceConfig) Generator {
+	return &KubernetesResourceGenerator{
+		Client:                   c,
+		ctx:                      ctx,
+		dynClient:                dynClient,
+		clientset:                clientset,
+		namespace:                namespace,
+		clusterClients:           newClusterClients,
+		KubernetesResourceConfig: config,
+	}
+}
+
+func newClusterClients(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error) {
+	config, err := cluster.RESTConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting the REST config of cluster %q: %w", cluster.Name, err)
+	}
+	dynClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating the dynamic client of cluster %q: %w", cluster.Name, err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating the discovery client of cluster %q: %w", cluster.Name, err)
+	}
+	return dynClient, discoveryClient, nil
+}
+
+func (g *KubernetesResourceGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	if appSetGenerator.KubernetesResource.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.KubernetesResource.RequeueAfterSeconds) * time.Second
+	}
+
+	return getDefaultRequeueAfter()
+}
+
+func (g *KubernetesResourceGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.KubernetesResource.Template
+}
+
+func (g *KubernetesResourceGenerator) GenerateParams(_ context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.KubernetesResource == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+	if !g.enabled {
+		return nil, ErrKubernetesResourceGeneratorDisabled
+	}
+	generatorConfig := appSetGenerator.KubernetesResource
+
+	gv, err := schema.ParseGroupVersion(generatorConfig.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid apiVersion %q: %w", generatorConfig.APIVersion, err)
+	}
+	if generatorConfig.Kind == "" {
+		return nil, errors.New("kind must be specified")
+	}
+	gk := schema.GroupKind{Group: gv.Group, Kind: generatorConfig.Kind}
+	if gv.Group == "" && generatorConfig.Kind == "Secret" {
+		return nil, errors.New("secrets cannot be listed by the kubernetesResource generator")
+	}
+	if !slices.Contains(g.allowedKinds, gk.String()) {
+		return nil, fmt.Errorf("kind %q is not allowed to be listed by the kubernetesResource generator", gk.String())
+	}
+
+	// ApplicationSets outside of the Argo CD namespace may only list the namespaced resources of their own namespace
+	namespace := generatorConfig.Namespace
+	if appSet.Namespace != g.namespace {
+		if namespace != "" && namespace != appSet.Namespace {
+			return nil, fmt.Errorf("namespace %q is not allowed, ApplicationSets outside of the Argo CD namespace can only list the resources of their namespace", namespace)
+		}
+		namespace = appSet.Namespace
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(&generatorConfig.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("error converting label selector: %w", err)
+	}
+
+	fields, err := parseJSONPathFields(generatorConfig.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := g.getProject(appSet)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := g.getClusters(generatorConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []map[string]any{}
+	for _, cluster := range clusters {
+		dynClient, discoveryClient := g.dynClient, g.clientset.Discovery()
+		if cluster.secret != nil {
+			dynClient, discoveryClient, err = g.clusterClients(cluster.secret)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		apiResource, err := discoverAPIResource(discoveryClient, gv, generatorConfig.Kind)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s in cluster %q: %w", generatorConfig.Kind, cluster.name, err)
+		}
+		if err := g.checkPermitted(appSet, project, cluster, apiResource, namespace); err != nil {
+			return nil, err
+		}
+
+		resources, err := listKubernetesResources(g.ctx, dynClient, gv, apiResource, namespace, metav1.ListOptions{LabelSelector: labelSelector.String()})
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s in cluster %q: %w", generatorConfig.Kind, cluster.name, err)
+		}
+		log.WithField("kind", generatorConfig.Kind).WithField("cluster", cluster.name).Debugf("found %d resources", len(resources))
+
+		for _, resource := range resources {
+			params, err := getKubernetesResourceParams(resource, cluster, fields, appSet.Spec.GoTemplate)
+			if err != nil {
+				return nil, err
+			}
+
+			err = appendTemplatedValues(generatorConfig.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to append templated values: %w", err)
+			}
+
+			res = append(res, params)
+		}
+	}
+
+	return res, nil
+}
+
+// kubernetesResourceCluster is a cluster the resources are listed in. The secret is nil for the local cluster.
+type kubernetesResourceCluster struct {
+	name   string
+	server string
+	secret *argoprojiov1alpha1.Cluster
+}
+
+// getProject returns the project of the applications generated by the ApplicationSet
+func (g *KubernetesResourceGenerator) getProject(appSet *argoprojiov1alpha1.ApplicationSet) (*argoprojiov1alpha1.AppProject, error) {
+	projectName := appSet.Spec.Template.Spec.Project
+	if projectName == "" || strings.Contains(projectName, "{{") {
+		return nil, errors.New("the kubernetesResource generator requires the project of the template to be set and not templated")
+	}
+	project := &argoprojiov1alpha1.AppProject{}
+	if err := g.Get(g.ctx, types.NamespacedName{Name: projectName, Namespace: g.namespace}, project); err != nil {
+		return nil, fmt.Errorf("error getting project %s: %w", projectName, err)
+	}
+	return project, nil
+}
+
+// checkPermitted verifies that the resources may be listed in the cluster: cluster-scoped resources may only be listed
+// by the ApplicationSets of the Argo CD namespace, and the project has to permit both the kind and the cluster and
+// namespace the resources are listed in. Listing across all namespaces requires a project permitting all namespaces.
+func (g *KubernetesResourceGenerator) checkPermitted(appSet *argoprojiov1alpha1.ApplicationSet, project *argoprojiov1alpha1.AppProject, cluster kubernetesResourceCluster, apiResource *metav1.APIResource, namespace string) error {
+	gk := schema.GroupKind{Group: apiResource.Group, Kind: apiResource.Kind}
+	if !apiResource.Namespaced && appSet.Namespace != g.namespace {
+		return fmt.Errorf("cluster-scoped kind %q is not allowed, ApplicationSets outside of the Argo CD namespace can only list namespaced resources", gk.String())
+	}
+	if !project.IsGroupKindNamePermitted(gk, "", apiResource.Namespaced) {
+		return fmt.Errorf("kind %q is not permitted in project %q", gk.String(), project.Name)
+	}
+	destNamespace := namespace
+	if destNamespace == "" || !apiResource.Namespaced {
+		destNamespace = "*"
+	}
+	destCluster := cluster.secret
+	if destCluster == nil {
+		destCluster = &argoprojiov1alpha1.Cluster{Name: cluster.name, Server: cluster.server}
+	}
+	permitted, err := project.IsDestinationPermitted(destCluster, destNamespace, g.getProjectClusters)
+	if err != nil {
+		return fmt.Errorf("error verifying the destinations of project %q: %w", project.Name, err)
+	}
+	if !permitted {
+		return fmt.Errorf("listing %s in namespace %q of cluster %q is not permitted in project %q", gk.String(), destNamespace, cluster.name, project.Name)
+	}
+	return nil
+}
+
+// getProjectClusters returns the clusters scoped to the given project
+func (g *KubernetesResourceGenerator) getProjectClusters(project string) ([]*argoprojiov1alpha1.Cluster, error) {
+	clusterSecretList := &corev1.SecretList{}
+	if err := g.List(g.ctx, clusterSecretList, client.InNamespace(g.namespace), client.MatchingLabels{common.LabelKeySecretType: common.LabelValueSecretTypeCluster}); err != nil {
+		return nil, fmt.Errorf("error listing cluster secrets: %w", err)
+	}
+	var clusters []*argoprojiov1alpha1.Cluster
+	for i := range clusterSecretList.Items {
+		cluster, err := db.SecretToCluster(&clusterSecretList.Items[i])
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert cluster secret to cluster object '%s': %w", clusterSecretList.Items[i].Name, err)
+		}
+		if cluster.Project == project {
+			clusters = append(clusters, cluster)
+		}
+	}
+	return clusters, nil
+}
+
+// getClusters returns the clusters selected by the generator, or the local cluster if no selector is given
+func (g *KubernetesResourceGenerator) getClusters(generatorConfig *argoprojiov1alpha1.KubernetesResourceGenerator) ([]kubernetesResourceCluster, error) {
+	if generatorConfig.Clusters == nil {
+		return []kubernetesResourceCluster{{name: "in-cluster", server: argoprojiov1alpha1.KubernetesInternalAPIServerAddr}}, nil
+	}
+
+	selector := metav1.AddLabelToSelector(generatorConfig.Clusters.DeepCopy(), common.LabelKeySecretType, common.LabelValueSecretTypeCluster)
+	secretSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, fmt.Errorf("error converting cluster selector: %w", err)
+	}
+
+	clusterSecretList := &corev1.SecretList{}
+	if err := g.List(g.ctx, clusterSecretList, client.InNamespace(g.namespace), client.MatchingLabelsSelector{Selector: secretSelector}); err != nil {
+		return nil, fmt.Errorf("error listing cluster secrets: %w", err)
+	}
+
+	clusters := make([]kubernetesResourceCluster, 0, len(clusterSecretList.Items))
+	for i := range clusterSecretList.Items {
+		cluster, err := db.SecretToCluster(&clusterSecretList.Items[i])
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert cluster secret to cluster object '%s': %w", clusterSecretList.Items[i].Name, err)
+		}
+		clusters = append(clusters, kubernetesResourceCluster{name: cluster.Name, server: cluster.Server, secret: cluster})
+	}
+	return clusters, nil
+}
+
+// discoverAPIResource returns the API resource of the given kind with the discovery client
+func discoverAPIResource(discoveryClient discovery.DiscoveryInterface, gv schema.GroupVersion, kind string) (*metav1.APIResource, error) {
+	apiResources, err := discoveryClient.ServerResourcesForGroupVersion(gv.String())
+	if err != nil {
+		return nil, fmt.Errorf("error discovering the resources of %s: %w", gv.String(), err)
+	}
+
+	for _, apiResource := range apiResources.APIResources {
+		// Skip the subresources, e.g. namespaces/status
+		if apiResource.Kind != kind || strings.Contains(apiResource.Name, "/") {
+			continue
+		}
+		apiResource.Group = gv.Group
+		apiResource.Version = gv.Version
+		return &apiResource, nil
+	}
+
+	return nil, fmt.Errorf("kind %s not found in %s", kind, gv.String())
+}
+
+// listKubernetesResources lists the resources of the given API resource, in the given namespace if it is namespaced
+func listKubernetesResources(ctx context.Context, dynClient dynamic.Interface, gv schema.GroupVersion, apiResource *metav1.APIResource, namespace string, listOptions metav1.ListOptions) ([]unstructured.Unstructured, error) {
+	resourceClient := dynClient.Resource(gv.WithResource(apiResource.Name))
+	var list *unstructured.UnstructuredList
+	var err error
+	if apiResource.Namespaced && namespace != "" {
+		list, err = resourceClient.Namespace(namespace).List(ctx, listOptions)
+	} else {
+		list, err = resourceClient.List(ctx, listOptions)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// parseJSONPathFields parses the JSONPath expressions of the given fields, keyed by parameter name. The braces of an
+// expression are optional.
+func parseJSONPathFields(fields map[string]string) (map[string]*jsonpath.JSONPath, error) {
+	parsers := map[string]*jsonpath.JSONPath{}
+	for name, expression := range fields {
+		if !strings.HasPrefix(strings.TrimSpace(expression), "{") {
+			expression = "{" + expression + "}"
+		}
+		parser := jsonpath.New(name).AllowMissingKeys(true)
+		if err := parser.Parse(expression); err != nil {
+			return nil, fmt.Errorf("invalid JSONPath expression of field %q: %w", name, err)
+		}
+		parsers[name] = parser
+	}
+	return parsers, nil
+}
+
+// getKubernetesResourceParams returns the parameters of a resource: its metadata, the cluster it was listed in and the
+// fields extracted from it
+func getKubernetesResourceParams(resource unstructured.Unstructured, cluster kubernetesResourceCluster, fields map[string]*jsonpath.JSONPath, useGoTemplate bool) (map[string]any, error) {
+	params := map[string]any{}
+
+	if useGoTemplate {
+		params["metadata"] = map[string]any{
+			"name":        resource.GetName(),
+			"namespace":   resource.GetNamespace(),
+			"labels":      resource.GetLabels(),
+			"annotations": resource.GetAnnotations(),
+		}
+		params["cluster"] = map[string]any{
+			"name":   cluster.name,
+			"server": cluster.server,
+		}
+	} else {
+		params["metadata.name"] = resource.GetName()
+		params["metadata.namespace"] = resource.GetNamespace()
+		for key, value := range resource.GetLabels() {
+			params["metadata.labels."+key] = value
+		}
+		for key, value := range resource.GetAnnotations() {
+			params["metadata.annotations."+key] = value
+		}
+		params["cluster.name"] = cluster.name
+		params["cluster.server"] = cluster.server
+	}
+
+	for name, parser := range fields {
+		buf := &bytes.Buffer{}
+		if err := parser.Execute(buf, resource.Object); err != nil {
+			return nil, fmt.Errorf("error evaluating field %q of %s %q: %w", name, resource.GetKind(), resource.GetName(), err)
+		}
+		params[name] = buf.String()
+	}
+
+	return params, nil
+}
@@ -0,0 +1,303 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newKubernetesResourceNamespace(name string, labels map[string]string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata": map[string]any{
+			"name":        name,
+			"labels":      toAnyMap(labels),
+			"annotations": map[string]any{"example.com/owner": name + "-owner"},
+		},
+		"spec": map[string]any{"finalizers": []any{"kubernetes"}},
+	}}
+}
+
+func toAnyMap(m map[string]string) map[string]any {
+	res := map[string]any{}
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
+
+func newKubernetesResourceProject(destinations ...argoprojiov1alpha1.ApplicationDestination) *argoprojiov1alpha1.AppProject {
+	if len(destinations) == 0 {
+		destinations = []argoprojiov1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}}
+	}
+	return &argoprojiov1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec: argoprojiov1alpha1.AppProjectSpec{
+			Destinations:             destinations,
+			ClusterResourceWhitelist: []argoprojiov1alpha1.ClusterResourceRestrictionItem{{Group: "*", Kind: "*"}},
+		},
+	}
+}
+
+func newKubernetesResourceGenerator(t *testing.T, clusterObjects []runtime.Object, objects ...runtime.Object) *KubernetesResourceGenerator {
+	t.Helper()
+	clientset := kubefake.NewClientset()
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "namespaces", Kind: "Namespace"},
+			{Name: "namespaces/status", Kind: "Namespace"},
+			{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+		},
+	}}
+	gvrToListKind := map[schema.GroupVersionResource]string{
+		{Version: "v1", Resource: "namespaces"}: "NamespaceList",
+		{Version: "v1", Resource: "configmaps"}: "ConfigMapList",
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, argoprojiov1alpha1.AddToScheme(scheme))
+	return &KubernetesResourceGenerator{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(clusterObjects...).Build(),
+		ctx:       t.Context(),
+		dynClient: dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrToListKind, objects...),
+		clientset: clientset,
+		namespace: "argocd",
+		clusterClients: func(cluster *argoprojiov1alpha1.Cluster) (dynamic.Interface, discovery.DiscoveryInterface, error) {
+			remoteObjects := []runtime.Object{newKubernetesResourceNamespace("team-"+cluster.Name, map[string]string{"team": "true"})}
+			return dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrToListKind, remoteObjects...), clientset.Discovery(), nil
+		},
+		KubernetesResourceConfig: NewKubernetesResourceConfig(true, []string{"Namespace", "ConfigMap", "Duck"}),
+	}
+}
+
+func TestKubernetesResourceGenerateParams(t *testing.T) {
+	clusterSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "staging",
+			Namespace: "argocd",
+			Labels:    map[string]string{"argocd.argoproj.io/secret-type": "cluster", "environment": "staging"},
+		},
+		Data: map[string][]byte{"name": []byte("staging"), "server": []byte("https://staging.example.com")},
+	}
+	objects := []runtime.Object{
+		newKubernetesResourceNamespace("team-a", map[string]string{"team": "true"}),
+		newKubernetesResourceNamespace("kube-system", nil),
+	}
+
+	for _, cc := range []struct {
+		name            string
+		generator       *argoprojiov1alpha1.KubernetesResourceGenerator
+		appSetNs        string
+		goTemplate      bool
+		project         *argoprojiov1alpha1.AppProject
+		templateProject string
+		expected        []map[string]any
+		expectedError   string
+	}{
+		{
+			name: "local cluster with fields",
+			generator: &argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion:    "v1",
+				Kind:          "Namespace",
+				LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "true"}},
+				Fields:        map[string]string{"owner": `{.metadata.annotations.example\.com/owner}`, "finalizer": ".spec.finalizers[0]", "missing": ".spec.missing"},
+			},
+			appSetNs: "argocd",
+			expected: []map[string]any{{
+				"metadata.name":                          "team-a",
+				"metadata.namespace":                     "",
+				"metadata.labels.team":                   "true",
+				"metadata.annotations.example.com/owner": "team-a-owner",
+				"cluster.name":                           "in-cluster",
+				"cluster.server":                         argoprojiov1alpha1.KubernetesInternalAPIServerAddr,
+				"owner":                                  "team-a-owner",
+				"finalizer":                              "kubernetes",
+				"missing":                                "",
+			}},
+		},
+		{
+			name: "registered clusters with go template",
+			generator: &argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "v1",
+				Kind:       "Namespace",
+				Clusters:   &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "staging"}},
+				Values:     map[string]string{"project": "{{ .metadata.name }}"},
+			},
+			appSetNs:   "argocd",
+			goTemplate: true,
+			expected: []map[string]any{{
+				"metadata": map[string]any{
+					"name":        "team-staging",
+					"namespace":   "",
+					"labels":      map[string]string{"team": "true"},
+					"annotations": map[string]string{"example.com/owner": "team-staging-owner"},
+				},
+				"cluster": map[string]any{"name": "staging", "server": "https://staging.example.com"},
+				"values":  map[string]string{"project": "team-staging"},
+			}},
+		},
+		{
+			name: "no matching cluster",
+			generator: &argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "v1",
+				Kind:       "Namespace",
+				Clusters:   &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "production"}},
+			},
+			appSetNs: "argocd",
+			expected: []map[string]any{},
+		},
+		{
+			name:          "secrets",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Secret"},
+			appSetNs:      "argocd",
+			expectedError: "secrets cannot be listed by the kubernetesResource generator",
+		},
+		{
+			name:          "kind not allowed",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Pod"},
+			appSetNs:      "argocd",
+			expectedError: `kind "Pod" is not allowed to be listed by the kubernetesResource generator`,
+		},
+		{
+			name:          "cluster-scoped kind outside of the Argo CD namespace",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Namespace"},
+			appSetNs:      "team-a",
+			expectedError: `cluster-scoped kind "Namespace" is not allowed, ApplicationSets outside of the Argo CD namespace can only list namespaced resources`,
+		},
+		{
+			name:      "kind not permitted in project",
+			generator: &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Namespace"},
+			appSetNs:  "argocd",
+			project: &argoprojiov1alpha1.AppProject{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+				Spec:       argoprojiov1alpha1.AppProjectSpec{Destinations: []argoprojiov1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}}},
+			},
+			expectedError: `kind "Namespace" is not permitted in project "default"`,
+		},
+		{
+			name:          "all namespaces not permitted in project",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "ConfigMap"},
+			appSetNs:      "argocd",
+			project:       newKubernetesResourceProject(argoprojiov1alpha1.ApplicationDestination{Server: "*", Namespace: "team-*"}),
+			expectedError: `listing ConfigMap in namespace "*" of cluster "in-cluster" is not permitted in project "default"`,
+		},
+		{
+			name:          "cluster not permitted in project",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "ConfigMap", Namespace: "team-a", Clusters: &metav1.LabelSelector{MatchLabels: map[string]string{"environment": "staging"}}},
+			appSetNs:      "argocd",
+			project:       newKubernetesResourceProject(argoprojiov1alpha1.ApplicationDestination{Name: "in-cluster", Namespace: "*"}),
+			expectedError: `listing ConfigMap in namespace "team-a" of cluster "staging" is not permitted in project "default"`,
+		},
+		{
+			name:            "templated project",
+			generator:       &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Namespace"},
+			appSetNs:        "argocd",
+			templateProject: "{{ .metadata.name }}",
+			expectedError:   "the kubernetesResource generator requires the project of the template to be set and not templated",
+		},
+		{
+			name:          "unknown kind",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Duck"},
+			appSetNs:      "argocd",
+			expectedError: `error listing Duck in cluster "in-cluster": kind Duck not found in v1`,
+		},
+		{
+			name:          "invalid field",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "Namespace", Fields: map[string]string{"owner": "{.metadata"}},
+			appSetNs:      "argocd",
+			expectedError: `invalid JSONPath expression of field "owner": unclosed action`,
+		},
+		{
+			name:          "other namespace outside of the Argo CD namespace",
+			generator:     &argoprojiov1alpha1.KubernetesResourceGenerator{APIVersion: "v1", Kind: "ConfigMap", Namespace: "argocd"},
+			appSetNs:      "team-a",
+			expectedError: `namespace "argocd" is not allowed, ApplicationSets outside of the Argo CD namespace can only list the resources of their namespace`,
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			project := cc.project
+			if project == nil {
+				project = newKubernetesResourceProject()
+			}
+			templateProject := cc.templateProject
+			if templateProject == "" {
+				templateProject = "default"
+			}
+			generator := newKubernetesResourceGenerator(t, []runtime.Object{clusterSecret, project}, objects...)
+			appSet := &argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: cc.appSetNs},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					GoTemplate: cc.goTemplate,
+					Template:   argoprojiov1alpha1.ApplicationSetTemplate{Spec: argoprojiov1alpha1.ApplicationSpec{Project: templateProject}},
+				},
+			}
+
+			got, err := generator.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{KubernetesResource: cc.generator}, appSet, nil)
+			if cc.expectedError != "" {
+				require.EqualError(t, err, cc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, cc.expected, got)
+		})
+	}
+}
+
+func TestKubernetesResourceGenerateParamsNamespaced(t *testing.T) {
+	configMap := func(namespace string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": "team", "namespace": namespace},
+		}}
+	}
+	generator := newKubernetesResourceGenerator(t, []runtime.Object{newKubernetesResourceProject()}, configMap("argocd"), configMap("team-a"))
+	appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{KubernetesResource: &argoprojiov1alpha1.KubernetesResourceGenerator{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+	}}
+	newAppSet := func(namespace string) *argoprojiov1alpha1.ApplicationSet {
+		return &argoprojiov1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+			Spec:       argoprojiov1alpha1.ApplicationSetSpec{Template: argoprojiov1alpha1.ApplicationSetTemplate{Spec: argoprojiov1alpha1.ApplicationSpec{Project: "default"}}},
+		}
+	}
+
+	// all the namespaces are listed for the ApplicationSets of the Argo CD namespace
+	got, err := generator.GenerateParams(t.Context(), appSetGenerator, newAppSet("argocd"), nil)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+
+	// only their own namespace for the other ApplicationSets
+	got, err = generator.GenerateParams(t.Context(), appSetGenerator, newAppSet("team-a"), nil)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "team-a", got[0]["metadata.namespace"])
+}
+
+func TestKubernetesResourceGenerateParamsDisabled(t *testing.T) {
+	generator := newKubernetesResourceGenerator(t, []runtime.Object{newKubernetesResourceProject()})
+	generator.KubernetesResourceConfig = NewKubernetesResourceConfig(false, []string{"Namespace"})
+	_, err := generator.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{KubernetesResource: &argoprojiov1alpha1.KubernetesResourceGenerator{
+		APIVersion: "v1",
+		Kind:       "Namespace",
+	}}, &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Namespace: "argocd"}}, nil)
+	require.ErrorIs(t, err, ErrKubernetesResourceGeneratorDisabled)
+}
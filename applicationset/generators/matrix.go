@@ -1,8 +1,12 @@
 package generators
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"dario.cat/mergo"
@@ -32,7 +36,7 @@ func NewMatrixGenerator(supportedGenerators map[string]Generator) Generator {
 	return m
 }
 
-func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+func (m *MatrixGenerator) GenerateParams(ctx context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
 	if appSetGenerator.Matrix == nil {
 		return nil, ErrEmptyAppSetGenerator
 	}
@@ -47,19 +51,32 @@ func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 
 	res := []map[string]any{}
 
-	g0, err := m.getParams(appSetGenerator.Matrix.Generators[0], appSet, nil, client)
+	g0, err := m.getParams(ctx, appSetGenerator.Matrix.Generators[0], appSet, nil, client)
 	if err != nil {
 		return nil, fmt.Errorf("error failed to get params for first generator in matrix generator: %w", err)
 	}
+	// The second generator is only evaluated for the parameters of the first generator matching its selector. Its
+	// parameters are memoized by interpolated generator, so that it is evaluated once per distinct interpolation, e.g.
+	// only once if it does not use the parameters of the first generator.
+	memoizedParams := map[string][]map[string]any{}
 	for _, a := range g0 {
-		g1, err := m.getParams(appSetGenerator.Matrix.Generators[1], appSet, a, client)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get params for second generator in the matrix generator: %w", err)
+		key, memoizable := m.memoizationKey(appSetGenerator.Matrix.Generators[1], appSet, a)
+		g1, ok := memoizedParams[key]
+		if !ok || !memoizable {
+			g1, err = m.getParams(ctx, appSetGenerator.Matrix.Generators[1], appSet, a, client)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get params for second generator in the matrix generator: %w", err)
+			}
+			if memoizable {
+				memoizedParams[key] = g1
+			}
 		}
 		for _, b := range g1 {
 			if appSet.Spec.GoTemplate {
 				tmp := map[string]any{}
-				if err := mergo.Merge(&tmp, b, mergo.WithOverride); err != nil {
+				// The params are copied, as merging the params of the first generator would modify the nested maps of
+				// the memoized params
+				if err := mergo.Merge(&tmp, deepCopyParams(b), mergo.WithOverride); err != nil {
 					return nil, fmt.Errorf("failed to merge params from the second generator in the matrix generator with temp map: %w", err)
 				}
 				if err := mergo.Merge(&tmp, a, mergo.WithOverride); err != nil {
@@ -79,7 +96,57 @@ func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 	return res, nil
 }
 
-func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]any, client client.Client) ([]map[string]any, error) {
+// memoizationKey returns the key under which the parameters of the child generator interpolated with the given
+// parameters are memoized, and false if they cannot be memoized
+func (m *MatrixGenerator) memoizationKey(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]any) (string, bool) {
+	generator, err := toApplicationSetGenerator(appSetBaseGenerator)
+	if err != nil {
+		return "", false
+	}
+	if len(params) != 0 {
+		// Errors are reported when generating the parameters
+		interpolatedGenerator, err := InterpolateGenerator(generator, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+		if err != nil {
+			return "", false
+		}
+		generator = &interpolatedGenerator
+	}
+	key, err := json.Marshal(generator)
+	if err != nil {
+		return "", false
+	}
+	return string(key), true
+}
+
+// deepCopyParams returns a copy of the params, copying the nested maps and slices
+func deepCopyParams(params map[string]any) map[string]any {
+	res := make(map[string]any, len(params))
+	for k, v := range params {
+		res[k] = deepCopyParam(v)
+	}
+	return res
+}
+
+func deepCopyParam(param any) any {
+	switch v := param.(type) {
+	case map[string]any:
+		return deepCopyParams(v)
+	case []any:
+		res := make([]any, len(v))
+		for i, item := range v {
+			res[i] = deepCopyParam(item)
+		}
+		return res
+	case map[string]string:
+		return maps.Clone(v)
+	case []string:
+		return slices.Clone(v)
+	default:
+		return v
+	}
+}
+
+func toApplicationSetGenerator(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator) (*argoprojiov1alpha1.ApplicationSetGenerator, error) {
 	matrixGen, err := getMatrixGenerator(appSetBaseGenerator)
 	if err != nil {
 		return nil, err
@@ -89,19 +156,34 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 		return nil, fmt.Errorf("error retrieving merge generator: %w", err)
 	}
 
+	return &argoprojiov1alpha1.ApplicationSetGenerator{
+		List:                    appSetBaseGenerator.List,
+		Clusters:                appSetBaseGenerator.Clusters,
+		Git:                     appSetBaseGenerator.Git,
+		SCMProvider:             appSetBaseGenerator.SCMProvider,
+		ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
+		PullRequest:             appSetBaseGenerator.PullRequest,
+		Plugin:                  appSetBaseGenerator.Plugin,
+		Terraform:               appSetBaseGenerator.Terraform,
+		CloudInventory:          appSetBaseGenerator.CloudInventory,
+		KubernetesResource:      appSetBaseGenerator.KubernetesResource,
+		OCI:                     appSetBaseGenerator.OCI,
+		Matrix:                  matrixGen,
+		Merge:                   mergeGen,
+		Selector:                appSetBaseGenerator.Selector,
+		Transform:               appSetBaseGenerator.Transform,
+	}, nil
+}
+
+func (m *MatrixGenerator) getParams(ctx context.Context, appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]any, client client.Client) ([]map[string]any, error) {
+	generator, err := toApplicationSetGenerator(appSetBaseGenerator)
+	if err != nil {
+		return nil, err
+	}
+
 	t, err := Transform(
-		argoprojiov1alpha1.ApplicationSetGenerator{
-			List:                    appSetBaseGenerator.List,
-			Clusters:                appSetBaseGenerator.Clusters,
-			Git:                     appSetBaseGenerator.Git,
-			SCMProvider:             appSetBaseGenerator.SCMProvider,
-			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
-			PullRequest:             appSetBaseGenerator.PullRequest,
-			Plugin:                  appSetBaseGenerator.Plugin,
-			Matrix:                  matrixGen,
-			Merge:                   mergeGen,
-			Selector:                appSetBaseGenerator.Selector,
-		},
+		ctx,
+		*generator,
 		m.supportedGenerators,
 		argoprojiov1alpha1.ApplicationSetTemplate{},
 		appSet,
@@ -137,6 +219,10 @@ func (m *MatrixGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Ap
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			Terraform:               r.Terraform,
+			CloudInventory:          r.CloudInventory,
+			KubernetesResource:      r.KubernetesResource,
+			OCI:                     r.OCI,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
@@ -1,6 +1,7 @@
 package generators
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -149,7 +150,7 @@ func TestMatrixGenerate(t *testing.T) {
 					Git:  g.Git,
 					List: g.List,
 				}
-				genMock.EXPECT().GenerateParams(mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet, mock.Anything).Return([]map[string]any{
+				genMock.EXPECT().GenerateParams(mock.Anything, mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet, mock.Anything).Return([]map[string]any{
 					{
 						"path":                    "app1",
 						"path.basename":           "app1",
@@ -173,7 +174,7 @@ func TestMatrixGenerate(t *testing.T) {
 				},
 			)
 
-			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
+			got, err := matrixGenerator.GenerateParams(t.Context(), &v1alpha1.ApplicationSetGenerator{
 				Matrix: &v1alpha1.MatrixGenerator{
 					Generators: testCaseCopy.baseGenerators,
 					Template:   v1alpha1.ApplicationSetTemplate{},
@@ -358,7 +359,7 @@ func TestMatrixGenerateGoTemplate(t *testing.T) {
 					Git:  g.Git,
 					List: g.List,
 				}
-				genMock.EXPECT().GenerateParams(mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet, mock.Anything).Return([]map[string]any{
+				genMock.EXPECT().GenerateParams(mock.Anything, mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet, mock.Anything).Return([]map[string]any{
 					{
 						"path": map[string]string{
 							"path":               "app1",
@@ -386,7 +387,7 @@ func TestMatrixGenerateGoTemplate(t *testing.T) {
 				},
 			)
 
-			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
+			got, err := matrixGenerator.GenerateParams(t.Context(), &v1alpha1.ApplicationSetGenerator{
 				Matrix: &v1alpha1.MatrixGenerator{
 					Generators: testCaseCopy.baseGenerators,
 					Template:   v1alpha1.ApplicationSetTemplate{},
@@ -650,7 +651,7 @@ func TestInterpolatedMatrixGenerate(t *testing.T) {
 					Git:      g.Git,
 					Clusters: g.Clusters,
 				}
-				genMock.EXPECT().GenerateParams(mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet, mock.Anything).Return([]map[string]any{
+				genMock.EXPECT().GenerateParams(mock.Anything, mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet, mock.Anything).Return([]map[string]any{
 					{
 						"path":                    "examples/git-generator-files-discovery/cluster-config/dev/config.json",
 						"path.basename":           "dev",
@@ -672,7 +673,7 @@ func TestInterpolatedMatrixGenerate(t *testing.T) {
 				},
 			)
 
-			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
+			got, err := matrixGenerator.GenerateParams(t.Context(), &v1alpha1.ApplicationSetGenerator{
 				Matrix: &v1alpha1.MatrixGenerator{
 					Generators: testCaseCopy.baseGenerators,
 					Template:   v1alpha1.ApplicationSetTemplate{},
@@ -833,7 +834,7 @@ func TestInterpolatedMatrixGenerateGoTemplate(t *testing.T) {
 					Git:      g.Git,
 					Clusters: g.Clusters,
 				}
-				genMock.EXPECT().GenerateParams(mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet, mock.Anything).Return([]map[string]any{
+				genMock.EXPECT().GenerateParams(mock.Anything, mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet, mock.Anything).Return([]map[string]any{
 					{
 						"path": map[string]string{
 							"path":               "examples/git-generator-files-discovery/cluster-config/dev/config.json",
@@ -859,7 +860,7 @@ func TestInterpolatedMatrixGenerateGoTemplate(t *testing.T) {
 				},
 			)
 
-			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
+			got, err := matrixGenerator.GenerateParams(t.Context(), &v1alpha1.ApplicationSetGenerator{
 				Matrix: &v1alpha1.MatrixGenerator{
 					Generators: testCaseCopy.baseGenerators,
 					Template:   v1alpha1.ApplicationSetTemplate{},
@@ -984,7 +985,7 @@ func TestMatrixGenerateListElementsYaml(t *testing.T) {
 					Git:  g.Git,
 					List: g.List,
 				}
-				genMock.EXPECT().GenerateParams(mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet, mock.Anything).Return([]map[string]any{{
+				genMock.EXPECT().GenerateParams(mock.Anything, mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet, mock.Anything).Return([]map[string]any{{
 					"foo": map[string]any{
 						"bar": []any{
 							map[string]any{
@@ -1020,7 +1021,7 @@ func TestMatrixGenerateListElementsYaml(t *testing.T) {
 				},
 			)
 
-			got, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
+			got, err := matrixGenerator.GenerateParams(t.Context(), &v1alpha1.ApplicationSetGenerator{
 				Matrix: &v1alpha1.MatrixGenerator{
 					Generators: testCaseCopy.baseGenerators,
 					Template:   v1alpha1.ApplicationSetTemplate{},
@@ -1051,7 +1052,7 @@ func TestGitGenerator_GenerateParams_list_x_git_matrix_generator(t *testing.T) {
 	// of that bug.
 
 	listGeneratorMock := &generatorsMock.Generator{}
-	listGeneratorMock.EXPECT().GenerateParams(mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).Return([]map[string]any{
+	listGeneratorMock.EXPECT().GenerateParams(mock.Anything, mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).Return([]map[string]any{
 		{"some": "value"},
 	}, nil)
 	listGeneratorMock.EXPECT().GetTemplate(mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator")).Return(&v1alpha1.ApplicationSetTemplate{})
@@ -1098,7 +1099,7 @@ func TestGitGenerator_GenerateParams_list_x_git_matrix_generator(t *testing.T) {
 
 	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appProject).Build()
 
-	params, err := matrixGenerator.GenerateParams(&v1alpha1.ApplicationSetGenerator{
+	params, err := matrixGenerator.GenerateParams(t.Context(), &v1alpha1.ApplicationSetGenerator{
 		Matrix: matrixGeneratorSpec,
 	}, &v1alpha1.ApplicationSet{}, client)
 	require.NoError(t, err)
@@ -1113,3 +1114,74 @@ func TestGitGenerator_GenerateParams_list_x_git_matrix_generator(t *testing.T) {
 		"test":                    "content",
 	}}, params)
 }
+
+func TestMatrixGenerateMemoization(t *testing.T) {
+	firstGenerator := v1alpha1.ApplicationSetNestedGenerator{
+		List: &v1alpha1.ListGenerator{
+			Elements: []apiextensionsv1.JSON{
+				{Raw: []byte(`{"env": "dev", "cluster": {"env": "dev"}}`)},
+				{Raw: []byte(`{"env": "staging"}`)},
+				{Raw: []byte(`{"env": "production", "cluster": {"env": "production"}}`)},
+				{Raw: []byte(`{"env": "excluded"}`)},
+			},
+		},
+		Selector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"excluded"}}},
+		},
+	}
+
+	for _, cc := range []struct {
+		name          string
+		values        map[string]string
+		expectedCalls int
+	}{
+		{
+			name:          "second generator not using the parameters of the first one",
+			expectedCalls: 1,
+		},
+		{
+			name:          "second generator using the parameters of the first one",
+			values:        map[string]string{"env": "{{ .env }}"},
+			expectedCalls: 3,
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			clusterGeneratorMock := &generatorsMock.Generator{}
+			clusterGeneratorMock.EXPECT().GenerateParams(mock.Anything, mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), mock.Anything, mock.Anything).RunAndReturn(
+				func(_ context.Context, _ *v1alpha1.ApplicationSetGenerator, _ *v1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+					return []map[string]any{
+						{"cluster": map[string]any{"name": "east"}},
+						{"cluster": map[string]any{"name": "west"}},
+					}, nil
+				}).Times(cc.expectedCalls)
+			clusterGeneratorMock.EXPECT().GetTemplate(mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator")).Return(&v1alpha1.ApplicationSetTemplate{})
+
+			matrixGenerator := NewMatrixGenerator(map[string]Generator{
+				"List":     NewListGenerator(),
+				"Clusters": clusterGeneratorMock,
+			})
+			appSet := &v1alpha1.ApplicationSet{Spec: v1alpha1.ApplicationSetSpec{GoTemplate: true}}
+
+			params, err := matrixGenerator.GenerateParams(t.Context(), &v1alpha1.ApplicationSetGenerator{
+				Matrix: &v1alpha1.MatrixGenerator{
+					Generators: []v1alpha1.ApplicationSetNestedGenerator{
+						firstGenerator,
+						{Clusters: &v1alpha1.ClusterGenerator{Values: cc.values}},
+					},
+				},
+			}, appSet, nil)
+			require.NoError(t, err)
+
+			// the params of the second generator are not modified by the merges with the params of the first one
+			assert.Equal(t, []map[string]any{
+				{"env": "dev", "cluster": map[string]any{"name": "east", "env": "dev"}},
+				{"env": "dev", "cluster": map[string]any{"name": "west", "env": "dev"}},
+				{"env": "staging", "cluster": map[string]any{"name": "east"}},
+				{"env": "staging", "cluster": map[string]any{"name": "west"}},
+				{"env": "production", "cluster": map[string]any{"name": "east", "env": "production"}},
+				{"env": "production", "cluster": map[string]any{"name": "west", "env": "production"}},
+			}, params)
+			clusterGeneratorMock.AssertExpectations(t)
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package generators
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	"dario.cat/mergo"
@@ -36,10 +39,10 @@ func NewMergeGenerator(supportedGenerators map[string]Generator) Generator {
 
 // getParamSetsForAllGenerators generates params for each child generator in a MergeGenerator. Param sets are returned
 // in slices ordered according to the order of the given generators.
-func (m *MergeGenerator) getParamSetsForAllGenerators(generators []argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([][]map[string]any, error) {
+func (m *MergeGenerator) getParamSetsForAllGenerators(ctx context.Context, generators []argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([][]map[string]any, error) {
 	var paramSets [][]map[string]any
 	for i, generator := range generators {
-		generatorParamSets, err := m.getParams(generator, appSet, client)
+		generatorParamSets, err := m.getParams(ctx, generator, appSet, client)
 		if err != nil {
 			return nil, fmt.Errorf("error getting params from generator %d of %d: %w", i+1, len(generators), err)
 		}
@@ -50,7 +53,7 @@ func (m *MergeGenerator) getParamSetsForAllGenerators(generators []argoprojiov1a
 }
 
 // GenerateParams gets the params produced by the MergeGenerator.
-func (m *MergeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+func (m *MergeGenerator) GenerateParams(ctx context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
 	if appSetGenerator.Merge == nil {
 		return nil, ErrEmptyAppSetGenerator
 	}
@@ -59,26 +62,28 @@ func (m *MergeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.Appl
 		return nil, ErrLessThanTwoGeneratorsInMerge
 	}
 
-	paramSetsFromGenerators, err := m.getParamSetsForAllGenerators(appSetGenerator.Merge.Generators, appSet, client)
+	paramSetsFromGenerators, err := m.getParamSetsForAllGenerators(ctx, appSetGenerator.Merge.Generators, appSet, client)
 	if err != nil {
 		return nil, fmt.Errorf("error getting param sets from generators: %w", err)
 	}
 
-	baseParamSetsByMergeKey, err := getParamSetsByMergeKey(appSetGenerator.Merge.MergeKeys, paramSetsFromGenerators[0])
+	useGoTemplate := appSet.Spec.GoTemplate
+	baseParamSetsByMergeKey, err := getParamSetsByMergeKey(appSetGenerator.Merge.MergeKeys, paramSetsFromGenerators[0], useGoTemplate)
 	if err != nil {
-		return nil, fmt.Errorf("error getting param sets by merge key: %w", err)
+		return nil, fmt.Errorf("error getting param sets of generator 1 by merge key: %w", err)
 	}
 
-	for _, paramSets := range paramSetsFromGenerators[1:] {
-		paramSetsByMergeKey, err := getParamSetsByMergeKey(appSetGenerator.Merge.MergeKeys, paramSets)
+	for i, paramSets := range paramSetsFromGenerators[1:] {
+		paramSetsByMergeKey, err := getParamSetsByMergeKey(appSetGenerator.Merge.MergeKeys, paramSets, useGoTemplate)
 		if err != nil {
-			return nil, fmt.Errorf("error getting param sets by merge key: %w", err)
+			return nil, fmt.Errorf("error getting param sets of generator %d by merge key: %w", i+2, err)
 		}
 
 		for mergeKeyValue, baseParamSet := range baseParamSetsByMergeKey {
 			if overrideParamSet, exists := paramSetsByMergeKey[mergeKeyValue]; exists {
-				if appSet.Spec.GoTemplate {
-					if err := mergo.Merge(&baseParamSet, overrideParamSet, mergo.WithOverride); err != nil {
+				if useGoTemplate {
+					baseParamSet, err = mergeParamSets(baseParamSet, overrideParamSet, appSetGenerator.Merge.MergeStrategy)
+					if err != nil {
 						return nil, fmt.Errorf("error merging base param set with override param set: %w", err)
 					}
 					baseParamSetsByMergeKey[mergeKeyValue] = baseParamSet
@@ -90,40 +95,103 @@ func (m *MergeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.Appl
 		}
 	}
 
-	mergedParamSets := make([]map[string]any, len(baseParamSetsByMergeKey))
-	i := 0
-	for _, mergedParamSet := range baseParamSetsByMergeKey {
-		mergedParamSets[i] = mergedParamSet
-		i++
+	// Return the merged param sets in the order of the base param sets, so that the output is deterministic
+	mergedParamSets := make([]map[string]any, 0, len(baseParamSetsByMergeKey))
+	for _, paramSet := range paramSetsFromGenerators[0] {
+		mergeKeyValue, err := getMergeKeyValue(appSetGenerator.Merge.MergeKeys, paramSet, useGoTemplate)
+		if err != nil {
+			return nil, err
+		}
+		mergedParamSets = append(mergedParamSets, baseParamSetsByMergeKey[mergeKeyValue])
 	}
 
 	return mergedParamSets, nil
 }
 
+// mergeParamSets merges the override param set into the base param set of Go templates according to the merge strategy
+func mergeParamSets(baseParamSet, overrideParamSet map[string]any, strategy argoprojiov1alpha1.MergeStrategy) (map[string]any, error) {
+	switch strategy {
+	case "", argoprojiov1alpha1.MergeStrategyReplaceArrays:
+		if err := mergo.Merge(&baseParamSet, overrideParamSet, mergo.WithOverride); err != nil {
+			return nil, err
+		}
+	case argoprojiov1alpha1.MergeStrategyAppendArrays:
+		if err := mergo.Merge(&baseParamSet, overrideParamSet, mergo.WithOverride, mergo.WithAppendSlice); err != nil {
+			return nil, err
+		}
+	case argoprojiov1alpha1.MergeStrategyDeep:
+		baseParamSet = mergeParamsByIndex(baseParamSet, overrideParamSet).(map[string]any)
+	case argoprojiov1alpha1.MergeStrategyShallow:
+		maps.Copy(baseParamSet, overrideParamSet)
+	default:
+		return nil, fmt.Errorf("unknown merge strategy %q", strategy)
+	}
+	return baseParamSet, nil
+}
+
+// mergeParamsByIndex merges the override param into the base param: maps are merged key by key and arrays element by
+// element, the values of the override param taking precedence
+func mergeParamsByIndex(base, override any) any {
+	switch overrideValue := override.(type) {
+	case map[string]any:
+		if baseValue, ok := base.(map[string]any); ok {
+			res := maps.Clone(baseValue)
+			for k, v := range overrideValue {
+				if existing, ok := res[k]; ok {
+					v = mergeParamsByIndex(existing, v)
+				}
+				res[k] = v
+			}
+			return res
+		}
+	case []any:
+		if baseValue, ok := base.([]any); ok {
+			res := slices.Clone(baseValue)
+			for i, v := range overrideValue {
+				if i < len(res) {
+					res[i] = mergeParamsByIndex(res[i], v)
+				} else {
+					res = append(res, v)
+				}
+			}
+			return res
+		}
+	case map[string]string:
+		if baseValue, ok := base.(map[string]string); ok {
+			res := maps.Clone(baseValue)
+			maps.Copy(res, overrideValue)
+			return res
+		}
+	case []string:
+		if baseValue, ok := base.([]string); ok {
+			res := slices.Clone(baseValue)
+			for i, v := range overrideValue {
+				if i < len(res) {
+					res[i] = v
+				} else {
+					res = append(res, v)
+				}
+			}
+			return res
+		}
+	}
+	return override
+}
+
 // getParamSetsByMergeKey converts the given list of parameter sets to a map of parameter sets where the key is the
 // unique key of the parameter set as determined by the given mergeKeys. If any two parameter sets share the same merge
 // key, getParamSetsByMergeKey will throw NonUniqueParamSets.
-func getParamSetsByMergeKey(mergeKeys []string, paramSets []map[string]any) (map[string]map[string]any, error) {
+func getParamSetsByMergeKey(mergeKeys []string, paramSets []map[string]any, useGoTemplate bool) (map[string]map[string]any, error) {
 	if len(mergeKeys) < 1 {
 		return nil, ErrNoMergeKeys
 	}
 
-	deDuplicatedMergeKeys := make(map[string]bool, len(mergeKeys))
-	for _, mergeKey := range mergeKeys {
-		deDuplicatedMergeKeys[mergeKey] = false
-	}
-
 	paramSetsByMergeKey := make(map[string]map[string]any, len(paramSets))
 	for _, paramSet := range paramSets {
-		paramSetKey := make(map[string]any)
-		for mergeKey := range deDuplicatedMergeKeys {
-			paramSetKey[mergeKey] = paramSet[mergeKey]
-		}
-		paramSetKeyJSON, err := json.Marshal(paramSetKey)
+		paramSetKeyString, err := getMergeKeyValue(mergeKeys, paramSet, useGoTemplate)
 		if err != nil {
-			return nil, fmt.Errorf("error marshalling param set key json: %w", err)
+			return nil, err
 		}
-		paramSetKeyString := string(paramSetKeyJSON)
 		if _, exists := paramSetsByMergeKey[paramSetKeyString]; exists {
 			return nil, fmt.Errorf("%w. Duplicate key was %s", ErrNonUniqueParamSets, paramSetKeyString)
 		}
@@ -133,8 +201,40 @@ func getParamSetsByMergeKey(mergeKeys []string, paramSets []map[string]any) (map
 	return paramSetsByMergeKey, nil
 }
 
+// getMergeKeyValue returns the key of the given parameter set composed of the values of all mergeKeys. Parameter sets
+// of Go templates are nested, so a merge key is resolved as a dot-separated path if the parameter set has no parameter
+// of that name.
+func getMergeKeyValue(mergeKeys []string, paramSet map[string]any, useGoTemplate bool) (string, error) {
+	paramSetKey := make(map[string]any, len(mergeKeys))
+	for _, mergeKey := range mergeKeys {
+		value, exists := paramSet[mergeKey]
+		if !exists && useGoTemplate {
+			value = getNestedParam(paramSet, mergeKey)
+		}
+		paramSetKey[mergeKey] = value
+	}
+	paramSetKeyJSON, err := json.Marshal(paramSetKey)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling param set key json: %w", err)
+	}
+	return string(paramSetKeyJSON), nil
+}
+
+// getNestedParam returns the value at the given dot-separated path of the nested parameter set, or nil if there is none
+func getNestedParam(paramSet map[string]any, path string) any {
+	var value any = paramSet
+	for _, field := range strings.Split(path, ".") {
+		params, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = params[field]
+	}
+	return value
+}
+
 // getParams get the parameters generated by this generator.
-func (m *MergeGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+func (m *MergeGenerator) getParams(ctx context.Context, appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
 	matrixGen, err := getMatrixGenerator(appSetBaseGenerator)
 	if err != nil {
 		return nil, err
@@ -145,6 +245,7 @@ func (m *MergeGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Applic
 	}
 
 	t, err := Transform(
+		ctx,
 		argoprojiov1alpha1.ApplicationSetGenerator{
 			List:                    appSetBaseGenerator.List,
 			Clusters:                appSetBaseGenerator.Clusters,
@@ -153,9 +254,14 @@ func (m *MergeGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Applic
 			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
 			PullRequest:             appSetBaseGenerator.PullRequest,
 			Plugin:                  appSetBaseGenerator.Plugin,
+			Terraform:               appSetBaseGenerator.Terraform,
+			CloudInventory:          appSetBaseGenerator.CloudInventory,
+			KubernetesResource:      appSetBaseGenerator.KubernetesResource,
+			OCI:                     appSetBaseGenerator.OCI,
 			Matrix:                  matrixGen,
 			Merge:                   mergeGen,
 			Selector:                appSetBaseGenerator.Selector,
+			Transform:               appSetBaseGenerator.Transform,
 		},
 		m.supportedGenerators,
 		argoprojiov1alpha1.ApplicationSetTemplate{},
@@ -189,6 +295,10 @@ func (m *MergeGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.App
 			Git:                     r.Git,
 			PullRequest:             r.PullRequest,
 			Plugin:                  r.Plugin,
+			Terraform:               r.Terraform,
+			CloudInventory:          r.CloudInventory,
+			KubernetesResource:      r.KubernetesResource,
+			OCI:                     r.OCI,
 			SCMProvider:             r.SCMProvider,
 			ClusterDecisionResource: r.ClusterDecisionResource,
 			Matrix:                  matrixGen,
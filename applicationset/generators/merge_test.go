@@ -148,6 +148,22 @@ func TestMergeGenerate(t *testing.T) {
 				{"a": "2", "b": "2"},
 			},
 		},
+		{
+			name: "duplicate composite merge keys",
+			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				*getNestedListGenerator(`{"cluster": "a", "region": "eu"}`),
+				{
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"cluster": "a", "region": "eu", "c": "1"}`)},
+							{Raw: []byte(`{"cluster": "a", "region": "eu", "c": "2"}`)},
+						},
+					},
+				},
+			},
+			mergeKeys:   []string{"cluster", "region"},
+			expectedErr: fmt.Errorf("error getting param sets of generator 2 by merge key: %w. Duplicate key was %s", ErrNonUniqueParamSets, `{"cluster":"a","region":"eu"}`),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -174,7 +190,7 @@ func TestMergeGenerate(t *testing.T) {
 				},
 			)
 
-			got, err := mergeGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+			got, err := mergeGenerator.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{
 				Merge: &argoprojiov1alpha1.MergeGenerator{
 					Generators: testCaseCopy.baseGenerators,
 					MergeKeys:  testCaseCopy.mergeKeys,
@@ -198,6 +214,196 @@ func TestMergeGenerate(t *testing.T) {
 	}
 }
 
+func TestMergeGenerateCompositeKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		goTemplate    bool
+		baseGenerator string
+		generator     string
+		mergeKeys     []string
+		expected      []map[string]any
+	}{
+		{
+			name: "merged param sets keep the order of the base generator",
+			baseGenerator: `[
+				{"cluster": "b", "region": "us", "a": "1"},
+				{"cluster": "a", "region": "us", "a": "2"},
+				{"cluster": "a", "region": "eu", "a": "3"}
+			]`,
+			generator: `[
+				{"cluster": "a", "region": "eu", "b": "override"},
+				{"cluster": "b", "region": "eu", "b": "ignored"}
+			]`,
+			mergeKeys: []string{"cluster", "region"},
+			expected: []map[string]any{
+				{"cluster": "b", "region": "us", "a": "1"},
+				{"cluster": "a", "region": "us", "a": "2"},
+				{"cluster": "a", "region": "eu", "a": "3", "b": "override"},
+			},
+		},
+		{
+			name:       "nested merge keys with go templates",
+			goTemplate: true,
+			baseGenerator: `[
+				{"name": "a", "labels": {"region": "us"}},
+				{"name": "a", "labels": {"region": "eu"}}
+			]`,
+			generator: `[
+				{"name": "a", "labels": {"region": "eu"}, "values": {"size": "large"}}
+			]`,
+			mergeKeys: []string{"name", "labels.region"},
+			expected: []map[string]any{
+				{"name": "a", "labels": map[string]any{"region": "us"}},
+				{"name": "a", "labels": map[string]any{"region": "eu"}, "values": map[string]any{"size": "large"}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			listGenerator := func(elements string) argoprojiov1alpha1.ApplicationSetNestedGenerator {
+				var rawElements []apiextensionsv1.JSON
+				require.NoError(t, json.Unmarshal([]byte(elements), &rawElements))
+				return argoprojiov1alpha1.ApplicationSetNestedGenerator{
+					List: &argoprojiov1alpha1.ListGenerator{Elements: rawElements},
+				}
+			}
+			appSet := &argoprojiov1alpha1.ApplicationSet{
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: testCase.goTemplate},
+			}
+			mergeGenerator := NewMergeGenerator(map[string]Generator{"List": &ListGenerator{}})
+
+			got, err := mergeGenerator.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{
+				Merge: &argoprojiov1alpha1.MergeGenerator{
+					Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+						listGenerator(testCase.baseGenerator),
+						listGenerator(testCase.generator),
+					},
+					MergeKeys: testCase.mergeKeys,
+				},
+			}, appSet, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, got)
+		})
+	}
+}
+
+func TestMergeGenerateMergeStrategies(t *testing.T) {
+	t.Parallel()
+
+	baseGenerator := `[{"name": "a", "cluster": {"labels": {"env": "dev", "team": "a"}, "zones": ["z1", "z2"]}, "config": {"replicas": 1}}]`
+	generator := `[{"name": "a", "cluster": {"labels": {"env": "prod"}, "zones": ["z3"]}, "values": {"size": "large"}}]`
+
+	for _, testCase := range []struct {
+		strategy argoprojiov1alpha1.MergeStrategy
+		expected map[string]any
+	}{
+		{
+			strategy: "",
+			expected: map[string]any{
+				"name":    "a",
+				"cluster": map[string]any{"labels": map[string]any{"env": "prod", "team": "a"}, "zones": []any{"z3"}},
+				"config":  map[string]any{"replicas": float64(1)},
+				"values":  map[string]any{"size": "large"},
+			},
+		},
+		{
+			strategy: argoprojiov1alpha1.MergeStrategyReplaceArrays,
+			expected: map[string]any{
+				"name":    "a",
+				"cluster": map[string]any{"labels": map[string]any{"env": "prod", "team": "a"}, "zones": []any{"z3"}},
+				"config":  map[string]any{"replicas": float64(1)},
+				"values":  map[string]any{"size": "large"},
+			},
+		},
+		{
+			strategy: argoprojiov1alpha1.MergeStrategyDeep,
+			expected: map[string]any{
+				"name":    "a",
+				"cluster": map[string]any{"labels": map[string]any{"env": "prod", "team": "a"}, "zones": []any{"z3", "z2"}},
+				"config":  map[string]any{"replicas": float64(1)},
+				"values":  map[string]any{"size": "large"},
+			},
+		},
+		{
+			strategy: argoprojiov1alpha1.MergeStrategyAppendArrays,
+			expected: map[string]any{
+				"name":    "a",
+				"cluster": map[string]any{"labels": map[string]any{"env": "prod", "team": "a"}, "zones": []any{"z1", "z2", "z3"}},
+				"config":  map[string]any{"replicas": float64(1)},
+				"values":  map[string]any{"size": "large"},
+			},
+		},
+		{
+			strategy: argoprojiov1alpha1.MergeStrategyShallow,
+			expected: map[string]any{
+				"name":    "a",
+				"cluster": map[string]any{"labels": map[string]any{"env": "prod"}, "zones": []any{"z3"}},
+				"config":  map[string]any{"replicas": float64(1)},
+				"values":  map[string]any{"size": "large"},
+			},
+		},
+	} {
+		t.Run(string(testCase.strategy), func(t *testing.T) {
+			t.Parallel()
+
+			listGenerator := func(elements string) argoprojiov1alpha1.ApplicationSetNestedGenerator {
+				var rawElements []apiextensionsv1.JSON
+				require.NoError(t, json.Unmarshal([]byte(elements), &rawElements))
+				return argoprojiov1alpha1.ApplicationSetNestedGenerator{
+					List: &argoprojiov1alpha1.ListGenerator{Elements: rawElements},
+				}
+			}
+			appSet := &argoprojiov1alpha1.ApplicationSet{
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: true},
+			}
+			mergeGenerator := NewMergeGenerator(map[string]Generator{"List": &ListGenerator{}})
+
+			got, err := mergeGenerator.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{
+				Merge: &argoprojiov1alpha1.MergeGenerator{
+					Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+						listGenerator(baseGenerator),
+						listGenerator(generator),
+					},
+					MergeKeys:     []string{"name"},
+					MergeStrategy: testCase.strategy,
+				},
+			}, appSet, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, []map[string]any{testCase.expected}, got)
+		})
+	}
+}
+
+func TestMergeParamsByIndex(t *testing.T) {
+	t.Parallel()
+
+	base := map[string]any{
+		"labels": map[string]string{"env": "dev", "team": "a"},
+		"tags":   []string{"a", "b"},
+		"zones":  []any{map[string]any{"name": "z1", "size": "small"}},
+	}
+	override := map[string]any{
+		"labels": map[string]string{"env": "prod"},
+		"tags":   []string{"c", "d", "e"},
+		"zones":  []any{map[string]any{"size": "large"}, map[string]any{"name": "z2"}},
+	}
+
+	assert.Equal(t, map[string]any{
+		"labels": map[string]string{"env": "prod", "team": "a"},
+		"tags":   []string{"c", "d", "e"},
+		"zones":  []any{map[string]any{"name": "z1", "size": "large"}, map[string]any{"name": "z2"}},
+	}, mergeParamsByIndex(base, override))
+	// the base param is not modified
+	assert.Equal(t, map[string]string{"env": "dev", "team": "a"}, base["labels"])
+}
+
 func toAPIExtensionsJSON(t *testing.T, g any) *apiextensionsv1.JSON {
 	t.Helper()
 	resVal, err := json.Marshal(g)
@@ -340,7 +546,7 @@ func TestParamSetsAreUniqueByMergeKeys(t *testing.T) {
 		t.Run(testCaseCopy.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := getParamSetsByMergeKey(testCaseCopy.mergeKeys, testCaseCopy.paramSets)
+			got, err := getParamSetsByMergeKey(testCaseCopy.mergeKeys, testCaseCopy.paramSets, false)
 
 			if testCaseCopy.expectedErr != nil {
 				require.EqualError(t, err, testCaseCopy.expectedErr.Error())
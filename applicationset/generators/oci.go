@@ -0,0 +1,146 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*OCIGenerator)(nil)
+
+// OCIGenerator generates parameters from the tags of an OCI repository.
+type OCIGenerator struct {
+	repos services.Repos
+}
+
+func NewOCIGenerator(repos services.Repos) Generator {
+	return &OCIGenerator{
+		repos: repos,
+	}
+}
+
+func (g *OCIGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	if appSetGenerator.OCI.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.OCI.RequeueAfterSeconds) * time.Second
+	}
+
+	return getDefaultRequeueAfter()
+}
+
+func (g *OCIGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.OCI.Template
+}
+
+func (g *OCIGenerator) GenerateParams(ctx context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.OCI == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+	generatorConfig := appSetGenerator.OCI
+
+	if !strings.HasPrefix(generatorConfig.RepoURL, "oci://") {
+		return nil, fmt.Errorf("repoURL %q must start with oci://", generatorConfig.RepoURL)
+	}
+	if generatorConfig.Limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
+
+	// If the project field is templated, only globally-scoped repo credentials can be used, as for the Git generator
+	project := resolveProjectName(appSet.Spec.Template.Spec.Project)
+
+	tags, err := g.repos.GetOCITags(ctx, generatorConfig.RepoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error listing OCI tags: %w", err)
+	}
+
+	tags, err = filterOCITags(tags, generatorConfig.Constraint, generatorConfig.Limit)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("repoURL", generatorConfig.RepoURL).Debugf("found %d matching OCI tags", len(tags))
+
+	chart := path.Base(strings.TrimPrefix(generatorConfig.RepoURL, "oci://"))
+	res := make([]map[string]any, 0, len(tags))
+	for _, tag := range tags {
+		digest, err := g.repos.ResolveOCIDigest(ctx, generatorConfig.RepoURL, project, tag)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving the digest of OCI tag %s: %w", tag, err)
+		}
+
+		params := map[string]any{
+			"repoURL": generatorConfig.RepoURL,
+			"chart":   chart,
+			"version": tag,
+			"digest":  digest,
+		}
+
+		err = appendTemplatedValues(generatorConfig.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		res = append(res, params)
+	}
+
+	return res, nil
+}
+
+// filterOCITags returns the tags satisfying the semantic version constraint, highest version first, restricted to the
+// limit. The tags are returned as is if neither a constraint nor a limit is given.
+func filterOCITags(tags []string, constraint string, limit int64) ([]string, error) {
+	if constraint == "" && limit == 0 {
+		return tags, nil
+	}
+
+	var constraints *semver.Constraints
+	if constraint != "" {
+		var err error
+		constraints, err = semver.NewConstraint(constraint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semantic version constraint %q: %w", constraint, err)
+		}
+	}
+
+	type taggedVersion struct {
+		tag     string
+		version *semver.Version
+	}
+	var versions []taggedVersion
+	for _, tag := range tags {
+		version, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		if constraints != nil && !constraints.Check(version) {
+			continue
+		}
+		versions = append(versions, taggedVersion{tag: tag, version: version})
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].version.GreaterThan(versions[j].version)
+	})
+	if limit > 0 && int64(len(versions)) > limit {
+		versions = versions[:limit]
+	}
+
+	res := make([]string, 0, len(versions))
+	for _, version := range versions {
+		res = append(res, version.tag)
+	}
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestOCIGenerateParams(t *testing.T) {
+	const repoURL = "oci://ghcr.io/example/charts/guestbook"
+
+	for _, cc := range []struct {
+		name          string
+		generator     *argoprojiov1alpha1.OCIGenerator
+		tags          []string
+		tagsErr       error
+		expected      []map[string]any
+		expectedError string
+	}{
+		{
+			name:      "all tags",
+			generator: &argoprojiov1alpha1.OCIGenerator{RepoURL: repoURL},
+			tags:      []string{"latest", "1.0.0"},
+			expected: []map[string]any{
+				{"repoURL": repoURL, "chart": "guestbook", "version": "latest", "digest": "sha256:latest"},
+				{"repoURL": repoURL, "chart": "guestbook", "version": "1.0.0", "digest": "sha256:1.0.0"},
+			},
+		},
+		{
+			name:      "constraint and limit",
+			generator: &argoprojiov1alpha1.OCIGenerator{RepoURL: repoURL, Constraint: ">=1.0.0 <2.0.0", Limit: 2},
+			tags:      []string{"latest", "0.9.0", "1.0.0", "1.2.0", "1.10.0", "2.0.0"},
+			expected: []map[string]any{
+				{"repoURL": repoURL, "chart": "guestbook", "version": "1.10.0", "digest": "sha256:1.10.0"},
+				{"repoURL": repoURL, "chart": "guestbook", "version": "1.2.0", "digest": "sha256:1.2.0"},
+			},
+		},
+		{
+			name:      "values",
+			generator: &argoprojiov1alpha1.OCIGenerator{RepoURL: repoURL, Constraint: "1.0.x", Values: map[string]string{"name": "{{chart}}-{{version}}"}},
+			tags:      []string{"1.0.0", "1.1.0"},
+			expected: []map[string]any{
+				{"repoURL": repoURL, "chart": "guestbook", "version": "1.0.0", "digest": "sha256:1.0.0", "values.name": "guestbook-1.0.0"},
+			},
+		},
+		{
+			name:          "invalid constraint",
+			generator:     &argoprojiov1alpha1.OCIGenerator{RepoURL: repoURL, Constraint: "not a constraint"},
+			tags:          []string{"1.0.0"},
+			expectedError: `invalid semantic version constraint "not a constraint": improper constraint: not a constraint`,
+		},
+		{
+			name:          "not an OCI repository",
+			generator:     &argoprojiov1alpha1.OCIGenerator{RepoURL: "https://charts.example.com"},
+			expectedError: `repoURL "https://charts.example.com" must start with oci://`,
+		},
+		{
+			name:          "error listing tags",
+			generator:     &argoprojiov1alpha1.OCIGenerator{RepoURL: repoURL},
+			tagsErr:       errors.New("unauthorized"),
+			expectedError: "error listing OCI tags: unauthorized",
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			repos := mocks.NewRepos(t)
+			repos.EXPECT().GetOCITags(mock.Anything, repoURL, "default").Return(cc.tags, cc.tagsErr).Maybe()
+			repos.EXPECT().ResolveOCIDigest(mock.Anything, repoURL, "default", mock.Anything).RunAndReturn(func(_ context.Context, _, _, tag string) (string, error) {
+				return "sha256:" + tag, nil
+			}).Maybe()
+
+			appSet := &argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "set"},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					Template: argoprojiov1alpha1.ApplicationSetTemplate{Spec: argoprojiov1alpha1.ApplicationSpec{Project: "default"}},
+				},
+			}
+			got, err := NewOCIGenerator(repos).GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{OCI: cc.generator}, appSet, nil)
+			if cc.expectedError != "" {
+				require.EqualError(t, err, cc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, cc.expected, got)
+		})
+	}
+}
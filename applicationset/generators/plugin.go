@@ -2,10 +2,13 @@ package generators
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/jeremywohl/flatten"
@@ -20,6 +23,10 @@ import (
 
 const (
 	DefaultPluginRequeueAfter = 30 * time.Minute
+
+	// pluginCacheMaxIdle is how long a cached plugin response is kept without being used, e.g. after the input
+	// parameters of the generator changed
+	pluginCacheMaxIdle = 24 * time.Hour
 )
 
 var _ Generator = (*PluginGenerator)(nil)
@@ -27,16 +34,65 @@ var _ Generator = (*PluginGenerator)(nil)
 type PluginGenerator struct {
 	client    client.Client
 	namespace string
+	cache     *pluginResponseCache
 }
 
 func NewPluginGenerator(client client.Client, namespace string) Generator {
 	g := &PluginGenerator{
 		client:    client,
 		namespace: namespace,
+		cache:     &pluginResponseCache{entries: map[string]*pluginCacheEntry{}},
 	}
 	return g
 }
 
+// pluginResponseCache caches the parameters returned by the plugins, keyed by ApplicationSet, plugin and input
+// parameters
+type pluginResponseCache struct {
+	lock    sync.Mutex
+	entries map[string]*pluginCacheEntry
+}
+
+type pluginCacheEntry struct {
+	parameters []map[string]any
+	etag       string
+	fetchedAt  time.Time
+	usedAt     time.Time
+}
+
+func (c *pluginResponseCache) get(key string) *pluginCacheEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	entry.usedAt = time.Now()
+	copied := *entry
+	return &copied
+}
+
+func (c *pluginResponseCache) set(key string, entry *pluginCacheEntry) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := time.Now()
+	for k, e := range c.entries {
+		if now.Sub(e.usedAt) > pluginCacheMaxIdle {
+			delete(c.entries, k)
+		}
+	}
+	entry.usedAt = now
+	c.entries[key] = entry
+}
+
+func pluginCacheKey(appSet *argoprojiov1alpha1.ApplicationSet, configMapName string, parameters argoprojiov1alpha1.PluginParameters) (string, error) {
+	parametersJSON, err := json.Marshal(parameters)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling the input parameters: %w", err)
+	}
+	return fmt.Sprintf("%s/%s|%s|%x", appSet.Namespace, appSet.Name, configMapName, sha256.Sum256(parametersJSON)), nil
+}
+
 func (g *PluginGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
 	// Return a requeue default of 30 minutes, if no default is specified.
 
@@ -51,7 +107,7 @@ func (g *PluginGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.Applic
 	return &appSetGenerator.Plugin.Template
 }
 
-func (g *PluginGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+func (g *PluginGenerator) GenerateParams(ctx context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
 	if appSetGenerator == nil {
 		return nil, ErrEmptyAppSetGenerator
 	}
@@ -60,21 +116,19 @@ func (g *PluginGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 		return nil, ErrEmptyAppSetGenerator
 	}
 
-	ctx := context.Background()
-
 	providerConfig := appSetGenerator.Plugin
 
-	pluginClient, err := g.getPluginFromGenerator(ctx, applicationSetInfo.Name, providerConfig)
+	pluginClient, cacheTTL, err := g.getPluginFromGenerator(ctx, applicationSetInfo.Name, providerConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error getting plugin from generator: %w", err)
 	}
 
-	list, err := pluginClient.List(ctx, providerConfig.Input.Parameters)
+	parameters, err := g.listParameters(ctx, pluginClient, cacheTTL, applicationSetInfo, providerConfig)
 	if err != nil {
-		return nil, fmt.Errorf("error listing params: %w", err)
+		return nil, err
 	}
 
-	res, err := g.generateParams(appSetGenerator, applicationSetInfo, list.Output.Parameters, appSetGenerator.Plugin.Input.Parameters, applicationSetInfo.Spec.GoTemplate)
+	res, err := g.generateParams(appSetGenerator, applicationSetInfo, parameters, appSetGenerator.Plugin.Input.Parameters, applicationSetInfo.Spec.GoTemplate)
 	if err != nil {
 		return nil, fmt.Errorf("error generating params: %w", err)
 	}
@@ -82,14 +136,49 @@ func (g *PluginGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 	return res, nil
 }
 
-func (g *PluginGenerator) getPluginFromGenerator(ctx context.Context, appSetName string, generatorConfig *argoprojiov1alpha1.PluginGenerator) (*plugin.Service, error) {
+// listParameters returns the parameters of the plugin. The parameters are cached for the cache TTL of the plugin,
+// unless the ApplicationSet is refreshed, then revalidated with the ETag of the plugin response if it returned one.
+func (g *PluginGenerator) listParameters(ctx context.Context, pluginClient *plugin.Service, cacheTTL time.Duration, appSet *argoprojiov1alpha1.ApplicationSet, generatorConfig *argoprojiov1alpha1.PluginGenerator) ([]map[string]any, error) {
+	cacheKey, err := pluginCacheKey(appSet, generatorConfig.ConfigMapRef.Name, generatorConfig.Input.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	cached := g.cache.get(cacheKey)
+	if cached != nil && cacheTTL > 0 && time.Since(cached.fetchedAt) < cacheTTL && !appSet.RefreshRequired() {
+		return cached.parameters, nil
+	}
+
+	etag := ""
+	if cached != nil {
+		etag = cached.etag
+	}
+	list, etag, err := pluginClient.List(ctx, generatorConfig.Input.Parameters, etag)
+	if err != nil {
+		return nil, fmt.Errorf("error listing params: %w", err)
+	}
+
+	var parameters []map[string]any
+	if list == nil {
+		// the parameters were not modified since the cached response
+		parameters = cached.parameters
+	} else {
+		parameters = list.Output.Parameters
+	}
+	if cacheTTL > 0 || etag != "" {
+		g.cache.set(cacheKey, &pluginCacheEntry{parameters: parameters, etag: etag, fetchedAt: time.Now()})
+	}
+	return parameters, nil
+}
+
+func (g *PluginGenerator) getPluginFromGenerator(ctx context.Context, appSetName string, generatorConfig *argoprojiov1alpha1.PluginGenerator) (*plugin.Service, time.Duration, error) {
 	cm, err := g.getConfigMap(ctx, generatorConfig.ConfigMapRef.Name)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching ConfigMap: %w", err)
+		return nil, 0, fmt.Errorf("error fetching ConfigMap: %w", err)
 	}
 	token, err := g.getToken(ctx, cm["token"])
 	if err != nil {
-		return nil, fmt.Errorf("error fetching Secret token: %w", err)
+		return nil, 0, fmt.Errorf("error fetching Secret token: %w", err)
 	}
 
 	var requestTimeout int
@@ -97,15 +186,23 @@ func (g *PluginGenerator) getPluginFromGenerator(ctx context.Context, appSetName
 	if ok {
 		requestTimeout, err = strconv.Atoi(requestTimeoutStr)
 		if err != nil {
-			return nil, fmt.Errorf("error set requestTimeout : %w", err)
+			return nil, 0, fmt.Errorf("error set requestTimeout : %w", err)
+		}
+	}
+
+	var cacheTTL time.Duration
+	if cacheTTLStr, ok := cm["cacheTTL"]; ok {
+		cacheTTL, err = time.ParseDuration(cacheTTLStr)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error parsing cacheTTL: %w", err)
 		}
 	}
 
 	pluginClient, err := plugin.NewPluginService(appSetName, cm["baseUrl"], token, requestTimeout)
 	if err != nil {
-		return nil, fmt.Errorf("error initializing plugin client: %w", err)
+		return nil, 0, fmt.Errorf("error initializing plugin client: %w", err)
 	}
-	return pluginClient, nil
+	return pluginClient, cacheTTL, nil
 }
 
 func (g *PluginGenerator) generateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, objectsFound []map[string]any, pluginParams argoprojiov1alpha1.PluginParameters, useGoTemplate bool) ([]map[string]any, error) {
@@ -145,6 +242,16 @@ func (g *PluginGenerator) generateParams(appSetGenerator *argoprojiov1alpha1.App
 	return res, nil
 }
 
+// GetPluginToken returns the token of the plugin configured by the given ConfigMap, which the plugin also uses to sign
+// the payloads of the ApplicationSet webhook
+func (g *PluginGenerator) GetPluginToken(ctx context.Context, configMapName string) (string, error) {
+	cm, err := g.getConfigMap(ctx, configMapName)
+	if err != nil {
+		return "", fmt.Errorf("error fetching ConfigMap: %w", err)
+	}
+	return g.getToken(ctx, cm["token"])
+}
+
 func (g *PluginGenerator) getToken(ctx context.Context, tokenRef string) (string, error) {
 	if tokenRef == "" || !strings.HasPrefix(tokenRef, "$") {
 		return "", fmt.Errorf("token is empty, or does not reference a secret key starting with '$': %v", tokenRef)
@@ -675,7 +675,7 @@ func TestPluginGenerateParams(t *testing.T) {
 				},
 			}
 
-			got, err := pluginGenerator.GenerateParams(&generatorConfig, &applicationSetInfo, nil)
+			got, err := pluginGenerator.GenerateParams(t.Context(), &generatorConfig, &applicationSetInfo, nil)
 			if err != nil {
 				fmt.Println(err)
 			}
@@ -693,3 +693,69 @@ func TestPluginGenerateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestPluginGenerateParamsCache(t *testing.T) {
+	for _, cc := range []struct {
+		name             string
+		cacheTTL         string
+		etag             string
+		refresh          bool
+		expectedRequests int
+		expectedFetches  int
+	}{
+		{name: "no cache", expectedRequests: 3, expectedFetches: 3},
+		{name: "cache TTL", cacheTTL: "1h", expectedRequests: 1, expectedFetches: 1},
+		{name: "cache TTL with refresh", cacheTTL: "1h", refresh: true, expectedRequests: 3, expectedFetches: 3},
+		{name: "ETag", etag: `"v1"`, expectedRequests: 3, expectedFetches: 1},
+		{name: "expired cache TTL with ETag", cacheTTL: "1ns", etag: `"v1"`, expectedRequests: 3, expectedFetches: 1},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			requests, fetches := 0, 0
+			fakeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if cc.etag != "" && r.Header.Get("If-None-Match") == cc.etag {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				fetches++
+				if cc.etag != "" {
+					w.Header().Set("ETag", cc.etag)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, err := w.Write([]byte(`{"output": {"parameters": [{"key1": "val1"}]}}`))
+				assert.NoError(t, err)
+			}))
+			defer fakeServer.Close()
+
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "plugin-cm", Namespace: "default"},
+				Data:       map[string]string{"baseUrl": fakeServer.URL, "token": "$plugin:plugin.token"},
+			}
+			if cc.cacheTTL != "" {
+				configMap.Data["cacheTTL"] = cc.cacheTTL
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "plugin", Namespace: "default"},
+				Data:       map[string][]byte{"plugin.token": []byte("my-secret")},
+			}
+			pluginGenerator := NewPluginGenerator(fake.NewClientBuilder().WithObjects(configMap, secret).Build(), "default")
+
+			appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "default"}}
+			if cc.refresh {
+				appSet.Annotations = map[string]string{"argocd.argoproj.io/application-set-refresh": "true"}
+			}
+			generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+				Plugin: &argoprojiov1alpha1.PluginGenerator{ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: "plugin-cm"}},
+			}
+
+			for range 3 {
+				got, err := pluginGenerator.GenerateParams(t.Context(), &generatorConfig, appSet, nil)
+				require.NoError(t, err)
+				require.Len(t, got, 1)
+				assert.Equal(t, "val1", got[0]["key1"])
+			}
+			assert.Equal(t, cc.expectedRequests, requests)
+			assert.Equal(t, cc.expectedFetches, fetches)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,6 +17,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -56,7 +58,7 @@ func (g *PullRequestGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.A
 	return &appSetGenerator.PullRequest.Template
 }
 
-func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+func (g *PullRequestGenerator) GenerateParams(ctx context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
 	if appSetGenerator == nil {
 		return nil, ErrEmptyAppSetGenerator
 	}
@@ -65,7 +67,6 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		return nil, ErrEmptyAppSetGenerator
 	}
 
-	ctx := context.Background()
 	svc, err := g.selectServiceProviderFunc(ctx, appSetGenerator.PullRequest, applicationSetInfo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to select pull request service provider: %w", err)
@@ -82,6 +83,13 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		return nil, fmt.Errorf("error listing repos: %w", err)
 	}
 
+	if command := appSetGenerator.PullRequest.CommentCommand; command != "" {
+		pulls, err = filterCommandedPullRequests(pulls, appSetGenerator.PullRequest, applicationSetInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// In order to follow the DNS label standard as defined in RFC 1123,
 	// we need to limit the 'branch' to 50 to give room to append/suffix-ing it
 	// with 13 more characters. Also, there is the need to clean it as recommended
@@ -117,6 +125,12 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 			"head_short_sha":     pull.HeadSHA[:shortSHALength],
 			"head_short_sha_7":   pull.HeadSHA[:shortSHALength7],
 			"author":             pull.Author,
+			"draft":              strconv.FormatBool(pull.Draft),
+		}
+		if pull.BaseNumber != 0 {
+			paramMap["base_number"] = strconv.FormatInt(pull.BaseNumber, 10)
+		} else {
+			paramMap["base_number"] = ""
 		}
 
 		// PR lables will only be supported for Go Template appsets, since fasttemplate will be deprecated.
@@ -133,6 +147,47 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 	return params, nil
 }
 
+// PullRequestCommandKey returns the key recording in the AnnotationApplicationSetPullRequestCommands annotation of an
+// ApplicationSet that the comment command of a Pull Request generator was commented on the given pull request
+func PullRequestCommandKey(repository string, number int64) string {
+	return fmt.Sprintf("%s#%d", strings.ToLower(repository), number)
+}
+
+// PullRequestCommandRepository returns the repository of a Pull Request generator as identified in the keys of the
+// AnnotationApplicationSetPullRequestCommands annotation, or an empty string if comment commands are not supported by
+// the provider
+func PullRequestCommandRepository(generatorConfig *argoprojiov1alpha1.PullRequestGenerator) string {
+	switch {
+	case generatorConfig.Github != nil:
+		return generatorConfig.Github.Owner + "/" + generatorConfig.Github.Repo
+	case generatorConfig.GitLab != nil:
+		return generatorConfig.GitLab.Project
+	}
+	return ""
+}
+
+// filterCommandedPullRequests returns the pull requests on which the comment command of the given generator was
+// commented, as recorded by the webhook in the annotations of the ApplicationSet
+func filterCommandedPullRequests(pulls []*pullrequest.PullRequest, generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) ([]*pullrequest.PullRequest, error) {
+	repository := PullRequestCommandRepository(generatorConfig)
+	if repository == "" {
+		return nil, errors.New("comment commands are only supported for GitHub and GitLab pull requests")
+	}
+	commanded := map[string]bool{}
+	if applicationSetInfo != nil {
+		for _, key := range strings.Split(applicationSetInfo.GetAnnotations()[common.AnnotationApplicationSetPullRequestCommands], ",") {
+			commanded[key] = true
+		}
+	}
+	filtered := make([]*pullrequest.PullRequest, 0, len(pulls))
+	for _, pull := range pulls {
+		if commanded[PullRequestCommandKey(repository, pull.Number)] {
+			filtered = append(filtered, pull)
+		}
+	}
+	return filtered, nil
+}
+
 // selectServiceProvider selects the provider to get pull requests from the configuration
 func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
 	if !g.enableSCMProviders {
@@ -159,7 +214,7 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Secret token: %w", err)
 		}
-		return pullrequest.NewGitLabService(token, providerConfig.API, providerConfig.Project, providerConfig.Labels, providerConfig.PullRequestState, g.scmRootCAPath, providerConfig.Insecure, caCerts)
+		return pullrequest.NewGitLabService(ctx, token, providerConfig.API, providerConfig.Project, providerConfig.Labels, providerConfig.PullRequestState, g.scmRootCAPath, providerConfig.Insecure, caCerts)
 	}
 	if generatorConfig.Gitea != nil {
 		providerConfig := generatorConfig.Gitea
@@ -168,7 +223,7 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 			return nil, fmt.Errorf("error fetching Secret token: %w", err)
 		}
 
-		return pullrequest.NewGiteaService(token, providerConfig.API, providerConfig.Owner, providerConfig.Repo, providerConfig.Labels, providerConfig.Insecure)
+		return pullrequest.NewGiteaService(ctx, token, providerConfig.API, providerConfig.Owner, providerConfig.Repo, providerConfig.Labels, providerConfig.Insecure)
 	}
 	if generatorConfig.BitbucketServer != nil {
 		providerConfig := generatorConfig.BitbucketServer
@@ -202,15 +257,15 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 			if err != nil {
 				return nil, fmt.Errorf("error fetching Secret Bearer token: %w", err)
 			}
-			return pullrequest.NewBitbucketCloudServiceBearerToken(providerConfig.API, appToken, providerConfig.Owner, providerConfig.Repo)
+			return pullrequest.NewBitbucketCloudServiceBearerToken(ctx, providerConfig.API, appToken, providerConfig.Owner, providerConfig.Repo)
 		} else if providerConfig.BasicAuth != nil {
 			password, err := utils.GetSecretRef(ctx, g.client, providerConfig.BasicAuth.PasswordRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
 			if err != nil {
 				return nil, fmt.Errorf("error fetching Secret token: %w", err)
 			}
-			return pullrequest.NewBitbucketCloudServiceBasicAuth(providerConfig.API, providerConfig.BasicAuth.Username, password, providerConfig.Owner, providerConfig.Repo)
+			return pullrequest.NewBitbucketCloudServiceBasicAuth(ctx, providerConfig.API, providerConfig.BasicAuth.Username, password, providerConfig.Owner, providerConfig.Repo)
 		}
-		return pullrequest.NewBitbucketCloudServiceNoAuth(providerConfig.API, providerConfig.Owner, providerConfig.Repo)
+		return pullrequest.NewBitbucketCloudServiceNoAuth(ctx, providerConfig.API, providerConfig.Owner, providerConfig.Repo)
 	}
 	if generatorConfig.AzureDevOps != nil {
 		providerConfig := generatorConfig.AzureDevOps
@@ -234,6 +289,7 @@ func (g *PullRequestGenerator) github(ctx context.Context, cfg *argoprojiov1alph
 		}
 		httpClient = services.NewGitHubMetricsClient(metricsCtx)
 	}
+	httpClient = services.NewCorrelationIDClient(ctx, httpClient)
 
 	// use an app if it was configured
 	if cfg.AppSecretName != "" {
@@ -242,10 +298,7 @@ func (g *PullRequestGenerator) github(ctx context.Context, cfg *argoprojiov1alph
 			return nil, fmt.Errorf("error getting GitHub App secret: %w", err)
 		}
 
-		if g.enableGitHubAPIMetrics {
-			return pullrequest.NewGithubAppService(ctx, *auth, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, httpClient)
-		}
-		return pullrequest.NewGithubAppService(ctx, *auth, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels)
+		return pullrequest.NewGithubAppService(ctx, *auth, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, httpClient)
 	}
 
 	// always default to token, even if not set (public access)
@@ -254,8 +307,5 @@ func (g *PullRequestGenerator) github(ctx context.Context, cfg *argoprojiov1alph
 		return nil, fmt.Errorf("error fetching Secret token: %w", err)
 	}
 
-	if g.enableGitHubAPIMetrics {
-		return pullrequest.NewGithubService(token, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, httpClient)
-	}
-	return pullrequest.NewGithubService(token, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels)
+	return pullrequest.NewGithubService(token, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, httpClient)
 }
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/common"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -52,6 +53,8 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_short_sha":     "089d92cb",
 					"head_short_sha_7":   "089d92c",
 					"author":             "testName",
+					"draft":              "false",
+					"base_number":        "",
 				},
 			},
 			expectedErr: nil,
@@ -85,6 +88,8 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_short_sha":     "9b34ff5b",
 					"head_short_sha_7":   "9b34ff5",
 					"author":             "testName",
+					"draft":              "false",
+					"base_number":        "",
 				},
 			},
 			expectedErr: nil,
@@ -101,6 +106,7 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 							TargetBranch: "master",
 							HeadSHA:      "abcd",
 							Author:       "testName",
+							Draft:        true,
 						},
 					},
 					nil,
@@ -118,6 +124,8 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_short_sha":     "abcd",
 					"head_short_sha_7":   "abcd",
 					"author":             "testName",
+					"draft":              "true",
+					"base_number":        "",
 				},
 			},
 			expectedErr: nil,
@@ -155,6 +163,8 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_short_sha":     "abcd",
 					"head_short_sha_7":   "abcd",
 					"author":             "testName",
+					"draft":              "false",
+					"base_number":        "",
 					"values.foo":         "bar",
 					"values.pr_branch":   "my_branch",
 				},
@@ -227,6 +237,8 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_short_sha_7":   "089d92c",
 					"labels":             []string{"preview"},
 					"author":             "testName",
+					"draft":              "false",
+					"base_number":        "",
 				},
 			},
 			expectedErr: nil,
@@ -267,6 +279,8 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_short_sha":     "089d92cb",
 					"head_short_sha_7":   "089d92c",
 					"author":             "testName",
+					"draft":              "false",
+					"base_number":        "",
 				},
 			},
 			expectedErr: nil,
@@ -310,6 +324,8 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 					"head_short_sha":     "abcd",
 					"head_short_sha_7":   "abcd",
 					"author":             "testName",
+					"draft":              "false",
+					"base_number":        "",
 					"labels":             []string{"preview", "preview:team1"},
 					"values":             map[string]string{"preview_env": "team1"},
 				},
@@ -335,7 +351,7 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 			},
 		}
 
-		got, gotErr := gen.GenerateParams(&generatorConfig, &c.applicationSet, nil)
+		got, gotErr := gen.GenerateParams(t.Context(), &generatorConfig, &c.applicationSet, nil)
 		if c.expectedErr != nil {
 			require.EqualError(t, gotErr, c.expectedErr.Error())
 		} else {
@@ -345,6 +361,53 @@ func TestPullRequestGithubGenerateParams(t *testing.T) {
 	}
 }
 
+func TestPullRequestGeneratorCommentCommand(t *testing.T) {
+	gen := PullRequestGenerator{
+		selectServiceProviderFunc: func(ctx context.Context, _ *argoprojiov1alpha1.PullRequestGenerator, _ *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+			return pullrequest.NewFakeService(
+				ctx,
+				[]*pullrequest.PullRequest{
+					{Number: 1, Branch: "one", TargetBranch: "main", HeadSHA: "089d92cbf9ff857a39e6feccd32798ca700fb958"},
+					{Number: 2, Branch: "two", TargetBranch: "one", HeadSHA: "189d92cbf9ff857a39e6feccd32798ca700fb958"},
+					{Number: 3, Branch: "three", TargetBranch: "main", HeadSHA: "289d92cbf9ff857a39e6feccd32798ca700fb958"},
+				},
+				nil,
+			)
+		},
+	}
+	appSet := &argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "set",
+			Annotations: map[string]string{
+				common.AnnotationApplicationSetPullRequestCommands: "argoproj/argo-cd#2,argoproj/other#3",
+			},
+		},
+	}
+
+	t.Run("only commanded pull requests", func(t *testing.T) {
+		got, err := gen.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{
+			PullRequest: &argoprojiov1alpha1.PullRequestGenerator{
+				Github:         &argoprojiov1alpha1.PullRequestGeneratorGithub{Owner: "ArgoProj", Repo: "argo-cd"},
+				CommentCommand: "/deploy-preview",
+			},
+		}, appSet, nil)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "2", got[0]["number"])
+		assert.Equal(t, "1", got[0]["base_number"])
+	})
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		_, err := gen.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{
+			PullRequest: &argoprojiov1alpha1.PullRequestGenerator{
+				Gitea:          &argoprojiov1alpha1.PullRequestGeneratorGitea{Owner: "argoproj", Repo: "argo-cd"},
+				CommentCommand: "/deploy-preview",
+			},
+		}, appSet, nil)
+		require.Error(t, err)
+	})
+}
+
 func TestAllowedSCMProviderPullRequest(t *testing.T) {
 	t.Parallel()
 
@@ -398,7 +461,7 @@ func TestAllowedSCMProviderPullRequest(t *testing.T) {
 				"gitea.myorg.com",
 				"bitbucket.myorg.com",
 				"azuredevops.myorg.com",
-			}, true, true, nil, true))
+			}, true, true, nil, true, false, 1, nil))
 
 			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
@@ -411,7 +474,7 @@ func TestAllowedSCMProviderPullRequest(t *testing.T) {
 				},
 			}
 
-			_, err := pullRequestGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+			_, err := pullRequestGenerator.GenerateParams(t.Context(), &applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
 
 			require.Error(t, err, "Must return an error")
 			var expectedError ErrDisallowedSCMProvider
@@ -421,7 +484,7 @@ func TestAllowedSCMProviderPullRequest(t *testing.T) {
 }
 
 func TestSCMProviderDisabled_PRGenerator(t *testing.T) {
-	generator := NewPullRequestGenerator(nil, NewSCMConfig("", []string{}, false, true, nil, true))
+	generator := NewPullRequestGenerator(nil, NewSCMConfig("", []string{}, false, true, nil, true, false, 1, nil))
 
 	applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -438,6 +501,6 @@ func TestSCMProviderDisabled_PRGenerator(t *testing.T) {
 		},
 	}
 
-	_, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+	_, err := generator.GenerateParams(t.Context(), &applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
 	assert.ErrorIs(t, err, ErrSCMProvidersDisabled)
 }
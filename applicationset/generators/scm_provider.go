@@ -2,6 +2,7 @@ package generators
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -39,17 +40,29 @@ type SCMConfig struct {
 	enableGitHubAPIMetrics bool
 	GitHubApps             github_app_auth.Credentials
 	tokenRefStrictMode     bool
+	// conditionalRequestCache holds the SCM provider responses used to issue conditional requests, nil if disabled
+	conditionalRequestCache *services.ConditionalRequestCache
+	// scmProviderConcurrency is the maximum number of repositories scanned at the same time by the SCM provider generator
+	scmProviderConcurrency int
+	// rateLimitBudget is the rate limit budget of the SCM provider APIs, nil if requests are not rate limited
+	rateLimitBudget *services.SCMRateLimitBudget
 }
 
-func NewSCMConfig(scmRootCAPath string, allowedSCMProviders []string, enableSCMProviders bool, enableGitHubAPIMetrics bool, gitHubApps github_app_auth.Credentials, tokenRefStrictMode bool) SCMConfig {
-	return SCMConfig{
+func NewSCMConfig(scmRootCAPath string, allowedSCMProviders []string, enableSCMProviders bool, enableGitHubAPIMetrics bool, gitHubApps github_app_auth.Credentials, tokenRefStrictMode bool, enableConditionalRequests bool, scmProviderConcurrency int, rateLimitBudget *services.SCMRateLimitBudget) SCMConfig {
+	scmConfig := SCMConfig{
 		scmRootCAPath:          scmRootCAPath,
 		allowedSCMProviders:    allowedSCMProviders,
 		enableSCMProviders:     enableSCMProviders,
 		enableGitHubAPIMetrics: enableGitHubAPIMetrics,
 		GitHubApps:             gitHubApps,
 		tokenRefStrictMode:     tokenRefStrictMode,
+		scmProviderConcurrency: scmProviderConcurrency,
+		rateLimitBudget:        rateLimitBudget,
 	}
+	if enableConditionalRequests {
+		scmConfig.conditionalRequestCache = services.NewConditionalRequestCache(services.DefaultConditionalRequestCacheExpiration)
+	}
+	return scmConfig
 }
 
 func NewSCMProviderGenerator(client client.Client, scmConfig SCMConfig) Generator {
@@ -120,7 +133,7 @@ func ScmProviderAllowed(applicationSetInfo *argoprojiov1alpha1.ApplicationSet, g
 	return NewErrDisallowedSCMProvider(url, allowedScmProviders)
 }
 
-func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+func (g *SCMProviderGenerator) GenerateParams(ctx context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
 	if appSetGenerator == nil {
 		return nil, ErrEmptyAppSetGenerator
 	}
@@ -140,14 +153,13 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		return nil, fmt.Errorf("scm provider not allowed: %w", err)
 	}
 
-	ctx := context.Background()
 	var provider scm_provider.SCMProviderService
 	switch {
 	case g.overrideProvider != nil:
 		provider = g.overrideProvider
 	case providerConfig.Github != nil:
 		var err error
-		provider, err = g.githubProvider(ctx, providerConfig.Github, applicationSetInfo)
+		provider, err = g.githubProvider(ctx, providerConfig.Github, providerConfig.Filters, applicationSetInfo)
 		if err != nil {
 			return nil, fmt.Errorf("scm provider: %w", err)
 		}
@@ -165,7 +177,7 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Gitlab token: %w", err)
 		}
-		provider, err = scm_provider.NewGitlabProvider(providerConfig.Group, token, providerConfig.API, providerConfig.AllBranches, providerConfig.IncludeSubgroups, providerConfig.WillIncludeSharedProjects(), providerConfig.Insecure, g.scmRootCAPath, providerConfig.Topic, caCerts)
+		provider, err = scm_provider.NewGitlabProvider(ctx, providerConfig.Group, token, providerConfig.API, providerConfig.AllBranches, providerConfig.IncludeSubgroups, providerConfig.WillIncludeSharedProjects(), providerConfig.Insecure, g.scmRootCAPath, providerConfig.Topic, caCerts)
 		if err != nil {
 			return nil, fmt.Errorf("error initializing Gitlab service: %w", err)
 		}
@@ -174,7 +186,7 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Gitea token: %w", err)
 		}
-		provider, err = scm_provider.NewGiteaProvider(providerConfig.Gitea.Owner, token, providerConfig.Gitea.API, providerConfig.Gitea.AllBranches, providerConfig.Gitea.Insecure)
+		provider, err = scm_provider.NewGiteaProvider(ctx, providerConfig.Gitea.Owner, token, providerConfig.Gitea.API, providerConfig.Gitea.AllBranches, providerConfig.Gitea.Insecure)
 		if err != nil {
 			return nil, fmt.Errorf("error initializing Gitea service: %w", err)
 		}
@@ -221,7 +233,7 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Bitbucket cloud appPassword: %w", err)
 		}
-		provider, err = scm_provider.NewBitBucketCloudProvider(providerConfig.Bitbucket.Owner, providerConfig.Bitbucket.User, appPassword, providerConfig.Bitbucket.AllBranches)
+		provider, err = scm_provider.NewBitBucketCloudProvider(ctx, providerConfig.Bitbucket.Owner, providerConfig.Bitbucket.User, appPassword, providerConfig.Bitbucket.AllBranches)
 		if err != nil {
 			return nil, fmt.Errorf("error initializing Bitbucket cloud service: %w", err)
 		}
@@ -236,7 +248,7 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 	}
 
 	// Find all the available repos.
-	repos, err := scm_provider.ListRepos(ctx, provider, providerConfig.Filters, providerConfig.CloneProtocol)
+	repos, err := scm_provider.ListReposConcurrently(ctx, provider, providerConfig.Filters, providerConfig.CloneProtocol, g.scmProviderConcurrency)
 	if err != nil {
 		return nil, fmt.Errorf("error listing repos: %w", err)
 	}
@@ -277,7 +289,7 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 	return paramsArray, nil
 }
 
-func (g *SCMProviderGenerator) githubProvider(ctx context.Context, github *argoprojiov1alpha1.SCMProviderGeneratorGithub, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (scm_provider.SCMProviderService, error) {
+func (g *SCMProviderGenerator) githubProvider(ctx context.Context, github *argoprojiov1alpha1.SCMProviderGeneratorGithub, filters []argoprojiov1alpha1.SCMProviderGeneratorFilter, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (scm_provider.SCMProviderService, error) {
 	var metricsCtx *services.MetricsContext
 	var httpClient *http.Client
 
@@ -288,6 +300,18 @@ func (g *SCMProviderGenerator) githubProvider(ctx context.Context, github *argop
 		}
 		httpClient = services.NewGitHubMetricsClient(metricsCtx)
 	}
+	if g.rateLimitBudget != nil {
+		httpClient = services.NewRateLimitClient(httpClient, g.rateLimitBudget)
+	}
+	if g.conditionalRequestCache != nil {
+		// Unchanged repository listings are answered with 304 Not Modified, which does not count against the rate limit
+		scope, err := conditionalRequestScope(github, filters)
+		if err != nil {
+			return nil, err
+		}
+		httpClient = services.NewConditionalRequestClient(httpClient, g.conditionalRequestCache, scope)
+	}
+	httpClient = services.NewCorrelationIDClient(ctx, httpClient)
 
 	if github.AppSecretName != "" {
 		auth, err := g.GitHubApps.GetAuthSecret(ctx, github.AppSecretName)
@@ -295,10 +319,7 @@ func (g *SCMProviderGenerator) githubProvider(ctx context.Context, github *argop
 			return nil, fmt.Errorf("error fetching Github app secret: %w", err)
 		}
 
-		if g.enableGitHubAPIMetrics {
-			return scm_provider.NewGithubAppProviderFor(ctx, *auth, github.Organization, github.API, github.AllBranches, httpClient)
-		}
-		return scm_provider.NewGithubAppProviderFor(ctx, *auth, github.Organization, github.API, github.AllBranches)
+		return scm_provider.NewGithubAppProviderFor(ctx, *auth, github.Organization, github.API, github.AllBranches, httpClient)
 	}
 
 	token, err := utils.GetSecretRef(ctx, g.client, github.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
@@ -306,8 +327,19 @@ func (g *SCMProviderGenerator) githubProvider(ctx context.Context, github *argop
 		return nil, fmt.Errorf("error fetching Github token: %w", err)
 	}
 
-	if g.enableGitHubAPIMetrics {
-		return scm_provider.NewGithubProvider(github.Organization, token, github.API, github.AllBranches, httpClient)
+	return scm_provider.NewGithubProvider(github.Organization, token, github.API, github.AllBranches, httpClient)
+}
+
+// conditionalRequestScope returns the scope of the cached responses of the given GitHub SCM provider, so that the
+// listings of an organization are cached per API, organization and filters
+func conditionalRequestScope(github *argoprojiov1alpha1.SCMProviderGeneratorGithub, filters []argoprojiov1alpha1.SCMProviderGeneratorFilter) (string, error) {
+	scope, err := json.Marshal(struct {
+		API          string                                          `json:"api"`
+		Organization string                                          `json:"organization"`
+		Filters      []argoprojiov1alpha1.SCMProviderGeneratorFilter `json:"filters"`
+	}{github.API, github.Organization, filters})
+	if err != nil {
+		return "", fmt.Errorf("error computing the scope of conditional requests: %w", err)
 	}
-	return scm_provider.NewGithubProvider(github.Organization, token, github.API, github.AllBranches)
+	return string(scope), nil
 }
@@ -0,0 +1,232 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jeremywohl/flatten"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/terraform"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var _ Generator = (*TerraformGenerator)(nil)
+
+const (
+	DefaultTerraformRequeueAfter = 30 * time.Minute
+)
+
+var ErrTerraformGeneratorDisabled = errors.New("the terraform generator is disabled")
+
+// TerraformConfig holds the operator settings of the Terraform generator
+type TerraformConfig struct {
+	enabled bool
+	// allowedEndpoints are the URLs of the S3 compatible APIs and of the Terraform Enterprise instances which may be
+	// used instead of AWS S3 and HCP Terraform
+	allowedEndpoints []string
+}
+
+func NewTerraformConfig(enabled bool, allowedEndpoints []string) TerraformConfig {
+	return TerraformConfig{
+		enabled:          enabled,
+		allowedEndpoints: allowedEndpoints,
+	}
+}
+
+type TerraformGenerator struct {
+	client client.Client
+	SCMConfig
+	TerraformConfig
+	// Testing hooks.
+	selectServiceFunc func(ctx context.Context, generatorConfig *argoprojiov1alpha1.TerraformGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (terraform.StateService, error)
+}
+
+func NewTerraformGenerator(client client.Client, scmConfig SCMConfig, terraformConfig TerraformConfig) Generator {
+	g := &TerraformGenerator{
+		client:          client,
+		SCMConfig:       scmConfig,
+		TerraformConfig: terraformConfig,
+	}
+	g.selectServiceFunc = g.selectService
+	return g
+}
+
+func (g *TerraformGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.Terraform.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.Terraform.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultTerraformRequeueAfter
+}
+
+func (g *TerraformGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.Terraform.Template
+}
+
+func (g *TerraformGenerator) GenerateParams(ctx context.Context, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.Terraform == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+	if !g.enabled {
+		return nil, ErrTerraformGeneratorDisabled
+	}
+
+	generatorConfig := appSetGenerator.Terraform
+
+	var nameMatch *regexp.Regexp
+	if generatorConfig.WorkspaceMatch != "" {
+		var err error
+		nameMatch, err = regexp.Compile(generatorConfig.WorkspaceMatch)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling workspaceMatch regex: %w", err)
+		}
+	}
+
+	service, err := g.selectServiceFunc(ctx, generatorConfig, applicationSetInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select Terraform backend: %w", err)
+	}
+
+	workspaces, err := service.ListWorkspaces(ctx, nameMatch)
+	if err != nil {
+		return nil, fmt.Errorf("error listing Terraform workspaces: %w", err)
+	}
+
+	params := make([]map[string]any, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		var items []any
+		if generatorConfig.ExpandOutput != "" {
+			output, ok := workspace.Outputs[generatorConfig.ExpandOutput]
+			if !ok {
+				// the output might not have been applied to every workspace yet
+				continue
+			}
+			items, ok = output.([]any)
+			if !ok {
+				return nil, fmt.Errorf("output %q of workspace %s is not a list", generatorConfig.ExpandOutput, workspace.Name)
+			}
+		} else {
+			items = []any{nil}
+		}
+
+		for _, item := range items {
+			paramMap := map[string]any{
+				"workspace": workspace.Name,
+				"outputs":   workspace.Outputs,
+			}
+			if generatorConfig.ExpandOutput != "" {
+				paramMap["item"] = item
+			}
+			if !applicationSetInfo.Spec.GoTemplate {
+				flat, err := flatten.Flatten(paramMap, "", flatten.DotStyle)
+				if err != nil {
+					return nil, fmt.Errorf("error flattening params of workspace %s: %w", workspace.Name, err)
+				}
+				paramMap = make(map[string]any, len(flat))
+				for k, v := range flat {
+					paramMap[k] = fmt.Sprintf("%v", v)
+				}
+			}
+
+			err := appendTemplatedValues(generatorConfig.Values, paramMap, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to append templated values: %w", err)
+			}
+
+			params = append(params, paramMap)
+		}
+	}
+
+	return params, nil
+}
+
+// endpointAllowed returns an error if the endpoint is not in the endpoints allowed by the operator
+func (g *TerraformGenerator) endpointAllowed(endpoint string) error {
+	if slices.Contains(g.allowedEndpoints, strings.TrimSuffix(endpoint, "/")) || slices.Contains(g.allowedEndpoints, endpoint) {
+		return nil
+	}
+	return fmt.Errorf("endpoint %q not allowed, must use one of the following: %s", endpoint, strings.Join(g.allowedEndpoints, ", "))
+}
+
+// selectService returns the state service for the backend of the generator. Exactly one backend must be configured.
+// The credentials must be given with secret references: the credentials of the ApplicationSet controller itself, e.g.
+// its pod identity, are never used.
+func (g *TerraformGenerator) selectService(ctx context.Context, generatorConfig *argoprojiov1alpha1.TerraformGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (terraform.StateService, error) {
+	backends := 0
+	for _, configured := range []bool{generatorConfig.S3 != nil, generatorConfig.GCS != nil, generatorConfig.TerraformCloud != nil} {
+		if configured {
+			backends++
+		}
+	}
+	if backends != 1 {
+		return nil, errors.New("exactly one of s3, gcs or terraformCloud must be specified")
+	}
+
+	switch {
+	case generatorConfig.S3 != nil:
+		providerConfig := generatorConfig.S3
+		if providerConfig.Endpoint != "" {
+			if err := g.endpointAllowed(providerConfig.Endpoint); err != nil {
+				return nil, err
+			}
+		}
+		accessKeyID, secretAccessKey, err := getAWSCredentials(ctx, g.client, providerConfig.AccessKeyIDRef, providerConfig.SecretAccessKeyRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching S3 credentials: %w", err)
+		}
+		service, err := terraform.NewS3Service(providerConfig.Bucket, providerConfig.Key, providerConfig.WorkspaceKeyPrefix, terraform.S3Options{
+			Region:          providerConfig.Region,
+			Role:            providerConfig.Role,
+			Endpoint:        providerConfig.Endpoint,
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error initializing S3 service: %w", err)
+		}
+		return service, nil
+	case generatorConfig.GCS != nil:
+		providerConfig := generatorConfig.GCS
+		credentials, err := getGoogleCredentials(ctx, g.client, providerConfig.CredentialsRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Google Cloud credentials: %w", err)
+		}
+		service, err := terraform.NewGCSService(ctx, providerConfig.Bucket, providerConfig.Prefix, credentials)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing GCS service: %w", err)
+		}
+		return service, nil
+	default:
+		providerConfig := generatorConfig.TerraformCloud
+		if providerConfig.TokenRef == nil {
+			return nil, errors.New("terraformCloud requires tokenRef")
+		}
+		if providerConfig.API != "" && strings.TrimSuffix(providerConfig.API, "/") != terraform.DefaultTerraformCloudAPI {
+			if err := g.endpointAllowed(providerConfig.API); err != nil {
+				return nil, err
+			}
+		}
+		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Terraform Cloud token: %w", err)
+		}
+		service, err := terraform.NewTerraformCloudService(providerConfig.Organization, providerConfig.API, token)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing Terraform Cloud service: %w", err)
+		}
+		return service, nil
+	}
+}
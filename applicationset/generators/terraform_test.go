@@ -0,0 +1,225 @@
+package generators
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/terraform"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeTerraformStateService struct {
+	workspaces []terraform.Workspace
+}
+
+func (s *fakeTerraformStateService) ListWorkspaces(_ context.Context, nameMatch *regexp.Regexp) ([]terraform.Workspace, error) {
+	var workspaces []terraform.Workspace
+	for _, ws := range s.workspaces {
+		if nameMatch == nil || nameMatch.MatchString(ws.Name) {
+			workspaces = append(workspaces, ws)
+		}
+	}
+	return workspaces, nil
+}
+
+func TestTerraformGenerateParams(t *testing.T) {
+	service := &fakeTerraformStateService{workspaces: []terraform.Workspace{
+		{Name: "prod-eu", Outputs: map[string]any{
+			"cluster": map[string]any{"name": "prod-eu", "server": "https://prod-eu.example.com"},
+			"tenants": []any{"team-a", "team-b"},
+		}},
+		{Name: "staging", Outputs: map[string]any{
+			"cluster": map[string]any{"name": "staging", "server": "https://staging.example.com"},
+		}},
+	}}
+
+	cases := []struct {
+		name          string
+		generator     *argoprojiov1alpha1.TerraformGenerator
+		goTemplate    bool
+		expected      []map[string]any
+		expectedError string
+	}{
+		{
+			name:      "flat params",
+			generator: &argoprojiov1alpha1.TerraformGenerator{WorkspaceMatch: "^prod-", Values: map[string]string{"env": "{{workspace}}"}},
+			expected: []map[string]any{
+				{
+					"workspace":              "prod-eu",
+					"outputs.cluster.name":   "prod-eu",
+					"outputs.cluster.server": "https://prod-eu.example.com",
+					"outputs.tenants.0":      "team-a",
+					"outputs.tenants.1":      "team-b",
+					"values.env":             "prod-eu",
+				},
+			},
+		},
+		{
+			name:       "go template params",
+			generator:  &argoprojiov1alpha1.TerraformGenerator{},
+			goTemplate: true,
+			expected: []map[string]any{
+				{"workspace": "prod-eu", "outputs": service.workspaces[0].Outputs},
+				{"workspace": "staging", "outputs": service.workspaces[1].Outputs},
+			},
+		},
+		{
+			name:       "expand output",
+			generator:  &argoprojiov1alpha1.TerraformGenerator{ExpandOutput: "tenants", Values: map[string]string{"app": "{{ .workspace }}-{{ .item }}"}},
+			goTemplate: true,
+			expected: []map[string]any{
+				{"workspace": "prod-eu", "outputs": service.workspaces[0].Outputs, "item": "team-a", "values": map[string]string{"app": "prod-eu-team-a"}},
+				{"workspace": "prod-eu", "outputs": service.workspaces[0].Outputs, "item": "team-b", "values": map[string]string{"app": "prod-eu-team-b"}},
+			},
+		},
+		{
+			name:          "expand output which is not a list",
+			generator:     &argoprojiov1alpha1.TerraformGenerator{ExpandOutput: "cluster"},
+			expectedError: `output "cluster" of workspace prod-eu is not a list`,
+		},
+		{
+			name:          "invalid workspace regex",
+			generator:     &argoprojiov1alpha1.TerraformGenerator{WorkspaceMatch: "("},
+			expectedError: "error compiling workspaceMatch regex",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gen := &TerraformGenerator{
+				TerraformConfig: NewTerraformConfig(true, nil),
+				selectServiceFunc: func(context.Context, *argoprojiov1alpha1.TerraformGenerator, *argoprojiov1alpha1.ApplicationSet) (terraform.StateService, error) {
+					return service, nil
+				},
+			}
+			appSet := &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: c.goTemplate}}
+			params, err := gen.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{Terraform: c.generator}, appSet, nil)
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, params)
+		})
+	}
+}
+
+func TestTerraformGenerateParamsDisabled(t *testing.T) {
+	gen := NewTerraformGenerator(nil, SCMConfig{}, NewTerraformConfig(false, nil))
+	_, err := gen.GenerateParams(t.Context(), &argoprojiov1alpha1.ApplicationSetGenerator{Terraform: &argoprojiov1alpha1.TerraformGenerator{}}, &argoprojiov1alpha1.ApplicationSet{}, nil)
+	require.ErrorIs(t, err, ErrTerraformGeneratorDisabled)
+}
+
+func TestTerraformSelectService(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "argocd"},
+		Data: map[string][]byte{
+			"accessKeyID":     []byte("AKIAEXAMPLE"),
+			"secretAccessKey": []byte("secret"),
+			"token":           []byte("token"),
+		},
+	}
+	gen := NewTerraformGenerator(fake.NewClientBuilder().WithObjects(secret).Build(), SCMConfig{}, NewTerraformConfig(true, []string{"https://minio.example.com", "https://tfe.example.com"})).(*TerraformGenerator)
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Namespace: "argocd"}}
+	s3 := func(endpoint string) *argoprojiov1alpha1.TerraformGeneratorS3 {
+		return &argoprojiov1alpha1.TerraformGeneratorS3{
+			Bucket:             "states",
+			Region:             "eu-west-1",
+			Endpoint:           endpoint,
+			AccessKeyIDRef:     &argoprojiov1alpha1.SecretRef{SecretName: "creds", Key: "accessKeyID"},
+			SecretAccessKeyRef: &argoprojiov1alpha1.SecretRef{SecretName: "creds", Key: "secretAccessKey"},
+		}
+	}
+	terraformCloud := func(api string) *argoprojiov1alpha1.TerraformGeneratorTerraformCloud {
+		return &argoprojiov1alpha1.TerraformGeneratorTerraformCloud{
+			Organization: "example",
+			API:          api,
+			TokenRef:     &argoprojiov1alpha1.SecretRef{SecretName: "creds", Key: "token"},
+		}
+	}
+
+	cases := []struct {
+		name          string
+		generator     *argoprojiov1alpha1.TerraformGenerator
+		expectedType  terraform.StateService
+		expectedError string
+	}{
+		{
+			name:          "no backend",
+			generator:     &argoprojiov1alpha1.TerraformGenerator{},
+			expectedError: "exactly one of s3, gcs or terraformCloud must be specified",
+		},
+		{
+			name: "several backends",
+			generator: &argoprojiov1alpha1.TerraformGenerator{
+				S3:  s3(""),
+				GCS: &argoprojiov1alpha1.TerraformGeneratorGCS{Bucket: "states"},
+			},
+			expectedError: "exactly one of s3, gcs or terraformCloud must be specified",
+		},
+		{
+			name:         "s3",
+			generator:    &argoprojiov1alpha1.TerraformGenerator{S3: s3("")},
+			expectedType: &terraform.S3Service{},
+		},
+		{
+			name:         "s3 with allowed endpoint",
+			generator:    &argoprojiov1alpha1.TerraformGenerator{S3: s3("https://minio.example.com/")},
+			expectedType: &terraform.S3Service{},
+		},
+		{
+			name:          "s3 with disallowed endpoint",
+			generator:     &argoprojiov1alpha1.TerraformGenerator{S3: s3("http://169.254.169.254")},
+			expectedError: `endpoint "http://169.254.169.254" not allowed`,
+		},
+		{
+			name:          "s3 without credentials",
+			generator:     &argoprojiov1alpha1.TerraformGenerator{S3: &argoprojiov1alpha1.TerraformGeneratorS3{Bucket: "states", Region: "eu-west-1"}},
+			expectedError: "error fetching S3 credentials: accessKeyIDRef and secretAccessKeyRef are required",
+		},
+		{
+			name:          "gcs without credentials",
+			generator:     &argoprojiov1alpha1.TerraformGenerator{GCS: &argoprojiov1alpha1.TerraformGeneratorGCS{Bucket: "states"}},
+			expectedError: "error fetching Google Cloud credentials: credentialsRef is required",
+		},
+		{
+			name:         "terraform cloud",
+			generator:    &argoprojiov1alpha1.TerraformGenerator{TerraformCloud: terraformCloud(terraform.DefaultTerraformCloudAPI)},
+			expectedType: &terraform.TerraformCloudService{},
+		},
+		{
+			name:         "terraform enterprise with allowed address",
+			generator:    &argoprojiov1alpha1.TerraformGenerator{TerraformCloud: terraformCloud("https://tfe.example.com")},
+			expectedType: &terraform.TerraformCloudService{},
+		},
+		{
+			name:          "terraform enterprise with disallowed address",
+			generator:     &argoprojiov1alpha1.TerraformGenerator{TerraformCloud: terraformCloud("https://internal.example.com")},
+			expectedError: `endpoint "https://internal.example.com" not allowed`,
+		},
+		{
+			name:          "terraform cloud without token",
+			generator:     &argoprojiov1alpha1.TerraformGenerator{TerraformCloud: &argoprojiov1alpha1.TerraformGeneratorTerraformCloud{Organization: "example"}},
+			expectedError: "terraformCloud requires tokenRef",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			service, err := gen.selectService(t.Context(), c.generator, appSet)
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, c.expectedType, service)
+		})
+	}
+}
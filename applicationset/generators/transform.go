@@ -0,0 +1,140 @@
+package generators
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// transformParamsVariable is the name of the variable holding the parameter set in transform expressions
+	transformParamsVariable = "params"
+	// transformCostLimit limits the cost of evaluating a single transform expression, so that an expensive
+	// expression cannot block the reconciliation of the ApplicationSet controller
+	transformCostLimit = 1000000
+)
+
+var structValueType = reflect.TypeOf(&structpb.Value{})
+
+// paramsTransformer applies the transform of a generator to the parameter sets generated by it
+type paramsTransformer struct {
+	filter     cel.Program
+	fields     []argoprojiov1alpha1.ApplicationSetGeneratorTransformField
+	programs   []cel.Program
+	remove     []string
+	goTemplate bool
+}
+
+// newParamsTransformer compiles the expressions of the given transform
+func newParamsTransformer(transform *argoprojiov1alpha1.ApplicationSetGeneratorTransform, goTemplate bool) (*paramsTransformer, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(transformParamsVariable, cel.MapType(cel.StringType, cel.DynType)),
+		ext.Strings(),
+		ext.Lists(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL environment: %w", err)
+	}
+	t := &paramsTransformer{fields: transform.Fields, remove: transform.Remove, goTemplate: goTemplate}
+	if transform.Filter != "" {
+		t.filter, err = compileTransformExpression(env, transform.Filter, cel.BoolType)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling transform filter: %w", err)
+		}
+	}
+	for _, field := range transform.Fields {
+		if field.Name == "" {
+			return nil, errors.New("transform field name must not be empty")
+		}
+		program, err := compileTransformExpression(env, field.Expression, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling expression of transform field %q: %w", field.Name, err)
+		}
+		t.programs = append(t.programs, program)
+	}
+	return t, nil
+}
+
+func compileTransformExpression(env *cel.Env, expression string, outputType *cel.Type) (cel.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if outputType != nil && !ast.OutputType().IsAssignableType(outputType) {
+		return nil, fmt.Errorf("expression must evaluate to %s, but evaluates to %s", outputType, ast.OutputType())
+	}
+	return env.Program(ast, cel.CostLimit(transformCostLimit))
+}
+
+// Apply filters the given parameter sets and derives the fields of the transform for the remaining ones. The given
+// parameter sets are not modified.
+func (t *paramsTransformer) Apply(params []map[string]any) ([]map[string]any, error) {
+	var res []map[string]any
+	for _, param := range params {
+		if t.filter != nil {
+			val, _, err := t.filter.Eval(map[string]any{transformParamsVariable: param})
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating transform filter: %w", err)
+			}
+			matches, ok := val.(types.Bool)
+			if !ok {
+				return nil, fmt.Errorf("transform filter must evaluate to a boolean, but evaluates to %s", val.Type())
+			}
+			if !matches {
+				continue
+			}
+		}
+
+		transformed := make(map[string]any, len(param)+len(t.fields))
+		for k, v := range param {
+			transformed[k] = v
+		}
+		for i, field := range t.fields {
+			val, _, err := t.programs[i].Eval(map[string]any{transformParamsVariable: transformed})
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating expression of transform field %q: %w", field.Name, err)
+			}
+			value, err := t.toParamValue(val)
+			if err != nil {
+				return nil, fmt.Errorf("error converting value of transform field %q: %w", field.Name, err)
+			}
+			transformed[field.Name] = value
+		}
+		for _, name := range t.remove {
+			delete(transformed, name)
+		}
+		res = append(res, transformed)
+	}
+	return res, nil
+}
+
+// toParamValue converts the result of an expression to a parameter value. Without Go templates parameters are strings,
+// so only scalar results are accepted and converted to their string representation.
+func (t *paramsTransformer) toParamValue(val ref.Val) (any, error) {
+	native, err := val.ConvertToNative(structValueType)
+	if err != nil {
+		return nil, err
+	}
+	value := native.(*structpb.Value).AsInterface()
+	if t.goTemplate {
+		return value, nil
+	}
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool, float64:
+		return fmt.Sprintf("%v", val.Value()), nil
+	case nil:
+		return "", nil
+	default:
+		return nil, fmt.Errorf("expression must evaluate to a scalar value when goTemplate is disabled, but evaluates to %s", val.Type())
+	}
+}
@@ -0,0 +1,137 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestParamsTransformer(t *testing.T) {
+	testCases := []struct {
+		name          string
+		transform     argov1alpha1.ApplicationSetGeneratorTransform
+		goTemplate    bool
+		params        []map[string]any
+		expected      []map[string]any
+		expectedError string
+	}{
+		{
+			name: "filter",
+			transform: argov1alpha1.ApplicationSetGeneratorTransform{
+				Filter: `params.branch.startsWith("release-")`,
+			},
+			params:   []map[string]any{{"branch": "main"}, {"branch": "release-1.0"}},
+			expected: []map[string]any{{"branch": "release-1.0"}},
+		},
+		{
+			name: "derive and rename fields",
+			transform: argov1alpha1.ApplicationSetGeneratorTransform{
+				Fields: []argov1alpha1.ApplicationSetGeneratorTransformField{
+					{Name: "repo", Expression: `params.repository`},
+					{Name: "shortName", Expression: `params.repo.split("-")[0]`},
+					{Name: "replicas", Expression: `size(params.repo)`},
+				},
+				Remove: []string{"repository"},
+			},
+			params:   []map[string]any{{"repository": "guestbook-ui"}},
+			expected: []map[string]any{{"repo": "guestbook-ui", "shortName": "guestbook", "replicas": "12"}},
+		},
+		{
+			name: "go template",
+			transform: argov1alpha1.ApplicationSetGeneratorTransform{
+				Filter: `params.metadata.labels.env != "dev"`,
+				Fields: []argov1alpha1.ApplicationSetGeneratorTransformField{
+					{Name: "tags", Expression: `params.metadata.labels.map(k, k)`},
+				},
+			},
+			goTemplate: true,
+			params: []map[string]any{
+				{"metadata": map[string]any{"labels": map[string]any{"env": "dev"}}},
+				{"metadata": map[string]any{"labels": map[string]any{"env": "prod"}}},
+			},
+			expected: []map[string]any{{"metadata": map[string]any{"labels": map[string]any{"env": "prod"}}, "tags": []any{"env"}}},
+		},
+		{
+			name: "filter must evaluate to a boolean",
+			transform: argov1alpha1.ApplicationSetGeneratorTransform{
+				Filter: `"true"`,
+			},
+			params:        []map[string]any{{"branch": "main"}},
+			expectedError: "expression must evaluate to bool",
+		},
+		{
+			name: "invalid expression",
+			transform: argov1alpha1.ApplicationSetGeneratorTransform{
+				Fields: []argov1alpha1.ApplicationSetGeneratorTransformField{{Name: "name", Expression: `params.name +`}},
+			},
+			params:        []map[string]any{{"name": "main"}},
+			expectedError: `error compiling expression of transform field "name"`,
+		},
+		{
+			name: "missing parameter",
+			transform: argov1alpha1.ApplicationSetGeneratorTransform{
+				Fields: []argov1alpha1.ApplicationSetGeneratorTransformField{{Name: "name", Expression: `params.missing`}},
+			},
+			params:        []map[string]any{{"name": "main"}},
+			expectedError: `error evaluating expression of transform field "name"`,
+		},
+		{
+			name: "non-scalar value without go template",
+			transform: argov1alpha1.ApplicationSetGeneratorTransform{
+				Fields: []argov1alpha1.ApplicationSetGeneratorTransformField{{Name: "names", Expression: `[params.name]`}},
+			},
+			params:        []map[string]any{{"name": "main"}},
+			expectedError: "expression must evaluate to a scalar value when goTemplate is disabled",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			transformer, err := newParamsTransformer(&testCase.transform, testCase.goTemplate)
+			var res []map[string]any
+			if err == nil {
+				res, err = transformer.Apply(testCase.params)
+			}
+			if testCase.expectedError != "" {
+				require.ErrorContains(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, res)
+		})
+	}
+}
+
+func TestTransformWithGeneratorTransform(t *testing.T) {
+	applicationSetInfo := argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "set",
+		},
+	}
+
+	results, err := Transform(t.Context(), argov1alpha1.ApplicationSetGenerator{
+		List: &argov1alpha1.ListGenerator{
+			Elements: []apiextensionsv1.JSON{
+				{Raw: []byte(`{"cluster": "production-west", "url": "https://west.example.com"}`)},
+				{Raw: []byte(`{"cluster": "staging-east", "url": "https://east.example.com"}`)},
+			},
+		},
+		Transform: &argov1alpha1.ApplicationSetGeneratorTransform{
+			Filter: `params.cluster.startsWith("production")`,
+			Fields: []argov1alpha1.ApplicationSetGeneratorTransformField{{Name: "region", Expression: `params.cluster.split("-")[1]`}},
+		},
+		// the selector is applied to the transformed parameters
+		Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "west"}},
+	},
+		map[string]Generator{"List": NewListGenerator()},
+		emptyTemplate(),
+		&applicationSetInfo, nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{"cluster": "production-west", "url": "https://west.example.com", "region": "west"}}, results[0].Params)
+}
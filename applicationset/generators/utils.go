@@ -10,7 +10,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
-func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, controllerNamespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig) map[string]Generator {
+func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, controllerNamespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig, kubernetesResourceConfig KubernetesResourceConfig, terraformConfig TerraformConfig, cloudInventoryConfig CloudInventoryConfig) map[string]Generator {
 	terminalGenerators := map[string]Generator{
 		"List":                    NewListGenerator(),
 		"Clusters":                NewClusterGenerator(ctx, c, k8sClient, controllerNamespace),
@@ -19,6 +19,10 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": NewDuckTypeGenerator(ctx, dynamicClient, k8sClient, controllerNamespace),
 		"PullRequest":             NewPullRequestGenerator(c, scmConfig),
 		"Plugin":                  NewPluginGenerator(c, controllerNamespace),
+		"Terraform":               NewTerraformGenerator(c, scmConfig, terraformConfig),
+		"CloudInventory":          NewCloudInventoryGenerator(c, scmConfig, cloudInventoryConfig),
+		"KubernetesResource":      NewKubernetesResourceGenerator(ctx, c, dynamicClient, k8sClient, controllerNamespace, kubernetesResourceConfig),
+		"OCI":                     NewOCIGenerator(argoCDService),
 	}
 
 	nestedGenerators := map[string]Generator{
@@ -29,6 +33,10 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
+		"Terraform":               terminalGenerators["Terraform"],
+		"CloudInventory":          terminalGenerators["CloudInventory"],
+		"KubernetesResource":      terminalGenerators["KubernetesResource"],
+		"OCI":                     terminalGenerators["OCI"],
 		"Matrix":                  NewMatrixGenerator(terminalGenerators),
 		"Merge":                   NewMergeGenerator(terminalGenerators),
 	}
@@ -41,6 +49,10 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
+		"Terraform":               terminalGenerators["Terraform"],
+		"CloudInventory":          terminalGenerators["CloudInventory"],
+		"KubernetesResource":      terminalGenerators["KubernetesResource"],
+		"OCI":                     terminalGenerators["OCI"],
 		"Matrix":                  NewMatrixGenerator(nestedGenerators),
 		"Merge":                   NewMergeGenerator(nestedGenerators),
 	}
@@ -15,7 +15,12 @@ func NewFakeAppsetMetrics() *ApplicationsetMetrics {
 		[]string{"name", "namespace"},
 	)
 
+	generatorDurationHistogram, generatorParameterSetsHistogram, generatorErrorsCounter := newGeneratorMetrics()
+
 	return &ApplicationsetMetrics{
-		reconcileHistogram: reconcileHistogram,
+		reconcileHistogram:              reconcileHistogram,
+		generatorDurationHistogram:      generatorDurationHistogram,
+		generatorParameterSetsHistogram: generatorParameterSetsHistogram,
+		generatorErrorsCounter:          generatorErrorsCounter,
 	}
 }
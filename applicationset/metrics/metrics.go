@@ -29,10 +29,20 @@ var (
 		descAppsetDefaultLabels,
 		nil,
 	)
+
+	descAppsetRolloutFailedApps = prometheus.NewDesc(
+		"argocd_appset_rollout_failed_applications",
+		"Number of applications of the applicationset which timed out in their progressive sync step",
+		append(descAppsetDefaultLabels, "step"),
+		nil,
+	)
 )
 
 type ApplicationsetMetrics struct {
-	reconcileHistogram *prometheus.HistogramVec
+	reconcileHistogram              *prometheus.HistogramVec
+	generatorDurationHistogram      *prometheus.HistogramVec
+	generatorParameterSetsHistogram *prometheus.HistogramVec
+	generatorErrorsCounter          *prometheus.CounterVec
 }
 
 type appsetCollector struct {
@@ -52,24 +62,73 @@ func NewApplicationsetMetrics(appsetLister applisters.ApplicationSetLister, apps
 		descAppsetDefaultLabels,
 	)
 
+	generatorDurationHistogram, generatorParameterSetsHistogram, generatorErrorsCounter := newGeneratorMetrics()
+
 	appsetCollector := newAppsetCollector(appsetLister, appsetLabels, appsetFilter)
 
 	// Register collectors and metrics
 	metrics.Registry.MustRegister(reconcileHistogram)
+	metrics.Registry.MustRegister(generatorDurationHistogram)
+	metrics.Registry.MustRegister(generatorParameterSetsHistogram)
+	metrics.Registry.MustRegister(generatorErrorsCounter)
 	metrics.Registry.MustRegister(appsetCollector)
 
 	kubectl.RegisterWithClientGo()
 	kubectl.RegisterWithPrometheus(metrics.Registry)
 
 	return ApplicationsetMetrics{
-		reconcileHistogram: reconcileHistogram,
+		reconcileHistogram:              reconcileHistogram,
+		generatorDurationHistogram:      generatorDurationHistogram,
+		generatorParameterSetsHistogram: generatorParameterSetsHistogram,
+		generatorErrorsCounter:          generatorErrorsCounter,
 	}
 }
 
+// newGeneratorMetrics returns the metrics of the executions of the generators, labeled by the namespace and name of
+// the applicationset and the kind of the generator
+func newGeneratorMetrics() (*prometheus.HistogramVec, *prometheus.HistogramVec, *prometheus.CounterVec) {
+	generatorLabels := append(descAppsetDefaultLabels, "generator")
+	generatorDurationHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_appset_generator_duration_seconds",
+			Help:    "Generator execution time in seconds.",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		},
+		generatorLabels,
+	)
+	generatorParameterSetsHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_appset_generator_parameter_sets",
+			Help:    "Number of parameter sets generated by a generator execution.",
+			Buckets: prometheus.ExponentialBuckets(1, 4, 8),
+		},
+		generatorLabels,
+	)
+	generatorErrorsCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_appset_generator_errors_total",
+			Help: "Number of generator executions which failed to generate parameters.",
+		},
+		generatorLabels,
+	)
+	return generatorDurationHistogram, generatorParameterSetsHistogram, generatorErrorsCounter
+}
+
 func (m *ApplicationsetMetrics) ObserveReconcile(appset *argoappv1.ApplicationSet, duration time.Duration) {
 	m.reconcileHistogram.WithLabelValues(appset.Namespace, appset.Name).Observe(duration.Seconds())
 }
 
+// ObserveGenerator records the execution of a generator of the given kind, e.g. git, of the given applicationset. The
+// number of parameter sets is only observed if the generator succeeded.
+func (m *ApplicationsetMetrics) ObserveGenerator(appset *argoappv1.ApplicationSet, generator string, duration time.Duration, parameterSets int, err error) {
+	m.generatorDurationHistogram.WithLabelValues(appset.Namespace, appset.Name, generator).Observe(duration.Seconds())
+	if err != nil {
+		m.generatorErrorsCounter.WithLabelValues(appset.Namespace, appset.Name, generator).Inc()
+		return
+	}
+	m.generatorParameterSetsHistogram.WithLabelValues(appset.Namespace, appset.Name, generator).Observe(float64(parameterSets))
+}
+
 func newAppsetCollector(lister applisters.ApplicationSetLister, labels []string, filter func(appset *argoappv1.ApplicationSet) bool) *appsetCollector {
 	descAppsetDefaultLabels = []string{"namespace", "name"}
 
@@ -93,6 +152,7 @@ func newAppsetCollector(lister applisters.ApplicationSetLister, labels []string,
 func (c *appsetCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- descAppsetInfo
 	ch <- descAppsetGeneratedApps
+	ch <- descAppsetRolloutFailedApps
 
 	if len(c.labels) > 0 {
 		ch <- descAppsetLabels
@@ -132,4 +192,14 @@ func collectAppset(appset *argoappv1.ApplicationSet, labelsToCollect []string, c
 
 	ch <- prometheus.MustNewConstMetric(descAppsetInfo, prometheus.GaugeValue, 1, appset.Namespace, appset.Name, resourceUpdateStatus)
 	ch <- prometheus.MustNewConstMetric(descAppsetGeneratedApps, prometheus.GaugeValue, float64(len(appset.Status.Resources)), appset.Namespace, appset.Name)
+
+	failedAppsPerStep := map[string]int{}
+	for _, appStatus := range appset.Status.ApplicationStatus {
+		if appStatus.Status == argoappv1.ProgressiveSyncFailed {
+			failedAppsPerStep[appStatus.Step]++
+		}
+	}
+	for step, failedApps := range failedAppsPerStep {
+		ch <- prometheus.MustNewConstMetric(descAppsetRolloutFailedApps, prometheus.GaugeValue, float64(failedApps), appset.Namespace, appset.Name, step)
+	}
 }
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -94,6 +95,17 @@ status:
     reason: ApplicationSetUpToDate
     status: "True"
     type: ResourcesUpToDate
+  applicationStatus:
+  - application: test-app1
+    message: Application resource stayed Progressing for longer than the timeout of step 1 of 10m0s, updating status to Failed
+    status: Failed
+    step: "1"
+    targetRevisions: []
+  - application: test-app2
+    message: Application resource became Healthy, updating status from Progressing to Healthy
+    status: Healthy
+    step: "1"
+    targetRevisions: []
 ---
 apiVersion: argoproj.io/v1alpha1
 kind: ApplicationSet
@@ -205,6 +217,11 @@ argocd_appset_info{name="test2",namespace="argocd",resource_update_status="Unkno
 	assert.Contains(t, rr.Body.String(), `
 argocd_appset_owned_applications{name="test2",namespace="argocd"} 0
 `)
+	// Test that the applications which timed out in their step are counted
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_rollout_failed_applications{name="test1",namespace="argocd",step="1"} 1
+`)
+	assert.NotContains(t, rr.Body.String(), `argocd_appset_rollout_failed_applications{name="test2"`)
 	// Test that filter is working
 	assert.NotContains(t, rr.Body.String(), `name="should-be-filtered-out"`)
 }
@@ -250,3 +267,34 @@ func initializeClient(appsets []argoappv1.ApplicationSet) ctrlclient.WithWatch {
 func normalizeLabel(label string) string {
 	return metricsutil.NormalizeLabels("label", []string{label})[0]
 }
+
+func TestObserveGenerator(t *testing.T) {
+	appsetList := newFakeAppsets(fakeAppsetList)
+	client := initializeClient(appsetList)
+	metrics.Registry = prometheus.NewRegistry()
+
+	appsetMetrics := NewApplicationsetMetrics(utils.NewAppsetLister(client), collectedLabels, filter)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", http.NoBody)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	handler := promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
+	appsetMetrics.ObserveGenerator(&appsetList[0], "git", 2*time.Second, 3, nil)
+	appsetMetrics.ObserveGenerator(&appsetList[0], "plugin", time.Second, 0, errors.New("plugin unavailable"))
+	handler.ServeHTTP(rr, req)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generator_duration_seconds_sum{generator="git",name="test1",namespace="argocd"} 2
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generator_parameter_sets_sum{generator="git",name="test1",namespace="argocd"} 3
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generator_duration_seconds_count{generator="plugin",name="test1",namespace="argocd"} 1
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generator_errors_total{generator="plugin",name="test1",namespace="argocd"} 1
+`)
+	// the parameter sets of failed generators are not observed
+	assert.NotContains(t, rr.Body.String(), `argocd_appset_generator_parameter_sets_count{generator="plugin"`)
+	assert.NotContains(t, rr.Body.String(), `argocd_appset_generator_errors_total{generator="git"`)
+}
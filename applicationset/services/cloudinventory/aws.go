@@ -0,0 +1,85 @@
+package cloudinventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/internal/cloud"
+)
+
+var _ InventoryService = (*AWSService)(nil)
+
+// AWSService lists the accounts of an AWS Organizations organization
+type AWSService struct {
+	client   organizationsiface.OrganizationsAPI
+	parentID string
+}
+
+// AWSOptions configures the access to the AWS Organizations API
+type AWSOptions struct {
+	Region          string
+	Role            string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// NewAWSService creates a service which authenticates with the given static credentials, which are required so that
+// the credentials of the ApplicationSet controller are never used. The role, if any, must be of the management
+// account or of a delegated administrator account, from which the accounts can be listed.
+func NewAWSService(parentID string, opts AWSOptions) (*AWSService, error) {
+	sess, err := cloud.NewAWSSession(cloud.AWSOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return &AWSService{client: organizations.New(sess), parentID: parentID}, nil
+}
+
+func (s *AWSService) ListAccounts(ctx context.Context) ([]Account, error) {
+	var accounts []Account
+	addAccounts := func(page []*organizations.Account) {
+		for _, account := range page {
+			if aws.StringValue(account.Status) != organizations.AccountStatusActive {
+				continue
+			}
+			accounts = append(accounts, Account{ID: aws.StringValue(account.Id), Name: aws.StringValue(account.Name)})
+		}
+	}
+	var err error
+	if s.parentID != "" {
+		err = s.client.ListAccountsForParentPagesWithContext(ctx, &organizations.ListAccountsForParentInput{ParentId: aws.String(s.parentID)},
+			func(page *organizations.ListAccountsForParentOutput, _ bool) bool {
+				addAccounts(page.Accounts)
+				return true
+			})
+	} else {
+		err = s.client.ListAccountsPagesWithContext(ctx, &organizations.ListAccountsInput{},
+			func(page *organizations.ListAccountsOutput, _ bool) bool {
+				addAccounts(page.Accounts)
+				return true
+			})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing AWS accounts: %w", err)
+	}
+
+	for i := range accounts {
+		tags := map[string]string{}
+		err := s.client.ListTagsForResourcePagesWithContext(ctx, &organizations.ListTagsForResourceInput{ResourceId: aws.String(accounts[i].ID)},
+			func(page *organizations.ListTagsForResourceOutput, _ bool) bool {
+				for _, tag := range page.Tags {
+					tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+				}
+				return true
+			})
+		if err != nil {
+			return nil, fmt.Errorf("error listing tags of AWS account %s: %w", accounts[i].ID, err)
+		}
+		accounts[i].Tags = tags
+	}
+	sortAccounts(accounts)
+	return accounts, nil
+}
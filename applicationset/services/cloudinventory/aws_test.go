@@ -0,0 +1,73 @@
+package cloudinventory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/organizations/organizationsiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeOrganizationsClient struct {
+	organizationsiface.OrganizationsAPI
+	accounts map[string][]*organizations.Account
+	tags     map[string][]*organizations.Tag
+}
+
+func (c *fakeOrganizationsClient) ListAccountsPagesWithContext(_ aws.Context, _ *organizations.ListAccountsInput, fn func(*organizations.ListAccountsOutput, bool) bool, _ ...request.Option) error {
+	// return the accounts of every parent on a separate page to exercise pagination
+	for _, accounts := range c.accounts {
+		if !fn(&organizations.ListAccountsOutput{Accounts: accounts}, false) {
+			break
+		}
+	}
+	return nil
+}
+
+func (c *fakeOrganizationsClient) ListAccountsForParentPagesWithContext(_ aws.Context, input *organizations.ListAccountsForParentInput, fn func(*organizations.ListAccountsForParentOutput, bool) bool, _ ...request.Option) error {
+	fn(&organizations.ListAccountsForParentOutput{Accounts: c.accounts[aws.StringValue(input.ParentId)]}, true)
+	return nil
+}
+
+func (c *fakeOrganizationsClient) ListTagsForResourcePagesWithContext(_ aws.Context, input *organizations.ListTagsForResourceInput, fn func(*organizations.ListTagsForResourceOutput, bool) bool, _ ...request.Option) error {
+	fn(&organizations.ListTagsForResourceOutput{Tags: c.tags[aws.StringValue(input.ResourceId)]}, true)
+	return nil
+}
+
+func newAWSAccount(id, name, status string) *organizations.Account {
+	return &organizations.Account{Id: aws.String(id), Name: aws.String(name), Status: aws.String(status)}
+}
+
+func TestAWSService_ListAccounts(t *testing.T) {
+	client := &fakeOrganizationsClient{
+		accounts: map[string][]*organizations.Account{
+			"ou-prod": {
+				newAWSAccount("222222222222", "prod-eu", organizations.AccountStatusActive),
+				newAWSAccount("111111111111", "prod-us", organizations.AccountStatusActive),
+			},
+			"ou-sandbox": {
+				newAWSAccount("333333333333", "sandbox", organizations.AccountStatusActive),
+				newAWSAccount("444444444444", "closed", organizations.AccountStatusSuspended),
+			},
+		},
+		tags: map[string][]*organizations.Tag{
+			"111111111111": {{Key: aws.String("env"), Value: aws.String("prod")}},
+		},
+	}
+
+	accounts, err := (&AWSService{client: client}).ListAccounts(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Account{
+		{ID: "111111111111", Name: "prod-us", Tags: map[string]string{"env": "prod"}},
+		{ID: "222222222222", Name: "prod-eu", Tags: map[string]string{}},
+		{ID: "333333333333", Name: "sandbox", Tags: map[string]string{}},
+	}, accounts)
+
+	accounts, err = (&AWSService{client: client, parentID: "ou-sandbox"}).ListAccounts(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Account{{ID: "333333333333", Name: "sandbox", Tags: map[string]string{}}}, accounts)
+}
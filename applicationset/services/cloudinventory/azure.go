@@ -0,0 +1,102 @@
+package cloudinventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/internal/cloud"
+)
+
+const (
+	azureBaseURL          = "https://management.azure.com"
+	azureScope            = "https://management.azure.com/.default"
+	azureAPIVersion       = "2022-12-01"
+	azureEnabledState     = "Enabled"
+	azureSubscriptionPath = "/subscriptions?api-version=" + azureAPIVersion
+)
+
+var _ InventoryService = (*AzureService)(nil)
+
+// AzureService lists the Azure subscriptions the credentials have access to, using the Resource Manager API
+type AzureService struct {
+	client     *http.Client
+	baseURL    string
+	credential azcore.TokenCredential
+}
+
+// AzureOptions configures the service principal used to access the Resource Manager API
+type AzureOptions struct {
+	TenantID     string
+	ClientID     string
+	ClientSecret string
+}
+
+// NewAzureService creates a service which authenticates with the given service principal. The client secret is
+// required so that the workload or managed identity of the ApplicationSet controller is never used.
+func NewAzureService(opts AzureOptions) (*AzureService, error) {
+	if opts.ClientSecret == "" {
+		return nil, errors.New("a client secret is required")
+	}
+	credential, err := azidentity.NewClientSecretCredential(opts.TenantID, opts.ClientID, opts.ClientSecret, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Azure credentials: %w", err)
+	}
+	return newAzureService(http.DefaultClient, azureBaseURL, credential), nil
+}
+
+func newAzureService(client *http.Client, baseURL string, credential azcore.TokenCredential) *AzureService {
+	return &AzureService{client: client, baseURL: strings.TrimSuffix(baseURL, "/"), credential: credential}
+}
+
+type azureSubscriptionList struct {
+	Value []struct {
+		SubscriptionID string            `json:"subscriptionId"`
+		DisplayName    string            `json:"displayName"`
+		State          string            `json:"state"`
+		Tags           map[string]string `json:"tags"`
+	} `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+func (s *AzureService) ListAccounts(ctx context.Context) ([]Account, error) {
+	token, err := s.credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{azureScope}})
+	if err != nil {
+		return nil, fmt.Errorf("error getting Azure access token: %w", err)
+	}
+	accounts := []Account{}
+	next := s.baseURL + azureSubscriptionPath
+	for next != "" {
+		// the token must only be sent to the Resource Manager API
+		if !strings.HasPrefix(next, s.baseURL+"/") {
+			return nil, fmt.Errorf("unexpected next link %q", next)
+		}
+		var list azureSubscriptionList
+		if err := s.get(ctx, next, token.Token, &list); err != nil {
+			return nil, fmt.Errorf("error listing Azure subscriptions: %w", err)
+		}
+		for _, subscription := range list.Value {
+			if subscription.State != azureEnabledState {
+				continue
+			}
+			tags := subscription.Tags
+			if tags == nil {
+				tags = map[string]string{}
+			}
+			accounts = append(accounts, Account{ID: subscription.SubscriptionID, Name: subscription.DisplayName, Tags: tags})
+		}
+		next = list.NextLink
+	}
+	sortAccounts(accounts)
+	return accounts, nil
+}
+
+func (s *AzureService) get(ctx context.Context, url, token string, v any) error {
+	return cloud.Get(ctx, s.client, url, http.Header{"Authorization": {"Bearer " + token}}, v)
+}
@@ -0,0 +1,51 @@
+package cloudinventory
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTokenCredential struct{}
+
+func (fakeTokenCredential) GetToken(_ context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token"}, nil
+}
+
+func TestAzureService_ListAccounts(t *testing.T) {
+	nextLink := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "/subscriptions", r.URL.Path)
+		// return the subscriptions on two pages to exercise pagination
+		if r.URL.Query().Get("$skipToken") == "" {
+			_, _ = w.Write([]byte(`{"value": [{"subscriptionId": "b", "displayName": "prod", "state": "Enabled", "tags": {"env": "prod"}}], "nextLink": "` + nextLink + `"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"value": [{"subscriptionId": "a", "displayName": "dev", "state": "Enabled"}, {"subscriptionId": "c", "displayName": "old", "state": "Disabled"}]}`))
+	}))
+	defer server.Close()
+
+	nextLink = server.URL + "/subscriptions?api-version=" + azureAPIVersion + "&$skipToken=next"
+	accounts, err := newAzureService(server.Client(), server.URL, fakeTokenCredential{}).ListAccounts(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Account{
+		{ID: "a", Name: "dev", Tags: map[string]string{}},
+		{ID: "b", Name: "prod", Tags: map[string]string{"env": "prod"}},
+	}, accounts)
+
+	nextLink = "https://attacker.example.com/subscriptions"
+	_, err = newAzureService(server.Client(), server.URL, fakeTokenCredential{}).ListAccounts(context.Background())
+	require.ErrorContains(t, err, "unexpected next link")
+}
+
+func TestNewAzureService_RequiresClientSecret(t *testing.T) {
+	_, err := NewAzureService(AzureOptions{TenantID: "tenant", ClientID: "client"})
+	require.ErrorContains(t, err, "a client secret is required")
+}
@@ -0,0 +1,88 @@
+package cloudinventory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/internal/cloud"
+)
+
+const (
+	gcpBaseURL       = "https://cloudresourcemanager.googleapis.com"
+	gcpReadOnlyScope = "https://www.googleapis.com/auth/cloud-platform.read-only"
+	gcpActiveState   = "ACTIVE"
+)
+
+var _ InventoryService = (*GCPService)(nil)
+
+// GCPService lists the Google Cloud projects the credentials have access to, using the Resource Manager API
+type GCPService struct {
+	client  *http.Client
+	baseURL string
+	parent  string
+}
+
+// NewGCPService creates a service which authenticates with the given service account key in JSON format. The key is
+// required so that the application default credentials of the ApplicationSet controller are never used.
+func NewGCPService(ctx context.Context, parent string, credentialsJSON []byte) (*GCPService, error) {
+	client, err := cloud.NewGoogleClient(ctx, credentialsJSON, gcpReadOnlyScope)
+	if err != nil {
+		return nil, err
+	}
+	return newGCPService(client, gcpBaseURL, parent), nil
+}
+
+func newGCPService(client *http.Client, baseURL, parent string) *GCPService {
+	return &GCPService{client: client, baseURL: strings.TrimSuffix(baseURL, "/"), parent: parent}
+}
+
+type gcpProjectList struct {
+	Projects []struct {
+		ProjectID   string            `json:"projectId"`
+		DisplayName string            `json:"displayName"`
+		State       string            `json:"state"`
+		Labels      map[string]string `json:"labels"`
+	} `json:"projects"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+func (s *GCPService) ListAccounts(ctx context.Context) ([]Account, error) {
+	accounts := []Account{}
+	pageToken := ""
+	for {
+		query := url.Values{}
+		if s.parent != "" {
+			query.Set("query", "parent:"+s.parent)
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		var list gcpProjectList
+		if err := s.get(ctx, "/v3/projects:search?"+query.Encode(), &list); err != nil {
+			return nil, fmt.Errorf("error listing Google Cloud projects: %w", err)
+		}
+		for _, project := range list.Projects {
+			if project.State != gcpActiveState {
+				continue
+			}
+			labels := project.Labels
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			accounts = append(accounts, Account{ID: project.ProjectID, Name: project.DisplayName, Tags: labels})
+		}
+		if list.NextPageToken == "" {
+			break
+		}
+		pageToken = list.NextPageToken
+	}
+	sortAccounts(accounts)
+	return accounts, nil
+}
+
+func (s *GCPService) get(ctx context.Context, path string, v any) error {
+	return cloud.Get(ctx, s.client, s.baseURL+path, nil, v)
+}
@@ -0,0 +1,43 @@
+package cloudinventory
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/internal/cloud"
+)
+
+func TestGCPService_ListAccounts(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v3/projects:search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "parent:folders/123", r.URL.Query().Get("query"))
+		// return the projects on two pages to exercise pagination
+		if r.URL.Query().Get("pageToken") == "" {
+			_, _ = w.Write([]byte(`{"projects": [{"projectId": "prod-us", "displayName": "Production US", "state": "ACTIVE", "labels": {"env": "prod"}}], "nextPageToken": "next"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"projects": [{"projectId": "deleted", "state": "DELETE_REQUESTED"}, {"projectId": "dev", "displayName": "Development", "state": "ACTIVE"}]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	accounts, err := newGCPService(server.Client(), server.URL, "folders/123").ListAccounts(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Account{
+		{ID: "dev", Name: "Development", Tags: map[string]string{}},
+		{ID: "prod-us", Name: "Production US", Tags: map[string]string{"env": "prod"}},
+	}, accounts)
+
+	_, err = newGCPService(server.Client(), server.URL+"/missing", "folders/123").ListAccounts(context.Background())
+	require.ErrorIs(t, err, cloud.ErrNotFound)
+}
+
+func TestNewGCPService_RequiresCredentials(t *testing.T) {
+	_, err := NewGCPService(t.Context(), "folders/123", nil)
+	require.ErrorContains(t, err, "a Google Cloud service account key is required")
+}
@@ -0,0 +1,46 @@
+package cloudinventory
+
+import (
+	"context"
+	"sort"
+)
+
+const (
+	ProviderAWS   = "aws"
+	ProviderGCP   = "gcp"
+	ProviderAzure = "azure"
+)
+
+// Account is a cloud account: an AWS account, a Google Cloud project or an Azure subscription
+type Account struct {
+	// ID is the AWS account ID, the Google Cloud project ID or the Azure subscription ID
+	ID string
+	// Name is the display name of the account
+	Name string
+	// Tags are the tags of the account, or the labels of the Google Cloud project
+	Tags map[string]string
+}
+
+// InventoryService lists the accounts of a cloud provider
+type InventoryService interface {
+	// ListAccounts returns the active accounts
+	ListAccounts(ctx context.Context) ([]Account, error)
+}
+
+// MatchesTags returns whether the account has all the given tags with the given values. An empty value matches any
+// value of the tag.
+func (a Account) MatchesTags(tags map[string]string) bool {
+	for key, value := range tags {
+		actual, ok := a.Tags[key]
+		if !ok || (value != "" && actual != value) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortAccounts(accounts []Account) {
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].ID < accounts[j].ID
+	})
+}
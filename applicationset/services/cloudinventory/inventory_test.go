@@ -0,0 +1,17 @@
+package cloudinventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccount_MatchesTags(t *testing.T) {
+	account := Account{ID: "1", Tags: map[string]string{"env": "prod", "team": "platform"}}
+
+	assert.True(t, account.MatchesTags(nil))
+	assert.True(t, account.MatchesTags(map[string]string{"env": "prod"}))
+	assert.True(t, account.MatchesTags(map[string]string{"env": "prod", "team": ""}))
+	assert.False(t, account.MatchesTags(map[string]string{"env": "dev"}))
+	assert.False(t, account.MatchesTags(map[string]string{"cost-center": ""}))
+}
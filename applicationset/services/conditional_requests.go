@@ -0,0 +1,155 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultConditionalRequestCacheExpiration is the time after which a cached response is dropped if it has not been
+	// revalidated by a conditional request
+	DefaultConditionalRequestCacheExpiration = 24 * time.Hour
+
+	conditionalRequestCacheCleanupInterval = 10 * time.Minute
+)
+
+// ConditionalRequestCache stores responses of SCM provider API requests, so that subsequent identical requests can be
+// turned into conditional requests (If-None-Match / If-Modified-Since). Providers such as GitHub do not count
+// requests answered with 304 Not Modified against the API rate limit.
+type ConditionalRequestCache struct {
+	cache *gocache.Cache
+}
+
+type cachedResponse struct {
+	statusCode   int
+	header       http.Header
+	body         []byte
+	etag         string
+	lastModified string
+}
+
+// NewConditionalRequestCache creates a new cache whose entries expire after the given duration without revalidation
+func NewConditionalRequestCache(expiration time.Duration) *ConditionalRequestCache {
+	return &ConditionalRequestCache{cache: gocache.New(expiration, conditionalRequestCacheCleanupInterval)}
+}
+
+// conditionalRequestCacheKey returns the cache key of the request within the given scope. The credentials of the
+// request are part of the key, so that responses are never shared between callers with different access rights.
+func conditionalRequestCacheKey(scope string, req *http.Request) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\n%s\n%s\n%s\n%s", scope, req.Method, req.URL.String(), req.Header.Get("Accept"), req.Header.Get("Authorization"))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ConditionalRequestTransport is a http.RoundTripper that revalidates cached responses of GET requests using
+// conditional requests and serves the cached response when the server reports it as not modified
+type ConditionalRequestTransport struct {
+	transport http.RoundTripper
+	cache     *ConditionalRequestCache
+	// scope partitions the cache, e.g. per organization and filters of an SCM provider generator
+	scope string
+}
+
+// NewConditionalRequestTransport wraps the given transport with conditional request support backed by cache. Cached
+// responses are only shared between transports of the same scope.
+func NewConditionalRequestTransport(transport http.RoundTripper, cache *ConditionalRequestCache, scope string) *ConditionalRequestTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &ConditionalRequestTransport{transport: transport, cache: cache, scope: scope}
+}
+
+// RoundTrip implements http.RoundTripper interface
+func (t *ConditionalRequestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return t.transport.RoundTrip(req)
+	}
+
+	key := conditionalRequestCacheKey(t.scope, req)
+	var cached *cachedResponse
+	if item, ok := t.cache.cache.Get(key); ok {
+		cached = item.(*cachedResponse)
+		req = req.Clone(req.Context())
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		_ = resp.Body.Close()
+		log.WithField("url", req.URL.Redacted()).Debug("SCM provider response not modified, using cached response")
+		t.cache.cache.SetDefault(key, cached)
+		return cached.toResponse(req, resp.Header), nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	if resp.StatusCode != http.StatusOK || (etag == "" && lastModified == "") {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	t.cache.cache.SetDefault(key, &cachedResponse{
+		statusCode:   resp.StatusCode,
+		header:       resp.Header.Clone(),
+		body:         body,
+		etag:         etag,
+		lastModified: lastModified,
+	})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// toResponse builds a response from the cached one. Headers of the not modified response, e.g. rate limit
+// information, take precedence over the cached headers, except for the ones describing the body.
+func (c *cachedResponse) toResponse(req *http.Request, notModifiedHeader http.Header) *http.Response {
+	header := c.header.Clone()
+	for k, v := range notModifiedHeader {
+		if strings.HasPrefix(k, "Content-") {
+			continue
+		}
+		header[k] = v
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.statusCode, http.StatusText(c.statusCode)),
+		StatusCode:    c.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+// NewConditionalRequestClient returns a http.Client using conditional requests backed by cache on top of the
+// transport of the given client. Cached responses are only shared between clients of the same scope.
+func NewConditionalRequestClient(httpClient *http.Client, cache *ConditionalRequestCache, scope string) *http.Client {
+	var transport http.RoundTripper
+	if httpClient != nil {
+		transport = httpClient.Transport
+	}
+	return &http.Client{Transport: NewConditionalRequestTransport(transport, cache, scope)}
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalRequestTransport(t *testing.T) {
+	const etag = `"abc123"`
+	var requests, notModified int
+	body := `[{"name":"repo"}]`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Link", `<https://api.github.com/orgs/argoproj/repos?page=2>; rel="next"`)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer ts.Close()
+
+	cache := NewConditionalRequestCache(time.Hour)
+	client := NewConditionalRequestClient(nil, cache, "argoproj")
+	get := func(token string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+"/orgs/argoproj/repos", http.NoBody)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+	readBody := func(resp *http.Response) string {
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	// first request populates the cache
+	resp := get("token1")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, body, readBody(resp))
+	assert.Equal(t, 0, notModified)
+
+	// second request is revalidated and served from the cache
+	resp = get("token1")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, body, readBody(resp))
+	assert.Equal(t, "42", resp.Header.Get("X-RateLimit-Remaining"))
+	assert.NotEmpty(t, resp.Header.Get("Link"))
+	assert.Equal(t, 1, notModified)
+
+	// requests with different credentials do not share cached responses
+	resp = get("token2")
+	assert.Equal(t, body, readBody(resp))
+	assert.Equal(t, 1, notModified)
+	assert.Equal(t, 3, requests)
+
+	// clients of different scopes do not share cached responses
+	client = NewConditionalRequestClient(nil, cache, "argoproj-labs")
+	resp = get("token1")
+	assert.Equal(t, body, readBody(resp))
+	assert.Equal(t, 1, notModified)
+	assert.Equal(t, 4, requests)
+}
+
+func TestConditionalRequestTransport_NotCached(t *testing.T) {
+	var conditional int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" || r.Header.Get("If-Modified-Since") != "" {
+			conditional++
+		}
+		if r.URL.Path == "/etag" {
+			w.Header().Set("ETag", `"abc123"`)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("no validators"))
+	}))
+	defer ts.Close()
+
+	client := NewConditionalRequestClient(&http.Client{}, NewConditionalRequestCache(time.Hour), "")
+	for range 2 {
+		// responses without validators are not cached
+		resp, err := client.Get(ts.URL + "/plain")
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		// unsuccessful responses are not cached
+		resp, err = client.Get(ts.URL + "/etag")
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		// only GET requests are cached
+		resp, err = client.Post(ts.URL+"/plain", "text/plain", http.NoBody)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	assert.Equal(t, 0, conditional)
+}
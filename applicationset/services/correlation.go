@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc/metadata"
+
+	grpc_util "github.com/argoproj/argo-cd/v3/util/grpc"
+)
+
+// CorrelationIDHeader is the HTTP header carrying the correlation ID of the reconciliation which issued a request
+const CorrelationIDHeader = "X-Correlation-ID"
+
+type correlationIDKey struct{}
+
+// ContextWithCorrelationID returns a context carrying the given correlation ID. The ID is also added to the outgoing
+// gRPC metadata, so calls to the repo-server can be correlated with the reconciliation which issued them.
+func ContextWithCorrelationID(ctx context.Context, id string) context.Context {
+	ctx = context.WithValue(ctx, correlationIDKey{}, id)
+	return metadata.AppendToOutgoingContext(ctx, grpc_util.CorrelationIDMetadataKey, id)
+}
+
+// CorrelationIDFromContext returns the correlation ID carried by the context, or an empty string if there is none
+func CorrelationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// CorrelationIDTransport is a http.RoundTripper adding the correlation ID carried by the request context as header. The
+// requests of clients which do not pass a context to them carry the correlation ID of the context the transport was
+// created with. It is shared by the HTTP clients of all the SCM providers and pull request services.
+type CorrelationIDTransport struct {
+	transport http.RoundTripper
+	id        string
+}
+
+// NewCorrelationIDTransport returns a CorrelationIDTransport on top of the given transport, using the correlation ID
+// carried by ctx for the requests whose context does not carry one
+func NewCorrelationIDTransport(ctx context.Context, transport http.RoundTripper) *CorrelationIDTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &CorrelationIDTransport{transport: transport, id: CorrelationIDFromContext(ctx)}
+}
+
+// RoundTrip implements http.RoundTripper interface
+func (t *CorrelationIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	id := CorrelationIDFromContext(req.Context())
+	if id == "" {
+		id = t.id
+	}
+	if id == "" {
+		return t.transport.RoundTrip(req)
+	}
+	// A RoundTripper must not modify the given request
+	req = req.Clone(req.Context())
+	req.Header.Set(CorrelationIDHeader, id)
+	return t.transport.RoundTrip(req)
+}
+
+// NewCorrelationIDClient returns a copy of the given client adding the correlation ID header on top of its transport
+func NewCorrelationIDClient(ctx context.Context, httpClient *http.Client) *http.Client {
+	client := &http.Client{}
+	if httpClient != nil {
+		*client = *httpClient
+	}
+	client.Transport = NewCorrelationIDTransport(ctx, client.Transport)
+	return client
+}
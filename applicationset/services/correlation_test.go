@@ -0,0 +1,53 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	grpc_util "github.com/argoproj/argo-cd/v3/util/grpc"
+)
+
+func TestContextWithCorrelationID(t *testing.T) {
+	assert.Empty(t, CorrelationIDFromContext(t.Context()))
+
+	ctx := ContextWithCorrelationID(t.Context(), "abc")
+	assert.Equal(t, "abc", CorrelationIDFromContext(ctx))
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, []string{"abc"}, md.Get(grpc_util.CorrelationIDMetadataKey))
+}
+
+func TestCorrelationIDTransport(t *testing.T) {
+	var received []string
+	ts := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(CorrelationIDHeader))
+	}))
+	defer ts.Close()
+	client := NewCorrelationIDClient(t.Context(), nil)
+
+	req, err := http.NewRequestWithContext(ContextWithCorrelationID(t.Context(), "abc"), http.MethodGet, ts.URL, http.NoBody)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Empty(t, req.Header.Get(CorrelationIDHeader), "the original request must not be modified")
+
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodGet, ts.URL, http.NoBody)
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	// clients which do not pass a context to their requests use the correlation ID of the context they were created with
+	client = NewCorrelationIDClient(ContextWithCorrelationID(t.Context(), "def"), nil)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, []string{"abc", "", "def"}, received)
+}
@@ -0,0 +1,45 @@
+// Package cloud contains the clients of the cloud provider APIs shared by the Terraform and cloud inventory services.
+// The clients only authenticate with the credentials they are given, never with the credentials of the ApplicationSet
+// controller itself, e.g. its pod identity, so that ApplicationSets cannot access what the controller has access to.
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// AWSOptions configures the access to the AWS APIs
+type AWSOptions struct {
+	Region          string
+	Role            string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// NewAWSSession creates a session which authenticates with the static credentials of the options, assuming the role
+// of the options if any
+func NewAWSSession(opts AWSOptions) (*session.Session, error) {
+	if opts.AccessKeyID == "" || opts.SecretAccessKey == "" {
+		return nil, errors.New("an access key ID and a secret access key are required")
+	}
+	config := &aws.Config{
+		Credentials: credentials.NewStaticCredentials(opts.AccessKeyID, opts.SecretAccessKey, ""),
+	}
+	if opts.Region != "" {
+		config.Region = aws.String(opts.Region)
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+	// assume role if provided - e.g. for cross account access
+	if opts.Role != "" {
+		sess = sess.Copy(&aws.Config{Credentials: stscreds.NewCredentials(sess, opts.Role)})
+	}
+	return sess, nil
+}
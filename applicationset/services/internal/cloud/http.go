@@ -0,0 +1,76 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/jwt"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// ErrNotFound is returned by Get if the API responds with a 404 status code
+var ErrNotFound = errors.New("not found")
+
+// NewGoogleClient creates an HTTP client which authenticates with the given service account key in JSON format
+func NewGoogleClient(ctx context.Context, credentialsJSON []byte, scope string) (*http.Client, error) {
+	if len(credentialsJSON) == 0 {
+		return nil, errors.New("a Google Cloud service account key is required")
+	}
+	config, err := newGoogleJWTConfig(credentialsJSON, scope)
+	if err != nil {
+		return nil, err
+	}
+	return config.Client(ctx), nil
+}
+
+// newGoogleJWTConfig parses the given service account key. Only service account keys are accepted, since other
+// credential types can make the controller send requests to arbitrary URLs. For the same reason, tokens are always
+// requested from the Google token endpoint rather than from the token_uri of the key.
+func newGoogleJWTConfig(credentialsJSON []byte, scope string) (*jwt.Config, error) {
+	config, err := google.JWTConfigFromJSON(credentialsJSON, scope)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Google Cloud service account key: %w", err)
+	}
+	config.TokenURL = google.JWTTokenURL
+	return config, nil
+}
+
+// Get sends a GET request with the given header to a JSON API and decodes the response into v, or stores the raw
+// response if v is a pointer to a byte slice
+func Get(ctx context.Context, client *http.Client, url string, header http.Header, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return err
+	}
+	for k, values := range header {
+		for _, value := range values {
+			req.Header.Add(k, value)
+		}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer utilio.Close(resp.Body)
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("API error with status code %d: %s", resp.StatusCode, string(data))
+	}
+	if raw, ok := v.(*[]byte); ok {
+		*raw = data
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,65 @@
+package cloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2/google"
+)
+
+func TestGet(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"name": "prod"}`))
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("forbidden"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	header := http.Header{"Authorization": {"Bearer token"}}
+
+	var v struct {
+		Name string `json:"name"`
+	}
+	require.NoError(t, Get(t.Context(), server.Client(), server.URL+"/json", header, &v))
+	assert.Equal(t, "prod", v.Name)
+
+	var raw []byte
+	require.NoError(t, Get(t.Context(), server.Client(), server.URL+"/json", header, &raw))
+	assert.JSONEq(t, `{"name": "prod"}`, string(raw))
+
+	err := Get(t.Context(), server.Client(), server.URL+"/missing", nil, &v)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	err = Get(t.Context(), server.Client(), server.URL+"/error", nil, &v)
+	require.EqualError(t, err, "API error with status code 403: forbidden")
+}
+
+func TestNewAWSSession(t *testing.T) {
+	_, err := NewAWSSession(AWSOptions{Region: "eu-west-1"})
+	require.ErrorContains(t, err, "an access key ID and a secret access key are required")
+
+	sess, err := NewAWSSession(AWSOptions{Region: "eu-west-1", AccessKeyID: "AKIAEXAMPLE", SecretAccessKey: "secret"})
+	require.NoError(t, err)
+	credentials, err := sess.Config.Credentials.Get()
+	require.NoError(t, err)
+	assert.Equal(t, "AKIAEXAMPLE", credentials.AccessKeyID)
+}
+
+func TestNewGoogleClient(t *testing.T) {
+	_, err := NewGoogleClient(t.Context(), nil, "https://www.googleapis.com/auth/cloud-platform.read-only")
+	require.ErrorContains(t, err, "a Google Cloud service account key is required")
+
+	_, err = NewGoogleClient(t.Context(), []byte(`{"type": "external_account"}`), "https://www.googleapis.com/auth/cloud-platform.read-only")
+	require.ErrorContains(t, err, "error parsing Google Cloud service account key")
+
+	config, err := newGoogleJWTConfig([]byte(`{"type": "service_account", "client_email": "sa@example.iam.gserviceaccount.com", "private_key": "key", "token_uri": "http://169.254.169.254/token"}`), "https://www.googleapis.com/auth/cloud-platform.read-only")
+	require.NoError(t, err)
+	assert.Equal(t, google.JWTTokenURL, config.TokenURL, "the token_uri of the key must not be used")
+}
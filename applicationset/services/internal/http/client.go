@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
 const (
@@ -49,7 +51,8 @@ func newClient(baseURL string, options ...ClientOptionFunc) (*Client, error) {
 
 	// Configure the HTTP client.
 	c.client = &http.Client{
-		Timeout: time.Duration(defaultTimeout) * time.Second,
+		Timeout:   time.Duration(defaultTimeout) * time.Second,
+		Transport: services.NewCorrelationIDTransport(context.Background(), nil),
 	}
 
 	// Apply any given client options.
@@ -38,8 +38,8 @@ func (_m *Repos) EXPECT() *Repos_Expecter {
 }
 
 // GetDirectories provides a mock function for the type Repos
-func (_mock *Repos) GetDirectories(ctx context.Context, repoURL string, revision string, project string, noRevisionCache bool, verifyCommit bool) ([]string, error) {
-	ret := _mock.Called(ctx, repoURL, revision, project, noRevisionCache, verifyCommit)
+func (_mock *Repos) GetDirectories(ctx context.Context, repoURL string, revision string, project string, paths []string, excludedPaths []string, noRevisionCache bool, verifyCommit bool) ([]string, error) {
+	ret := _mock.Called(ctx, repoURL, revision, project, paths, excludedPaths, noRevisionCache, verifyCommit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDirectories")
@@ -47,18 +47,18 @@ func (_mock *Repos) GetDirectories(ctx context.Context, repoURL string, revision
 
 	var r0 []string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, bool, bool) ([]string, error)); ok {
-		return returnFunc(ctx, repoURL, revision, project, noRevisionCache, verifyCommit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []string, []string, bool, bool) ([]string, error)); ok {
+		return returnFunc(ctx, repoURL, revision, project, paths, excludedPaths, noRevisionCache, verifyCommit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, bool, bool) []string); ok {
-		r0 = returnFunc(ctx, repoURL, revision, project, noRevisionCache, verifyCommit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, []string, []string, bool, bool) []string); ok {
+		r0 = returnFunc(ctx, repoURL, revision, project, paths, excludedPaths, noRevisionCache, verifyCommit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, bool, bool) error); ok {
-		r1 = returnFunc(ctx, repoURL, revision, project, noRevisionCache, verifyCommit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, []string, []string, bool, bool) error); ok {
+		r1 = returnFunc(ctx, repoURL, revision, project, paths, excludedPaths, noRevisionCache, verifyCommit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -75,13 +75,15 @@ type Repos_GetDirectories_Call struct {
 //   - repoURL string
 //   - revision string
 //   - project string
+//   - paths []string
+//   - excludedPaths []string
 //   - noRevisionCache bool
 //   - verifyCommit bool
-func (_e *Repos_Expecter) GetDirectories(ctx interface{}, repoURL interface{}, revision interface{}, project interface{}, noRevisionCache interface{}, verifyCommit interface{}) *Repos_GetDirectories_Call {
-	return &Repos_GetDirectories_Call{Call: _e.mock.On("GetDirectories", ctx, repoURL, revision, project, noRevisionCache, verifyCommit)}
+func (_e *Repos_Expecter) GetDirectories(ctx interface{}, repoURL interface{}, revision interface{}, project interface{}, paths interface{}, excludedPaths interface{}, noRevisionCache interface{}, verifyCommit interface{}) *Repos_GetDirectories_Call {
+	return &Repos_GetDirectories_Call{Call: _e.mock.On("GetDirectories", ctx, repoURL, revision, project, paths, excludedPaths, noRevisionCache, verifyCommit)}
 }
 
-func (_c *Repos_GetDirectories_Call) Run(run func(ctx context.Context, repoURL string, revision string, project string, noRevisionCache bool, verifyCommit bool)) *Repos_GetDirectories_Call {
+func (_c *Repos_GetDirectories_Call) Run(run func(ctx context.Context, repoURL string, revision string, project string, paths []string, excludedPaths []string, noRevisionCache bool, verifyCommit bool)) *Repos_GetDirectories_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -99,13 +101,21 @@ func (_c *Repos_GetDirectories_Call) Run(run func(ctx context.Context, repoURL s
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
-		var arg4 bool
+		var arg4 []string
 		if args[4] != nil {
-			arg4 = args[4].(bool)
+			arg4 = args[4].([]string)
 		}
-		var arg5 bool
+		var arg5 []string
 		if args[5] != nil {
-			arg5 = args[5].(bool)
+			arg5 = args[5].([]string)
+		}
+		var arg6 bool
+		if args[6] != nil {
+			arg6 = args[6].(bool)
+		}
+		var arg7 bool
+		if args[7] != nil {
+			arg7 = args[7].(bool)
 		}
 		run(
 			arg0,
@@ -114,6 +124,8 @@ func (_c *Repos_GetDirectories_Call) Run(run func(ctx context.Context, repoURL s
 			arg3,
 			arg4,
 			arg5,
+			arg6,
+			arg7,
 		)
 	})
 	return _c
@@ -124,7 +136,7 @@ func (_c *Repos_GetDirectories_Call) Return(strings []string, err error) *Repos_
 	return _c
 }
 
-func (_c *Repos_GetDirectories_Call) RunAndReturn(run func(ctx context.Context, repoURL string, revision string, project string, noRevisionCache bool, verifyCommit bool) ([]string, error)) *Repos_GetDirectories_Call {
+func (_c *Repos_GetDirectories_Call) RunAndReturn(run func(ctx context.Context, repoURL string, revision string, project string, paths []string, excludedPaths []string, noRevisionCache bool, verifyCommit bool) ([]string, error)) *Repos_GetDirectories_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -226,3 +238,155 @@ func (_c *Repos_GetFiles_Call) RunAndReturn(run func(ctx context.Context, repoUR
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetOCITags provides a mock function for the type Repos
+func (_mock *Repos) GetOCITags(ctx context.Context, repoURL string, project string) ([]string, error) {
+	ret := _mock.Called(ctx, repoURL, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOCITags")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return returnFunc(ctx, repoURL, project)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = returnFunc(ctx, repoURL, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, repoURL, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_GetOCITags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOCITags'
+type Repos_GetOCITags_Call struct {
+	*mock.Call
+}
+
+// GetOCITags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - project string
+func (_e *Repos_Expecter) GetOCITags(ctx interface{}, repoURL interface{}, project interface{}) *Repos_GetOCITags_Call {
+	return &Repos_GetOCITags_Call{Call: _e.mock.On("GetOCITags", ctx, repoURL, project)}
+}
+
+func (_c *Repos_GetOCITags_Call) Run(run func(ctx context.Context, repoURL string, project string)) *Repos_GetOCITags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repos_GetOCITags_Call) Return(strings []string, err error) *Repos_GetOCITags_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Repos_GetOCITags_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string) ([]string, error)) *Repos_GetOCITags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveOCIDigest provides a mock function for the type Repos
+func (_mock *Repos) ResolveOCIDigest(ctx context.Context, repoURL string, project string, tag string) (string, error) {
+	ret := _mock.Called(ctx, repoURL, project, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveOCIDigest")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return returnFunc(ctx, repoURL, project, tag)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = returnFunc(ctx, repoURL, project, tag)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, repoURL, project, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_ResolveOCIDigest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveOCIDigest'
+type Repos_ResolveOCIDigest_Call struct {
+	*mock.Call
+}
+
+// ResolveOCIDigest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - project string
+//   - tag string
+func (_e *Repos_Expecter) ResolveOCIDigest(ctx interface{}, repoURL interface{}, project interface{}, tag interface{}) *Repos_ResolveOCIDigest_Call {
+	return &Repos_ResolveOCIDigest_Call{Call: _e.mock.On("ResolveOCIDigest", ctx, repoURL, project, tag)}
+}
+
+func (_c *Repos_ResolveOCIDigest_Call) Run(run func(ctx context.Context, repoURL string, project string, tag string)) *Repos_ResolveOCIDigest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repos_ResolveOCIDigest_Call) Return(s string, err error) *Repos_ResolveOCIDigest_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Repos_ResolveOCIDigest_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string, tag string) (string, error)) *Repos_ResolveOCIDigest_Call {
+	_c.Call.Return(run)
+	return _c
+}
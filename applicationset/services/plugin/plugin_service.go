@@ -54,18 +54,27 @@ func NewPluginService(appSetName string, baseURL string, token string, requestTi
 	}, nil
 }
 
-func (p *Service) List(ctx context.Context, parameters v1alpha1.PluginParameters) (*ServiceResponse, error) {
+// List returns the parameters of the plugin. If etag is not empty, it is sent in the If-None-Match header: a nil
+// response is returned if the plugin responds that the parameters were not modified. The returned ETag is the one of the
+// response, if any.
+func (p *Service) List(ctx context.Context, parameters v1alpha1.PluginParameters, etag string) (*ServiceResponse, string, error) {
 	req, err := p.client.NewRequestWithContext(ctx, http.MethodPost, "api/v1/getparams.execute", ServiceRequest{ApplicationSetName: p.appSetName, Input: v1alpha1.PluginInput{Parameters: parameters}})
 	if err != nil {
-		return nil, fmt.Errorf("NewRequest returned unexpected error: %w", err)
+		return nil, "", fmt.Errorf("NewRequest returned unexpected error: %w", err)
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
 	}
 
 	var data ServiceResponse
 
-	_, err = p.client.Do(req, &data)
+	resp, err := p.client.Do(req, &data)
+	if resp != nil && resp.StatusCode == http.StatusNotModified && etag != "" {
+		return nil, etag, nil
+	}
 	if err != nil {
-		return nil, fmt.Errorf("error get api '%s': %w", p.appSetName, err)
+		return nil, "", fmt.Errorf("error get api '%s': %w", p.appSetName, err)
 	}
 
-	return &data, err
+	return &data, resp.Header.Get("ETag"), nil
 }
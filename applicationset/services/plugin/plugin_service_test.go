@@ -33,11 +33,40 @@ func TestPlugin(t *testing.T) {
 	client, err := NewPluginService("plugin-test", ts.URL, token, 0)
 	require.NoError(t, err)
 
-	data, err := client.List(t.Context(), nil)
+	data, etag, err := client.List(t.Context(), nil, "")
 	require.NoError(t, err)
+	assert.Empty(t, etag)
 
 	var expectedData ServiceResponse
 	err = json.Unmarshal([]byte(expectedJSON), &expectedData)
 	require.NoError(t, err)
 	assert.Equal(t, &expectedData, data)
 }
+
+func TestPluginETag(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		_, err := w.Write([]byte(`{"output": {"parameters": [{"key": "value"}]}}`))
+		assert.NoError(t, err)
+	})
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	client, err := NewPluginService("plugin-test", ts.URL, "token", 0)
+	require.NoError(t, err)
+
+	data, etag, err := client.List(t.Context(), nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, `"v1"`, etag)
+	assert.Equal(t, []map[string]any{{"key": "value"}}, data.Output.Parameters)
+
+	data, etag, err = client.List(t.Context(), nil, `"v1"`)
+	require.NoError(t, err)
+	assert.Equal(t, `"v1"`, etag)
+	assert.Nil(t, data)
+}
@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/ktrysmt/go-bitbucket"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
 type BitbucketCloudService struct {
@@ -23,6 +25,7 @@ type BitbucketCloudPullRequest struct {
 	Source      BitbucketCloudPullRequestSource      `json:"source"`
 	Author      BitbucketCloudPullRequestAuthor      `json:"author"`
 	Destination BitbucketCloudPullRequestDestination `json:"destination"`
+	Draft       bool                                 `json:"draft"`
 }
 
 type BitbucketCloudPullRequestDestination struct {
@@ -75,7 +78,7 @@ func parseURL(uri string) (*url.URL, error) {
 	return url, nil
 }
 
-func NewBitbucketCloudServiceBasicAuth(baseURL, username, password, owner, repositorySlug string) (PullRequestService, error) {
+func NewBitbucketCloudServiceBasicAuth(ctx context.Context, baseURL, username, password, owner, repositorySlug string) (PullRequestService, error) {
 	url, err := parseURL(baseURL)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing base url of %s for %s/%s: %w", baseURL, owner, repositorySlug, err)
@@ -86,6 +89,7 @@ func NewBitbucketCloudServiceBasicAuth(baseURL, username, password, owner, repos
 		return nil, fmt.Errorf("error creating BitBucket Cloud client with basic auth: %w", err)
 	}
 	bitbucketClient.SetApiBaseURL(*url)
+	bitbucketClient.HttpClient = services.NewCorrelationIDClient(ctx, bitbucketClient.HttpClient)
 
 	return &BitbucketCloudService{
 		client:         bitbucketClient,
@@ -94,7 +98,7 @@ func NewBitbucketCloudServiceBasicAuth(baseURL, username, password, owner, repos
 	}, nil
 }
 
-func NewBitbucketCloudServiceBearerToken(baseURL, bearerToken, owner, repositorySlug string) (PullRequestService, error) {
+func NewBitbucketCloudServiceBearerToken(ctx context.Context, baseURL, bearerToken, owner, repositorySlug string) (PullRequestService, error) {
 	url, err := parseURL(baseURL)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing base url of %s for %s/%s: %w", baseURL, owner, repositorySlug, err)
@@ -105,13 +109,14 @@ func NewBitbucketCloudServiceBearerToken(baseURL, bearerToken, owner, repository
 		return nil, fmt.Errorf("error creating BitBucket Cloud client with oauth bearer token: %w", err)
 	}
 	bitbucketClient.SetApiBaseURL(*url)
+	bitbucketClient.HttpClient = services.NewCorrelationIDClient(ctx, bitbucketClient.HttpClient)
 
 	return &BitbucketCloudService{client: bitbucketClient, owner: owner, repositorySlug: repositorySlug}, nil
 }
 
-func NewBitbucketCloudServiceNoAuth(baseURL, owner, repositorySlug string) (PullRequestService, error) {
+func NewBitbucketCloudServiceNoAuth(ctx context.Context, baseURL, owner, repositorySlug string) (PullRequestService, error) {
 	// There is currently no method to explicitly not require auth
-	return NewBitbucketCloudServiceBearerToken(baseURL, "", owner, repositorySlug)
+	return NewBitbucketCloudServiceBearerToken(ctx, baseURL, "", owner, repositorySlug)
 }
 
 func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error) {
@@ -162,6 +167,7 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 			TargetBranch: pull.Destination.Branch.Name,
 			HeadSHA:      pull.Source.Commit.Hash,
 			Author:       pull.Author.Nickname,
+			Draft:        pull.Draft,
 		})
 	}
 
@@ -61,19 +61,19 @@ func TestParseUrlEmptyUrl(t *testing.T) {
 }
 
 func TestInvalidBaseUrlBasicAuthCloud(t *testing.T) {
-	_, err := NewBitbucketCloudServiceBasicAuth("http:// example.org", "user", "password", "OWNER", "REPO")
+	_, err := NewBitbucketCloudServiceBasicAuth(t.Context(), "http:// example.org", "user", "password", "OWNER", "REPO")
 
 	require.Error(t, err)
 }
 
 func TestInvalidBaseUrlBearerTokenCloud(t *testing.T) {
-	_, err := NewBitbucketCloudServiceBearerToken("http:// example.org", "TOKEN", "OWNER", "REPO")
+	_, err := NewBitbucketCloudServiceBearerToken(t.Context(), "http:// example.org", "TOKEN", "OWNER", "REPO")
 
 	require.Error(t, err)
 }
 
 func TestInvalidBaseUrlNoAuthCloud(t *testing.T) {
-	_, err := NewBitbucketCloudServiceNoAuth("http:// example.org", "OWNER", "REPO")
+	_, err := NewBitbucketCloudServiceNoAuth(t.Context(), "http:// example.org", "OWNER", "REPO")
 
 	require.Error(t, err)
 }
@@ -84,7 +84,7 @@ func TestListPullRequestBearerTokenCloud(t *testing.T) {
 		defaultHandlerCloud(t)(w, r)
 	}))
 	defer ts.Close()
-	svc, err := NewBitbucketCloudServiceBearerToken(ts.URL, "TOKEN", "OWNER", "REPO")
+	svc, err := NewBitbucketCloudServiceBearerToken(t.Context(), ts.URL, "TOKEN", "OWNER", "REPO")
 	require.NoError(t, err)
 	pullRequests, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{})
 	require.NoError(t, err)
@@ -102,7 +102,7 @@ func TestListPullRequestNoAuthCloud(t *testing.T) {
 		defaultHandlerCloud(t)(w, r)
 	}))
 	defer ts.Close()
-	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	svc, err := NewBitbucketCloudServiceNoAuth(t.Context(), ts.URL, "OWNER", "REPO")
 	require.NoError(t, err)
 	pullRequests, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{})
 	require.NoError(t, err)
@@ -120,7 +120,7 @@ func TestListPullRequestBasicAuthCloud(t *testing.T) {
 		defaultHandlerCloud(t)(w, r)
 	}))
 	defer ts.Close()
-	svc, err := NewBitbucketCloudServiceBasicAuth(ts.URL, "user", "password", "OWNER", "REPO")
+	svc, err := NewBitbucketCloudServiceBasicAuth(t.Context(), ts.URL, "user", "password", "OWNER", "REPO")
 	require.NoError(t, err)
 	pullRequests, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{})
 	require.NoError(t, err)
@@ -211,7 +211,7 @@ func TestListPullRequestPaginationCloud(t *testing.T) {
 		}
 	}))
 	defer ts.Close()
-	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	svc, err := NewBitbucketCloudServiceNoAuth(t.Context(), ts.URL, "OWNER", "REPO")
 	require.NoError(t, err)
 	pullRequests, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{})
 	require.NoError(t, err)
@@ -244,7 +244,7 @@ func TestListResponseErrorCloud(t *testing.T) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}))
 	defer ts.Close()
-	svc, _ := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	svc, _ := NewBitbucketCloudServiceNoAuth(t.Context(), ts.URL, "OWNER", "REPO")
 	_, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{})
 	require.Error(t, err)
 }
@@ -268,7 +268,7 @@ func TestListResponseMalformedCloud(t *testing.T) {
 		}
 	}))
 	defer ts.Close()
-	svc, _ := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	svc, _ := NewBitbucketCloudServiceNoAuth(t.Context(), ts.URL, "OWNER", "REPO")
 	_, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{})
 	require.Error(t, err)
 }
@@ -292,7 +292,7 @@ func TestListResponseMalformedValuesCloud(t *testing.T) {
 		}
 	}))
 	defer ts.Close()
-	svc, _ := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	svc, _ := NewBitbucketCloudServiceNoAuth(t.Context(), ts.URL, "OWNER", "REPO")
 	_, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{})
 	require.Error(t, err)
 }
@@ -316,7 +316,7 @@ func TestListResponseEmptyCloud(t *testing.T) {
 		}
 	}))
 	defer ts.Close()
-	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	svc, err := NewBitbucketCloudServiceNoAuth(t.Context(), ts.URL, "OWNER", "REPO")
 	require.NoError(t, err)
 	pullRequests, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{})
 	require.NoError(t, err)
@@ -418,7 +418,7 @@ func TestListPullRequestBranchMatchCloud(t *testing.T) {
 	}))
 	defer ts.Close()
 	regexp := `feature-1[\d]{2}`
-	svc, err := NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	svc, err := NewBitbucketCloudServiceNoAuth(t.Context(), ts.URL, "OWNER", "REPO")
 	require.NoError(t, err)
 	pullRequests, err := ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{
 		{
@@ -445,7 +445,7 @@ func TestListPullRequestBranchMatchCloud(t *testing.T) {
 	}, *pullRequests[1])
 
 	regexp = `.*2$`
-	svc, err = NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	svc, err = NewBitbucketCloudServiceNoAuth(t.Context(), ts.URL, "OWNER", "REPO")
 	require.NoError(t, err)
 	pullRequests, err = ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{
 		{
@@ -464,7 +464,7 @@ func TestListPullRequestBranchMatchCloud(t *testing.T) {
 	}, *pullRequests[0])
 
 	regexp = `[\d{2}`
-	svc, err = NewBitbucketCloudServiceNoAuth(ts.URL, "OWNER", "REPO")
+	svc, err = NewBitbucketCloudServiceNoAuth(t.Context(), ts.URL, "OWNER", "REPO")
 	require.NoError(t, err)
 	_, err = ListPullRequests(t.Context(), svc, []v1alpha1.PullRequestGeneratorFilter{
 		{
@@ -506,7 +506,7 @@ func TestBitbucketCloudListReturnsRepositoryNotFoundError(t *testing.T) {
 		_, _ = w.Write([]byte(`{"message": "404 Project Not Found"}`))
 	})
 
-	svc, err := NewBitbucketCloudServiceNoAuth(server.URL, "nonexistent", "nonexistent")
+	svc, err := NewBitbucketCloudServiceNoAuth(t.Context(), server.URL, "nonexistent", "nonexistent")
 	require.NoError(t, err)
 
 	prs, err := svc.List(t.Context())
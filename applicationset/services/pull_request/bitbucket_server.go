@@ -80,7 +80,9 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 			return nil, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
 		}
 
-		for _, pull := range pulls {
+		// The draft status is not supported by the library, it is read from the raw response
+		rawPulls, _ := response.Values["values"].([]any)
+		for i, pull := range pulls {
 			pullRequests = append(pullRequests, &PullRequest{
 				Number:       int64(pull.ID),
 				Title:        pull.Title,
@@ -89,6 +91,7 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 				HeadSHA:      pull.FromRef.LatestCommit, // This is not defined in the official docs, but works in practice
 				Labels:       []string{},                // Not supported by library
 				Author:       pull.Author.User.Name,
+				Draft:        isBitbucketServerDraft(rawPulls, i),
 			})
 		}
 
@@ -100,3 +103,17 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+// isBitbucketServerDraft returns true if the i-th pull request of the raw response is a draft, which is only reported by
+// Bitbucket Data Center 8.18 and later
+func isBitbucketServerDraft(rawPulls []any, i int) bool {
+	if i >= len(rawPulls) {
+		return false
+	}
+	rawPull, ok := rawPulls[i].(map[string]any)
+	if !ok {
+		return false
+	}
+	draft, _ := rawPull["draft"].(bool)
+	return draft
+}
@@ -29,6 +29,7 @@ func defaultHandler(t *testing.T) func(http.ResponseWriter, *http.Request) {
 						{
 							"id": 101,
 							"title": "feat(ABC) : 123",
+							"draft": true,
 							"toRef": {
 								"latestCommit": "5b766e3564a3453808f3cd3dd3f2e5fad8ef0e7a",
 								"displayId": "master",
@@ -74,6 +75,7 @@ func TestListPullRequestNoAuth(t *testing.T) {
 	assert.Equal(t, "master", pullRequests[0].TargetBranch)
 	assert.Equal(t, "cb3cf2e4d1517c83e720d2585b9402dbef71f992", pullRequests[0].HeadSHA)
 	assert.Equal(t, "testName", pullRequests[0].Author)
+	assert.True(t, pullRequests[0].Draft)
 }
 
 func TestListPullRequestPagination(t *testing.T) {
@@ -8,6 +8,8 @@ import (
 	"os"
 
 	"code.gitea.io/sdk/gitea"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
 type GiteaService struct {
@@ -19,7 +21,7 @@ type GiteaService struct {
 
 var _ PullRequestService = (*GiteaService)(nil)
 
-func NewGiteaService(token, url, owner, repo string, labels []string, insecure bool) (PullRequestService, error) {
+func NewGiteaService(ctx context.Context, token, url, owner, repo string, labels []string, insecure bool) (PullRequestService, error) {
 	if token == "" {
 		token = os.Getenv("GITEA_TOKEN")
 	}
@@ -35,7 +37,7 @@ func NewGiteaService(token, url, owner, repo string, labels []string, insecure b
 			Transport: tr,
 		}
 	}
-	client, err := gitea.NewClient(url, gitea.SetToken(token), gitea.SetHTTPClient(httpClient))
+	client, err := gitea.NewClient(url, gitea.SetToken(token), gitea.SetHTTPClient(services.NewCorrelationIDClient(ctx, httpClient)))
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +77,7 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 			HeadSHA:      pr.Head.Sha,
 			Labels:       getGiteaPRLabelNames(pr.Labels),
 			Author:       pr.Poster.UserName,
+			Draft:        pr.Draft,
 		})
 	}
 	return list, nil
@@ -57,6 +57,7 @@ func giteaMockHandler(t *testing.T) func(http.ResponseWriter, *http.Request) {
 				"assignee": null,
 				"assignees": null,
 				"state": "open",
+				"draft": true,
 				"is_locked": false,
 				"comments": 0,
 				"html_url": "https://gitea.com/test-argocd/pr-test/pulls/1",
@@ -298,7 +299,7 @@ func TestGiteaList(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		giteaMockHandler(t)(w, r)
 	}))
-	host, err := NewGiteaService("", ts.URL, "test-argocd", "pr-test", []string{"label1"}, false)
+	host, err := NewGiteaService(t.Context(), "", ts.URL, "test-argocd", "pr-test", []string{"label1"}, false)
 	require.NoError(t, err)
 	prs, err := host.List(t.Context())
 	require.NoError(t, err)
@@ -309,6 +310,7 @@ func TestGiteaList(t *testing.T) {
 	assert.Equal(t, "main", prs[0].TargetBranch)
 	assert.Equal(t, "7bbaf62d92ddfafd9cc8b340c619abaec32bc09f", prs[0].HeadSHA)
 	assert.Equal(t, "graytshirt", prs[0].Author)
+	assert.True(t, prs[0].Draft)
 }
 
 func TestGetGiteaPRLabelNames(t *testing.T) {
@@ -359,7 +361,7 @@ func TestGiteaListReturnsRepositoryNotFoundError(t *testing.T) {
 		_, _ = w.Write([]byte(`{"message": "404 Project Not Found"}`))
 	})
 
-	svc, err := NewGiteaService("", server.URL, "nonexistent", "nonexistent", []string{}, false)
+	svc, err := NewGiteaService(t.Context(), "", server.URL, "nonexistent", "nonexistent", []string{}, false)
 	require.NoError(t, err)
 
 	prs, err := svc.List(t.Context())
@@ -21,6 +21,11 @@ type PullRequest struct {
 	Labels []string
 	// Author is the author of the pull request.
 	Author string
+	// Draft is true if the pull request is a draft.
+	Draft bool
+	// BaseNumber is the number of the pull request whose branch is targeted by the pull request, or 0 if the pull
+	// request is not stacked on another pull request. It is set by ListPullRequests.
+	BaseNumber int64
 }
 
 type PullRequestService interface {
@@ -32,4 +37,8 @@ type Filter struct {
 	BranchMatch       *regexp.Regexp
 	TargetBranchMatch *regexp.Regexp
 	TitleMatch        *regexp.Regexp
+	Stacked           *bool
+	Draft             *bool
+	AuthorMatch       *regexp.Regexp
+	Labels            []string
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
@@ -31,6 +32,15 @@ func compileFilters(filters []argoprojiov1alpha1.PullRequestGeneratorFilter) ([]
 				return nil, fmt.Errorf("error compiling TitleMatch regexp %q: %w", *filter.TitleMatch, err)
 			}
 		}
+		if filter.AuthorMatch != nil {
+			outFilter.AuthorMatch, err = regexp.Compile(*filter.AuthorMatch)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling AuthorMatch regexp %q: %w", *filter.AuthorMatch, err)
+			}
+		}
+		outFilter.Stacked = filter.Stacked
+		outFilter.Draft = filter.Draft
+		outFilter.Labels = filter.Labels
 		outFilters = append(outFilters, outFilter)
 	}
 	return outFilters, nil
@@ -46,6 +56,20 @@ func matchFilter(pullRequest *PullRequest, filter *Filter) bool {
 	if filter.TitleMatch != nil && !filter.TitleMatch.MatchString(pullRequest.Title) {
 		return false
 	}
+	if filter.Stacked != nil && *filter.Stacked != (pullRequest.BaseNumber != 0) {
+		return false
+	}
+	if filter.Draft != nil && *filter.Draft != pullRequest.Draft {
+		return false
+	}
+	if filter.AuthorMatch != nil && !filter.AuthorMatch.MatchString(pullRequest.Author) {
+		return false
+	}
+	for _, label := range filter.Labels {
+		if !slices.Contains(pullRequest.Labels, label) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -60,6 +84,7 @@ func ListPullRequests(ctx context.Context, provider PullRequestService, filters
 	if err != nil {
 		return nil, err
 	}
+	setBaseNumbers(pullRequests)
 
 	if len(compiledFilters) == 0 {
 		return pullRequests, nil
@@ -78,3 +103,16 @@ func ListPullRequests(ctx context.Context, provider PullRequestService, filters
 
 	return filteredPullRequests, nil
 }
+
+// setBaseNumbers sets the base number of the pull requests which target the branch of another pull request
+func setBaseNumbers(pullRequests []*PullRequest) {
+	numbersByBranch := make(map[string]int64, len(pullRequests))
+	for _, pullRequest := range pullRequests {
+		numbersByBranch[pullRequest.Branch] = pullRequest.Number
+	}
+	for _, pullRequest := range pullRequests {
+		if number, ok := numbersByBranch[pullRequest.TargetBranch]; ok && number != pullRequest.Number {
+			pullRequest.BaseNumber = number
+		}
+	}
+}
@@ -399,3 +399,138 @@ func TestNoFilters(t *testing.T) {
 	assert.Equal(t, "one", repos[0].Branch)
 	assert.Equal(t, "two", repos[1].Branch)
 }
+
+func TestFilterStacked(t *testing.T) {
+	newProvider := func() PullRequestService {
+		provider, _ := NewFakeService(
+			t.Context(),
+			[]*PullRequest{
+				{
+					Number:       1,
+					Title:        "PR one",
+					Branch:       "one",
+					TargetBranch: "master",
+					HeadSHA:      "189d92cbf9ff857a39e6feccd32798ca700fb958",
+					Author:       "name1",
+				},
+				{
+					Number:       2,
+					Title:        "PR two",
+					Branch:       "two",
+					TargetBranch: "one",
+					HeadSHA:      "289d92cbf9ff857a39e6feccd32798ca700fb958",
+					Author:       "name2",
+				},
+				{
+					Number:       3,
+					Title:        "PR three",
+					Branch:       "three",
+					TargetBranch: "release",
+					HeadSHA:      "389d92cbf9ff857a39e6feccd32798ca700fb958",
+					Author:       "name3",
+				},
+			},
+			nil,
+		)
+		return provider
+	}
+
+	t.Run("stacked", func(t *testing.T) {
+		stacked := true
+		pullRequests, err := ListPullRequests(t.Context(), newProvider(), []argoprojiov1alpha1.PullRequestGeneratorFilter{{Stacked: &stacked}})
+		require.NoError(t, err)
+		require.Len(t, pullRequests, 1)
+		assert.Equal(t, int64(2), pullRequests[0].Number)
+		assert.Equal(t, int64(1), pullRequests[0].BaseNumber)
+	})
+
+	t.Run("not stacked", func(t *testing.T) {
+		stacked := false
+		pullRequests, err := ListPullRequests(t.Context(), newProvider(), []argoprojiov1alpha1.PullRequestGeneratorFilter{{Stacked: &stacked}})
+		require.NoError(t, err)
+		require.Len(t, pullRequests, 2)
+		assert.Equal(t, int64(1), pullRequests[0].Number)
+		assert.Equal(t, int64(3), pullRequests[1].Number)
+		assert.Zero(t, pullRequests[1].BaseNumber)
+	})
+
+	t.Run("stacked with target branch", func(t *testing.T) {
+		stacked := false
+		pullRequests, err := ListPullRequests(t.Context(), newProvider(), []argoprojiov1alpha1.PullRequestGeneratorFilter{{Stacked: &stacked, TargetBranchMatch: strp("^rel.*")}})
+		require.NoError(t, err)
+		require.Len(t, pullRequests, 1)
+		assert.Equal(t, int64(3), pullRequests[0].Number)
+	})
+}
+
+func TestFilterDraftAuthorAndLabels(t *testing.T) {
+	newProvider := func() PullRequestService {
+		provider, _ := NewFakeService(
+			t.Context(),
+			[]*PullRequest{
+				{
+					Number:       1,
+					Title:        "PR one",
+					Branch:       "one",
+					TargetBranch: "master",
+					HeadSHA:      "189d92cbf9ff857a39e6feccd32798ca700fb958",
+					Labels:       []string{"preview", "team-a"},
+					Author:       "alice",
+				},
+				{
+					Number:       2,
+					Title:        "PR two",
+					Branch:       "two",
+					TargetBranch: "master",
+					HeadSHA:      "289d92cbf9ff857a39e6feccd32798ca700fb958",
+					Labels:       []string{"preview"},
+					Author:       "bob",
+					Draft:        true,
+				},
+				{
+					Number:       3,
+					Title:        "PR three",
+					Branch:       "three",
+					TargetBranch: "master",
+					HeadSHA:      "389d92cbf9ff857a39e6feccd32798ca700fb958",
+					Author:       "renovate[bot]",
+				},
+			},
+			nil,
+		)
+		return provider
+	}
+
+	numbers := func(pullRequests []*PullRequest) []int64 {
+		res := []int64{}
+		for _, pullRequest := range pullRequests {
+			res = append(res, pullRequest.Number)
+		}
+		return res
+	}
+
+	draft, notDraft := true, false
+	for _, cc := range []struct {
+		name     string
+		filter   argoprojiov1alpha1.PullRequestGeneratorFilter
+		expected []int64
+	}{
+		{name: "draft", filter: argoprojiov1alpha1.PullRequestGeneratorFilter{Draft: &draft}, expected: []int64{2}},
+		{name: "not draft", filter: argoprojiov1alpha1.PullRequestGeneratorFilter{Draft: &notDraft}, expected: []int64{1, 3}},
+		{name: "author", filter: argoprojiov1alpha1.PullRequestGeneratorFilter{AuthorMatch: strp(`^(alice|bob)$`)}, expected: []int64{1, 2}},
+		{name: "labels", filter: argoprojiov1alpha1.PullRequestGeneratorFilter{Labels: []string{"preview"}}, expected: []int64{1, 2}},
+		{name: "all the labels", filter: argoprojiov1alpha1.PullRequestGeneratorFilter{Labels: []string{"preview", "team-a"}}, expected: []int64{1}},
+		{name: "labels and not draft", filter: argoprojiov1alpha1.PullRequestGeneratorFilter{Labels: []string{"preview"}, Draft: &notDraft}, expected: []int64{1}},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			pullRequests, err := ListPullRequests(t.Context(), newProvider(), []argoprojiov1alpha1.PullRequestGeneratorFilter{cc.filter})
+			require.NoError(t, err)
+			assert.Equal(t, cc.expected, numbers(pullRequests))
+		})
+	}
+
+	t.Run("bad author regexp", func(t *testing.T) {
+		_, err := ListPullRequests(t.Context(), newProvider(), []argoprojiov1alpha1.PullRequestGeneratorFilter{{AuthorMatch: strp("(")}})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+// DefaultSCMRateLimitMaxWait is the longest time a request waits for the rate limit of an SCM provider API to reset.
+// Requests whose rate limit resets later fail immediately, so that reconciliations are not blocked until then.
+const DefaultSCMRateLimitMaxWait = time.Minute
+
+// SCMRateLimitBudget is the rate limit budget of the SCM provider APIs, shared between the generators and across
+// reconciliations. Requests are throttled by a token bucket per API host and credentials, and are held back until
+// the rate limit resets when the provider reports it as exhausted.
+type SCMRateLimitBudget struct {
+	limit   rate.Limit
+	burst   int
+	maxWait time.Duration
+
+	lock    sync.Mutex
+	buckets map[string]*rateLimitBucket
+}
+
+type rateLimitBucket struct {
+	limiter *rate.Limiter
+
+	lock         sync.Mutex
+	blockedUntil time.Time
+}
+
+// NewSCMRateLimitBudget creates a budget allowing requestsPerSecond requests per second with bursts of burst requests
+// to each SCM provider API. The requests are not throttled if requestsPerSecond is 0, but are still held back when
+// the rate limit of the provider is exhausted.
+func NewSCMRateLimitBudget(requestsPerSecond float64, burst int, maxWait time.Duration) *SCMRateLimitBudget {
+	limit := rate.Inf
+	if requestsPerSecond > 0 {
+		limit = rate.Limit(requestsPerSecond)
+	}
+	return &SCMRateLimitBudget{
+		limit:   limit,
+		burst:   max(burst, 1),
+		maxWait: maxWait,
+		buckets: map[string]*rateLimitBucket{},
+	}
+}
+
+// rateLimitBucketKey returns the key of the bucket of the request. Rate limits are accounted per credentials, so the
+// credentials of the request are part of the key.
+func rateLimitBucketKey(req *http.Request) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\n%s", req.URL.Host, req.Header.Get("Authorization"))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (b *SCMRateLimitBudget) bucket(req *http.Request) *rateLimitBucket {
+	key := rateLimitBucketKey(req)
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	bucket, ok := b.buckets[key]
+	if !ok {
+		bucket = &rateLimitBucket{limiter: rate.NewLimiter(b.limit, b.burst)}
+		b.buckets[key] = bucket
+	}
+	return bucket
+}
+
+func (b *rateLimitBucket) getBlockedUntil() time.Time {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.blockedUntil
+}
+
+func (b *rateLimitBucket) blockUntil(until time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if until.After(b.blockedUntil) {
+		b.blockedUntil = until
+	}
+}
+
+// rateLimitResetTime returns the time until which the provider asks not to send requests anymore, or the zero time if
+// the response does not report an exhausted rate limit. Both the primary rate limit headers (X-RateLimit-Remaining
+// and X-RateLimit-Reset) and the Retry-After header of the secondary rate limits are supported.
+func rateLimitResetTime(resp *http.Response, now time.Time) time.Time {
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil {
+				return now.Add(time.Duration(seconds) * time.Second)
+			}
+			if date, err := http.ParseTime(retryAfter); err == nil {
+				return date
+			}
+		}
+	}
+	for _, prefix := range []string{"X-RateLimit-", "RateLimit-"} {
+		if resp.Header.Get(prefix+"Remaining") != "0" {
+			continue
+		}
+		reset, err := strconv.ParseInt(resp.Header.Get(prefix+"Reset"), 10, 64)
+		if err != nil {
+			continue
+		}
+		return time.Unix(reset, 0)
+	}
+	return time.Time{}
+}
+
+// RateLimitTransport is a http.RoundTripper spending the rate limit budget of the SCM provider APIs
+type RateLimitTransport struct {
+	transport http.RoundTripper
+	budget    *SCMRateLimitBudget
+}
+
+// NewRateLimitTransport wraps the given transport with the rate limit budget
+func NewRateLimitTransport(transport http.RoundTripper, budget *SCMRateLimitBudget) *RateLimitTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &RateLimitTransport{transport: transport, budget: budget}
+}
+
+// RoundTrip implements http.RoundTripper interface
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	bucket := t.budget.bucket(req)
+
+	blockedUntil := bucket.getBlockedUntil()
+	if wait := time.Until(blockedUntil); wait > 0 {
+		if wait > t.budget.maxWait {
+			return nil, fmt.Errorf("rate limit of %s is exhausted until %s", req.URL.Host, blockedUntil.Format(time.RFC3339))
+		}
+		log.WithField("host", req.URL.Host).Debugf("rate limit exhausted, waiting %s for it to reset", wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+
+	if err := bucket.limiter.Wait(req.Context()); err != nil {
+		return nil, fmt.Errorf("error waiting for the rate limit budget of %s: %w", req.URL.Host, err)
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if reset := rateLimitResetTime(resp, time.Now()); !reset.IsZero() {
+		log.WithField("host", req.URL.Host).Infof("rate limit exhausted, holding back requests until %s", reset.Format(time.RFC3339))
+		bucket.blockUntil(reset)
+	}
+	return resp, nil
+}
+
+// NewRateLimitClient returns a http.Client spending the rate limit budget on top of the transport of the given client
+func NewRateLimitClient(httpClient *http.Client, budget *SCMRateLimitBudget) *http.Client {
+	var transport http.RoundTripper
+	if httpClient != nil {
+		transport = httpClient.Transport
+	}
+	return &http.Client{Transport: NewRateLimitTransport(transport, budget)}
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitResetTime(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	for _, cc := range []struct {
+		name       string
+		statusCode int
+		header     map[string]string
+		expected   time.Time
+	}{
+		{
+			name:       "remaining budget",
+			statusCode: http.StatusOK,
+			header:     map[string]string{"X-RateLimit-Remaining": "42", "X-RateLimit-Reset": "1700000600"},
+		},
+		{
+			name:       "exhausted primary rate limit",
+			statusCode: http.StatusForbidden,
+			header:     map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1700000600"},
+			expected:   time.Unix(1700000600, 0),
+		},
+		{
+			name:       "exhausted primary rate limit with unprefixed headers",
+			statusCode: http.StatusOK,
+			header:     map[string]string{"RateLimit-Remaining": "0", "RateLimit-Reset": "1700000600"},
+			expected:   time.Unix(1700000600, 0),
+		},
+		{
+			name:       "secondary rate limit",
+			statusCode: http.StatusForbidden,
+			header:     map[string]string{"Retry-After": "30", "X-RateLimit-Remaining": "42"},
+			expected:   now.Add(30 * time.Second),
+		},
+		{
+			name:       "too many requests with a date",
+			statusCode: http.StatusTooManyRequests,
+			header:     map[string]string{"Retry-After": now.Add(time.Minute).UTC().Format(http.TimeFormat)},
+			expected:   now.Add(time.Minute),
+		},
+		{
+			name:       "retry after of a successful response",
+			statusCode: http.StatusOK,
+			header:     map[string]string{"Retry-After": "30"},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: cc.statusCode, Header: http.Header{}}
+			for k, v := range cc.header {
+				resp.Header.Set(k, v)
+			}
+			assert.True(t, cc.expected.Equal(rateLimitResetTime(resp, now)), "expected %s, got %s", cc.expected, rateLimitResetTime(resp, now))
+		})
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	var requests int
+	var reset time.Time
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("Authorization") == "Bearer exhausted" {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	budget := NewSCMRateLimitBudget(0, 1, 2*time.Second)
+	get := func(token string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+"/orgs/argoproj/repos", http.NoBody)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		// a new client per request, as the generators create one per reconciliation
+		resp, err := NewRateLimitClient(nil, budget).Do(req)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		return resp, err
+	}
+
+	// the rate limit resets after the maximum wait, the next requests fail without being sent
+	reset = time.Now().Add(time.Hour)
+	resp, err := get("exhausted")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	_, err = get("exhausted")
+	require.ErrorContains(t, err, "rate limit of "+ts.Listener.Addr().String()+" is exhausted until")
+	assert.Equal(t, 1, requests)
+
+	// the budget is accounted per credentials
+	resp, err = get("other")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, requests)
+
+	// the rate limit resets within the maximum wait, the next request waits for it
+	budget = NewSCMRateLimitBudget(0, 1, 2*time.Second)
+	reset = time.Now().Add(time.Second)
+	_, err = get("exhausted")
+	require.NoError(t, err)
+	start := time.Now()
+	_, err = get("exhausted")
+	require.NoError(t, err)
+	assert.Equal(t, 4, requests)
+	assert.True(t, time.Now().After(reset.Truncate(time.Second)), "request sent %s before the reset", time.Since(start))
+}
+
+func TestRateLimitTransportThrottling(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := NewRateLimitClient(nil, NewSCMRateLimitBudget(20, 1, time.Minute))
+	start := time.Now()
+	for range 5 {
+		resp, err := client.Get(ts.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	// the first request is served by the burst, the other ones at 20 requests per second
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
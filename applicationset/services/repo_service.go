@@ -16,14 +16,23 @@ type argoCDService struct {
 	newFileGlobbingEnabled          bool
 	getGitFilesFromRepoServer       func(ctx context.Context, req *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error)
 	getGitDirectoriesFromRepoServer func(ctx context.Context, req *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error)
+	getOCITagsFromRepoServer        func(ctx context.Context, req *apiclient.ListRefsRequest) (*apiclient.Refs, error)
+	resolveRevisionFromRepoServer   func(ctx context.Context, req *apiclient.ResolveRevisionRequest) (*apiclient.ResolveRevisionResponse, error)
 }
 
 type Repos interface {
 	// GetFiles returns content of files (not directories) within the target repo
 	GetFiles(ctx context.Context, repoURL, revision, project, pattern string, noRevisionCache, verifyCommit bool) (map[string][]byte, error)
 
-	// GetDirectories returns a list of directories (not files) within the target repo
-	GetDirectories(ctx context.Context, repoURL, revision, project string, noRevisionCache, verifyCommit bool) ([]string, error)
+	// GetDirectories returns a list of directories (not files) within the target repo. When paths is not empty, only
+	// the directories matching one of the paths glob patterns, and none of the excludedPaths glob patterns, are returned.
+	GetDirectories(ctx context.Context, repoURL, revision, project string, paths, excludedPaths []string, noRevisionCache, verifyCommit bool) ([]string, error)
+
+	// GetOCITags returns the tags of the target OCI repo
+	GetOCITags(ctx context.Context, repoURL, project string) ([]string, error)
+
+	// ResolveOCIDigest returns the digest of the given tag of the target OCI repo
+	ResolveOCIDigest(ctx context.Context, repoURL, project, tag string) (string, error)
 }
 
 func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclient.Clientset, newFileGlobbingEnabled bool) Repos {
@@ -47,6 +56,22 @@ func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclie
 			defer utilio.Close(closer)
 			return client.GetGitDirectories(ctx, dirRequest)
 		},
+		getOCITagsFromRepoServer: func(ctx context.Context, tagsRequest *apiclient.ListRefsRequest) (*apiclient.Refs, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.ListOCITags(ctx, tagsRequest)
+		},
+		resolveRevisionFromRepoServer: func(ctx context.Context, revisionRequest *apiclient.ResolveRevisionRequest) (*apiclient.ResolveRevisionResponse, error) {
+			closer, client, err := repoClientset.NewRepoServerClient()
+			if err != nil {
+				return nil, fmt.Errorf("error initializing new repo server client: %w", err)
+			}
+			defer utilio.Close(closer)
+			return client.ResolveRevision(ctx, revisionRequest)
+		},
 	}
 }
 
@@ -72,7 +97,7 @@ func (a *argoCDService) GetFiles(ctx context.Context, repoURL, revision, project
 	return fileResponse.GetMap(), nil
 }
 
-func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, project string, noRevisionCache, verifyCommit bool) ([]string, error) {
+func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, project string, paths, excludedPaths []string, noRevisionCache, verifyCommit bool) ([]string, error) {
 	repo, err := a.getRepository(ctx, repoURL, project)
 	if err != nil {
 		return nil, fmt.Errorf("error in GetRepository: %w", err)
@@ -84,6 +109,8 @@ func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, p
 		Revision:         revision,
 		NoRevisionCache:  noRevisionCache,
 		VerifyCommit:     verifyCommit,
+		Paths:            paths,
+		ExcludedPaths:    excludedPaths,
 	}
 
 	dirResponse, err := a.getGitDirectoriesFromRepoServer(ctx, dirRequest)
@@ -92,3 +119,37 @@ func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, p
 	}
 	return dirResponse.GetPaths(), nil
 }
+
+func (a *argoCDService) GetOCITags(ctx context.Context, repoURL, project string) ([]string, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	refs, err := a.getOCITagsFromRepoServer(ctx, &apiclient.ListRefsRequest{Repo: repo})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving OCI tags: %w", err)
+	}
+	return refs.GetTags(), nil
+}
+
+func (a *argoCDService) ResolveOCIDigest(ctx context.Context, repoURL, project, tag string) (string, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return "", fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	// The repo server resolves the revision of the source of the application, which only needs to be an OCI source
+	revisionRequest := &apiclient.ResolveRevisionRequest{
+		Repo: repo,
+		App: &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
+			Source: &v1alpha1.ApplicationSource{RepoURL: repoURL},
+		}},
+		AmbiguousRevision: tag,
+	}
+	revisionResponse, err := a.resolveRevisionFromRepoServer(ctx, revisionRequest)
+	if err != nil {
+		return "", fmt.Errorf("error resolving OCI tag %s: %w", tag, err)
+	}
+	return revisionResponse.GetRevision(), nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
@@ -27,6 +28,8 @@ func TestGetDirectories(t *testing.T) {
 		ctx             context.Context
 		repoURL         string
 		revision        string
+		paths           []string
+		excludedPaths   []string
 		noRevisionCache bool
 		verifyCommit    bool
 	}
@@ -64,6 +67,25 @@ func TestGetDirectories(t *testing.T) {
 		}, args: args{
 			repoURL: "foo",
 		}, want: []string{"foo", "foo/bar", "bar/foo"}, wantErr: assert.NoError},
+		{name: "WithPaths", fields: fields{
+			getRepository: func(_ context.Context, _, _ string) (*v1alpha1.Repository, error) {
+				return &v1alpha1.Repository{
+					Repo: "foo",
+				}, nil
+			},
+			getGitDirectories: func(_ context.Context, req *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error) {
+				if !assert.Equal(t, []string{"foo/*"}, req.GetPaths()) || !assert.Equal(t, []string{"foo/baz"}, req.GetExcludedPaths()) {
+					return nil, errors.New("unexpected paths")
+				}
+				return &apiclient.GitDirectoriesResponse{
+					Paths: []string{"foo/bar"},
+				}, nil
+			},
+		}, args: args{
+			repoURL:       "foo",
+			paths:         []string{"foo/*"},
+			excludedPaths: []string{"foo/baz"},
+		}, want: []string{"foo/bar"}, wantErr: assert.NoError},
 		{name: "ErrorVerifyingCommit", fields: fields{
 			getRepository: func(_ context.Context, _, _ string) (*v1alpha1.Repository, error) {
 				return &v1alpha1.Repository{}, nil
@@ -80,7 +102,7 @@ func TestGetDirectories(t *testing.T) {
 				submoduleEnabled:                tt.fields.submoduleEnabled,
 				getGitDirectoriesFromRepoServer: tt.fields.getGitDirectories,
 			}
-			got, err := a.GetDirectories(tt.args.ctx, tt.args.repoURL, tt.args.revision, "", tt.args.noRevisionCache, tt.args.verifyCommit)
+			got, err := a.GetDirectories(tt.args.ctx, tt.args.repoURL, tt.args.revision, "", tt.args.paths, tt.args.excludedPaths, tt.args.noRevisionCache, tt.args.verifyCommit)
 			if !tt.wantErr(t, err, fmt.Sprintf("GetDirectories(%v, %v, %v, %v)", tt.args.ctx, tt.args.repoURL, tt.args.revision, tt.args.noRevisionCache)) {
 				return
 			}
@@ -168,6 +190,38 @@ func TestGetFiles(t *testing.T) {
 	}
 }
 
+func TestGetOCITags(t *testing.T) {
+	a := &argoCDService{
+		getRepository: func(_ context.Context, url, _ string) (*v1alpha1.Repository, error) {
+			return &v1alpha1.Repository{Repo: url}, nil
+		},
+		getOCITagsFromRepoServer: func(_ context.Context, req *apiclient.ListRefsRequest) (*apiclient.Refs, error) {
+			assert.Equal(t, "oci://ghcr.io/example/chart", req.GetRepo().Repo)
+			return &apiclient.Refs{Tags: []string{"1.0.0", "1.1.0"}}, nil
+		},
+	}
+	tags, err := a.GetOCITags(t.Context(), "oci://ghcr.io/example/chart", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0", "1.1.0"}, tags)
+}
+
+func TestResolveOCIDigest(t *testing.T) {
+	a := &argoCDService{
+		getRepository: func(_ context.Context, url, _ string) (*v1alpha1.Repository, error) {
+			return &v1alpha1.Repository{Repo: url}, nil
+		},
+		resolveRevisionFromRepoServer: func(_ context.Context, req *apiclient.ResolveRevisionRequest) (*apiclient.ResolveRevisionResponse, error) {
+			if source := req.GetApp().Spec.GetSource(); !source.IsOCI() {
+				return nil, errors.New("not an OCI source")
+			}
+			return &apiclient.ResolveRevisionResponse{Revision: "sha256:" + req.GetAmbiguousRevision()}, nil
+		},
+	}
+	digest, err := a.ResolveOCIDigest(t.Context(), "oci://ghcr.io/example/chart", "", "1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:1.0.0", digest)
+}
+
 func TestNewArgoCDService(t *testing.T) {
 	testNamespace := "test"
 	clientset := fake.NewClientset()
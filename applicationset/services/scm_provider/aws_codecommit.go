@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"net/http"
 	pathpkg "path"
 	"path/filepath"
 	"slices"
@@ -20,6 +21,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	application "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -343,7 +345,7 @@ func toAbsolutePath(path string) string {
 	return filepath.ToSlash(filepath.Join("/", path)) //nolint:gocritic // Prepend slash to have an absolute path
 }
 
-func createAWSDiscoveryClients(_ context.Context, role string, region string) (*resourcegroupstaggingapi.ResourceGroupsTaggingAPI, *codecommit.CodeCommit, error) {
+func createAWSDiscoveryClients(ctx context.Context, role string, region string) (*resourcegroupstaggingapi.ResourceGroupsTaggingAPI, *codecommit.CodeCommit, error) {
 	podSession, err := session.NewSession()
 	if err != nil {
 		return nil, nil, fmt.Errorf("error creating new AWS pod session: %w", err)
@@ -372,6 +374,10 @@ func createAWSDiscoveryClients(_ context.Context, role string, region string) (*
 		log.Debugf("region is not provided for AWS CodeCommit discovery, using pod region")
 	}
 
+	discoverySession = discoverySession.Copy(&aws.Config{
+		HTTPClient: services.NewCorrelationIDClient(ctx, &http.Client{}),
+	})
+
 	taggingClient := resourcegroupstaggingapi.New(discoverySession)
 	codeCommitClient := codecommit.New(discoverySession)
 
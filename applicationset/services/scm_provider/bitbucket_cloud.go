@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	bitbucket "github.com/ktrysmt/go-bitbucket"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
 type BitBucketCloudProvider struct {
@@ -52,11 +54,12 @@ func (c *ExtendedClient) GetContents(repo *Repository, path string) (bool, error
 
 var _ SCMProviderService = &BitBucketCloudProvider{}
 
-func NewBitBucketCloudProvider(owner string, user string, password string, allBranches bool) (*BitBucketCloudProvider, error) {
+func NewBitBucketCloudProvider(ctx context.Context, owner string, user string, password string, allBranches bool) (*BitBucketCloudProvider, error) {
 	bitbucketClient, err := bitbucket.NewBasicAuth(user, password)
 	if err != nil {
 		return nil, fmt.Errorf("error creating BitBucket Cloud client with basic auth: %w", err)
 	}
+	bitbucketClient.HttpClient = services.NewCorrelationIDClient(ctx, bitbucketClient.HttpClient)
 	client := &ExtendedClient{
 		bitbucketClient,
 		user,
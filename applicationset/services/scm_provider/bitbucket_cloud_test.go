@@ -86,7 +86,7 @@ func TestBitbucketHasRepo(t *testing.T) {
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			provider, _ := NewBitBucketCloudProvider(c.owner, "user", "password", false)
+			provider, _ := NewBitBucketCloudProvider(t.Context(), c.owner, "user", "password", false)
 			repo := &Repository{
 				Organization: c.owner,
 				Repository:   c.repo,
@@ -486,7 +486,7 @@ func TestBitbucketListRepos(t *testing.T) {
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			provider, _ := NewBitBucketCloudProvider(c.owner, "user", "password", c.allBranches)
+			provider, _ := NewBitBucketCloudProvider(t.Context(), c.owner, "user", "password", c.allBranches)
 			rawRepos, err := ListRepos(t.Context(), provider, c.filters, c.proto)
 			if c.hasError {
 				require.Error(t, err)
@@ -9,6 +9,8 @@ import (
 	"os"
 
 	"code.gitea.io/sdk/gitea"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
 type GiteaProvider struct {
@@ -19,7 +21,7 @@ type GiteaProvider struct {
 
 var _ SCMProviderService = &GiteaProvider{}
 
-func NewGiteaProvider(owner, token, url string, allBranches, insecure bool) (*GiteaProvider, error) {
+func NewGiteaProvider(ctx context.Context, owner, token, url string, allBranches, insecure bool) (*GiteaProvider, error) {
 	if token == "" {
 		token = os.Getenv("GITEA_TOKEN")
 	}
@@ -35,7 +37,7 @@ func NewGiteaProvider(owner, token, url string, allBranches, insecure bool) (*Gi
 			Transport: tr,
 		}
 	}
-	client, err := gitea.NewClient(url, gitea.SetToken(token), gitea.SetHTTPClient(httpClient))
+	client, err := gitea.NewClient(url, gitea.SetToken(token), gitea.SetHTTPClient(services.NewCorrelationIDClient(ctx, httpClient)))
 	if err != nil {
 		return nil, fmt.Errorf("error creating a new gitea client: %w", err)
 	}
@@ -6,6 +6,8 @@ import (
 	"regexp"
 	"strings"
 
+	"golang.org/x/sync/errgroup"
+
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -98,7 +100,15 @@ func matchFilter(ctx context.Context, provider SCMProviderService, repo *Reposit
 	return true, nil
 }
 
+// ListRepos lists the repositories and branches of the provider matching the filters, scanning one repository at a
+// time
 func ListRepos(ctx context.Context, provider SCMProviderService, filters []argoprojiov1alpha1.SCMProviderGeneratorFilter, cloneProtocol string) ([]*Repository, error) {
+	return ListReposConcurrently(ctx, provider, filters, cloneProtocol, 1)
+}
+
+// ListReposConcurrently lists the repositories and branches of the provider matching the filters, scanning up to
+// concurrency repositories at the same time. The repositories are returned in the order of the provider.
+func ListReposConcurrently(ctx context.Context, provider SCMProviderService, filters []argoprojiov1alpha1.SCMProviderGeneratorFilter, cloneProtocol string, concurrency int) ([]*Repository, error) {
 	compiledFilters, err := compileFilters(filters)
 	if err != nil {
 		return nil, err
@@ -108,63 +118,82 @@ func ListRepos(ctx context.Context, provider SCMProviderService, filters []argop
 		return nil, err
 	}
 	repoFilters := getApplicableFilters(compiledFilters)[FilterTypeRepo]
-	if len(repoFilters) == 0 {
-		repos, err := getBranches(ctx, provider, repos, compiledFilters)
+	if len(repoFilters) != 0 {
+		repos, err = filterRepos(ctx, provider, repos, repoFilters, concurrency)
 		if err != nil {
 			return nil, err
 		}
-		return repos, nil
-	}
-	filteredRepos := make([]*Repository, 0, len(repos))
-	for _, repo := range repos {
-		for _, filter := range repoFilters {
-			matches, err := matchFilter(ctx, provider, repo, filter)
-			if err != nil {
-				return nil, err
-			}
-			if matches {
-				filteredRepos = append(filteredRepos, repo)
-				break
-			}
-		}
 	}
 
-	repos, err = getBranches(ctx, provider, filteredRepos, compiledFilters)
-	if err != nil {
-		return nil, err
-	}
-	return repos, nil
+	return getBranches(ctx, provider, repos, compiledFilters, concurrency)
 }
 
-func getBranches(ctx context.Context, provider SCMProviderService, repos []*Repository, compiledFilters []*Filter) ([]*Repository, error) {
-	reposWithBranches := []*Repository{}
-	for _, repo := range repos {
+func getBranches(ctx context.Context, provider SCMProviderService, repos []*Repository, compiledFilters []*Filter, concurrency int) ([]*Repository, error) {
+	branches := make([][]*Repository, len(repos))
+	err := forEachRepo(ctx, repos, concurrency, func(ctx context.Context, i int, repo *Repository) error {
 		reposFilled, err := provider.GetBranches(ctx, repo)
 		if err != nil {
-			return nil, err
+			return err
 		}
+		branches[i] = reposFilled
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	reposWithBranches := []*Repository{}
+	for _, reposFilled := range branches {
 		reposWithBranches = append(reposWithBranches, reposFilled...)
 	}
+
 	branchFilters := getApplicableFilters(compiledFilters)[FilterTypeBranch]
 	if len(branchFilters) == 0 {
 		return reposWithBranches, nil
 	}
-	filteredRepos := make([]*Repository, 0, len(reposWithBranches))
-	for _, repo := range reposWithBranches {
-		for _, filter := range branchFilters {
+	return filterRepos(ctx, provider, reposWithBranches, branchFilters, concurrency)
+}
+
+// filterRepos returns the repositories matching any of the filters
+func filterRepos(ctx context.Context, provider SCMProviderService, repos []*Repository, filters []*Filter, concurrency int) ([]*Repository, error) {
+	matched := make([]bool, len(repos))
+	err := forEachRepo(ctx, repos, concurrency, func(ctx context.Context, i int, repo *Repository) error {
+		for _, filter := range filters {
 			matches, err := matchFilter(ctx, provider, repo, filter)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			if matches {
-				filteredRepos = append(filteredRepos, repo)
-				break
+				matched[i] = true
+				return nil
 			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filteredRepos := make([]*Repository, 0, len(repos))
+	for i, repo := range repos {
+		if matched[i] {
+			filteredRepos = append(filteredRepos, repo)
+		}
 	}
 	return filteredRepos, nil
 }
 
+// forEachRepo calls f for each repository, with at most concurrency calls in flight. It stops at the first error.
+func forEachRepo(ctx context.Context, repos []*Repository, concurrency int, f func(ctx context.Context, i int, repo *Repository) error) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(concurrency, 1))
+	for i, repo := range repos {
+		g.Go(func() error {
+			return f(ctx, i, repo)
+		})
+	}
+	return g.Wait()
+}
+
 // getApplicableFilters returns a map of filters separated by type.
 func getApplicableFilters(filters []*Filter) map[FilterType][]*Filter {
 	filterMap := map[FilterType][]*Filter{
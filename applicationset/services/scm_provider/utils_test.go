@@ -1,8 +1,13 @@
 package scm_provider
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"regexp"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -320,3 +325,64 @@ func TestApplicableFilterMap(t *testing.T) {
 	assert.Len(t, filterMap[FilterTypeRepo], 2)
 	assert.Len(t, filterMap[FilterTypeBranch], 4)
 }
+
+// concurrencyProvider records the maximum number of concurrent calls to the mock provider
+type concurrencyProvider struct {
+	*MockProvider
+	lock        sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (p *concurrencyProvider) track() func() {
+	p.lock.Lock()
+	p.inFlight++
+	p.maxInFlight = max(p.maxInFlight, p.inFlight)
+	p.lock.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	return func() {
+		p.lock.Lock()
+		p.inFlight--
+		p.lock.Unlock()
+	}
+}
+
+func (p *concurrencyProvider) RepoHasPath(ctx context.Context, repo *Repository, path string) (bool, error) {
+	defer p.track()()
+	return p.MockProvider.RepoHasPath(ctx, repo, path)
+}
+
+func (p *concurrencyProvider) GetBranches(ctx context.Context, repo *Repository) ([]*Repository, error) {
+	defer p.track()()
+	if repo.Repository == "broken" {
+		return nil, errors.New("broken repository")
+	}
+	return p.MockProvider.GetBranches(ctx, repo)
+}
+
+func TestListReposConcurrently(t *testing.T) {
+	mockRepos := []*Repository{}
+	for i := range 20 {
+		name := fmt.Sprintf("repo%02d", i)
+		mockRepos = append(mockRepos, &Repository{Repository: name, Branch: "main"}, &Repository{Repository: name, Branch: "dev"})
+	}
+	filters := []argoprojiov1alpha1.SCMProviderGeneratorFilter{
+		{RepositoryMatch: strp("[02468]$"), PathsExist: []string{"repo02"}},
+		{RepositoryMatch: strp("1$"), BranchMatch: strp("^dev$")},
+	}
+
+	expected, err := ListRepos(t.Context(), &MockProvider{Repos: mockRepos}, filters, "")
+	require.NoError(t, err)
+	require.Len(t, expected, 4)
+
+	provider := &concurrencyProvider{MockProvider: &MockProvider{Repos: mockRepos}}
+	repos, err := ListReposConcurrently(t.Context(), provider, filters, "", 4)
+	require.NoError(t, err)
+	assert.Equal(t, expected, repos)
+	assert.LessOrEqual(t, provider.maxInFlight, 4)
+	assert.Greater(t, provider.maxInFlight, 1)
+
+	provider = &concurrencyProvider{MockProvider: &MockProvider{Repos: append(mockRepos, &Repository{Repository: "broken"})}}
+	_, err = ListReposConcurrently(t.Context(), provider, nil, "", 4)
+	require.EqualError(t, err, "broken repository")
+}
@@ -0,0 +1,108 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/internal/cloud"
+)
+
+const (
+	gcsBaseURL        = "https://storage.googleapis.com"
+	gcsReadOnlyScope  = "https://www.googleapis.com/auth/devstorage.read_only"
+	gcsStateExtension = ".tfstate"
+)
+
+var _ StateService = (*GCSService)(nil)
+
+// GCSService reads states from a Google Cloud Storage bucket, using the same layout as the Terraform gcs backend: the
+// state of each workspace is stored at <prefix>/<workspace>.tfstate.
+type GCSService struct {
+	client  *http.Client
+	baseURL string
+	bucket  string
+	prefix  string
+}
+
+// NewGCSService creates a service which authenticates with the given service account key in JSON format. The key is
+// required so that the application default credentials of the ApplicationSet controller are never used.
+func NewGCSService(ctx context.Context, bucket, prefix string, credentialsJSON []byte) (*GCSService, error) {
+	client, err := cloud.NewGoogleClient(ctx, credentialsJSON, gcsReadOnlyScope)
+	if err != nil {
+		return nil, err
+	}
+	return newGCSService(client, gcsBaseURL, bucket, prefix), nil
+}
+
+func newGCSService(client *http.Client, baseURL, bucket, prefix string) *GCSService {
+	prefix = strings.Trim(prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	return &GCSService{client: client, baseURL: strings.TrimSuffix(baseURL, "/"), bucket: bucket, prefix: prefix}
+}
+
+type gcsObjectList struct {
+	Items []struct {
+		Name string `json:"name"`
+	} `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+func (s *GCSService) ListWorkspaces(ctx context.Context, nameMatch *regexp.Regexp) ([]Workspace, error) {
+	names := []string{}
+	if nameMatch == nil {
+		names = append(names, DefaultWorkspace)
+	} else {
+		pageToken := ""
+		for {
+			query := url.Values{"prefix": {s.prefix}, "fields": {"items(name),nextPageToken"}}
+			if pageToken != "" {
+				query.Set("pageToken", pageToken)
+			}
+			var list gcsObjectList
+			if err := s.get(ctx, fmt.Sprintf("/storage/v1/b/%s/o?%s", url.PathEscape(s.bucket), query.Encode()), &list); err != nil {
+				return nil, fmt.Errorf("error listing states in bucket %s: %w", s.bucket, err)
+			}
+			for _, item := range list.Items {
+				name, ok := strings.CutSuffix(strings.TrimPrefix(item.Name, s.prefix), gcsStateExtension)
+				if ok && name != "" && !strings.Contains(name, "/") && nameMatch.MatchString(name) {
+					names = append(names, name)
+				}
+			}
+			if list.NextPageToken == "" {
+				break
+			}
+			pageToken = list.NextPageToken
+		}
+	}
+
+	workspaces := make([]Workspace, 0, len(names))
+	for _, name := range names {
+		var data []byte
+		err := s.get(ctx, fmt.Sprintf("/storage/v1/b/%s/o/%s?alt=media", url.PathEscape(s.bucket), url.PathEscape(s.prefix+name+gcsStateExtension)), &data)
+		if err != nil {
+			return nil, fmt.Errorf("error reading state of workspace %s: %w", name, err)
+		}
+		outputs, err := parseStateOutputs(data)
+		if err != nil {
+			return nil, fmt.Errorf("error reading state of workspace %s: %w", name, err)
+		}
+		workspaces = append(workspaces, Workspace{Name: name, Outputs: outputs})
+	}
+	sort.Slice(workspaces, func(i, j int) bool {
+		return workspaces[i].Name < workspaces[j].Name
+	})
+	return workspaces, nil
+}
+
+// get sends a GET request to the JSON API and decodes the response into v, or stores the raw response if v is a
+// pointer to a byte slice
+func (s *GCSService) get(ctx context.Context, path string, v any) error {
+	return cloud.Get(ctx, s.client, s.baseURL+path, nil, v)
+}
@@ -0,0 +1,63 @@
+package terraform
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGCSService_ListWorkspaces(t *testing.T) {
+	objects := map[string]string{
+		"clusters/default.tfstate": `{"version": 4, "outputs": {"name": {"value": "default"}}}`,
+		"clusters/prod-eu.tfstate": `{"version": 4, "outputs": {"name": {"value": "prod-eu"}}}`,
+		"clusters/prod-us.tfstate": `{"version": 4, "outputs": {"name": {"value": "prod-us"}}}`,
+		"clusters/prod-us.tflock":  `{}`,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/storage/v1/b/bucket/o", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "clusters/", r.URL.Query().Get("prefix"))
+		// return the objects on two pages to exercise pagination
+		if r.URL.Query().Get("pageToken") == "" {
+			_, _ = w.Write([]byte(`{"items": [{"name": "clusters/default.tfstate"}, {"name": "clusters/prod-eu.tfstate"}], "nextPageToken": "next"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"items": [{"name": "clusters/prod-us.tfstate"}, {"name": "clusters/prod-us.tflock"}]}`))
+	})
+	mux.HandleFunc("/storage/v1/b/bucket/o/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "media", r.URL.Query().Get("alt"))
+		data, ok := objects[r.URL.Path[len("/storage/v1/b/bucket/o/"):]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(data))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	service := newGCSService(server.Client(), server.URL, "bucket", "/clusters/")
+
+	workspaces, err := service.ListWorkspaces(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []Workspace{{Name: "default", Outputs: map[string]any{"name": "default"}}}, workspaces)
+
+	workspaces, err = service.ListWorkspaces(context.Background(), regexp.MustCompile("^prod-"))
+	require.NoError(t, err)
+	assert.Equal(t, []Workspace{
+		{Name: "prod-eu", Outputs: map[string]any{"name": "prod-eu"}},
+		{Name: "prod-us", Outputs: map[string]any{"name": "prod-us"}},
+	}, workspaces)
+
+	_, err = newGCSService(server.Client(), server.URL, "bucket", "missing").ListWorkspaces(context.Background(), nil)
+	require.ErrorContains(t, err, "error reading state of workspace default")
+}
+
+func TestNewGCSService_RequiresCredentials(t *testing.T) {
+	_, err := NewGCSService(t.Context(), "states", "", nil)
+	require.ErrorContains(t, err, "a Google Cloud service account key is required")
+}
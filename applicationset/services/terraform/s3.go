@@ -0,0 +1,142 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/internal/cloud"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// DefaultS3Key is the default path of the state of the default workspace of the s3 backend
+	DefaultS3Key = "terraform.tfstate"
+	// DefaultS3WorkspaceKeyPrefix is the default prefix of the paths of the states of non-default workspaces of the s3 backend
+	DefaultS3WorkspaceKeyPrefix = "env:"
+	// defaultS3CompatibleRegion is used for S3 compatible APIs if no region is given, since the AWS SDK requires one
+	defaultS3CompatibleRegion = "us-east-1"
+)
+
+var _ StateService = (*S3Service)(nil)
+
+// S3Service reads states from an S3 bucket, using the same layout as the Terraform s3 backend: the state of the default
+// workspace is stored at <key>, the states of the other workspaces at <workspaceKeyPrefix>/<workspace>/<key>.
+type S3Service struct {
+	client             s3iface.S3API
+	bucket             string
+	key                string
+	workspaceKeyPrefix string
+}
+
+// S3Options configures the access to the S3 bucket
+type S3Options struct {
+	Region          string
+	Role            string
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// NewS3Service creates a service which authenticates with the given static credentials, which are required so that
+// the credentials of the ApplicationSet controller are never used
+func NewS3Service(bucket, key, workspaceKeyPrefix string, opts S3Options) (*S3Service, error) {
+	region := opts.Region
+	if opts.Endpoint != "" && region == "" {
+		region = defaultS3CompatibleRegion
+	}
+	sess, err := cloud.NewAWSSession(cloud.AWSOptions{
+		Region:          region,
+		Role:            opts.Role,
+		AccessKeyID:     opts.AccessKeyID,
+		SecretAccessKey: opts.SecretAccessKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	config := &aws.Config{}
+	if opts.Endpoint != "" {
+		config.Endpoint = aws.String(opts.Endpoint)
+		config.S3ForcePathStyle = aws.Bool(true)
+	}
+	return newS3Service(s3.New(sess, config), bucket, key, workspaceKeyPrefix), nil
+}
+
+func newS3Service(client s3iface.S3API, bucket, key, workspaceKeyPrefix string) *S3Service {
+	if key == "" {
+		key = DefaultS3Key
+	}
+	if workspaceKeyPrefix == "" {
+		workspaceKeyPrefix = DefaultS3WorkspaceKeyPrefix
+	}
+	return &S3Service{client: client, bucket: bucket, key: key, workspaceKeyPrefix: workspaceKeyPrefix}
+}
+
+func (s *S3Service) ListWorkspaces(ctx context.Context, nameMatch *regexp.Regexp) ([]Workspace, error) {
+	keys := map[string]string{}
+	if matchesWorkspace(nameMatch, DefaultWorkspace) {
+		keys[DefaultWorkspace] = s.key
+	}
+	if nameMatch != nil {
+		prefix := s.workspaceKeyPrefix + "/"
+		err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(prefix),
+		}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+			for _, object := range page.Contents {
+				key := aws.StringValue(object.Key)
+				workspace, statePath, ok := strings.Cut(strings.TrimPrefix(key, prefix), "/")
+				if ok && statePath == s.key && nameMatch.MatchString(workspace) {
+					keys[workspace] = key
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing states in bucket %s: %w", s.bucket, err)
+		}
+	}
+
+	workspaces := make([]Workspace, 0, len(keys))
+	for name, key := range keys {
+		outputs, err := s.getOutputs(ctx, key)
+		if err != nil {
+			var awsErr awserr.Error
+			if name == DefaultWorkspace && nameMatch != nil && errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+				// the default workspace is only returned if it has a state when listing workspaces
+				continue
+			}
+			return nil, fmt.Errorf("error reading state of workspace %s: %w", name, err)
+		}
+		workspaces = append(workspaces, Workspace{Name: name, Outputs: outputs})
+	}
+	sort.Slice(workspaces, func(i, j int) bool {
+		return workspaces[i].Name < workspaces[j].Name
+	})
+	return workspaces, nil
+}
+
+func (s *S3Service) getOutputs(ctx context.Context, key string) (map[string]any, error) {
+	object, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer utilio.Close(object.Body)
+	data, err := io.ReadAll(object.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parseStateOutputs(data)
+}
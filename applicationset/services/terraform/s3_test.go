@@ -0,0 +1,117 @@
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeS3Client struct {
+	s3iface.S3API
+	objects map[string]string
+}
+
+func (c *fakeS3Client) GetObjectWithContext(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	data, ok := c.objects[aws.StringValue(input.Key)]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil)
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewBufferString(data))}, nil
+}
+
+func (c *fakeS3Client) ListObjectsV2PagesWithContext(_ aws.Context, input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool, _ ...request.Option) error {
+	// return every object on its own page to exercise pagination
+	for key := range c.objects {
+		if strings.HasPrefix(key, aws.StringValue(input.Prefix)) {
+			if !fn(&s3.ListObjectsV2Output{Contents: []*s3.Object{{Key: aws.String(key)}}}, false) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+func TestS3Service_ListWorkspaces(t *testing.T) {
+	state := func(name string) string {
+		return `{"version": 4, "outputs": {"name": {"value": "` + name + `"}}}`
+	}
+
+	t.Run("default layout", func(t *testing.T) {
+		client := &fakeS3Client{objects: map[string]string{
+			"terraform.tfstate":                    state("default"),
+			"env:/prod-eu/terraform.tfstate":       state("prod-eu"),
+			"env:/prod-us/terraform.tfstate":       state("prod-us"),
+			"env:/staging/terraform.tfstate":       state("staging"),
+			"env:/prod-eu/other/terraform.tfstate": state("ignored"),
+		}}
+		service := newS3Service(client, "bucket", "", "")
+
+		workspaces, err := service.ListWorkspaces(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []Workspace{{Name: "default", Outputs: map[string]any{"name": "default"}}}, workspaces)
+
+		workspaces, err = service.ListWorkspaces(context.Background(), regexp.MustCompile("^prod-"))
+		require.NoError(t, err)
+		assert.Equal(t, []Workspace{
+			{Name: "prod-eu", Outputs: map[string]any{"name": "prod-eu"}},
+			{Name: "prod-us", Outputs: map[string]any{"name": "prod-us"}},
+		}, workspaces)
+
+		workspaces, err = service.ListWorkspaces(context.Background(), regexp.MustCompile(".*"))
+		require.NoError(t, err)
+		assert.Len(t, workspaces, 4)
+	})
+
+	t.Run("custom key and prefix", func(t *testing.T) {
+		client := &fakeS3Client{objects: map[string]string{
+			"clusters/state.json":                 state("prod"),
+			"workspaces/prod/clusters/state.json": state("prod"),
+		}}
+		service := newS3Service(client, "bucket", "clusters/state.json", "workspaces")
+
+		workspaces, err := service.ListWorkspaces(context.Background(), regexp.MustCompile(".*"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"default", "prod"}, workspaceNames(workspaces))
+	})
+
+	t.Run("missing default state", func(t *testing.T) {
+		client := &fakeS3Client{objects: map[string]string{
+			"env:/prod/terraform.tfstate": state("prod"),
+		}}
+		service := newS3Service(client, "bucket", "", "")
+
+		workspaces, err := service.ListWorkspaces(context.Background(), regexp.MustCompile(".*"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"prod"}, workspaceNames(workspaces))
+
+		_, err = service.ListWorkspaces(context.Background(), nil)
+		require.ErrorContains(t, err, "error reading state of workspace default")
+	})
+}
+
+func workspaceNames(workspaces []Workspace) []string {
+	names := make([]string, 0, len(workspaces))
+	for _, ws := range workspaces {
+		names = append(names, ws.Name)
+	}
+	return names
+}
+
+func TestNewS3Service_RequiresCredentials(t *testing.T) {
+	_, err := NewS3Service("states", "", "", S3Options{Region: "eu-west-1"})
+	require.ErrorContains(t, err, "an access key ID and a secret access key are required")
+
+	_, err = NewS3Service("states", "", "", S3Options{Region: "eu-west-1", AccessKeyID: "AKIAEXAMPLE", SecretAccessKey: "secret"})
+	require.NoError(t, err)
+}
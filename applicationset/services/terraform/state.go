@@ -0,0 +1,65 @@
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// DefaultWorkspace is the name of the workspace which exists in every Terraform backend
+const DefaultWorkspace = "default"
+
+// Workspace is a Terraform workspace together with the outputs of its current state
+type Workspace struct {
+	// Name is the name of the workspace
+	Name string
+	// Outputs are the non-sensitive outputs of the state of the workspace
+	Outputs map[string]any
+}
+
+// StateService reads the states of Terraform workspaces from a backend
+type StateService interface {
+	// ListWorkspaces returns the workspaces whose names match the given regex. If the regex is nil, the backends which
+	// store states in buckets only return the default workspace.
+	ListWorkspaces(ctx context.Context, nameMatch *regexp.Regexp) ([]Workspace, error)
+}
+
+// state is the part of a Terraform or OpenTofu state file containing the outputs
+type state struct {
+	Version int                    `json:"version"`
+	Outputs map[string]stateOutput `json:"outputs"`
+}
+
+type stateOutput struct {
+	Value     any  `json:"value"`
+	Sensitive bool `json:"sensitive"`
+}
+
+// parseStateOutputs returns the non-sensitive outputs of the given state file
+func parseStateOutputs(data []byte) (map[string]any, error) {
+	var s state
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("error parsing state: %w", err)
+	}
+	if s.Version != 4 {
+		return nil, fmt.Errorf("unsupported state version %d", s.Version)
+	}
+	outputs := make(map[string]any, len(s.Outputs))
+	for name, output := range s.Outputs {
+		if output.Sensitive {
+			continue
+		}
+		outputs[name] = output.Value
+	}
+	return outputs, nil
+}
+
+// matchesWorkspace returns whether the given workspace is selected by the regex, using the semantics of ListWorkspaces
+// for backends which store states in buckets
+func matchesWorkspace(nameMatch *regexp.Regexp, name string) bool {
+	if nameMatch == nil {
+		return name == DefaultWorkspace
+	}
+	return nameMatch.MatchString(name)
+}
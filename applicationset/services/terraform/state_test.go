@@ -0,0 +1,42 @@
+package terraform
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testState = `{
+  "version": 4,
+  "terraform_version": "1.9.0",
+  "outputs": {
+    "cluster_name": {"value": "prod-eu", "type": "string"},
+    "regions": {"value": ["eu-west-1", "eu-central-1"], "type": ["list", "string"]},
+    "admin_password": {"value": "hunter2", "type": "string", "sensitive": true}
+  },
+  "resources": []
+}`
+
+func TestParseStateOutputs(t *testing.T) {
+	outputs, err := parseStateOutputs([]byte(testState))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"cluster_name": "prod-eu",
+		"regions":      []any{"eu-west-1", "eu-central-1"},
+	}, outputs)
+
+	_, err = parseStateOutputs([]byte(`{"version": 3, "modules": []}`))
+	require.ErrorContains(t, err, "unsupported state version 3")
+
+	_, err = parseStateOutputs([]byte(`not json`))
+	require.ErrorContains(t, err, "error parsing state")
+}
+
+func TestMatchesWorkspace(t *testing.T) {
+	assert.True(t, matchesWorkspace(nil, DefaultWorkspace))
+	assert.False(t, matchesWorkspace(nil, "prod"))
+	assert.True(t, matchesWorkspace(regexp.MustCompile("^prod"), "prod-eu"))
+	assert.False(t, matchesWorkspace(regexp.MustCompile("^prod"), DefaultWorkspace))
+}
@@ -0,0 +1,131 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+
+	internalhttp "github.com/argoproj/argo-cd/v3/applicationset/services/internal/http"
+)
+
+// DefaultTerraformCloudAPI is the URL of the HCP Terraform API
+const DefaultTerraformCloudAPI = "https://app.terraform.io"
+
+var (
+	_ StateService = (*TerraformCloudService)(nil)
+
+	errTerraformCloudNotFound = errors.New("not found")
+)
+
+// TerraformCloudService reads the outputs of the workspaces of an HCP Terraform or Terraform Enterprise organization
+type TerraformCloudService struct {
+	client       *internalhttp.Client
+	organization string
+}
+
+func NewTerraformCloudService(organization, api, token string) (*TerraformCloudService, error) {
+	if api == "" {
+		api = DefaultTerraformCloudAPI
+	}
+	client, err := internalhttp.NewClient(api, internalhttp.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("error creating Terraform Cloud client: %w", err)
+	}
+	return &TerraformCloudService{client: client, organization: organization}, nil
+}
+
+type terraformCloudWorkspaceList struct {
+	Data []struct {
+		ID         string `json:"id"`
+		Attributes struct {
+			Name string `json:"name"`
+		} `json:"attributes"`
+	} `json:"data"`
+	Meta struct {
+		Pagination struct {
+			NextPage *int `json:"next-page"`
+		} `json:"pagination"`
+	} `json:"meta"`
+}
+
+type terraformCloudOutputList struct {
+	Data []struct {
+		Attributes struct {
+			Name      string `json:"name"`
+			Sensitive bool   `json:"sensitive"`
+			Value     any    `json:"value"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// ListWorkspaces returns the workspaces of the organization whose names match the given regex, or all workspaces if
+// the regex is nil. Workspaces without a state are skipped.
+func (s *TerraformCloudService) ListWorkspaces(ctx context.Context, nameMatch *regexp.Regexp) ([]Workspace, error) {
+	workspaces := []Workspace{}
+	for page := 1; ; {
+		var list terraformCloudWorkspaceList
+		path := fmt.Sprintf("api/v2/organizations/%s/workspaces?page%%5Bnumber%%5D=%d&page%%5Bsize%%5D=100", url.PathEscape(s.organization), page)
+		if err := s.get(ctx, path, &list); err != nil {
+			return nil, fmt.Errorf("error listing workspaces of organization %s: %w", s.organization, err)
+		}
+		for _, ws := range list.Data {
+			if nameMatch != nil && !nameMatch.MatchString(ws.Attributes.Name) {
+				continue
+			}
+			outputs, found, err := s.getOutputs(ctx, ws.ID)
+			if err != nil {
+				return nil, fmt.Errorf("error reading outputs of workspace %s: %w", ws.Attributes.Name, err)
+			}
+			if !found {
+				continue
+			}
+			workspaces = append(workspaces, Workspace{Name: ws.Attributes.Name, Outputs: outputs})
+		}
+		if list.Meta.Pagination.NextPage == nil || *list.Meta.Pagination.NextPage <= page {
+			break
+		}
+		page = *list.Meta.Pagination.NextPage
+	}
+	sort.Slice(workspaces, func(i, j int) bool {
+		return workspaces[i].Name < workspaces[j].Name
+	})
+	return workspaces, nil
+}
+
+// getOutputs returns the non-sensitive outputs of the current state version of the workspace, and whether the
+// workspace has a state at all
+func (s *TerraformCloudService) getOutputs(ctx context.Context, workspaceID string) (map[string]any, bool, error) {
+	var list terraformCloudOutputList
+	err := s.get(ctx, fmt.Sprintf("api/v2/workspaces/%s/current-state-version-outputs", url.PathEscape(workspaceID)), &list)
+	if errors.Is(err, errTerraformCloudNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	outputs := make(map[string]any, len(list.Data))
+	for _, output := range list.Data {
+		if output.Attributes.Sensitive {
+			continue
+		}
+		outputs[output.Attributes.Name] = output.Attributes.Value
+	}
+	return outputs, true, nil
+}
+
+func (s *TerraformCloudService) get(ctx context.Context, path string, v any) error {
+	req, err := s.client.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.api+json")
+	resp, err := s.client.Do(req, v)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return errTerraformCloudNotFound
+	}
+	return err
+}
@@ -0,0 +1,66 @@
+package terraform
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerraformCloudService_ListWorkspaces(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/organizations/my-org/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch r.URL.Query().Get("page[number]") {
+		case "1":
+			_, _ = w.Write([]byte(`{"data": [
+				{"id": "ws-1", "attributes": {"name": "prod-eu"}},
+				{"id": "ws-2", "attributes": {"name": "staging"}}
+			], "meta": {"pagination": {"current-page": 1, "next-page": 2}}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"data": [
+				{"id": "ws-3", "attributes": {"name": "prod-us"}},
+				{"id": "ws-4", "attributes": {"name": "prod-new"}}
+			], "meta": {"pagination": {"current-page": 2, "next-page": null}}}`))
+		default:
+			t.Errorf("unexpected page %s", r.URL.Query().Get("page[number]"))
+		}
+	})
+	outputs := map[string]string{
+		"ws-1": `{"data": [
+			{"attributes": {"name": "cluster", "sensitive": false, "value": "prod-eu"}},
+			{"attributes": {"name": "password", "sensitive": true, "value": null}}
+		]}`,
+		"ws-2": `{"data": [{"attributes": {"name": "cluster", "sensitive": false, "value": "staging"}}]}`,
+		"ws-3": `{"data": [{"attributes": {"name": "cluster", "sensitive": false, "value": "prod-us"}}]}`,
+	}
+	mux.HandleFunc("/api/v2/workspaces/{id}/current-state-version-outputs", func(w http.ResponseWriter, r *http.Request) {
+		data, ok := outputs[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": [{"status": "404", "title": "not found"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(data))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	service, err := NewTerraformCloudService("my-org", server.URL, "token")
+	require.NoError(t, err)
+
+	workspaces, err := service.ListWorkspaces(context.Background(), regexp.MustCompile("^prod-"))
+	require.NoError(t, err)
+	assert.Equal(t, []Workspace{
+		{Name: "prod-eu", Outputs: map[string]any{"cluster": "prod-eu"}},
+		{Name: "prod-us", Outputs: map[string]any{"cluster": "prod-us"}},
+	}, workspaces)
+
+	workspaces, err = service.ListWorkspaces(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prod-eu", "prod-us", "staging"}, workspaceNames(workspaces))
+}
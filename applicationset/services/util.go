@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	azuregit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 )
@@ -16,7 +18,24 @@ func SetupBitbucketClient(ctx context.Context, config *bitbucketv1.Configuration
 
 	transport := http.DefaultTransport.(*http.Transport).Clone()
 	transport.TLSClientConfig = tlsConfig
-	config.HTTPClient = &http.Client{Transport: transport}
+	config.HTTPClient = NewCorrelationIDClient(ctx, &http.Client{Transport: transport})
 
 	return bitbucketv1.NewAPIClient(ctx, config)
 }
+
+// NewAzureDevOpsGitClient returns an Azure DevOps git client of the given connection whose requests carry the
+// correlation ID header
+func NewAzureDevOpsGitClient(ctx context.Context, connection *azuredevops.Connection) (azuregit.Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, azuregit.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := &http.Client{}
+	if connection.Timeout != nil {
+		httpClient.Timeout = *connection.Timeout
+	}
+	// the client is copied, so that the client cached by the connection is not modified
+	correlatedClient := *client
+	azuredevops.WithHTTPClient(NewCorrelationIDClient(ctx, httpClient))(&correlatedClient)
+	return &azuregit.ClientImpl{Client: correlatedClient}, nil
+}
@@ -21,8 +21,12 @@ func TestSetupBitbucketClient(t *testing.T) {
 	require.NotNil(t, client, "expected client to be created")
 	require.NotNil(t, cfg.HTTPClient, "expected HTTPClient to be set")
 
-	// The transport should be a clone of DefaultTransport
-	tr, ok := cfg.HTTPClient.Transport.(*http.Transport)
+	// The transport should send the correlation ID header
+	correlationTransport, ok := cfg.HTTPClient.Transport.(*CorrelationIDTransport)
+	require.True(t, ok, "expected HTTPClient.Transport to be *CorrelationIDTransport")
+
+	// The wrapped transport should be a clone of DefaultTransport
+	tr, ok := correlationTransport.transport.(*http.Transport)
 	require.True(t, ok, "expected HTTPClient.Transport to be *http.Transport")
 	require.NotSame(t, http.DefaultTransport, tr, "transport should be a clone, not the global DefaultTransport")
 
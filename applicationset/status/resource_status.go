@@ -1,6 +1,8 @@
 package status
 
 import (
+	"github.com/argoproj/gitops-engine/pkg/health"
+
 	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -26,6 +28,29 @@ func BuildResourceStatus(statusMap map[string]argov1alpha1.ResourceStatus, apps
 	return statusMap
 }
 
+// BuildSummary counts the Applications of the resource statuses per health and sync status
+func BuildSummary(statusMap map[string]argov1alpha1.ResourceStatus) argov1alpha1.ApplicationSetSummary {
+	var summary argov1alpha1.ApplicationSetSummary
+	for _, status := range statusMap {
+		if status.Health != nil {
+			switch status.Health.Status {
+			case health.HealthStatusHealthy:
+				summary.Healthy++
+			case health.HealthStatusDegraded:
+				summary.Degraded++
+			case health.HealthStatusProgressing:
+				summary.Progressing++
+			case health.HealthStatusMissing:
+				summary.Missing++
+			}
+		}
+		if status.Status == argov1alpha1.SyncStatusCodeOutOfSync {
+			summary.OutOfSync++
+		}
+	}
+	return summary
+}
+
 func GetResourceStatusMap(appset *argov1alpha1.ApplicationSet) map[string]argov1alpha1.ResourceStatus {
 	statusMap := map[string]argov1alpha1.ResourceStatus{}
 	for _, status := range appset.Status.Resources {
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// EncodeParameterSets returns the gzip compressed JSON of the parameter sets generated by the generators a generator of
+// an ApplicationSet consists of, together with the hash of the JSON.
+func EncodeParameterSets(params [][]map[string]any) ([]byte, string, error) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, "", fmt.Errorf("error marshaling parameter sets: %w", err)
+	}
+	hash := sha256.Sum256(data)
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, "", fmt.Errorf("error compressing parameter sets: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", fmt.Errorf("error compressing parameter sets: %w", err)
+	}
+	return buf.Bytes(), hex.EncodeToString(hash[:]), nil
+}
+
+// DecodeParameterSets returns the parameter sets encoded by EncodeParameterSets
+func DecodeParameterSets(encoded []byte) ([][]map[string]any, error) {
+	r, err := gzip.NewReader(bytes.NewReader(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing parameter sets: %w", err)
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing parameter sets: %w", err)
+	}
+	var params [][]map[string]any
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, fmt.Errorf("error unmarshaling parameter sets: %w", err)
+	}
+	return params, nil
+}
+
+// GeneratorHash returns the hash of the given generator of an ApplicationSet. The requeue strategy of the generator is
+// ignored, as it does not affect the generated parameters.
+func GeneratorHash(generator argoappsv1.ApplicationSetGenerator) (string, error) {
+	generator.RequeueStrategy = nil
+	data, err := json.Marshal(generator)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling generator: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
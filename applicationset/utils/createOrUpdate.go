@@ -15,9 +15,11 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
@@ -60,17 +62,127 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 	}
 
 	// Apply ignoreApplicationDifferences rules to remove ignored fields from both the live and the desired state. This
-	// prevents those differences from appearing in the diff and therefore in the patch.
-	err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, obj, ignoreNormalizerOpts)
-	if err != nil {
-		return controllerutil.OperationResultNone, fmt.Errorf("failed to apply ignore differences: %w", err)
+	// prevents those differences from appearing in the diff and therefore in the patch. The rules are skipped if the
+	// template is requested to be reapplied to the Application.
+	if !IsReapplyTemplateRequested(normalizedLive) {
+		err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, obj, ignoreNormalizerOpts)
+		if err != nil {
+			return controllerutil.OperationResultNone, fmt.Errorf("failed to apply ignore differences: %w", err)
+		}
 	}
 
 	// Normalize to avoid diffing on unimportant differences.
 	normalizedLive.Spec = *argo.NormalizeApplicationSpec(&normalizedLive.Spec)
 	obj.Spec = *argo.NormalizeApplicationSpec(&obj.Spec)
 
-	equality := conversion.EqualitiesOrDie(
+	if applicationEqualities().DeepEqual(normalizedLive, obj) {
+		return controllerutil.OperationResultNone, nil
+	}
+
+	// The Application CRD might be newer than this controller, e.g. during an upgrade. Fields unknown to this controller
+	// are lost when the cached Application is decoded, so they are read from the live object and kept in the desired
+	// state. Otherwise patching list fields, e.g. spec.sources, would strip them from the list items.
+	desired, err := preserveUnknownFields(ctx, c, key, obj)
+	if err != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("failed to preserve unknown application fields: %w", err)
+	}
+	patchBytes, err := client.MergeFrom(normalizedLive).Data(desired)
+	if err != nil {
+		return controllerutil.OperationResultNone, fmt.Errorf("failed to generate patch: %w", err)
+	}
+	patch := client.RawPatch(types.MergePatchType, patchBytes)
+	if log.IsLevelEnabled(log.DebugLevel) {
+		LogPatch(logCtx, patch, obj)
+	}
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	return controllerutil.OperationResultUpdated, nil
+}
+
+// preserveUnknownFields returns the desired Application as unstructured object, including the fields of the live
+// Application which are not known to this version of the Application type. Unstructured objects are not cached by
+// the controller, so the live Application is read from the API server.
+func preserveUnknownFields(ctx context.Context, c client.Client, key client.ObjectKey, desired *argov1alpha1.Application) (*unstructured.Unstructured, error) {
+	desiredUn, err := appToUnstructured(desired)
+	if err != nil {
+		return nil, err
+	}
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(argov1alpha1.ApplicationSchemaGroupVersionKind)
+	if err := c.Get(ctx, key, live); err != nil {
+		return nil, fmt.Errorf("failed to get application: %w", err)
+	}
+	// Round-trip the live Application through the typed struct to find out which of its fields are known
+	typedLive := &argov1alpha1.Application{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(live.Object, typedLive); err != nil {
+		return nil, fmt.Errorf("failed to convert live application: %w", err)
+	}
+	knownLive, err := appToUnstructured(typedLive)
+	if err != nil {
+		return nil, err
+	}
+	copyUnknownFields(live.Object, knownLive.Object, desiredUn.Object)
+	return desiredUn, nil
+}
+
+// copyUnknownFields copies the fields of live which are missing in known into desired. Nested objects are only
+// considered if desired contains them as well, list items are matched by their position.
+func copyUnknownFields(live any, known any, desired any) {
+	switch live := live.(type) {
+	case map[string]any:
+		known, _ := known.(map[string]any)
+		desired, ok := desired.(map[string]any)
+		if !ok {
+			return
+		}
+		for field, liveValue := range live {
+			knownValue, isKnown := known[field]
+			desiredValue, isDesired := desired[field]
+			switch {
+			case !isKnown && !isDesired:
+				desired[field] = runtime.DeepCopyJSONValue(liveValue)
+			case isKnown && isDesired:
+				copyUnknownFields(liveValue, knownValue, desiredValue)
+			}
+		}
+	case []any:
+		known, _ := known.([]any)
+		desired, ok := desired.([]any)
+		if !ok {
+			return
+		}
+		for i := range live {
+			if i >= len(known) || i >= len(desired) {
+				return
+			}
+			copyUnknownFields(live[i], known[i], desired[i])
+		}
+	}
+}
+
+// IsReapplyTemplateRequested returns true if the Application is annotated to request the ApplicationSet template to be reapplied
+func IsReapplyTemplateRequested(app *argov1alpha1.Application) bool {
+	_, found := app.Annotations[common.AnnotationApplicationSetReapplyTemplate]
+	return found
+}
+
+// IsApplicationStale returns true if the spec of the live Application deviates from the spec of the desired Application,
+// the latest rendered template. Differences in ignored application differences are not considered, while differences in
+// preserved spec paths are, so that values pinned on the Application are reported until the template is reapplied.
+func IsApplicationStale(live *argov1alpha1.Application, desired *argov1alpha1.Application, ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts) (bool, error) {
+	normalizedLive := live.DeepCopy()
+	normalizedDesired := desired.DeepCopy()
+	if err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, normalizedDesired, ignoreNormalizerOpts); err != nil {
+		return false, fmt.Errorf("failed to apply ignore differences: %w", err)
+	}
+	liveSpec := argo.NormalizeApplicationSpec(&normalizedLive.Spec)
+	desiredSpec := argo.NormalizeApplicationSpec(&normalizedDesired.Spec)
+	return !applicationEqualities().DeepEqual(liveSpec, desiredSpec), nil
+}
+
+func applicationEqualities() conversion.Equalities {
+	return conversion.EqualitiesOrDie(
 		func(a, b resource.Quantity) bool {
 			// Ignore formatting, only care that numeric value stayed the same.
 			// TODO: if we decide it's important, it should be safe to start comparing the format.
@@ -94,19 +206,6 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 			return a.Namespace == b.Namespace && a.Name == b.Name && a.Server == b.Server
 		},
 	)
-
-	if equality.DeepEqual(normalizedLive, obj) {
-		return controllerutil.OperationResultNone, nil
-	}
-
-	patch := client.MergeFrom(normalizedLive)
-	if log.IsLevelEnabled(log.DebugLevel) {
-		LogPatch(logCtx, patch, obj)
-	}
-	if err := c.Patch(ctx, obj, patch); err != nil {
-		return controllerutil.OperationResultNone, err
-	}
-	return controllerutil.OperationResultUpdated, nil
 }
 
 func LogPatch(logCtx *log.Entry, patch client.Patch, obj *argov1alpha1.Application) {
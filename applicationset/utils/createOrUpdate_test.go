@@ -235,3 +235,79 @@ spec:
 		})
 	}
 }
+
+func TestIsApplicationStale(t *testing.T) {
+	appMeta := metav1.TypeMeta{
+		APIVersion: v1alpha1.ApplicationSchemaGroupVersionKind.GroupVersion().String(),
+		Kind:       v1alpha1.ApplicationSchemaGroupVersionKind.Kind,
+	}
+	newApp := func(path string, targetRevision string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			TypeMeta: appMeta,
+			Spec: v1alpha1.ApplicationSpec{
+				Source: &v1alpha1.ApplicationSource{RepoURL: "https://git.example.com/test-org/test-repo", Path: path, TargetRevision: targetRevision},
+			},
+		}
+	}
+	desired := newApp("guestbook", "main")
+
+	stale, err := IsApplicationStale(newApp("guestbook", "main"), desired, nil, normalizers.IgnoreNormalizerOpts{})
+	require.NoError(t, err)
+	assert.False(t, stale)
+
+	// a value preserved on the Application is drift from the template
+	stale, err = IsApplicationStale(newApp("guestbook", "v1.0.0"), desired, nil, normalizers.IgnoreNormalizerOpts{})
+	require.NoError(t, err)
+	assert.True(t, stale)
+
+	ignoreDifferences := v1alpha1.ApplicationSetIgnoreDifferences{{JSONPointers: []string{"/spec/source/targetRevision"}}}
+	stale, err = IsApplicationStale(newApp("guestbook", "v1.0.0"), desired, ignoreDifferences, normalizers.IgnoreNormalizerOpts{})
+	require.NoError(t, err)
+	assert.False(t, stale)
+
+	stale, err = IsApplicationStale(newApp("helm-guestbook", "v1.0.0"), desired, ignoreDifferences, normalizers.IgnoreNormalizerOpts{})
+	require.NoError(t, err)
+	assert.True(t, stale)
+}
+
+func Test_copyUnknownFields(t *testing.T) {
+	live := map[string]any{
+		"spec": map[string]any{
+			"project":    "default",
+			"newSetting": "enabled",
+			"sources": []any{
+				map[string]any{"repoURL": "https://git.example.com/a", "newSourceField": "a"},
+				map[string]any{"repoURL": "https://git.example.com/b", "newSourceField": "b"},
+			},
+		},
+	}
+	known := map[string]any{
+		"spec": map[string]any{
+			"project": "default",
+			"sources": []any{
+				map[string]any{"repoURL": "https://git.example.com/a"},
+				map[string]any{"repoURL": "https://git.example.com/b"},
+			},
+		},
+	}
+	desired := map[string]any{
+		"spec": map[string]any{
+			"project": "other",
+			"sources": []any{
+				map[string]any{"repoURL": "https://git.example.com/c"},
+			},
+		},
+	}
+
+	copyUnknownFields(live, known, desired)
+
+	assert.Equal(t, map[string]any{
+		"spec": map[string]any{
+			"project":    "other",
+			"newSetting": "enabled",
+			"sources": []any{
+				map[string]any{"repoURL": "https://git.example.com/c", "newSourceField": "a"},
+			},
+		},
+	}, desired)
+}
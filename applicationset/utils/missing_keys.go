@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"text/template/parse"
+)
+
+// MissingKeys records the parameters referenced by templates which are not provided by the rendered parameters
+type MissingKeys struct {
+	mutex sync.Mutex
+	keys  map[string]bool
+}
+
+func (m *MissingKeys) add(key string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.keys == nil {
+		m.keys = map[string]bool{}
+	}
+	m.keys[key] = true
+}
+
+// List returns the sorted missing keys, e.g. cluster.region for {{ .cluster.region }}
+func (m *MissingKeys) List() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	keys := make([]string, 0, len(m.keys))
+	for key := range m.keys {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// fillMissingKeys returns the given parameters with an empty string for each parameter the given Go template
+// references but which does not exist, and records the missing parameters. Only references evaluated against the
+// parameters are considered, i.e. not the ones evaluated against the dot within range and with actions.
+func (m *MissingKeys) fillMissingKeys(tree *parse.Tree, params map[string]any) map[string]any {
+	if tree == nil || tree.Root == nil {
+		return params
+	}
+	var paths [][]string
+	collectParamPaths(tree.Root, true, &paths)
+	// fill the longest paths first, so missing parents of nested parameters become maps rather than strings
+	slices.SortStableFunc(paths, func(a, b []string) int {
+		return len(b) - len(a)
+	})
+
+	filled := params
+	copied := false
+	for _, path := range paths {
+		if !isMissing(filled, path) {
+			continue
+		}
+		if !copied {
+			filled = copyParams(params)
+			copied = true
+		}
+		if setEmpty(filled, path) {
+			m.add(strings.Join(path, "."))
+		}
+	}
+	return filled
+}
+
+// collectParamPaths collects the paths of the fields referenced by the given node. Fields are only collected if the
+// dot is the parameters, which is not the case in the body of range and with actions. Fields of the $ variable always
+// refer to the parameters.
+func collectParamPaths(node parse.Node, dotIsParams bool, paths *[][]string) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectParamPaths(child, dotIsParams, paths)
+		}
+	case *parse.ActionNode:
+		collectParamPaths(n.Pipe, dotIsParams, paths)
+	case *parse.IfNode:
+		collectParamPaths(n.Pipe, dotIsParams, paths)
+		collectParamPaths(n.List, dotIsParams, paths)
+		collectParamPaths(n.ElseList, dotIsParams, paths)
+	case *parse.RangeNode:
+		collectParamPaths(n.Pipe, dotIsParams, paths)
+		collectParamPaths(n.List, false, paths)
+		collectParamPaths(n.ElseList, dotIsParams, paths)
+	case *parse.WithNode:
+		collectParamPaths(n.Pipe, dotIsParams, paths)
+		collectParamPaths(n.List, false, paths)
+		collectParamPaths(n.ElseList, dotIsParams, paths)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectParamPaths(cmd, dotIsParams, paths)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectParamPaths(arg, dotIsParams, paths)
+		}
+	case *parse.FieldNode:
+		if dotIsParams {
+			*paths = append(*paths, n.Ident)
+		}
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			*paths = append(*paths, n.Ident[1:])
+		}
+	}
+}
+
+// isMissing returns whether the parameter with the given path does not exist. Paths traversing a parameter which is not
+// a map are not considered missing, since they cannot be filled.
+func isMissing(params map[string]any, path []string) bool {
+	current := params
+	for i, key := range path {
+		value, ok := current[key]
+		if !ok {
+			return true
+		}
+		if i == len(path)-1 {
+			return false
+		}
+		if current, ok = value.(map[string]any); !ok {
+			return false
+		}
+	}
+	return false
+}
+
+// setEmpty sets the parameter with the given path to an empty string, creating the missing parent maps. It returns
+// false if a parent is not a map.
+func setEmpty(params map[string]any, path []string) bool {
+	current := params
+	for _, key := range path[:len(path)-1] {
+		value, ok := current[key]
+		if !ok {
+			value = map[string]any{}
+			current[key] = value
+		}
+		if current, ok = value.(map[string]any); !ok {
+			return false
+		}
+	}
+	current[path[len(path)-1]] = ""
+	return true
+}
+
+// copyParams returns a copy of the given parameters with copies of the nested maps, so they can be filled without
+// modifying the parameters of the generator
+func copyParams(params map[string]any) map[string]any {
+	copied := make(map[string]any, len(params))
+	for key, value := range params {
+		if nested, ok := value.(map[string]any); ok {
+			value = copyParams(nested)
+		}
+		copied[key] = value
+	}
+	return copied
+}
@@ -0,0 +1,159 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const specPathPrefix = "spec."
+
+// PreserveSpecPaths makes the desired Application spec match the live Application spec at the given paths. Paths are
+// dot-separated and relative to the Application (e.g. spec.source.targetRevision), numeric segments index into lists
+// (e.g. spec.sources.0.targetRevision). Fields which are not set in the live spec are removed from the desired spec, so
+// that a preserved field removed from the Application is not set again. Fields within lists are only kept, as list
+// elements cannot be removed by path.
+func PreserveSpecPaths(live *argoappsv1.ApplicationSpec, desired *argoappsv1.ApplicationSpec, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	liveObj, err := toJSONMap(live)
+	if err != nil {
+		return fmt.Errorf("error converting live application spec: %w", err)
+	}
+	desiredObj, err := toJSONMap(desired)
+	if err != nil {
+		return fmt.Errorf("error converting desired application spec: %w", err)
+	}
+
+	changed := false
+	for _, path := range paths {
+		if !strings.HasPrefix(path, specPathPrefix) {
+			return fmt.Errorf("invalid preserved path %q: path must start with %q", path, specPathPrefix)
+		}
+		fields := strings.Split(strings.TrimPrefix(path, specPathPrefix), ".")
+		value, found := getJSONPath(liveObj, fields)
+		if !found {
+			if removeJSONPath(desiredObj, fields) {
+				changed = true
+			}
+			continue
+		}
+		if err := setJSONPath(desiredObj, fields, value); err != nil {
+			return fmt.Errorf("error preserving path %q: %w", path, err)
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	data, err := json.Marshal(desiredObj)
+	if err != nil {
+		return fmt.Errorf("error marshaling application spec: %w", err)
+	}
+	var spec argoappsv1.ApplicationSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return fmt.Errorf("error unmarshaling application spec: %w", err)
+	}
+	*desired = spec
+	return nil
+}
+
+func toJSONMap(spec *argoappsv1.ApplicationSpec) (map[string]any, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	obj := map[string]any{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func getJSONPath(obj any, fields []string) (any, bool) {
+	current := obj
+	for _, field := range fields {
+		switch typed := current.(type) {
+		case map[string]any:
+			value, ok := typed[field]
+			if !ok {
+				return nil, false
+			}
+			current = value
+		case []any:
+			index, err := strconv.Atoi(field)
+			if err != nil || index < 0 || index >= len(typed) {
+				return nil, false
+			}
+			current = typed[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func setJSONPath(obj map[string]any, fields []string, value any) error {
+	var current any = obj
+	for i, field := range fields {
+		last := i == len(fields)-1
+		switch typed := current.(type) {
+		case map[string]any:
+			if last {
+				typed[field] = value
+				return nil
+			}
+			next, ok := typed[field]
+			if !ok || next == nil {
+				// the field does not exist in the desired spec, create it as a map unless the next segment is a list index
+				if _, err := strconv.Atoi(fields[i+1]); err == nil {
+					return fmt.Errorf("list %q does not exist in the desired spec", field)
+				}
+				next = map[string]any{}
+				typed[field] = next
+			}
+			current = next
+		case []any:
+			index, err := strconv.Atoi(field)
+			if err != nil {
+				return fmt.Errorf("%q is not a valid list index", field)
+			}
+			if index < 0 || index >= len(typed) {
+				return fmt.Errorf("list index %d is out of range in the desired spec", index)
+			}
+			if last {
+				typed[index] = value
+				return nil
+			}
+			current = typed[index]
+		default:
+			return errors.New("cannot set a field on a non-object value")
+		}
+	}
+	return nil
+}
+
+// removeJSONPath removes the field at the given path of the object, and returns true if it was removed. Only paths
+// made of object fields are removed, the paths indexing into lists are left untouched.
+func removeJSONPath(obj map[string]any, fields []string) bool {
+	current := obj
+	for _, field := range fields[:len(fields)-1] {
+		next, ok := current[field].(map[string]any)
+		if !ok {
+			return false
+		}
+		current = next
+	}
+	last := fields[len(fields)-1]
+	if _, ok := current[last]; !ok {
+		return false
+	}
+	delete(current, last)
+	return true
+}
@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestPreserveSpecPaths(t *testing.T) {
+	testCases := []struct {
+		name          string
+		live          argoappsv1.ApplicationSpec
+		desired       argoappsv1.ApplicationSpec
+		paths         []string
+		expected      argoappsv1.ApplicationSpec
+		expectedError string
+	}{
+		{
+			name:     "no paths",
+			live:     argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{RepoURL: "https://a", TargetRevision: "hotfix"}},
+			desired:  argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{RepoURL: "https://a", TargetRevision: "main"}},
+			expected: argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{RepoURL: "https://a", TargetRevision: "main"}},
+		},
+		{
+			name:     "live value is preserved",
+			live:     argoappsv1.ApplicationSpec{Project: "default", Source: &argoappsv1.ApplicationSource{RepoURL: "https://a", TargetRevision: "hotfix"}},
+			desired:  argoappsv1.ApplicationSpec{Project: "other", Source: &argoappsv1.ApplicationSource{RepoURL: "https://b", TargetRevision: "main"}},
+			paths:    []string{"spec.source.targetRevision"},
+			expected: argoappsv1.ApplicationSpec{Project: "other", Source: &argoappsv1.ApplicationSource{RepoURL: "https://b", TargetRevision: "hotfix"}},
+		},
+		{
+			name:     "field unset in live spec is removed from the desired spec",
+			live:     argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{RepoURL: "https://a"}},
+			desired:  argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{RepoURL: "https://a", TargetRevision: "main"}},
+			paths:    []string{"spec.source.targetRevision"},
+			expected: argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{RepoURL: "https://a"}},
+		},
+		{
+			name: "object unset in live spec is removed from the desired spec",
+			live: argoappsv1.ApplicationSpec{Project: "default"},
+			desired: argoappsv1.ApplicationSpec{Project: "default", SyncPolicy: &argoappsv1.SyncPolicy{
+				Automated: &argoappsv1.SyncPolicyAutomated{Prune: true},
+			}},
+			paths:    []string{"spec.syncPolicy"},
+			expected: argoappsv1.ApplicationSpec{Project: "default"},
+		},
+		{
+			name: "list element unset in live spec is left untouched",
+			live: argoappsv1.ApplicationSpec{Sources: argoappsv1.ApplicationSources{
+				{RepoURL: "https://a", TargetRevision: "main"},
+			}},
+			desired: argoappsv1.ApplicationSpec{Sources: argoappsv1.ApplicationSources{
+				{RepoURL: "https://a", TargetRevision: "main"},
+				{RepoURL: "https://b", TargetRevision: "v1.1.0"},
+			}},
+			paths: []string{"spec.sources.1.targetRevision"},
+			expected: argoappsv1.ApplicationSpec{Sources: argoappsv1.ApplicationSources{
+				{RepoURL: "https://a", TargetRevision: "main"},
+				{RepoURL: "https://b", TargetRevision: "v1.1.0"},
+			}},
+		},
+		{
+			name: "list index",
+			live: argoappsv1.ApplicationSpec{Sources: argoappsv1.ApplicationSources{
+				{RepoURL: "https://a", TargetRevision: "main"},
+				{RepoURL: "https://b", TargetRevision: "v1.0.1-hotfix"},
+			}},
+			desired: argoappsv1.ApplicationSpec{Sources: argoappsv1.ApplicationSources{
+				{RepoURL: "https://a", TargetRevision: "main"},
+				{RepoURL: "https://b", TargetRevision: "v1.1.0"},
+			}},
+			paths: []string{"spec.sources.1.targetRevision"},
+			expected: argoappsv1.ApplicationSpec{Sources: argoappsv1.ApplicationSources{
+				{RepoURL: "https://a", TargetRevision: "main"},
+				{RepoURL: "https://b", TargetRevision: "v1.0.1-hotfix"},
+			}},
+		},
+		{
+			name:     "nested object is created in the desired spec",
+			live:     argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{RepoURL: "https://a", Helm: &argoappsv1.ApplicationSourceHelm{ReleaseName: "pinned"}}},
+			desired:  argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{RepoURL: "https://a"}},
+			paths:    []string{"spec.source.helm.releaseName"},
+			expected: argoappsv1.ApplicationSpec{Source: &argoappsv1.ApplicationSource{RepoURL: "https://a", Helm: &argoappsv1.ApplicationSourceHelm{ReleaseName: "pinned"}}},
+		},
+		{
+			name:          "path outside of spec",
+			live:          argoappsv1.ApplicationSpec{},
+			desired:       argoappsv1.ApplicationSpec{},
+			paths:         []string{"metadata.labels"},
+			expectedError: `invalid preserved path "metadata.labels"`,
+		},
+		{
+			name: "list index out of range in desired spec",
+			live: argoappsv1.ApplicationSpec{Sources: argoappsv1.ApplicationSources{
+				{RepoURL: "https://a", TargetRevision: "main"},
+				{RepoURL: "https://b", TargetRevision: "hotfix"},
+			}},
+			desired: argoappsv1.ApplicationSpec{Sources: argoappsv1.ApplicationSources{
+				{RepoURL: "https://a", TargetRevision: "main"},
+			}},
+			paths:         []string{"spec.sources.1.targetRevision"},
+			expectedError: "list index 1 is out of range",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			desired := tc.desired.DeepCopy()
+			err := PreserveSpecPaths(&tc.live, desired, tc.paths)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, *desired)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// ShardingMethodNamespace assigns the ApplicationSets to the shards by the hash of their namespace
+	ShardingMethodNamespace = "namespace"
+	// ShardingMethodLabel assigns the ApplicationSets to the shards by their argocd.argoproj.io/applicationset-shard
+	// label. The ApplicationSets without a valid label are assigned to the first shard.
+	ShardingMethodLabel = "label"
+)
+
+// ApplicationSetSharding assigns the ApplicationSets to the shards of the ApplicationSet controller, so that several
+// replicas of the controller reconcile distinct ApplicationSets
+type ApplicationSetSharding struct {
+	// Shards is the number of shards
+	Shards int
+	// Shard is the shard of this replica of the controller, starting at 0
+	Shard int
+	// Method is the method assigning the ApplicationSets to the shards
+	Method string
+}
+
+// NewApplicationSetSharding returns the sharding of the given shard, or nil if the ApplicationSets are not sharded
+func NewApplicationSetSharding(shards, shard int, method string) (*ApplicationSetSharding, error) {
+	if method != ShardingMethodNamespace && method != ShardingMethodLabel {
+		return nil, fmt.Errorf("unknown sharding method %q, must be one of: %s, %s", method, ShardingMethodNamespace, ShardingMethodLabel)
+	}
+	if shards < 1 {
+		return nil, fmt.Errorf("invalid number of shards %d, must be at least 1", shards)
+	}
+	if shard < 0 || shard >= shards {
+		return nil, fmt.Errorf("invalid shard %d, must be between 0 and %d", shard, shards-1)
+	}
+	if shards == 1 {
+		return nil, nil
+	}
+	return &ApplicationSetSharding{Shards: shards, Shard: shard, Method: method}, nil
+}
+
+// GetShard returns the shard of the given ApplicationSet
+func (s *ApplicationSetSharding) GetShard(appset *argoappsv1.ApplicationSet) int {
+	if s.Method == ShardingMethodLabel {
+		value, ok := appset.Labels[common.LabelKeyApplicationSetShard]
+		if !ok {
+			return 0
+		}
+		shard, err := strconv.Atoi(value)
+		if err != nil || shard < 0 || shard >= s.Shards {
+			log.WithField("applicationset", appset.Namespace+"/"+appset.Name).
+				Warnf("invalid %s label %q, must be a shard between 0 and %d, using shard 0", common.LabelKeyApplicationSetShard, value, s.Shards-1)
+			return 0
+		}
+		return shard
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(appset.Namespace))
+	return int(h.Sum32() % uint32(s.Shards))
+}
+
+// IsInShard returns whether the given ApplicationSet is assigned to the shard of this replica of the controller. All the
+// ApplicationSets are assigned to it if the ApplicationSets are not sharded.
+func (s *ApplicationSetSharding) IsInShard(appset *argoappsv1.ApplicationSet) bool {
+	return s == nil || s.GetShard(appset) == s.Shard
+}
@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argoappsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestNewApplicationSetSharding(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		shards      int
+		shard       int
+		method      string
+		expected    *ApplicationSetSharding
+		expectedErr string
+	}{
+		{
+			name:     "no sharding with a single shard",
+			shards:   1,
+			shard:    0,
+			method:   ShardingMethodNamespace,
+			expected: nil,
+		},
+		{
+			name:     "sharding by namespace",
+			shards:   3,
+			shard:    2,
+			method:   ShardingMethodNamespace,
+			expected: &ApplicationSetSharding{Shards: 3, Shard: 2, Method: ShardingMethodNamespace},
+		},
+		{
+			name:     "sharding by label",
+			shards:   2,
+			shard:    0,
+			method:   ShardingMethodLabel,
+			expected: &ApplicationSetSharding{Shards: 2, Shard: 0, Method: ShardingMethodLabel},
+		},
+		{
+			name:        "unknown method",
+			shards:      2,
+			shard:       0,
+			method:      "name",
+			expectedErr: `unknown sharding method "name"`,
+		},
+		{
+			name:        "no shards",
+			shards:      0,
+			shard:       0,
+			method:      ShardingMethodNamespace,
+			expectedErr: "invalid number of shards 0",
+		},
+		{
+			name:        "shard out of range",
+			shards:      2,
+			shard:       2,
+			method:      ShardingMethodNamespace,
+			expectedErr: "invalid shard 2, must be between 0 and 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sharding, err := NewApplicationSetSharding(tc.shards, tc.shard, tc.method)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, sharding)
+		})
+	}
+}
+
+func TestApplicationSetShardingGetShard(t *testing.T) {
+	t.Parallel()
+
+	appset := func(namespace string, labels map[string]string) *argoappsv1.ApplicationSet {
+		return &argoappsv1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "appset", Namespace: namespace, Labels: labels}}
+	}
+
+	t.Run("by namespace", func(t *testing.T) {
+		t.Parallel()
+
+		sharding := &ApplicationSetSharding{Shards: 3, Method: ShardingMethodNamespace}
+		shards := map[int]bool{}
+		for _, namespace := range []string{"argocd", "team-a", "team-b", "team-c", "team-d", "team-e"} {
+			shard := sharding.GetShard(appset(namespace, nil))
+			assert.GreaterOrEqual(t, shard, 0)
+			assert.Less(t, shard, 3)
+			// the ApplicationSets of a namespace are always assigned to the same shard
+			assert.Equal(t, shard, sharding.GetShard(appset(namespace, map[string]string{common.LabelKeyApplicationSetShard: "2"})))
+			shards[shard] = true
+		}
+		assert.Greater(t, len(shards), 1)
+	})
+
+	t.Run("by label", func(t *testing.T) {
+		t.Parallel()
+
+		sharding := &ApplicationSetSharding{Shards: 3, Method: ShardingMethodLabel}
+		assert.Equal(t, 2, sharding.GetShard(appset("argocd", map[string]string{common.LabelKeyApplicationSetShard: "2"})))
+		assert.Equal(t, 0, sharding.GetShard(appset("argocd", nil)))
+		assert.Equal(t, 0, sharding.GetShard(appset("argocd", map[string]string{common.LabelKeyApplicationSetShard: "3"})))
+		assert.Equal(t, 0, sharding.GetShard(appset("argocd", map[string]string{common.LabelKeyApplicationSetShard: "one"})))
+	})
+}
+
+func TestApplicationSetShardingIsInShard(t *testing.T) {
+	t.Parallel()
+
+	appset := &argoappsv1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{
+		Name:      "appset",
+		Namespace: "argocd",
+		Labels:    map[string]string{common.LabelKeyApplicationSetShard: "1"},
+	}}
+
+	var noSharding *ApplicationSetSharding
+	assert.True(t, noSharding.IsInShard(appset))
+	assert.True(t, (&ApplicationSetSharding{Shards: 2, Shard: 1, Method: ShardingMethodLabel}).IsInShard(appset))
+	assert.False(t, (&ApplicationSetSharding{Shards: 2, Shard: 0, Method: ShardingMethodLabel}).IsInShard(appset))
+}
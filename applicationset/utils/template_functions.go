@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"regexp"
 	"strings"
 
@@ -24,6 +26,55 @@ func SanitizeName(name string) string {
 	return strings.Trim(name, "-.")
 }
 
+const (
+	// maxDNS1123LabelLength is the maximum length of a DNS-1123 label, e.g. of a label value or a namespace
+	maxDNS1123LabelLength = 63
+	// hashSuffixLength is the length of the hash appended by hashSuffix, without the separating hyphen
+	hashSuffixLength = 8
+)
+
+var (
+	invalidDNS1123LabelChars = regexp.MustCompile("[^-a-z0-9]+")
+	repeatedHyphens          = regexp.MustCompile("-{2,}")
+)
+
+// DNS1123 sanitizes the name into a DNS-1123 label, which is valid as an Application name and as a label value:
+// invalid characters are replaced by hyphens and names longer than 63 characters are shortened using HashSuffix
+func DNS1123(name string) string {
+	name = invalidDNS1123LabelChars.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.Trim(repeatedHyphens.ReplaceAllString(name, "-"), "-")
+	return HashSuffix(maxDNS1123LabelLength, name)
+}
+
+// ShortName truncates the name to at most maxLength characters, trimming trailing hyphens and dots so the truncated
+// name stays a valid DNS name. Names which only differ after maxLength characters are truncated to the same name, use
+// HashSuffix to keep them unique.
+func ShortName(maxLength int, name string) string {
+	if maxLength < 0 || len(name) <= maxLength {
+		return name
+	}
+	return strings.TrimRight(name[:maxLength], "-.")
+}
+
+// HashSuffix shortens names longer than maxLength characters by truncating them and appending a hyphen and a hash of
+// the whole name, so different names stay unique after truncation. Names which are not longer than maxLength are
+// returned unchanged.
+func HashSuffix(maxLength int, name string) string {
+	if maxLength < 0 || len(name) <= maxLength {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	hash := hex.EncodeToString(sum[:])[:hashSuffixLength]
+	if maxLength <= hashSuffixLength+1 {
+		return hash[:min(maxLength, hashSuffixLength)]
+	}
+	prefix := strings.TrimRight(name[:maxLength-hashSuffixLength-1], "-.")
+	if prefix == "" {
+		return hash
+	}
+	return prefix + "-" + hash
+}
+
 // This has been copied from helm and may be removed as soon as it is retrofited in sprig
 // toYAML takes an interface, marshals it to yaml, and returns a string. It will
 // always return a string, even on marshal error (empty string).
@@ -41,6 +41,9 @@ func init() {
 	delete(sprigFuncMap, "getHostByName")
 	sprigFuncMap["normalize"] = SanitizeName
 	sprigFuncMap["slugify"] = SlugifyName
+	sprigFuncMap["dns1123"] = DNS1123
+	sprigFuncMap["shortName"] = ShortName
+	sprigFuncMap["hashSuffix"] = HashSuffix
 	sprigFuncMap["toYaml"] = toYAML
 	sprigFuncMap["fromYaml"] = fromYAML
 	sprigFuncMap["fromYamlArray"] = fromYAMLArray
@@ -55,7 +58,11 @@ type Renderer interface {
 	Replace(tmpl string, replaceMap map[string]any, useGoTemplate bool, goTemplateOptions []string) (string, error)
 }
 
-type Render struct{}
+type Render struct {
+	// MissingKeys, if set, tolerates references of the templates to parameters which do not exist: they are rendered
+	// as empty strings and recorded instead of failing or being left unrendered.
+	MissingKeys *MissingKeys
+}
 
 func IsNamespaceAllowed(namespaces []string, namespace string) bool {
 	return glob.MatchStringInList(namespaces, namespace, glob.REGEXP)
@@ -330,6 +337,10 @@ func (r *Render) Replace(tmpl string, replaceMap map[string]any, useGoTemplate b
 			return "", fmt.Errorf("failed to parse template %s: %w", tmpl, err)
 		}
 
+		if r.MissingKeys != nil {
+			replaceMap = r.MissingKeys.fillMissingKeys(parsed.Tree, replaceMap)
+		}
+
 		var replacedTmplBuffer bytes.Buffer
 		if err = parsed.Execute(&replacedTmplBuffer, replaceMap); err != nil {
 			return "", fmt.Errorf("failed to execute go template %s: %w", tmpl, err)
@@ -348,7 +359,12 @@ func (r *Render) Replace(tmpl string, replaceMap map[string]any, useGoTemplate b
 	}
 	replacedTmpl := fstTmpl.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
 		trimmedTag := strings.TrimSpace(tag)
-		replacement, ok := replaceMap[trimmedTag].(string)
+		value, exists := replaceMap[trimmedTag]
+		if trimmedTag != "" && !exists && r.MissingKeys != nil {
+			r.MissingKeys.add(trimmedTag)
+			return 0, nil
+		}
+		replacement, ok := value.(string)
 		if trimmedTag == "" || !ok {
 			return fmt.Fprintf(w, "{{%s}}", tag)
 		}
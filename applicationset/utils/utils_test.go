@@ -569,6 +569,22 @@ func TestRenderTemplateParamsGoTemplate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "hashSuffix",
+			fieldVal:    `{{ .name | hashSuffix 20 }}`,
+			expectedVal: "my-really-l-859c79ce",
+			params: map[string]any{
+				"name": "my-really-long-application-name",
+			},
+		},
+		{
+			name:        "dns1123",
+			fieldVal:    `{{ dns1123 .branch }}`,
+			expectedVal: "feature-my-branch-name",
+			params: map[string]any{
+				"branch": "Feature/My_Branch--Name.",
+			},
+		},
 		{
 			name:        "fromYaml",
 			fieldVal:    `{{ get (fromYaml .value) "hello" }}`,
@@ -720,6 +736,46 @@ func Test_Render_Replace_no_panic_on_missing_closing_brace(t *testing.T) {
 	})
 }
 
+func TestRenderMissingKeys(t *testing.T) {
+	params := map[string]any{
+		"name":    "guestbook",
+		"cluster": map[string]any{"name": "in-cluster"},
+		"labels":  []any{"a", "b"},
+	}
+
+	t.Run("gotemplate", func(t *testing.T) {
+		missingKeys := &MissingKeys{}
+		r := &Render{MissingKeys: missingKeys}
+		tmpl := `{{ .name }}-{{ .cluster.name }}-{{ .cluster.region }}-{{ .env.tier }}-{{ default "none" .owner }}` +
+			`{{ range .labels }}{{ . }}{{ end }}{{ $.path }}`
+
+		replaced, err := r.Replace(tmpl, params, true, []string{"missingkey=error"})
+		require.NoError(t, err)
+		assert.Equal(t, "guestbook-in-cluster---noneab", replaced)
+		assert.Equal(t, []string{"cluster.region", "env.tier", "owner", "path"}, missingKeys.List())
+		assert.Equal(t, map[string]any{"name": "in-cluster"}, params["cluster"], "the parameters must not be modified")
+		assert.NotContains(t, params, "env")
+	})
+	t.Run("gotemplate without missing keys", func(t *testing.T) {
+		_, err := (&Render{}).Replace("{{ .cluster.region }}", params, true, []string{"missingkey=error"})
+		require.ErrorContains(t, err, `map has no entry for key "region"`)
+	})
+	t.Run("fasttemplate", func(t *testing.T) {
+		missingKeys := &MissingKeys{}
+		r := &Render{MissingKeys: missingKeys}
+
+		replaced, err := r.Replace("{{name}}-{{ region }}", params, false, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "guestbook-", replaced)
+		assert.Equal(t, []string{"region"}, missingKeys.List())
+	})
+	t.Run("fasttemplate without missing keys", func(t *testing.T) {
+		replaced, err := (&Render{}).Replace("{{name}}-{{ region }}", params, false, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "guestbook-{{ region }}", replaced)
+	})
+}
+
 func TestRenderTemplateParamsFinalizers(t *testing.T) {
 	emptyApplication := &argoappsv1.Application{
 		Spec: argoappsv1.ApplicationSpec{
@@ -1259,6 +1315,42 @@ func TestSlugify(t *testing.T) {
 	}
 }
 
+func TestDNS1123(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		expected string
+	}{
+		{name: "my-app", expected: "my-app"},
+		{name: "Feature/My_Branch--Name.", expected: "feature-my-branch-name"},
+		{name: "--my.app--", expected: "my-app"},
+		{name: "feature/a-very-long-branch-name-that-exceeds-the-limit-of-sixty-three-characters", expected: "feature-a-very-long-branch-name-that-exceeds-the-limit-14b34189"},
+	} {
+		result := DNS1123(c.name)
+		assert.Equal(t, c.expected, result, c.name)
+		assert.LessOrEqual(t, len(result), 63, c.name)
+	}
+}
+
+func TestShortName(t *testing.T) {
+	assert.Equal(t, "my-app", ShortName(10, "my-app"))
+	assert.Equal(t, "my-app", ShortName(7, "my-app-name"), "trailing hyphens must be trimmed")
+	assert.Equal(t, "my", ShortName(3, "my.app"), "trailing dots must be trimmed")
+	assert.Equal(t, "my-app-name", ShortName(-1, "my-app-name"))
+}
+
+func TestHashSuffix(t *testing.T) {
+	assert.Equal(t, "my-app", HashSuffix(20, "my-app"), "names within the limit must not be changed")
+
+	name := HashSuffix(20, "my-really-long-application-name")
+	other := HashSuffix(20, "my-really-long-application-other")
+	assert.Equal(t, "my-really-l-859c79ce", name)
+	assert.Equal(t, "my-really-l-9d2b734d", other)
+	assert.Equal(t, name, HashSuffix(20, "my-really-long-application-name"), "the suffix must be deterministic")
+
+	assert.Equal(t, "9c56c", HashSuffix(5, "abcdefgh"), "the hash must be truncated if there is no room for a prefix")
+	assert.Equal(t, "1772a413", HashSuffix(10, "----------------"), "the separator must be omitted if the prefix is empty")
+}
+
 func TestGetTLSConfig(t *testing.T) {
 	temppath := t.TempDir()
 	certFromFile := `
@@ -2,8 +2,13 @@ package webhook
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"html"
+	"io"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -32,16 +37,48 @@ import (
 
 const payloadQueueSize = 50000
 
+const (
+	// PluginSignatureHeader is the header holding the HMAC-SHA256 signature of the payloads of the Plugin generator
+	// webhook, keyed by the token of the plugin, in the form sha256=<hex digest>
+	PluginSignatureHeader = "X-Argocd-Plugin-Signature"
+	// maxPluginPayloadSize is the maximum size of the payloads of the Plugin generator webhook
+	maxPluginPayloadSize = 1024 * 1024
+)
+
 const panicMsgAppSet = "panic while processing applicationset-controller webhook event"
 
+const (
+	providerGitHub      = "github"
+	providerGitLab      = "gitlab"
+	providerAzureDevOps = "azuredevops"
+)
+
 type WebhookHandler struct {
 	sync.WaitGroup // for testing
-	github         *github.Webhook
-	gitlab         *gitlab.Webhook
-	azuredevops    *azuredevops.Webhook
-	client         client.Client
-	generators     map[string]generators.Generator
-	queue          chan any
+	settingsMgr    *argosettings.SettingsManager
+	// secretsMutex guards the parsers, which are created again once unresolved webhook secrets are resolved
+	secretsMutex      sync.RWMutex
+	github            *github.Webhook
+	gitlab            *gitlab.Webhook
+	azuredevops       *azuredevops.Webhook
+	unresolvedSecrets map[string]bool
+	client            client.Client
+	generators        map[string]generators.Generator
+	queue             chan any
+}
+
+// pluginTokenGetter is implemented by the Plugin generator to authenticate the payloads of its webhook
+type pluginTokenGetter interface {
+	GetPluginToken(ctx context.Context, configMapName string) (string, error)
+}
+
+// pluginRefreshPayload is the payload of the webhook called by a plugin to refresh the ApplicationSets which use it
+type pluginRefreshPayload struct {
+	// ConfigMapRef is the name of the ConfigMap configuring the plugin
+	ConfigMapRef string `json:"configMapRef"`
+	// ApplicationSets restricts the refresh to the ApplicationSets of the given names, which may be qualified with
+	// their namespace as namespace/name. All the ApplicationSets using the plugin are refreshed if empty.
+	ApplicationSets []string `json:"applicationSets,omitempty"`
 }
 
 type gitGeneratorInfo struct {
@@ -54,6 +91,24 @@ type prGeneratorInfo struct {
 	Azuredevops *prGeneratorAzuredevopsInfo
 	Github      *prGeneratorGithubInfo
 	Gitlab      *prGeneratorGitlabInfo
+	// Number of the pull request
+	Number int64
+	// Closed is whether the pull request was closed or merged
+	Closed bool
+	// Comment is the comment added to the pull request, if the event is a comment event
+	Comment *string
+}
+
+// commandRepository returns the repository of the pull request as identified in the keys of the
+// AnnotationApplicationSetPullRequestCommands annotation, see generators.PullRequestCommandRepository
+func (info *prGeneratorInfo) commandRepository() string {
+	switch {
+	case info.Github != nil:
+		return info.Github.Owner + "/" + info.Github.Repo
+	case info.Gitlab != nil:
+		return info.Gitlab.Project
+	}
+	return ""
 }
 
 type prGeneratorAzuredevopsInfo struct {
@@ -78,31 +133,71 @@ func NewWebhookHandler(webhookParallelism int, argocdSettingsMgr *argosettings.S
 	if err != nil {
 		return nil, fmt.Errorf("failed to get argocd settings: %w", err)
 	}
+	webhookHandler := &WebhookHandler{
+		settingsMgr: argocdSettingsMgr,
+		client:      client,
+		generators:  generators,
+		queue:       make(chan any, payloadQueueSize),
+	}
+	if err := webhookHandler.setSecrets(argocdSettings); err != nil {
+		return nil, err
+	}
+
+	webhookHandler.startWorkerPool(webhookParallelism)
+
+	return webhookHandler, nil
+}
+
+// setSecrets creates the parsers verifying the payloads with the webhook secrets of the given settings
+func (h *WebhookHandler) setSecrets(argocdSettings *argosettings.ArgoCDSettings) error {
 	githubHandler, err := github.New(github.Options.Secret(argocdSettings.GetWebhookGitHubSecret()))
 	if err != nil {
-		return nil, fmt.Errorf("unable to init GitHub webhook: %w", err)
+		return fmt.Errorf("unable to init GitHub webhook: %w", err)
 	}
 	gitlabHandler, err := gitlab.New(gitlab.Options.Secret(argocdSettings.GetWebhookGitLabSecret()))
 	if err != nil {
-		return nil, fmt.Errorf("unable to init GitLab webhook: %w", err)
+		return fmt.Errorf("unable to init GitLab webhook: %w", err)
 	}
 	azuredevopsHandler, err := azuredevops.New(azuredevops.Options.BasicAuth(argocdSettings.GetWebhookAzureDevOpsUsername(), argocdSettings.GetWebhookAzureDevOpsPassword()))
 	if err != nil {
-		return nil, fmt.Errorf("unable to init Azure DevOps webhook: %w", err)
+		return fmt.Errorf("unable to init Azure DevOps webhook: %w", err)
 	}
-
-	webhookHandler := &WebhookHandler{
-		github:      githubHandler,
-		gitlab:      gitlabHandler,
-		azuredevops: azuredevopsHandler,
-		client:      client,
-		generators:  generators,
-		queue:       make(chan any, payloadQueueSize),
+	unresolved := map[string]bool{
+		providerGitHub:      argocdSettings.HasUnresolvedSecretReference(argocdSettings.WebhookGitHubSecret),
+		providerGitLab:      argocdSettings.HasUnresolvedSecretReference(argocdSettings.WebhookGitLabSecret),
+		providerAzureDevOps: argocdSettings.HasUnresolvedSecretReference(argocdSettings.WebhookAzureDevOpsUsername, argocdSettings.WebhookAzureDevOpsPassword),
 	}
 
-	webhookHandler.startWorkerPool(webhookParallelism)
+	h.secretsMutex.Lock()
+	defer h.secretsMutex.Unlock()
+	h.github = githubHandler
+	h.gitlab = gitlabHandler
+	h.azuredevops = azuredevopsHandler
+	h.unresolvedSecrets = unresolved
+	return nil
+}
 
-	return webhookHandler, nil
+// secretResolved returns whether the webhook secret of the given provider is available. If it references a secret
+// of an external secret manager which was not resolved when the parsers were created, the secrets are loaded again.
+func (h *WebhookHandler) secretResolved(provider string) bool {
+	h.secretsMutex.RLock()
+	unresolved := h.unresolvedSecrets[provider]
+	h.secretsMutex.RUnlock()
+	if !unresolved {
+		return true
+	}
+	argocdSettings, err := h.settingsMgr.GetSettings()
+	if err != nil {
+		log.Warnf("Failed to get argocd settings: %v", err)
+		return false
+	}
+	if err := h.setSecrets(argocdSettings); err != nil {
+		log.Warnf("Failed to load webhook secrets: %v", err)
+		return false
+	}
+	h.secretsMutex.RLock()
+	defer h.secretsMutex.RUnlock()
+	return !h.unresolvedSecrets[provider]
 }
 
 func (h *WebhookHandler) startWorkerPool(webhookParallelism int) {
@@ -123,6 +218,11 @@ func (h *WebhookHandler) startWorkerPool(webhookParallelism int) {
 }
 
 func (h *WebhookHandler) HandleEvent(payload any) {
+	if pluginPayload, ok := payload.(*pluginRefreshPayload); ok {
+		h.handlePluginRefresh(pluginPayload)
+		return
+	}
+
 	gitGenInfo := getGitGeneratorInfo(payload)
 	prGenInfo := getPRGeneratorInfo(payload)
 	if gitGenInfo == nil && prGenInfo == nil {
@@ -137,6 +237,11 @@ func (h *WebhookHandler) HandleEvent(payload any) {
 	}
 
 	for _, appSet := range appSetList.Items {
+		if prGenInfo != nil && prGenInfo.Comment != nil {
+			h.handlePullRequestComment(&appSet, prGenInfo)
+			continue
+		}
+
 		shouldRefresh := false
 		for _, gen := range appSet.Spec.Generators {
 			// check if the ApplicationSet uses any generator that is relevant to the payload
@@ -150,7 +255,13 @@ func (h *WebhookHandler) HandleEvent(payload any) {
 			}
 		}
 		if shouldRefresh {
-			err := refreshApplicationSet(h.client, &appSet)
+			err := patchApplicationSet(h.client, &appSet, func(annotations map[string]string) {
+				annotations[common.AnnotationApplicationSetRefresh] = "true"
+				if prGenInfo != nil && prGenInfo.Closed {
+					// the pull request has to be commented again if it is reopened
+					removePullRequestCommand(annotations, generators.PullRequestCommandKey(prGenInfo.commandRepository(), prGenInfo.Number))
+				}
+			})
 			if err != nil {
 				log.Errorf("Failed to refresh ApplicationSet '%s' for controller reprocessing", appSet.Name)
 				continue
@@ -164,18 +275,37 @@ func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 	var payload any
 	var err error
 
+	var provider string
 	switch {
 	case r.Header.Get("X-GitHub-Event") != "":
-		payload, err = h.github.Parse(r, github.PushEvent, github.PullRequestEvent, github.PingEvent)
+		provider = providerGitHub
 	case r.Header.Get("X-Gitlab-Event") != "":
-		payload, err = h.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.MergeRequestEvents, gitlab.SystemHookEvents)
+		provider = providerGitLab
 	case r.Header.Get("X-Vss-Activityid") != "":
-		payload, err = h.azuredevops.Parse(r, azuredevops.GitPushEventType, azuredevops.GitPullRequestCreatedEventType, azuredevops.GitPullRequestUpdatedEventType, azuredevops.GitPullRequestMergedEventType)
+		provider = providerAzureDevOps
 	default:
 		log.Debug("Ignoring unknown webhook event")
 		http.Error(w, "Unknown webhook event", http.StatusBadRequest)
 		return
 	}
+	// requests cannot be verified while the webhook secret references an unresolved secret of an external secret manager
+	if !h.secretResolved(provider) {
+		log.Warnf("Webhook secret of %s references a secret of an external secret manager which has not been resolved yet, rejecting webhook event", provider)
+		http.Error(w, "Webhook secret is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	h.secretsMutex.RLock()
+	githubHandler, gitlabHandler, azuredevopsHandler := h.github, h.gitlab, h.azuredevops
+	h.secretsMutex.RUnlock()
+	switch provider {
+	case providerGitHub:
+		payload, err = githubHandler.Parse(r, github.PushEvent, github.PullRequestEvent, github.IssueCommentEvent, github.PingEvent)
+	case providerGitLab:
+		payload, err = gitlabHandler.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.MergeRequestEvents, gitlab.CommentEvents, gitlab.SystemHookEvents)
+	case providerAzureDevOps:
+		payload, err = azuredevopsHandler.Parse(r, azuredevops.GitPushEventType, azuredevops.GitPullRequestCreatedEventType, azuredevops.GitPullRequestUpdatedEventType, azuredevops.GitPullRequestMergedEventType)
+	}
 
 	if err != nil {
 		log.Infof("Webhook processing failed: %s", err)
@@ -195,6 +325,94 @@ func (h *WebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// PluginHandler handles the webhook called by a plugin to refresh the ApplicationSets which use it, rather than waiting
+// for the requeue of the Plugin generators. The payload is authenticated by its HMAC-SHA256 signature, keyed by the
+// token of the plugin.
+func (h *WebhookHandler) PluginHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Webhook processing failed: method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPluginPayloadSize))
+	if err != nil {
+		http.Error(w, "Webhook processing failed: unable to read the payload", http.StatusBadRequest)
+		return
+	}
+	payload := &pluginRefreshPayload{}
+	if err := json.Unmarshal(body, payload); err != nil || payload.ConfigMapRef == "" {
+		http.Error(w, "Webhook processing failed: invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	tokenGetter, ok := h.generators["Plugin"].(pluginTokenGetter)
+	if !ok {
+		http.Error(w, "Webhook processing failed: the Plugin generator is not available", http.StatusNotFound)
+		return
+	}
+	token, err := tokenGetter.GetPluginToken(r.Context(), payload.ConfigMapRef)
+	if err != nil {
+		log.Infof("Plugin webhook processing failed: unable to get the token of plugin %q: %v", payload.ConfigMapRef, err)
+		http.Error(w, "Webhook processing failed: unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if !isValidPluginSignature(body, token, r.Header.Get(PluginSignatureHeader)) {
+		log.Infof("Plugin webhook processing failed: invalid signature for plugin %q", payload.ConfigMapRef)
+		http.Error(w, "Webhook processing failed: unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	select {
+	case h.queue <- payload:
+	default:
+		log.Info("Queue is full, discarding webhook payload")
+		http.Error(w, "Queue is full, discarding webhook payload", http.StatusServiceUnavailable)
+	}
+}
+
+// isValidPluginSignature returns whether the signature, in the form sha256=<hex digest>, is the HMAC-SHA256 of the
+// payload keyed by the token
+func isValidPluginSignature(payload []byte, token string, signature string) bool {
+	digest, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok || token == "" {
+		return false
+	}
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(token))
+	_, _ = mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// handlePluginRefresh refreshes the ApplicationSets which use the plugin of the payload and match its filter
+func (h *WebhookHandler) handlePluginRefresh(payload *pluginRefreshPayload) {
+	appSetList := &v1alpha1.ApplicationSetList{}
+	err := h.client.List(context.Background(), appSetList, &client.ListOptions{})
+	if err != nil {
+		log.Errorf("Failed to list applicationsets: %v", err)
+		return
+	}
+
+	for _, appSet := range appSetList.Items {
+		if len(payload.ApplicationSets) > 0 && !slices.ContainsFunc(payload.ApplicationSets, func(name string) bool {
+			return name == appSet.Name || name == appSet.Namespace+"/"+appSet.Name
+		}) {
+			continue
+		}
+		if !slices.ContainsFunc(pluginGenerators(&appSet), func(gen *v1alpha1.PluginGenerator) bool {
+			return gen.ConfigMapRef.Name == payload.ConfigMapRef
+		}) {
+			continue
+		}
+		if err := refreshApplicationSet(h.client, &appSet); err != nil {
+			log.Errorf("Failed to refresh ApplicationSet '%s' for controller reprocessing", appSet.Name)
+			continue
+		}
+		log.Infof("refresh ApplicationSet %v/%v from plugin %s webhook", appSet.Namespace, appSet.Name, payload.ConfigMapRef)
+	}
+}
+
 func getGitGeneratorInfo(payload any) *gitGeneratorInfo {
 	var (
 		webURL      string
@@ -253,6 +471,45 @@ func getPRGeneratorInfo(payload any) *prGeneratorInfo {
 			Owner:     payload.Repository.Owner.Login,
 			APIRegexp: apiRegexp,
 		}
+		info.Number = payload.Number
+		info.Closed = payload.Action == "closed"
+	case github.IssueCommentPayload:
+		// issue comment events are also sent for comments on pull requests
+		if payload.Action != "created" || payload.Issue.PullRequest == nil {
+			return nil
+		}
+
+		apiURL := payload.Repository.URL
+		apiRegexp, err := webhook.GetAPIURLRegex(apiURL)
+		if err != nil {
+			log.Errorf("Failed to compile regexp for repoURL '%s'", apiURL)
+			return nil
+		}
+		info.Github = &prGeneratorGithubInfo{
+			Repo:      payload.Repository.Name,
+			Owner:     payload.Repository.Owner.Login,
+			APIRegexp: apiRegexp,
+		}
+		info.Number = payload.Issue.Number
+		info.Comment = &payload.Comment.Body
+	case gitlab.CommentEventPayload:
+		if payload.ObjectAttributes.NotebookType != "MergeRequest" {
+			return nil
+		}
+
+		apiURL := payload.Project.WebURL
+		urlObj, err := url.Parse(apiURL)
+		if err != nil {
+			log.Errorf("Failed to parse repoURL '%s'", apiURL)
+			return nil
+		}
+
+		info.Gitlab = &prGeneratorGitlabInfo{
+			Project:     strconv.FormatInt(payload.MergeRequest.TargetProjectID, 10),
+			APIHostname: urlObj.Hostname(),
+		}
+		info.Number = payload.MergeRequest.IID
+		info.Comment = &payload.ObjectAttributes.Note
 	case gitlab.MergeRequestEventPayload:
 		if !slices.Contains(gitlabAllowedPullRequestActions, payload.ObjectAttributes.Action) {
 			return nil
@@ -269,6 +526,8 @@ func getPRGeneratorInfo(payload any) *prGeneratorInfo {
 			Project:     strconv.FormatInt(payload.ObjectAttributes.TargetProjectID, 10),
 			APIHostname: urlObj.Hostname(),
 		}
+		info.Number = payload.ObjectAttributes.IID
+		info.Closed = payload.ObjectAttributes.Action == "close" || payload.ObjectAttributes.Action == "merge"
 	case azuredevops.GitPullRequestEvent:
 		if !slices.Contains(azuredevopsAllowedPullRequestActions, string(payload.EventType)) {
 			return nil
@@ -476,6 +735,10 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g0.ClusterDecisionResource,
 		PullRequest:             g0.PullRequest,
 		Plugin:                  g0.Plugin,
+		Terraform:               g0.Terraform,
+		CloudInventory:          g0.CloudInventory,
+		KubernetesResource:      g0.KubernetesResource,
+		OCI:                     g0.OCI,
 		Matrix:                  matrixGenerator0,
 		Merge:                   mergeGenerator0,
 	}
@@ -484,7 +747,7 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 	relGenerators := generators.GetRelevantGenerators(requestedGenerator0, h.generators)
 	params := []map[string]any{}
 	for _, g := range relGenerators {
-		p, err := g.GenerateParams(requestedGenerator0, appSet, h.client)
+		p, err := g.GenerateParams(context.Background(), requestedGenerator0, appSet, h.client)
 		if err != nil {
 			log.Error(err)
 			return false
@@ -531,6 +794,10 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g1.ClusterDecisionResource,
 		PullRequest:             g1.PullRequest,
 		Plugin:                  g1.Plugin,
+		Terraform:               g1.Terraform,
+		CloudInventory:          g1.CloudInventory,
+		KubernetesResource:      g1.KubernetesResource,
+		OCI:                     g1.OCI,
 		Matrix:                  matrixGenerator1,
 		Merge:                   mergeGenerator1,
 	}
@@ -610,8 +877,114 @@ func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerato
 	return false
 }
 
+// handlePullRequestComment records the pull request in the annotations of the ApplicationSet and refreshes it if the
+// comment is the comment command of one of its Pull Request generators for the repository of the pull request
+func (h *WebhookHandler) handlePullRequestComment(appSet *v1alpha1.ApplicationSet, info *prGeneratorInfo) {
+	if !slices.ContainsFunc(pullRequestGenerators(appSet), func(gen *v1alpha1.PullRequestGenerator) bool {
+		return gen.CommentCommand != "" && isCommentCommand(*info.Comment, gen.CommentCommand) && shouldRefreshPRGenerator(gen, info)
+	}) {
+		return
+	}
+	key := generators.PullRequestCommandKey(info.commandRepository(), info.Number)
+	err := patchApplicationSet(h.client, appSet, func(annotations map[string]string) {
+		annotations[common.AnnotationApplicationSetRefresh] = "true"
+		addPullRequestCommand(annotations, key)
+	})
+	if err != nil {
+		log.Errorf("Failed to record pull request command %s for ApplicationSet '%s': %v", key, appSet.Name, err)
+		return
+	}
+	log.Infof("refresh ApplicationSet %v/%v from pull request command %s", appSet.Namespace, appSet.Name, key)
+}
+
+// pullRequestGenerators returns the Pull Request generators of the ApplicationSet, including the ones which are direct
+// children of Matrix and Merge generators
+func pullRequestGenerators(appSet *v1alpha1.ApplicationSet) []*v1alpha1.PullRequestGenerator {
+	var gens []*v1alpha1.PullRequestGenerator
+	for _, gen := range appSet.Spec.Generators {
+		if gen.PullRequest != nil {
+			gens = append(gens, gen.PullRequest)
+		}
+		var children []v1alpha1.ApplicationSetNestedGenerator
+		if gen.Matrix != nil {
+			children = append(children, gen.Matrix.Generators...)
+		}
+		if gen.Merge != nil {
+			children = append(children, gen.Merge.Generators...)
+		}
+		for _, child := range children {
+			if child.PullRequest != nil {
+				gens = append(gens, child.PullRequest)
+			}
+		}
+	}
+	return gens
+}
+
+// pluginGenerators returns the Plugin generators of the ApplicationSet, including the ones which are direct children of
+// Matrix and Merge generators
+func pluginGenerators(appSet *v1alpha1.ApplicationSet) []*v1alpha1.PluginGenerator {
+	var gens []*v1alpha1.PluginGenerator
+	for _, gen := range appSet.Spec.Generators {
+		if gen.Plugin != nil {
+			gens = append(gens, gen.Plugin)
+		}
+		var children []v1alpha1.ApplicationSetNestedGenerator
+		if gen.Matrix != nil {
+			children = append(children, gen.Matrix.Generators...)
+		}
+		if gen.Merge != nil {
+			children = append(children, gen.Merge.Generators...)
+		}
+		for _, child := range children {
+			if child.Plugin != nil {
+				gens = append(gens, child.Plugin)
+			}
+		}
+	}
+	return gens
+}
+
+// isCommentCommand returns whether the first line of the comment starts with the command, e.g. "/deploy-preview please"
+// for the command /deploy-preview
+func isCommentCommand(comment string, command string) bool {
+	firstLine, _, _ := strings.Cut(strings.TrimSpace(comment), "\n")
+	fields := strings.Fields(firstLine)
+	return len(fields) > 0 && fields[0] == command
+}
+
+func addPullRequestCommand(annotations map[string]string, key string) {
+	var keys []string
+	if value := annotations[common.AnnotationApplicationSetPullRequestCommands]; value != "" {
+		keys = strings.Split(value, ",")
+	}
+	if !slices.Contains(keys, key) {
+		keys = append(keys, key)
+	}
+	annotations[common.AnnotationApplicationSetPullRequestCommands] = strings.Join(keys, ",")
+}
+
+func removePullRequestCommand(annotations map[string]string, key string) {
+	value, ok := annotations[common.AnnotationApplicationSetPullRequestCommands]
+	if !ok {
+		return
+	}
+	keys := slices.DeleteFunc(strings.Split(value, ","), func(k string) bool {
+		return k == key || k == ""
+	})
+	// the annotation is left empty rather than deleted, since the merge patch cannot remove it
+	annotations[common.AnnotationApplicationSetPullRequestCommands] = strings.Join(keys, ",")
+}
+
 func refreshApplicationSet(c client.Client, appSet *v1alpha1.ApplicationSet) error {
 	// patch the ApplicationSet with the refresh annotation to reconcile
+	return patchApplicationSet(c, appSet, func(annotations map[string]string) {
+		annotations[common.AnnotationApplicationSetRefresh] = "true"
+	})
+}
+
+// patchApplicationSet patches the annotations of the ApplicationSet, retrying on conflicts
+func patchApplicationSet(c client.Client, appSet *v1alpha1.ApplicationSet, updateAnnotations func(annotations map[string]string)) error {
 	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		err := c.Get(context.Background(), types.NamespacedName{Name: appSet.Name, Namespace: appSet.Namespace}, appSet)
 		if err != nil {
@@ -620,7 +993,7 @@ func refreshApplicationSet(c client.Client, appSet *v1alpha1.ApplicationSet) err
 		if appSet.Annotations == nil {
 			appSet.Annotations = map[string]string{}
 		}
-		appSet.Annotations[common.AnnotationApplicationSetRefresh] = "true"
+		updateAnnotations(appSet.Annotations)
 		return c.Patch(context.Background(), appSet, client.Merge)
 	})
 }
@@ -2,12 +2,18 @@ package webhook
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -38,7 +44,7 @@ func (g *generatorMock) GetTemplate(_ *v1alpha1.ApplicationSetGenerator) *v1alph
 	return &v1alpha1.ApplicationSetTemplate{}
 }
 
-func (g *generatorMock) GenerateParams(_ *v1alpha1.ApplicationSetGenerator, _ *v1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+func (g *generatorMock) GenerateParams(_ context.Context, _ *v1alpha1.ApplicationSetGenerator, _ *v1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
 	return []map[string]any{}, nil
 }
 
@@ -802,3 +808,266 @@ func newFakeClient(ns string) *kubefake.Clientset {
 		},
 	})
 }
+
+func TestWebhookHandlerUnresolvedSecretReference(t *testing.T) {
+	namespace := "test"
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	fc := fake.NewClientBuilder().WithScheme(scheme).Build()
+	kubeClient := newFakeClient(namespace)
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(t.Context(), common.ArgoCDSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	secret.Data["webhook.github.secret"] = []byte("$ref:vault:argocd#github")
+	_, err = kubeClient.CoreV1().Secrets(namespace).Update(t.Context(), secret, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	h, err := NewWebhookHandler(10, argosettings.NewSettingsManager(t.Context(), kubeClient, namespace), fc, mockGenerators())
+	require.NoError(t, err)
+	defer func() {
+		close(h.queue)
+		h.Wait()
+	}()
+	send := func() int {
+		req := httptest.NewRequest(http.MethodPost, "/api/webhook", http.NoBody)
+		req.Header.Set("X-GitHub-Event", "push")
+		eventJSON, err := os.ReadFile(filepath.Join("testdata", "github-commit-event.json"))
+		require.NoError(t, err)
+		req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+		w := httptest.NewRecorder()
+		h.Handler(w, req)
+		return w.Code
+	}
+
+	// the payload cannot be verified while the secret is unresolved
+	assert.Equal(t, http.StatusServiceUnavailable, send())
+
+	// the secrets are loaded again once the reference is gone or resolved
+	delete(secret.Data, "webhook.github.secret")
+	_, err = kubeClient.CoreV1().Secrets(namespace).Update(t.Context(), secret, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return send() == http.StatusOK
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestWebhookHandlerPullRequestComment(t *testing.T) {
+	namespace := "test"
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	withCommentCommand := func(appSet *v1alpha1.ApplicationSet, command string) *v1alpha1.ApplicationSet {
+		appSet.Spec.Generators[0].PullRequest.CommentCommand = command
+		return appSet
+	}
+
+	tt := []struct {
+		desc                string
+		headerKey           string
+		headerValue         string
+		payloadFile         string
+		commandedAppSets    map[string]string
+		notCommandedAppSets []string
+	}{
+		{
+			desc:        "Comment on a GitHub pull request",
+			headerKey:   "X-GitHub-Event",
+			headerValue: "issue_comment",
+			payloadFile: "github-issue-comment-event.json",
+			commandedAppSets: map[string]string{
+				"pull-request-github-command": "codertocat/hello-world#2",
+			},
+			notCommandedAppSets: []string{"pull-request-github", "pull-request-github-other-command", "pull-request-gitlab-command"},
+		},
+		{
+			desc:        "Comment on a GitLab merge request",
+			headerKey:   "X-Gitlab-Event",
+			headerValue: "Note Hook",
+			payloadFile: "gitlab-merge-request-comment-event.json",
+			commandedAppSets: map[string]string{
+				"pull-request-gitlab-command": "100500#1",
+			},
+			notCommandedAppSets: []string{"pull-request-github", "pull-request-github-command", "pull-request-github-other-command"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.desc, func(t *testing.T) {
+			fc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				fakeAppWithGithubPullRequestGenerator("pull-request-github", namespace, "Codertocat", "Hello-World"),
+				withCommentCommand(fakeAppWithGithubPullRequestGenerator("pull-request-github-command", namespace, "Codertocat", "Hello-World"), "/deploy-preview"),
+				withCommentCommand(fakeAppWithGithubPullRequestGenerator("pull-request-github-other-command", namespace, "Codertocat", "Hello-World"), "/deploy"),
+				withCommentCommand(fakeAppWithGitlabPullRequestGenerator("pull-request-gitlab-command", namespace, "100500"), "/deploy-preview"),
+			).Build()
+			set := argosettings.NewSettingsManager(t.Context(), newFakeClient(namespace), namespace)
+			h, err := NewWebhookHandler(10, set, fc, mockGenerators())
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/webhook", http.NoBody)
+			req.Header.Set(test.headerKey, test.headerValue)
+			eventJSON, err := os.ReadFile(filepath.Join("testdata", test.payloadFile))
+			require.NoError(t, err)
+			req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+			w := httptest.NewRecorder()
+
+			h.Handler(w, req)
+			close(h.queue)
+			h.Wait()
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			for name, key := range test.commandedAppSets {
+				appSet := &v1alpha1.ApplicationSet{}
+				require.NoError(t, fc.Get(t.Context(), client.ObjectKey{Namespace: namespace, Name: name}, appSet))
+				assert.True(t, appSet.RefreshRequired(), name)
+				assert.Equal(t, key, appSet.Annotations[common.AnnotationApplicationSetPullRequestCommands], name)
+			}
+			for _, name := range test.notCommandedAppSets {
+				appSet := &v1alpha1.ApplicationSet{}
+				require.NoError(t, fc.Get(t.Context(), client.ObjectKey{Namespace: namespace, Name: name}, appSet))
+				assert.False(t, appSet.RefreshRequired(), name)
+				assert.NotContains(t, appSet.Annotations, common.AnnotationApplicationSetPullRequestCommands, name)
+			}
+		})
+	}
+}
+
+func TestWebhookHandlerPlugin(t *testing.T) {
+	namespace := "test"
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	sign := func(payload []byte, token string) string {
+		mac := hmac.New(sha256.New, []byte(token))
+		mac.Write(payload)
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	tt := []struct {
+		desc               string
+		method             string
+		payload            string
+		token              string
+		expectedStatusCode int
+		refreshedAppSets   []string
+	}{
+		{
+			desc:               "Refresh all the ApplicationSets using the plugin",
+			payload:            `{"configMapRef": "test"}`,
+			token:              "my-secret",
+			expectedStatusCode: http.StatusOK,
+			refreshedAppSets:   []string{"plugin", "plugin-copy", "matrix-pull-request-github-plugin"},
+		},
+		{
+			desc:               "Refresh the given ApplicationSets using the plugin",
+			payload:            `{"configMapRef": "test", "applicationSets": ["plugin", "test/matrix-pull-request-github-plugin", "git-github"]}`,
+			token:              "my-secret",
+			expectedStatusCode: http.StatusOK,
+			refreshedAppSets:   []string{"plugin", "matrix-pull-request-github-plugin"},
+		},
+		{
+			desc:               "Invalid signature",
+			payload:            `{"configMapRef": "test"}`,
+			token:              "other-secret",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			desc:               "Unknown plugin",
+			payload:            `{"configMapRef": "other"}`,
+			token:              "my-secret",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			desc:               "Invalid payload",
+			payload:            `{}`,
+			token:              "my-secret",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			desc:               "Invalid method",
+			method:             http.MethodGet,
+			payload:            `{"configMapRef": "test"}`,
+			token:              "my-secret",
+			expectedStatusCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.desc, func(t *testing.T) {
+			fc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: namespace},
+					Data:       map[string]string{"baseUrl": "http://plugin", "token": "$plugin.token"},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: namespace},
+					Data:       map[string][]byte{"plugin.token": []byte("my-secret")},
+				},
+				fakeAppWithPluginGenerator("plugin", namespace),
+				fakeAppWithPluginGenerator("plugin-copy", namespace),
+				fakeAppWithMatrixAndPullRequestGeneratorWithPluginGenerator("matrix-pull-request-github-plugin", namespace, "Codertocat", "Hello-World", "test"),
+				fakeAppWithMatrixAndPullRequestGeneratorWithPluginGenerator("matrix-pull-request-github-other-plugin", namespace, "Codertocat", "Hello-World", "other"),
+				fakeAppWithGitGenerator("git-github", namespace, "https://github.com/org/repo"),
+			).Build()
+			set := argosettings.NewSettingsManager(t.Context(), newFakeClient(namespace), namespace)
+			gens := mockGenerators()
+			gens["Plugin"] = generators.NewPluginGenerator(fc, namespace)
+			h, err := NewWebhookHandler(10, set, fc, gens)
+			require.NoError(t, err)
+
+			method := test.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, "/api/webhook/plugin", bytes.NewReader([]byte(test.payload)))
+			req.Header.Set(PluginSignatureHeader, sign([]byte(test.payload), test.token))
+			w := httptest.NewRecorder()
+
+			h.PluginHandler(w, req)
+			close(h.queue)
+			h.Wait()
+			assert.Equal(t, test.expectedStatusCode, w.Code)
+
+			list := &v1alpha1.ApplicationSetList{}
+			require.NoError(t, fc.List(t.Context(), list))
+			for i := range list.Items {
+				appSet := &list.Items[i]
+				assert.Equal(t, slices.Contains(test.refreshedAppSets, appSet.Name), appSet.RefreshRequired(), appSet.Name)
+			}
+		})
+	}
+}
+
+func TestIsValidPluginSignature(t *testing.T) {
+	payload := []byte(`{"configMapRef": "test"}`)
+	mac := hmac.New(sha256.New, []byte("my-secret"))
+	mac.Write(payload)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, isValidPluginSignature(payload, "my-secret", signature))
+	assert.False(t, isValidPluginSignature(payload, "other-secret", signature))
+	assert.False(t, isValidPluginSignature([]byte(`{"configMapRef": "other"}`), "my-secret", signature))
+	assert.False(t, isValidPluginSignature(payload, "my-secret", strings.TrimPrefix(signature, "sha256=")))
+	assert.False(t, isValidPluginSignature(payload, "my-secret", "sha256=invalid"))
+	assert.False(t, isValidPluginSignature(payload, "", "sha256="+hex.EncodeToString(hmac.New(sha256.New, nil).Sum(nil))))
+}
+
+func TestPullRequestCommandAnnotation(t *testing.T) {
+	annotations := map[string]string{}
+	addPullRequestCommand(annotations, "org/repo#1")
+	addPullRequestCommand(annotations, "org/repo#2")
+	addPullRequestCommand(annotations, "org/repo#1")
+	assert.Equal(t, "org/repo#1,org/repo#2", annotations[common.AnnotationApplicationSetPullRequestCommands])
+
+	removePullRequestCommand(annotations, "org/repo#1")
+	assert.Equal(t, "org/repo#2", annotations[common.AnnotationApplicationSetPullRequestCommands])
+	removePullRequestCommand(annotations, "org/repo#2")
+	assert.Empty(t, annotations[common.AnnotationApplicationSetPullRequestCommands])
+}
+
+func TestIsCommentCommand(t *testing.T) {
+	assert.True(t, isCommentCommand("/deploy-preview", "/deploy-preview"))
+	assert.True(t, isCommentCommand("  /deploy-preview please\nthanks", "/deploy-preview"))
+	assert.False(t, isCommentCommand("/deploy-preview-now", "/deploy-preview"))
+	assert.False(t, isCommentCommand("please\n/deploy-preview", "/deploy-preview"))
+	assert.False(t, isCommentCommand("", "/deploy-preview"))
+}
@@ -9,20 +9,24 @@ import (
 	"time"
 
 	"github.com/argoproj/pkg/v2/stats"
+	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
+	metricsutil "github.com/argoproj/argo-cd/v3/util/metrics"
 	"github.com/argoproj/argo-cd/v3/util/profile"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/controllers"
+	"github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/applicationset/webhook"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/controller/sharding"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/github_app"
 
@@ -32,6 +36,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -76,11 +81,28 @@ func NewCommand() *cobra.Command {
 		globalPreservedAnnotations   []string
 		globalPreservedLabels        []string
 		enableGitHubAPIMetrics       bool
+		enableSCMConditionalRequests bool
+		scmProviderConcurrency       int
+		scmProviderRateLimit         float64
+		scmProviderRateLimitBurst    int
+		scmProviderRateLimitMaxWait  time.Duration
 		metricsAplicationsetLabels   []string
 		enableScmProviders           bool
 		webhookParallelism           int
 		tokenRefStrictMode           bool
+		metricsServerOpts            *metricsutil.ServerOpts
 		maxResourcesStatusCount      int
+		defaultTemplate              string
+		useCachedParametersOnError   bool
+		shards                       int
+		shard                        int
+		shardingMethod               string
+		enableKubernetesResource     bool
+		kubernetesResourceKinds      []string
+		enableTerraform              bool
+		terraformEndpoints           []string
+		enableCloudInventory         bool
+		prometheusAddresses          []string
 	)
 	scheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(scheme)
@@ -132,6 +154,24 @@ func NewCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
+			if shards > 1 && shard < 0 {
+				shard, err = sharding.InferShard()
+				errors.CheckError(err)
+			}
+			if shard < 0 {
+				shard = 0
+			}
+			appsetSharding, err := utils.NewApplicationSetSharding(shards, shard, shardingMethod)
+			errors.CheckError(err)
+			leaderElectionID := "58ac56fa.applicationsets.argoproj.io"
+			if appsetSharding != nil {
+				log.Infof("Reconciling the ApplicationSets of shard %d of %d, sharded by %s", shard, shards, shardingMethod)
+				if shard > 0 {
+					// the replicas of each shard elect their own leader
+					leaderElectionID = fmt.Sprintf("58ac56fa-shard-%d.applicationsets.argoproj.io", shard)
+				}
+			}
+
 			// By default, watch all namespaces
 			var watchedNamespace string
 			// If the applicationset-namespaces contains only one namespace it corresponds to the current namespace
@@ -159,15 +199,29 @@ func NewCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
+			errors.CheckError(metricsServerOpts.Validate())
+			metricsOpts := metricsserver.Options{
+				BindAddress: metricsAddr,
+			}
+			if metricsServerOpts.TLSEnabled() {
+				metricsOpts.SecureServing = true
+				metricsOpts.TLSOpts = append(metricsOpts.TLSOpts, metricsServerOpts.ApplyTLSConfig)
+			}
+			if metricsServerOpts.AuthEnabled() {
+				metricsOpts.FilterProvider = func(_ *rest.Config, _ *http.Client) (metricsserver.Filter, error) {
+					return func(_ logr.Logger, handler http.Handler) (http.Handler, error) {
+						return metricsServerOpts.WrapHandler(handler), nil
+					}, nil
+				}
+			}
+
 			mgr, err := ctrl.NewManager(cfg, ctrl.Options{
-				Scheme: scheme,
-				Metrics: metricsserver.Options{
-					BindAddress: metricsAddr,
-				},
+				Scheme:                 scheme,
+				Metrics:                metricsOpts,
 				Cache:                  cacheOpt,
 				HealthProbeBindAddress: probeBindAddr,
 				LeaderElection:         enableLeaderElection,
-				LeaderElectionID:       "58ac56fa.applicationsets.argoproj.io",
+				LeaderElectionID:       leaderElectionID,
 				Client: ctrlclient.Options{
 					DryRun: &dryRun,
 				},
@@ -193,7 +247,7 @@ func NewCommand() *cobra.Command {
 			argoSettingsMgr := argosettings.NewSettingsManager(ctx, k8sClient, namespace)
 			argoCDDB := db.NewDB(namespace, argoSettingsMgr, k8sClient)
 
-			scmConfig := generators.NewSCMConfig(scmRootCAPath, allowedScmProviders, enableScmProviders, enableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), tokenRefStrictMode)
+			scmConfig := generators.NewSCMConfig(scmRootCAPath, allowedScmProviders, enableScmProviders, enableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), tokenRefStrictMode, enableSCMConditionalRequests, scmProviderConcurrency, services.NewSCMRateLimitBudget(scmProviderRateLimit, scmProviderRateLimitBurst, scmProviderRateLimitMaxWait))
 
 			tlsConfig := apiclient.TLSConfiguration{
 				DisableTLS:       repoServerPlaintext,
@@ -212,7 +266,10 @@ func NewCommand() *cobra.Command {
 			repoClientset := apiclient.NewRepoServerClientset(argocdRepoServer, repoServerTimeoutSeconds, tlsConfig)
 			argoCDService := services.NewArgoCDService(argoCDDB, gitSubmoduleEnabled, repoClientset, enableNewGitFileGlobbing)
 
-			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig)
+			kubernetesResourceConfig := generators.NewKubernetesResourceConfig(enableKubernetesResource, kubernetesResourceKinds)
+			terraformConfig := generators.NewTerraformConfig(enableTerraform, terraformEndpoints)
+			cloudInventoryConfig := generators.NewCloudInventoryConfig(enableCloudInventory)
+			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig, kubernetesResourceConfig, terraformConfig, cloudInventoryConfig)
 
 			// start a webhook server that listens to incoming webhook payloads
 			webhookHandler, err := webhook.NewWebhookHandler(webhookParallelism, argoSettingsMgr, mgr.GetClient(), topLevelGenerators)
@@ -227,27 +284,34 @@ func NewCommand() *cobra.Command {
 				utils.NewAppsetLister(mgr.GetClient()),
 				metricsAplicationsetLabels,
 				func(appset *appv1alpha1.ApplicationSet) bool {
-					return utils.IsNamespaceAllowed(applicationSetNamespaces, appset.Namespace)
+					return utils.IsNamespaceAllowed(applicationSetNamespaces, appset.Namespace) && appsetSharding.IsInShard(appset)
 				})
 
+			defaultTemplateObj, err := template.ParseDefaultTemplate(defaultTemplate)
+			errors.CheckError(err)
+
 			if err = (&controllers.ApplicationSetReconciler{
-				Generators:                 topLevelGenerators,
-				Client:                     mgr.GetClient(),
-				Scheme:                     mgr.GetScheme(),
-				Recorder:                   mgr.GetEventRecorderFor("applicationset-controller"),
-				Renderer:                   &utils.Render{},
-				Policy:                     policyObj,
-				EnablePolicyOverride:       enablePolicyOverride,
-				KubeClientset:              k8sClient,
-				ArgoDB:                     argoCDDB,
-				ArgoCDNamespace:            namespace,
-				ApplicationSetNamespaces:   applicationSetNamespaces,
-				EnableProgressiveSyncs:     enableProgressiveSyncs,
-				SCMRootCAPath:              scmRootCAPath,
-				GlobalPreservedAnnotations: globalPreservedAnnotations,
-				GlobalPreservedLabels:      globalPreservedLabels,
-				Metrics:                    &metrics,
-				MaxResourcesStatusCount:    maxResourcesStatusCount,
+				Generators:                      topLevelGenerators,
+				Client:                          mgr.GetClient(),
+				Scheme:                          mgr.GetScheme(),
+				Recorder:                        mgr.GetEventRecorderFor("applicationset-controller"),
+				Renderer:                        &utils.Render{},
+				Policy:                          policyObj,
+				EnablePolicyOverride:            enablePolicyOverride,
+				KubeClientset:                   k8sClient,
+				ArgoDB:                          argoCDDB,
+				ArgoCDNamespace:                 namespace,
+				ApplicationSetNamespaces:        applicationSetNamespaces,
+				EnableProgressiveSyncs:          enableProgressiveSyncs,
+				SCMRootCAPath:                   scmRootCAPath,
+				GlobalPreservedAnnotations:      globalPreservedAnnotations,
+				GlobalPreservedLabels:           globalPreservedLabels,
+				Metrics:                         &metrics,
+				MaxResourcesStatusCount:         maxResourcesStatusCount,
+				DefaultTemplate:                 defaultTemplateObj,
+				UseCachedParametersOnError:      useCachedParametersOnError,
+				Sharding:                        appsetSharding,
+				StepAnalysisPrometheusAddresses: prometheusAddresses,
 			}).SetupWithManager(mgr, enableProgressiveSyncs, maxConcurrentReconciliations); err != nil {
 				log.Error(err, "unable to create controller", "controller", "ApplicationSet")
 				os.Exit(1)
@@ -292,7 +356,24 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&webhookParallelism, "webhook-parallelism-limit", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_WEBHOOK_PARALLELISM_LIMIT", 50, 1, 1000), "Number of webhook requests processed concurrently")
 	command.Flags().StringSliceVar(&metricsAplicationsetLabels, "metrics-applicationset-labels", []string{}, "List of Application labels that will be added to the argocd_applicationset_labels metric")
 	command.Flags().BoolVar(&enableGitHubAPIMetrics, "enable-github-api-metrics", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_GITHUB_API_METRICS", false), "Enable GitHub API metrics for generators that use the GitHub API")
+	command.Flags().BoolVar(&enableSCMConditionalRequests, "enable-scm-provider-conditional-requests", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SCM_PROVIDER_CONDITIONAL_REQUESTS", false), "Cache SCM provider API responses and revalidate them using conditional requests, so that unchanged repository listings do not consume API rate limit. Currently supported by the GitHub SCM provider generator")
+	command.Flags().IntVar(&scmProviderConcurrency, "scm-provider-concurrency", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_PROVIDER_CONCURRENCY", 1, 1, 100), "Maximum number of repositories scanned concurrently by an SCM provider generator")
+	command.Flags().Float64Var(&scmProviderRateLimit, "scm-provider-rate-limit", env.ParseFloat64FromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_PROVIDER_RATE_LIMIT", 0, 0, math.MaxFloat64), "Maximum number of requests per second sent by the SCM provider generators to an SCM provider API with the same credentials, 0 for no limit. Currently supported by the GitHub SCM provider generator")
+	command.Flags().IntVar(&scmProviderRateLimitBurst, "scm-provider-rate-limit-burst", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_PROVIDER_RATE_LIMIT_BURST", 10, 1, math.MaxInt), "Maximum burst of requests sent by the SCM provider generators to an SCM provider API with the same credentials")
+	command.Flags().DurationVar(&scmProviderRateLimitMaxWait, "scm-provider-rate-limit-max-wait", env.ParseDurationFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_PROVIDER_RATE_LIMIT_MAX_WAIT", services.DefaultSCMRateLimitMaxWait, 0, math.MaxInt64), "Maximum time the SCM provider generators wait for an exhausted SCM provider API rate limit to reset before failing. Currently supported by the GitHub SCM provider generator")
+	command.Flags().StringVar(&defaultTemplate, "default-template", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_DEFAULT_TEMPLATE", ""), "YAML of a template merged beneath the template of every ApplicationSet, e.g. to set default labels, finalizers or sync options")
+	command.Flags().BoolVar(&useCachedParametersOnError, "use-cached-parameters-on-error", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_USE_CACHED_PARAMETERS_ON_ERROR", false), "Cache the parameters last successfully generated by each generator in the ApplicationSet status, and use them while the generator fails, so that transient errors, e.g. of SCM provider or plugin APIs, do not cause Applications to be changed or deleted")
+	command.Flags().IntVar(&shards, "shards", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SHARDS", 1, 1, math.MaxInt32), "Number of shards the ApplicationSets are split into, each of which is reconciled by its own replicas of the controller")
+	command.Flags().IntVar(&shard, "shard", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SHARD", -1, -1, math.MaxInt32), "Shard of the ApplicationSets reconciled by this replica, starting at 0. Inferred from the ordinal of the hostname, e.g. of a StatefulSet pod, if not set")
+	command.Flags().StringVar(&shardingMethod, "sharding-method", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SHARDING_METHOD", utils.ShardingMethodNamespace), fmt.Sprintf("Method assigning the ApplicationSets to the shards. One of: %s (hash of the namespace)|%s (%s label, shard 0 if unset)", utils.ShardingMethodNamespace, utils.ShardingMethodLabel, common.LabelKeyApplicationSetShard))
+	command.Flags().BoolVar(&enableKubernetesResource, "enable-kubernetes-resource-generator", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_KUBERNETES_RESOURCE_GENERATOR", false), "Enable the Kubernetes resource generator, which lists resources in the clusters permitted by the project of the ApplicationSet (Default: false)")
+	command.Flags().StringSliceVar(&kubernetesResourceKinds, "kubernetes-resource-generator-allowed-kinds", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_KUBERNETES_RESOURCE_GENERATOR_ALLOWED_KINDS", []string{}, ","), "The list of kinds the Kubernetes resource generator is allowed to list, formatted as Kind for the core group and as Kind.group otherwise, e.g. Namespace,Team.example.com (Default: Empty = none)")
+	command.Flags().BoolVar(&enableTerraform, "enable-terraform-generator", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_TERRAFORM_GENERATOR", false), "Enable the Terraform generator, which reads Terraform states with the credentials referenced by the ApplicationSet (Default: false)")
+	command.Flags().StringSliceVar(&terraformEndpoints, "terraform-generator-allowed-endpoints", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_TERRAFORM_GENERATOR_ALLOWED_ENDPOINTS", []string{}, ","), "The list of allowed URLs of S3 compatible APIs and Terraform Enterprise instances used by the Terraform generator instead of AWS S3 and HCP Terraform (Default: Empty = none)")
+	command.Flags().BoolVar(&enableCloudInventory, "enable-cloud-inventory-generator", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_CLOUD_INVENTORY_GENERATOR", false), "Enable the cloud inventory generator, which lists cloud accounts with the credentials referenced by the ApplicationSet (Default: false)")
+	command.Flags().StringSliceVar(&prometheusAddresses, "step-analysis-prometheus-allowed-addresses", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_STEP_ANALYSIS_PROMETHEUS_ALLOWED_ADDRESSES", []string{}, ","), "The list of allowed addresses of Prometheus which the analyses of RollingSync steps may query (Default: Empty = none)")
 	command.Flags().IntVar(&maxResourcesStatusCount, "max-resources-status-count", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_MAX_RESOURCES_STATUS_COUNT", 0, 0, math.MaxInt), "Max number of resources stored in appset status.")
+	metricsServerOpts = metricsutil.AddServerFlags(&command, "ARGOCD_APPLICATIONSET_CONTROLLER")
 
 	return &command
 }
@@ -300,6 +381,7 @@ func NewCommand() *cobra.Command {
 func startWebhookServer(webhookHandler *webhook.WebhookHandler, webhookAddr string) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/api/webhook", webhookHandler.Handler)
+	mux.HandleFunc("/api/webhook/plugin", webhookHandler.PluginHandler)
 	go func() {
 		log.Infof("Starting webhook server %s", webhookAddr)
 		err := http.ListenAndServe(webhookAddr, mux)
@@ -34,6 +34,8 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/gpg"
 	"github.com/argoproj/argo-cd/v3/util/healthz"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	metricsutil "github.com/argoproj/argo-cd/v3/util/metrics"
+	"github.com/argoproj/argo-cd/v3/util/profile"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
 )
@@ -65,9 +67,12 @@ func NewCommand() *cobra.Command {
 		cacheSrc                           func() (*reposervercache.Cache, error)
 		tlsConfigCustomizer                tls.ConfigCustomizer
 		tlsConfigCustomizerSrc             func() (tls.ConfigCustomizer, error)
+		metricsServerOpts                  *metricsutil.ServerOpts
 		redisClient                        *redis.Client
 		disableTLS                         bool
 		maxCombinedDirectoryManifestsSize  string
+		manifestMaxCount                   int
+		manifestMaxSize                    string
 		cmpTarExcludedGlobs                []string
 		allowOutOfBoundsSymlinks           bool
 		streamedManifestMaxTarSize         string
@@ -81,6 +86,11 @@ func NewCommand() *cobra.Command {
 		cmpUseManifestGeneratePaths        bool
 		ociMediaTypes                      []string
 		enableBuiltinGitConfig             bool
+		checkoutCoordination               string
+		checkoutLeaseDuration              time.Duration
+		checkoutHashedPaths                bool
+		gitReferenceDir                    string
+		gitReferenceDissociate             bool
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -120,6 +130,9 @@ func NewCommand() *cobra.Command {
 			maxCombinedDirectoryManifestsQuantity, err := resource.ParseQuantity(maxCombinedDirectoryManifestsSize)
 			errors.CheckError(err)
 
+			manifestMaxSizeQuantity, err := resource.ParseQuantity(manifestMaxSize)
+			errors.CheckError(err)
+
 			streamedManifestMaxTarSizeQuantity, err := resource.ParseQuantity(streamedManifestMaxTarSize)
 			errors.CheckError(err)
 
@@ -135,6 +148,12 @@ func NewCommand() *cobra.Command {
 			helmRegistryMaxIndexSizeQuantity, err := resource.ParseQuantity(helmRegistryMaxIndexSize)
 			errors.CheckError(err)
 
+			errors.CheckError(repository.ValidateCheckoutCoordination(checkoutCoordination))
+			if checkoutCoordination == repository.CheckoutCoordinationLease && !checkoutHashedPaths {
+				log.Warn("Repository checkouts are not shared between replicas since hashed checkout paths are not enabled")
+			}
+			errors.CheckError(metricsServerOpts.Validate())
+
 			askPassServer := askpass.NewServer(askpass.SocketPath)
 			metricsServer := metrics.NewMetricsServer()
 			cacheutil.CollectMetrics(redisClient, metricsServer, nil)
@@ -145,6 +164,8 @@ func NewCommand() *cobra.Command {
 				PauseGenerationOnFailureForRequests:          pauseGenerationOnFailureForRequests,
 				SubmoduleEnabled:                             gitSubmoduleEnabled,
 				MaxCombinedDirectoryManifestsSize:            maxCombinedDirectoryManifestsQuantity,
+				ManifestMaxCount:                             manifestMaxCount,
+				ManifestMaxSize:                              manifestMaxSizeQuantity.ToDec().Value(),
 				CMPTarExcludedGlobs:                          cmpTarExcludedGlobs,
 				AllowOutOfBoundsSymlinks:                     allowOutOfBoundsSymlinks,
 				StreamedManifestMaxExtractedSize:             streamedManifestMaxExtractedSizeQuantity.ToDec().Value(),
@@ -157,6 +178,11 @@ func NewCommand() *cobra.Command {
 				CMPUseManifestGeneratePaths:                  cmpUseManifestGeneratePaths,
 				OCIMediaTypes:                                ociMediaTypes,
 				EnableBuiltinGitConfig:                       enableBuiltinGitConfig,
+				CheckoutCoordination:                         checkoutCoordination,
+				CheckoutLeaseDuration:                        checkoutLeaseDuration,
+				CheckoutHashedPaths:                          checkoutHashedPaths,
+				GitReferenceDir:                              gitReferenceDir,
+				GitReferenceDissociate:                       gitReferenceDissociate,
 			}, askPassServer)
 			errors.CheckError(err)
 
@@ -198,7 +224,11 @@ func NewCommand() *cobra.Command {
 				return nil
 			})
 			http.Handle("/metrics", metricsServer.GetHandler())
-			go func() { errors.CheckError(http.ListenAndServe(fmt.Sprintf("%s:%d", metricsHost, metricsPort), nil)) }()
+			// the pprof endpoints are registered to the default mux by net/http/pprof
+			profile.RegisterDiagnostics(http.DefaultServeMux)
+			metricsHTTPServer := &http.Server{Addr: fmt.Sprintf("%s:%d", metricsHost, metricsPort), Handler: http.DefaultServeMux}
+			metricsServerOpts.ConfigureServer(metricsHTTPServer)
+			go func() { errors.CheckError(metricsutil.ListenAndServe(metricsHTTPServer)) }()
 			go func() { errors.CheckError(askPassServer.Run()) }()
 
 			if gpg.IsGPGEnabled() {
@@ -255,6 +285,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&otlpAttrs, "otlp-attrs", env.StringsFromEnv("ARGOCD_REPO_SERVER_OTLP_ATTRS", []string{}, ","), "List of OpenTelemetry collector extra attrs when send traces, each attribute is separated by a colon(e.g. key:value)")
 	command.Flags().BoolVar(&disableTLS, "disable-tls", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_TLS", false), "Disable TLS on the gRPC endpoint")
 	command.Flags().StringVar(&maxCombinedDirectoryManifestsSize, "max-combined-directory-manifests-size", env.StringFromEnv("ARGOCD_REPO_SERVER_MAX_COMBINED_DIRECTORY_MANIFESTS_SIZE", "10M"), "Max combined size of manifest files in a directory-type Application")
+	command.Flags().IntVar(&manifestMaxCount, "manifest-max-count", env.ParseNumFromEnv("ARGOCD_REPO_SERVER_MANIFEST_MAX_COUNT", 0, 0, math.MaxInt32), "Maximum number of manifests generated for an application. Any value less than 1 means no limit.")
+	command.Flags().StringVar(&manifestMaxSize, "manifest-max-size", env.StringFromEnv("ARGOCD_REPO_SERVER_MANIFEST_MAX_SIZE", "0"), "Maximum combined size of the manifests generated for an application. Zero means no limit.")
 	command.Flags().StringArrayVar(&cmpTarExcludedGlobs, "plugin-tar-exclude", env.StringsFromEnv("ARGOCD_REPO_SERVER_PLUGIN_TAR_EXCLUSIONS", []string{}, ";"), "Globs to filter when sending tarballs to plugins.")
 	command.Flags().BoolVar(&allowOutOfBoundsSymlinks, "allow-oob-symlinks", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_ALLOW_OUT_OF_BOUNDS_SYMLINKS", false), "Allow out-of-bounds symlinks in repositories (not recommended)")
 	command.Flags().StringVar(&streamedManifestMaxTarSize, "streamed-manifest-max-tar-size", env.StringFromEnv("ARGOCD_REPO_SERVER_STREAMED_MANIFEST_MAX_TAR_SIZE", "100M"), "Maximum size of streamed manifest archives")
@@ -268,7 +300,13 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&cmpUseManifestGeneratePaths, "plugin-use-manifest-generate-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_PLUGIN_USE_MANIFEST_GENERATE_PATHS", false), "Pass the resources described in argocd.argoproj.io/manifest-generate-paths value to the cmpserver to generate the application manifests.")
 	command.Flags().StringSliceVar(&ociMediaTypes, "oci-layer-media-types", env.StringsFromEnv("ARGOCD_REPO_SERVER_OCI_LAYER_MEDIA_TYPES", []string{"application/vnd.oci.image.layer.v1.tar", "application/vnd.oci.image.layer.v1.tar+gzip", "application/vnd.cncf.helm.chart.content.v1.tar+gzip"}, ","), "Comma separated list of allowed media types for OCI media types. This only accounts for media types within layers.")
 	command.Flags().BoolVar(&enableBuiltinGitConfig, "enable-builtin-git-config", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_ENABLE_BUILTIN_GIT_CONFIG", true), "Enable builtin git configuration options that are required for correct argocd-repo-server operation.")
+	command.Flags().StringVar(&checkoutCoordination, "checkout-coordination", env.StringFromEnv("ARGOCD_REPO_SERVER_CHECKOUT_COORDINATION", repository.CheckoutCoordinationNone), "Coordination of repository checkouts between replicas sharing a volume. One of: none|lease")
+	command.Flags().DurationVar(&checkoutLeaseDuration, "checkout-lease-duration", env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_CHECKOUT_LEASE_DURATION", 30*time.Second, time.Second, time.Hour), "Duration after which the lease of a repository checkout held by an unresponsive replica can be taken over by other replicas")
+	command.Flags().BoolVar(&checkoutHashedPaths, "checkout-hashed-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_CHECKOUT_HASHED_PATHS", false), "Store repository checkouts in paths derived from the hash of the repository URL instead of random paths, so that replicas sharing a volume share the checkouts. The paths are predictable, so the volume must only be accessible to repo-server replicas")
+	command.Flags().StringVar(&gitReferenceDir, "git-reference-dir", env.StringFromEnv("ARGOCD_REPO_SERVER_GIT_REFERENCE_DIR", ""), "Directory containing reference repositories (<dir>/<host>/<path>) or bundles (<dir>/<host>/<path>.bundle) used to speed up the initial clone of Git repositories")
+	command.Flags().BoolVar(&gitReferenceDissociate, "git-reference-dissociate", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_GIT_REFERENCE_DISSOCIATE", true), "Copy the objects borrowed from a reference repository after the first fetch, so that repositories do not depend on the reference directory")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
+	metricsServerOpts = metricsutil.AddServerFlags(&command, "ARGOCD_REPO_SERVER")
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
 			redisClient = client
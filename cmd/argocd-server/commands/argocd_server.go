@@ -28,6 +28,7 @@ import (
 	reposervercache "github.com/argoproj/argo-cd/v3/reposerver/cache"
 	"github.com/argoproj/argo-cd/v3/server"
 	servercache "github.com/argoproj/argo-cd/v3/server/cache"
+	"github.com/argoproj/argo-cd/v3/server/diagnostics"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 	"github.com/argoproj/argo-cd/v3/util/cli"
@@ -35,6 +36,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/kube"
+	metricsutil "github.com/argoproj/argo-cd/v3/util/metrics"
 	"github.com/argoproj/argo-cd/v3/util/templates"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
@@ -67,14 +69,22 @@ func NewCommand() *cobra.Command {
 		glogLevel                int
 		clientConfig             clientcmd.ClientConfig
 		repoServerTimeoutSeconds int
+		repoServerMethodTimeouts map[string]string
+		repoServerHedgingDelay   time.Duration
+		repoServerHedgedMethods  []string
 		baseHRef                 string
 		rootPath                 string
 		repoServerAddress        string
+		serverDiagAddress        string
+		controllerDiagAddress    string
+		repoServerDiagAddress    string
 		dexServerAddress         string
 		disableAuth              bool
 		contentTypes             string
 		enableGZip               bool
 		tlsConfigCustomizerSrc   func() (tls.ConfigCustomizer, error)
+		metricsServerOpts        *metricsutil.ServerOpts
+		diagMetricsClientOpts    *metricsutil.ClientOpts
 		cacheSrc                 func() (*servercache.Cache, error)
 		repoServerCacheSrc       func() (*reposervercache.Cache, error)
 		frameOptions             string
@@ -89,6 +99,7 @@ func NewCommand() *cobra.Command {
 		webhookParallelism       int
 		hydratorEnabled          bool
 		syncWithReplaceAllowed   bool
+		clusterRegistration      bool
 
 		// ApplicationSet
 		enableNewGitFileGlobbing bool
@@ -136,6 +147,8 @@ func NewCommand() *cobra.Command {
 
 			tlsConfigCustomizer, err := tlsConfigCustomizerSrc()
 			errors.CheckError(err)
+			errors.CheckError(metricsServerOpts.Validate())
+			errors.CheckError(diagMetricsClientOpts.Validate())
 			cache, err := cacheSrc()
 			errors.CheckError(err)
 			repoServerCache, err := repoServerCacheSrc()
@@ -203,7 +216,12 @@ func NewCommand() *cobra.Command {
 				dexTLSConfig.Certificate = cert.Raw
 			}
 
-			repoclientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig)
+			methodTimeouts, err := apiclient.ParseMethodTimeouts(repoServerMethodTimeouts)
+			errors.CheckError(err)
+			errors.CheckError(apiclient.ValidateHedgedMethods(repoServerHedgedMethods))
+			repoclientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig,
+				apiclient.WithMethodTimeouts(methodTimeouts),
+				apiclient.WithHedging(repoServerHedgingDelay, repoServerHedgedMethods))
 			if rootPath != "" {
 				if baseHRef != "" && baseHRef != rootPath {
 					log.Warnf("--basehref and --rootpath had conflict: basehref: %s rootpath: %s", baseHRef, rootPath)
@@ -217,37 +235,45 @@ func NewCommand() *cobra.Command {
 			}
 
 			argoCDOpts := server.ArgoCDServerOpts{
-				Insecure:                insecure,
-				ListenPort:              listenPort,
-				ListenHost:              listenHost,
-				MetricsPort:             metricsPort,
-				MetricsHost:             metricsHost,
-				Namespace:               namespace,
-				BaseHRef:                baseHRef,
-				RootPath:                rootPath,
-				DynamicClientset:        dynamicClient,
-				KubeControllerClientset: controllerClient,
-				KubeClientset:           kubeclientset,
-				AppClientset:            appClientSet,
-				RepoClientset:           repoclientset,
-				DexServerAddr:           dexServerAddress,
-				DexTLSConfig:            dexTLSConfig,
-				DisableAuth:             disableAuth,
-				ContentTypes:            contentTypesList,
-				EnableGZip:              enableGZip,
-				TLSConfigCustomizer:     tlsConfigCustomizer,
-				Cache:                   cache,
-				RepoServerCache:         repoServerCache,
-				XFrameOptions:           frameOptions,
-				ContentSecurityPolicy:   contentSecurityPolicy,
-				RedisClient:             redisClient,
-				StaticAssetsDir:         staticAssetsDir,
-				ApplicationNamespaces:   applicationNamespaces,
-				EnableProxyExtension:    enableProxyExtension,
-				WebhookParallelism:      webhookParallelism,
-				EnableK8sEvent:          enableK8sEvent,
-				HydratorEnabled:         hydratorEnabled,
-				SyncWithReplaceAllowed:  syncWithReplaceAllowed,
+				Insecure:                   insecure,
+				ListenPort:                 listenPort,
+				ListenHost:                 listenHost,
+				MetricsPort:                metricsPort,
+				MetricsHost:                metricsHost,
+				MetricsServerOpts:          *metricsServerOpts,
+				Namespace:                  namespace,
+				BaseHRef:                   baseHRef,
+				RootPath:                   rootPath,
+				DynamicClientset:           dynamicClient,
+				KubeControllerClientset:    controllerClient,
+				KubeClientset:              kubeclientset,
+				AppClientset:               appClientSet,
+				RepoClientset:              repoclientset,
+				DexServerAddr:              dexServerAddress,
+				DexTLSConfig:               dexTLSConfig,
+				DisableAuth:                disableAuth,
+				ContentTypes:               contentTypesList,
+				EnableGZip:                 enableGZip,
+				TLSConfigCustomizer:        tlsConfigCustomizer,
+				Cache:                      cache,
+				RepoServerCache:            repoServerCache,
+				XFrameOptions:              frameOptions,
+				ContentSecurityPolicy:      contentSecurityPolicy,
+				RedisClient:                redisClient,
+				StaticAssetsDir:            staticAssetsDir,
+				ApplicationNamespaces:      applicationNamespaces,
+				EnableProxyExtension:       enableProxyExtension,
+				WebhookParallelism:         webhookParallelism,
+				EnableK8sEvent:             enableK8sEvent,
+				HydratorEnabled:            hydratorEnabled,
+				SyncWithReplaceAllowed:     syncWithReplaceAllowed,
+				ClusterRegistrationEnabled: clusterRegistration,
+				DiagnosticsAddresses: map[string]string{
+					diagnostics.ComponentServer:                serverDiagAddress,
+					diagnostics.ComponentApplicationController: controllerDiagAddress,
+					diagnostics.ComponentRepoServer:            repoServerDiagAddress,
+				},
+				DiagnosticsMetricsClientOpts: *diagMetricsClientOpts,
 			}
 
 			appsetOpts := server.ApplicationSetOpts{
@@ -303,6 +329,9 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_SERVER_LOG_LEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
 	command.Flags().StringVar(&repoServerAddress, "repo-server", env.StringFromEnv("ARGOCD_SERVER_REPO_SERVER", common.DefaultRepoServerAddr), "Repo server address")
+	command.Flags().StringVar(&serverDiagAddress, "server-diagnostics-address", env.StringFromEnv("ARGOCD_SERVER_SERVER_DIAGNOSTICS_ADDRESS", fmt.Sprintf("argocd-server-metrics:%d", common.DefaultPortArgoCDAPIServerMetrics)), "Metrics address of the API server the runtime diagnostics are requested from")
+	command.Flags().StringVar(&controllerDiagAddress, "controller-diagnostics-address", env.StringFromEnv("ARGOCD_SERVER_CONTROLLER_DIAGNOSTICS_ADDRESS", fmt.Sprintf("argocd-metrics:%d", common.DefaultPortArgoCDMetrics)), "Metrics address of the application controller the runtime diagnostics are requested from")
+	command.Flags().StringVar(&repoServerDiagAddress, "repo-server-diagnostics-address", env.StringFromEnv("ARGOCD_SERVER_REPO_SERVER_DIAGNOSTICS_ADDRESS", fmt.Sprintf("argocd-repo-server:%d", common.DefaultPortRepoServerMetrics)), "Metrics address of the repo server the runtime diagnostics are requested from")
 	command.Flags().StringVar(&dexServerAddress, "dex-server", env.StringFromEnv("ARGOCD_SERVER_DEX_SERVER", common.DefaultDexServerAddr), "Dex server address")
 	command.Flags().BoolVar(&disableAuth, "disable-auth", env.ParseBoolFromEnv("ARGOCD_SERVER_DISABLE_AUTH", false), "Disable client authentication")
 	command.Flags().StringVar(&contentTypes, "api-content-types", env.StringFromEnv("ARGOCD_API_CONTENT_TYPES", "application/json", env.StringFromEnvOpts{AllowEmpty: true}), "Semicolon separated list of allowed content types for non GET api requests. Any content type is allowed if empty.")
@@ -317,6 +346,9 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringToStringVar(&otlpHeaders, "otlp-headers", env.ParseStringToStringFromEnv("ARGOCD_SERVER_OTLP_HEADERS", map[string]string{}, ","), "List of OpenTelemetry collector extra headers sent with traces, headers are comma-separated key-value pairs(e.g. key1=value1,key2=value2)")
 	command.Flags().StringSliceVar(&otlpAttrs, "otlp-attrs", env.StringsFromEnv("ARGOCD_SERVER_OTLP_ATTRS", []string{}, ","), "List of OpenTelemetry collector extra attrs when send traces, each attribute is separated by a colon(e.g. key:value)")
 	command.Flags().IntVar(&repoServerTimeoutSeconds, "repo-server-timeout-seconds", env.ParseNumFromEnv("ARGOCD_SERVER_REPO_SERVER_TIMEOUT_SECONDS", 60, 0, math.MaxInt64), "Repo server RPC call timeout seconds.")
+	command.Flags().StringToStringVar(&repoServerMethodTimeouts, "repo-server-method-timeouts", env.ParseStringToStringFromEnv("ARGOCD_SERVER_REPO_SERVER_METHOD_TIMEOUTS", map[string]string{}, ","), "Repo server RPC call timeouts of specific methods, overriding --repo-server-timeout-seconds, as comma-separated method=duration pairs (e.g. GenerateManifest=3m,ListRefs=10s)")
+	command.Flags().DurationVar(&repoServerHedgingDelay, "repo-server-hedging-delay", env.ParseDurationFromEnv("ARGOCD_SERVER_REPO_SERVER_HEDGING_DELAY", 0, 0, time.Hour), "Send a second request to another repo server replica if a hedged repo server RPC call has not completed after this delay. Hedging is disabled if 0")
+	command.Flags().StringSliceVar(&repoServerHedgedMethods, "repo-server-hedged-methods", env.StringsFromEnv("ARGOCD_SERVER_REPO_SERVER_HEDGED_METHODS", apiclient.DefaultHedgedMethods, ","), "List of repo server RPC methods which are hedged if --repo-server-hedging-delay is set")
 	command.Flags().StringVar(&frameOptions, "x-frame-options", env.StringFromEnv("ARGOCD_SERVER_X_FRAME_OPTIONS", "sameorigin"), "Set X-Frame-Options header in HTTP responses to `value`. To disable, set to \"\".")
 	command.Flags().StringVar(&contentSecurityPolicy, "content-security-policy", env.StringFromEnv("ARGOCD_SERVER_CONTENT_SECURITY_POLICY", "frame-ancestors 'self';"), "Set Content-Security-Policy header in HTTP responses to `value`. To disable, set to \"\".")
 	command.Flags().BoolVar(&repoServerPlaintext, "repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_SERVER_REPO_SERVER_PLAINTEXT", false), "Use a plaintext client (non-TLS) to connect to repository server")
@@ -329,6 +361,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&enableK8sEvent, "enable-k8s-event", env.StringsFromEnv("ARGOCD_ENABLE_K8S_EVENT", argo.DefaultEnableEventList(), ","), "Enable ArgoCD to use k8s event. For disabling all events, set the value as `none`. (e.g --enable-k8s-event=none), For enabling specific events, set the value as `event reason`. (e.g --enable-k8s-event=StatusRefreshed,ResourceCreated)")
 	command.Flags().BoolVar(&hydratorEnabled, "hydrator-enabled", env.ParseBoolFromEnv("ARGOCD_HYDRATOR_ENABLED", false), "Feature flag to enable Hydrator. Default (\"false\")")
 	command.Flags().BoolVar(&syncWithReplaceAllowed, "sync-with-replace-allowed", env.ParseBoolFromEnv("ARGOCD_SYNC_WITH_REPLACE_ALLOWED", true), "Whether to allow users to select replace for syncs from UI/CLI")
+	command.Flags().BoolVar(&clusterRegistration, "cluster-registration-enabled", env.ParseBoolFromEnv("ARGOCD_SERVER_CLUSTER_REGISTRATION_ENABLED", false), "Feature flag to reconcile Cluster resources into cluster secrets. Default (\"false\")")
 
 	// Flags related to the applicationSet component.
 	command.Flags().StringVar(&scmRootCAPath, "appset-scm-root-ca-path", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_ROOT_CA_PATH", ""), "Provide Root CA Path for self-signed TLS Certificates")
@@ -338,6 +371,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&enableGitHubAPIMetrics, "appset-enable-github-api-metrics", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_GITHUB_API_METRICS", false), "Enable GitHub API metrics for generators that use the GitHub API")
 
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
+	metricsServerOpts = metricsutil.AddServerFlags(command, "ARGOCD_SERVER")
+	diagMetricsClientOpts = metricsutil.AddClientFlags(command, "diagnostics", "ARGOCD_SERVER_DIAGNOSTICS")
 	cacheSrc = servercache.AddCacheFlagsToCmd(command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
 			redisClient = client
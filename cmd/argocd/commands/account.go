@@ -60,6 +60,8 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewAccountGenerateTokenCommand(clientOpts))
 	command.AddCommand(NewAccountGetCommand(clientOpts))
 	command.AddCommand(NewAccountDeleteTokenCommand(clientOpts))
+	command.AddCommand(NewAccountListSessionsCommand(clientOpts))
+	command.AddCommand(NewAccountRevokeSessionCommand(clientOpts))
 	command.AddCommand(NewBcryptCmd())
 	return command
 }
@@ -448,3 +450,108 @@ argocd account delete-token --account <account-name> ID`,
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	return cmd
 }
+
+func NewAccountListSessionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output  string
+		account string
+	)
+	cmd := &cobra.Command{
+		Use:   "list-sessions",
+		Short: "List active login sessions of an account",
+		Example: `# List sessions of the currently logged in account
+argocd account list-sessions
+
+# List sessions of the account with the specified name
+argocd account list-sessions --account <account-name>`,
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, client := clientset.NewAccountClientOrDie()
+			defer utilio.Close(conn)
+			if account == "" {
+				account = getCurrentAccount(ctx, clientset).Username
+			}
+			response, err := client.ListSessions(ctx, &accountpkg.ListSessionsRequest{Name: account})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(response.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printSessionsTable(response.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	return cmd
+}
+
+func printSessionsTable(items []*accountpkg.Session) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\n")
+	for _, s := range items {
+		expiresAtFormatted := "never"
+		if s.ExpiresAt > 0 {
+			expiresAtFormatted = time.Unix(s.ExpiresAt, 0).Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", s.Id, time.Unix(s.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted)
+	}
+	_ = w.Flush()
+}
+
+func NewAccountRevokeSessionCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		account string
+		all     bool
+	)
+	cmd := &cobra.Command{
+		Use:   "revoke-session",
+		Short: "Immediately revoke login sessions of an account",
+		Example: `# Revoke a session of the currently logged in account
+argocd account revoke-session ID
+
+# Revoke all sessions of the account with the specified name
+argocd account revoke-session --account <account-name> --all`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if (all && len(args) != 0) || (!all && len(args) != 1) {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, client := clientset.NewAccountClientOrDie()
+			defer utilio.Close(conn)
+			if account == "" {
+				account = getCurrentAccount(ctx, clientset).Username
+			}
+			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+			if all {
+				if !promptUtil.Confirm(fmt.Sprintf("Are you sure you want to revoke all sessions of account '%s'? [y/n]", account)) {
+					fmt.Printf("The command to revoke all sessions of account '%s' was cancelled.\n", account)
+					return
+				}
+				response, err := client.RevokeSessions(ctx, &accountpkg.RevokeSessionsRequest{Name: account})
+				errors.CheckError(err)
+				fmt.Printf("Revoked %d sessions of account '%s'\n", response.Count, account)
+				return
+			}
+			id := args[0]
+			if !promptUtil.Confirm(fmt.Sprintf("Are you sure you want to revoke session '%s'? [y/n]", id)) {
+				fmt.Printf("The command to revoke session '%s' was cancelled.\n", id)
+				return
+			}
+			_, err := client.RevokeSession(ctx, &accountpkg.RevokeSessionRequest{Name: account, Id: id})
+			errors.CheckError(err)
+		},
+	}
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	cmd.Flags().BoolVar(&all, "all", false, "Revoke all sessions of the account")
+	return cmd
+}
@@ -25,10 +25,11 @@ type DashboardConfig struct {
 	ClientOpts   *argocdclient.ClientOptions
 	ClientConfig clientcmd.ClientConfig
 	Context      string
+	ReadOnly     bool
 }
 
 type dashboard struct {
-	startLocalServer func(ctx context.Context, clientOpts *argocdclient.ClientOptions, contextName string, port *int, address *string, clientConfig clientcmd.ClientConfig) (func(), error)
+	startLocalServer func(ctx context.Context, clientOpts *argocdclient.ClientOptions, contextName string, port *int, address *string, clientConfig clientcmd.ClientConfig, readOnly bool) (func(), error)
 }
 
 // NewDashboard initializes a new dashboard with default dependencies
@@ -44,11 +45,15 @@ func (ds *dashboard) Run(ctx context.Context, config *DashboardConfig) error {
 	defer stop()
 	config.ClientOpts.Core = true
 	println("starting dashboard")
-	shutDownFunc, err := ds.startLocalServer(ctx, config.ClientOpts, config.Context, &config.Port, &config.Address, config.ClientConfig)
+	shutDownFunc, err := ds.startLocalServer(ctx, config.ClientOpts, config.Context, &config.Port, &config.Address, config.ClientConfig, config.ReadOnly)
 	if err != nil {
 		return fmt.Errorf("could not start dashboard: %w", err)
 	}
-	fmt.Printf("Argo CD UI is available at http://%s:%d\n", config.Address, config.Port)
+	if config.ReadOnly {
+		fmt.Printf("Argo CD UI is available read-only at http://%s:%d\n", config.Address, config.Port)
+	} else {
+		fmt.Printf("Argo CD UI is available at http://%s:%d\n", config.Address, config.Port)
+	}
 	<-ctx.Done()
 	stop() // unregister the signal handler as soon as we receive a signal
 	println("signal received, shutting down dashboard")
@@ -76,10 +81,14 @@ $ argocd admin dashboard --port 8080 --address 127.0.0.1
 
 # Start the Argo CD Web UI with GZip compression
 $ argocd admin dashboard --redis-compress gzip
+
+# Start the Argo CD Web UI without permitting any changes
+$ argocd admin dashboard --read-only
   `,
 	}
 	config.ClientConfig = cli.AddKubectlFlagsToSet(cmd.Flags())
 	cmd.Flags().IntVar(&config.Port, "port", common.DefaultPortAPIServer, "Listen on given port")
 	cmd.Flags().StringVar(&config.Address, "address", common.DefaultAddressAdminDashboard, "Listen on given address")
+	cmd.Flags().BoolVar(&config.ReadOnly, "read-only", false, "Reject all requests which would change any state, e.g. syncs, refreshes and edits, and disable the terminal")
 	return cmd
 }
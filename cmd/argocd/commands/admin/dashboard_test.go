@@ -16,7 +16,7 @@ import (
 func TestRun_SignalHandling_GracefulShutdown(t *testing.T) {
 	stopCalled := false
 	d := &dashboard{
-		startLocalServer: func(_ context.Context, opts *apiclient.ClientOptions, _ string, _ *int, _ *string, _ clientcmd.ClientConfig) (func(), error) {
+		startLocalServer: func(_ context.Context, opts *apiclient.ClientOptions, _ string, _ *int, _ *string, _ clientcmd.ClientConfig, _ bool) (func(), error) {
 			return func() {
 				stopCalled = true
 				require.True(t, opts.Core, "Core client option should be set to true")
@@ -37,6 +37,7 @@ var resourceMap = map[string]string{
 	"certs":           rbac.ResourceCertificates,
 	"certificate":     rbac.ResourceCertificates,
 	"cluster":         rbac.ResourceClusters,
+	"diagnostics":     rbac.ResourceDiagnostics,
 	"extension":       rbac.ResourceExtensions,
 	"gpgkey":          rbac.ResourceGPGKeys,
 	"key":             rbac.ResourceGPGKeys,
@@ -58,6 +59,7 @@ var validRBACResourcesActions = map[string]actionTraitMap{
 	rbac.ResourceApplicationSets: defaultCRUDActions,
 	rbac.ResourceCertificates:    defaultCRDActions,
 	rbac.ResourceClusters:        defaultCRUDActions,
+	rbac.ResourceDiagnostics:     diagnosticsActions,
 	rbac.ResourceExtensions:      extensionActions,
 	rbac.ResourceGPGKeys:         defaultCRDActions,
 	rbac.ResourceLogs:            logsActions,
@@ -95,6 +97,10 @@ var accountsActions = actionTraitMap{
 	rbac.ActionUpdate: rbacTrait{},
 }
 
+var diagnosticsActions = actionTraitMap{
+	rbac.ActionGet: rbacTrait{},
+}
+
 var execActions = actionTraitMap{
 	rbac.ActionCreate: rbacTrait{},
 }
@@ -104,6 +110,7 @@ var logsActions = actionTraitMap{
 }
 
 var extensionActions = actionTraitMap{
+	rbac.ActionCreate: rbacTrait{},
 	rbac.ActionInvoke: rbacTrait{},
 }
 
@@ -129,6 +136,8 @@ func NewRBACCanCommand() *cobra.Command {
 		useBuiltin   bool
 		strict       bool
 		quiet        bool
+		batchFile    string
+		output       string
 		subject      string
 		action       string
 		resource     string
@@ -141,6 +150,12 @@ func NewRBACCanCommand() *cobra.Command {
 		Long: `
 Check whether a given role or subject has appropriate RBAC permissions to do
 something.
+
+With --batch, the checks of a YAML or JSON file are evaluated instead. Each
+check specifies a subject, action, resource, optional sub-resource and the
+expected result, 'allow' or 'deny'. The results are printed as text, JSON or
+JUnit XML together with the rules of the policy which were exercised by the
+checks, and the command fails if any check does not have the expected result.
 `,
 		Example: `
 # Check whether role some:role has permissions to create an application in the
@@ -159,19 +174,37 @@ argocd admin settings rbac can some:role create application 'default/app' --name
 # You can override a possibly configured default role
 argocd admin settings rbac can someuser create application 'default/app' --default-role role:readonly
 
+# Evaluate a batch of checks against a policy file and write JUnit results,
+# e.g. to gate changes of the RBAC ConfigMap in a CI pipeline. The batch file
+# contains a list of checks like:
+#   - name: readonly users cannot sync
+#     subject: role:readonly
+#     action: sync
+#     resource: applications
+#     subResource: default/app
+#     expect: deny
+argocd admin settings rbac can --batch checks.yaml --policy-file argocd-rbac-cm.yaml --output junit > rbac-results.xml
+
 `,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
-			if len(args) < 3 || len(args) > 4 {
-				c.HelpFunc()(c, args)
-				os.Exit(1)
-			}
-			subject = args[0]
-			action = args[1]
-			resource = args[2]
-			if len(args) > 3 {
-				subResource = args[3]
+			if batchFile != "" {
+				if len(args) > 0 {
+					c.HelpFunc()(c, args)
+					log.Fatalf("ROLE/SUBJECT, ACTION and RESOURCE must not be given with --batch")
+				}
+			} else {
+				if len(args) < 3 || len(args) > 4 {
+					c.HelpFunc()(c, args)
+					os.Exit(1)
+				}
+				subject = args[0]
+				action = args[1]
+				resource = args[2]
+				if len(args) > 3 {
+					subResource = args[3]
+				}
 			}
 
 			namespace, nsOverride, err := clientConfig.Namespace()
@@ -208,6 +241,23 @@ argocd admin settings rbac can someuser create application 'default/app' --defau
 				defaultRole = newDefaultRole
 			}
 
+			if batchFile != "" {
+				checks, err := getRBACChecksFromFile(batchFile)
+				if err != nil {
+					log.Fatalf("could not load batch file: %v", err)
+				}
+				report := checkPolicyBatch(checks, builtinPolicy, userPolicy, defaultRole, matchMode, strict)
+				if !quiet {
+					if err := printRBACBatchReport(os.Stdout, report, output); err != nil {
+						log.Fatalf("could not print results: %v", err)
+					}
+				}
+				if report.Failed > 0 {
+					os.Exit(1)
+				}
+				os.Exit(0)
+			}
+
 			res := checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode, strict)
 			if res {
 				if !quiet {
@@ -227,6 +277,8 @@ argocd admin settings rbac can someuser create application 'default/app' --defau
 	command.Flags().BoolVar(&useBuiltin, "use-builtin-policy", true, "whether to also use builtin-policy")
 	command.Flags().BoolVar(&strict, "strict", true, "whether to perform strict check on action and resource names")
 	command.Flags().BoolVarP(&quiet, "quiet", "q", false, "quiet mode - do not print results to stdout")
+	command.Flags().StringVar(&batchFile, "batch", "", "path to a file with checks to evaluate instead of a single check")
+	command.Flags().StringVarP(&output, "output", "o", "text", "output format of the results of --batch. One of: text|json|junit")
 	return command
 }
 
@@ -375,26 +427,39 @@ func getPolicyConfigMap(ctx context.Context, client kubernetes.Interface, namesp
 // checkPolicy checks whether given subject is allowed to execute specified
 // action against specified resource
 func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode string, strict bool) bool {
+	enf := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+	realResource, realSubResource, err := resolveRBACRequest(action, resource, subResource, strict)
+	if err != nil {
+		log.Fatalf("error in RBAC request: %v", err)
+		return false
+	}
+	return enf.Enforce(subject, realResource, action, realSubResource)
+}
+
+// newPolicyEnforcer returns an enforcer for the given built-in and user policies
+func newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode string) *rbac.Enforcer {
 	enf := rbac.NewEnforcer(nil, "argocd", "argocd-rbac-cm", nil)
 	enf.SetDefaultRole(defaultRole)
 	enf.SetMatchMode(matchMode)
 	if builtinPolicy != "" {
 		if err := enf.SetBuiltinPolicy(builtinPolicy); err != nil {
 			log.Fatalf("could not set built-in policy: %v", err)
-			return false
 		}
 	}
 	if userPolicy != "" {
 		if err := rbac.ValidatePolicy(userPolicy); err != nil {
 			log.Fatalf("invalid user policy: %v", err)
-			return false
 		}
 		if err := enf.SetUserPolicy(userPolicy); err != nil {
 			log.Fatalf("could not set user policy: %v", err)
-			return false
 		}
 	}
+	return enf
+}
 
+// resolveRBACRequest resolves the resource and sub-resource of a RBAC request
+// to their RBAC notation, and validates the request in strict mode
+func resolveRBACRequest(action, resource, subResource string, strict bool) (string, string, error) {
 	// User could have used a mutation of the resource name (i.e. 'cert' for
 	// 'certificate') - let's resolve it to the valid resource.
 	realResource := resolveRBACResourceName(resource)
@@ -403,8 +468,7 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 	// actually valid tokens.
 	if strict {
 		if err := validateRBACResourceAction(realResource, action); err != nil {
-			log.Fatalf("error in RBAC request: %v", err)
-			return false
+			return "", "", err
 		}
 	}
 
@@ -416,7 +480,7 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 			subResource = "*/*"
 		}
 	}
-	return enf.Enforce(subject, realResource, action, subResource)
+	return realResource, subResource, nil
 }
 
 // resolveRBACResourceName resolves a user supplied value to a valid RBAC
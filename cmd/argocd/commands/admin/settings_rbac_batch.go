@@ -0,0 +1,260 @@
+package admin
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	rbacCheckAllow = "allow"
+	rbacCheckDeny  = "deny"
+)
+
+// rbacCheck is a single check of a batch file, i.e. a RBAC request and its expected result
+type rbacCheck struct {
+	// Name optionally identifies the check in the results
+	Name        string `json:"name,omitempty"`
+	Subject     string `json:"subject"`
+	Action      string `json:"action"`
+	Resource    string `json:"resource"`
+	SubResource string `json:"subResource,omitempty"`
+	// Expect is the expected result of the request, either 'allow' or 'deny'
+	Expect string `json:"expect"`
+}
+
+// displayName returns the name of the check, or a name derived from the request if it has none
+func (c rbacCheck) displayName() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return strings.TrimSpace(strings.Join([]string{c.Subject, c.Action, c.Resource, c.SubResource}, " "))
+}
+
+// rbacCheckResult is the result of evaluating a single check
+type rbacCheckResult struct {
+	rbacCheck
+	// Result is the actual result of the request, either 'allow' or 'deny'
+	Result string `json:"result,omitempty"`
+	Passed bool   `json:"passed"`
+	// MatchedPolicy is the policy rule which decided the result, if any
+	MatchedPolicy string `json:"matchedPolicy,omitempty"`
+	// Error is set if the check could not be evaluated
+	Error string `json:"error,omitempty"`
+}
+
+// message returns a human readable description why the check failed
+func (r rbacCheckResult) message() string {
+	if r.Error != "" {
+		return r.Error
+	}
+	return fmt.Sprintf("expected %s, got %s", r.Expect, r.Result)
+}
+
+// rbacPolicyCoverage contains the policy rules of the user policy which decided
+// the result of at least one check, and those which did not
+type rbacPolicyCoverage struct {
+	Total       int      `json:"total"`
+	Exercised   []string `json:"exercised"`
+	Unexercised []string `json:"unexercised"`
+}
+
+// rbacBatchReport is the result of evaluating a batch file
+type rbacBatchReport struct {
+	Results  []rbacCheckResult  `json:"results"`
+	Passed   int                `json:"passed"`
+	Failed   int                `json:"failed"`
+	Coverage rbacPolicyCoverage `json:"coverage"`
+}
+
+// getRBACChecksFromFile loads the checks of a batch file in YAML or JSON format
+func getRBACChecksFromFile(batchFile string) ([]rbacCheck, error) {
+	data, err := os.ReadFile(batchFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading batch file: %w", err)
+	}
+	var checks []rbacCheck
+	if err := yaml.UnmarshalStrict(data, &checks); err != nil {
+		return nil, fmt.Errorf("error parsing batch file: %w", err)
+	}
+	for i, check := range checks {
+		if check.Subject == "" || check.Action == "" || check.Resource == "" {
+			return nil, fmt.Errorf("check %d: subject, action and resource are required", i+1)
+		}
+		if check.Expect != rbacCheckAllow && check.Expect != rbacCheckDeny {
+			return nil, fmt.Errorf("check %d: expect must be either '%s' or '%s'", i+1, rbacCheckAllow, rbacCheckDeny)
+		}
+	}
+	return checks, nil
+}
+
+// checkPolicyBatch evaluates the given checks and computes which rules of the
+// user policy decided the result of at least one of them
+func checkPolicyBatch(checks []rbacCheck, builtinPolicy, userPolicy, defaultRole, matchMode string, strict bool) rbacBatchReport {
+	enf := newPolicyEnforcer(builtinPolicy, userPolicy, defaultRole, matchMode)
+	rules := getPolicyRules(userPolicy)
+	exercised := make(map[string]bool)
+
+	report := rbacBatchReport{Results: make([]rbacCheckResult, 0, len(checks))}
+	for _, check := range checks {
+		result := rbacCheckResult{rbacCheck: check}
+		resource, subResource, err := resolveRBACRequest(check.Action, check.Resource, check.SubResource, strict)
+		if err != nil {
+			result.Error = fmt.Sprintf("error in RBAC request: %v", err)
+		} else {
+			allowed, rule := enf.EnforceEx(check.Subject, resource, check.Action, subResource)
+			result.Result = rbacCheckDeny
+			if allowed {
+				result.Result = rbacCheckAllow
+			}
+			result.Passed = result.Result == check.Expect
+			if len(rule) > 0 {
+				result.MatchedPolicy = "p, " + strings.Join(rule, ", ")
+				exercised[strings.Join(rule, "\x00")] = true
+			}
+		}
+		if result.Passed {
+			report.Passed++
+		} else {
+			report.Failed++
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	report.Coverage = rbacPolicyCoverage{Total: len(rules), Exercised: []string{}, Unexercised: []string{}}
+	for _, rule := range rules {
+		if exercised[strings.Join(rule.tokens, "\x00")] {
+			report.Coverage.Exercised = append(report.Coverage.Exercised, rule.line)
+		} else {
+			report.Coverage.Unexercised = append(report.Coverage.Unexercised, rule.line)
+		}
+	}
+	return report
+}
+
+type policyRule struct {
+	line   string
+	tokens []string
+}
+
+// getPolicyRules returns the 'p' rules of the given policy. The policy is
+// expected to be valid.
+func getPolicyRules(policy string) []policyRule {
+	var rules []policyRule
+	for _, line := range strings.Split(policy, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		reader := csv.NewReader(strings.NewReader(line))
+		reader.TrimLeadingSpace = true
+		tokens, err := reader.Read()
+		if err != nil || len(tokens) != 6 || tokens[0] != "p" {
+			continue
+		}
+		rules = append(rules, policyRule{line: line, tokens: tokens[1:]})
+	}
+	return rules
+}
+
+// printRBACBatchReport prints the report in the given output format
+func printRBACBatchReport(w io.Writer, report rbacBatchReport, output string) error {
+	switch output {
+	case "", "text":
+		for _, result := range report.Results {
+			if result.Passed {
+				_, _ = fmt.Fprintf(w, "PASS %s\n", result.displayName())
+			} else {
+				_, _ = fmt.Fprintf(w, "FAIL %s: %s\n", result.displayName(), result.message())
+			}
+		}
+		_, _ = fmt.Fprintf(w, "\n%d passed, %d failed\n", report.Passed, report.Failed)
+		_, _ = fmt.Fprintf(w, "Policy coverage: %d of %d rules exercised\n", len(report.Coverage.Exercised), report.Coverage.Total)
+		for _, line := range report.Coverage.Unexercised {
+			_, _ = fmt.Fprintf(w, "  not exercised: %s\n", line)
+		}
+		return nil
+	case "json":
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case "junit":
+		data, err := xml.MarshalIndent(newJUnitTestSuites(report), "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s%s\n", xml.Header, data)
+		return err
+	default:
+		return errors.New("unknown output format: " + output)
+	}
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Properties []junitProperty `xml:"properties>property"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// newJUnitTestSuites converts the report to JUnit test suites, with one test
+// case per check and the policy coverage as properties
+func newJUnitTestSuites(report rbacBatchReport) junitTestSuites {
+	suite := junitTestSuite{
+		Name:     "rbac",
+		Tests:    len(report.Results),
+		Failures: report.Failed,
+		Properties: []junitProperty{
+			{Name: "coverage.total", Value: fmt.Sprint(report.Coverage.Total)},
+			{Name: "coverage.exercised", Value: fmt.Sprint(len(report.Coverage.Exercised))},
+		},
+	}
+	for _, line := range report.Coverage.Unexercised {
+		suite.Properties = append(suite.Properties, junitProperty{Name: "coverage.unexercised", Value: line})
+	}
+	for _, result := range report.Results {
+		testCase := junitTestCase{Name: result.displayName(), ClassName: "rbac"}
+		if !result.Passed {
+			testCase.Failure = &junitFailure{
+				Message: result.message(),
+				Text:    fmt.Sprintf("subject=%s action=%s resource=%s subResource=%s matchedPolicy=%s", result.Subject, result.Action, result.Resource, result.SubResource, result.MatchedPolicy),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	return junitTestSuites{Tests: suite.Tests, Failures: suite.Failures, Suites: []junitTestSuite{suite}}
+}
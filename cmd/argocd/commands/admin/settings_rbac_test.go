@@ -1,7 +1,11 @@
 package admin
 
 import (
+	"bytes"
+	"encoding/json"
+	"io"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -292,6 +296,96 @@ p, role:, certificates, get, .*, allow`
 	})
 }
 
+func Test_getRBACChecksFromFile(t *testing.T) {
+	checks, err := getRBACChecksFromFile("testdata/rbac/checks.yaml")
+	require.NoError(t, err)
+	require.Len(t, checks, 4)
+	assert.Equal(t, rbacCheck{Name: "user can get clusters", Subject: "role:user", Action: "get", Resource: "clusters", SubResource: "*", Expect: "allow"}, checks[0])
+
+	invalidFile := filepath.Join(t.TempDir(), "checks.yaml")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("- subject: role:user\n  action: get\n  resource: clusters\n  expect: maybe\n"), 0o600))
+	_, err = getRBACChecksFromFile(invalidFile)
+	require.ErrorContains(t, err, "check 1: expect must be either 'allow' or 'deny'")
+}
+
+func Test_checkPolicyBatch(t *testing.T) {
+	ctx := t.Context()
+	uPol, dRole, matchMode := getPolicy(ctx, "testdata/rbac/policy.csv", nil, "")
+	checks, err := getRBACChecksFromFile("testdata/rbac/checks.yaml")
+	require.NoError(t, err)
+
+	report := checkPolicyBatch(checks, assets.BuiltinPolicyCSV, uPol, dRole, matchMode, true)
+	assert.Equal(t, 2, report.Passed)
+	assert.Equal(t, 2, report.Failed)
+
+	assert.True(t, report.Results[0].Passed)
+	assert.Equal(t, "allow", report.Results[0].Result)
+	assert.Equal(t, "p, role:user, clusters, get, *, allow", report.Results[0].MatchedPolicy)
+	assert.True(t, report.Results[1].Passed)
+	assert.Equal(t, "p, role:user, applications, delete, */guestbook, deny", report.Results[1].MatchedPolicy)
+	assert.False(t, report.Results[2].Passed)
+	assert.Equal(t, "allow", report.Results[2].Result)
+	assert.False(t, report.Results[3].Passed)
+	assert.Contains(t, report.Results[3].Error, "'sync' is not a valid action for clusters")
+
+	assert.Equal(t, 14, report.Coverage.Total)
+	assert.Equal(t, []string{
+		"p, role:user, clusters, get, *, allow",
+		"p, role:user, applications, create, */*, allow",
+		"p, role:user, applications, delete, */guestbook, deny",
+	}, report.Coverage.Exercised)
+	assert.Len(t, report.Coverage.Unexercised, 11)
+}
+
+func Test_printRBACBatchReport(t *testing.T) {
+	report := rbacBatchReport{
+		Results: []rbacCheckResult{
+			{rbacCheck: rbacCheck{Subject: "role:user", Action: "get", Resource: "clusters", Expect: "allow"}, Result: "allow", Passed: true, MatchedPolicy: "p, role:user, clusters, get, *, allow"},
+			{rbacCheck: rbacCheck{Name: "no delete", Subject: "role:user", Action: "delete", Resource: "clusters", Expect: "deny"}, Result: "allow"},
+		},
+		Passed: 1,
+		Failed: 1,
+		Coverage: rbacPolicyCoverage{
+			Total:       2,
+			Exercised:   []string{"p, role:user, clusters, get, *, allow"},
+			Unexercised: []string{"p, role:user, clusters, delete, *, allow"},
+		},
+	}
+
+	t.Run("text", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printRBACBatchReport(&out, report, "text"))
+		assert.Equal(t, `PASS role:user get clusters
+FAIL no delete: expected deny, got allow
+
+1 passed, 1 failed
+Policy coverage: 1 of 2 rules exercised
+  not exercised: p, role:user, clusters, delete, *, allow
+`, out.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printRBACBatchReport(&out, report, "json"))
+		var parsed rbacBatchReport
+		require.NoError(t, json.Unmarshal(out.Bytes(), &parsed))
+		assert.Equal(t, report, parsed)
+	})
+
+	t.Run("junit", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printRBACBatchReport(&out, report, "junit"))
+		assert.Contains(t, out.String(), `<testsuites tests="2" failures="1">`)
+		assert.Contains(t, out.String(), `<testcase name="role:user get clusters" classname="rbac"></testcase>`)
+		assert.Contains(t, out.String(), `<failure message="expected deny, got allow">`)
+		assert.Contains(t, out.String(), `<property name="coverage.exercised" value="1"></property>`)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		require.ErrorContains(t, printRBACBatchReport(io.Discard, report, "yaml"), "unknown output format: yaml")
+	})
+}
+
 func TestNewRBACCanCommand(t *testing.T) {
 	command := NewRBACCanCommand()
 
@@ -26,6 +26,7 @@ import (
 	"github.com/mattn/go-isatty"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -39,13 +40,10 @@ import (
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
-	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/controller"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 
-	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
-
 	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
@@ -96,9 +94,11 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
+	command.AddCommand(NewApplicationSyncPlanCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
+	command.AddCommand(NewApplicationMoveCommand(clientOpts))
 	command.AddCommand(NewApplicationGetResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteResourceCommand(clientOpts))
@@ -851,6 +851,9 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 		appNamespace   string
 		sourcePosition int
 		sourceName     string
+		patch          string
+		patchFile      string
+		patchType      string
 	)
 	command := &cobra.Command{
 		Use:   "set APPNAME",
@@ -870,6 +873,12 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 
   # Set application parameters and specify the namespace
   argocd app set my-app --parameter key1=value1 --parameter key2=value2 --namespace my-namespace
+
+  # Update the source path of "my-app" using a JSON patch
+  argocd app set my-app --patch '[{"op": "replace", "path": "/spec/source/path", "value": "guestbook"}]'
+
+  # Update the destination namespace of "my-app" using a strategic merge patch read from a file
+  argocd app set my-app --patch-file patch.yaml --patch-type strategic
   		`),
 
 		Run: func(c *cobra.Command, args []string) {
@@ -883,6 +892,20 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			argocdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := argocdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
+
+			if patch != "" || patchFile != "" {
+				patchBody, err := getApplicationPatch(c.Flags(), patch, patchFile)
+				errors.CheckError(err)
+				_, err = appIf.Patch(ctx, &application.ApplicationPatchRequest{
+					Name:         &appName,
+					Patch:        &patchBody,
+					PatchType:    &patchType,
+					AppNamespace: &appNs,
+				})
+				errors.CheckError(err)
+				return
+			}
+
 			app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
 			errors.CheckError(err)
 
@@ -929,9 +952,44 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 	cmdutil.AddAppFlags(command, &appOpts)
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Set application parameters in namespace")
 	command.Flags().IntVar(&sourcePosition, "source-position", -1, "Position of the source from the list of sources of the app. Counting starts at 1.")
+	command.Flags().StringVar(&patch, "patch", "", "Patch to apply to the application instead of setting individual parameters")
+	command.Flags().StringVar(&patchFile, "patch-file", "", "Path to a JSON or YAML file containing the patch to apply to the application")
+	command.Flags().StringVar(&patchType, "patch-type", "json", "The type of the patch; one of [json merge strategic]")
+	command.MarkFlagsMutuallyExclusive("patch", "patch-file")
 	return command
 }
 
+// getApplicationPatch returns the patch body of the app set command. The patch is applied atomically by the API server,
+// so it cannot be combined with the flags which set individual application parameters.
+func getApplicationPatch(flags *pflag.FlagSet, patch string, patchFile string) (string, error) {
+	appFlags := &cobra.Command{}
+	cmdutil.AddAppFlags(appFlags, &cmdutil.AppOptions{})
+	var changed []string
+	appFlags.Flags().VisitAll(func(f *pflag.Flag) {
+		if flags.Changed(f.Name) {
+			changed = append(changed, "--"+f.Name)
+		}
+	})
+	if flags.Changed("source-position") {
+		changed = append(changed, "--source-position")
+	}
+	if len(changed) > 0 {
+		return "", fmt.Errorf("--patch and --patch-file cannot be combined with %s", strings.Join(changed, ", "))
+	}
+	if patchFile == "" {
+		return patch, nil
+	}
+	data, err := os.ReadFile(patchFile)
+	if err != nil {
+		return "", fmt.Errorf("error reading patch file %s: %w", patchFile, err)
+	}
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		return "", fmt.Errorf("error converting patch file %s to JSON: %w", patchFile, err)
+	}
+	return string(data), nil
+}
+
 // unsetOpts describe what to unset in an Application.
 type unsetOpts struct {
 	namePrefix              bool
@@ -1232,6 +1290,7 @@ func getLocalObjectsString(ctx context.Context, app *argoappv1.Application, proj
 		TrackingMethod:                  trackingMethod,
 		ProjectName:                     proj.Name,
 		ProjectSourceRepos:              proj.Spec.SourceRepos,
+		ProjectSourcePaths:              proj.GetSourcePaths(),
 		AnnotationManifestGeneratePaths: app.GetAnnotation(argoappv1.AnnotationKeyManifestGeneratePaths),
 	}, true, &git.NoopCredsStore{}, resource.MustParse("0"), nil)
 	errors.CheckError(err)
@@ -1257,7 +1316,7 @@ func groupObjsByKey(localObs []*unstructured.Unstructured, liveObjs []*unstructu
 			namespacedByGk[schema.GroupKind{Group: key.Group, Kind: key.Kind}] = key.Namespace != ""
 		}
 	}
-	localObs, _, err := controller.DeduplicateTargetObjects(appNamespace, localObs, &resourceInfoProvider{namespacedByGk: namespacedByGk})
+	localObs, _, err := controller.DeduplicateTargetObjects(appNamespace, localObs, &resourceInfoProvider{namespacedByGk: namespacedByGk}, nil)
 	errors.CheckError(err)
 	objByKey := make(map[kube.ResourceKey]*unstructured.Unstructured)
 	for i := range localObs {
@@ -1350,7 +1409,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			errors.CheckError(err)
 			diffOption := &DifferenceOption{}
 
-			hasServerSideDiffAnnotation := resourceutil.HasAnnotationOption(app, argocommon.AnnotationCompareOptions, "ServerSideDiff=true")
+			hasServerSideDiffAnnotation := argodiff.ApplicationDiffEngine(app, argodiff.DiffEngineClientSide) == argodiff.DiffEngineServerSide
 
 			// Use annotation if flag not explicitly set
 			if !c.Flags().Changed("server-side-diff") {
@@ -1795,6 +1854,7 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		appNamespace string
 		cluster      string
 		path         string
+		chunkSize    int64
 	)
 	command := &cobra.Command{
 		Use:   "list",
@@ -1813,13 +1873,17 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 
 			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 			defer utilio.Close(conn)
-			apps, err := appIf.List(ctx, &application.ApplicationQuery{
+			query := &application.ApplicationQuery{
 				Selector:     ptr.To(selector),
 				AppNamespace: &appNamespace,
-			})
-
+				Limit:        ptr.To(chunkSize),
+			}
+			if output == "wide" || output == "name" || output == "" {
+				// The tables and names do not need the largest fields of the Applications
+				query.ExcludeFields = []string{"metadata.managedFields", "status.resources", "status.history"}
+			}
+			appList, err := listApplications(ctx, appIf, query)
 			errors.CheckError(err)
-			appList := apps.Items
 
 			if len(projects) != 0 {
 				appList = argo.FilterByProjects(appList, projects)
@@ -1854,9 +1918,27 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only list applications in namespace")
 	command.Flags().StringVarP(&cluster, "cluster", "c", "", "List apps by cluster name or url")
 	command.Flags().StringVarP(&path, "path", "P", "", "List apps by path")
+	command.Flags().Int64Var(&chunkSize, "chunk-size", 500, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
 	return command
 }
 
+// listApplications lists the applications matching the given query, one page at a time if the query has a limit
+func listApplications(ctx context.Context, appIf application.ApplicationServiceClient, query *application.ApplicationQuery) ([]argoappv1.Application, error) {
+	var apps []argoappv1.Application
+	for {
+		list, err := appIf.List(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, list.Items...)
+		// The servers not supporting pagination return all the applications without a continue token
+		if list.Continue == "" {
+			return apps, nil
+		}
+		query.Continue = ptr.To(list.Continue)
+	}
+}
+
 func formatSyncPolicy(app argoappv1.Application) string {
 	if app.Spec.SyncPolicy == nil || !app.Spec.SyncPolicy.IsAutomatedSyncEnabled() {
 		return "Manual"
@@ -2105,6 +2187,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		output                  string
 		appNamespace            string
 		ignoreNormalizerOpts    normalizers.IgnoreNormalizerOpts
+		waitTimeoutBehavior     string
 	)
 	command := &cobra.Command{
 		Use:   "sync [APPNAME... | -l selector | --project project-name]",
@@ -2171,6 +2254,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			if waitTimeoutBehavior != waitTimeoutBehaviorExit && waitTimeoutBehavior != waitTimeoutBehaviorTerminate {
+				log.Fatalf("Unknown wait timeout behavior: '%s'", waitTimeoutBehavior)
+			}
+
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
@@ -2220,6 +2307,9 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			// exitCode is the most severe exit code reported by the sync operations of the applications, if any
+			exitCode := 0
+			failedApps := 0
 			for _, appQualifiedName := range appNames {
 				// Construct QualifiedName
 				if appNamespace != "" && !strings.Contains(appQualifiedName, "/") {
@@ -2390,14 +2480,14 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 
 					proj := getProject(ctx, c, clientOpts, app.Spec.Project)
 
-					// Check if application has ServerSideDiff annotation
-					serverSideDiff := resourceutil.HasAnnotationOption(app, argocommon.AnnotationCompareOptions, "ServerSideDiff=true")
+					// Check if application selects the server-side diff engine
+					serverSideDiff := argodiff.ApplicationDiffEngine(app, argodiff.DiffEngineClientSide) == argodiff.DiffEngineServerSide
 
 					foundDiffs = findAndPrintDiff(ctx, app, proj.Project, resources, argoSettings, diffOption, ignoreNormalizerOpts, serverSideDiff, appIf, appName, appNs)
 					if !foundDiffs {
 						fmt.Printf("====== No Differences found ======\n")
 						// if no differences found, then no need to sync
-						return
+						continue
 					}
 					if !diffChangesConfirm {
 						yesno := cli.AskToProceed(fmt.Sprintf("Please review changes to application %s shown above. Do you want to continue the sync process? (y/n): ", appQualifiedName))
@@ -2410,22 +2500,20 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				errors.CheckError(err)
 
 				if !async {
-					app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources, output)
-					errors.CheckError(err)
-
-					if !dryRun {
-						if !opState.Phase.Successful() {
-							log.Fatalf("Operation has completed with phase: %s", opState.Phase)
-						} else if len(selectedResources) == 0 && app.Status.Sync.Status != argoappv1.SyncStatusCodeSynced {
-							// Only get resources to be pruned if sync was application-wide and final status is not synced
-							pruningRequired := opState.SyncResult.Resources.PruningRequired()
-							if pruningRequired > 0 {
-								log.Fatalf("%d resources require pruning", pruningRequired)
-							}
+					code, err := waitOnSyncOperation(ctx, acdClient, appIf, appQualifiedName, timeout, selectedResources, output, dryRun, waitTimeoutBehavior)
+					if code != 0 {
+						// keep waiting on the remaining applications and report the most severe outcome at the end
+						log.Error(err)
+						failedApps++
+						if exitCode == 0 || code < exitCode {
+							exitCode = code
 						}
 					}
 				}
 			}
+			if exitCode != 0 {
+				errors.Fatalf(exitCode, "%d of %d applications did not sync successfully", failedApps, len(appNames))
+			}
 		},
 	}
 	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
@@ -2435,6 +2523,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Sync apps that match this label. Supports '=', '==', '!=', in, notin, exists & not exists. Matching apps must satisfy all of the specified label constraints.")
 	command.Flags().StringArrayVar(&labels, "label", []string{}, "Sync only specific resources with a label. This option may be specified repeatedly.")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
+	command.Flags().StringVar(&waitTimeoutBehavior, "wait-timeout-behavior", waitTimeoutBehaviorExit, fmt.Sprintf("Behavior if the sync operation does not complete before the timeout. One of: %s (leave the operation running)|%s (terminate the operation)", waitTimeoutBehaviorExit, waitTimeoutBehaviorTerminate))
 	command.Flags().Int64Var(&retryLimit, "retry-limit", 0, "Max number of allowed sync retries")
 	command.Flags().BoolVar(&retryRefresh, "retry-refresh", false, "Indicates if the latest revision should be used on retry instead of the initial one")
 	command.Flags().DurationVar(&retryBackoffDuration, "retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
@@ -2880,7 +2969,79 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 		_ = w.Flush()
 	}
 	_ = printFinalStatus(appWithLock.GetApp())
-	return nil, finalOperationState, fmt.Errorf("timed out (%ds) waiting for app %q match desired state", timeout, appName)
+	return nil, finalOperationState, &waitTimeoutError{timeout: timeout, appName: appName}
+}
+
+// waitOnSyncOperation waits for the sync operation of the application to complete and returns the exit code reporting
+// its outcome along with an error describing it. If the operation succeeded while the application is still progressing,
+// it also waits for the application to become healthy or degraded, so that ErrorDegradedAfterSync is not missed.
+func waitOnSyncOperation(ctx context.Context, acdClient argocdclient.Client, appIf application.ApplicationServiceClient, appQualifiedName string, timeout uint, selectedResources []*argoappv1.SyncOperationResource, output string, dryRun bool, waitTimeoutBehavior string) (int, error) {
+	appName, appNs := argo.ParseFromQualifiedName(appQualifiedName, "")
+	start := time.Now()
+	app, opState, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources, output)
+	var timeoutErr *waitTimeoutError
+	if stderrors.As(err, &timeoutErr) {
+		if waitTimeoutBehavior == waitTimeoutBehaviorTerminate {
+			_, terminateErr := appIf.TerminateOperation(ctx, &application.OperationTerminateRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			if terminateErr != nil {
+				return errors.ErrorGeneric, fmt.Errorf("failed to terminate operation of application '%s': %w", appQualifiedName, terminateErr)
+			}
+			fmt.Printf("Application '%s' operation terminating\n", appQualifiedName)
+		}
+		return errors.ErrorWaitTimeout, err
+	}
+	if err != nil {
+		return errors.ErrorGeneric, err
+	}
+	if dryRun {
+		return 0, nil
+	}
+
+	if !opState.Phase.Successful() {
+		return errors.ErrorSyncFailed, fmt.Errorf("operation of application '%s' has completed with phase: %s", appQualifiedName, opState.Phase)
+	} else if len(selectedResources) == 0 && app.Status.Sync.Status != argoappv1.SyncStatusCodeSynced {
+		// Only get resources to be pruned if sync was application-wide and final status is not synced
+		pruningRequired := opState.SyncResult.Resources.PruningRequired()
+		if pruningRequired > 0 {
+			return errors.ErrorSyncFailed, fmt.Errorf("%d resources of application '%s' require pruning", pruningRequired, appQualifiedName)
+		}
+	}
+
+	if app.Status.Health.Status == health.HealthStatusProgressing {
+		// the remaining time of the timeout applies to waiting for the health, at least one second
+		healthTimeout := timeout
+		if timeout != 0 {
+			healthTimeout = 1
+			if elapsed := uint(time.Since(start) / time.Second); elapsed < timeout {
+				healthTimeout = timeout - elapsed
+			}
+		}
+		app, _, err = waitOnApplicationStatus(ctx, acdClient, appQualifiedName, healthTimeout, watchOpts{health: true, degraded: true, suspended: true}, selectedResources, output)
+		if stderrors.As(err, &timeoutErr) {
+			return errors.ErrorWaitTimeout, err
+		}
+		if err != nil {
+			// waitOnApplicationStatus only fails here if a resource transitioned to degraded
+			return errors.ErrorDegradedAfterSync, err
+		}
+	}
+	if app.Status.Health.Status == health.HealthStatusDegraded {
+		return errors.ErrorDegradedAfterSync, fmt.Errorf("application '%s' is degraded after sync", appQualifiedName)
+	}
+	return 0, nil
+}
+
+// waitTimeoutError is returned by waitOnApplicationStatus if the application did not match the desired state in time
+type waitTimeoutError struct {
+	timeout uint
+	appName string
+}
+
+func (e *waitTimeoutError) Error() string {
+	return fmt.Sprintf("timed out (%ds) waiting for app %q match desired state", e.timeout, e.appName)
 }
 
 // setParameterOverrides updates an existing or appends a new parameter override in the application
@@ -3097,6 +3258,13 @@ const (
 	defaultCheckTimeoutSeconds = 0
 )
 
+const (
+	// waitTimeoutBehaviorExit leaves the sync operation running if waiting for it timed out
+	waitTimeoutBehaviorExit = "exit"
+	// waitTimeoutBehaviorTerminate terminates the sync operation if waiting for it timed out
+	waitTimeoutBehaviorTerminate = "terminate"
+)
+
 func printOperationResult(opState *argoappv1.OperationState) {
 	if opState == nil {
 		return
@@ -3122,6 +3290,14 @@ func printOperationResult(opState *argoappv1.OperationState) {
 	if opState.Message != "" {
 		fmt.Printf(printOpFmtStr, "Message:", opState.Message)
 	}
+	if len(opState.PendingPrune) > 0 {
+		pendingPrune := make([]string, len(opState.PendingPrune))
+		for i, res := range opState.PendingPrune {
+			pendingPrune[i] = fmt.Sprintf("%s/%s", res.Kind, res.Name)
+		}
+		fmt.Printf(printOpFmtStr, "Pending Prune:", strings.Join(pendingPrune, ", "))
+		fmt.Println("Run 'argocd app confirm-deletion' to confirm pruning of these resources")
+	}
 }
 
 // NewApplicationManifestsCommand returns a new instance of an `argocd app manifests` command
@@ -3284,11 +3460,127 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationSyncPlanCommand returns a new instance of an `argocd app sync-plan` command
+func NewApplicationSyncPlanCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		revision        string
+		revisions       []string
+		sourcePositions []int64
+		sourceNames     []string
+		skipHooks       bool
+		output          string
+	)
+	command := &cobra.Command{
+		Use:   "sync-plan APPNAME",
+		Short: "Print the order in which a sync of an application applies its resources",
+		Example: templates.Examples(`
+  # Print the phases, waves and hooks of a sync of an application
+  argocd app sync-plan my-app
+
+  # Print the apply order of a sync of an application at a specific revision
+  argocd app sync-plan my-app --revision 0.0.2
+
+  # Print the apply order of a sync of a multi-source application at specific revisions for specific sources
+  argocd app sync-plan my-app --revisions 0.0.1 --source-names src-base --revisions 0.0.2 --source-names src-values
+  		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			if len(sourceNames) > 0 && len(sourcePositions) > 0 {
+				errors.Fatal(errors.ErrorGeneric, "Only one of source-positions and source-names can be specified.")
+			}
+
+			if len(sourcePositions) > 0 && len(revisions) != len(sourcePositions) {
+				errors.Fatal(errors.ErrorGeneric, "While using --revisions and --source-positions, length of values for both flags should be same.")
+			}
+
+			if len(sourceNames) > 0 && len(revisions) != len(sourceNames) {
+				errors.Fatal(errors.ErrorGeneric, "While using --revisions and --source-names, length of values for both flags should be same.")
+			}
+
+			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			if len(sourceNames) > 0 {
+				app, err := appIf.Get(ctx, &application.ApplicationQuery{
+					Name:         &appName,
+					AppNamespace: &appNs,
+				})
+				errors.CheckError(err)
+
+				sourceNameToPosition := getSourceNameToPositionMap(app)
+				for _, name := range sourceNames {
+					pos, ok := sourceNameToPosition[name]
+					if !ok {
+						log.Fatalf("Unknown source name '%s'", name)
+					}
+					sourcePositions = append(sourcePositions, pos)
+				}
+			}
+
+			res, err := appIf.SyncPlan(ctx, &application.ApplicationSyncPlanQuery{
+				Name:            &appName,
+				AppNamespace:    &appNs,
+				Revision:        ptr.To(revision),
+				Revisions:       revisions,
+				SourcePositions: sourcePositions,
+				SkipHooks:       ptr.To(skipHooks),
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(res.Steps, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printSyncPlanTable(res.Steps)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&revision, "revision", "", "Plan the sync at a specific revision")
+	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Plan the sync at specific revisions for the source at position in source-positions")
+	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
+	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
+	command.Flags().BoolVar(&skipHooks, "skip-hooks", false, "Plan the sync without running hooks")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// printSyncPlanTable prints the resources applied by a sync in the order they are applied
+func printSyncPlanTable(steps []*application.SyncPlanStep) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "PHASE\tWAVE\tHOOK\tGROUP\tKIND\tNAMESPACE\tNAME\n")
+	for _, step := range steps {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\n", step.GetPhase(), step.GetWave(), strings.Join(step.HookTypes, ","), step.GetGroup(), step.GetKind(), step.GetNamespace(), step.GetName())
+	}
+	_ = w.Flush()
+}
+
 // NewApplicationTerminateOpCommand returns a new instance of an `argocd app terminate-op` command
 func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		reason string
+		force  bool
+	)
 	command := &cobra.Command{
 		Use:   "terminate-op APPNAME",
 		Short: "Terminate running operation of an application",
+		Example: `  # Terminate the running operation of an application
+  argocd app terminate-op my-app
+
+  # Terminate the running operation and record why it was terminated
+  argocd app terminate-op my-app --reason "migration job is stuck"
+
+  # Abort running hooks without grace period, e.g. if a previous termination does not complete
+  argocd app terminate-op my-app --force`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -3299,14 +3591,28 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 			appName, appNs := argo.ParseFromQualifiedName(args[0], "")
 			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 			defer utilio.Close(conn)
-			_, err := appIf.TerminateOperation(ctx, &application.OperationTerminateRequest{
+			resp, err := appIf.TerminateOperation(ctx, &application.OperationTerminateRequest{
 				Name:         &appName,
 				AppNamespace: &appNs,
+				Reason:       &reason,
+				Force:        &force,
 			})
 			errors.CheckError(err)
 			fmt.Printf("Application '%s' operation terminating\n", appName)
+			if state := resp.GetOperationState(); state != nil {
+				fmt.Printf("Phase: %s\n", state.Phase)
+				if state.SyncResult != nil {
+					for _, res := range state.SyncResult.Resources {
+						if res.HookPhase == common.OperationRunning {
+							fmt.Printf("Running hook: %s/%s/%s\n", res.Kind, res.Namespace, res.Name)
+						}
+					}
+				}
+			}
 		},
 	}
+	command.Flags().StringVar(&reason, "reason", "", "Reason for the termination, recorded in the operation state and the application events")
+	command.Flags().BoolVar(&force, "force", false, "Abort running hooks without grace period and without waiting for their dependents to be deleted")
 	return command
 }
 
@@ -3371,6 +3677,43 @@ func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	return command
 }
 
+// NewApplicationMoveCommand returns a new instance of an `argocd app move` command
+func NewApplicationMoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		project      string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "move APPNAME",
+		Short: "Move an application to another project",
+		Long:  "Move an application to another project. The application is validated against the destinations and source repositories of the target project and keeps its history.",
+		Example: `  # Move an application to the project my-project
+  argocd app move my-app --project my-project`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 || project == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			app, err := appIf.Move(ctx, &application.ApplicationMoveRequest{
+				Name:          &appName,
+				AppNamespace:  &appNs,
+				TargetProject: &project,
+			})
+			errors.CheckError(err)
+			fmt.Printf("application '%s' moved to project '%s'\n", app.QualifiedName(), app.Spec.GetProject())
+		},
+	}
+	command.Flags().StringVarP(&project, "project", "p", "", "Project to move the application to")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application to move")
+	return command
+}
+
 func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		patch        string
@@ -3413,7 +3756,7 @@ func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	}
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only patch application in namespace")
 	command.Flags().StringVar(&patch, "patch", "", "Patch body")
-	command.Flags().StringVar(&patchType, "type", "json", "The type of patch being provided; one of [json merge]")
+	command.Flags().StringVar(&patchType, "type", "json", "The type of patch being provided; one of [json merge strategic]")
 	return &command
 }
 
@@ -148,13 +148,17 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	var kind string
 	var group string
 	var all bool
+	var selector string
 	command := &cobra.Command{
 		Use:   "run APPNAME ACTION",
 		Short: "Runs an available action on resource(s) matching the specified filters.",
-		Long:  "All filters except --kind are optional. Use --all to run the action on all matching resources if more than one resource matches the filters. Actions may only be run on resources that are represented in git and cannot be run on child resources.",
+		Long:  "All filters except --kind are optional. Use --all to run the action on all matching resources if more than one resource matches the filters. Use --selector to run the action on all resources matching a label selector in a single request. Actions may only be run on resources that are represented in git and cannot be run on child resources.",
 		Example: templates.Examples(`
 	# Run an available action for an application
 	argocd app actions run APPNAME ACTION --kind KIND [--resource-name RESOURCE] [--namespace NAMESPACE] [--group GROUP]
+
+	# Restart all deployments of an application labeled with tier=frontend
+	argocd app actions run APPNAME restart --kind Deployment --group apps --selector tier=frontend
 	`),
 	}
 
@@ -164,6 +168,8 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	command.Flags().StringVar(&group, "group", "", "Group of the resource on which the action should be run")
 	errors.CheckError(command.MarkFlagRequired("kind"))
 	command.Flags().BoolVar(&all, "all", false, "Indicates whether to run the action on multiple matching resources")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Run the action on all resources matching the label selector (e.g. -l key1=value1,key2=value2)")
+	command.MarkFlagsMutuallyExclusive("selector", "resource-name")
 
 	command.Run = func(c *cobra.Command, args []string) {
 		ctx := c.Context()
@@ -177,6 +183,24 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 
 		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 		defer utilio.Close(conn)
+
+		if selector != "" {
+			response, err := appIf.RunResourceActionBulk(ctx, &applicationpkg.ResourceActionRunBulkRequest{
+				Name:          &appName,
+				AppNamespace:  &appNs,
+				Namespace:     &namespace,
+				Group:         &group,
+				Kind:          &kind,
+				LabelSelector: &selector,
+				Action:        &actionName,
+			})
+			errors.CheckError(err)
+			if !printResourceActionResults(response.Results) {
+				os.Exit(1)
+			}
+			return
+		}
+
 		resources, err := getActionableResourcesForApplication(ctx, appIf, &appNs, &appName)
 		errors.CheckError(err)
 		filteredObjects, err := util.FilterResources(command.Flags().Changed("group"), resources, group, kind, namespace, resourceName, all)
@@ -227,6 +251,28 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	return command
 }
 
+// printResourceActionResults prints the results of a bulk resource action and returns whether the action succeeded on
+// all resources
+func printResourceActionResults(results []*applicationpkg.ResourceActionResult) bool {
+	if len(results) == 0 {
+		fmt.Println("No resources matched the filters.")
+		return true
+	}
+	succeeded := true
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tRESULT\n")
+	for _, result := range results {
+		message := "succeeded"
+		if result.GetError() != "" {
+			message = result.GetError()
+			succeeded = false
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", result.GetGroup(), result.GetKind(), result.GetNamespace(), result.GetName(), message)
+	}
+	_ = w.Flush()
+	return succeeded
+}
+
 func getActionableResourcesForApplication(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appNs *string, appName *string) ([]*v1alpha1.ResourceDiff, error) {
 	resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{
 		ApplicationName: appName,
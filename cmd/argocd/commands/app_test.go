@@ -7,7 +7,9 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -46,6 +48,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/errors"
 )
 
 func Test_getInfos(t *testing.T) {
@@ -1545,6 +1548,46 @@ func TestParseSelectedResourcesEmptyList(t *testing.T) {
 	assert.Empty(t, operationResources)
 }
 
+// pagedAppServiceClient lists the applications in pages of at most the limit of the query
+type pagedAppServiceClient struct {
+	fakeAppServiceClient
+	apps      []v1alpha1.Application
+	continues []string
+}
+
+func (c *pagedAppServiceClient) List(_ context.Context, q *applicationpkg.ApplicationQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationList, error) {
+	c.continues = append(c.continues, q.GetContinue())
+	start, _ := strconv.Atoi(q.GetContinue())
+	end := len(c.apps)
+	list := &v1alpha1.ApplicationList{}
+	if q.GetLimit() > 0 && start+int(q.GetLimit()) < end {
+		end = start + int(q.GetLimit())
+		list.Continue = strconv.Itoa(end)
+	}
+	list.Items = c.apps[start:end]
+	return list, nil
+}
+
+func TestListApplications(t *testing.T) {
+	appIf := &pagedAppServiceClient{apps: []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app3"}},
+	}}
+
+	limit := int64(2)
+	apps, err := listApplications(t.Context(), appIf, &applicationpkg.ApplicationQuery{Limit: &limit})
+	require.NoError(t, err)
+	assert.Equal(t, appIf.apps, apps)
+	assert.Equal(t, []string{"", "2"}, appIf.continues)
+
+	appIf.continues = nil
+	apps, err = listApplications(t.Context(), appIf, &applicationpkg.ApplicationQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, appIf.apps, apps)
+	assert.Equal(t, []string{""}, appIf.continues)
+}
+
 func TestPrintApplicationTableNotWide(t *testing.T) {
 	output, err := captureOutput(func() error {
 		app := &v1alpha1.Application{
@@ -1959,10 +2002,13 @@ func TestWaitOnApplicationStatus_JSON_YAML_WideOutput_With_Timeout(t *testing.T)
 	}
 	watch = getWatchOpts(watch)
 
+	var err error
 	output, _ := captureOutput(func() error {
-		_, _, _ = waitOnApplicationStatus(ctx, acdClient, "app-name", 5, watch, selectResource, "")
+		_, _, err = waitOnApplicationStatus(ctx, acdClient, "app-name", 5, watch, selectResource, "")
 		return nil
 	})
+	var timeoutErr *waitTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
 	timeStr := time.Now().Format("2006-01-02T15:04:05-07:00")
 
 	expectation := `TIMESTAMP                  GROUP        KIND   NAMESPACE                  NAME    STATUS   HEALTH        HOOK  MESSAGE
@@ -2012,6 +2058,54 @@ apps   Deployment  default    test           Synced  Healthy
 	assert.Equalf(t, expectationSorted, outputSorted, "Incorrect output %q, should be %q (items order doesn't matter)", output, expectation)
 }
 
+func TestWaitOnSyncOperation_Timeout(t *testing.T) {
+	tests := []struct {
+		name                string
+		waitTimeoutBehavior string
+		expectedTerminated  []string
+	}{
+		{
+			name:                "exit leaves the operation running",
+			waitTimeoutBehavior: waitTimeoutBehaviorExit,
+		},
+		{
+			name:                "terminate terminates the operation",
+			waitTimeoutBehavior: waitTimeoutBehaviorTerminate,
+			expectedTerminated:  []string{"argocd/app-name"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acdClient := &customAcdClient{&fakeAcdClient{simulateTimeout: 2}}
+			appIf := &terminateRecordingAppServiceClient{}
+
+			var code int
+			var err error
+			output, _ := captureOutput(func() error {
+				code, err = waitOnSyncOperation(t.Context(), acdClient, appIf, "argocd/app-name", 1, nil, "", false, tt.waitTimeoutBehavior)
+				return nil
+			})
+
+			assert.Equal(t, errors.ErrorWaitTimeout, code)
+			var timeoutErr *waitTimeoutError
+			require.ErrorAs(t, err, &timeoutErr)
+			assert.Equal(t, tt.expectedTerminated, appIf.terminated)
+			assert.Equal(t, tt.expectedTerminated != nil, strings.Contains(output, "Application 'argocd/app-name' operation terminating"))
+		})
+	}
+}
+
+// terminateRecordingAppServiceClient records the applications whose operation has been terminated
+type terminateRecordingAppServiceClient struct {
+	fakeAppServiceClient
+	terminated []string
+}
+
+func (c *terminateRecordingAppServiceClient) TerminateOperation(_ context.Context, req *applicationpkg.OperationTerminateRequest, _ ...grpc.CallOption) (*applicationpkg.OperationTerminateResponse, error) {
+	c.terminated = append(c.terminated, req.GetAppNamespace()+"/"+req.GetName())
+	return &applicationpkg.OperationTerminateResponse{}, nil
+}
+
 type customAcdClient struct {
 	*fakeAcdClient
 }
@@ -2180,6 +2274,10 @@ func (c *fakeAppServiceClient) Update(_ context.Context, _ *applicationpkg.Appli
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) Move(_ context.Context, _ *applicationpkg.ApplicationMoveRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) UpdateSpec(_ context.Context, _ *applicationpkg.ApplicationUpdateSpecRequest, _ ...grpc.CallOption) (*v1alpha1.ApplicationSpec, error) {
 	return nil, nil
 }
@@ -2200,6 +2298,10 @@ func (c *fakeAppServiceClient) ManagedResources(_ context.Context, _ *applicatio
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) OperationLog(_ context.Context, _ *applicationpkg.OperationLogQuery, _ ...grpc.CallOption) (*applicationpkg.OperationLogResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ResourceTree(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	return nil, nil
 }
@@ -2237,6 +2339,10 @@ func (c *fakeAppServiceClient) RunResourceActionV2(_ context.Context, _ *applica
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) RunResourceActionBulk(_ context.Context, _ *applicationpkg.ResourceActionRunBulkRequest, _ ...grpc.CallOption) (*applicationpkg.ResourceActionRunBulkResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) DeleteResource(_ context.Context, _ *applicationpkg.ApplicationResourceDeleteRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResponse, error) {
 	return nil, nil
 }
@@ -2245,6 +2351,10 @@ func (c *fakeAppServiceClient) PodLogs(_ context.Context, _ *applicationpkg.Appl
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) CreateExtensionToken(_ context.Context, _ *applicationpkg.ExtensionTokenRequest, _ ...grpc.CallOption) (*applicationpkg.ExtensionTokenResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ListLinks(_ context.Context, _ *applicationpkg.ListAppLinksRequest, _ ...grpc.CallOption) (*applicationpkg.LinksResponse, error) {
 	return nil, nil
 }
@@ -2253,6 +2363,10 @@ func (c *fakeAppServiceClient) ListResourceLinks(_ context.Context, _ *applicati
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) SyncPlan(_ context.Context, _ *applicationpkg.ApplicationSyncPlanQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationSyncPlanResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ServerSideDiff(_ context.Context, _ *applicationpkg.ApplicationServerSideDiffQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationServerSideDiffResponse, error) {
 	return nil, nil
 }
@@ -2386,3 +2500,33 @@ func (c *fakeAcdClient) WatchApplicationWithRetry(_ context.Context, _ string, _
 	}()
 	return appEventsCh
 }
+
+func TestGetApplicationPatch(t *testing.T) {
+	t.Run("Patch", func(t *testing.T) {
+		command := NewApplicationSetCommand(&argocdclient.ClientOptions{})
+		require.NoError(t, command.ParseFlags([]string{"--patch", `[{"op": "remove", "path": "/spec/syncPolicy"}]`}))
+
+		patch, err := getApplicationPatch(command.Flags(), `[{"op": "remove", "path": "/spec/syncPolicy"}]`, "")
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"op": "remove", "path": "/spec/syncPolicy"}]`, patch)
+	})
+
+	t.Run("PatchFile", func(t *testing.T) {
+		patchFile := filepath.Join(t.TempDir(), "patch.yaml")
+		require.NoError(t, os.WriteFile(patchFile, []byte("spec:\n  source:\n    path: guestbook\n"), 0o644))
+		command := NewApplicationSetCommand(&argocdclient.ClientOptions{})
+		require.NoError(t, command.ParseFlags([]string{"--patch-file", patchFile, "--patch-type", "merge"}))
+
+		patch, err := getApplicationPatch(command.Flags(), "", patchFile)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"spec": {"source": {"path": "guestbook"}}}`, patch)
+	})
+
+	t.Run("CombinedWithAppFlags", func(t *testing.T) {
+		command := NewApplicationSetCommand(&argocdclient.ClientOptions{})
+		require.NoError(t, command.ParseFlags([]string{"--patch", "[]", "--path", "guestbook", "--source-position", "1"}))
+
+		_, err := getApplicationPatch(command.Flags(), "[]", "")
+		require.EqualError(t, err, "--patch and --patch-file cannot be combined with --path, --source-position")
+	})
+}
@@ -1,11 +1,14 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"reflect"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
@@ -37,6 +40,12 @@ var appSetExample = templates.Examples(`
 
 	# Delete an ApplicationSet
 	argocd appset delete APPSETNAME (APPSETNAME...)
+
+	# Resume the rollout of an ApplicationSet after a paused RollingSync step
+	argocd appset resume APPSETNAME --step 1
+
+	# Show the parameters cached for the generators of an ApplicationSet
+	argocd appset cached-parameters APPSETNAME
 	`)
 
 // NewAppSetCommand returns a new instance of an `argocd appset` command
@@ -55,6 +64,8 @@ func NewAppSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewApplicationSetListCommand(clientOpts))
 	command.AddCommand(NewApplicationSetDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationSetGenerateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetResumeCommand(clientOpts))
+	command.AddCommand(NewApplicationSetCachedParametersCommand(clientOpts))
 	return command
 }
 
@@ -384,6 +395,99 @@ func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationSetResumeCommand returns a new instance of an `argocd appset resume` command
+func NewApplicationSetResumeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var step int64
+	command := &cobra.Command{
+		Use:   "resume APPSETNAME",
+		Short: "Resume the rollout of an ApplicationSet after a RollingSync step which pauses after its Applications are Healthy",
+		Example: templates.Examples(`
+	# Resume the rollout after the first step
+	argocd appset resume APPSETNAME --step 1
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 || step < 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], "")
+			_, err := appIf.Resume(ctx, &applicationset.ApplicationSetResumeRequest{Name: appSetName, AppsetNamespace: appSetNs, Step: step})
+			errors.CheckError(err)
+			fmt.Printf("applicationset '%s' resumed after step %d\n", args[0], step)
+		},
+	}
+	command.Flags().Int64Var(&step, "step", 0, "Number of the RollingSync step to resume the rollout after, starting at 1")
+	return command
+}
+
+// NewApplicationSetCachedParametersCommand returns a new instance of an `argocd appset cached-parameters` command
+func NewApplicationSetCachedParametersCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "cached-parameters APPSETNAME",
+		Short: "Show the parameters last successfully generated by the generators of an ApplicationSet, which are used while a generator fails",
+		Example: templates.Examples(`
+	# Show the number of cached parameter sets per generator
+	argocd appset cached-parameters APPSETNAME
+
+	# Show the cached parameter sets
+	argocd appset cached-parameters APPSETNAME -o yaml
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], "")
+			resp, err := appIf.GetCachedParameters(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				var resources []any
+				for _, cached := range resp.Generators {
+					var params []map[string]any
+					errors.CheckError(json.Unmarshal([]byte(cached.Parameters), &params))
+					resources = append(resources, map[string]any{
+						"generator":   cached.Generator,
+						"hash":        cached.Hash,
+						"generatedAt": cached.GeneratedAt,
+						"parameters":  params,
+					})
+				}
+				cobra.CheckErr(admin.PrintResources(output, os.Stdout, resources...))
+			case "wide", "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintf(w, "GENERATOR\tGENERATED AT\tPARAMETER SETS\tHASH\n")
+				for _, cached := range resp.Generators {
+					var params []map[string]any
+					errors.CheckError(json.Unmarshal([]byte(cached.Parameters), &params))
+					generatedAt := ""
+					if cached.GeneratedAt != nil {
+						generatedAt = cached.GeneratedAt.UTC().Format(time.RFC3339)
+					}
+					_, _ = fmt.Fprintf(w, "%d\t%s\t%d\t%s\n", cached.Generator, generatedAt, len(params), cached.Hash)
+				}
+				_ = w.Flush()
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
 // Print simple list of application names
 func printApplicationSetNames(apps []arogappsetv1.ApplicationSet) {
 	for _, app := range apps {
@@ -439,6 +543,9 @@ func printAppSetSummaryTable(appSet *arogappsetv1.ApplicationSet) {
 	fmt.Printf(printOpFmtStr, "Server:", getServerForAppSet(appSet))
 	fmt.Printf(printOpFmtStr, "Namespace:", appSet.Spec.Template.Spec.Destination.Namespace)
 	fmt.Printf(printOpFmtStr, "Health Status:", appSet.Status.Health.Status)
+	if appSet.Status.StaleApplicationsCount > 0 {
+		fmt.Printf(printOpFmtStr, "Stale Applications:", strings.Join(appSet.Status.StaleApplications, ", "))
+	}
 	if !appSet.Spec.Template.Spec.HasMultipleSources() {
 		fmt.Println("Source:")
 	} else {
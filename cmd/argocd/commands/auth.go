@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+const (
+	// execInfoEnv is the environment variable used by client-go to pass the exec credential request to the plugin
+	execInfoEnv = "KUBERNETES_EXEC_INFO"
+
+	execCredentialKind          = "ExecCredential"
+	execCredentialAPIVersionV1  = "client.authentication.k8s.io/v1"
+	execCredentialAPIVersionV1b = "client.authentication.k8s.io/v1beta1"
+)
+
+// NewAuthCommand returns a new instance of `argocd auth` command
+func NewAuthCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "auth",
+		Short: "Manage authentication credentials",
+		Example: templates.Examples(`
+			# Print the auth token of the current context as a Kubernetes exec credential
+			argocd auth exec
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewAuthExecCommand(clientOpts))
+	return command
+}
+
+// NewAuthExecCommand returns a new instance of `argocd auth exec` command
+func NewAuthExecCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "exec",
+		Short: "Print an Argo CD auth token using the Kubernetes exec credential protocol",
+		Long: `
+Print the auth token of the current context as an ExecCredential object of the
+Kubernetes client-go credential plugin protocol. Expired tokens are refreshed
+using the refresh token of the context, if available.
+
+This allows tools that support kubeconfig-style exec credential plugins to
+obtain Argo CD API tokens transparently.
+`,
+		Example: templates.Examples(`
+			# Print an exec credential for the current context
+			argocd auth exec
+
+			# Print an exec credential for a specific context
+			argocd auth exec --argocd-context cd.argoproj.io
+
+			# Use as a credential plugin in a kubeconfig-style configuration
+			users:
+			- name: argocd
+			  user:
+			    exec:
+			      apiVersion: client.authentication.k8s.io/v1
+			      command: argocd
+			      args: ["auth", "exec"]
+			      interactiveMode: Never
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			// The client refreshes and persists an expired token on creation, so the returned token is the current one
+			acdClient, err := argocdclient.NewClient(clientOpts)
+			errors.CheckError(err)
+			token := acdClient.ClientOptions().AuthToken
+			if token == "" {
+				log.Fatal("No auth token found. Login using `argocd login`")
+			}
+			cred, err := newExecCredential(token, os.Getenv(execInfoEnv))
+			errors.CheckError(err)
+			out, err := json.Marshal(cred)
+			errors.CheckError(err)
+			_, _ = fmt.Fprintln(os.Stdout, string(out))
+		},
+	}
+	return command
+}
+
+// newExecCredential returns an exec credential holding the given token. The API version of the credential matches the
+// one of the request passed by client-go in execInfo, if any. The expiration of the credential is taken from the
+// token claims, so that client-go invokes the plugin again once the token has expired.
+func newExecCredential(token string, execInfo string) (*clientauthv1.ExecCredential, error) {
+	apiVersion := execCredentialAPIVersionV1
+	if execInfo != "" {
+		var req metav1.TypeMeta
+		if err := json.Unmarshal([]byte(execInfo), &req); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", execInfoEnv, err)
+		}
+		switch req.APIVersion {
+		case "":
+		case execCredentialAPIVersionV1, execCredentialAPIVersionV1b:
+			// both versions share the same wire format for the fields we populate
+			apiVersion = req.APIVersion
+		default:
+			return nil, fmt.Errorf("unsupported exec credential API version %q", req.APIVersion)
+		}
+	}
+
+	status := &clientauthv1.ExecCredentialStatus{Token: token}
+	var claims jwt.RegisteredClaims
+	// tokens which are not JWTs are passed through without expiration
+	if _, _, err := jwt.NewParser(jwt.WithoutClaimsValidation()).ParseUnverified(token, &claims); err == nil && claims.ExpiresAt != nil {
+		expiresAt := metav1.NewTime(claims.ExpiresAt.Time)
+		status.ExpirationTimestamp = &expiresAt
+	}
+
+	return &clientauthv1.ExecCredential{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiVersion,
+			Kind:       execCredentialKind,
+		},
+		Status: status,
+	}, nil
+}
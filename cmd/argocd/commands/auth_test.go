@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExecCredential(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "admin",
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	t.Run("DefaultAPIVersion", func(t *testing.T) {
+		cred, err := newExecCredential(token, "")
+		require.NoError(t, err)
+		assert.Equal(t, "client.authentication.k8s.io/v1", cred.APIVersion)
+		assert.Equal(t, "ExecCredential", cred.Kind)
+		assert.Equal(t, token, cred.Status.Token)
+		require.NotNil(t, cred.Status.ExpirationTimestamp)
+		assert.True(t, expiresAt.Equal(cred.Status.ExpirationTimestamp.Time))
+	})
+
+	t.Run("RequestedAPIVersion", func(t *testing.T) {
+		cred, err := newExecCredential(token, `{"apiVersion":"client.authentication.k8s.io/v1beta1","kind":"ExecCredential","spec":{"interactive":false}}`)
+		require.NoError(t, err)
+		assert.Equal(t, "client.authentication.k8s.io/v1beta1", cred.APIVersion)
+	})
+
+	t.Run("UnsupportedAPIVersion", func(t *testing.T) {
+		_, err := newExecCredential(token, `{"apiVersion":"client.authentication.k8s.io/v1alpha1","kind":"ExecCredential"}`)
+		require.ErrorContains(t, err, "unsupported exec credential API version")
+	})
+
+	t.Run("InvalidExecInfo", func(t *testing.T) {
+		_, err := newExecCredential(token, "{")
+		require.ErrorContains(t, err, "failed to parse KUBERNETES_EXEC_INFO")
+	})
+
+	t.Run("OpaqueToken", func(t *testing.T) {
+		cred, err := newExecCredential("not-a-jwt", "")
+		require.NoError(t, err)
+		assert.Equal(t, "not-a-jwt", cred.Status.Token)
+		assert.Nil(t, cred.Status.ExpirationTimestamp)
+	})
+}
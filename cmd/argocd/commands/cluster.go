@@ -38,10 +38,6 @@ const (
 	clusterFieldName = "name"
 	// cluster field is 'namespaces'
 	clusterFieldNamespaces = "namespaces"
-	// cluster field is 'labels'
-	clusterFieldLabel = "labels"
-	// cluster field is 'annotations'
-	clusterFieldAnnotation = "annotations"
 	// indicates managing all namespaces
 	allNamespaces = "*"
 )
@@ -69,7 +65,10 @@ func NewClusterCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientc
 
   # Set a target cluster context from ArgoCD
   argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace '*'
-  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two`,
+  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two
+
+  # Add a label to the cluster and remove an annotation from it
+  argocd cluster set CLUSTER_NAME --label env=prod --remove-annotation team`,
 	}
 
 	command.AddCommand(NewClusterAddCommand(clientOpts, pathOpts))
@@ -234,17 +233,22 @@ func getRestConfig(pathOpts *clientcmd.PathOptions, ctxName string) (*rest.Confi
 // NewClusterSetCommand returns a new instance of an `argocd cluster set` command
 func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		clusterOptions cmdutil.ClusterOptions
-		clusterName    string
-		labels         []string
-		annotations    []string
+		clusterOptions    cmdutil.ClusterOptions
+		clusterName       string
+		labels            []string
+		annotations       []string
+		removeLabels      []string
+		removeAnnotations []string
 	)
 	command := &cobra.Command{
 		Use:   "set NAME",
 		Short: "Set cluster information",
 		Example: `  # Set cluster information
   argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace '*'
-  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two`,
+  argocd cluster set CLUSTER_NAME --name new-cluster-name --namespace namespace-one --namespace namespace-two
+
+  # Add a label to the cluster and remove an annotation from it
+  argocd cluster set CLUSTER_NAME --label env=prod --remove-annotation team`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) != 1 {
@@ -256,7 +260,7 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
 			defer utilio.Close(conn)
 			// checks the fields that needs to be updated
-			updatedFields := checkFieldsToUpdate(clusterOptions, labels, annotations)
+			updatedFields := checkFieldsToUpdate(clusterOptions)
 			namespaces := clusterOptions.Namespaces
 			// check if all namespaces have to be considered
 			if len(namespaces) == 1 && strings.EqualFold(namespaces[0], allNamespaces) {
@@ -268,42 +272,60 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			// parse the annotations you're receiving from the annotation flag
 			annotationsMap, err := label.Parse(annotations)
 			errors.CheckError(err)
+			updateMetadata := labels != nil || annotations != nil || removeLabels != nil || removeAnnotations != nil
+			if updatedFields == nil && !updateMetadata {
+				fmt.Print("Specify the cluster field to be updated.\n")
+				return
+			}
+			clusterID := &clusterpkg.ClusterID{
+				Type:  clusterIdTypeName,
+				Value: clusterName,
+			}
+			checkUpdateError := func(err error) {
+				if err != nil {
+					if status.Code(err) == codes.PermissionDenied {
+						log.Error("Ensure that the cluster is present and you have the necessary permissions to update the cluster")
+					}
+					errors.CheckError(err)
+				}
+			}
+			// the metadata is updated first, as the cluster cannot be found by its previous name after a rename
+			if updateMetadata {
+				_, err := clusterIf.UpdateMetadata(ctx, &clusterpkg.ClusterMetadataUpdateRequest{
+					Id:                clusterID,
+					Labels:            labelsMap,
+					Annotations:       annotationsMap,
+					RemoveLabels:      removeLabels,
+					RemoveAnnotations: removeAnnotations,
+				})
+				checkUpdateError(err)
+			}
 			if updatedFields != nil {
 				clusterUpdateRequest := clusterpkg.ClusterUpdateRequest{
 					Cluster: &argoappv1.Cluster{
-						Name:        clusterOptions.Name,
-						Namespaces:  namespaces,
-						Labels:      labelsMap,
-						Annotations: annotationsMap,
+						Name:       clusterOptions.Name,
+						Namespaces: namespaces,
 					},
 					UpdatedFields: updatedFields,
-					Id: &clusterpkg.ClusterID{
-						Type:  clusterIdTypeName,
-						Value: clusterName,
-					},
+					Id:            clusterID,
 				}
 				_, err := clusterIf.Update(ctx, &clusterUpdateRequest)
-				if err != nil {
-					if status.Code(err) == codes.PermissionDenied {
-						log.Error("Ensure that the cluster is present and you have the necessary permissions to update the cluster")
-					}
-					errors.CheckError(err)
-				}
-				fmt.Printf("Cluster '%s' updated.\n", clusterName)
-			} else {
-				fmt.Print("Specify the cluster field to be updated.\n")
+				checkUpdateError(err)
 			}
+			fmt.Printf("Cluster '%s' updated.\n", clusterName)
 		},
 	}
 	command.Flags().StringVar(&clusterOptions.Name, "name", "", "Overwrite the cluster name")
 	command.Flags().StringArrayVar(&clusterOptions.Namespaces, "namespace", nil, "List of namespaces which are allowed to manage. Specify '*' to manage all namespaces")
-	command.Flags().StringArrayVar(&labels, "label", nil, "Set metadata labels (e.g. --label key=value)")
-	command.Flags().StringArrayVar(&annotations, "annotation", nil, "Set metadata annotations (e.g. --annotation key=value)")
+	command.Flags().StringArrayVar(&labels, "label", nil, "Add or overwrite metadata labels, keeping the other labels of the cluster (e.g. --label key=value)")
+	command.Flags().StringArrayVar(&annotations, "annotation", nil, "Add or overwrite metadata annotations, keeping the other annotations of the cluster (e.g. --annotation key=value)")
+	command.Flags().StringArrayVar(&removeLabels, "remove-label", nil, "Remove metadata labels (e.g. --remove-label key)")
+	command.Flags().StringArrayVar(&removeAnnotations, "remove-annotation", nil, "Remove metadata annotations (e.g. --remove-annotation key)")
 	return command
 }
 
 // checkFieldsToUpdate returns the fields that needs to be updated
-func checkFieldsToUpdate(clusterOptions cmdutil.ClusterOptions, labels []string, annotations []string) []string {
+func checkFieldsToUpdate(clusterOptions cmdutil.ClusterOptions) []string {
 	var updatedFields []string
 	if clusterOptions.Name != "" {
 		updatedFields = append(updatedFields, clusterFieldName)
@@ -311,12 +333,6 @@ func checkFieldsToUpdate(clusterOptions cmdutil.ClusterOptions, labels []string,
 	if clusterOptions.Namespaces != nil {
 		updatedFields = append(updatedFields, clusterFieldNamespaces)
 	}
-	if labels != nil {
-		updatedFields = append(updatedFields, clusterFieldLabel)
-	}
-	if annotations != nil {
-		updatedFields = append(updatedFields, clusterFieldAnnotation)
-	}
 	return updatedFields
 }
 
@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"slices"
 	"strings"
 	"text/tabwriter"
+	"unicode"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/config"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/localconfig"
 )
@@ -83,9 +86,132 @@ argocd context cd.argoproj.io --delete`,
 		},
 	}
 	command.Flags().BoolVar(&deletion, "delete", false, "Delete the context instead of switching to it")
+	command.AddCommand(NewContextSetDefaultCommand(clientOpts))
 	return command
 }
 
+// NewContextSetDefaultCommand returns a new instance of an `argocd context set-default` command
+func NewContextSetDefaultCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var defaults localconfig.ContextDefaults
+	command := &cobra.Command{
+		Use:   "set-default [CONTEXT]",
+		Short: "Set the defaults of flags applied when using a context",
+		Example: `# Use the project my-project and the output format json by default in the current context
+argocd context set-default --project my-project --output json
+
+# Connect using the gRPC-web protocol and use the applications namespace team-a by default in the context cd.argoproj.io
+argocd context set-default cd.argoproj.io --grpc-web --app-namespace team-a
+
+# Unset the default project of the current context
+argocd context set-default --project ""`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			localCfg, err := localconfig.ReadLocalConfig(clientOpts.ConfigPath)
+			errors.CheckError(err)
+			if localCfg == nil {
+				log.Fatalf("No contexts defined in %s", clientOpts.ConfigPath)
+			}
+			ctxName := localCfg.CurrentContext
+			if len(args) > 0 {
+				ctxName = args[0]
+			}
+			context, err := localCfg.ResolveContext(ctxName)
+			errors.CheckError(err)
+
+			updated := localconfig.ContextDefaults{}
+			if context.Defaults != nil {
+				updated = *context.Defaults
+			}
+			if c.Flags().Changed("project") {
+				updated.Project = defaults.Project
+			}
+			if c.Flags().Changed("app-namespace") {
+				updated.AppNamespace = defaults.AppNamespace
+			}
+			if c.Flags().Changed("grpc-web") {
+				updated.GRPCWeb = defaults.GRPCWeb
+			}
+			if c.Flags().Changed("output") {
+				if defaults.Output != "" && defaults.Output != "json" && defaults.Output != "yaml" && defaults.Output != "wide" {
+					log.Fatalf("Unsupported output format %q: one of json|yaml|wide is supported", defaults.Output)
+				}
+				updated.Output = defaults.Output
+			}
+			localCfg.SetContextDefaults(context.Name, &updated)
+			err = localconfig.WriteLocalConfig(*localCfg, clientOpts.ConfigPath)
+			errors.CheckError(err)
+			fmt.Printf("Defaults of context '%s' updated\n", context.Name)
+		},
+	}
+	command.Flags().StringVar(&defaults.Project, "project", "", "Default project")
+	command.Flags().StringVarP(&defaults.AppNamespace, "app-namespace", "N", "", "Default namespace of applications")
+	command.Flags().BoolVar(&defaults.GRPCWeb, "grpc-web", false, "Connect using the gRPC-web protocol by default")
+	command.Flags().StringVarP(&defaults.Output, "output", "o", "", "Default output format of the commands supporting it. One of: json|yaml|wide")
+	return command
+}
+
+// isApplicationCommand returns true if the given command is a subcommand of `argocd app`
+func isApplicationCommand(c *cobra.Command) bool {
+	for cmd := c; cmd.HasParent(); cmd = cmd.Parent() {
+		if !cmd.Parent().HasParent() {
+			return cmd.Name() == "app"
+		}
+	}
+	return false
+}
+
+// contextDefaultsExcludedCommands are the commands which manage contexts, so the defaults of a context are not applied
+var contextDefaultsExcludedCommands = []string{"context", "login", "relogin", "logout"}
+
+// applyContextDefaults applies the defaults of the context used by the given command to its flags, unless they are
+// set on the command line or in ARGOCD_OPTS
+func applyContextDefaults(c *cobra.Command, clientOpts *argocdclient.ClientOptions) {
+	for cmd := c; cmd.HasParent(); cmd = cmd.Parent() {
+		if !cmd.Parent().HasParent() && slices.Contains(contextDefaultsExcludedCommands, cmd.Name()) {
+			return
+		}
+	}
+	localCfg, err := localconfig.ReadLocalConfig(clientOpts.ConfigPath)
+	if err != nil || localCfg == nil {
+		return
+	}
+	context, err := localCfg.ResolveContext(clientOpts.Context)
+	if err != nil || context.Defaults == nil {
+		return
+	}
+	defaults := map[string]string{
+		"project":       context.Defaults.Project,
+		"app-namespace": context.Defaults.AppNamespace,
+		"output":        context.Defaults.Output,
+	}
+	if context.Defaults.GRPCWeb {
+		defaults["grpc-web"] = "true"
+	}
+	for name, value := range defaults {
+		flag := c.Flags().Lookup(name)
+		if value == "" || flag == nil || flag.Changed || config.HasFlag(name) {
+			continue
+		}
+		// the project of e.g. repositories and clusters restricts their usage, so it is only defaulted for applications
+		if name == "project" && !isApplicationCommand(c) {
+			continue
+		}
+		// only string flags are set, e.g. the projects of `argocd app list` are a filter rather than a default
+		if flag.Value.Type() != "string" && flag.Value.Type() != "bool" {
+			continue
+		}
+		// the output formats differ between the commands
+		if name == "output" && !slices.Contains(strings.FieldsFunc(flag.Usage, func(r rune) bool {
+			return !unicode.IsLetter(r)
+		}), value) {
+			continue
+		}
+		if err := flag.Value.Set(value); err != nil {
+			log.Warnf("Failed to apply default %s=%s of context '%s': %v", name, value, context.Name, err)
+		}
+	}
+}
+
 func deleteContext(context, configPath string) error {
 	localCfg, err := localconfig.ReadLocalConfig(configPath)
 	errors.CheckError(err)
@@ -2,11 +2,14 @@ package commands
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/util/localconfig"
 )
 
@@ -76,3 +79,108 @@ func TestContextDelete(t *testing.T) {
 	assert.NotContains(t, localConfig.Users, localconfig.User{AuthToken: "vErrYS3c3tReFRe$hToken", Name: "localhost:8080"})
 	assert.Contains(t, localConfig.Contexts, localconfig.ContextRef{Name: "argocd2.example.com:443", Server: "argocd2.example.com:443", User: "argocd2.example.com:443"})
 }
+
+func writeTestConfig(t *testing.T) string {
+	t.Helper()
+	configPath := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(configPath, []byte(testConfig), 0o600))
+	return configPath
+}
+
+func TestContextSetDefault(t *testing.T) {
+	configPath := writeTestConfig(t)
+	clientOpts := &argocdclient.ClientOptions{ConfigPath: configPath}
+
+	command := NewContextSetDefaultCommand(clientOpts)
+	command.SetArgs([]string{"--project", "my-project", "-N", "team-a", "--output", "json"})
+	require.NoError(t, command.Execute())
+
+	command = NewContextSetDefaultCommand(clientOpts)
+	command.SetArgs([]string{"argocd1.example.com:443", "--grpc-web"})
+	require.NoError(t, command.Execute())
+
+	localConfig, err := localconfig.ReadLocalConfig(configPath)
+	require.NoError(t, err)
+	context, err := localConfig.ResolveContext("localhost:8080")
+	require.NoError(t, err)
+	assert.Equal(t, &localconfig.ContextDefaults{Project: "my-project", AppNamespace: "team-a", Output: "json"}, context.Defaults)
+	context, err = localConfig.ResolveContext("argocd1.example.com:443")
+	require.NoError(t, err)
+	assert.Equal(t, &localconfig.ContextDefaults{GRPCWeb: true}, context.Defaults)
+
+	// only the given defaults are updated, and empty defaults are removed
+	command = NewContextSetDefaultCommand(clientOpts)
+	command.SetArgs([]string{"--project", ""})
+	require.NoError(t, command.Execute())
+	command = NewContextSetDefaultCommand(clientOpts)
+	command.SetArgs([]string{"argocd1.example.com:443", "--grpc-web=false"})
+	require.NoError(t, command.Execute())
+
+	localConfig, err = localconfig.ReadLocalConfig(configPath)
+	require.NoError(t, err)
+	context, err = localConfig.ResolveContext("localhost:8080")
+	require.NoError(t, err)
+	assert.Equal(t, &localconfig.ContextDefaults{AppNamespace: "team-a", Output: "json"}, context.Defaults)
+	context, err = localConfig.ResolveContext("argocd1.example.com:443")
+	require.NoError(t, err)
+	assert.Nil(t, context.Defaults)
+}
+
+func TestApplyContextDefaults(t *testing.T) {
+	configPath := writeTestConfig(t)
+	localConfig, err := localconfig.ReadLocalConfig(configPath)
+	require.NoError(t, err)
+	localConfig.SetContextDefaults("localhost:8080", &localconfig.ContextDefaults{Project: "my-project", AppNamespace: "team-a", GRPCWeb: true, Output: "yaml"})
+	require.NoError(t, localconfig.WriteLocalConfig(*localConfig, configPath))
+
+	// newCommand returns the given subcommand of `argocd app` with the flags parsed from the given arguments
+	newCommand := func(t *testing.T, name string, clientOpts *argocdclient.ClientOptions, args ...string) *cobra.Command {
+		t.Helper()
+		root := &cobra.Command{Use: "argocd"}
+		root.PersistentFlags().BoolVar(&clientOpts.GRPCWeb, "grpc-web", false, "")
+		app := &cobra.Command{Use: name}
+		root.AddCommand(app)
+		sub := &cobra.Command{Use: "create"}
+		sub.Flags().String("project", "", "")
+		sub.Flags().StringP("app-namespace", "N", "", "")
+		sub.Flags().StringP("output", "o", "wide", "Output format. One of: json|yaml|wide")
+		app.AddCommand(sub)
+		require.NoError(t, sub.ParseFlags(args))
+		return sub
+	}
+
+	t.Run("defaults are applied", func(t *testing.T) {
+		clientOpts := &argocdclient.ClientOptions{ConfigPath: configPath}
+		c := newCommand(t, "app", clientOpts)
+		applyContextDefaults(c, clientOpts)
+		assert.Equal(t, "my-project", c.Flag("project").Value.String())
+		assert.Equal(t, "team-a", c.Flag("app-namespace").Value.String())
+		assert.Equal(t, "yaml", c.Flag("output").Value.String())
+		assert.True(t, clientOpts.GRPCWeb)
+	})
+
+	t.Run("explicit flags take precedence", func(t *testing.T) {
+		clientOpts := &argocdclient.ClientOptions{ConfigPath: configPath}
+		c := newCommand(t, "app", clientOpts, "--project", "other", "-o", "json")
+		applyContextDefaults(c, clientOpts)
+		assert.Equal(t, "other", c.Flag("project").Value.String())
+		assert.Equal(t, "json", c.Flag("output").Value.String())
+		assert.Equal(t, "team-a", c.Flag("app-namespace").Value.String())
+	})
+
+	t.Run("project is only defaulted for applications", func(t *testing.T) {
+		clientOpts := &argocdclient.ClientOptions{ConfigPath: configPath}
+		c := newCommand(t, "repo", clientOpts)
+		applyContextDefaults(c, clientOpts)
+		assert.Empty(t, c.Flag("project").Value.String())
+		assert.True(t, clientOpts.GRPCWeb)
+	})
+
+	t.Run("defaults are not applied when managing contexts", func(t *testing.T) {
+		clientOpts := &argocdclient.ClientOptions{ConfigPath: configPath}
+		c := newCommand(t, "context", clientOpts)
+		applyContextDefaults(c, clientOpts)
+		assert.Equal(t, "wide", c.Flag("output").Value.String())
+		assert.False(t, clientOpts.GRPCWeb)
+	})
+}
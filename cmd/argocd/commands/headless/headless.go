@@ -176,8 +176,8 @@ func testAPI(ctx context.Context, clientOpts *apiclient.ClientOptions) error {
 // server on the fly and changes provided client options to use started API server port.
 //
 // If the clientOpts enables core mode, but the local config does not have core mode enabled, this function will
-// not start the local server.
-func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOptions, ctxStr string, port *int, address *string, clientConfig clientcmd.ClientConfig) (func(), error) {
+// not start the local server. If readOnly is set, the local server rejects all requests which would change any state.
+func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOptions, ctxStr string, port *int, address *string, clientConfig clientcmd.ClientConfig, readOnly bool) (func(), error) {
 	if clientConfig == nil {
 		flags := pflag.NewFlagSet("tmp", pflag.ContinueOnError)
 		clientConfig = cli.AddKubectlFlagsToSet(flags)
@@ -287,6 +287,7 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 		ListenHost:              *address,
 		RepoClientset:           &forwardRepoClientset{namespace: namespace, context: ctxStr, repoServerName: clientOpts.RepoServerName, kubeClientset: kubeClientset},
 		EnableProxyExtension:    false,
+		ReadOnly:                readOnly,
 	}, server.ApplicationSetOpts{})
 	srv.Init(ctx)
 
@@ -322,7 +323,7 @@ func NewClientOrDie(opts *apiclient.ClientOptions, c *cobra.Command) apiclient.C
 	ctxStr := initialize.RetrieveContextIfChanged(c.Flag("context"))
 	// If we're in core mode, start the API server on the fly and configure the client `opts` to use it.
 	// If we're not in core mode, this function call will do nothing.
-	_, err := MaybeStartLocalServer(ctx, opts, ctxStr, nil, nil, nil)
+	_, err := MaybeStartLocalServer(ctx, opts, ctxStr, nil, nil, nil, false)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -615,6 +616,23 @@ ID          ISSUED-AT                                  EXPIRES-AT
 			} else {
 				fmt.Println("<none>")
 			}
+			fmt.Printf("Role Bindings:\n")
+			bw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(bw, "NAME\tGROUPS\tEXPIRES-AT\tCREATED-BY\tREASON\n")
+			for _, binding := range proj.Spec.RoleBindings {
+				if binding.Role != roleName {
+					continue
+				}
+				expiresAt := "<none>"
+				if binding.ExpiresAt != nil {
+					expiresAt = humanizeTimestamp(binding.ExpiresAt.Unix())
+					if binding.IsExpired(time.Now()) {
+						expiresAt += " (expired)"
+					}
+				}
+				fmt.Fprintf(bw, "%s\t%s\t%s\t%s\t%s\n", binding.Name, strings.Join(binding.Groups, ","), expiresAt, binding.CreatedBy, binding.Reason)
+			}
+			_ = bw.Flush()
 			fmt.Printf("JWT Tokens:\n")
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 			fmt.Fprintf(w, "ID\tISSUED-AT\tEXPIRES-AT\n")
@@ -55,7 +55,10 @@ argocd repo rm https://github.com/yourusername/your-repo.git
 
 // NewRepoAddCommand returns a new instance of an `argocd repo add` command
 func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var repoOpts cmdutil.RepoOptions
+	var (
+		repoOpts          cmdutil.RepoOptions
+		verifyWriteAccess bool
+	)
 
 	// For better readability and easier formatting
 	repoAddExamples := `  # Add a Git repository via SSH using a private key for authentication, ignoring the server's host key:
@@ -102,6 +105,9 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 
   # Add a private Git repository on Google Cloud Sources via GCP service account credentials
   argocd repo add https://source.developers.google.com/p/my-google-cloud-project/r/my-repo --gcp-service-account-key-path service-account-key.json
+
+  # Add a private Git repository via HTTPS, verifying that the credentials grant push access to it
+  argocd repo add https://git.example.com/repos/repo --username git --password secret --verify-write-access
 `
 
 	command := &cobra.Command{
@@ -251,8 +257,9 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 			errors.CheckError(err)
 
 			repoCreateReq := repositorypkg.RepoCreateRequest{
-				Repo:   &repoOpts.Repo,
-				Upsert: repoOpts.Upsert,
+				Repo:              &repoOpts.Repo,
+				Upsert:            repoOpts.Upsert,
+				VerifyWriteAccess: verifyWriteAccess,
 			}
 
 			createdRepo, err := repoIf.CreateRepository(ctx, &repoCreateReq)
@@ -261,6 +268,7 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		},
 	}
 	command.Flags().BoolVar(&repoOpts.Upsert, "upsert", false, "Override an existing repository with the same name even if the spec differs")
+	command.Flags().BoolVar(&verifyWriteAccess, "verify-write-access", false, "Verify that the credentials grant push access to the repository, e.g. for repositories written back to (only for Git repositories)")
 	cmdutil.AddRepoFlags(command, &repoOpts)
 	return command
 }
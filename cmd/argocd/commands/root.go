@@ -42,6 +42,9 @@ func NewCommand() *cobra.Command {
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
 		},
+		PersistentPreRun: func(c *cobra.Command, _ []string) {
+			applyContextDefaults(c, &clientOpts)
+		},
 		DisableAutoGenTag: true,
 		SilenceUsage:      true,
 		ValidArgsFunction: func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
@@ -64,6 +67,7 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(initialize.InitCommand(NewProjectCommand(&clientOpts)))
 	command.AddCommand(initialize.InitCommand(NewAccountCommand(&clientOpts)))
 	command.AddCommand(NewLogoutCommand(&clientOpts))
+	command.AddCommand(NewAuthCommand(&clientOpts))
 	command.AddCommand(initialize.InitCommand(NewCertCommand(&clientOpts)))
 	command.AddCommand(initialize.InitCommand(NewGPGCommand(&clientOpts)))
 	command.AddCommand(admin.NewAdminCommand(&clientOpts))
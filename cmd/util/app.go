@@ -625,6 +625,10 @@ func constructAppsBaseOnName(appName string, labels, annotations, args []string,
 		Spec: argoappv1.ApplicationSpec{},
 	}
 	SetAppSpecOptions(flags, &app.Spec, &appOpts, 0)
+	if app.Spec.Project == "" {
+		// the project may be defaulted by the CLI context without setting the flag
+		app.Spec.Project = appOpts.project
+	}
 	SetParameterOverrides(app, appOpts.Parameters, 0)
 	mergeLabels(app, labels)
 	setAnnotations(app, annotations)
@@ -184,6 +184,9 @@ const (
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelKeyClusterKubernetesVersion contains the kubernetes version of the cluster secret if it has been enabled
 	LabelKeyClusterKubernetesVersion = "argocd.argoproj.io/kubernetes-version"
+	// LabelKeyApplicationSetShard is the label key of the shard of the ApplicationSet controller which reconciles an ApplicationSet, if
+	// the ApplicationSet controller shards the ApplicationSets by label
+	LabelKeyApplicationSetShard = "argocd.argoproj.io/applicationset-shard"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
 	// LabelValueSecretTypeRepository indicates a secret type of repository
@@ -196,6 +199,10 @@ const (
 	LabelValueSecretTypeRepoCredsWrite = "repo-write-creds"
 	// LabelValueSecretTypeSCMCreds indicates a secret type of SCM credentials
 	LabelValueSecretTypeSCMCreds = "scm-creds"
+	// LabelValueSecretTypeSOPS indicates a secret type of SOPS keys
+	LabelValueSecretTypeSOPS = "sops"
+	// LabelValueSecretTypeClusterConfig indicates a secret type of connection settings referenced by Cluster resources
+	LabelValueSecretTypeClusterConfig = "cluster-config"
 
 	// AnnotationKeyAppInstance is the Argo CD application name is used as the instance name
 	AnnotationKeyAppInstance = "argocd.argoproj.io/tracking-id"
@@ -211,6 +218,10 @@ const (
 	// can be disregarded.
 	AnnotationIgnoreHealthCheck = "argocd.argoproj.io/ignore-healthcheck"
 
+	// AnnotationHealthDependencies when set on a resource declares the resources, possibly in other namespaces or clusters, the health of
+	// the resource depends on, as a YAML list of references. The health of the resource is degraded if one of them is not healthy.
+	AnnotationHealthDependencies = "argocd.argoproj.io/health-dependencies"
+
 	// AnnotationKeyManagedBy is annotation name which indicates that k8s resource is managed by an application.
 	AnnotationKeyManagedBy = "managed-by"
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
@@ -234,6 +245,13 @@ const (
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
 
+	// AnnotationKeyManifestGenerateMaxConcurrency is the annotation on a repository secret which limits the number of
+	// concurrent manifest generations for the repository per repo-server, overriding the global parallelism limit
+	AnnotationKeyManifestGenerateMaxConcurrency = "argocd.argoproj.io/manifest-generate-max-concurrency"
+	// AnnotationKeyManifestGenerateMemoryLimit is the annotation on a repository secret which sets a soft memory budget,
+	// e.g. "1Gi", for every manifest generation subprocess of the repository
+	AnnotationKeyManifestGenerateMemoryLimit = "argocd.argoproj.io/manifest-generate-memory-limit"
+
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -264,6 +282,10 @@ const (
 	EnvGnuPGHome = "ARGOCD_GNUPGHOME"
 	// EnvWatchAPIBufferSize is the buffer size used to transfer K8S watch events to watch API consumer
 	EnvWatchAPIBufferSize = "ARGOCD_WATCH_API_BUFFER_SIZE"
+	// EnvResourceActionParallelismLimit is the maximum number of resources a bulk resource action is run on concurrently
+	EnvResourceActionParallelismLimit = "ARGOCD_RESOURCE_ACTION_PARALLELISM_LIMIT"
+	// EnvRepoValidationParallelismLimit is the maximum number of repositories a batch validation checks concurrently
+	EnvRepoValidationParallelismLimit = "ARGOCD_REPO_VALIDATION_PARALLELISM_LIMIT"
 	// EnvPauseGenerationAfterFailedAttempts will pause manifest generation after the specified number of failed generation attempts
 	EnvPauseGenerationAfterFailedAttempts = "ARGOCD_PAUSE_GEN_AFTER_FAILED_ATTEMPTS"
 	// EnvPauseGenerationMinutes pauses manifest generation for the specified number of minutes, after sufficient manifest generation failures
@@ -419,6 +441,31 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetPullRequestCommands is an annotation that is added to an ApplicationSet by the webhook to record the pull
+	// requests on which the comment command of a Pull Request generator was commented, as a comma separated list of <repository>#<number>.
+	AnnotationApplicationSetPullRequestCommands = "argocd.argoproj.io/application-set-pull-request-commands"
+	// AnnotationApplicationSetReapplyTemplate is an annotation that is added to an Application generated by an ApplicationSet to request the
+	// ApplicationSet controller to overwrite the Application with the latest rendered template, regardless of the preserved fields, the ignored
+	// application differences and the ApplicationSet policy. The ApplicationSet controller removes this annotation once the template is reapplied.
+	AnnotationApplicationSetReapplyTemplate = "argocd.argoproj.io/application-set-reapply-template"
+	// AnnotationApplicationSetSuspend is an annotation that can be set by the template of an ApplicationSet to suspend an individual generated
+	// Application. The ApplicationSet controller keeps a suspended Application up to date, but strips its automated sync policy and does not
+	// promote it during progressive syncs. It is also set on Applications generated from parameters with a true suspend parameter.
+	AnnotationApplicationSetSuspend = "argocd.argoproj.io/application-set-suspend"
+	// AnnotationApplicationSetRollingSyncMatchValues is an annotation that is set by the ApplicationSet controller on the Applications generated
+	// by an ApplicationSet whose RollingSync steps use templates in the values of their matchExpressions. It holds the values resolved against
+	// the parameters the Application is generated from, as a JSON list of the values of each matchExpression of each step.
+	AnnotationApplicationSetRollingSyncMatchValues = "argocd.argoproj.io/application-set-rolling-sync-match-values"
+	// AnnotationApplicationSetResumeStep is an annotation that is added to an ApplicationSet to resume its rollout after the RollingSync step
+	// of the given number, which pauses after its Applications are Healthy. The ApplicationSet controller removes this annotation once the
+	// Applications of the step are marked as resumed.
+	AnnotationApplicationSetResumeStep = "argocd.argoproj.io/application-set-resume-step"
+	// AnnotationApplicationSetDeleteProtection is an annotation that can be set on an Application generated by an ApplicationSet to protect
+	// it from being deleted by the ApplicationSet controller when it is no longer generated. The ApplicationSet controller skips the deletion
+	// of an Application with the value AnnotationValueApplicationSetDeleteProtectionEnabled and reports it in the conditions of the ApplicationSet.
+	AnnotationApplicationSetDeleteProtection = "argocd.argoproj.io/appset-delete-protection"
+	// AnnotationValueApplicationSetDeleteProtectionEnabled is the value of AnnotationApplicationSetDeleteProtection enabling the protection
+	AnnotationValueApplicationSetDeleteProtectionEnabled = "enabled"
 )
 
 // gRPC settings
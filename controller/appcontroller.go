@@ -71,6 +71,8 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/helm"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
+	metricsutil "github.com/argoproj/argo-cd/v3/util/metrics"
+	"github.com/argoproj/argo-cd/v3/util/profile"
 	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -79,6 +81,9 @@ const (
 	defaultDeploymentInformerResyncDuration = 10 * time.Second
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// verificationRollbackInfoName is the name of the operation info recording the revisions which were rolled back
+	// because their post-sync verification failed
+	verificationRollbackInfoName = "Rolled back revision"
 )
 
 type CompareWith int
@@ -129,6 +134,12 @@ type ApplicationController struct {
 	selfHealTimeout               time.Duration
 	selfHealBackoff               *wait.Backoff
 	syncTimeout                   time.Duration
+	operationLogLimit             int
+	operationTimings              map[string]*operationTimings
+	operationTimingsMutex         *sync.Mutex
+	statusUpdateBatchInterval     time.Duration
+	pendingStatusUpdates          map[string]*appv1.ApplicationStatus
+	pendingStatusUpdatesMutex     *sync.Mutex
 	db                            db.ArgoDB
 	settingsMgr                   *settings_util.SettingsManager
 	refreshRequestedApps          map[string]CompareWith
@@ -164,6 +175,8 @@ func NewApplicationController(
 	selfHealTimeout time.Duration,
 	selfHealBackoff *wait.Backoff,
 	syncTimeout time.Duration,
+	operationLogLimit int,
+	statusUpdateBatchInterval time.Duration,
 	repoErrorGracePeriod time.Duration,
 	metricsPort int,
 	metricsCacheExpiration time.Duration,
@@ -210,6 +223,12 @@ func NewApplicationController(
 		selfHealTimeout:                   selfHealTimeout,
 		selfHealBackoff:                   selfHealBackoff,
 		syncTimeout:                       syncTimeout,
+		operationLogLimit:                 operationLogLimit,
+		operationTimings:                  make(map[string]*operationTimings),
+		operationTimingsMutex:             &sync.Mutex{},
+		statusUpdateBatchInterval:         statusUpdateBatchInterval,
+		pendingStatusUpdates:              make(map[string]*appv1.ApplicationStatus),
+		pendingStatusUpdatesMutex:         &sync.Mutex{},
 		clusterSharding:                   clusterSharding,
 		projByNameCache:                   sync.Map{},
 		applicationNamespaces:             applicationNamespaces,
@@ -334,6 +353,9 @@ func NewApplicationController(
 	ctrl.deploymentInformer = deploymentInformer
 	ctrl.appStateManager = appStateManager
 	ctrl.stateCache = stateCache
+	profile.RegisterStats("clusterCache", func() any {
+		return statecache.GetClusterCacheStats(stateCache.GetClustersInfo())
+	})
 
 	return &ctrl, nil
 }
@@ -925,7 +947,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	}
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
-	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+	go func() { errors.CheckError(metricsutil.ListenAndServe(ctrl.metricsServer.Server)) }()
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -951,6 +973,10 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 		}
 	}, time.Second, ctx.Done())
 
+	if ctrl.statusUpdateBatchInterval > 0 {
+		go wait.Until(ctrl.flushStatusUpdates, ctrl.statusUpdateBatchInterval, ctx.Done())
+	}
+
 	if ctrl.hydrator != nil {
 		go wait.Until(func() {
 			for ctrl.processAppHydrateQueueItem() {
@@ -1374,6 +1400,10 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		if err := ctrl.cache.SetAppResourcesTree(app.Name, nil); err != nil {
 			return err
 		}
+
+		if err := ctrl.cache.DeleteAppOperationLog(app.InstanceName(ctrl.namespace)); err != nil {
+			return err
+		}
 		ctrl.projectRefreshQueue.Add(fmt.Sprintf("%s/%s", ctrl.namespace, app.Spec.GetProject()))
 	}
 
@@ -1422,6 +1452,14 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 	}
 }
 
+// operationContext returns the context of an iteration of the operation, which expires once the operation times out
+func (ctrl *ApplicationController) operationContext(state *appv1.OperationState) (context.Context, context.CancelFunc) {
+	if ctrl.syncTimeout == time.Duration(0) {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithDeadline(context.Background(), state.StartedAt.Add(ctrl.syncTimeout))
+}
+
 func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	var state *appv1.OperationState
@@ -1508,7 +1546,11 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	project, err := ctrl.getAppProj(app)
 	if err == nil {
 		// Start or resume the sync
-		ctrl.appStateManager.SyncAppState(app, project, state)
+		iterationStartedAt := time.Now()
+		opCtx, cancel := ctrl.operationContext(state)
+		ctrl.appStateManager.SyncAppState(opCtx, app, project, state)
+		cancel()
+		ctrl.observeOperationResources(app, state, iterationStartedAt)
 	} else {
 		state.Phase = synccommon.OperationError
 		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
@@ -1555,6 +1597,9 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 
 	ctrl.setOperationState(app, state)
 	ts.AddCheckpoint("final_set_operation_state")
+	if state.Phase == synccommon.OperationFailed {
+		ctrl.rollbackFailedVerification(app, state)
+	}
 	if state.Phase.Completed() && (app.Operation.Sync != nil && !app.Operation.Sync.DryRun) {
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
@@ -1651,6 +1696,7 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 		}
 		ctrl.metricsServer.IncSync(app, destServer, state)
 		ctrl.metricsServer.IncAppSyncDuration(app, destServer, state)
+		ctrl.recordOperationLog(app, state)
 	}
 }
 
@@ -1847,20 +1893,20 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
+	recordDegradedTransition(app, compareResult.healthStatus, now.Time)
+
 	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
 	if canSync {
-		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
+		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges, compareResult.manifestsHash)
 		setOpDuration = opDuration
+		evaluatedTypes := map[appv1.ApplicationConditionType]bool{
+			appv1.ApplicationConditionSyncError:                true,
+			appv1.ApplicationConditionAutoSyncSuspendedWarning: true,
+		}
 		if syncErrCond != nil {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{*syncErrCond},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+			app.Status.SetConditions([]appv1.ApplicationCondition{*syncErrCond}, evaluatedTypes)
 		} else {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+			app.Status.SetConditions([]appv1.ApplicationCondition{}, evaluatedTypes)
 		}
 	} else {
 		logCtx.Info("Sync prevented by sync window")
@@ -1876,6 +1922,13 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	sort.Slice(app.Status.Resources, func(i, j int) bool {
 		return resourceStatusKey(app.Status.Resources[i]) < resourceStatusKey(app.Status.Resources[j])
 	})
+	app.Status.ChildApplications = getChildApplicationsSummary(app, app.Status.Resources, func(namespace, name string) (*appv1.Application, error) {
+		if !ctrl.isAppNamespaceAllowed(&appv1.Application{ObjectMeta: metav1.ObjectMeta{Namespace: namespace}}) {
+			return nil, errChildApplicationNotWatched
+		}
+		return ctrl.appLister.Applications(namespace).Get(name)
+	})
+	app.Status.SetConditions(getChildApplicationsCondition(app.Status.ChildApplications), map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionChildApplicationWarning: true})
 	app.Status.SourceType = compareResult.appSourceType
 	app.Status.SourceTypes = compareResult.appSourceTypes
 	app.Status.ControllerNamespace = ctrl.namespace
@@ -2004,8 +2057,9 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 	compareWith := CompareWithLatest
 	refreshType := appv1.RefreshTypeNormal
 
-	softExpired := app.Status.ReconciledAt == nil || app.Status.ReconciledAt.Add(statusRefreshTimeout).Before(time.Now().UTC())
-	hardExpired := (app.Status.ReconciledAt == nil || app.Status.ReconciledAt.Add(statusHardRefreshTimeout).Before(time.Now().UTC())) && statusHardRefreshTimeout.Seconds() != 0
+	reconciledAt := ctrl.getReconciledAt(app)
+	softExpired := reconciledAt == nil || reconciledAt.Add(statusRefreshTimeout).Before(time.Now().UTC())
+	hardExpired := (reconciledAt == nil || reconciledAt.Add(statusHardRefreshTimeout).Before(time.Now().UTC())) && statusHardRefreshTimeout.Seconds() != 0
 
 	if requestedType, ok := app.IsRefreshRequested(); ok {
 		compareWith = CompareWithLatestForceResolve
@@ -2024,8 +2078,8 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 			// reason = fmt.Sprintf("comparison expired. reconciledAt: %v, expiry: %v", app.Status.ReconciledAt, statusRefreshTimeout)
 			// TODO: find existing Golang bug or create a new one
 			reconciledAtStr := "never"
-			if app.Status.ReconciledAt != nil {
-				reconciledAtStr = app.Status.ReconciledAt.String()
+			if reconciledAt != nil {
+				reconciledAtStr = reconciledAt.String()
 			}
 			reason = fmt.Sprintf("comparison expired, requesting refresh. reconciledAt: %v, expiry: %v", reconciledAtStr, statusRefreshTimeout)
 			if hardExpired {
@@ -2058,6 +2112,9 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 		errorConditions = append(errorConditions, ctrl.projectErrorToCondition(err, app))
 	} else {
 		specConditions, err := argo.ValidatePermissions(context.Background(), &app.Spec, proj, ctrl.db)
+		if err == nil && len(specConditions) == 0 {
+			specConditions, err = argo.ValidateAppNamespaceDestination(context.Background(), app.Namespace, &app.Spec, ctrl.settingsMgr, ctrl.db)
+		}
 		if err != nil {
 			errorConditions = append(errorConditions, appv1.ApplicationCondition{
 				Type:    appv1.ApplicationConditionUnknownError,
@@ -2148,6 +2205,20 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 		logCtx.Infof("No status changes. Skipping patch")
 		return patchDuration
 	}
+	if ctrl.statusUpdateBatchInterval > 0 {
+		nonCritical, err := isNonCriticalStatusUpdate(orig, newStatus, newAnnotations)
+		if err != nil {
+			logCtx.WithError(err).Error("Error constructing app status patch")
+			return patchDuration
+		}
+		if nonCritical {
+			logCtx.Debugf("Only non-critical status changes. Batching patch")
+			ctrl.queueStatusUpdate(orig, newStatus)
+			return patchDuration
+		}
+		// the whole status is persisted below, which makes a queued update obsolete
+		ctrl.dropQueuedStatusUpdate(orig)
+	}
 	// calculate time for path call
 	start := time.Now()
 	defer func() {
@@ -2163,7 +2234,7 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 }
 
 // autoSync will initiate a sync operation for an application configured with automated sync
-func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool) (*appv1.ApplicationCondition, time.Duration) {
+func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool, manifestsHash string) (*appv1.ApplicationCondition, time.Duration) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	ts := stats.NewTimingStats()
 	defer func() {
@@ -2185,6 +2256,13 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		logCtx.Infof("Skipping auto-sync: deletion in progress")
 		return nil, 0
 	}
+	if suspendedCond, remainingTime := flapDetectionSuspension(app, time.Now()); suspendedCond != nil {
+		logCtx.Warnf("Skipping auto-sync: %s", suspendedCond.Message)
+		if remainingTime > 0 {
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remainingTime)
+		}
+		return suspendedCond, 0
+	}
 
 	// Only perform auto-sync if we detect OutOfSync status. This is to prevent us from attempting
 	// a sync when application is already in a Synced or Unknown state
@@ -2230,12 +2308,24 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		op.Retry = *app.Spec.SyncPolicy.Retry
 	}
 
+	// Do not sync again to revisions which were rolled back because their post-sync verification failed
+	if rolledBack := verificationRolledBackRevisions(app); rolledBack != "" && rolledBack == strings.Join(desiredRevisions, ",") {
+		message := fmt.Sprintf("Skipping auto-sync: post-sync verification of %s failed and the application was rolled back", desiredRevisions)
+		logCtx.Warn(message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}, 0
+	}
+
 	// It is possible for manifests to remain OutOfSync even after a sync/kubectl apply (e.g.
 	// auto-sync with pruning disabled). We need to ensure that we do not keep Syncing an
 	// application in an infinite loop. To detect this, we only attempt the Sync if the revision
 	// and parameter overrides are different from our most recent sync operation.
 	alreadyAttempted, lastAttemptedRevisions, lastAttemptedPhase := alreadyAttemptedSync(app, desiredRevisions, shouldCompareRevisions)
 	ts.AddCheckpoint("already_attempted_sync_ms")
+	if !alreadyAttempted && alreadySyncedManifests(app, desiredRevisions, shouldCompareRevisions, manifestsHash) {
+		// the sources changed without changing the manifests, e.g. a refresh resolved the same revision again
+		logCtx.Infof("Most recent successful sync to %s already applied identical manifests", desiredRevisions)
+		alreadyAttempted = true
+	}
 	if alreadyAttempted {
 		if !lastAttemptedPhase.Successful() {
 			logCtx.Warnf("Skipping auto-sync: failed previous sync attempt to %s and will not retry for %s", lastAttemptedRevisions, desiredRevisions)
@@ -2312,6 +2402,84 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	return nil, setOpTime
 }
 
+// rollbackFailedVerification syncs the application back to the most recently deployed revision if the post-sync
+// verification of the given operation failed and the application enables rollback. Rollback operations are not rolled
+// back again.
+func (ctrl *ApplicationController) rollbackFailedVerification(app *appv1.Application, state *appv1.OperationState) {
+	verification := app.Spec.SyncPolicy.GetVerification()
+	if verification == nil || !verification.Rollback || state.SyncResult == nil || !state.SyncResult.Verification.Failed() {
+		return
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	if operationInfo(&state.Operation, verificationRollbackInfoName) != "" {
+		logCtx.Warn("Skipping rollback: post-sync verification of a rollback failed")
+		return
+	}
+	failedRevisions := joinRevisions(state.SyncResult.Revision, state.SyncResult.Revisions)
+	var target *appv1.RevisionHistory
+	for i := len(app.Status.History) - 1; i >= 0; i-- {
+		if joinRevisions(app.Status.History[i].Revision, app.Status.History[i].Revisions) != failedRevisions {
+			target = &app.Status.History[i]
+			break
+		}
+	}
+	if target == nil {
+		logCtx.Warnf("Skipping rollback: no previously deployed revision other than %s", failedRevisions)
+		return
+	}
+
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:     target.Revision,
+			Revisions:    target.Revisions,
+			Prune:        app.Spec.SyncPolicy.IsAutomatedSyncEnabled() && app.Spec.SyncPolicy.Automated.Prune,
+			SyncOptions:  app.Spec.SyncPolicy.SyncOptions,
+			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
+			Source:       &target.Source,
+			Sources:      target.Sources,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Info:        []*appv1.Info{{Name: verificationRollbackInfoName, Value: failedRevisions}},
+	}
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	if err != nil {
+		logCtx.WithError(err).Errorf("Failed to initiate rollback to %s", joinRevisions(target.Revision, target.Revisions))
+		return
+	}
+	ctrl.writeBackToInformer(updatedApp)
+
+	message := fmt.Sprintf("Initiated rollback to %s after post-sync verification of %s failed", joinRevisions(target.Revision, target.Revisions), failedRevisions)
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeWarning}, message)
+	logCtx.Info(message)
+}
+
+// verificationRolledBackRevisions returns the revisions which were rolled back by the most recent operation because
+// their post-sync verification failed, or an empty string if the operation was not such a rollback
+func verificationRolledBackRevisions(app *appv1.Application) string {
+	if app.Status.OperationState == nil {
+		return ""
+	}
+	return operationInfo(&app.Status.OperationState.Operation, verificationRollbackInfoName)
+}
+
+// operationInfo returns the value of the operation info with the given name
+func operationInfo(op *appv1.Operation, name string) string {
+	for _, info := range op.Info {
+		if info != nil && info.Name == name {
+			return info.Value
+		}
+	}
+	return ""
+}
+
+func joinRevisions(revision string, revisions []string) string {
+	if len(revisions) > 0 {
+		return strings.Join(revisions, ",")
+	}
+	return revision
+}
+
 // alreadyAttemptedSync returns whether the most recently synced revision(s) exactly match the given desiredRevisions
 // and for the same application source. If the revision(s) have changed or the Application source configuration has been updated,
 // it will return false, indicating that a new sync should be attempted.
@@ -2352,6 +2520,74 @@ func alreadyAttemptedSync(app *appv1.Application, desiredRevisions []string, new
 	return reflect.DeepEqual(app.Spec.GetSource(), app.Status.OperationState.SyncResult.Source), []string{app.Status.OperationState.SyncResult.Revision}, app.Status.OperationState.Phase
 }
 
+// alreadySyncedManifests returns whether the most recent sync operation succeeded to the given desiredRevisions with
+// manifests identical to the given hash, even if the sources of the application have been updated since. When
+// compareRevisions is false, due to commits not having direct changes on the application, only the manifests are
+// compared.
+func alreadySyncedManifests(app *appv1.Application, desiredRevisions []string, compareRevisions bool, manifestsHash string) bool {
+	state := app.Status.OperationState
+	if manifestsHash == "" || state == nil || state.SyncResult == nil || !state.Phase.Successful() {
+		return false
+	}
+	if state.SyncResult.ManifestsHash != manifestsHash {
+		return false
+	}
+	if !compareRevisions {
+		return true
+	}
+	if app.Spec.HasMultipleSources() {
+		return reflect.DeepEqual(state.SyncResult.Revisions, desiredRevisions)
+	}
+	return len(desiredRevisions) == 1 && state.SyncResult.Revision == desiredRevisions[0]
+}
+
+// recordDegradedTransition records the time at which the application health changed to Degraded if flap detection is
+// enabled for automated sync, and forgets the transitions which happened before the flap detection window
+func recordDegradedTransition(app *appv1.Application, healthStatus health.HealthStatusCode, now time.Time) {
+	flapDetection := app.Spec.SyncPolicy.GetFlapDetection()
+	if flapDetection == nil {
+		app.Status.Health.DegradedTransitionTimes = nil
+		return
+	}
+	window, err := flapDetection.GetWindow()
+	if err != nil {
+		// the invalid window is reported by flapDetectionSuspension
+		return
+	}
+	var transitions []metav1.Time
+	for _, transition := range app.Status.Health.DegradedTransitionTimes {
+		if now.Sub(transition.Time) < window {
+			transitions = append(transitions, transition)
+		}
+	}
+	if healthStatus == health.HealthStatusDegraded && app.Status.Health.Status != health.HealthStatusDegraded {
+		transitions = append(transitions, metav1.NewTime(now))
+	}
+	app.Status.Health.DegradedTransitionTimes = transitions
+}
+
+// flapDetectionSuspension returns the condition suspending automated sync if the application health changed to
+// Degraded more often within the flap detection window than allowed, and the remaining time of the suspension
+func flapDetectionSuspension(app *appv1.Application, now time.Time) (*appv1.ApplicationCondition, time.Duration) {
+	flapDetection := app.Spec.SyncPolicy.GetFlapDetection()
+	if flapDetection == nil {
+		return nil, 0
+	}
+	window, err := flapDetection.GetWindow()
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: fmt.Sprintf("Invalid flap detection window: %v", err)}, 0
+	}
+	transitions := app.Status.Health.DegradedTransitionTimes
+	excess := int64(len(transitions)) - flapDetection.MaxDegradedTransitions
+	if excess <= 0 {
+		return nil, 0
+	}
+	// the suspension ends once enough transitions left the window, so that no more than the maximum remain
+	remainingTime := transitions[excess-1].Add(window).Sub(now)
+	message := fmt.Sprintf("Automated sync is suspended because the application health changed to Degraded more than %d times within %s", flapDetection.MaxDegradedTransitions, window)
+	return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionAutoSyncSuspendedWarning, Message: message}, remainingTime
+}
+
 func (ctrl *ApplicationController) selfHealRemainingBackoff(app *appv1.Application, selfHealAttemptsCount int) time.Duration {
 	if app.Status.OperationState == nil {
 		return time.Duration(0)
@@ -2566,6 +2802,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				delApp, delOK := obj.(*appv1.Application)
 				if err == nil && delOK {
 					ctrl.clusterSharding.DeleteApp(delApp)
+					ctrl.deleteOperationTimings(delApp)
 				}
 			},
 		},
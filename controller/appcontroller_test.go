@@ -76,6 +76,7 @@ type fakeData struct {
 	applicationNamespaces          []string
 	updateRevisionForPathsResponse *apiclient.UpdateRevisionForPathsResponse
 	additionalObjs                 []runtime.Object
+	serverVersion                  string
 }
 
 type MockKubectl struct {
@@ -180,6 +181,8 @@ func newFakeControllerWithResync(ctx context.Context, data *fakeData, appResyncP
 		time.Minute,
 		nil,
 		0,
+		0,
+		0,
 		time.Second*10,
 		common.DefaultPortArgoCDMetrics,
 		data.metricsCacheExpiration,
@@ -218,7 +221,11 @@ func newFakeControllerWithResync(ctx context.Context, data *fakeData, appResyncP
 	ctrl.stateCache = mockStateCache
 	mockStateCache.EXPECT().IsNamespaced(mock.Anything, mock.Anything).Return(true, nil)
 	mockStateCache.EXPECT().GetManagedLiveObjs(mock.Anything, mock.Anything, mock.Anything).Return(data.managedLiveObjs, nil)
-	mockStateCache.EXPECT().GetVersionsInfo(mock.Anything).Return("v1.2.3", nil, nil)
+	serverVersion := "v1.2.3"
+	if data.serverVersion != "" {
+		serverVersion = data.serverVersion
+	}
+	mockStateCache.EXPECT().GetVersionsInfo(mock.Anything).Return(serverVersion, nil, nil)
 	response := make(map[kube.ResourceKey]v1alpha1.ResourceNode)
 	for k, v := range data.namespacedResources {
 		response[k] = v.ResourceNode
@@ -641,7 +648,7 @@ func TestAutoSync(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, "")
 	assert.Nil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 	require.NoError(t, err)
@@ -659,7 +666,7 @@ func TestAutoSyncEnabledSetToTrue(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, "")
 	assert.Nil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 	require.NoError(t, err)
@@ -680,7 +687,7 @@ func TestAutoSyncMultiSourceWithoutSelfHeal(t *testing.T) {
 			Status:    v1alpha1.SyncStatusCodeOutOfSync,
 			Revisions: []string{"z", "x", "v"},
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, "")
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -695,7 +702,7 @@ func TestAutoSyncMultiSourceWithoutSelfHeal(t *testing.T) {
 			Status:    v1alpha1.SyncStatusCodeOutOfSync,
 			Revisions: []string{"a", "b", "c"},
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook-1", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, "")
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -711,7 +718,7 @@ func TestAutoSyncNotAllowEmpty(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true, "")
 	assert.NotNil(t, cond)
 }
 
@@ -724,7 +731,7 @@ func TestAutoSyncAllowEmpty(t *testing.T) {
 		Status:   v1alpha1.SyncStatusCodeOutOfSync,
 		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true, "")
 	assert.Nil(t, cond)
 }
 
@@ -738,7 +745,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true, "")
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -753,7 +760,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeSynced,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true, "")
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -769,7 +776,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true, "")
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -786,7 +793,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true, "")
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -803,7 +810,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{}, true, "")
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -829,7 +836,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, "")
 		assert.NotNil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -853,7 +860,7 @@ func TestSkipAutoSync(t *testing.T) {
 			Status:   v1alpha1.SyncStatusCodeOutOfSync,
 			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, "")
 		assert.NotNil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -869,7 +876,7 @@ func TestSkipAutoSync(t *testing.T) {
 		}
 		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{
 			{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync, RequiresPruning: true},
-		}, true)
+		}, true, "")
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -905,13 +912,235 @@ func TestAutoSyncIndicateError(t *testing.T) {
 			Source:   *app.Spec.Source.DeepCopy(),
 		},
 	}
-	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, "")
 	assert.NotNil(t, cond)
 	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 	require.NoError(t, err)
 	assert.Nil(t, app.Operation)
 }
 
+// TestAutoSyncFlapDetection verifies we skip auto-sync and return a condition if the application health is flapping
+func TestAutoSyncFlapDetection(t *testing.T) {
+	now := time.Now()
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+
+	t.Run("Suspended", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.FlapDetection = &v1alpha1.SyncPolicyAutomatedFlapDetection{MaxDegradedTransitions: 2, Window: "10m"}
+		app.Status.Health.DegradedTransitionTimes = []metav1.Time{
+			metav1.NewTime(now.Add(-8 * time.Minute)),
+			metav1.NewTime(now.Add(-5 * time.Minute)),
+			metav1.NewTime(now.Add(-time.Minute)),
+		}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true, "")
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionAutoSyncSuspendedWarning, cond.Type)
+		assert.Equal(t, "Automated sync is suspended because the application health changed to Degraded more than 2 times within 10m0s", cond.Message)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("NotSuspended", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.FlapDetection = &v1alpha1.SyncPolicyAutomatedFlapDetection{MaxDegradedTransitions: 2, Window: "10m"}
+		app.Status.Health.DegradedTransitionTimes = []metav1.Time{
+			metav1.NewTime(now.Add(-5 * time.Minute)),
+			metav1.NewTime(now.Add(-time.Minute)),
+		}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true, "")
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+
+	t.Run("InvalidWindow", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.FlapDetection = &v1alpha1.SyncPolicyAutomatedFlapDetection{MaxDegradedTransitions: 2, Window: "invalid"}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true, "")
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+	})
+}
+
+// TestAutoSyncVerificationRolledBack verifies we skip auto-sync to revisions which were rolled back because their
+// post-sync verification failed
+func TestAutoSyncVerificationRolledBack(t *testing.T) {
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+	newRolledBackApp := func() *v1alpha1.Application {
+		app := newFakeApp()
+		app.Status.OperationState.Operation.Info = []*v1alpha1.Info{{Name: verificationRollbackInfoName, Value: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}}
+		return app
+	}
+
+	t.Run("RolledBackRevision", func(t *testing.T) {
+		app := newRolledBackApp()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		syncStatus := v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true, "")
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+		assert.Contains(t, cond.Message, "post-sync verification of [bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb] failed")
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("NewRevision", func(t *testing.T) {
+		app := newRolledBackApp()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		syncStatus := v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: "cccccccccccccccccccccccccccccccccccccccc"}
+		cond, _ := ctrl.autoSync(app, &syncStatus, resources, true, "")
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+}
+
+func TestRollbackFailedVerification(t *testing.T) {
+	newVerifiedApp := func() *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Verification = &v1alpha1.SyncPolicyVerification{
+			Probes:   []v1alpha1.SyncVerificationProbe{{Name: "smoke", HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: "http://my-app/healthz"}}},
+			Rollback: true,
+		}
+		app.Status.History = v1alpha1.RevisionHistories{
+			{ID: 1, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Source: *app.Spec.Source.DeepCopy()},
+		}
+		return app
+	}
+	newFailedState := func() *v1alpha1.OperationState {
+		return &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}},
+			Phase:     synccommon.OperationFailed,
+			SyncResult: &v1alpha1.SyncOperationResult{
+				Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+				Verification: &v1alpha1.SyncVerificationResult{
+					Probes: []v1alpha1.SyncVerificationProbeResult{{Name: "smoke", Attempts: 1, Message: "connection refused"}},
+				},
+			},
+		}
+	}
+	getOperation := func(t *testing.T, ctrl *ApplicationController) *v1alpha1.Operation {
+		t.Helper()
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		return app.Operation
+	}
+
+	t.Run("RollsBack", func(t *testing.T) {
+		app := newVerifiedApp()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		ctrl.rollbackFailedVerification(app, newFailedState())
+		op := getOperation(t, ctrl)
+		require.NotNil(t, op)
+		require.NotNil(t, op.Sync)
+		assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", op.Sync.Revision)
+		assert.True(t, op.InitiatedBy.Automated)
+		assert.Equal(t, []*v1alpha1.Info{{Name: verificationRollbackInfoName, Value: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}}, op.Info)
+	})
+
+	t.Run("RollbackDisabled", func(t *testing.T) {
+		app := newVerifiedApp()
+		app.Spec.SyncPolicy.Verification.Rollback = false
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		ctrl.rollbackFailedVerification(app, newFailedState())
+		assert.Nil(t, getOperation(t, ctrl))
+	})
+
+	t.Run("VerificationSucceeded", func(t *testing.T) {
+		app := newVerifiedApp()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		state := newFailedState()
+		state.SyncResult.Verification = nil
+		ctrl.rollbackFailedVerification(app, state)
+		assert.Nil(t, getOperation(t, ctrl))
+	})
+
+	t.Run("NoPreviousRevision", func(t *testing.T) {
+		app := newVerifiedApp()
+		app.Status.History[0].Revision = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		ctrl.rollbackFailedVerification(app, newFailedState())
+		assert.Nil(t, getOperation(t, ctrl))
+	})
+
+	t.Run("RollbackIsNotRolledBack", func(t *testing.T) {
+		app := newVerifiedApp()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		state := newFailedState()
+		state.Operation.Info = []*v1alpha1.Info{{Name: verificationRollbackInfoName, Value: "cccccccccccccccccccccccccccccccccccccccc"}}
+		ctrl.rollbackFailedVerification(app, state)
+		assert.Nil(t, getOperation(t, ctrl))
+	})
+}
+
+func TestFlapDetectionSuspension(t *testing.T) {
+	now := time.Now()
+	app := newFakeApp()
+	app.Spec.SyncPolicy.Automated.FlapDetection = &v1alpha1.SyncPolicyAutomatedFlapDetection{MaxDegradedTransitions: 1, Window: "10m"}
+	app.Status.Health.DegradedTransitionTimes = []metav1.Time{
+		metav1.NewTime(now.Add(-8 * time.Minute)),
+		metav1.NewTime(now.Add(-5 * time.Minute)),
+		metav1.NewTime(now.Add(-time.Minute)),
+	}
+	cond, remainingTime := flapDetectionSuspension(app, now)
+	require.NotNil(t, cond)
+	// the suspension ends once only the most recent transition is within the window
+	assert.Equal(t, 5*time.Minute, remainingTime)
+
+	app.Spec.SyncPolicy.Automated.Enabled = ptr.To(false)
+	cond, _ = flapDetectionSuspension(app, now)
+	assert.Nil(t, cond)
+}
+
+func TestRecordDegradedTransition(t *testing.T) {
+	now := time.Now()
+	newApp := func(status health.HealthStatusCode, transitions ...time.Time) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.FlapDetection = &v1alpha1.SyncPolicyAutomatedFlapDetection{MaxDegradedTransitions: 3}
+		app.Status.Health.Status = status
+		for _, transition := range transitions {
+			app.Status.Health.DegradedTransitionTimes = append(app.Status.Health.DegradedTransitionTimes, metav1.NewTime(transition))
+		}
+		return app
+	}
+
+	t.Run("RecordTransitionToDegraded", func(t *testing.T) {
+		app := newApp(health.HealthStatusHealthy, now.Add(-time.Minute))
+		recordDegradedTransition(app, health.HealthStatusDegraded, now)
+		assert.Equal(t, []metav1.Time{metav1.NewTime(now.Add(-time.Minute)), metav1.NewTime(now)}, app.Status.Health.DegradedTransitionTimes)
+	})
+
+	t.Run("IgnoreUnchangedHealth", func(t *testing.T) {
+		app := newApp(health.HealthStatusDegraded, now.Add(-time.Minute))
+		recordDegradedTransition(app, health.HealthStatusDegraded, now)
+		assert.Equal(t, []metav1.Time{metav1.NewTime(now.Add(-time.Minute))}, app.Status.Health.DegradedTransitionTimes)
+	})
+
+	t.Run("ForgetTransitionsOutsideOfWindow", func(t *testing.T) {
+		app := newApp(health.HealthStatusDegraded, now.Add(-2*time.Hour), now.Add(-time.Minute))
+		recordDegradedTransition(app, health.HealthStatusHealthy, now)
+		assert.Equal(t, []metav1.Time{metav1.NewTime(now.Add(-time.Minute))}, app.Status.Health.DegradedTransitionTimes)
+	})
+
+	t.Run("FlapDetectionDisabled", func(t *testing.T) {
+		app := newApp(health.HealthStatusHealthy, now.Add(-time.Minute))
+		app.Spec.SyncPolicy.Automated.FlapDetection = nil
+		recordDegradedTransition(app, health.HealthStatusDegraded, now)
+		assert.Nil(t, app.Status.Health.DegradedTransitionTimes)
+	})
+}
+
 // TestAutoSyncParameterOverrides verifies we auto-sync if revision is same but parameter overrides are different
 func TestAutoSyncParameterOverrides(t *testing.T) {
 	t.Run("Single source", func(t *testing.T) {
@@ -949,7 +1178,7 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 			Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		}
 		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, "")
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -980,7 +1209,7 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 			Status:    v1alpha1.SyncStatusCodeOutOfSync,
 			Revisions: []string{"z", "x", "v"},
 		}
-		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true, "")
 		assert.Nil(t, cond)
 		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
 		require.NoError(t, err)
@@ -988,6 +1217,47 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 	})
 }
 
+// TestAutoSyncUnchangedManifests verifies we skip auto-sync if the sources changed without changing the manifests
+func TestAutoSyncUnchangedManifests(t *testing.T) {
+	newApp := func(manifestsHash string, phase synccommon.OperationPhase) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.Source.Helm = &v1alpha1.ApplicationSourceHelm{ReleaseName: "guestbook"}
+		app.Status.OperationState.Phase = phase
+		app.Status.OperationState.SyncResult.ManifestsHash = manifestsHash
+		return app
+	}
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+
+	for _, tc := range []struct {
+		name          string
+		app           *v1alpha1.Application
+		revision      string
+		manifestsHash string
+		expectSync    bool
+	}{
+		{name: "identical manifests", app: newApp("hash", synccommon.OperationSucceeded), revision: syncStatus.Revision, manifestsHash: "hash", expectSync: false},
+		{name: "changed manifests", app: newApp("hash", synccommon.OperationSucceeded), revision: syncStatus.Revision, manifestsHash: "other", expectSync: true},
+		{name: "changed revision", app: newApp("hash", synccommon.OperationSucceeded), revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", manifestsHash: "hash", expectSync: true},
+		{name: "failed sync", app: newApp("hash", synccommon.OperationFailed), revision: syncStatus.Revision, manifestsHash: "hash", expectSync: true},
+		{name: "no hash of the previous sync", app: newApp("", synccommon.OperationSucceeded), revision: syncStatus.Revision, manifestsHash: "", expectSync: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{tc.app}}, nil)
+			status := syncStatus
+			status.Revision = tc.revision
+			cond, _ := ctrl.autoSync(tc.app, &status, resources, true, tc.manifestsHash)
+			assert.Nil(t, cond)
+			app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectSync, app.Operation != nil)
+		})
+	}
+}
+
 // TestFinalizeAppDeletion verifies application deletion
 func TestFinalizeAppDeletion(t *testing.T) {
 	now := metav1.Now()
@@ -1969,6 +2239,24 @@ func TestRefreshAppConditions(t *testing.T) {
 		assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
 		assert.Equal(t, "Application referencing project wrong project which does not exist", app.Status.Conditions[0].Message)
 	})
+
+	t.Run("DestinationNotPermittedByNamespaceSettings", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}, configMapData: map[string]string{
+			"application.namespaceSettings": `
+- namespace: ` + test.FakeArgoCDNamespace + `
+  destinations:
+  - server: "*"
+    namespace: team-*
+`,
+		}}, nil)
+
+		_, hasErrors := ctrl.refreshAppConditions(app)
+		assert.True(t, hasErrors)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
+		assert.Contains(t, app.Status.Conditions[0].Message, "do not match any of the allowed destinations of namespace '"+test.FakeArgoCDNamespace+"'")
+	})
 }
 
 func TestUpdateReconciledAt(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os/exec"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -183,6 +184,11 @@ type ResourceInfo struct {
 	NodeInfo *NodeInfo
 
 	manifestHash string
+	// otherInstallation indicates that the resource is tracked by another Argo CD installation
+	otherInstallation bool
+	// fieldManagerUpdates holds the last update time of every managed fields entry, keyed by manager, operation and
+	// subresource. It is only populated if updates from some field managers are ignored.
+	fieldManagerUpdates map[fieldManagerUpdateKey]string
 }
 
 func NewLiveStateCache(
@@ -216,6 +222,9 @@ type cacheSettings struct {
 
 	// ignoreResourceUpdates is a flag to enable resource-ignore rules.
 	ignoreResourceUpdatesEnabled bool
+
+	// ignoreResourceUpdatesFieldManagers is a list of field managers whose updates of resources are ignored
+	ignoreResourceUpdatesFieldManagers []string
 }
 
 type liveStateCache struct {
@@ -254,6 +263,10 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	if err != nil {
 		return nil, err
 	}
+	ignoreResourceUpdatesFieldManagers, err := c.settingsMgr.GetIgnoreResourceUpdatesFieldManagers()
+	if err != nil {
+		return nil, err
+	}
 	resourcesFilter, err := c.settingsMgr.GetResourcesFilter()
 	if err != nil {
 		return nil, err
@@ -267,7 +280,7 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 		ResourcesFilter:        resourcesFilter,
 	}
 
-	return &cacheSettings{clusterSettings, appInstanceLabelKey, appv1.TrackingMethod(trackingMethod), installationID, resourceUpdatesOverrides, ignoreResourceUpdatesEnabled}, nil
+	return &cacheSettings{clusterSettings, appInstanceLabelKey, appv1.TrackingMethod(trackingMethod), installationID, resourceUpdatesOverrides, ignoreResourceUpdatesEnabled, ignoreResourceUpdatesFieldManagers}, nil
 }
 
 func asResourceNode(r *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource) appv1.ResourceNode {
@@ -397,6 +410,58 @@ func skipResourceUpdate(oldInfo, newInfo *ResourceInfo) bool {
 	return isSameHealthStatus && isSameManifest
 }
 
+type fieldManagerUpdateKey struct {
+	manager     string
+	operation   metav1.ManagedFieldsOperationType
+	subresource string
+}
+
+// getFieldManagerUpdates returns the last update time of every managed fields entry of the resource
+func getFieldManagerUpdates(un *unstructured.Unstructured) map[fieldManagerUpdateKey]string {
+	updates := map[fieldManagerUpdateKey]string{}
+	for _, entry := range un.GetManagedFields() {
+		var updatedAt string
+		if entry.Time != nil {
+			updatedAt = entry.Time.UTC().Format(time.RFC3339)
+		}
+		updates[fieldManagerUpdateKey{entry.Manager, entry.Operation, entry.Subresource}] = updatedAt
+	}
+	return updates
+}
+
+// isIgnoredFieldManagerUpdate returns true if the resource update was made by one of the ignored field managers only.
+// Changes of the managed fields entries of the resource are used to determine the field managers involved in the update.
+func isIgnoredFieldManagerUpdate(oldInfo, newInfo *ResourceInfo, ignoredManagers []string) bool {
+	if len(ignoredManagers) == 0 || oldInfo == nil || newInfo == nil || oldInfo.fieldManagerUpdates == nil || newInfo.fieldManagerUpdates == nil {
+		return false
+	}
+	isSameHealthStatus := (oldInfo.Health == nil && newInfo.Health == nil) || oldInfo.Health != nil && newInfo.Health != nil && oldInfo.Health.Status == newInfo.Health.Status
+	if !isSameHealthStatus {
+		return false
+	}
+	changed := map[fieldManagerUpdateKey]bool{}
+	for key, updatedAt := range newInfo.fieldManagerUpdates {
+		if oldUpdatedAt, ok := oldInfo.fieldManagerUpdates[key]; !ok || oldUpdatedAt != updatedAt {
+			changed[key] = true
+		}
+	}
+	for key := range oldInfo.fieldManagerUpdates {
+		if _, ok := newInfo.fieldManagerUpdates[key]; !ok {
+			changed[key] = true
+		}
+	}
+	// without any changed entry, the update cannot be attributed to a field manager
+	if len(changed) == 0 {
+		return false
+	}
+	for key := range changed {
+		if !slices.Contains(ignoredManagers, key.manager) {
+			return false
+		}
+	}
+	return true
+}
+
 // shouldHashManifest validates if the API resource needs to be hashed.
 // If there's an app name from resource tracking, or if this is itself an app, we should generate a hash.
 // Otherwise, the hashing should be skipped to save CPU time.
@@ -566,6 +631,7 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 			if isRoot && appName != "" {
 				res.AppName = appName
 			}
+			res.otherInstallation = argo.IsTrackedByOtherInstallation(un, cacheSettings.installationID)
 
 			gvk := un.GroupVersionKind()
 
@@ -576,6 +642,9 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 				} else {
 					res.manifestHash = hash
 				}
+				if len(cacheSettings.ignoreResourceUpdatesFieldManagers) > 0 {
+					res.fieldManagerUpdates = getFieldManagerUpdates(un)
+				}
 			}
 
 			// edge case. we do not label CRDs, so they miss the tracking label we inject. But we still
@@ -604,23 +673,32 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		cacheSettings := c.cacheSettings
 		c.lock.RUnlock()
 
-		if cacheSettings.ignoreResourceUpdatesEnabled && oldRes != nil && newRes != nil && skipResourceUpdate(resInfo(oldRes), resInfo(newRes)) {
-			// Additional check for debug level so we don't need to evaluate the
-			// format string in case of non-debug scenarios
-			if log.GetLevel() >= log.DebugLevel {
-				namespace := ref.Namespace
-				if ref.Namespace == "" {
-					namespace = "(cluster-scoped)"
+		if cacheSettings.ignoreResourceUpdatesEnabled && oldRes != nil && newRes != nil {
+			var reason string
+			switch {
+			case skipResourceUpdate(resInfo(oldRes), resInfo(newRes)):
+				reason = "none of the watched resource fields have changed"
+			case isIgnoredFieldManagerUpdate(resInfo(oldRes), resInfo(newRes), cacheSettings.ignoreResourceUpdatesFieldManagers):
+				reason = "it was made by an ignored field manager"
+			}
+			if reason != "" {
+				// Additional check for debug level so we don't need to evaluate the
+				// format string in case of non-debug scenarios
+				if log.GetLevel() >= log.DebugLevel {
+					namespace := ref.Namespace
+					if ref.Namespace == "" {
+						namespace = "(cluster-scoped)"
+					}
+					log.WithFields(log.Fields{
+						"server":      cluster.Server,
+						"namespace":   namespace,
+						"name":        ref.Name,
+						"api-version": ref.APIVersion,
+						"kind":        ref.Kind,
+					}).Debugf("Ignoring change of object because %s", reason)
 				}
-				log.WithFields(log.Fields{
-					"server":      cluster.Server,
-					"namespace":   namespace,
-					"name":        ref.Name,
-					"api-version": ref.APIVersion,
-					"kind":        ref.Kind,
-				}).Debug("Ignoring change of object because none of the watched resource fields have changed")
+				return
 			}
-			return
 		}
 
 		for _, r := range []*clustercache.Resource{newRes, oldRes} {
@@ -713,7 +791,11 @@ func (c *liveStateCache) GetNamespaceTopLevelResources(server *appv1.Cluster, na
 	if err != nil {
 		return nil, err
 	}
-	resources := clusterInfo.FindResources(namespace, clustercache.TopLevelResource)
+	// resources of other Argo CD installations managing the same cluster are never orphaned resources of this one
+	resources := clusterInfo.FindResources(namespace, clustercache.TopLevelResource, func(r *clustercache.Resource) bool {
+		info, ok := r.Info.(*ResourceInfo)
+		return !ok || !info.otherInstallation
+	})
 
 	// Get all namespace resources for parent lookups
 	namespaceResources := clusterInfo.FindResources(namespace, func(_ *clustercache.Resource) bool {
@@ -922,6 +1004,31 @@ func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
 	return res
 }
 
+// ClusterCacheStats are statistics of the cluster caches reported by the runtime diagnostics of the controller
+type ClusterCacheStats struct {
+	// Clusters is the number of cached clusters
+	Clusters int `json:"clusters"`
+	// FailedClusters is the number of cached clusters whose most recent synchronization failed
+	FailedClusters int `json:"failedClusters"`
+	// Resources is the number of cached resources of all clusters
+	Resources int `json:"resources"`
+	// APIs is the number of observed APIs of all clusters
+	APIs int `json:"apis"`
+}
+
+// GetClusterCacheStats returns statistics of the cluster caches with the given info
+func GetClusterCacheStats(infos []clustercache.ClusterInfo) ClusterCacheStats {
+	stats := ClusterCacheStats{Clusters: len(infos)}
+	for _, info := range infos {
+		if info.SyncError != nil {
+			stats.FailedClusters++
+		}
+		stats.Resources += info.ResourcesCount
+		stats.APIs += info.APIsCount
+	}
+	return stats
+}
+
 func (c *liveStateCache) GetClusterCache(server *appv1.Cluster) (clustercache.ClusterCache, error) {
 	return c.getSyncedCluster(server)
 }
@@ -686,6 +686,51 @@ func TestSkipResourceUpdate(t *testing.T) {
 	})
 }
 
+func TestIsIgnoredFieldManagerUpdate(t *testing.T) {
+	newInfo := func(managedFields ...metav1.ManagedFieldsEntry) *ResourceInfo {
+		un := &unstructured.Unstructured{Object: map[string]any{}}
+		un.SetManagedFields(managedFields)
+		return &ResourceInfo{fieldManagerUpdates: getFieldManagerUpdates(un)}
+	}
+	t0 := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	t1 := metav1.NewTime(t0.Add(time.Minute))
+	argocd := metav1.ManagedFieldsEntry{Manager: "argocd-controller", Operation: metav1.ManagedFieldsOperationApply, Time: &t0}
+	annotator := metav1.ManagedFieldsEntry{Manager: "metrics-annotator", Operation: metav1.ManagedFieldsOperationUpdate, Time: &t0}
+	annotatorUpdated := metav1.ManagedFieldsEntry{Manager: "metrics-annotator", Operation: metav1.ManagedFieldsOperationUpdate, Time: &t1}
+	argocdUpdated := metav1.ManagedFieldsEntry{Manager: "argocd-controller", Operation: metav1.ManagedFieldsOperationApply, Time: &t1}
+	ignored := []string{"metrics-annotator"}
+
+	t.Run("No ignored managers", func(t *testing.T) {
+		assert.False(t, isIgnoredFieldManagerUpdate(newInfo(argocd, annotator), newInfo(argocd, annotatorUpdated), nil))
+	})
+	t.Run("Nil", func(t *testing.T) {
+		assert.False(t, isIgnoredFieldManagerUpdate(nil, newInfo(argocd), ignored))
+		assert.False(t, isIgnoredFieldManagerUpdate(&ResourceInfo{}, newInfo(argocd), ignored))
+	})
+	t.Run("Update by ignored manager", func(t *testing.T) {
+		assert.True(t, isIgnoredFieldManagerUpdate(newInfo(argocd, annotator), newInfo(argocd, annotatorUpdated), ignored))
+	})
+	t.Run("First update by ignored manager", func(t *testing.T) {
+		assert.True(t, isIgnoredFieldManagerUpdate(newInfo(argocd), newInfo(argocd, annotator), ignored))
+	})
+	t.Run("Update by other manager", func(t *testing.T) {
+		assert.False(t, isIgnoredFieldManagerUpdate(newInfo(argocd, annotator), newInfo(argocdUpdated, annotator), ignored))
+	})
+	t.Run("Update by ignored and other manager", func(t *testing.T) {
+		assert.False(t, isIgnoredFieldManagerUpdate(newInfo(argocd, annotator), newInfo(argocdUpdated, annotatorUpdated), ignored))
+	})
+	t.Run("No managed fields change", func(t *testing.T) {
+		assert.False(t, isIgnoredFieldManagerUpdate(newInfo(argocd, annotator), newInfo(argocd, annotator), ignored))
+	})
+	t.Run("Health change", func(t *testing.T) {
+		oldInfo := newInfo(argocd, annotator)
+		oldInfo.Health = &health.HealthStatus{Status: health.HealthStatusProgressing}
+		updatedInfo := newInfo(argocd, annotatorUpdated)
+		updatedInfo.Health = &health.HealthStatus{Status: health.HealthStatusHealthy}
+		assert.False(t, isIgnoredFieldManagerUpdate(oldInfo, updatedInfo, ignored))
+	})
+}
+
 func TestShouldHashManifest(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -952,3 +997,11 @@ func Test_asResourceNode_same_namespace_parent(t *testing.T) {
 	assert.Equal(t, "my-deployment", resNode.ParentRefs[0].Name)
 	assert.Equal(t, "my-namespace", resNode.ParentRefs[0].Namespace, "Deployment parent should have same namespace")
 }
+
+func TestGetClusterCacheStats(t *testing.T) {
+	stats := GetClusterCacheStats([]cache.ClusterInfo{
+		{ResourcesCount: 10, APIsCount: 3},
+		{ResourcesCount: 5, APIsCount: 2, SyncError: errors.New("failed")},
+	})
+	assert.Equal(t, ClusterCacheStats{Clusters: 2, FailedClusters: 1, Resources: 15, APIs: 5}, stats)
+}
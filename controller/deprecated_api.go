@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// apiLifecycle describes the Kubernetes releases in which an API version of a kind is deprecated and removed. The
+// information is generated into the types of the Kubernetes API and is the same the API server bases its deprecation
+// warnings on.
+type apiLifecycle struct {
+	deprecated  *version.Version
+	removed     *version.Version
+	replacement schema.GroupVersionKind
+}
+
+type (
+	apiLifecycleDeprecated interface {
+		APILifecycleDeprecated() (major, minor int)
+	}
+	apiLifecycleRemoved interface {
+		APILifecycleRemoved() (major, minor int)
+	}
+	apiLifecycleReplacement interface {
+		APILifecycleReplacement() schema.GroupVersionKind
+	}
+)
+
+// apiLifecycles caches the lifecycle of the kinds, keyed by their GroupVersionKind. The value is nil for kinds
+// without lifecycle, e.g. stable APIs and custom resources.
+var apiLifecycles sync.Map
+
+// getAPILifecycle returns the lifecycle of the given kind or nil if the kind is not deprecated in any release
+func getAPILifecycle(gvk schema.GroupVersionKind) *apiLifecycle {
+	if cached, ok := apiLifecycles.Load(gvk); ok {
+		return cached.(*apiLifecycle)
+	}
+	var lifecycle *apiLifecycle
+	if obj, err := scheme.Scheme.New(gvk); err == nil {
+		if deprecated, ok := obj.(apiLifecycleDeprecated); ok {
+			if major, minor := deprecated.APILifecycleDeprecated(); major > 0 {
+				lifecycle = &apiLifecycle{deprecated: version.MajorMinor(uint(major), uint(minor))}
+				if removed, ok := obj.(apiLifecycleRemoved); ok {
+					if major, minor := removed.APILifecycleRemoved(); major > 0 {
+						lifecycle.removed = version.MajorMinor(uint(major), uint(minor))
+					}
+				}
+				if replacement, ok := obj.(apiLifecycleReplacement); ok {
+					lifecycle.replacement = replacement.APILifecycleReplacement()
+				}
+			}
+		}
+	}
+	apiLifecycles.Store(gvk, lifecycle)
+	return lifecycle
+}
+
+// formatGVK formats the given kind like it is referenced in manifests, e.g. apps/v1 Deployment
+func formatGVK(gvk schema.GroupVersionKind) string {
+	return gvk.GroupVersion().String() + " " + gvk.Kind
+}
+
+// getDeprecatedAPIUsage returns a description of each of the given objects which uses an API version that is deprecated
+// or removed in the given Kubernetes version, sorted alphabetically. Nothing is returned if the version is unknown.
+func getDeprecatedAPIUsage(objs []*unstructured.Unstructured, serverVersion string) []string {
+	clusterVersion, err := version.ParseGeneric(serverVersion)
+	if err != nil {
+		return nil
+	}
+	var usages []string
+	for _, obj := range objs {
+		gvk := obj.GroupVersionKind()
+		lifecycle := getAPILifecycle(gvk)
+		if lifecycle == nil || clusterVersion.LessThan(lifecycle.deprecated) {
+			continue
+		}
+		name := obj.GetName()
+		if obj.GetNamespace() != "" {
+			name = obj.GetNamespace() + "/" + name
+		}
+		details := []string{"deprecated in v" + lifecycle.deprecated.String()}
+		if lifecycle.removed != nil {
+			if clusterVersion.LessThan(lifecycle.removed) {
+				details = append(details, "will be removed in v"+lifecycle.removed.String())
+			} else {
+				details = append(details, "removed in v"+lifecycle.removed.String())
+			}
+		}
+		if !lifecycle.replacement.Empty() {
+			details = append(details, "use "+formatGVK(lifecycle.replacement)+" instead")
+		}
+		usages = append(usages, fmt.Sprintf("%s %s (%s)", formatGVK(gvk), name, strings.Join(details, ", ")))
+	}
+	sort.Strings(usages)
+	return usages
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newObj(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestGetDeprecatedAPIUsage(t *testing.T) {
+	objs := []*unstructured.Unstructured{
+		newObj("apps/v1", "Deployment", "default", "my-deployment"),
+		newObj("argoproj.io/v1alpha1", "Rollout", "default", "my-rollout"),
+		newObj("policy/v1beta1", "PodDisruptionBudget", "default", "my-pdb"),
+		newObj("flowcontrol.apiserver.k8s.io/v1beta3", "FlowSchema", "", "my-flow-schema"),
+	}
+
+	t.Run("Not deprecated yet", func(t *testing.T) {
+		assert.Empty(t, getDeprecatedAPIUsage(objs, "v1.20.0"))
+	})
+
+	t.Run("Deprecated", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"policy/v1beta1 PodDisruptionBudget default/my-pdb (deprecated in v1.21, will be removed in v1.25, use policy/v1 PodDisruptionBudget instead)",
+		}, getDeprecatedAPIUsage(objs, "v1.22.5+k3s1"))
+	})
+
+	t.Run("Removed", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"flowcontrol.apiserver.k8s.io/v1beta3 FlowSchema my-flow-schema (deprecated in v1.29, removed in v1.32, use flowcontrol.apiserver.k8s.io/v1 FlowSchema instead)",
+			"policy/v1beta1 PodDisruptionBudget default/my-pdb (deprecated in v1.21, removed in v1.25, use policy/v1 PodDisruptionBudget instead)",
+		}, getDeprecatedAPIUsage(objs, "1.32"))
+	})
+
+	t.Run("Unknown version", func(t *testing.T) {
+		assert.Empty(t, getDeprecatedAPIUsage(objs, ""))
+	})
+}
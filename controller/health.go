@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
@@ -17,8 +18,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/lua"
 )
 
-// setApplicationHealth updates the health statuses of all resources performed in the comparison
-func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool) (health.HealthStatusCode, error) {
+// setApplicationHealth updates the health statuses of all resources performed in the comparison, taking into account
+// and the health of the resources they depend on, which is looked up with the given resolver if it is not nil
+func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool, resolveDependency healthDependencyResolver) (health.HealthStatusCode, error) {
 	var savedErr error
 	var errCount uint
 
@@ -52,6 +54,21 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 				// also log so we don't lose the message
 				log.WithFields(applog.GetAppLogFields(app)).Warn(savedErr)
 			}
+			if err == nil && resolveDependency != nil {
+				deps, err := getHealthDependencies(res.Live)
+				if err != nil {
+					errCount++
+					if savedErr == nil {
+						savedErr = fmt.Errorf("failed to get health dependencies of %q with name %q in namespace %q: %w", res.Live.GetKind(), res.Live.GetName(), res.Live.GetNamespace(), err)
+					}
+				} else if len(deps) > 0 {
+					// resources without health check are healthy unless their dependencies are not
+					if healthStatus == nil {
+						healthStatus = &health.HealthStatus{Status: health.HealthStatusHealthy}
+					}
+					healthStatus = applyHealthDependencies(healthStatus, deps, resolveDependency)
+				}
+			}
 		}
 
 		if healthStatus == nil {
@@ -89,3 +106,138 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 	}
 	return appHealthStatus, savedErr
 }
+
+// maxChildApplicationDepth is the maximum number of child application levels which are searched for the deepest
+// failing resource
+const maxChildApplicationDepth = 10
+
+// errChildApplicationNotWatched is returned when looking up a child application in a namespace which is not watched
+// by the controller
+var errChildApplicationNotWatched = errors.New("child application is not in a watched namespace")
+
+// getChildApplicationsSummary aggregates the readiness of the child applications of the given application among the
+// given resources. The deepest failing resource is searched among the descendants of failing children, so that
+// failures are propagated through any number of app-of-apps levels up to maxChildApplicationDepth. Children in
+// namespaces which are not watched are skipped. Returns nil if there are no child applications.
+func getChildApplicationsSummary(app *appv1.Application, resources []appv1.ResourceStatus, getApp func(namespace, name string) (*appv1.Application, error)) *appv1.ChildApplicationsSummary {
+	var summary *appv1.ChildApplicationsSummary
+	for _, res := range resources {
+		if res.Group != application.Group || res.Kind != application.ApplicationKind {
+			continue
+		}
+		childApp, err := getApp(res.Namespace, res.Name)
+		if errors.Is(err, errChildApplicationNotWatched) {
+			continue
+		}
+		if summary == nil {
+			summary = &appv1.ChildApplicationsSummary{}
+		}
+		summary.Total++
+
+		child := appv1.ChildApplicationStatus{Namespace: res.Namespace, Name: res.Name}
+		var failingResource *appv1.ChildApplicationResourceStatus
+		if err != nil {
+			child.Health = health.HealthStatusMissing
+			child.Message = "child application does not exist"
+		} else {
+			child.Health = childApp.Status.Health.Status
+			child.Sync = childApp.Status.Sync.Status
+			visited := map[string]bool{applicationKey(app.Namespace, app.Name): true}
+			failingResource = getDeepestFailingResource(childApp, getApp, visited, 1)
+			if failingResource != nil {
+				child.Message = failingResource.Message
+			}
+		}
+
+		switch child.Health {
+		case health.HealthStatusHealthy:
+			if child.Sync == appv1.SyncStatusCodeSynced {
+				summary.Ready++
+			}
+		case health.HealthStatusProgressing:
+			summary.Progressing++
+		case health.HealthStatusDegraded:
+			summary.Degraded++
+		case health.HealthStatusMissing:
+			summary.Missing++
+		}
+		if child.Sync == appv1.SyncStatusCodeOutOfSync {
+			summary.OutOfSync++
+		}
+
+		if !child.IsFailing() {
+			continue
+		}
+		if summary.FirstFailingChild == nil {
+			summary.FirstFailingChild = &child
+		}
+		if failingResource != nil && (summary.DeepestFailingResource == nil || failingResource.Depth > summary.DeepestFailingResource.Depth) {
+			summary.DeepestFailingResource = failingResource
+		}
+	}
+	return summary
+}
+
+// getDeepestFailingResource returns the deepest failing resource of the given child application and its degraded
+// descendants, which is at the given depth relative to the parent application. Applications in the given set of
+// visited applications are skipped, so that applications which manage each other are searched only once.
+func getDeepestFailingResource(childApp *appv1.Application, getApp func(namespace, name string) (*appv1.Application, error), visited map[string]bool, depth int64) *appv1.ChildApplicationResourceStatus {
+	visited[applicationKey(childApp.Namespace, childApp.Name)] = true
+	var deepest *appv1.ChildApplicationResourceStatus
+	for _, res := range childApp.Status.Resources {
+		if depth >= maxChildApplicationDepth {
+			break
+		}
+		if res.Group != application.Group || res.Kind != application.ApplicationKind || visited[applicationKey(res.Namespace, res.Name)] {
+			continue
+		}
+		descendant, err := getApp(res.Namespace, res.Name)
+		if err != nil || descendant.Status.Health.Status != health.HealthStatusDegraded {
+			continue
+		}
+		failingResource := getDeepestFailingResource(descendant, getApp, visited, depth+1)
+		if failingResource != nil && (deepest == nil || failingResource.Depth > deepest.Depth) {
+			deepest = failingResource
+		}
+	}
+	if deepest != nil {
+		return deepest
+	}
+	for _, res := range childApp.Status.Resources {
+		if res.Health == nil || (res.Health.Status != health.HealthStatusDegraded && res.Health.Status != health.HealthStatusMissing) {
+			continue
+		}
+		return &appv1.ChildApplicationResourceStatus{
+			ApplicationNamespace: childApp.Namespace,
+			ApplicationName:      childApp.Name,
+			Group:                res.Group,
+			Kind:                 res.Kind,
+			Namespace:            res.Namespace,
+			Name:                 res.Name,
+			Health:               res.Health.Status,
+			Message:              res.Health.Message,
+			Depth:                depth,
+		}
+	}
+	return nil
+}
+
+func applicationKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// getChildApplicationsCondition returns a warning condition if the summary contains failing child applications
+func getChildApplicationsCondition(summary *appv1.ChildApplicationsSummary) []appv1.ApplicationCondition {
+	if summary == nil || summary.FirstFailingChild == nil {
+		return nil
+	}
+	failing := summary.FirstFailingChild
+	message := fmt.Sprintf("%d of %d child applications are degraded or missing; first failing child application %s/%s is %s", summary.Degraded+summary.Missing, summary.Total, failing.Namespace, failing.Name, failing.Health)
+	if res := summary.DeepestFailingResource; res != nil {
+		message += fmt.Sprintf("; deepest failing resource %s/%s %s/%s of application %s/%s is %s", res.Group, res.Kind, res.Namespace, res.Name, res.ApplicationNamespace, res.ApplicationName, res.Health)
+		if res.Message != "" {
+			message += ": " + res.Message
+		}
+	}
+	return []appv1.ApplicationCondition{{Type: appv1.ApplicationConditionChildApplicationWarning, Message: message}}
+}
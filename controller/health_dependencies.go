@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/db"
+)
+
+// healthDependency references a resource the health of another resource depends on
+type healthDependency struct {
+	// Server is the URL of the cluster of the resource. Defaults to the destination cluster of the application.
+	Server string `json:"server,omitempty"`
+	// Cluster is the name of the cluster of the resource, as an alternative to the server
+	Cluster   string `json:"cluster,omitempty"`
+	Group     string `json:"group,omitempty"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+func (d healthDependency) String() string {
+	var sb strings.Builder
+	if d.Group != "" {
+		sb.WriteString(d.Group + "/")
+	}
+	sb.WriteString(d.Kind + " ")
+	if d.Namespace != "" {
+		sb.WriteString(d.Namespace + "/")
+	}
+	sb.WriteString(d.Name)
+	switch {
+	case d.Cluster != "":
+		sb.WriteString(" in cluster " + d.Cluster)
+	case d.Server != "":
+		sb.WriteString(" in cluster " + d.Server)
+	}
+	return sb.String()
+}
+
+// healthDependencyResolver returns the health of the referenced resource, which is nil if the resource does not have a
+// health check
+type healthDependencyResolver func(dep healthDependency) (*health.HealthStatus, error)
+
+// getHealthDependencies returns the resources the health of the given resource depends on, as declared by its
+// annotation
+func getHealthDependencies(obj *unstructured.Unstructured) ([]healthDependency, error) {
+	value, ok := obj.GetAnnotations()[common.AnnotationHealthDependencies]
+	if !ok || strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var deps []healthDependency
+	if err := yaml.UnmarshalStrict([]byte(value), &deps); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", common.AnnotationHealthDependencies, err)
+	}
+	for _, dep := range deps {
+		if dep.Kind == "" || dep.Name == "" {
+			return nil, fmt.Errorf("invalid %s annotation: kind and name of every dependency are required", common.AnnotationHealthDependencies)
+		}
+	}
+	return deps, nil
+}
+
+// applyHealthDependencies returns the health of a resource with the given dependencies: the resource is degraded if a
+// dependency is degraded or missing, progressing if a dependency is progressing, and unknown if the health of a
+// dependency cannot be resolved. Otherwise, the health of the resource is returned unchanged.
+func applyHealthDependencies(healthStatus *health.HealthStatus, deps []healthDependency, resolve healthDependencyResolver) *health.HealthStatus {
+	result := healthStatus
+	for _, dep := range deps {
+		var depStatus *health.HealthStatus
+		depHealth, err := resolve(dep)
+		switch {
+		case err != nil:
+			depStatus = &health.HealthStatus{Status: health.HealthStatusUnknown, Message: fmt.Sprintf("health of dependency %s cannot be resolved: %s", dep, err)}
+		case depHealth == nil:
+			continue
+		case depHealth.Status == health.HealthStatusMissing || depHealth.Status == health.HealthStatusDegraded:
+			depStatus = &health.HealthStatus{Status: health.HealthStatusDegraded, Message: fmt.Sprintf("dependency %s is %s", dep, depHealth.Status)}
+		case depHealth.Status == health.HealthStatusProgressing || depHealth.Status == health.HealthStatusUnknown:
+			depStatus = &health.HealthStatus{Status: depHealth.Status, Message: fmt.Sprintf("dependency %s is %s", dep, depHealth.Status)}
+		default:
+			continue
+		}
+		if depHealth != nil && depHealth.Message != "" {
+			depStatus.Message += ": " + depHealth.Message
+		}
+		if health.IsWorse(result.Status, depStatus.Status) {
+			result = depStatus
+		}
+	}
+	return result
+}
+
+// newHealthDependencyResolver returns a resolver which looks up the health of dependencies in the cluster caches. Only
+// dependencies in destinations permitted by the project of the application are resolved.
+func newHealthDependencyResolver(ctx context.Context, argoDB db.ArgoDB, liveStateCache statecache.LiveStateCache, project *appv1.AppProject, destCluster *appv1.Cluster) healthDependencyResolver {
+	return func(dep healthDependency) (*health.HealthStatus, error) {
+		cluster := destCluster
+		if dep.Server != "" || dep.Cluster != "" {
+			var err error
+			cluster, err = argo.GetDestinationCluster(ctx, appv1.ApplicationDestination{Server: dep.Server, Name: dep.Cluster}, argoDB)
+			if err != nil {
+				return nil, err
+			}
+		}
+		permitted, err := project.IsDestinationPermitted(cluster, dep.Namespace, func(project string) ([]*appv1.Cluster, error) {
+			return argoDB.GetProjectClusters(ctx, project)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !permitted {
+			return nil, fmt.Errorf("namespace %q of cluster %q is not permitted in project %q", dep.Namespace, cluster.Server, project.Name)
+		}
+
+		clusterCache, err := liveStateCache.GetClusterCache(cluster)
+		if err != nil {
+			return nil, err
+		}
+		key := kube.NewResourceKey(dep.Group, dep.Kind, dep.Namespace, dep.Name)
+		resources := clusterCache.FindResources(dep.Namespace, func(r *clustercache.Resource) bool {
+			return r.ResourceKey() == key
+		})
+		res, ok := resources[key]
+		if !ok {
+			return &health.HealthStatus{Status: health.HealthStatusMissing}, nil
+		}
+		if info, ok := res.Info.(*statecache.ResourceInfo); ok {
+			return info.Health, nil
+		}
+		return nil, nil
+	}
+}
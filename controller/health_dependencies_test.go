@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"testing"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	mockstatecache "github.com/argoproj/argo-cd/v3/controller/cache/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+)
+
+func TestHealthDependencyResolver(t *testing.T) {
+	secret := &clustercache.Resource{
+		Ref:  corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Namespace: "guestbook", Name: "db-credentials"},
+		Info: &statecache.ResourceInfo{Health: &health.HealthStatus{Status: health.HealthStatusProgressing}},
+	}
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.EXPECT().FindResources(mock.Anything, mock.Anything).RunAndReturn(func(_ string, predicates ...func(r *clustercache.Resource) bool) map[kube.ResourceKey]*clustercache.Resource {
+		result := map[kube.ResourceKey]*clustercache.Resource{}
+		if predicates[0](secret) {
+			result[secret.ResourceKey()] = secret
+		}
+		return result
+	})
+	liveStateCache := &mockstatecache.LiveStateCache{}
+	liveStateCache.EXPECT().GetClusterCache(mock.Anything).Return(clusterCache, nil)
+
+	destCluster := &v1alpha1.Cluster{Server: "https://kubernetes.default.svc", Name: "in-cluster"}
+	project := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "guestbook"}},
+		},
+	}
+	resolve := newHealthDependencyResolver(t.Context(), &dbmocks.ArgoDB{}, liveStateCache, project, destCluster)
+
+	t.Run("Found", func(t *testing.T) {
+		status, err := resolve(healthDependency{Kind: "Secret", Namespace: "guestbook", Name: "db-credentials"})
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, status.Status)
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		status, err := resolve(healthDependency{Kind: "Secret", Namespace: "guestbook", Name: "other"})
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusMissing, status.Status)
+	})
+
+	t.Run("NotPermitted", func(t *testing.T) {
+		_, err := resolve(healthDependency{Kind: "Secret", Namespace: "kube-system", Name: "db-credentials"})
+		require.ErrorContains(t, err, `namespace "kube-system" of cluster "https://kubernetes.default.svc" is not permitted in project "default"`)
+	})
+}
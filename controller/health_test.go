@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/yaml"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/lua"
@@ -64,7 +66,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 	assert.Equal(t, health.HealthStatusHealthy, resourceStatuses[0].Health.Status)
@@ -73,7 +75,7 @@ func TestSetApplicationHealth(t *testing.T) {
 
 	// now mark the job as a hook and retry. it should ignore the hook and consider the app healthy
 	failedJob.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
-	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, true, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	app.Status.Health.Status = healthStatus
@@ -83,11 +85,86 @@ func TestSetApplicationHealth(t *testing.T) {
 	failedJob.SetAnnotations(nil)
 	failedJobIgnoreHealthcheck := resourceFromFile("./testdata/job-failed-ignore-healthcheck.yaml")
 	resources[1].Live = &failedJobIgnoreHealthcheck
-	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, true, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 }
 
+func TestSetApplicationHealth_Dependencies(t *testing.T) {
+	runningPod := resourceFromFile("./testdata/pod-running-restart-always.yaml")
+	runningPod.SetAnnotations(map[string]string{common.AnnotationHealthDependencies: `
+- kind: Secret
+  namespace: default
+  name: db-credentials
+- server: https://other-cluster
+  group: example.com
+  kind: VPCEndpoint
+  name: database
+`})
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":        "config",
+			"annotations": map[string]any{common.AnnotationHealthDependencies: "- kind: Secret\n  name: missing"},
+		},
+	}}
+	resources := []managedResource{{
+		Group: "", Version: "v1", Kind: "Pod", Live: &runningPod,
+	}, {
+		Group: "", Version: "v1", Kind: "ConfigMap", Live: configMap,
+	}}
+
+	dependencies := map[string]*health.HealthStatus{
+		"Secret default/db-credentials":                                     nil,
+		"example.com/VPCEndpoint database in cluster https://other-cluster": {Status: health.HealthStatusHealthy},
+		"Secret missing": {Status: health.HealthStatusMissing},
+	}
+	resolve := func(dep healthDependency) (*health.HealthStatus, error) {
+		status, ok := dependencies[dep.String()]
+		if !ok {
+			return nil, fmt.Errorf("unexpected dependency %s", dep)
+		}
+		return status, nil
+	}
+
+	resourceStatuses := initStatuses(resources)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, resolve)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
+	assert.Equal(t, health.HealthStatusHealthy, resourceStatuses[0].Health.Status)
+	assert.Equal(t, health.HealthStatusDegraded, resourceStatuses[1].Health.Status)
+	assert.Equal(t, "dependency Secret missing is Missing", resourceStatuses[1].Health.Message)
+
+	t.Run("Progressing", func(t *testing.T) {
+		dependencies["example.com/VPCEndpoint database in cluster https://other-cluster"] = &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "provisioning"}
+		resourceStatuses := initStatuses(resources[:1])
+		healthStatus, err := setApplicationHealth(resources[:1], resourceStatuses, lua.ResourceHealthOverrides{}, app, true, resolve)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus)
+		assert.Equal(t, "dependency example.com/VPCEndpoint database in cluster https://other-cluster is Progressing: provisioning", resourceStatuses[0].Health.Message)
+	})
+
+	t.Run("Unresolvable", func(t *testing.T) {
+		resolve := func(_ healthDependency) (*health.HealthStatus, error) {
+			return nil, errors.New("cluster is not managed by this controller")
+		}
+		resourceStatuses := initStatuses(resources[:1])
+		healthStatus, err := setApplicationHealth(resources[:1], resourceStatuses, lua.ResourceHealthOverrides{}, app, true, resolve)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusUnknown, healthStatus)
+	})
+
+	t.Run("InvalidAnnotation", func(t *testing.T) {
+		invalid := runningPod.DeepCopy()
+		invalid.SetAnnotations(map[string]string{common.AnnotationHealthDependencies: "- kind: Secret"})
+		resources := []managedResource{{Group: "", Version: "v1", Kind: "Pod", Live: invalid}}
+		healthStatus, err := setApplicationHealth(resources, initStatuses(resources), lua.ResourceHealthOverrides{}, app, true, resolve)
+		require.ErrorContains(t, err, "kind and name of every dependency are required")
+		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
+	})
+}
+
 func TestSetApplicationHealth_ResourceHealthNotPersisted(t *testing.T) {
 	failedJob := resourceFromFile("./testdata/job-failed.yaml")
 
@@ -96,7 +173,7 @@ func TestSetApplicationHealth_ResourceHealthNotPersisted(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 
@@ -111,7 +188,7 @@ func TestSetApplicationHealth_MissingResource(t *testing.T) {
 	}, {}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, nil)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus)
 }
@@ -142,7 +219,7 @@ func TestSetApplicationHealth_HealthImproves(t *testing.T) {
 		resourceStatuses := initStatuses(resources)
 
 		t.Run(string(fmt.Sprintf("%s to %s", tc.oldStatus, tc.newStatus)), func(t *testing.T) {
-			healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+			healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, nil)
 			require.NoError(t, err)
 			assert.Equal(t, tc.newStatus, healthStatus)
 		})
@@ -158,7 +235,7 @@ func TestSetApplicationHealth_MissingResourceNoBuiltHealthCheck(t *testing.T) {
 	resourceStatuses := initStatuses(resources)
 
 	t.Run("NoOverride", func(t *testing.T) {
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, nil)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 		assert.Equal(t, health.HealthStatusMissing, resourceStatuses[0].Health.Status)
@@ -169,7 +246,7 @@ func TestSetApplicationHealth_MissingResourceNoBuiltHealthCheck(t *testing.T) {
 			lua.GetConfigMapKey(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}): appv1.ResourceOverride{
 				HealthLua: "some health check",
 			},
-		}, app, true)
+		}, app, true, nil)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusMissing, healthStatus)
 	})
@@ -220,7 +297,7 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, nil)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 	})
@@ -232,8 +309,144 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, nil)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	})
 }
+
+func TestGetChildApplicationsSummary(t *testing.T) {
+	newChildApp := func(name string, healthStatus health.HealthStatusCode, syncStatus appv1.SyncStatusCode, resources ...appv1.ResourceStatus) *appv1.Application {
+		return &appv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Status: appv1.ApplicationStatus{
+				Health:    appv1.AppHealthStatus{Status: healthStatus},
+				Sync:      appv1.SyncStatus{Status: syncStatus},
+				Resources: resources,
+			},
+		}
+	}
+	degradedDeployment := appv1.ResourceStatus{
+		Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui",
+		Health: &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: "Deployment exceeded its progress deadline"},
+	}
+	childResource := func(name string) appv1.ResourceStatus {
+		return appv1.ResourceStatus{Group: application.Group, Kind: application.ApplicationKind, Namespace: "argocd", Name: name}
+	}
+	parent := newChildApp("parent", health.HealthStatusDegraded, appv1.SyncStatusCodeSynced)
+	apps := map[string]*appv1.Application{
+		"healthy":       newChildApp("healthy", health.HealthStatusHealthy, appv1.SyncStatusCodeSynced),
+		"progressing":   newChildApp("progressing", health.HealthStatusProgressing, appv1.SyncStatusCodeOutOfSync),
+		"degraded":      newChildApp("degraded", health.HealthStatusDegraded, appv1.SyncStatusCodeSynced, degradedDeployment),
+		"nested-parent": newChildApp("nested-parent", health.HealthStatusDegraded, appv1.SyncStatusCodeSynced, childResource("grand-child")),
+		"grand-child":   newChildApp("grand-child", health.HealthStatusDegraded, appv1.SyncStatusCodeSynced, degradedDeployment),
+		// cycle-a and cycle-b manage each other and the parent
+		"cycle-a": newChildApp("cycle-a", health.HealthStatusDegraded, appv1.SyncStatusCodeSynced, childResource("cycle-b"), childResource("parent"), degradedDeployment),
+		"cycle-b": newChildApp("cycle-b", health.HealthStatusDegraded, appv1.SyncStatusCodeSynced, childResource("cycle-a")),
+		"parent":  parent,
+	}
+	getApp := func(namespace, name string) (*appv1.Application, error) {
+		if namespace != "argocd" {
+			return nil, errChildApplicationNotWatched
+		}
+		if app, ok := apps[name]; ok {
+			return app, nil
+		}
+		return nil, fmt.Errorf("application %s not found", name)
+	}
+
+	t.Run("NoChildApplications", func(t *testing.T) {
+		summary := getChildApplicationsSummary(parent, []appv1.ResourceStatus{degradedDeployment}, getApp)
+		assert.Nil(t, summary)
+		assert.Empty(t, getChildApplicationsCondition(summary))
+	})
+
+	t.Run("AllChildApplicationsReady", func(t *testing.T) {
+		summary := getChildApplicationsSummary(parent, []appv1.ResourceStatus{childResource("healthy")}, getApp)
+		assert.Equal(t, &appv1.ChildApplicationsSummary{Total: 1, Ready: 1}, summary)
+		assert.Empty(t, getChildApplicationsCondition(summary))
+	})
+
+	t.Run("FailingChildApplications", func(t *testing.T) {
+		summary := getChildApplicationsSummary(parent, []appv1.ResourceStatus{
+			childResource("degraded"),
+			childResource("healthy"),
+			childResource("missing"),
+			childResource("nested-parent"),
+			childResource("progressing"),
+		}, getApp)
+		require.NotNil(t, summary)
+		assert.Equal(t, int64(5), summary.Total)
+		assert.Equal(t, int64(1), summary.Ready)
+		assert.Equal(t, int64(1), summary.Progressing)
+		assert.Equal(t, int64(2), summary.Degraded)
+		assert.Equal(t, int64(1), summary.Missing)
+		assert.Equal(t, int64(1), summary.OutOfSync)
+		assert.Equal(t, &appv1.ChildApplicationStatus{
+			Namespace: "argocd",
+			Name:      "degraded",
+			Health:    health.HealthStatusDegraded,
+			Sync:      appv1.SyncStatusCodeSynced,
+			Message:   "Deployment exceeded its progress deadline",
+		}, summary.FirstFailingChild)
+		assert.Equal(t, &appv1.ChildApplicationResourceStatus{
+			ApplicationNamespace: "argocd",
+			ApplicationName:      "grand-child",
+			Group:                "apps",
+			Kind:                 "Deployment",
+			Namespace:            "default",
+			Name:                 "guestbook-ui",
+			Health:               health.HealthStatusDegraded,
+			Message:              "Deployment exceeded its progress deadline",
+			Depth:                2,
+		}, summary.DeepestFailingResource)
+
+		conditions := getChildApplicationsCondition(summary)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, appv1.ApplicationConditionChildApplicationWarning, conditions[0].Type)
+		assert.Equal(t, "3 of 5 child applications are degraded or missing; first failing child application argocd/degraded is Degraded; "+
+			"deepest failing resource apps/Deployment default/guestbook-ui of application argocd/grand-child is Degraded: Deployment exceeded its progress deadline", conditions[0].Message)
+	})
+
+	t.Run("ChildApplicationsManagingEachOther", func(t *testing.T) {
+		summary := getChildApplicationsSummary(parent, []appv1.ResourceStatus{childResource("cycle-a")}, getApp)
+		require.NotNil(t, summary)
+		assert.Equal(t, &appv1.ChildApplicationResourceStatus{
+			ApplicationNamespace: "argocd",
+			ApplicationName:      "cycle-a",
+			Group:                "apps",
+			Kind:                 "Deployment",
+			Namespace:            "default",
+			Name:                 "guestbook-ui",
+			Health:               health.HealthStatusDegraded,
+			Message:              "Deployment exceeded its progress deadline",
+			Depth:                1,
+		}, summary.DeepestFailingResource)
+	})
+
+	t.Run("MaxChildApplicationDepth", func(t *testing.T) {
+		chain := map[string]*appv1.Application{}
+		for i := 1; i <= maxChildApplicationDepth+1; i++ {
+			app := newChildApp(fmt.Sprintf("level-%d", i), health.HealthStatusDegraded, appv1.SyncStatusCodeSynced, childResource(fmt.Sprintf("level-%d", i+1)), degradedDeployment)
+			chain[app.Name] = app
+		}
+		summary := getChildApplicationsSummary(parent, []appv1.ResourceStatus{childResource("level-1")}, func(_, name string) (*appv1.Application, error) {
+			if app, ok := chain[name]; ok {
+				return app, nil
+			}
+			return nil, fmt.Errorf("application %s not found", name)
+		})
+		require.NotNil(t, summary)
+		require.NotNil(t, summary.DeepestFailingResource)
+		assert.Equal(t, fmt.Sprintf("level-%d", maxChildApplicationDepth), summary.DeepestFailingResource.ApplicationName)
+		assert.Equal(t, int64(maxChildApplicationDepth), summary.DeepestFailingResource.Depth)
+	})
+
+	t.Run("ChildApplicationNotWatched", func(t *testing.T) {
+		summary := getChildApplicationsSummary(parent, []appv1.ResourceStatus{
+			childResource("healthy"),
+			{Group: application.Group, Kind: application.ApplicationKind, Namespace: "other", Name: "degraded"},
+		}, getApp)
+		assert.Equal(t, &appv1.ChildApplicationsSummary{Total: 1, Ready: 1}, summary)
+	})
+}
@@ -313,6 +313,10 @@ func (h *Hydrator) validateApplications(apps []*appv1.Application) (map[string]*
 			errors[app.QualifiedName()] = fmt.Errorf("application repo %s is not permitted in project '%s'", app.Spec.GetSource().RepoURL, proj.Name)
 			continue
 		}
+		if !proj.IsSourcePathPermitted(app.Spec.GetSource()) {
+			errors[app.QualifiedName()] = fmt.Errorf("application path '%s' of repo %s is not permitted in project '%s'", app.Spec.GetSource().Path, app.Spec.GetSource().RepoURL, proj.Name)
+			continue
+		}
 		projects[app.Spec.Project] = proj
 
 		// Disallow hydrating to the repository root.
@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+)
+
+const kubectlWarningPrefix = "Warning: "
+
+// operationTimings tracks when results of the resources of an in-progress operation were observed, so that the
+// duration of every resource can be recorded in the operation log once the operation completes.
+type operationTimings struct {
+	startedAt metav1.Time
+	resources map[string]*resourceTiming
+}
+
+type resourceTiming struct {
+	firstSeen   time.Time
+	lastChanged time.Time
+	state       string
+}
+
+func resourceResultKey(res *appv1.ResourceResult) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", res.Group, res.Kind, res.Namespace, res.Name, res.SyncPhase)
+}
+
+func resourceResultState(res *appv1.ResourceResult) string {
+	return fmt.Sprintf("%s/%s/%s", res.Status, res.HookPhase, res.Message)
+}
+
+// observeOperationResources records the time at which results of the operation resources appeared or changed.
+// iterationStartedAt is the time the sync iteration which produced the results was started.
+func (ctrl *ApplicationController) observeOperationResources(app *appv1.Application, state *appv1.OperationState, iterationStartedAt time.Time) {
+	if ctrl.operationLogLimit <= 0 || state.SyncResult == nil {
+		return
+	}
+	now := time.Now()
+	ctrl.operationTimingsMutex.Lock()
+	defer ctrl.operationTimingsMutex.Unlock()
+	timings, ok := ctrl.operationTimings[app.QualifiedName()]
+	if !ok || !timings.startedAt.Equal(&state.StartedAt) {
+		timings = &operationTimings{startedAt: state.StartedAt, resources: map[string]*resourceTiming{}}
+		ctrl.operationTimings[app.QualifiedName()] = timings
+	}
+	for _, res := range state.SyncResult.Resources {
+		key := resourceResultKey(res)
+		resState := resourceResultState(res)
+		timing, ok := timings.resources[key]
+		if !ok {
+			timings.resources[key] = &resourceTiming{firstSeen: iterationStartedAt, lastChanged: now, state: resState}
+			continue
+		}
+		if timing.state != resState {
+			timing.state = resState
+			timing.lastChanged = now
+		}
+	}
+}
+
+// deleteOperationTimings drops the timings of the in-progress operation of an application which was deleted before
+// the operation completed
+func (ctrl *ApplicationController) deleteOperationTimings(app *appv1.Application) {
+	ctrl.operationTimingsMutex.Lock()
+	defer ctrl.operationTimingsMutex.Unlock()
+	delete(ctrl.operationTimings, app.QualifiedName())
+}
+
+// recordOperationLog stores the completed operation in the operation log of the application
+func (ctrl *ApplicationController) recordOperationLog(app *appv1.Application, state *appv1.OperationState) {
+	ctrl.operationTimingsMutex.Lock()
+	timings := ctrl.operationTimings[app.QualifiedName()]
+	delete(ctrl.operationTimings, app.QualifiedName())
+	ctrl.operationTimingsMutex.Unlock()
+
+	if ctrl.operationLogLimit <= 0 {
+		return
+	}
+	if timings != nil && !timings.startedAt.Equal(&state.StartedAt) {
+		timings = nil
+	}
+	entry := newOperationLogEntry(state, timings)
+	if err := ctrl.cache.AppendAppOperationLog(app.InstanceName(ctrl.namespace), entry, ctrl.operationLogLimit); err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).WithError(err).Warn("Failed to record operation in the operation log")
+	}
+}
+
+func newOperationLogEntry(state *appv1.OperationState, timings *operationTimings) *appv1.OperationLogEntry {
+	entry := &appv1.OperationLogEntry{
+		Operation:  state.Operation,
+		Phase:      state.Phase,
+		Message:    state.Message,
+		StartedAt:  state.StartedAt,
+		FinishedAt: state.FinishedAt,
+		RetryCount: state.RetryCount,
+	}
+	if state.SyncResult == nil {
+		return entry
+	}
+	entry.Revisions = state.SyncResult.Revisions
+	if len(entry.Revisions) == 0 && state.SyncResult.Revision != "" {
+		entry.Revisions = []string{state.SyncResult.Revision}
+	}
+	for _, res := range state.SyncResult.Resources {
+		result := &appv1.OperationLogResourceResult{
+			ResourceResult: *res,
+			Warnings:       extractWarnings(res.Message),
+		}
+		if timings != nil {
+			if timing, ok := timings.resources[resourceResultKey(res)]; ok {
+				result.Duration = &metav1.Duration{Duration: timing.lastChanged.Sub(timing.firstSeen)}
+			}
+		}
+		entry.Resources = append(entry.Resources, result)
+	}
+	return entry
+}
+
+// extractWarnings returns the warnings reported by kubectl in the given resource result message
+func extractWarnings(message string) []string {
+	var warnings []string
+	for _, line := range strings.Split(message, "\n") {
+		for {
+			idx := strings.Index(line, kubectlWarningPrefix)
+			if idx < 0 {
+				break
+			}
+			line = line[idx+len(kubectlWarningPrefix):]
+			warning := line
+			if next := strings.Index(line, kubectlWarningPrefix); next >= 0 {
+				warning = line[:next]
+			}
+			if warning = strings.TrimSuffix(strings.TrimSpace(warning), "."); warning != "" {
+				warnings = append(warnings, warning)
+			}
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func TestExtractWarnings(t *testing.T) {
+	assert.Empty(t, extractWarnings("deployment.apps/guestbook configured"))
+	assert.Equal(t, []string{"spec.ports is deprecated"}, extractWarnings("service/guestbook configured. Warning: spec.ports is deprecated"))
+	assert.Equal(t,
+		[]string{"policy/v1beta1 PodSecurityPolicy is deprecated", "resource is missing the last-applied-configuration annotation"},
+		extractWarnings("podsecuritypolicy/psp created. Warning: policy/v1beta1 PodSecurityPolicy is deprecated.\nWarning: resource is missing the last-applied-configuration annotation"))
+}
+
+func TestNewOperationLogEntry(t *testing.T) {
+	startedAt := metav1.Now()
+	state := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}},
+		Phase:     synccommon.OperationSucceeded,
+		Message:   "successfully synced (all tasks run)",
+		StartedAt: startedAt,
+		SyncResult: &v1alpha1.SyncOperationResult{
+			Revision: "abc123",
+			Resources: v1alpha1.ResourceResults{{
+				Group:     "apps",
+				Kind:      "Deployment",
+				Namespace: "default",
+				Name:      "guestbook",
+				Status:    synccommon.ResultCodeSynced,
+				Message:   "deployment.apps/guestbook configured. Warning: spec.template is deprecated",
+				SyncPhase: synccommon.SyncPhaseSync,
+			}, {
+				Kind:      "Service",
+				Namespace: "default",
+				Name:      "guestbook",
+				Status:    synccommon.ResultCodeSynced,
+				SyncPhase: synccommon.SyncPhaseSync,
+			}},
+		},
+	}
+
+	t.Run("WithoutTimings", func(t *testing.T) {
+		entry := newOperationLogEntry(state, nil)
+		assert.Equal(t, synccommon.OperationSucceeded, entry.Phase)
+		assert.Equal(t, []string{"abc123"}, entry.Revisions)
+		require.Len(t, entry.Resources, 2)
+		assert.Equal(t, []string{"spec.template is deprecated"}, entry.Resources[0].Warnings)
+		assert.Nil(t, entry.Resources[0].Duration)
+	})
+
+	t.Run("WithTimings", func(t *testing.T) {
+		firstSeen := startedAt.Time
+		timings := &operationTimings{startedAt: startedAt, resources: map[string]*resourceTiming{
+			resourceResultKey(state.SyncResult.Resources[0]): {firstSeen: firstSeen, lastChanged: firstSeen.Add(5 * time.Second)},
+		}}
+		entry := newOperationLogEntry(state, timings)
+		require.Len(t, entry.Resources, 2)
+		require.NotNil(t, entry.Resources[0].Duration)
+		assert.Equal(t, 5*time.Second, entry.Resources[0].Duration.Duration)
+		assert.Nil(t, entry.Resources[1].Duration)
+	})
+}
+
+func TestProcessRequestedAppOperation_RecordsOperationLog(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponses: []*apiclient.ManifestResponse{{
+			Manifests: []string{},
+		}},
+	}, nil)
+	ctrl.operationLogLimit = 10
+
+	ctrl.processRequestedAppOperation(app)
+
+	var entries []*v1alpha1.OperationLogEntry
+	require.NoError(t, ctrl.cache.GetAppOperationLog(app.InstanceName(ctrl.namespace), &entries))
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(1), entries[0].ID)
+	assert.Equal(t, synccommon.OperationSucceeded, entries[0].Phase)
+	assert.NotNil(t, entries[0].FinishedAt)
+	assert.Empty(t, ctrl.operationTimings)
+}
+
+func TestDeleteOperationTimings(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	ctrl.operationLogLimit = 10
+	state := &v1alpha1.OperationState{
+		StartedAt: metav1.Now(),
+		SyncResult: &v1alpha1.SyncOperationResult{
+			Resources: v1alpha1.ResourceResults{{Kind: "Service", Namespace: "default", Name: "guestbook", Status: synccommon.ResultCodeSynced}},
+		},
+	}
+
+	ctrl.observeOperationResources(app, state, time.Now())
+	require.Len(t, ctrl.operationTimings, 1)
+
+	// the application is deleted before the operation completes
+	ctrl.deleteOperationTimings(app)
+	assert.Empty(t, ctrl.operationTimings)
+}
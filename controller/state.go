@@ -2,6 +2,8 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -76,7 +78,7 @@ type managedResource struct {
 // AppStateManager defines methods which allow to compare application spec and actual application state.
 type AppStateManager interface {
 	CompareAppState(app *v1alpha1.Application, project *v1alpha1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache, noRevisionCache bool, localObjects []string, hasMultipleSources bool) (*comparisonResult, error)
-	SyncAppState(app *v1alpha1.Application, project *v1alpha1.AppProject, state *v1alpha1.OperationState)
+	SyncAppState(ctx context.Context, app *v1alpha1.Application, project *v1alpha1.AppProject, state *v1alpha1.OperationState)
 	GetRepoObjs(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, appLabelKey string, revisions []string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject, sendRuntimeState bool) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, bool, error)
 }
 
@@ -98,6 +100,8 @@ type comparisonResult struct {
 	hasPreDeleteHooks  bool
 	// revisionsMayHaveChanges indicates if there are any possibilities that the revisions contain changes
 	revisionsMayHaveChanges bool
+	// manifestsHash is the hash of the target manifests, which is only computed if automated sync is enabled
+	manifestsHash string
 }
 
 func (res *comparisonResult) GetSyncStatus() *v1alpha1.SyncStatus {
@@ -198,6 +202,11 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 		return nil, nil, false, fmt.Errorf("failed to get installation ID: %w", err)
 	}
 
+	sopsEnv, err := argo.GetSOPSEnv(m.settingsMgr, proj)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to get SOPS keys: %w", err)
+	}
+
 	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, m.db)
 	if err != nil {
 		return nil, nil, false, fmt.Errorf("failed to get destination cluster: %w", err)
@@ -284,6 +293,7 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 				RefSources:         refSources,
 				HasMultipleSources: app.Spec.HasMultipleSources(),
 				InstallationID:     installationID,
+				ProjectSourcePaths: proj.GetSourcePaths(),
 			})
 			if err != nil {
 				return nil, nil, false, fmt.Errorf("failed to compare revisions for source %d of %d: %w", i+1, len(sources), err)
@@ -336,6 +346,8 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 			RefSources:                      refSources,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
+			ProjectSourcePaths:              proj.GetSourcePaths(),
+			SopsEnv:                         sopsEnv,
 			AnnotationManifestGeneratePaths: app.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 			InstallationID:                  installationID,
 		})
@@ -407,10 +419,14 @@ func unmarshalManifests(manifests []string) ([]*unstructured.Unstructured, error
 	return targetObjs, nil
 }
 
+// DeduplicateTargetObjects removes the target objects with the same group, kind, namespace and name, keeping the last one,
+// and returns a RepeatedResourceWarning condition for each of them. The given duplicates reported by the repo server are
+// used to name the sources of the repeated resources in the conditions.
 func DeduplicateTargetObjects(
 	namespace string,
 	objs []*unstructured.Unstructured,
 	infoProvider kubeutil.ResourceInfoProvider,
+	duplicates []*apiclient.DuplicateResource,
 ) ([]*unstructured.Unstructured, []v1alpha1.ApplicationCondition, error) {
 	targetByKey := make(map[kubeutil.ResourceKey][]*unstructured.Unstructured)
 	for i := range objs {
@@ -435,9 +451,13 @@ func DeduplicateTargetObjects(
 	for key, targets := range targetByKey {
 		if len(targets) > 1 {
 			now := metav1.Now()
+			message := fmt.Sprintf("Resource %s appeared %d times among application resources.", key.String(), len(targets))
+			if sources := duplicateResourceSources(duplicates, key); len(sources) > 0 {
+				message += fmt.Sprintf(" It is rendered from: %s.", strings.Join(sources, ", "))
+			}
 			conditions = append(conditions, v1alpha1.ApplicationCondition{
 				Type:               v1alpha1.ApplicationConditionRepeatedResourceWarning,
-				Message:            fmt.Sprintf("Resource %s appeared %d times among application resources.", key.String(), len(targets)),
+				Message:            message,
 				LastTransitionTime: &now,
 			})
 		}
@@ -447,6 +467,20 @@ func DeduplicateTargetObjects(
 	return result, conditions, nil
 }
 
+// duplicateResourceSources returns the sources of the duplicate resource with the given key. Duplicates reported by the
+// repo server have the namespace of the application if they have no namespace, since the repo server does not know
+// whether the resource is namespaced, so the namespace is ignored for cluster-scoped resources.
+func duplicateResourceSources(duplicates []*apiclient.DuplicateResource, key kubeutil.ResourceKey) []string {
+	var sources []string
+	for _, duplicate := range duplicates {
+		if duplicate.Group == key.Group && duplicate.Kind == key.Kind && duplicate.Name == key.Name &&
+			(key.Namespace == "" || duplicate.Namespace == key.Namespace) {
+			sources = append(sources, duplicate.Sources...)
+		}
+	}
+	return sources
+}
+
 // normalizeClusterScopeTracking will set the app instance tracking metadata on malformed cluster-scoped resources where
 // metadata.namespace is not empty. The repo-server doesn't know which resources are cluster-scoped, so it may apply
 // an incorrect tracking annotation using the metadata.namespace. This function will correct that.
@@ -664,7 +698,11 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 	}
 
-	targetObjs, dedupConditions, err := DeduplicateTargetObjects(app.Spec.Destination.Namespace, targetObjs, infoProvider)
+	var duplicates []*apiclient.DuplicateResource
+	for _, manifestInfo := range manifestInfos {
+		duplicates = append(duplicates, manifestInfo.GetDuplicateResources()...)
+	}
+	targetObjs, dedupConditions, err := DeduplicateTargetObjects(app.Spec.Destination.Namespace, targetObjs, infoProvider, duplicates)
 	if err != nil {
 		msg := "Failed to deduplicate target state: " + err.Error()
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
@@ -692,6 +730,16 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 	ts.AddCheckpoint("dedup_ms")
 
+	if serverVersion, _, err := m.liveStateCache.GetVersionsInfo(destCluster); err == nil {
+		if usages := getDeprecatedAPIUsage(targetObjs, serverVersion); len(usages) > 0 {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:               v1alpha1.ApplicationConditionDeprecatedAPIInUseWarning,
+				Message:            fmt.Sprintf("Resources use API versions which are deprecated in Kubernetes %s: %s", serverVersion, strings.Join(usages, "; ")),
+				LastTransitionTime: &now,
+			})
+		}
+	}
+
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(destCluster, app, targetObjs)
 	if err != nil {
 		liveObjByKey = make(map[kubeutil.ResourceKey]*unstructured.Unstructured)
@@ -724,6 +772,17 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 
 	for _, liveObj := range liveObjByKey {
 		if liveObj != nil {
+			if argo.IsTrackedByOtherInstallation(liveObj, installationID) {
+				otherInstallation := "another Argo CD installation"
+				if otherID := liveObj.GetAnnotations()[common.AnnotationInstallationID]; otherID != "" {
+					otherInstallation = fmt.Sprintf("Argo CD installation %s", otherID)
+				}
+				conditions = append(conditions, v1alpha1.ApplicationCondition{
+					Type:               v1alpha1.ApplicationConditionSharedResourceWarning,
+					Message:            fmt.Sprintf("%s/%s is part of application %s and managed by %s", liveObj.GetKind(), liveObj.GetName(), app.QualifiedName(), otherInstallation),
+					LastTransitionTime: &now,
+				})
+			}
 			appInstanceName := m.resourceTracking.GetAppName(liveObj, appLabelKey, v1alpha1.TrackingMethod(trackingMethod), installationID)
 			if appInstanceName != "" && appInstanceName != app.InstanceName(m.namespace) {
 				fqInstanceName := strings.ReplaceAll(appInstanceName, "_", "/")
@@ -799,14 +858,11 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		manifestRevisions = append(manifestRevisions, manifestInfo.Revision)
 	}
 
-	serverSideDiff := m.serverSideDiff ||
-		resourceutil.HasAnnotationOption(app, common.AnnotationCompareOptions, "ServerSideDiff=true")
-
-	// This allows turning SSD off for a given app if it is enabled at the
-	// controller level
-	if resourceutil.HasAnnotationOption(app, common.AnnotationCompareOptions, "ServerSideDiff=false") {
-		serverSideDiff = false
+	defaultDiffEngine := argodiff.DiffEngineClientSide
+	if m.serverSideDiff {
+		defaultDiffEngine = argodiff.DiffEngineServerSide
 	}
+	serverSideDiff := argodiff.ApplicationDiffEngine(app, defaultDiffEngine) == argodiff.DiffEngineServerSide
 
 	useDiffCache := useDiffCache(noCache, manifestInfos, sources, app, manifestRevisions, m.statusRefreshTimeout, serverSideDiff, logCtx)
 
@@ -831,8 +887,6 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	diffConfigBuilder.WithGVKParser(gvkParser)
 	diffConfigBuilder.WithManager(common.ArgoCDSSAManager)
 
-	diffConfigBuilder.WithServerSideDiff(serverSideDiff)
-
 	if serverSideDiff {
 		applier, cleanup, err := m.getServerSideDiffDryRunApplier(destCluster)
 		if err != nil {
@@ -840,7 +894,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionUnknownError, Message: err.Error(), LastTransitionTime: &now})
 		}
 		defer cleanup()
-		diffConfigBuilder.WithServerSideDryRunner(diff.NewK8sServerSideDryRunner(applier))
+		diffConfigBuilder.WithDiffEngine(argodiff.NewServerSideDiffEngine(diff.NewK8sServerSideDryRunner(applier)))
 	}
 
 	// enable structured merge diff if application syncs with server-side apply
@@ -981,7 +1035,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 
 	ts.AddCheckpoint("sync_ms")
 
-	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth)
+	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth, newHealthDependencyResolver(context.Background(), m.db, m.liveStateCache, project, destCluster))
 	if err != nil {
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: "error setting app health: " + err.Error(), LastTransitionTime: &now})
 	}
@@ -1008,6 +1062,14 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 		revisionsMayHaveChanges: revisionsMayHaveChanges,
 	}
 
+	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.IsAutomatedSyncEnabled() {
+		compRes.manifestsHash, err = hashManifests(reconciliation.Target)
+		if err != nil {
+			logCtx.Warnf("Failed to hash target manifests: %v", err)
+		}
+		ts.AddCheckpoint("hash_manifests_ms")
+	}
+
 	if hasMultipleSources {
 		for _, manifestInfo := range manifestInfos {
 			compRes.appSourceTypes = append(compRes.appSourceTypes, v1alpha1.ApplicationSourceType(manifestInfo.SourceType))
@@ -1020,16 +1082,39 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *v1
 	}
 
 	app.Status.SetConditions(conditions, map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:         true,
-		v1alpha1.ApplicationConditionSharedResourceWarning:   true,
-		v1alpha1.ApplicationConditionRepeatedResourceWarning: true,
-		v1alpha1.ApplicationConditionExcludedResourceWarning: true,
+		v1alpha1.ApplicationConditionComparisonError:           true,
+		v1alpha1.ApplicationConditionSharedResourceWarning:     true,
+		v1alpha1.ApplicationConditionRepeatedResourceWarning:   true,
+		v1alpha1.ApplicationConditionExcludedResourceWarning:   true,
+		v1alpha1.ApplicationConditionDeprecatedAPIInUseWarning: true,
 	})
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
 	return &compRes, nil
 }
 
+// hashManifests returns a hash of the given target objects, which does not depend on their order
+func hashManifests(targets []*unstructured.Unstructured) (string, error) {
+	manifests := make([]string, 0, len(targets))
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+		data, err := json.Marshal(target)
+		if err != nil {
+			return "", fmt.Errorf("error marshaling %s %s: %w", target.GetKind(), target.GetName(), err)
+		}
+		manifests = append(manifests, string(data))
+	}
+	slices.Sort(manifests)
+	hash := sha256.New()
+	for _, manifest := range manifests {
+		hash.Write([]byte(manifest))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 // useDiffCache will determine if the diff should be calculated based
 // on the existing live state cache or not.
 func useDiffCache(noCache bool, manifestInfos []*apiclient.ManifestResponse, sources []v1alpha1.ApplicationSource, app *v1alpha1.Application, manifestRevisions []string, statusRefreshTimeout time.Duration, serverSideDiff bool, log *log.Entry) bool {
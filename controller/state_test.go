@@ -648,6 +648,99 @@ func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
 	assert.Len(t, compRes.resources, 4)
 }
 
+func TestCompareAppStateDuplicatedResourcesWithSources(t *testing.T) {
+	obj1 := NewPod()
+	obj1.SetNamespace(test.FakeDestNamespace)
+	obj2 := NewPod()
+
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, obj1), toJSON(t, obj2)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+			DuplicateResources: []*apiclient.DuplicateResource{{
+				Kind:      "Pod",
+				Namespace: test.FakeDestNamespace,
+				Name:      "my-pod",
+				Sources:   []string{"guestbook/pod.yaml", "guestbook/copy/pod.yaml"},
+			}},
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{},
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false)
+	require.NoError(t, err)
+
+	assert.NotNil(t, compRes)
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionRepeatedResourceWarning, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Resource /Pod/fake-dest-ns/my-pod appeared 2 times among application resources. It is rendered from: guestbook/pod.yaml, guestbook/copy/pod.yaml.", app.Status.Conditions[0].Message)
+}
+
+func TestCompareAppStateDeprecatedAPIInUse(t *testing.T) {
+	pdb := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "policy/v1beta1",
+		"kind":       "PodDisruptionBudget",
+		"metadata": map[string]any{
+			"name":      "my-pdb",
+			"namespace": test.FakeDestNamespace,
+		},
+	}}
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pdb)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		serverVersion:   "v1.24.3",
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	_, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false)
+	require.NoError(t, err)
+
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionDeprecatedAPIInUseWarning, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Resources use API versions which are deprecated in Kubernetes v1.24.3: policy/v1beta1 PodDisruptionBudget fake-dest-ns/my-pdb (deprecated in v1.21, will be removed in v1.25, use policy/v1 PodDisruptionBudget instead)", app.Status.Conditions[0].Message)
+}
+
+func TestCompareAppStateResourceOfOtherInstallation(t *testing.T) {
+	pod := NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	livePod := pod.DeepCopy()
+	livePod.SetAnnotations(map[string]string{
+		common.AnnotationKeyAppInstance: "my-app:/Pod:" + test.FakeDestNamespace + "/my-pod",
+		common.AnnotationInstallationID: "instance-b",
+	})
+
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(livePod): livePod,
+		},
+		configMapData: map[string]string{
+			"installationID": "instance-a",
+		},
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	_, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false)
+	require.NoError(t, err)
+
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionSharedResourceWarning, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Pod/my-pod is part of application fake-argocd-ns/my-app and managed by Argo CD installation instance-b", app.Status.Conditions[0].Message)
+}
+
 func TestCompareAppStateManagedNamespaceMetadataWithLiveNsDoesNotGetPruned(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{
@@ -1897,3 +1990,58 @@ func TestCompareAppState_DoesNotCallUpdateRevisionForPaths_ForOCI(t *testing.T)
 	_, _, _, err := ctrl.appStateManager.GetRepoObjs(t.Context(), app, sources, "abc123", []string{"123456"}, false, false, false, &defaultProj, false)
 	require.NoError(t, err)
 }
+
+func TestHashManifests(t *testing.T) {
+	pod := NewPod()
+	service := NewService()
+
+	hash, err := hashManifests([]*unstructured.Unstructured{pod, nil, service})
+	require.NoError(t, err)
+	assert.NotEmpty(t, hash)
+
+	reordered, err := hashManifests([]*unstructured.Unstructured{service, pod})
+	require.NoError(t, err)
+	assert.Equal(t, hash, reordered, "the hash must not depend on the order of the manifests")
+
+	changed := pod.DeepCopy()
+	changed.SetLabels(map[string]string{"foo": "bar"})
+	other, err := hashManifests([]*unstructured.Unstructured{changed, service})
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+}
+
+func TestCompareAppStateManifestsHash(t *testing.T) {
+	t.Parallel()
+
+	compareAppState := func(t *testing.T, app *v1alpha1.Application) *comparisonResult {
+		t.Helper()
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{toJSON(t, NewPod())},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}
+		ctrl := newFakeController(t.Context(), &data, nil)
+		compRes, err := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false)
+		require.NoError(t, err)
+		return compRes
+	}
+
+	t.Run("automated sync", func(t *testing.T) {
+		t.Parallel()
+		app := newFakeApp()
+		compRes := compareAppState(t, app)
+		assert.NotEmpty(t, compRes.manifestsHash)
+		assert.Equal(t, compRes.manifestsHash, compareAppState(t, app).manifestsHash)
+	})
+
+	t.Run("manual sync", func(t *testing.T) {
+		t.Parallel()
+		app := newFakeApp()
+		app.Spec.SyncPolicy = nil
+		assert.Empty(t, compareAppState(t, app).manifestsHash)
+	})
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+)
+
+// withoutNonCriticalStatusFields returns a copy of the status without the fields which are updated on every
+// reconciliation but are not relevant for sync, health or operation state transitions.
+func withoutNonCriticalStatusFields(status *appv1.ApplicationStatus) *appv1.ApplicationStatus {
+	res := status.DeepCopy()
+	setNonCriticalStatusFields(res, &appv1.ApplicationStatus{})
+	return res
+}
+
+// setNonCriticalStatusFields copies the non-critical fields of the src status into dst
+func setNonCriticalStatusFields(dst *appv1.ApplicationStatus, src *appv1.ApplicationStatus) {
+	dst.ReconciledAt = src.ReconciledAt
+	dst.ObservedAt = src.ObservedAt
+	dst.Summary = src.Summary
+	dst.ChildApplications = src.ChildApplications
+}
+
+// isNonCriticalStatusUpdate returns whether the update of the application to the new status and annotations only
+// changes non-critical status fields and therefore can be batched.
+func isNonCriticalStatusUpdate(orig *appv1.Application, newStatus *appv1.ApplicationStatus, newAnnotations map[string]string) (bool, error) {
+	_, modified, err := createMergePatch(
+		&appv1.Application{ObjectMeta: metav1.ObjectMeta{Annotations: orig.GetAnnotations()}, Status: *withoutNonCriticalStatusFields(&orig.Status)},
+		&appv1.Application{ObjectMeta: metav1.ObjectMeta{Annotations: newAnnotations}, Status: *withoutNonCriticalStatusFields(newStatus)})
+	if err != nil {
+		return false, err
+	}
+	return !modified, nil
+}
+
+// queueStatusUpdate stores the non-critical status fields of the application, so that they are persisted by the next
+// flush of the batched status updates. A previously queued update of the same application is replaced.
+func (ctrl *ApplicationController) queueStatusUpdate(app *appv1.Application, newStatus *appv1.ApplicationStatus) {
+	ctrl.pendingStatusUpdatesMutex.Lock()
+	defer ctrl.pendingStatusUpdatesMutex.Unlock()
+	ctrl.pendingStatusUpdates[ctrl.toAppKey(app.QualifiedName())] = newStatus.DeepCopy()
+}
+
+// dropQueuedStatusUpdate removes the queued status update of the application, e.g. because its status is about to be
+// persisted as a whole.
+func (ctrl *ApplicationController) dropQueuedStatusUpdate(app *appv1.Application) {
+	ctrl.pendingStatusUpdatesMutex.Lock()
+	defer ctrl.pendingStatusUpdatesMutex.Unlock()
+	delete(ctrl.pendingStatusUpdates, ctrl.toAppKey(app.QualifiedName()))
+}
+
+// getReconciledAt returns the time the application was last reconciled, taking queued status updates into account
+func (ctrl *ApplicationController) getReconciledAt(app *appv1.Application) *metav1.Time {
+	reconciledAt := app.Status.ReconciledAt
+	if ctrl.statusUpdateBatchInterval <= 0 {
+		return reconciledAt
+	}
+	ctrl.pendingStatusUpdatesMutex.Lock()
+	defer ctrl.pendingStatusUpdatesMutex.Unlock()
+	if pending, ok := ctrl.pendingStatusUpdates[ctrl.toAppKey(app.QualifiedName())]; ok && pending.ReconciledAt != nil {
+		if reconciledAt == nil || pending.ReconciledAt.After(reconciledAt.Time) {
+			reconciledAt = pending.ReconciledAt
+		}
+	}
+	return reconciledAt
+}
+
+// flushStatusUpdates persists all queued status updates. Every update only patches the non-critical status fields,
+// so that status changes which have been persisted since the update was queued are preserved.
+func (ctrl *ApplicationController) flushStatusUpdates() {
+	ctrl.pendingStatusUpdatesMutex.Lock()
+	pending := ctrl.pendingStatusUpdates
+	ctrl.pendingStatusUpdates = make(map[string]*appv1.ApplicationStatus)
+	ctrl.pendingStatusUpdatesMutex.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+	start := time.Now()
+	patched := 0
+	for appKey, newStatus := range pending {
+		obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
+		if err != nil || !exists {
+			continue
+		}
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		// skip updates which are outdated by a status update which has been persisted since the update was queued
+		if app.Status.ReconciledAt != nil && (newStatus.ReconciledAt == nil || app.Status.ReconciledAt.After(newStatus.ReconciledAt.Time)) {
+			continue
+		}
+		logCtx := log.WithFields(applog.GetAppLogFields(app))
+		status := app.Status.DeepCopy()
+		setNonCriticalStatusFields(status, newStatus)
+		patch, modified, err := createMergePatch(&appv1.Application{Status: app.Status}, &appv1.Application{Status: *status})
+		if err != nil {
+			logCtx.WithError(err).Error("Error constructing batched app status patch")
+			continue
+		}
+		if !modified {
+			continue
+		}
+		if _, err := ctrl.PatchAppWithWriteBack(context.Background(), app.Name, app.Namespace, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			logCtx.WithError(err).Warn("Error updating application with batched status")
+			continue
+		}
+		patched++
+	}
+	log.Infof("Flushed %d batched application status updates in %v", patched, time.Since(start))
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+)
+
+func countPatches(ctrl *ApplicationController) int {
+	count := 0
+	for _, action := range ctrl.applicationClientset.(*appclientset.Clientset).Actions() {
+		if action.GetVerb() == "patch" {
+			count++
+		}
+	}
+	return count
+}
+
+func TestIsNonCriticalStatusUpdate(t *testing.T) {
+	app := newFakeApp()
+	now := metav1.Now()
+
+	newStatus := app.Status.DeepCopy()
+	newStatus.ReconciledAt = &now
+	newStatus.Summary.Images = []string{"nginx:1.25"}
+	nonCritical, err := isNonCriticalStatusUpdate(app, newStatus, app.GetAnnotations())
+	require.NoError(t, err)
+	assert.True(t, nonCritical)
+
+	newStatus.Health.Status = health.HealthStatusDegraded
+	nonCritical, err = isNonCriticalStatusUpdate(app, newStatus, app.GetAnnotations())
+	require.NoError(t, err)
+	assert.False(t, nonCritical)
+
+	newStatus = app.Status.DeepCopy()
+	newStatus.ReconciledAt = &now
+	nonCritical, err = isNonCriticalStatusUpdate(app, newStatus, map[string]string{"foo": "bar"})
+	require.NoError(t, err)
+	assert.False(t, nonCritical)
+}
+
+func TestPersistAppStatus_Batching(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	ctrl.statusUpdateBatchInterval = time.Minute
+
+	reconciledAt := metav1.NewTime(time.Now().Add(-time.Second).Truncate(time.Second))
+	newStatus := app.Status.DeepCopy()
+	newStatus.ReconciledAt = &reconciledAt
+	ctrl.persistAppStatus(app, newStatus)
+	assert.Equal(t, 0, countPatches(ctrl))
+	assert.Len(t, ctrl.pendingStatusUpdates, 1)
+	assert.Equal(t, &reconciledAt, ctrl.getReconciledAt(app))
+
+	// updates of the same application are coalesced
+	reconciledAt = metav1.NewTime(reconciledAt.Add(time.Second))
+	newStatus = app.Status.DeepCopy()
+	newStatus.ReconciledAt = &reconciledAt
+	ctrl.persistAppStatus(app, newStatus)
+	assert.Equal(t, 0, countPatches(ctrl))
+	assert.Len(t, ctrl.pendingStatusUpdates, 1)
+
+	ctrl.flushStatusUpdates()
+	assert.Equal(t, 1, countPatches(ctrl))
+	assert.Empty(t, ctrl.pendingStatusUpdates)
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, reconciledAt.Equal(updatedApp.Status.ReconciledAt))
+}
+
+func TestPersistAppStatus_BatchingCriticalUpdate(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	ctrl.statusUpdateBatchInterval = time.Minute
+
+	now := metav1.Now()
+	newStatus := app.Status.DeepCopy()
+	newStatus.ReconciledAt = &now
+	ctrl.persistAppStatus(app, newStatus)
+	require.Len(t, ctrl.pendingStatusUpdates, 1)
+
+	newStatus = newStatus.DeepCopy()
+	newStatus.Health.Status = health.HealthStatusDegraded
+	ctrl.persistAppStatus(app, newStatus)
+	assert.Equal(t, 1, countPatches(ctrl))
+	assert.Empty(t, ctrl.pendingStatusUpdates)
+}
+
+func TestFlushStatusUpdates_SkipsOutdatedUpdates(t *testing.T) {
+	app := newFakeApp()
+	reconciledAt := metav1.Now()
+	app.Status.ReconciledAt = &reconciledAt
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	ctrl.statusUpdateBatchInterval = time.Minute
+
+	outdated := metav1.NewTime(reconciledAt.Add(-time.Minute))
+	ctrl.queueStatusUpdate(app, &v1alpha1.ApplicationStatus{ReconciledAt: &outdated})
+	ctrl.flushStatusUpdates()
+	assert.Equal(t, 0, countPatches(ctrl))
+}
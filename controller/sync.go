@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"os"
@@ -19,6 +20,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -33,6 +35,7 @@ import (
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/argo/diff"
+	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
@@ -43,6 +46,9 @@ const (
 	// EnvVarSyncWaveDelay is an environment variable which controls the delay in seconds between
 	// each sync-wave
 	EnvVarSyncWaveDelay = "ARGOCD_SYNC_WAVE_DELAY"
+	// EnvVarSyncCRDReadinessTimeout is an environment variable which controls how long a sync waits for a CRD, which
+	// is part of the same sync, to be established and served before applying custom resources of it
+	EnvVarSyncCRDReadinessTimeout = "ARGOCD_SYNC_CRD_READINESS_TIMEOUT"
 
 	// serviceAccountDisallowedCharSet contains the characters that are not allowed to be present
 	// in a DefaultServiceAccount configured for a DestinationServiceAccount
@@ -117,7 +123,7 @@ func newSyncOperationResult(app *v1alpha1.Application, op v1alpha1.SyncOperation
 	return syncRes
 }
 
-func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alpha1.AppProject, state *v1alpha1.OperationState) {
+func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Application, project *v1alpha1.AppProject, state *v1alpha1.OperationState) {
 	syncId, err := syncid.Generate()
 	if err != nil {
 		state.Phase = common.OperationError
@@ -169,6 +175,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 	// what we should be syncing to when resuming operations.
 	state.SyncResult.Revision = compareResult.syncStatus.Revision
 	state.SyncResult.Revisions = compareResult.syncStatus.Revisions
+	state.SyncResult.ManifestsHash = compareResult.manifestsHash
 
 	// validates if it should fail the sync on that revision if it finds shared resources
 	hasSharedResource, sharedResourceMessage := hasSharedResourceCondition(app)
@@ -178,6 +185,12 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 		return
 	}
 
+	if err := checkResourceQuota(project.Spec.ResourceQuota, compareResult.reconciliationResult.Target); err != nil {
+		state.Phase = common.OperationFailed
+		state.Message = err.Error()
+		return
+	}
+
 	// If there are any comparison or spec errors error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
 		v1alpha1.ApplicationConditionComparisonError:  true,
@@ -347,7 +360,12 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			clientSideApplyManager,
 		),
 		sync.WithPruneConfirmed(app.IsDeletionConfirmed(state.StartedAt.Time)),
+		sync.WithPruneConfirmationRequired(syncOp.SyncOptions.HasOption(common.SyncOptionPruneRequireConfirm)),
+		sync.WithPruneConfirmationRequiredKinds(pruneConfirmationRequiredKinds(syncOp.SyncOptions)...),
 		sync.WithSkipDryRunOnMissingResource(syncOp.SyncOptions.HasOption(common.SyncOptionSkipDryRunOnMissingResource)),
+		sync.WithCRDReadinessTimeout(env.ParseDurationFromEnv(EnvVarSyncCRDReadinessTimeout, 30*time.Second, 0, time.Hour)),
+		sync.WithForceTermination(state.Termination != nil && state.Termination.Force),
+		sync.WithContext(ctx),
 	}
 
 	if syncOp.SyncOptions.HasOption("CreateNamespace=true") {
@@ -374,14 +392,19 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 
 	start := time.Now()
 
-	if state.Phase == common.OperationTerminating {
+	terminating := state.Phase == common.OperationTerminating
+	if terminating {
 		syncCtx.Terminate()
 	} else {
 		syncCtx.Sync()
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	if terminating && state.Termination != nil && state.Termination.Reason != "" {
+		state.Message = fmt.Sprintf("%s: %s", state.Message, state.Termination.Reason)
+	}
 	state.SyncResult.Resources = nil
+	state.PendingPrune = nil
 
 	if app.Spec.SyncPolicy != nil {
 		state.SyncResult.ManagedNamespaceMetadata = app.Spec.SyncPolicy.ManagedNamespaceMetadata
@@ -418,11 +441,29 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, project *v1alp
 			Message:   res.Message,
 			Images:    res.Images,
 		})
+		if res.Status == common.ResultCodePruneConfirmationRequired && !state.Phase.Completed() {
+			state.PendingPrune = append(state.PendingPrune, v1alpha1.SyncOperationResource{
+				Group:     res.ResourceKey.Group,
+				Kind:      res.ResourceKey.Kind,
+				Namespace: res.ResourceKey.Namespace,
+				Name:      res.ResourceKey.Name,
+			})
+		}
 	}
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
+		if verification := app.Spec.SyncPolicy.GetVerification(); verification != nil {
+			verificationStart := time.Now()
+			state.SyncResult.Verification = verifySync(ctx, app, verification, compareResult.syncStatus.Revision, compareResult.syncStatus.Revisions)
+			logEntry.WithField("duration", time.Since(verificationStart)).Info("post-sync verification complete")
+			if state.SyncResult.Verification.Failed() {
+				state.Phase = common.OperationFailed
+				state.Message = verificationFailureMessage(state.SyncResult.Verification)
+				return
+			}
+		}
 		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, compareResult.syncStatus.ComparedTo.Source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceSync, state.StartedAt, state.Operation.InitiatedBy)
 		if err != nil {
 			state.Phase = common.OperationError
@@ -534,6 +575,36 @@ func hasSharedResourceCondition(app *v1alpha1.Application) (bool, string) {
 	return false, ""
 }
 
+// checkResourceQuota returns an error if the number or the combined manifest size of the target resources of an
+// application exceed the given resource quota of its project
+func checkResourceQuota(quota *v1alpha1.AppProjectResourceQuota, targets []*unstructured.Unstructured) error {
+	if quota == nil {
+		return nil
+	}
+	var count, size int64
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+		count++
+		if quota.MaxManifestSize != nil {
+			data, err := json.Marshal(target)
+			if err != nil {
+				return fmt.Errorf("error marshaling %s %s: %w", target.GetKind(), target.GetName(), err)
+			}
+			size += int64(len(data))
+		}
+	}
+	if quota.MaxResources > 0 && count > quota.MaxResources {
+		return fmt.Errorf("resource quota exceeded: application has %d resources, but its project permits at most %d", count, quota.MaxResources)
+	}
+	if quota.MaxManifestSize != nil && size > quota.MaxManifestSize.Value() {
+		return fmt.Errorf("resource quota exceeded: application manifests have a size of %s, but its project permits at most %s",
+			resource.NewQuantity(size, resource.BinarySI).String(), quota.MaxManifestSize.String())
+	}
+	return nil
+}
+
 // delayBetweenSyncWaves is a gitops-engine SyncWaveHook which introduces an artificial delay
 // between each sync wave. We introduce an artificial delay in order give other controllers a
 // _chance_ to react to the spec change that we just applied. This is important because without
@@ -542,6 +613,23 @@ func hasSharedResourceCondition(app *v1alpha1.Application) (bool, string) {
 // Note, this is not foolproof, since a proper fix would require the CRD record
 // status.observedGeneration coupled with a health.lua that verifies
 // status.observedGeneration == metadata.generation
+// pruneConfirmationRequiredKinds returns the kinds listed in the PruneConfirmKinds sync option, in Kind[.group] format
+func pruneConfirmationRequiredKinds(syncOptions v1alpha1.SyncOptions) []schema.GroupKind {
+	var kinds []schema.GroupKind
+	for _, option := range syncOptions {
+		value, ok := strings.CutPrefix(option, common.SyncOptionPruneRequireConfirmKindsPrefix)
+		if !ok {
+			continue
+		}
+		for _, kind := range strings.Split(value, ",") {
+			if kind = strings.TrimSpace(kind); kind != "" {
+				kinds = append(kinds, schema.ParseGroupKind(kind))
+			}
+		}
+	}
+	return kinds
+}
+
 func delayBetweenSyncWaves(_ common.SyncPhase, _ int, finalWave bool) error {
 	if !finalWave {
 		delaySec := 2
@@ -1,7 +1,10 @@
 package controller
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/argoproj/gitops-engine/pkg/sync"
@@ -10,9 +13,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/controller/testdata"
@@ -50,7 +55,7 @@ func TestPersistRevisionHistory(t *testing.T) {
 	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
 		Sync: &v1alpha1.SyncOperation{},
 	}}
-	ctrl.appStateManager.SyncAppState(app, defaultProject, opState)
+	ctrl.appStateManager.SyncAppState(t.Context(), app, defaultProject, opState)
 	// Ensure we record spec.source into sync result
 	assert.Equal(t, app.Spec.GetSource(), opState.SyncResult.Source)
 
@@ -61,6 +66,82 @@ func TestPersistRevisionHistory(t *testing.T) {
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
 }
 
+func TestSyncAppStateTerminationReason(t *testing.T) {
+	app := newFakeApp()
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+
+	opState := &v1alpha1.OperationState{
+		Operation:   v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}},
+		Phase:       synccommon.OperationTerminating,
+		Termination: &v1alpha1.OperationTermination{Reason: "hook is stuck", Force: true},
+	}
+	ctrl.appStateManager.SyncAppState(t.Context(), app, defaultProject, opState)
+	assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+	assert.Equal(t, "Operation terminated: hook is stuck", opState.Message)
+}
+
+func TestSyncAppStateVerificationFailed(t *testing.T) {
+	setSyncVerificationConfig(t, newTestVerificationConfig("127.0.0.1"))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	app.Spec.SyncPolicy.Verification = &v1alpha1.SyncPolicyVerification{
+		Probes: []v1alpha1.SyncVerificationProbe{{Name: "smoke", HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: server.URL}}},
+	}
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(t.Context(), app, defaultProject, opState)
+	assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+	assert.Contains(t, opState.Message, `post-sync verification failed: probe "smoke" failed after 1 attempt(s)`)
+	require.NotNil(t, opState.SyncResult.Verification)
+	assert.True(t, opState.SyncResult.Verification.Failed())
+
+	// Ensure the failed revision is not recorded in the history
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, updatedApp.Status.History)
+}
+
 func TestPersistManagedNamespaceMetadataState(t *testing.T) {
 	app := newFakeApp()
 	app.Status.OperationState = nil
@@ -96,7 +177,7 @@ func TestPersistManagedNamespaceMetadataState(t *testing.T) {
 	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
 		Sync: &v1alpha1.SyncOperation{},
 	}}
-	ctrl.appStateManager.SyncAppState(app, defaultProject, opState)
+	ctrl.appStateManager.SyncAppState(t.Context(), app, defaultProject, opState)
 	// Ensure we record spec.syncPolicy.managedNamespaceMetadata into sync result
 	assert.Equal(t, app.Spec.SyncPolicy.ManagedNamespaceMetadata, opState.SyncResult.ManagedNamespaceMetadata)
 }
@@ -139,7 +220,7 @@ func TestPersistRevisionHistoryRollback(t *testing.T) {
 			Source: &source,
 		},
 	}}
-	ctrl.appStateManager.SyncAppState(app, defaultProject, opState)
+	ctrl.appStateManager.SyncAppState(t.Context(), app, defaultProject, opState)
 	// Ensure we record opState's source into sync result
 	assert.Equal(t, source, opState.SyncResult.Source)
 
@@ -182,7 +263,7 @@ func TestSyncComparisonError(t *testing.T) {
 		Sync: &v1alpha1.SyncOperation{},
 	}}
 	t.Setenv("ARGOCD_GPG_ENABLED", "true")
-	ctrl.appStateManager.SyncAppState(app, defaultProject, opState)
+	ctrl.appStateManager.SyncAppState(t.Context(), app, defaultProject, opState)
 
 	conditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionComparisonError: true})
 	assert.NotEmpty(t, conditions)
@@ -271,7 +352,7 @@ func TestAppStateManager_SyncAppState(t *testing.T) {
 		}}
 
 		// when
-		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
 
 		// then
 		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
@@ -279,6 +360,83 @@ func TestAppStateManager_SyncAppState(t *testing.T) {
 	})
 }
 
+func TestCheckResourceQuota(t *testing.T) {
+	targets := []*unstructured.Unstructured{
+		kube.MustToUnstructured(&corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "configmap1", Namespace: "default"},
+			Data:       map[string]string{"key": strings.Repeat("a", 1024)},
+		}),
+		kube.MustToUnstructured(&corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "configmap2", Namespace: "default"},
+		}),
+		// resources which are only live are not counted
+		nil,
+	}
+	maxManifestSize := func(value string) *resource.Quantity {
+		quantity := resource.MustParse(value)
+		return &quantity
+	}
+
+	t.Run("no quota", func(t *testing.T) {
+		require.NoError(t, checkResourceQuota(nil, targets))
+	})
+	t.Run("within quota", func(t *testing.T) {
+		require.NoError(t, checkResourceQuota(&v1alpha1.AppProjectResourceQuota{MaxResources: 2, MaxManifestSize: maxManifestSize("2Ki")}, targets))
+	})
+	t.Run("too many resources", func(t *testing.T) {
+		err := checkResourceQuota(&v1alpha1.AppProjectResourceQuota{MaxResources: 1}, targets)
+		require.EqualError(t, err, "resource quota exceeded: application has 2 resources, but its project permits at most 1")
+	})
+	t.Run("manifests too large", func(t *testing.T) {
+		err := checkResourceQuota(&v1alpha1.AppProjectResourceQuota{MaxManifestSize: maxManifestSize("1Ki")}, targets)
+		require.ErrorContains(t, err, "resource quota exceeded: application manifests have a size of")
+		require.ErrorContains(t, err, "but its project permits at most 1Ki")
+	})
+}
+
+func TestSyncAppStateResourceQuotaExceeded(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+
+	project := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			Destinations:  []v1alpha1.ApplicationDestination{{Namespace: "*", Server: "*"}},
+			ResourceQuota: &v1alpha1.AppProjectResourceQuota{MaxResources: 1},
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, project},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{
+				`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "configmap1"}}`,
+				`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "configmap2"}}`,
+			},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{
+			Source: &v1alpha1.ApplicationSource{},
+		},
+	}}
+	ctrl.appStateManager.SyncAppState(t.Context(), app, project, opState)
+
+	assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+	assert.Equal(t, "resource quota exceeded: application has 2 resources, but its project permits at most 1", opState.Message)
+}
+
 func TestSyncWindowDeniesSync(t *testing.T) {
 	t.Parallel()
 
@@ -343,7 +501,7 @@ func TestSyncWindowDeniesSync(t *testing.T) {
 			Phase: synccommon.OperationRunning,
 		}
 		// when
-		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
 
 		// then
 		assert.Equal(t, synccommon.OperationRunning, opState.Phase)
@@ -1435,7 +1593,7 @@ func TestSyncWithImpersonate(t *testing.T) {
 			Phase: synccommon.OperationRunning,
 		}
 		// when
-		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
 
 		// then, app sync should fail with expected error message in operation state
 		assert.Equal(t, synccommon.OperationError, opState.Phase)
@@ -1456,7 +1614,7 @@ func TestSyncWithImpersonate(t *testing.T) {
 			Phase: synccommon.OperationRunning,
 		}
 		// when
-		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
 
 		// then app sync should fail with expected error message in operation state
 		assert.Equal(t, synccommon.OperationError, opState.Phase)
@@ -1477,7 +1635,7 @@ func TestSyncWithImpersonate(t *testing.T) {
 			Phase: synccommon.OperationRunning,
 		}
 		// when
-		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
 
 		// then app sync should not fail
 		assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
@@ -1498,7 +1656,7 @@ func TestSyncWithImpersonate(t *testing.T) {
 			Phase: synccommon.OperationRunning,
 		}
 		// when
-		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
 
 		// then application sync should pass using the control plane service account
 		assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
@@ -1523,7 +1681,7 @@ func TestSyncWithImpersonate(t *testing.T) {
 		f.application.Spec.Destination.Name = "minikube"
 
 		// when
-		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
 
 		// then app sync should not fail
 		assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
@@ -1593,7 +1751,7 @@ func TestClientSideApplyMigration(t *testing.T) {
 				Source: &v1alpha1.ApplicationSource{},
 			},
 		}}
-		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
 
 		// then
 		assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
@@ -1611,7 +1769,7 @@ func TestClientSideApplyMigration(t *testing.T) {
 				Source: &v1alpha1.ApplicationSource{},
 			},
 		}}
-		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
 
 		// then
 		assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
@@ -1629,7 +1787,7 @@ func TestClientSideApplyMigration(t *testing.T) {
 				Source: &v1alpha1.ApplicationSource{},
 			},
 		}}
-		f.controller.appStateManager.SyncAppState(f.application, f.project, opState)
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
 
 		// then
 		assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
@@ -1653,3 +1811,12 @@ func dig(obj any, path ...any) any {
 
 	return i
 }
+
+func TestPruneConfirmationRequiredKinds(t *testing.T) {
+	assert.Empty(t, pruneConfirmationRequiredKinds(v1alpha1.SyncOptions{"Prune=confirm"}))
+	assert.Equal(t, []schema.GroupKind{
+		{Kind: "PersistentVolumeClaim"},
+		{Kind: "Namespace"},
+		{Group: "apps", Kind: "StatefulSet"},
+	}, pruneConfirmationRequiredKinds(v1alpha1.SyncOptions{"CreateNamespace=true", "PruneConfirmKinds=PersistentVolumeClaim, Namespace", "PruneConfirmKinds=StatefulSet.apps"}))
+}
@@ -0,0 +1,296 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// EnvVarSyncVerificationAllowedHosts is a comma separated list of glob patterns of the hosts which post-sync
+	// verification probes may connect to. Patterns are matched against the host and against host:port. Probes are
+	// rejected if the list is empty.
+	EnvVarSyncVerificationAllowedHosts = "ARGOCD_SYNC_VERIFICATION_ALLOWED_HOSTS"
+	// EnvVarSyncVerificationAllowInsecureSkipVerify is an environment variable which controls whether post-sync
+	// verification probes may skip the verification of the server certificate
+	EnvVarSyncVerificationAllowInsecureSkipVerify = "ARGOCD_SYNC_VERIFICATION_ALLOW_INSECURE_SKIP_VERIFY"
+	// EnvVarSyncVerificationTimeout is an environment variable which limits the total duration of the post-sync
+	// verification of a sync operation
+	EnvVarSyncVerificationTimeout = "ARGOCD_SYNC_VERIFICATION_TIMEOUT"
+	// EnvVarSyncVerificationMaxAttempts is an environment variable which limits the number of attempts of a single
+	// post-sync verification probe, regardless of its retries
+	EnvVarSyncVerificationMaxAttempts = "ARGOCD_SYNC_VERIFICATION_MAX_ATTEMPTS"
+)
+
+// maxVerificationRedirects is the maximum number of redirects followed by HTTP verification probes, like the default
+// of http.Client
+const maxVerificationRedirects = 10
+
+// verificationConfig holds the operator settings of the post-sync verification
+type verificationConfig struct {
+	allowedHosts         []string
+	allowInsecureSkipTLS bool
+	timeout              time.Duration
+	maxAttempts          int64
+}
+
+var syncVerificationConfig = verificationConfig{
+	allowedHosts:         env.StringsFromEnv(EnvVarSyncVerificationAllowedHosts, nil, ","),
+	allowInsecureSkipTLS: env.ParseBoolFromEnv(EnvVarSyncVerificationAllowInsecureSkipVerify, false),
+	timeout:              env.ParseDurationFromEnv(EnvVarSyncVerificationTimeout, 5*time.Minute, time.Second, time.Hour),
+	maxAttempts:          env.ParseInt64FromEnv(EnvVarSyncVerificationMaxAttempts, 10, 1, 100),
+}
+
+// verificationCheck performs a single attempt of a post-sync verification probe
+type verificationCheck func(ctx context.Context) error
+
+// verifySync executes the post-sync verification probes of the application one after another and returns their
+// results. The verification is bounded by ctx and by the configured total timeout.
+func verifySync(ctx context.Context, app *v1alpha1.Application, verification *v1alpha1.SyncPolicyVerification, revision string, revisions []string) *v1alpha1.SyncVerificationResult {
+	ctx, cancel := context.WithTimeout(ctx, syncVerificationConfig.timeout)
+	defer cancel()
+	result := &v1alpha1.SyncVerificationResult{}
+	data, err := verificationTemplateData(app, revision, revisions)
+	for i := range verification.Probes {
+		probe := &verification.Probes[i]
+		switch {
+		case err != nil:
+			result.Probes = append(result.Probes, v1alpha1.SyncVerificationProbeResult{Name: probe.Name, Message: err.Error()})
+		case ctx.Err() != nil:
+			result.Probes = append(result.Probes, v1alpha1.SyncVerificationProbeResult{Name: probe.Name, Message: fmt.Sprintf("verification aborted: %v", ctx.Err())})
+		default:
+			result.Probes = append(result.Probes, runVerificationProbe(ctx, probe, data))
+		}
+	}
+	return result
+}
+
+// verificationFailureMessage returns a message summarizing the failed probes of the verification result
+func verificationFailureMessage(result *v1alpha1.SyncVerificationResult) string {
+	var failed []string
+	for _, probe := range result.Probes {
+		if !probe.Succeeded {
+			failed = append(failed, fmt.Sprintf("probe %q failed after %d attempt(s): %s", probe.Name, probe.Attempts, probe.Message))
+		}
+	}
+	return "post-sync verification failed: " + strings.Join(failed, "; ")
+}
+
+// verificationTemplateData returns the values available in the templates of the probes
+func verificationTemplateData(app *v1alpha1.Application, revision string, revisions []string) (map[string]any, error) {
+	appJSON, err := json.Marshal(app)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal application: %w", err)
+	}
+	var appMap map[string]any
+	if err := json.Unmarshal(appJSON, &appMap); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal application: %w", err)
+	}
+	return map[string]any{
+		"app":       appMap,
+		"revision":  revision,
+		"revisions": revisions,
+	}, nil
+}
+
+func renderVerificationTemplate(tmpl string, data map[string]any) (string, error) {
+	t, err := template.New("probe").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template %q: %w", tmpl, err)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute template %q: %w", tmpl, err)
+	}
+	return buf.String(), nil
+}
+
+// runVerificationProbe executes the probe until it succeeds or runs out of retries
+func runVerificationProbe(ctx context.Context, probe *v1alpha1.SyncVerificationProbe, data map[string]any) v1alpha1.SyncVerificationProbeResult {
+	result := v1alpha1.SyncVerificationProbeResult{Name: probe.Name}
+	interval, err := probe.GetInterval()
+	if err != nil {
+		result.Message = fmt.Sprintf("invalid interval: %v", err)
+		return result
+	}
+	timeout, err := probe.GetTimeout()
+	if err != nil {
+		result.Message = fmt.Sprintf("invalid timeout: %v", err)
+		return result
+	}
+	check, err := newVerificationCheck(probe, data)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+
+	attempts := min(probe.Retries+1, syncVerificationConfig.maxAttempts)
+	for attempt := int64(0); attempt < attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				result.Message = ctx.Err().Error()
+				return result
+			case <-time.After(interval):
+			}
+		}
+		result.Attempts++
+		attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+		err = check(attemptCtx)
+		cancel()
+		if err == nil {
+			result.Succeeded = true
+			result.Message = "probe succeeded"
+			return result
+		}
+		result.Message = err.Error()
+	}
+	return result
+}
+
+func newVerificationCheck(probe *v1alpha1.SyncVerificationProbe, data map[string]any) (verificationCheck, error) {
+	if err := probe.Validate(); err != nil {
+		return nil, err
+	}
+	if probe.HTTP != nil {
+		rawURL, err := renderVerificationTemplate(probe.HTTP.URL, data)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateVerificationURL(rawURL, probe.HTTP.InsecureSkipVerify); err != nil {
+			return nil, err
+		}
+		return httpVerificationCheck(rawURL, probe.HTTP), nil
+	}
+	address, err := renderVerificationTemplate(probe.GRPC.Address, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateVerificationAddress(address, probe.GRPC.InsecureSkipVerify); err != nil {
+		return nil, err
+	}
+	return grpcVerificationCheck(address, probe.GRPC), nil
+}
+
+// validateVerificationURL verifies that a HTTP probe targets an allowed host
+func validateVerificationURL(rawURL string, insecureSkipVerify bool) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid url %q: %w", rawURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url %q must use the http or https scheme", rawURL)
+	}
+	return validateVerificationTarget(u.Hostname(), u.Host, insecureSkipVerify)
+}
+
+// validateVerificationAddress verifies that a gRPC probe targets an allowed host
+func validateVerificationAddress(address string, insecureSkipVerify bool) error {
+	hostPort := strings.TrimPrefix(address, "dns:///")
+	host, _, err := net.SplitHostPort(hostPort)
+	if err == nil && strings.Contains(hostPort, "/") {
+		err = errors.New("unsupported scheme")
+	}
+	if err != nil {
+		return fmt.Errorf("address %q must be of the form host:port: %w", address, err)
+	}
+	return validateVerificationTarget(host, hostPort, insecureSkipVerify)
+}
+
+// validateVerificationTarget verifies that the host is allowed by the operator and that certificate verification is
+// only skipped if permitted
+func validateVerificationTarget(host, hostPort string, insecureSkipVerify bool) error {
+	if insecureSkipVerify && !syncVerificationConfig.allowInsecureSkipTLS {
+		return fmt.Errorf("insecureSkipVerify is not permitted, see %s", EnvVarSyncVerificationAllowInsecureSkipVerify)
+	}
+	for _, pattern := range syncVerificationConfig.allowedHosts {
+		if glob.Match(pattern, host) || glob.Match(pattern, hostPort) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %q is not permitted for verification probes, see %s", hostPort, EnvVarSyncVerificationAllowedHosts)
+}
+
+func httpVerificationCheck(url string, probe *v1alpha1.SyncVerificationHTTPProbe) verificationCheck {
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: probe.InsecureSkipVerify,
+			},
+		},
+		// redirects must target allowed hosts as well, so a probe cannot be redirected to any other host
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxVerificationRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxVerificationRedirects)
+			}
+			return validateVerificationURL(req.URL.String(), probe.InsecureSkipVerify)
+		},
+	}
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+		if err != nil {
+			return fmt.Errorf("failed to create request for %s: %w", url, err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("request to %s failed: %w", url, err)
+		}
+		defer utilio.Close(resp.Body)
+		if probe.ExpectedStatus != 0 {
+			if int64(resp.StatusCode) != probe.ExpectedStatus {
+				return fmt.Errorf("request to %s returned status %d, expected %d", url, resp.StatusCode, probe.ExpectedStatus)
+			}
+			return nil
+		}
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("request to %s returned status %d, expected 2xx", url, resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+func grpcVerificationCheck(address string, probe *v1alpha1.SyncVerificationGRPCProbe) verificationCheck {
+	expected := grpc_health_v1.HealthCheckResponse_SERVING.String()
+	if probe.ExpectedStatus != "" {
+		expected = probe.ExpectedStatus
+	}
+	creds := insecure.NewCredentials()
+	if probe.TLS {
+		creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: probe.InsecureSkipVerify})
+	}
+	return func(ctx context.Context) error {
+		conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return fmt.Errorf("failed to connect to %s: %w", address, err)
+		}
+		defer utilio.Close(conn)
+		resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: probe.Service})
+		if err != nil {
+			return fmt.Errorf("health check of %s failed: %w", address, err)
+		}
+		if status := resp.GetStatus().String(); status != expected {
+			return fmt.Errorf("health check of %s returned status %s, expected %s", address, status, expected)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,267 @@
+package controller
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// setSyncVerificationConfig overrides the operator settings of the post-sync verification for the duration of the test
+func setSyncVerificationConfig(t *testing.T, config verificationConfig) {
+	t.Helper()
+	previous := syncVerificationConfig
+	syncVerificationConfig = config
+	t.Cleanup(func() { syncVerificationConfig = previous })
+}
+
+func newTestVerificationConfig(allowedHosts ...string) verificationConfig {
+	return verificationConfig{allowedHosts: allowedHosts, timeout: time.Minute, maxAttempts: 10}
+}
+
+func TestVerifySync_HTTP(t *testing.T) {
+	setSyncVerificationConfig(t, newTestVerificationConfig("127.0.0.1"))
+	var requestedPaths []string
+	statusCode := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPaths = append(requestedPaths, r.URL.Path)
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+	app := newFakeApp()
+
+	t.Run("Succeeded", func(t *testing.T) {
+		requestedPaths = nil
+		verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{{
+			Name: "smoke",
+			HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: server.URL + "/{{.app.metadata.name}}/{{.revision}}"},
+		}}}
+		result := verifySync(t.Context(), app, verification, "abc", nil)
+		assert.False(t, result.Failed())
+		assert.Equal(t, []v1alpha1.SyncVerificationProbeResult{{Name: "smoke", Succeeded: true, Attempts: 1, Message: "probe succeeded"}}, result.Probes)
+		assert.Equal(t, []string{"/my-app/abc"}, requestedPaths)
+	})
+
+	t.Run("UnexpectedStatus", func(t *testing.T) {
+		requestedPaths = nil
+		verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{{
+			Name:     "smoke",
+			HTTP:     &v1alpha1.SyncVerificationHTTPProbe{URL: server.URL, ExpectedStatus: http.StatusNoContent},
+			Retries:  2,
+			Interval: "0",
+		}}}
+		result := verifySync(t.Context(), app, verification, "abc", nil)
+		require.True(t, result.Failed())
+		assert.Equal(t, int64(3), result.Probes[0].Attempts)
+		assert.Contains(t, result.Probes[0].Message, "returned status 200, expected 204")
+		assert.Len(t, requestedPaths, 3)
+		assert.Contains(t, verificationFailureMessage(result), `probe "smoke" failed after 3 attempt(s)`)
+	})
+
+	t.Run("ServerError", func(t *testing.T) {
+		statusCode = http.StatusInternalServerError
+		defer func() { statusCode = http.StatusOK }()
+		verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{{
+			Name: "smoke",
+			HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: server.URL},
+		}}}
+		result := verifySync(t.Context(), app, verification, "abc", nil)
+		require.True(t, result.Failed())
+		assert.Contains(t, result.Probes[0].Message, "returned status 500, expected 2xx")
+	})
+
+	t.Run("InvalidTemplate", func(t *testing.T) {
+		requestedPaths = nil
+		verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{{
+			Name: "smoke",
+			HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: server.URL + "/{{.unknown}}"},
+		}}}
+		result := verifySync(t.Context(), app, verification, "abc", nil)
+		require.True(t, result.Failed())
+		assert.Equal(t, int64(0), result.Probes[0].Attempts)
+		assert.Contains(t, result.Probes[0].Message, "failed to execute template")
+		assert.Empty(t, requestedPaths)
+	})
+}
+
+func TestVerifySync_GRPC(t *testing.T) {
+	setSyncVerificationConfig(t, newTestVerificationConfig("127.0.0.1"))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("guestbook", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+	app := newFakeApp()
+
+	t.Run("Serving", func(t *testing.T) {
+		verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{{
+			Name: "grpc",
+			GRPC: &v1alpha1.SyncVerificationGRPCProbe{Address: listener.Addr().String()},
+		}}}
+		result := verifySync(t.Context(), app, verification, "abc", nil)
+		assert.False(t, result.Failed())
+	})
+
+	t.Run("NotServing", func(t *testing.T) {
+		verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{{
+			Name: "grpc",
+			GRPC: &v1alpha1.SyncVerificationGRPCProbe{Address: listener.Addr().String(), Service: "guestbook"},
+		}}}
+		result := verifySync(t.Context(), app, verification, "abc", nil)
+		require.True(t, result.Failed())
+		assert.Contains(t, result.Probes[0].Message, "returned status NOT_SERVING, expected SERVING")
+	})
+
+	t.Run("ExpectedStatus", func(t *testing.T) {
+		verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{{
+			Name: "grpc",
+			GRPC: &v1alpha1.SyncVerificationGRPCProbe{Address: listener.Addr().String(), Service: "guestbook", ExpectedStatus: "NOT_SERVING"},
+		}}}
+		result := verifySync(t.Context(), app, verification, "abc", nil)
+		assert.False(t, result.Failed())
+	})
+}
+
+func TestVerifySync_InvalidProbe(t *testing.T) {
+	setSyncVerificationConfig(t, newTestVerificationConfig("localhost"))
+	app := newFakeApp()
+	verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{
+		{Name: "none"},
+		{Name: "both", HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: "http://localhost"}, GRPC: &v1alpha1.SyncVerificationGRPCProbe{Address: "localhost:8080"}},
+		{Name: "timeout", HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: "http://localhost"}, Timeout: "invalid"},
+	}}
+	result := verifySync(t.Context(), app, verification, "abc", nil)
+	require.Len(t, result.Probes, 3)
+	assert.Equal(t, `probe "none": one of http and grpc must be set`, result.Probes[0].Message)
+	assert.Equal(t, `probe "both": only one of http and grpc may be set`, result.Probes[1].Message)
+	assert.Contains(t, result.Probes[2].Message, "invalid timeout")
+	for _, probe := range result.Probes {
+		assert.False(t, probe.Succeeded)
+	}
+}
+
+func TestVerifySync_Target(t *testing.T) {
+	app := newFakeApp()
+	verify := func(t *testing.T, probe v1alpha1.SyncVerificationProbe) v1alpha1.SyncVerificationProbeResult {
+		t.Helper()
+		probe.Name = "target"
+		result := verifySync(t.Context(), app, &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{probe}}, "abc", nil)
+		require.Len(t, result.Probes, 1)
+		return result.Probes[0]
+	}
+
+	t.Run("NoAllowedHosts", func(t *testing.T) {
+		setSyncVerificationConfig(t, newTestVerificationConfig())
+		result := verify(t, v1alpha1.SyncVerificationProbe{HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: "http://my-app.default.svc/healthz"}})
+		assert.Equal(t, int64(0), result.Attempts)
+		assert.Contains(t, result.Message, `host "my-app.default.svc" is not permitted`)
+	})
+	t.Run("HTTPHostNotAllowed", func(t *testing.T) {
+		setSyncVerificationConfig(t, newTestVerificationConfig("*.default.svc"))
+		result := verify(t, v1alpha1.SyncVerificationProbe{HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: "http://169.254.169.254/latest/meta-data"}})
+		assert.Equal(t, int64(0), result.Attempts)
+		assert.Contains(t, result.Message, `host "169.254.169.254" is not permitted`)
+	})
+	t.Run("HTTPSchemeNotAllowed", func(t *testing.T) {
+		setSyncVerificationConfig(t, newTestVerificationConfig("*"))
+		result := verify(t, v1alpha1.SyncVerificationProbe{HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: "file:///etc/passwd"}})
+		assert.Contains(t, result.Message, "must use the http or https scheme")
+	})
+	t.Run("GRPCHostPortNotAllowed", func(t *testing.T) {
+		setSyncVerificationConfig(t, newTestVerificationConfig("api.default.svc:9090"))
+		result := verify(t, v1alpha1.SyncVerificationProbe{GRPC: &v1alpha1.SyncVerificationGRPCProbe{Address: "api.default.svc:8080"}})
+		assert.Contains(t, result.Message, `host "api.default.svc:8080" is not permitted`)
+	})
+	t.Run("GRPCAddressWithoutPort", func(t *testing.T) {
+		setSyncVerificationConfig(t, newTestVerificationConfig("*"))
+		result := verify(t, v1alpha1.SyncVerificationProbe{GRPC: &v1alpha1.SyncVerificationGRPCProbe{Address: "unix:///var/run/socket"}})
+		assert.Contains(t, result.Message, "must be of the form host:port")
+	})
+	t.Run("HTTPRedirectNotAllowed", func(t *testing.T) {
+		setSyncVerificationConfig(t, newTestVerificationConfig("127.0.0.1"))
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+		}))
+		defer server.Close()
+		result := verify(t, v1alpha1.SyncVerificationProbe{HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: server.URL}})
+		assert.False(t, result.Succeeded)
+		assert.Contains(t, result.Message, `host "169.254.169.254" is not permitted`)
+	})
+	t.Run("InsecureSkipVerifyNotAllowed", func(t *testing.T) {
+		setSyncVerificationConfig(t, newTestVerificationConfig("*"))
+		result := verify(t, v1alpha1.SyncVerificationProbe{HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: "https://my-app.default.svc", InsecureSkipVerify: true}})
+		assert.Contains(t, result.Message, "insecureSkipVerify is not permitted")
+	})
+}
+
+func TestVerifySync_Limits(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	app := newFakeApp()
+
+	t.Run("MaxAttempts", func(t *testing.T) {
+		requests = 0
+		config := newTestVerificationConfig("127.0.0.1")
+		config.maxAttempts = 2
+		setSyncVerificationConfig(t, config)
+		verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{{
+			Name:     "smoke",
+			HTTP:     &v1alpha1.SyncVerificationHTTPProbe{URL: server.URL},
+			Retries:  100,
+			Interval: "0",
+		}}}
+		result := verifySync(t.Context(), app, verification, "abc", nil)
+		require.True(t, result.Failed())
+		assert.Equal(t, int64(2), result.Probes[0].Attempts)
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		config := newTestVerificationConfig("127.0.0.1")
+		config.timeout = 100 * time.Millisecond
+		setSyncVerificationConfig(t, config)
+		verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{
+			{Name: "slow", HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: server.URL}, Retries: 5, Interval: "1m"},
+			{Name: "skipped", HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: server.URL}},
+		}}
+		start := time.Now()
+		result := verifySync(t.Context(), app, verification, "abc", nil)
+		assert.Less(t, time.Since(start), 10*time.Second)
+		require.Len(t, result.Probes, 2)
+		assert.Equal(t, int64(1), result.Probes[0].Attempts)
+		assert.Equal(t, context.DeadlineExceeded.Error(), result.Probes[0].Message)
+		assert.Equal(t, int64(0), result.Probes[1].Attempts)
+		assert.Contains(t, result.Probes[1].Message, "verification aborted")
+	})
+
+	t.Run("OperationContextCanceled", func(t *testing.T) {
+		setSyncVerificationConfig(t, newTestVerificationConfig("127.0.0.1"))
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		verification := &v1alpha1.SyncPolicyVerification{Probes: []v1alpha1.SyncVerificationProbe{
+			{Name: "smoke", HTTP: &v1alpha1.SyncVerificationHTTPProbe{URL: server.URL}},
+		}}
+		result := verifySync(ctx, app, verification, "abc", nil)
+		require.True(t, result.Failed())
+		assert.Contains(t, result.Probes[0].Message, "verification aborted")
+	})
+}
@@ -17,6 +17,9 @@ const (
 	// AnnotationKeyHookDeletePolicy is the policy of deleting a hook
 	AnnotationKeyHookDeletePolicy = "argocd.argoproj.io/hook-delete-policy"
 	AnnotationDeletionApproved    = "argocd.argoproj.io/deletion-approved"
+	// AnnotationDependsOn is a comma-separated list of the resources a resource depends on, in the
+	// <group>/<kind>/<namespace>/<name> format. A pruned resource is deleted before the pruned resources it depends on.
+	AnnotationDependsOn = "argocd.argoproj.io/depends-on"
 
 	// Sync option that disables dry run in resource is missing in the cluster
 	SyncOptionSkipDryRunOnMissingResource = "SkipDryRunOnMissingResource=true"
@@ -46,6 +49,9 @@ const (
 	SyncOptionDeleteRequireConfirm = "Delete=confirm"
 	// Sync option that requires confirmation before deleting the resource
 	SyncOptionPruneRequireConfirm = "Prune=confirm"
+	// Prefix of the sync option that requires confirmation before pruning resources of the listed kinds, e.g.
+	// PruneConfirmKinds=PersistentVolumeClaim,Namespace
+	SyncOptionPruneRequireConfirmKindsPrefix = "PruneConfirmKinds="
 	// Sync option that enables client-side apply migration
 	SyncOptionClientSideApplyMigration = "ClientSideApplyMigration=true"
 	// Sync option that disables client-side apply migration
@@ -108,6 +114,8 @@ const (
 	ResultCodeSyncFailed   ResultCode = "SyncFailed"
 	ResultCodePruned       ResultCode = "Pruned"
 	ResultCodePruneSkipped ResultCode = "PruneSkipped"
+	// ResultCodePruneConfirmationRequired is the result of resources whose pruning is waiting for confirmation
+	ResultCodePruneConfirmationRequired ResultCode = "PruneConfirmationRequired"
 )
 
 type HookType string
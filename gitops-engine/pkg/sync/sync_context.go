@@ -14,6 +14,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -122,6 +123,27 @@ func WithPruneConfirmed(confirmed bool) SyncOpt {
 	}
 }
 
+// WithPruneConfirmationRequired specifies if pruning any resource requires confirmation, rather than only pruning the
+// resources annotated with the Prune=confirm sync option
+func WithPruneConfirmationRequired(required bool) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.pruneConfirmationRequired = required
+	}
+}
+
+// WithPruneConfirmationRequiredKinds specifies the kinds of resources whose pruning requires confirmation, in addition to
+// the resources annotated with the Prune=confirm sync option
+func WithPruneConfirmationRequiredKinds(kinds ...schema.GroupKind) SyncOpt {
+	return func(ctx *syncContext) {
+		if ctx.pruneConfirmationRequiredKinds == nil {
+			ctx.pruneConfirmationRequiredKinds = map[schema.GroupKind]bool{}
+		}
+		for _, kind := range kinds {
+			ctx.pruneConfirmationRequiredKinds[kind] = true
+		}
+	}
+}
+
 // WithOperationSettings allows to set sync operation settings
 func WithOperationSettings(dryRun bool, prune bool, force bool, skipHooks bool) SyncOpt {
 	return func(ctx *syncContext) {
@@ -218,6 +240,30 @@ func WithClientSideApplyMigration(enabled bool, manager string) SyncOpt {
 	}
 }
 
+// WithCRDReadinessTimeout sets the maximum time to wait for a CRD applied during the sync to be established and served
+// by the API server. Custom resources of a CRD which is part of the sync are only applied once the CRD is served.
+func WithCRDReadinessTimeout(timeout time.Duration) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.crdReadinessTimeout = timeout
+	}
+}
+
+// WithContext sets the context of the sync. Waits of the sync, e.g. for the CRDs applied during the sync to be ready,
+// are aborted once the context is done.
+func WithContext(c context.Context) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.ctx = c
+	}
+}
+
+// WithForceTermination specifies if termination should abort in-flight hooks forcefully: hooks are deleted without
+// grace period and without waiting for the deletion of their dependents, even if their state cannot be determined.
+func WithForceTermination(force bool) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.forceTermination = force
+	}
+}
+
 // NewSyncContext creates new instance of a SyncContext
 func NewSyncContext(
 	revision string,
@@ -263,6 +309,8 @@ func NewSyncContext(
 		syncRes:                         map[string]common.ResourceSyncResult{},
 		clientSideApplyMigrationManager: common.DefaultClientSideApplyMigrationManager,
 		enableClientSideApplyMigration:  true,
+		crdReadinessTimeout:             defaultCRDReadinessTimeout,
+		ctx:                             context.Background(),
 		permissionValidator: func(_ *unstructured.Unstructured, _ *metav1.APIResource) error {
 			return nil
 		},
@@ -312,9 +360,18 @@ func groupDiffResults(diffResultList *diff.DiffResultList) map[kubeutil.Resource
 }
 
 const (
-	crdReadinessTimeout = time.Duration(3) * time.Second
+	defaultCRDReadinessTimeout = time.Duration(30) * time.Second
+	crdReadinessPollInterval   = time.Duration(100) * time.Millisecond
 )
 
+// crdApplyBackoff is used to retry applying custom resources of a CRD which has just been established
+var crdApplyBackoff = wait.Backoff{
+	Steps:    5,
+	Duration: 500 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
 // getOperationPhase returns a health status from a _live_ unstructured object
 func (sc *syncContext) getOperationPhase(obj *unstructured.Unstructured) (common.OperationPhase, string, error) {
 	phase := common.OperationSucceeded
@@ -349,7 +406,7 @@ type syncContext struct {
 	rawConfig           *rest.Config
 	dynamicIf           dynamic.Interface
 	disco               discovery.DiscoveryInterface
-	extensionsclientset *clientset.Clientset
+	extensionsclientset clientset.Interface
 	kubectl             kubeutil.Kubectl
 	resourceOps         kubeutil.ResourceOperations
 	namespace           string
@@ -367,8 +424,15 @@ type syncContext struct {
 	pruneLast                       bool
 	prunePropagationPolicy          *metav1.DeletionPropagation
 	pruneConfirmed                  bool
+	pruneConfirmationRequired       bool
+	pruneConfirmationRequiredKinds  map[schema.GroupKind]bool
 	clientSideApplyMigrationManager string
 	enableClientSideApplyMigration  bool
+	crdReadinessTimeout             time.Duration
+	forceTermination                bool
+	ctx                             context.Context
+	// caches the custom resource types which are known to be served by the API server
+	servedGroupVersionKinds sync.Map
 
 	syncRes   map[string]common.ResourceSyncResult
 	startedAt time.Time
@@ -975,6 +1039,8 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 		}
 	}
 
+	sc.orderPruneDependencies(tasks)
+
 	tasks.Sort()
 
 	// finally enrich tasks with the result
@@ -990,6 +1056,65 @@ func (sc *syncContext) getSyncTasks() (_ syncTasks, successful bool) {
 	return tasks, successful
 }
 
+// orderPruneDependencies moves the pruned dependencies of pruned resources to a later wave than the resources depending
+// on them, so that e.g. an operator is deleted only once the custom resources it finalizes are gone.
+func (sc *syncContext) orderPruneDependencies(tasks syncTasks) {
+	pruneTasks := make(map[kubeutil.ResourceKey]*syncTask)
+	for _, task := range tasks {
+		if task.isPrune() {
+			pruneTasks[kubeutil.GetResourceKey(task.liveObj)] = task
+		}
+	}
+	dependencies := make(map[*syncTask][]*syncTask)
+	for _, task := range pruneTasks {
+		for _, key := range sc.getDependencies(task.liveObj) {
+			if dependency, ok := pruneTasks[key]; ok && dependency != task {
+				dependencies[task] = append(dependencies[task], dependency)
+			}
+		}
+	}
+	// every iteration settles at least one level of the dependency graph, so more iterations than tasks means a cycle
+	for i := 0; i <= len(pruneTasks); i++ {
+		changed := false
+		for task, taskDependencies := range dependencies {
+			for _, dependency := range taskDependencies {
+				if dependency.wave() > task.wave() {
+					continue
+				}
+				wave := task.wave() + 1
+				dependency.waveOverride = &wave
+				changed = true
+			}
+		}
+		if !changed {
+			return
+		}
+	}
+	sc.log.Info("Circular dependencies between pruned resources, some resources might be pruned before the resources depending on them")
+}
+
+// getDependencies returns the keys of the resources the given resource depends on
+func (sc *syncContext) getDependencies(obj *unstructured.Unstructured) []kubeutil.ResourceKey {
+	value, ok := obj.GetAnnotations()[common.AnnotationDependsOn]
+	if !ok {
+		return nil
+	}
+	var keys []kubeutil.ResourceKey
+	for _, ref := range strings.Split(value, ",") {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		parts := strings.Split(ref, "/")
+		if len(parts) != 4 {
+			sc.log.WithValues("resource", kubeutil.GetResourceKey(obj), "dependency", ref).Info("Ignoring invalid dependency, expected <group>/<kind>/<namespace>/<name>")
+			continue
+		}
+		keys = append(keys, kubeutil.NewResourceKey(parts[0], parts[1], parts[2], parts[3]))
+	}
+	return keys
+}
+
 func (sc *syncContext) autoCreateNamespace(tasks syncTasks) syncTasks {
 	isNamespaceCreationNeeded := true
 
@@ -1089,10 +1214,11 @@ func (sc *syncContext) setOperationPhase(phase common.OperationPhase, message st
 	sc.message = message
 }
 
-// ensureCRDReady waits until specified CRD is ready (established condition is true).
-func (sc *syncContext) ensureCRDReady(name string) error {
-	err := wait.PollUntilContextTimeout(context.Background(), time.Duration(100)*time.Millisecond, crdReadinessTimeout, true, func(_ context.Context) (bool, error) {
-		crd, err := sc.extensionsclientset.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), name, metav1.GetOptions{})
+// ensureCRDReady waits until the specified CRD is established and the API server serves its custom resources
+func (sc *syncContext) ensureCRDReady(crd *unstructured.Unstructured) error {
+	name := crd.GetName()
+	err := wait.PollUntilContextTimeout(sc.ctx, crdReadinessPollInterval, sc.crdReadinessTimeout, true, func(ctx context.Context) (bool, error) {
+		crd, err := sc.extensionsclientset.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			//nolint:wrapcheck // wrapped outside the retry
 			return false, err
@@ -1107,9 +1233,70 @@ func (sc *syncContext) ensureCRDReady(name string) error {
 	if err != nil {
 		return fmt.Errorf("failed to ensure CRD ready: %w", err)
 	}
+	for _, gvk := range crdGroupVersionKinds(crd) {
+		if err := sc.ensureResourceServed(gvk); err != nil {
+			return fmt.Errorf("failed to ensure CRD ready: %w", err)
+		}
+	}
+	return nil
+}
+
+// ensureResourceServed waits until the API server serves resources of the specified group, version and kind
+func (sc *syncContext) ensureResourceServed(gvk schema.GroupVersionKind) error {
+	if _, ok := sc.servedGroupVersionKinds.Load(gvk); ok {
+		return nil
+	}
+	var lastErr error
+	err := wait.PollUntilContextTimeout(sc.ctx, crdReadinessPollInterval, sc.crdReadinessTimeout, true, func(_ context.Context) (bool, error) {
+		_, lastErr = kubeutil.ServerResourceForGroupVersionKind(sc.disco, gvk, "get")
+		return lastErr == nil, nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			return fmt.Errorf("%s is not served by the API server: %w", gvk, lastErr)
+		}
+		return fmt.Errorf("%s is not served by the API server: %w", gvk, err)
+	}
+	sc.servedGroupVersionKinds.Store(gvk, true)
 	return nil
 }
 
+// crdGroupVersionKinds returns the group, versions and kind of the custom resources served according to the CRD
+func crdGroupVersionKinds(crd *unstructured.Unstructured) []schema.GroupVersionKind {
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	var gvks []schema.GroupVersionKind
+	for _, v := range versions {
+		version, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(version, "name")
+		served, _, _ := unstructured.NestedBool(version, "served")
+		if name != "" && served {
+			gvks = append(gvks, schema.GroupVersionKind{Group: group, Version: name, Kind: kind})
+		}
+	}
+	if len(versions) == 0 {
+		// apiextensions.k8s.io/v1beta1 CRDs might declare a single version only
+		if version, _, _ := unstructured.NestedString(crd.Object, "spec", "version"); version != "" {
+			gvks = append(gvks, schema.GroupVersionKind{Group: group, Version: version, Kind: kind})
+		}
+	}
+	return gvks
+}
+
+// isResourceTypeNotServedError returns true if the error indicates that the API server does not know the type of
+// the applied resource
+func isResourceTypeNotServedError(err error) bool {
+	if meta.IsNoMatchError(err) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "no matches for kind") || strings.Contains(msg, "the server could not find the requested resource")
+}
+
 func (sc *syncContext) shouldUseServerSideApply(targetObj *unstructured.Unstructured, dryRun bool) bool {
 	// if it is a dry run, disable server side apply, as the goal is to validate only the
 	// yaml correctness of the rendered manifests.
@@ -1190,6 +1377,14 @@ func (sc *syncContext) applyObject(t *syncTask, dryRun, validate bool) (common.R
 	force := sc.force || resourceutil.HasAnnotationOption(t.targetObj, common.AnnotationSyncOptions, common.SyncOptionForce)
 	serverSideApply := sc.shouldUseServerSideApply(t.targetObj, dryRun)
 
+	// Custom resources of a CRD which is part of the sync can only be applied once the CRD is served by the API server
+	crdInSync := !dryRun && sc.hasCRDOfGroupKind(t.group(), t.kind())
+	if crdInSync {
+		if err = sc.ensureResourceServed(t.groupVersionKind()); err != nil {
+			return common.ResultCodeSyncFailed, fmt.Sprintf("CRD of %s is not ready: %v", t.groupVersionKind().GroupKind(), err)
+		}
+	}
+
 	// Check if we need to perform client-side apply migration for server-side apply
 	if serverSideApply && !dryRun && sc.enableClientSideApplyMigration {
 		if sc.needsClientSideApplyMigration(t.liveObj, sc.clientSideApplyMigrationManager) {
@@ -1200,6 +1395,32 @@ func (sc *syncContext) applyObject(t *syncTask, dryRun, validate bool) (common.R
 		}
 	}
 
+	apply := func() error {
+		message, err = sc.applyOrReplace(t, dryRunStrategy, shouldReplace, force, validate, serverSideApply)
+		return err
+	}
+	if crdInSync {
+		// the type of a CRD which has just been established might not be known yet to all API server replicas
+		err = retry.OnError(crdApplyBackoff, isResourceTypeNotServedError, apply)
+	} else {
+		err = apply()
+	}
+	if err != nil {
+		return common.ResultCodeSyncFailed, err.Error()
+	}
+	if kubeutil.IsCRD(t.targetObj) && !dryRun {
+		if err = sc.ensureCRDReady(t.targetObj); err != nil {
+			sc.log.Error(err, fmt.Sprintf("failed to ensure that CRD %s is ready", t.targetObj.GetName()))
+			return common.ResultCodeSyncFailed, fmt.Sprintf("%s, but it is not ready: %v", message, err)
+		}
+	}
+	return common.ResultCodeSynced, message
+}
+
+// applyOrReplace applies the target object of the task, or replaces it if requested
+func (sc *syncContext) applyOrReplace(t *syncTask, dryRunStrategy cmdutil.DryRunStrategy, shouldReplace, force, validate, serverSideApply bool) (string, error) {
+	var err error
+	var message string
 	if shouldReplace {
 		if t.liveObj != nil {
 			// Avoid using `kubectl replace` for CRDs since 'replace' might recreate resource and so delete all CRD instances.
@@ -1223,16 +1444,16 @@ func (sc *syncContext) applyObject(t *syncTask, dryRun, validate bool) (common.R
 	} else {
 		message, err = sc.resourceOps.ApplyResource(context.TODO(), t.targetObj, dryRunStrategy, force, validate, serverSideApply, sc.serverSideApplyManager)
 	}
-	if err != nil {
-		return common.ResultCodeSyncFailed, err.Error()
-	}
-	if kubeutil.IsCRD(t.targetObj) && !dryRun {
-		crdName := t.targetObj.GetName()
-		if err = sc.ensureCRDReady(crdName); err != nil {
-			sc.log.Error(err, fmt.Sprintf("failed to ensure that CRD %s is ready", crdName))
-		}
+	//nolint:wrapcheck // the error message is reported as is in the sync result
+	return message, err
+}
+
+// pruneRequiresConfirmation returns true if pruning the task's live object must wait for confirmation
+func (sc *syncContext) pruneRequiresConfirmation(task *syncTask) bool {
+	if sc.pruneConfirmationRequired || sc.pruneConfirmationRequiredKinds[task.liveObj.GroupVersionKind().GroupKind()] {
+		return true
 	}
-	return common.ResultCodeSynced, message
+	return resourceutil.HasAnnotationOption(task.liveObj, common.AnnotationSyncOptions, common.SyncOptionPruneRequireConfirm)
 }
 
 // pruneObject deletes the object if both prune is true and dryRun is false. Otherwise appropriate message
@@ -1265,6 +1486,14 @@ func (sc *syncContext) getDeleteOptions() metav1.DeleteOptions {
 	return deleteOption
 }
 
+// getForceDeleteOptions returns the options to delete a resource immediately. Dependents, e.g. the pods of a job, are
+// deleted in the background, so the deletion does not wait for them to terminate.
+func (sc *syncContext) getForceDeleteOptions() metav1.DeleteOptions {
+	propagationPolicy := metav1.DeletePropagationBackground
+	gracePeriodSeconds := int64(0)
+	return metav1.DeleteOptions{PropagationPolicy: &propagationPolicy, GracePeriodSeconds: &gracePeriodSeconds}
+}
+
 func (sc *syncContext) targetObjs() []*unstructured.Unstructured {
 	objs := sc.hooks
 	for _, r := range sc.resources {
@@ -1317,11 +1546,19 @@ func (sc *syncContext) Terminate() {
 		}
 		phase, msg, err := sc.getOperationPhase(task.liveObj)
 		if err != nil {
-			sc.setOperationPhase(common.OperationError, fmt.Sprintf("Failed to get hook health: %v", err))
-			return
+			if !sc.forceTermination {
+				sc.setOperationPhase(common.OperationError, fmt.Sprintf("Failed to get hook health: %v", err))
+				return
+			}
+			// the hook might still be running, abort it anyway
+			phase = common.OperationRunning
 		}
 		if phase == common.OperationRunning {
-			err := sc.deleteResource(task)
+			deleteOptions := sc.getDeleteOptions()
+			if sc.forceTermination {
+				deleteOptions = sc.getForceDeleteOptions()
+			}
+			err := sc.deleteResourceWithOptions(task, deleteOptions)
 			if err != nil && !apierrors.IsNotFound(err) {
 				sc.setResourceResult(task, task.syncStatus, common.OperationFailed, fmt.Sprintf("Failed to delete: %v", err))
 				terminateSuccessful = false
@@ -1340,12 +1577,16 @@ func (sc *syncContext) Terminate() {
 }
 
 func (sc *syncContext) deleteResource(task *syncTask) error {
+	return sc.deleteResourceWithOptions(task, sc.getDeleteOptions())
+}
+
+func (sc *syncContext) deleteResourceWithOptions(task *syncTask, deleteOptions metav1.DeleteOptions) error {
 	sc.log.WithValues("task", task).V(1).Info("Deleting resource")
 	resIf, err := sc.getResourceIf(task, "delete")
 	if err != nil {
 		return err
 	}
-	err = resIf.Delete(context.TODO(), task.name(), sc.getDeleteOptions())
+	err = resIf.Delete(context.TODO(), task.name(), deleteOptions)
 	if err != nil {
 		return fmt.Errorf("failed to delete resource: %w", err)
 	}
@@ -1424,8 +1665,12 @@ func (sc *syncContext) runTasks(tasks syncTasks, dryRun bool) runState {
 		if !sc.pruneConfirmed {
 			var resources []string
 			for _, task := range pruneTasks {
-				if resourceutil.HasAnnotationOption(task.liveObj, common.AnnotationSyncOptions, common.SyncOptionPruneRequireConfirm) {
+				if sc.pruneRequiresConfirmation(task) {
 					resources = append(resources, fmt.Sprintf("%s/%s/%s", task.obj().GetAPIVersion(), task.obj().GetKind(), task.name()))
+					if !dryRun {
+						// record the resources waiting for confirmation in the operation state
+						sc.setResourceResult(task, common.ResultCodePruneConfirmationRequired, "", "waiting for pruning confirmation")
+					}
 				}
 			}
 			if len(resources) > 0 {
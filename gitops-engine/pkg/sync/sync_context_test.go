@@ -12,12 +12,15 @@ import (
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -48,6 +51,7 @@ func newTestSyncCtx(getResourceFunc *func(ctx context.Context, config *rest.Conf
 		resources: map[kube.ResourceKey]reconciledResource{},
 		syncRes:   map[string]synccommon.ResourceSyncResult{},
 		validate:  true,
+		ctx:       context.Background(),
 	}
 	sc.permissionValidator = func(_ *unstructured.Unstructured, _ *metav1.APIResource) error {
 		return nil
@@ -801,6 +805,61 @@ func TestDoNotPrunePruneFalse(t *testing.T) {
 	assert.Equal(t, synccommon.OperationSucceeded, phase)
 }
 
+func TestPruneConfirmationRequired(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, true, false, false), WithPruneConfirmationRequired(true))
+	pod := testingutils.NewPod()
+	pod.SetNamespace(testingutils.FakeArgoCDNamespace)
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{pod},
+		Target: []*unstructured.Unstructured{nil},
+	})
+
+	syncCtx.Sync()
+	phase, _, resources := syncCtx.GetState()
+
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	require.Len(t, resources, 1)
+	assert.Equal(t, synccommon.ResultCodePruneConfirmationRequired, resources[0].Status)
+
+	syncCtx.pruneConfirmed = true
+	syncCtx.Sync()
+	phase, _, resources = syncCtx.GetState()
+
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	require.Len(t, resources, 1)
+	assert.Equal(t, synccommon.ResultCodePruned, resources[0].Status)
+}
+
+func TestPruneConfirmationRequiredKinds(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithOperationSettings(false, true, false, false), WithPruneConfirmationRequiredKinds(schema.GroupKind{Kind: "Pod"}))
+	pod := testingutils.NewPod()
+	pod.SetNamespace(testingutils.FakeArgoCDNamespace)
+	svc := testingutils.NewService()
+	svc.SetNamespace(testingutils.FakeArgoCDNamespace)
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{pod, svc},
+		Target: []*unstructured.Unstructured{nil, nil},
+	})
+
+	syncCtx.Sync()
+	phase, _, resources := syncCtx.GetState()
+
+	assert.Equal(t, synccommon.OperationRunning, phase)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "Pod", resources[0].ResourceKey.Kind)
+	assert.Equal(t, synccommon.ResultCodePruneConfirmationRequired, resources[0].Status)
+
+	syncCtx.pruneConfirmed = true
+	syncCtx.Sync()
+	phase, _, resources = syncCtx.GetState()
+
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	require.Len(t, resources, 2)
+	for _, res := range resources {
+		assert.Equal(t, synccommon.ResultCodePruned, res.Status)
+	}
+}
+
 // // make sure Validate=false means we don't validate
 func TestSyncOptionValidate(t *testing.T) {
 	tests := []struct {
@@ -1382,6 +1441,79 @@ func TestSync_ExistingHooksWithFinalizer(t *testing.T) {
 	assert.True(t, apierrors.IsNotFound(err))
 }
 
+func TestTerminate(t *testing.T) {
+	newTerminateSyncCtx := func(healthOverride health.HealthOverride, opts ...SyncOpt) (*syncContext, *[]metav1.DeleteOptions) {
+		runningHook := newHook(synccommon.HookTypeSync, synccommon.HookDeletePolicyBeforeHookCreation)
+		runningHook.SetName("running-hook")
+		completedHook := newHook(synccommon.HookTypeSync, synccommon.HookDeletePolicyBeforeHookCreation)
+		completedHook.SetName("completed-hook")
+
+		syncCtx := newTestSyncCtx(nil, append(opts, WithHealthOverride(healthOverride))...)
+		fakeDynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme(), runningHook, completedHook)
+		syncCtx.dynamicIf = fakeDynamicClient
+		var deleteOptions []metav1.DeleteOptions
+		fakeDynamicClient.PrependReactor("delete", "*", func(action testcore.Action) (handled bool, ret runtime.Object, err error) {
+			deleteOptions = append(deleteOptions, action.(testcore.DeleteAction).GetDeleteOptions())
+			return false, nil, nil
+		})
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{runningHook, completedHook},
+			Target: []*unstructured.Unstructured{nil, nil},
+		})
+		syncCtx.hooks = []*unstructured.Unstructured{runningHook, completedHook}
+		return syncCtx, &deleteOptions
+	}
+	healthOverride := resourceNameHealthOverride(map[string]health.HealthStatusCode{
+		"running-hook":   health.HealthStatusProgressing,
+		"completed-hook": health.HealthStatusHealthy,
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		syncCtx, deleteOptions := newTerminateSyncCtx(healthOverride)
+		syncCtx.Terminate()
+		phase, message, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "Operation terminated", message)
+		require.Len(t, *deleteOptions, 1)
+		assert.Equal(t, metav1.DeletePropagationForeground, *(*deleteOptions)[0].PropagationPolicy)
+		assert.Nil(t, (*deleteOptions)[0].GracePeriodSeconds)
+	})
+
+	t.Run("Force", func(t *testing.T) {
+		syncCtx, deleteOptions := newTerminateSyncCtx(healthOverride, WithForceTermination(true))
+		syncCtx.Terminate()
+		phase, _, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		require.Len(t, *deleteOptions, 1)
+		assert.Equal(t, metav1.DeletePropagationBackground, *(*deleteOptions)[0].PropagationPolicy)
+		assert.Equal(t, int64(0), *(*deleteOptions)[0].GracePeriodSeconds)
+	})
+
+	t.Run("UnknownHealth", func(t *testing.T) {
+		syncCtx, deleteOptions := newTerminateSyncCtx(failingHealthOverride{})
+		syncCtx.Terminate()
+		phase, message, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationError, phase)
+		assert.Contains(t, message, "Failed to get hook health")
+		assert.Empty(t, *deleteOptions)
+	})
+
+	t.Run("ForceUnknownHealth", func(t *testing.T) {
+		syncCtx, deleteOptions := newTerminateSyncCtx(failingHealthOverride{}, WithForceTermination(true))
+		syncCtx.Terminate()
+		phase, message, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "Operation terminated", message)
+		assert.Len(t, *deleteOptions, 2)
+	})
+}
+
+type failingHealthOverride struct{}
+
+func (failingHealthOverride) GetResourceHealth(_ *unstructured.Unstructured) (*health.HealthStatus, error) {
+	return nil, errors.New("health check failed")
+}
+
 func TestRunSyncFailHooksFailed(t *testing.T) {
 	// Tests that other SyncFail Hooks run even if one of them fail.
 
@@ -1701,6 +1833,108 @@ func Test_syncContext_hasCRDOfGroupKind(t *testing.T) {
 	assert.True(t, (&syncContext{hooks: []*unstructured.Unstructured{testingutils.NewCRD()}}).hasCRDOfGroupKind("test.io", "TestCrd"))
 }
 
+func newEstablishedCRD(established apiextensionsv1.ConditionStatus) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "testcrds.argoproj.io"},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			Conditions: []apiextensionsv1.CustomResourceDefinitionCondition{{Type: apiextensionsv1.Established, Status: established}},
+		},
+	}
+}
+
+func Test_syncContext_ensureCRDReady(t *testing.T) {
+	crd := testingutils.NewCRD()
+	newSyncCtx := func(established apiextensionsv1.ConditionStatus, served bool) *syncContext {
+		syncCtx := newTestSyncCtx(nil, WithCRDReadinessTimeout(300*time.Millisecond))
+		syncCtx.extensionsclientset = apiextensionsfake.NewSimpleClientset(newEstablishedCRD(established))
+		if served {
+			fakeDisco := syncCtx.disco.(*fakedisco.FakeDiscovery)
+			fakeDisco.Resources = append(fakeDisco.Resources, &metav1.APIResourceList{
+				GroupVersion: "test.io/v1",
+				APIResources: []metav1.APIResource{{Kind: "TestCrd", Group: "test.io", Version: "v1", Namespaced: true, Verbs: testingutils.CommonVerbs}},
+			})
+		}
+		return syncCtx
+	}
+
+	t.Run("Ready", func(t *testing.T) {
+		require.NoError(t, newSyncCtx(apiextensionsv1.ConditionTrue, true).ensureCRDReady(crd))
+	})
+	t.Run("NotEstablished", func(t *testing.T) {
+		require.ErrorContains(t, newSyncCtx(apiextensionsv1.ConditionFalse, true).ensureCRDReady(crd), "failed to ensure CRD ready")
+	})
+	t.Run("NotServed", func(t *testing.T) {
+		err := newSyncCtx(apiextensionsv1.ConditionTrue, false).ensureCRDReady(crd)
+		require.ErrorContains(t, err, "test.io/v1, Kind=TestCrd is not served by the API server")
+	})
+	t.Run("ContextDone", func(t *testing.T) {
+		syncCtx := newSyncCtx(apiextensionsv1.ConditionFalse, true)
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		WithContext(ctx)(syncCtx)
+		syncCtx.crdReadinessTimeout = time.Hour
+		require.ErrorIs(t, syncCtx.ensureCRDReady(crd), context.Canceled)
+	})
+	t.Run("ApplyFailsIfNotReady", func(t *testing.T) {
+		result, message := newSyncCtx(apiextensionsv1.ConditionFalse, true).applyObject(&syncTask{targetObj: crd}, false, false)
+		assert.Equal(t, synccommon.ResultCodeSyncFailed, result)
+		assert.Contains(t, message, "but it is not ready: failed to ensure CRD ready")
+	})
+}
+
+func Test_syncContext_applyObject_CustomResourceOfCRDNotServed(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithCRDReadinessTimeout(300*time.Millisecond))
+	cr := testingutils.Unstructured(`
+apiVersion: test.io/v1
+kind: TestCrd
+metadata:
+  name: my-resource
+`)
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, nil},
+		Target: []*unstructured.Unstructured{cr, testingutils.NewCRD()},
+	})
+
+	result, message := syncCtx.applyObject(&syncTask{targetObj: cr}, false, false)
+	assert.Equal(t, synccommon.ResultCodeSyncFailed, result)
+	assert.Contains(t, message, "CRD of TestCrd.test.io is not ready")
+
+	// the custom resource is applied once the CRD is served
+	fakeDisco := syncCtx.disco.(*fakedisco.FakeDiscovery)
+	fakeDisco.Resources = append(fakeDisco.Resources, &metav1.APIResourceList{
+		GroupVersion: "test.io/v1",
+		APIResources: []metav1.APIResource{{Kind: "TestCrd", Group: "test.io", Version: "v1", Namespaced: true, Verbs: testingutils.CommonVerbs}},
+	})
+	result, _ = syncCtx.applyObject(&syncTask{targetObj: cr}, false, false)
+	assert.Equal(t, synccommon.ResultCodeSynced, result)
+}
+
+func Test_crdGroupVersionKinds(t *testing.T) {
+	crd := testingutils.Unstructured(`
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: testcrds.test.io
+spec:
+  group: test.io
+  names:
+    kind: TestCrd
+  versions:
+  - name: v1
+    served: true
+  - name: v1beta1
+    served: false
+`)
+	assert.Equal(t, []schema.GroupVersionKind{{Group: "test.io", Version: "v1", Kind: "TestCrd"}}, crdGroupVersionKinds(crd))
+	assert.Equal(t, []schema.GroupVersionKind{{Group: "test.io", Version: "v1", Kind: "TestCrd"}}, crdGroupVersionKinds(testingutils.NewCRD()))
+}
+
+func Test_isResourceTypeNotServedError(t *testing.T) {
+	assert.True(t, isResourceTypeNotServedError(&meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "test.io", Kind: "TestCrd"}}))
+	assert.True(t, isResourceTypeNotServedError(errors.New(`error: resource mapping not found for name: "my-resource" namespace: "" from "/dev/shm/123": no matches for kind "TestCrd" in version "test.io/v1"`)))
+	assert.False(t, isResourceTypeNotServedError(errors.New("admission webhook denied the request")))
+}
+
 func Test_setRunningPhase(t *testing.T) {
 	newPodTask := func(name string) *syncTask {
 		pod := testingutils.NewPod()
@@ -1952,6 +2186,57 @@ func TestPruneLast(t *testing.T) {
 	})
 }
 
+func TestPruneDependencies(t *testing.T) {
+	newPod := func(name string) *unstructured.Unstructured {
+		pod := testingutils.NewPod()
+		pod.SetName(name)
+		return pod
+	}
+	podKey := func(pod *unstructured.Unstructured) string {
+		return "/Pod/" + pod.GetNamespace() + "/" + pod.GetName()
+	}
+
+	t.Run("dependenciesPrunedAfterDependents", func(t *testing.T) {
+		crd := newPod("crd")
+		operator := newPod("operator")
+		operator.SetAnnotations(map[string]string{synccommon.AnnotationDependsOn: podKey(crd)})
+		cr := newPod("cr")
+		cr.SetAnnotations(map[string]string{synccommon.AnnotationDependsOn: podKey(operator) + ", " + podKey(crd)})
+		other := newPod("other")
+
+		syncCtx := newTestSyncCtx(nil)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{crd, operator, cr, other},
+			Target: []*unstructured.Unstructured{nil, nil, nil, nil},
+		})
+		tasks, successful := syncCtx.getSyncTasks()
+
+		assert.True(t, successful)
+		waves := map[string]int{}
+		for _, task := range tasks {
+			waves[task.name()] = task.wave()
+		}
+		assert.Equal(t, map[string]int{"cr": 0, "other": 0, "operator": 1, "crd": 2}, waves)
+	})
+
+	t.Run("circularDependencies", func(t *testing.T) {
+		operator := newPod("operator")
+		cr := newPod("cr")
+		operator.SetAnnotations(map[string]string{synccommon.AnnotationDependsOn: podKey(cr)})
+		cr.SetAnnotations(map[string]string{synccommon.AnnotationDependsOn: podKey(operator)})
+
+		syncCtx := newTestSyncCtx(nil)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{cr, operator},
+			Target: []*unstructured.Unstructured{nil, nil},
+		})
+		tasks, successful := syncCtx.getSyncTasks()
+
+		assert.True(t, successful)
+		assert.Len(t, tasks, 2)
+	})
+}
+
 func diffResultList() *diff.DiffResultList {
 	pod1 := testingutils.NewPod()
 	pod1.SetName("pod-1")
@@ -0,0 +1,52 @@
+package sync
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+)
+
+// PlannedTask is a step of the order in which a sync applies resources
+type PlannedTask struct {
+	// Phase is the sync phase the resource is applied in
+	Phase common.SyncPhase
+	// Wave is the sync wave the resource is applied in
+	Wave int
+	// HookTypes are the hook types of the resource, which are empty if the resource is not a hook
+	HookTypes []common.HookType
+	// Object is the target object of the resource
+	Object *unstructured.Unstructured
+}
+
+// PlanTasks returns the order in which a sync applies the given target objects, grouped by phase and wave in the same
+// way as the sync itself. Objects without a namespace are placed into the given namespace. Pruning is not planned,
+// since it depends on the live state of the resources, and hooks are omitted if skipHooks is true.
+func PlanTasks(targets []*unstructured.Unstructured, namespace string, skipHooks bool) []PlannedTask {
+	var tasks syncTasks
+	for _, target := range targets {
+		isHook := hook.IsHook(target)
+		if isHook && skipHooks {
+			continue
+		}
+		obj := target
+		if obj.GetNamespace() == "" && namespace != "" {
+			obj = obj.DeepCopy()
+			obj.SetNamespace(namespace)
+		}
+		for _, phase := range syncPhases(target) {
+			tasks = append(tasks, &syncTask{phase: phase, targetObj: obj})
+		}
+	}
+	tasks.Sort()
+
+	plan := make([]PlannedTask, 0, len(tasks))
+	for _, task := range tasks {
+		planned := PlannedTask{Phase: task.phase, Wave: task.wave(), Object: task.targetObj}
+		if task.isHook() {
+			planned.HookTypes = hook.Types(task.targetObj)
+		}
+		plan = append(plan, planned)
+	}
+	return plan
+}
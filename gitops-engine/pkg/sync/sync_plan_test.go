@@ -0,0 +1,72 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	testingutils "github.com/argoproj/gitops-engine/pkg/utils/testing"
+)
+
+func TestPlanTasks(t *testing.T) {
+	preSync := testingutils.NewPod()
+	preSync.SetName("pre-sync")
+	testingutils.Annotate(preSync, common.AnnotationKeyHook, "PreSync,PostSync")
+	skipped := testingutils.NewPod()
+	skipped.SetName("skipped")
+	testingutils.Annotate(skipped, common.AnnotationKeyHook, "Skip")
+	service := testingutils.NewService()
+	laterPod := testingutils.NewPod()
+	laterPod.SetName("later")
+	testingutils.Annotate(laterPod, common.AnnotationSyncWave, "1")
+	earlierPod := testingutils.NewPod()
+	earlierPod.SetName("earlier")
+	testingutils.Annotate(earlierPod, common.AnnotationSyncWave, "-1")
+
+	plan := PlanTasks([]*unstructured.Unstructured{laterPod, service, preSync, skipped, earlierPod}, "my-namespace", false)
+
+	require.Len(t, plan, 5)
+	var steps []string
+	for _, task := range plan {
+		steps = append(steps, string(task.Phase)+"/"+task.Object.GetKind()+"/"+task.Object.GetName())
+	}
+	assert.Equal(t, []string{
+		"PreSync/Pod/pre-sync",
+		"Sync/Pod/earlier",
+		"Sync/Service/my-service",
+		"Sync/Pod/later",
+		"PostSync/Pod/pre-sync",
+	}, steps)
+	assert.Equal(t, -1, plan[1].Wave)
+	assert.Equal(t, 1, plan[3].Wave)
+	assert.Equal(t, []common.HookType{common.HookTypePreSync, common.HookTypePostSync}, plan[0].HookTypes)
+	assert.Empty(t, plan[2].HookTypes)
+	assert.Equal(t, "my-namespace", plan[2].Object.GetNamespace())
+	assert.Empty(t, service.GetNamespace(), "the target objects must not be modified")
+}
+
+func TestPlanTasks_SkipHooks(t *testing.T) {
+	hook := testingutils.NewPod()
+	testingutils.Annotate(hook, common.AnnotationKeyHook, "PreSync")
+
+	plan := PlanTasks([]*unstructured.Unstructured{hook, testingutils.NewService()}, "", true)
+
+	require.Len(t, plan, 1)
+	assert.Equal(t, "Service", plan[0].Object.GetKind())
+}
+
+func TestPlanTasks_NamespaceBeforeResources(t *testing.T) {
+	ns := testingutils.NewNamespace()
+	testingutils.Annotate(ns, common.AnnotationSyncWave, "5")
+	pod := testingutils.NewPod()
+	pod.SetNamespace(ns.GetName())
+
+	plan := PlanTasks([]*unstructured.Unstructured{pod, ns}, "", false)
+
+	require.Len(t, plan, 2)
+	assert.Equal(t, "Namespace", plan[0].Object.GetKind())
+	assert.Equal(t, 0, plan[0].Wave, "the namespace must be created in the wave of the first resource in it")
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/cluster"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -21,12 +22,14 @@ var kindToCRDPath = map[string]string{
 	application.ApplicationFullName:    "manifests/crds/application-crd.yaml",
 	application.AppProjectFullName:     "manifests/crds/appproject-crd.yaml",
 	application.ApplicationSetFullName: "manifests/crds/applicationset-crd.yaml",
+	cluster.ClusterFullName:            "manifests/crds/cluster-crd.yaml",
 }
 
 func getCustomResourceDefinitions(ctx context.Context) map[string]*apiextensionsv1.CustomResourceDefinition {
 	crdYamlBytes, err := exec.CommandContext(ctx,
 		"controller-gen",
 		"paths=./pkg/apis/application/...",
+		"paths=./pkg/apis/cluster/...",
 		"crd:crdVersions=v1",
 		"output:crd:stdout",
 	).Output()
@@ -38,6 +41,7 @@ func getCustomResourceDefinitions(ctx context.Context) map[string]*apiextensions
 	deleteFile("config/argoproj.io_applications.yaml")
 	deleteFile("config/argoproj.io_appprojects.yaml")
 	deleteFile("config/argoproj.io_applicationsets.yaml")
+	deleteFile("config/cluster.argoproj.io_clusters.yaml")
 	deleteFile("config")
 
 	objs, err := kube.SplitYAML(crdYamlBytes)
@@ -54,6 +58,13 @@ func getCustomResourceDefinitions(ctx context.Context) map[string]*apiextensions
 			removeValidation(un, "status")
 		}
 
+		// the TLS client config of a cluster is marked as required by the JSON tags of the cluster config, but does not
+		// need to be specified in a Cluster
+		if un.GetName() == cluster.ClusterFullName {
+			removeRequired(un, "spec.config")
+			removeRequired(un, "spec.config.tlsClientConfig")
+		}
+
 		crd := toCRD(un, un.GetName() == "applicationsets.argoproj.io")
 		crd.Labels = map[string]string{
 			"app.kubernetes.io/name":    crd.Name,
@@ -81,6 +92,22 @@ func removeValidation(un *unstructured.Unstructured, path string) {
 	unstructured.RemoveNestedField(un.Object, schemaPath...)
 }
 
+func removeRequired(un *unstructured.Unstructured, path string) {
+	versions, _, _ := unstructured.NestedSlice(un.Object, "spec", "versions")
+	for i := range versions {
+		version, ok := versions[i].(map[string]any)
+		if !ok {
+			continue
+		}
+		schemaPath := []string{"schema", "openAPIV3Schema"}
+		for _, part := range strings.Split(path, ".") {
+			schemaPath = append(schemaPath, "properties", part)
+		}
+		unstructured.RemoveNestedField(version, append(schemaPath, "required")...)
+	}
+	checkErr(unstructured.SetNestedSlice(un.Object, versions, "spec", "versions"))
+}
+
 func toCRD(un *unstructured.Unstructured, removeDesc bool) *apiextensionsv1.CustomResourceDefinition {
 	if removeDesc {
 		removeDescription(un.Object)
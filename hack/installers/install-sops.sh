@@ -0,0 +1,17 @@
+#!/bin/bash
+set -eux -o pipefail
+
+. "$(dirname "$0")"/../tool-versions.sh
+
+PROJECT_ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")"/../..; pwd)
+INSTALL_PATH="${BIN:-$INSTALL_PATH}"
+INSTALL_PATH="${INSTALL_PATH:-$PROJECT_ROOT/dist}"
+PATH="${INSTALL_PATH}:${PATH}"
+[ -d "$INSTALL_PATH" ] || mkdir -p "$INSTALL_PATH"
+
+# SOPS does not publish binaries for all the architectures of the Argo CD image, so it is built from source. The
+# sources are verified against the Go checksum database instead of a checksum file in ./hack/installers/checksums.
+# shellcheck disable=SC2154
+GOBIN=/tmp/sops CGO_ENABLED=0 go install "github.com/getsops/sops/v3/cmd/sops@v${sops_version}"
+sudo install -m 0755 /tmp/sops/sops "$INSTALL_PATH/sops"
+sops --version
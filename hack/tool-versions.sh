@@ -9,9 +9,11 @@
 # SHA256 sum of the binary.
 #
 # Use ./hack/installers/checksums/add-helm-checksums.sh and
-# add-kustomize-checksums.sh to help download checksums.
+# add-kustomize-checksums.sh to help download checksums. SOPS is built from
+# source, its version only needs to be updated here.
 ###############################################################################
 helm3_version=3.19.4
 kustomize5_version=5.8.0
 protoc_version=29.3
 oras_version=1.2.0
+sops_version=3.11.0
@@ -17,7 +17,8 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
-	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	math "math"
 	math_bits "math/bits"
 )
@@ -387,6 +388,200 @@ func (m *ApplicationSetTreeQuery) GetAppsetNamespace() string {
 	return ""
 }
 
+// ApplicationSetResumeRequest is a request to resume the rollout of an applicationset after a paused RollingSync step
+type ApplicationSetResumeRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The application set namespace. Default empty is argocd control plane namespace
+	AppsetNamespace string `protobuf:"bytes,2,opt,name=appsetNamespace,proto3" json:"appsetNamespace,omitempty"`
+	// the 1-based number of the RollingSync step to resume the rollout after
+	Step                 int64    `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetResumeRequest) Reset()         { *m = ApplicationSetResumeRequest{} }
+func (m *ApplicationSetResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetResumeRequest) ProtoMessage()    {}
+func (*ApplicationSetResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{6}
+}
+func (m *ApplicationSetResumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetResumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetResumeRequest.Merge(m, src)
+}
+func (m *ApplicationSetResumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetResumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetResumeRequest proto.InternalMessageInfo
+
+func (m *ApplicationSetResumeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetResumeRequest) GetAppsetNamespace() string {
+	if m != nil {
+		return m.AppsetNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationSetResumeRequest) GetStep() int64 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+// ApplicationSetCachedParametersResponse is a response for applicationset cached parameters request
+type ApplicationSetCachedParametersResponse struct {
+	Generators           []*ApplicationSetGeneratorCachedParameters `protobuf:"bytes,1,rep,name=generators,proto3" json:"generators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *ApplicationSetCachedParametersResponse) Reset() {
+	*m = ApplicationSetCachedParametersResponse{}
+}
+func (m *ApplicationSetCachedParametersResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetCachedParametersResponse) ProtoMessage()    {}
+func (*ApplicationSetCachedParametersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{7}
+}
+func (m *ApplicationSetCachedParametersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetCachedParametersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetCachedParametersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetCachedParametersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetCachedParametersResponse.Merge(m, src)
+}
+func (m *ApplicationSetCachedParametersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetCachedParametersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetCachedParametersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetCachedParametersResponse proto.InternalMessageInfo
+
+func (m *ApplicationSetCachedParametersResponse) GetGenerators() []*ApplicationSetGeneratorCachedParameters {
+	if m != nil {
+		return m.Generators
+	}
+	return nil
+}
+
+// ApplicationSetGeneratorCachedParameters are the parameters last successfully generated by a generator of an applicationset
+type ApplicationSetGeneratorCachedParameters struct {
+	// the 0-based index of the generator in the applicationset
+	Generator int64 `protobuf:"varint,1,opt,name=generator,proto3" json:"generator,omitempty"`
+	// the hash of the parameter sets
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// the time the parameter sets were first generated
+	GeneratedAt *v1.Time `protobuf:"bytes,3,opt,name=generatedAt,proto3" json:"generatedAt,omitempty"`
+	// the JSON encoded parameter sets
+	Parameters           string   `protobuf:"bytes,4,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetGeneratorCachedParameters) Reset() {
+	*m = ApplicationSetGeneratorCachedParameters{}
+}
+func (m *ApplicationSetGeneratorCachedParameters) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetGeneratorCachedParameters) ProtoMessage()    {}
+func (*ApplicationSetGeneratorCachedParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{8}
+}
+func (m *ApplicationSetGeneratorCachedParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetGeneratorCachedParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetGeneratorCachedParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetGeneratorCachedParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetGeneratorCachedParameters.Merge(m, src)
+}
+func (m *ApplicationSetGeneratorCachedParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetGeneratorCachedParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetGeneratorCachedParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetGeneratorCachedParameters proto.InternalMessageInfo
+
+func (m *ApplicationSetGeneratorCachedParameters) GetGenerator() int64 {
+	if m != nil {
+		return m.Generator
+	}
+	return 0
+}
+
+func (m *ApplicationSetGeneratorCachedParameters) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ApplicationSetGeneratorCachedParameters) GetGeneratedAt() *v1.Time {
+	if m != nil {
+		return m.GeneratedAt
+	}
+	return nil
+}
+
+func (m *ApplicationSetGeneratorCachedParameters) GetParameters() string {
+	if m != nil {
+		return m.Parameters
+	}
+	return ""
+}
+
 // ApplicationSetGetQuery is a query for applicationset resources
 type ApplicationSetGenerateRequest struct {
 	// the applicationsets
@@ -400,7 +595,7 @@ func (m *ApplicationSetGenerateRequest) Reset()         { *m = ApplicationSetGen
 func (m *ApplicationSetGenerateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSetGenerateRequest) ProtoMessage()    {}
 func (*ApplicationSetGenerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eacb9df0ce5738fa, []int{6}
+	return fileDescriptor_eacb9df0ce5738fa, []int{9}
 }
 func (m *ApplicationSetGenerateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +643,7 @@ func (m *ApplicationSetGenerateResponse) Reset()         { *m = ApplicationSetGe
 func (m *ApplicationSetGenerateResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSetGenerateResponse) ProtoMessage()    {}
 func (*ApplicationSetGenerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eacb9df0ce5738fa, []int{7}
+	return fileDescriptor_eacb9df0ce5738fa, []int{10}
 }
 func (m *ApplicationSetGenerateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,6 +686,9 @@ func init() {
 	proto.RegisterType((*ApplicationSetCreateRequest)(nil), "applicationset.ApplicationSetCreateRequest")
 	proto.RegisterType((*ApplicationSetDeleteRequest)(nil), "applicationset.ApplicationSetDeleteRequest")
 	proto.RegisterType((*ApplicationSetTreeQuery)(nil), "applicationset.ApplicationSetTreeQuery")
+	proto.RegisterType((*ApplicationSetResumeRequest)(nil), "applicationset.ApplicationSetResumeRequest")
+	proto.RegisterType((*ApplicationSetCachedParametersResponse)(nil), "applicationset.ApplicationSetCachedParametersResponse")
+	proto.RegisterType((*ApplicationSetGeneratorCachedParameters)(nil), "applicationset.ApplicationSetGeneratorCachedParameters")
 	proto.RegisterType((*ApplicationSetGenerateRequest)(nil), "applicationset.ApplicationSetGenerateRequest")
 	proto.RegisterType((*ApplicationSetGenerateResponse)(nil), "applicationset.ApplicationSetGenerateResponse")
 }
@@ -500,52 +698,65 @@ func init() {
 }
 
 var fileDescriptor_eacb9df0ce5738fa = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x99, 0xb6, 0xc4, 0x74, 0x5a, 0x14, 0x06, 0x6c, 0x63, 0xb4, 0x31, 0x2c, 0xf4, 0x87,
-	0xad, 0x99, 0x25, 0xad, 0x07, 0xa9, 0x27, 0x7f, 0x51, 0x0a, 0x45, 0x74, 0x23, 0x0a, 0x7a, 0x90,
-	0xed, 0xe6, 0xb1, 0x5d, 0x9b, 0xec, 0x8c, 0x33, 0x93, 0x85, 0x52, 0xbc, 0x08, 0x1e, 0xf4, 0xe2,
-	0x41, 0xf4, 0x0f, 0xd0, 0x8b, 0x7f, 0x80, 0x77, 0x0f, 0x5e, 0x3c, 0x0a, 0xfe, 0x03, 0x52, 0xfc,
-	0x43, 0x64, 0x66, 0x37, 0x69, 0x77, 0x4c, 0x9a, 0x82, 0xd1, 0xdb, 0xbe, 0x9d, 0xd9, 0xf7, 0x3e,
-	0xf3, 0xde, 0x77, 0xbf, 0x0c, 0x5e, 0x96, 0x20, 0x12, 0x10, 0xae, 0xcf, 0x79, 0x2b, 0x0a, 0x7c,
-	0x15, 0xb1, 0x58, 0x82, 0xb2, 0x42, 0xca, 0x05, 0x53, 0x8c, 0x9c, 0xce, 0xbf, 0x2d, 0x5f, 0x08,
-	0x19, 0x0b, 0x5b, 0xe0, 0xfa, 0x3c, 0x72, 0xfd, 0x38, 0x66, 0x2a, 0x5d, 0x49, 0x77, 0x97, 0x9d,
-	0xdd, 0xab, 0x92, 0x46, 0xcc, 0xac, 0x06, 0x4c, 0x80, 0x9b, 0xd4, 0xdd, 0x10, 0x62, 0x10, 0xbe,
-	0x82, 0x66, 0xb6, 0x67, 0x2b, 0x8c, 0xd4, 0x4e, 0x67, 0x9b, 0x06, 0xac, 0xed, 0xfa, 0x22, 0x64,
-	0x5c, 0xb0, 0xa7, 0xe6, 0xa1, 0x16, 0x34, 0xdd, 0x64, 0xcd, 0xe5, 0xbb, 0xa1, 0xfe, 0x5e, 0x1e,
-	0xe5, 0x71, 0x93, 0xba, 0xdf, 0xe2, 0x3b, 0xfe, 0x1f, 0xd9, 0x9c, 0x07, 0x78, 0xe6, 0xfa, 0xe1,
-	0xbe, 0x06, 0xa8, 0x0d, 0x50, 0xf7, 0x3a, 0x20, 0xf6, 0x08, 0xc1, 0x13, 0xb1, 0xdf, 0x86, 0x12,
-	0xaa, 0xa2, 0xa5, 0x49, 0xcf, 0x3c, 0x93, 0x25, 0x7c, 0xc6, 0xe7, 0x5c, 0x82, 0xba, 0xe3, 0xb7,
-	0x41, 0x72, 0x3f, 0x80, 0xd2, 0x98, 0x59, 0xb6, 0x5f, 0x3b, 0xfb, 0x78, 0x36, 0x9f, 0x77, 0x2b,
-	0x92, 0x59, 0xe2, 0x32, 0x2e, 0x6a, 0x66, 0x08, 0x94, 0x2c, 0xa1, 0xea, 0xf8, 0xd2, 0xa4, 0xd7,
-	0x8b, 0xf5, 0x9a, 0x84, 0x16, 0x04, 0x8a, 0x89, 0x2c, 0x73, 0x2f, 0xee, 0x57, 0x7c, 0xbc, 0x7f,
-	0xf1, 0x4f, 0xc8, 0x3e, 0x95, 0x07, 0x92, 0xeb, 0x01, 0x90, 0x12, 0x3e, 0x95, 0x15, 0xcb, 0x0e,
-	0xd6, 0x0d, 0x89, 0xc2, 0xd6, 0xac, 0x0c, 0xc0, 0xd4, 0xea, 0x16, 0x3d, 0x6c, 0x38, 0xed, 0x36,
-	0xdc, 0x3c, 0x3c, 0x09, 0x9a, 0x34, 0x59, 0xa3, 0x7c, 0x37, 0xa4, 0xba, 0xe1, 0xf4, 0xc8, 0xe7,
-	0xb4, 0xdb, 0x70, 0x6a, 0x71, 0x58, 0x35, 0x9c, 0xaf, 0x08, 0x9f, 0xcf, 0x6f, 0xb9, 0x29, 0xc0,
-	0x57, 0xe0, 0xc1, 0xb3, 0x0e, 0xc8, 0x7e, 0x54, 0xe8, 0xdf, 0x53, 0x91, 0x19, 0x5c, 0xe8, 0x70,
-	0x09, 0x22, 0xed, 0x41, 0xd1, 0xcb, 0x22, 0xfd, 0xbe, 0x29, 0xf6, 0xbc, 0x4e, 0x6c, 0x3a, 0x5f,
-	0xf4, 0xb2, 0xc8, 0x79, 0x6c, 0x1f, 0xe2, 0x16, 0xb4, 0xe0, 0xf0, 0x10, 0x7f, 0x27, 0xa5, 0x87,
-	0xb6, 0x94, 0xee, 0x0b, 0x80, 0x51, 0x68, 0xf4, 0x1d, 0xc2, 0x73, 0xb6, 0xf8, 0xd3, 0xbf, 0xa3,
-	0x7f, 0xf7, 0x1b, 0xff, 0xa1, 0xfb, 0x0d, 0x50, 0xce, 0x1b, 0x84, 0x2b, 0x83, 0xb8, 0x32, 0x19,
-	0xb7, 0xf1, 0xf4, 0xd1, 0x91, 0x99, 0xff, 0x68, 0x6a, 0x75, 0x73, 0x64, 0x58, 0x5e, 0x2e, 0xfd,
-	0xea, 0xab, 0x49, 0x7c, 0x36, 0x4f, 0xd4, 0x00, 0x91, 0x44, 0x01, 0x90, 0x8f, 0x08, 0x8f, 0x6f,
-	0x80, 0x22, 0x0b, 0xd4, 0xb2, 0xbf, 0xfe, 0xae, 0x52, 0x1e, 0x69, 0xe7, 0x9c, 0x85, 0x17, 0x3f,
-	0x7e, 0xbd, 0x1d, 0xab, 0x92, 0x8a, 0x71, 0xcc, 0xa4, 0x6e, 0x79, 0xb0, 0x74, 0xf7, 0xb5, 0x24,
-	0x9e, 0x93, 0xf7, 0x08, 0x17, 0xbb, 0x3d, 0x24, 0xb5, 0x61, 0xa8, 0x39, 0x0d, 0x94, 0xe9, 0x49,
-	0xb7, 0xa7, 0xa3, 0x71, 0x56, 0x0c, 0xd3, 0xbc, 0x53, 0x1d, 0xc4, 0xd4, 0xb5, 0xe0, 0x75, 0xb4,
-	0x4c, 0x3e, 0x20, 0x3c, 0xa1, 0x9d, 0x91, 0x2c, 0x1e, 0x5f, 0xa5, 0xe7, 0x9e, 0xe5, 0xbb, 0xa3,
-	0x6c, 0xa0, 0x4e, 0xeb, 0x5c, 0x34, 0xc0, 0xe7, 0xc8, 0xec, 0x00, 0x60, 0xf2, 0x19, 0xe1, 0x42,
-	0xea, 0x4a, 0x64, 0xe5, 0x78, 0xcc, 0x9c, 0x77, 0x8d, 0x78, 0xd6, 0xae, 0xc1, 0xbc, 0xe4, 0x0c,
-	0xc2, 0x5c, 0xb7, 0x4d, 0xec, 0x25, 0xc2, 0x85, 0xd4, 0x87, 0x86, 0x61, 0xe7, 0xdc, 0xaa, 0x3c,
-	0x44, 0xca, 0xbd, 0x41, 0x67, 0xe2, 0x5b, 0x1e, 0x26, 0xbe, 0x2f, 0x08, 0x4f, 0x7b, 0x20, 0x59,
-	0x47, 0x04, 0xa0, 0xad, 0x6b, 0xd8, 0xac, 0x7b, 0xf6, 0x36, 0xda, 0x59, 0xeb, 0xb4, 0xce, 0x15,
-	0xc3, 0x4c, 0xc9, 0xe5, 0xe3, 0x99, 0x5d, 0x91, 0xf1, 0xd6, 0x94, 0x06, 0x7e, 0x8d, 0x30, 0xd1,
-	0x52, 0xe9, 0x9e, 0xe2, 0x76, 0x02, 0xb1, 0x92, 0x27, 0xfe, 0xe7, 0xe7, 0x68, 0x7a, 0xad, 0xd1,
-	0xa8, 0x54, 0x5f, 0x6b, 0x68, 0x52, 0xa7, 0x26, 0x87, 0xd1, 0x5f, 0xcd, 0x30, 0x2d, 0x92, 0xf9,
-	0x21, 0x4c, 0x60, 0xaa, 0xde, 0xd8, 0xfc, 0x76, 0x50, 0x41, 0xdf, 0x0f, 0x2a, 0xe8, 0xe7, 0x41,
-	0x05, 0x3d, 0xba, 0x76, 0xb2, 0xdb, 0x50, 0xd0, 0x8a, 0x20, 0xb6, 0xaf, 0x68, 0xdb, 0x05, 0x73,
-	0x07, 0x5a, 0xfb, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xae, 0xa7, 0x68, 0xe3, 0xd1, 0x09, 0x00, 0x00,
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xcd, 0x8a, 0x23, 0x45,
+	0x1c, 0xc0, 0xa9, 0xcd, 0x10, 0x67, 0x2a, 0x8b, 0x42, 0x89, 0xbb, 0x31, 0xee, 0xc6, 0xa1, 0x60,
+	0x3e, 0xcc, 0x9a, 0xea, 0x9d, 0xcc, 0xa2, 0xcb, 0x7a, 0x5a, 0x3f, 0x18, 0x16, 0x06, 0x59, 0x3b,
+	0x8b, 0x0b, 0x7a, 0x90, 0xda, 0xce, 0x9f, 0xa4, 0x9d, 0x74, 0x57, 0x5b, 0x55, 0x69, 0x18, 0x06,
+	0x0f, 0x0a, 0x5e, 0xbc, 0x78, 0x10, 0x7d, 0x00, 0xbd, 0x78, 0xf3, 0xa2, 0x67, 0x0f, 0x5e, 0xf4,
+	0x26, 0xf8, 0x02, 0x32, 0xf8, 0x06, 0xbe, 0x80, 0x54, 0x75, 0xa7, 0xd3, 0xdd, 0x93, 0x4c, 0x07,
+	0x6c, 0xf7, 0x56, 0x9f, 0xff, 0xfa, 0xfd, 0xbf, 0xbb, 0x71, 0x4f, 0x81, 0x8c, 0x41, 0x3a, 0x3c,
+	0x8a, 0xa6, 0xbe, 0xc7, 0xb5, 0x2f, 0x42, 0x05, 0xba, 0x34, 0x65, 0x91, 0x14, 0x5a, 0x90, 0x67,
+	0x8b, 0xab, 0x9d, 0x1b, 0x63, 0x21, 0xc6, 0x53, 0x70, 0x78, 0xe4, 0x3b, 0x3c, 0x0c, 0x85, 0x4e,
+	0x76, 0x92, 0xd3, 0x1d, 0x7a, 0x72, 0x57, 0x31, 0x5f, 0xd8, 0x5d, 0x4f, 0x48, 0x70, 0xe2, 0x03,
+	0x67, 0x0c, 0x21, 0x48, 0xae, 0x61, 0x94, 0x9e, 0xb9, 0xb3, 0x38, 0x13, 0x70, 0x6f, 0xe2, 0x87,
+	0x20, 0x4f, 0x9d, 0xe8, 0x64, 0x6c, 0x16, 0x94, 0x13, 0x80, 0xe6, 0xcb, 0x6e, 0x1d, 0x8f, 0x7d,
+	0x3d, 0x99, 0x3d, 0x61, 0x9e, 0x08, 0x1c, 0x2e, 0xc7, 0x22, 0x92, 0xe2, 0x63, 0x3b, 0xe8, 0x7b,
+	0x23, 0x27, 0x3e, 0x5c, 0x08, 0xc8, 0xf1, 0x3a, 0xf1, 0x01, 0x9f, 0x46, 0x13, 0x7e, 0x41, 0x1a,
+	0x7d, 0x1f, 0x5f, 0xbb, 0xbf, 0x38, 0x37, 0x04, 0x7d, 0x04, 0xfa, 0xbd, 0x19, 0xc8, 0x53, 0x42,
+	0xf0, 0x46, 0xc8, 0x03, 0x68, 0xa3, 0x6d, 0xb4, 0xbf, 0xe5, 0xda, 0x31, 0xd9, 0xc7, 0xcf, 0xf1,
+	0x28, 0x52, 0xa0, 0xdf, 0xe5, 0x01, 0xa8, 0x88, 0x7b, 0xd0, 0xbe, 0x62, 0xb7, 0xcb, 0xcb, 0xf4,
+	0x0c, 0x5f, 0x2f, 0xca, 0x3d, 0xf6, 0x55, 0x2a, 0xb8, 0x83, 0x37, 0x0d, 0x33, 0x78, 0x5a, 0xb5,
+	0xd1, 0x76, 0x63, 0x7f, 0xcb, 0xcd, 0xe6, 0x66, 0x4f, 0xc1, 0x14, 0x3c, 0x2d, 0x64, 0x2a, 0x39,
+	0x9b, 0x2f, 0x7b, 0xbc, 0xb1, 0xfc, 0xf1, 0x1f, 0x50, 0x59, 0x2b, 0x17, 0x54, 0x64, 0xdc, 0x46,
+	0xda, 0xf8, 0x99, 0xf4, 0xb1, 0x54, 0xb1, 0xf9, 0x94, 0x68, 0x5c, 0xf2, 0xb0, 0x05, 0x68, 0x0d,
+	0x8e, 0xd9, 0xc2, 0xe0, 0x6c, 0x6e, 0x70, 0x3b, 0xf8, 0xc8, 0x1b, 0xb1, 0xf8, 0x90, 0x45, 0x27,
+	0x63, 0x66, 0x0c, 0xce, 0x72, 0xd7, 0xd9, 0xdc, 0xe0, 0xac, 0xc4, 0x51, 0x7a, 0x83, 0xfe, 0x8a,
+	0xf0, 0x4b, 0xc5, 0x23, 0x6f, 0x49, 0xe0, 0x1a, 0x5c, 0xf8, 0x64, 0x06, 0x6a, 0x19, 0x15, 0xfa,
+	0xff, 0xa9, 0xc8, 0x35, 0xdc, 0x9c, 0x45, 0x0a, 0x64, 0x62, 0x83, 0x4d, 0x37, 0x9d, 0x99, 0xf5,
+	0x91, 0x3c, 0x75, 0x67, 0xa1, 0xb5, 0xfc, 0xa6, 0x9b, 0xce, 0xe8, 0x87, 0x65, 0x25, 0xde, 0x86,
+	0x29, 0x2c, 0x94, 0xf8, 0x6f, 0xa1, 0xf4, 0xb8, 0x1c, 0x4a, 0x8f, 0x24, 0x40, 0x1d, 0x31, 0x2a,
+	0xca, 0xd4, 0x2e, 0xa8, 0x59, 0x50, 0x0f, 0xb5, 0xb9, 0xad, 0x34, 0x44, 0xd6, 0x50, 0x0d, 0xd7,
+	0x8e, 0xe9, 0x67, 0x08, 0xef, 0x96, 0x9c, 0xcd, 0xbd, 0x09, 0x8c, 0x1e, 0x72, 0xc9, 0x03, 0xd0,
+	0x20, 0x55, 0x16, 0xa7, 0x8f, 0x31, 0x4e, 0x53, 0x55, 0xc8, 0x24, 0x4d, 0x5a, 0x83, 0xd7, 0x59,
+	0xa9, 0x30, 0x95, 0x33, 0x37, 0x3d, 0x7f, 0x41, 0x68, 0x4e, 0x14, 0xfd, 0x1d, 0xe1, 0xbd, 0x35,
+	0xef, 0x91, 0x1b, 0x78, 0x2b, 0xbb, 0x69, 0xcd, 0xd0, 0x70, 0x17, 0x0b, 0x46, 0xc3, 0x09, 0x57,
+	0x93, 0xd4, 0x00, 0x76, 0x4c, 0x8e, 0x71, 0x2b, 0xab, 0x30, 0xf7, 0xb5, 0x55, 0xbe, 0x35, 0xe8,
+	0xb1, 0xa4, 0xd0, 0xb1, 0x7c, 0xa1, 0x5b, 0x04, 0xa8, 0x29, 0x74, 0x2c, 0x3e, 0x60, 0x8f, 0xfc,
+	0x00, 0xdc, 0xfc, 0x75, 0xd2, 0xc5, 0x38, 0xca, 0x68, 0xda, 0x1b, 0xf6, 0x9d, 0xdc, 0x0a, 0xfd,
+	0x06, 0xe1, 0x9b, 0x4b, 0x75, 0x59, 0x91, 0x3e, 0xc3, 0xa7, 0x90, 0x3e, 0x43, 0xd0, 0xf4, 0x2b,
+	0x84, 0xbb, 0xab, 0xb8, 0x52, 0xff, 0x06, 0xf8, 0x6a, 0xde, 0x99, 0xa9, 0x87, 0x1f, 0xd4, 0x86,
+	0xe5, 0x16, 0xc4, 0x0f, 0xfe, 0x69, 0xe1, 0x17, 0x8a, 0x44, 0x43, 0x90, 0xb1, 0xef, 0x01, 0xf9,
+	0x1e, 0xe1, 0xc6, 0x11, 0x68, 0xb2, 0x5b, 0x15, 0x5c, 0x49, 0xf5, 0xee, 0xd4, 0x6a, 0x39, 0xba,
+	0xfb, 0xf9, 0x9f, 0x7f, 0x7f, 0x7d, 0x65, 0x9b, 0x74, 0x6d, 0xa3, 0x8c, 0x0f, 0x4a, 0xad, 0x57,
+	0x39, 0x67, 0x26, 0xed, 0x3e, 0x25, 0xdf, 0x22, 0xbc, 0x39, 0xb7, 0x21, 0xe9, 0xaf, 0x95, 0x07,
+	0xf3, 0x18, 0xe8, 0xb0, 0x75, 0x8f, 0x27, 0xae, 0xa1, 0xb7, 0x2c, 0xd3, 0x0e, 0xdd, 0x5e, 0xc5,
+	0x34, 0x0f, 0xd1, 0x7b, 0xa8, 0x47, 0xbe, 0x43, 0x78, 0xc3, 0xb4, 0x36, 0xb2, 0x77, 0xf9, 0x2b,
+	0x59, 0xfb, 0xeb, 0x3c, 0xac, 0xd3, 0x80, 0x46, 0x2c, 0x7d, 0xd9, 0x02, 0xbf, 0x48, 0xae, 0xaf,
+	0x00, 0x26, 0x3f, 0x21, 0xdc, 0x4c, 0xda, 0x0a, 0xb9, 0x75, 0x39, 0x66, 0xa1, 0xf9, 0xd4, 0xec,
+	0x6b, 0xc7, 0x62, 0xbe, 0x42, 0x57, 0x61, 0xde, 0x2b, 0x77, 0xa1, 0x2f, 0x10, 0x6e, 0x26, 0x8d,
+	0xa4, 0x0a, 0xbb, 0xd0, 0x6e, 0x3a, 0x15, 0xa1, 0x9c, 0x39, 0x3a, 0x0d, 0xbe, 0x5e, 0x55, 0xf0,
+	0xfd, 0x82, 0xf0, 0x55, 0x17, 0x94, 0x98, 0x49, 0x0f, 0x4c, 0xef, 0xa9, 0xf2, 0x75, 0xd6, 0x9f,
+	0xea, 0xf5, 0xb5, 0x11, 0x4b, 0xef, 0x58, 0x66, 0x46, 0x5e, 0xbd, 0x9c, 0xd9, 0x91, 0x29, 0x6f,
+	0x5f, 0x1b, 0xe0, 0x2f, 0x11, 0x26, 0x26, 0x54, 0xe6, 0x5a, 0xbc, 0x13, 0x43, 0xa8, 0xd5, 0xda,
+	0x39, 0x7f, 0x33, 0x57, 0xc0, 0x99, 0x27, 0x24, 0x98, 0x72, 0x6d, 0x65, 0xd8, 0xf8, 0xeb, 0x5b,
+	0xa6, 0x3d, 0xb2, 0x53, 0xc1, 0x04, 0xc9, 0xab, 0x3f, 0x22, 0xfc, 0xfc, 0xd1, 0xc5, 0xd6, 0xb7,
+	0x36, 0xcd, 0x6b, 0x15, 0x21, 0xbc, 0xa2, 0xa5, 0xd2, 0xbb, 0x16, 0x73, 0x40, 0x6e, 0x57, 0x60,
+	0x7a, 0x56, 0x40, 0x7f, 0xd1, 0x67, 0xc8, 0xcf, 0x08, 0x37, 0x93, 0x6f, 0x83, 0xaa, 0x40, 0x2c,
+	0x7c, 0x41, 0xd4, 0x9c, 0x3f, 0xb7, 0x2d, 0x7f, 0x8f, 0xee, 0x54, 0xbb, 0x7e, 0x16, 0x98, 0xe2,
+	0xf4, 0xe6, 0x83, 0xdf, 0xce, 0xbb, 0xe8, 0x8f, 0xf3, 0x2e, 0xfa, 0xeb, 0xbc, 0x8b, 0x3e, 0x78,
+	0x63, 0xbd, 0x1f, 0x07, 0x6f, 0xea, 0x43, 0x58, 0xfe, 0x07, 0x7a, 0xd2, 0xb4, 0xbf, 0x0b, 0x87,
+	0xff, 0x0e, 0x00, 0x15, 0xff, 0x44, 0xf7, 0x32, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -573,7 +784,11 @@ type ApplicationSetServiceClient interface {
 	// ResourceTree returns resource tree
 	ResourceTree(ctx context.Context, in *ApplicationSetTreeQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetTree, error)
 	// ListResourceEvents returns a list of event resources
-	ListResourceEvents(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*v1.EventList, error)
+	ListResourceEvents(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*v11.EventList, error)
+	// GetCachedParameters returns the parameters last successfully generated by the generators of an applicationset, which are used while a generator fails
+	GetCachedParameters(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*ApplicationSetCachedParametersResponse, error)
+	// Resume resumes the rollout of an applicationset after a RollingSync step which pauses after its applications are healthy
+	Resume(ctx context.Context, in *ApplicationSetResumeRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 }
 
 type applicationSetServiceClient struct {
@@ -638,8 +853,8 @@ func (c *applicationSetServiceClient) ResourceTree(ctx context.Context, in *Appl
 	return out, nil
 }
 
-func (c *applicationSetServiceClient) ListResourceEvents(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*v1.EventList, error) {
-	out := new(v1.EventList)
+func (c *applicationSetServiceClient) ListResourceEvents(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*v11.EventList, error) {
+	out := new(v11.EventList)
 	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/ListResourceEvents", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -647,6 +862,24 @@ func (c *applicationSetServiceClient) ListResourceEvents(ctx context.Context, in
 	return out, nil
 }
 
+func (c *applicationSetServiceClient) GetCachedParameters(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*ApplicationSetCachedParametersResponse, error) {
+	out := new(ApplicationSetCachedParametersResponse)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/GetCachedParameters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationSetServiceClient) Resume(ctx context.Context, in *ApplicationSetResumeRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationSetServiceServer is the server API for ApplicationSetService service.
 type ApplicationSetServiceServer interface {
 	// Get returns an applicationset by name
@@ -662,7 +895,11 @@ type ApplicationSetServiceServer interface {
 	// ResourceTree returns resource tree
 	ResourceTree(context.Context, *ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error)
 	// ListResourceEvents returns a list of event resources
-	ListResourceEvents(context.Context, *ApplicationSetGetQuery) (*v1.EventList, error)
+	ListResourceEvents(context.Context, *ApplicationSetGetQuery) (*v11.EventList, error)
+	// GetCachedParameters returns the parameters last successfully generated by the generators of an applicationset, which are used while a generator fails
+	GetCachedParameters(context.Context, *ApplicationSetGetQuery) (*ApplicationSetCachedParametersResponse, error)
+	// Resume resumes the rollout of an applicationset after a RollingSync step which pauses after its applications are healthy
+	Resume(context.Context, *ApplicationSetResumeRequest) (*v1alpha1.ApplicationSet, error)
 }
 
 // UnimplementedApplicationSetServiceServer can be embedded to have forward compatible implementations.
@@ -687,9 +924,15 @@ func (*UnimplementedApplicationSetServiceServer) Delete(ctx context.Context, req
 func (*UnimplementedApplicationSetServiceServer) ResourceTree(ctx context.Context, req *ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
 }
-func (*UnimplementedApplicationSetServiceServer) ListResourceEvents(ctx context.Context, req *ApplicationSetGetQuery) (*v1.EventList, error) {
+func (*UnimplementedApplicationSetServiceServer) ListResourceEvents(ctx context.Context, req *ApplicationSetGetQuery) (*v11.EventList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListResourceEvents not implemented")
 }
+func (*UnimplementedApplicationSetServiceServer) GetCachedParameters(ctx context.Context, req *ApplicationSetGetQuery) (*ApplicationSetCachedParametersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCachedParameters not implemented")
+}
+func (*UnimplementedApplicationSetServiceServer) Resume(ctx context.Context, req *ApplicationSetResumeRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
 
 func RegisterApplicationSetServiceServer(s *grpc.Server, srv ApplicationSetServiceServer) {
 	s.RegisterService(&_ApplicationSetService_serviceDesc, srv)
@@ -821,6 +1064,42 @@ func _ApplicationSetService_ListResourceEvents_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationSetService_GetCachedParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetGetQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).GetCachedParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/GetCachedParameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).GetCachedParameters(ctx, req.(*ApplicationSetGetQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationSetService_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).Resume(ctx, req.(*ApplicationSetResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "applicationset.ApplicationSetService",
 	HandlerType: (*ApplicationSetServiceServer)(nil),
@@ -853,6 +1132,14 @@ var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListResourceEvents",
 			Handler:    _ApplicationSetService_ListResourceEvents_Handler,
 		},
+		{
+			MethodName: "GetCachedParameters",
+			Handler:    _ApplicationSetService_GetCachedParameters_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _ApplicationSetService_Resume_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/applicationset/applicationset.proto",
@@ -1136,7 +1423,7 @@ func (m *ApplicationSetTreeQuery) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSetGenerateRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationSetResumeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1146,12 +1433,12 @@ func (m *ApplicationSetGenerateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationSetGenerateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationSetResumeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSetGenerateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationSetResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1160,22 +1447,29 @@ func (m *ApplicationSetGenerateRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ApplicationSet != nil {
-		{
-			size, err := m.ApplicationSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationset(dAtA, i, uint64(size))
-		}
+	if m.Step != 0 {
+		i = encodeVarintApplicationset(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AppsetNamespace) > 0 {
+		i -= len(m.AppsetNamespace)
+		copy(dAtA[i:], m.AppsetNamespace)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.AppsetNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSetGenerateResponse) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationSetCachedParametersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1185,12 +1479,12 @@ func (m *ApplicationSetGenerateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationSetGenerateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationSetCachedParametersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationSetCachedParametersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1199,10 +1493,10 @@ func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Applications) > 0 {
-		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Generators) > 0 {
+		for iNdEx := len(m.Generators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Applications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Generators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1216,52 +1510,190 @@ func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationset(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ApplicationSetGeneratorCachedParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ApplicationSetGetQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ApplicationSetGeneratorCachedParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetGeneratorCachedParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
-	}
-	l = len(m.AppsetNamespace)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *ApplicationSetListQuery) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Parameters) > 0 {
+		i -= len(m.Parameters)
+		copy(dAtA[i:], m.Parameters)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Parameters)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if len(m.Projects) > 0 {
-		for _, s := range m.Projects {
-			l = len(s)
-			n += 1 + l + sovApplicationset(uint64(l))
+	if m.GeneratedAt != nil {
+		{
+			size, err := m.GeneratedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationset(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Selector)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Generator != 0 {
+		i = encodeVarintApplicationset(dAtA, i, uint64(m.Generator))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetGenerateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetGenerateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetGenerateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ApplicationSet != nil {
+		{
+			size, err := m.ApplicationSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationset(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetGenerateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetGenerateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Applications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ApplicationSetGetQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetListQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
 	}
 	l = len(m.AppsetNamespace)
 	if l > 0 {
@@ -1355,6 +1787,74 @@ func (m *ApplicationSetTreeQuery) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetResumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.Step != 0 {
+		n += 1 + sovApplicationset(uint64(m.Step))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetCachedParametersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Generators) > 0 {
+		for _, e := range m.Generators {
+			l = e.Size()
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetGeneratorCachedParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Generator != 0 {
+		n += 1 + sovApplicationset(uint64(m.Generator))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.GeneratedAt != nil {
+		l = m.GeneratedAt.Size()
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.Parameters)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ApplicationSetGenerateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2133,6 +2633,395 @@ func (m *ApplicationSetTreeQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSetResumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetResumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetCachedParametersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetCachedParametersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetCachedParametersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generators = append(m.Generators, &ApplicationSetGeneratorCachedParameters{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetGeneratorCachedParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetGeneratorCachedParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetGeneratorCachedParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generator", wireType)
+			}
+			m.Generator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generator |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneratedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GeneratedAt == nil {
+				m.GeneratedAt = &v1.Time{}
+			}
+			if err := m.GeneratedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationSetGenerateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -311,56 +311,153 @@ func (m *ClusterUpdateRequest) GetId() *ClusterID {
 	return nil
 }
 
+// ClusterMetadataUpdateRequest is a request to merge labels and annotations into the metadata of a cluster
+type ClusterMetadataUpdateRequest struct {
+	Id *ClusterID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// labels are added to the labels of the cluster, overwriting existing labels with the same key
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// annotations are added to the annotations of the cluster, overwriting existing annotations with the same key
+	Annotations map[string]string `protobuf:"bytes,3,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// removeLabels holds the keys of the labels to remove from the cluster
+	RemoveLabels []string `protobuf:"bytes,4,rep,name=removeLabels,proto3" json:"removeLabels,omitempty"`
+	// removeAnnotations holds the keys of the annotations to remove from the cluster
+	RemoveAnnotations    []string `protobuf:"bytes,5,rep,name=removeAnnotations,proto3" json:"removeAnnotations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterMetadataUpdateRequest) Reset()         { *m = ClusterMetadataUpdateRequest{} }
+func (m *ClusterMetadataUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*ClusterMetadataUpdateRequest) ProtoMessage()    {}
+func (*ClusterMetadataUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{5}
+}
+func (m *ClusterMetadataUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterMetadataUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterMetadataUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterMetadataUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterMetadataUpdateRequest.Merge(m, src)
+}
+func (m *ClusterMetadataUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterMetadataUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterMetadataUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterMetadataUpdateRequest proto.InternalMessageInfo
+
+func (m *ClusterMetadataUpdateRequest) GetId() *ClusterID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ClusterMetadataUpdateRequest) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ClusterMetadataUpdateRequest) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *ClusterMetadataUpdateRequest) GetRemoveLabels() []string {
+	if m != nil {
+		return m.RemoveLabels
+	}
+	return nil
+}
+
+func (m *ClusterMetadataUpdateRequest) GetRemoveAnnotations() []string {
+	if m != nil {
+		return m.RemoveAnnotations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterID)(nil), "cluster.ClusterID")
 	proto.RegisterType((*ClusterQuery)(nil), "cluster.ClusterQuery")
 	proto.RegisterType((*ClusterResponse)(nil), "cluster.ClusterResponse")
 	proto.RegisterType((*ClusterCreateRequest)(nil), "cluster.ClusterCreateRequest")
 	proto.RegisterType((*ClusterUpdateRequest)(nil), "cluster.ClusterUpdateRequest")
+	proto.RegisterType((*ClusterMetadataUpdateRequest)(nil), "cluster.ClusterMetadataUpdateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "cluster.ClusterMetadataUpdateRequest.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "cluster.ClusterMetadataUpdateRequest.LabelsEntry")
 }
 
 func init() { proto.RegisterFile("server/cluster/cluster.proto", fileDescriptor_a6b5ba0b5aa57b32) }
 
 var fileDescriptor_a6b5ba0b5aa57b32 = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe5, 0x6e, 0x74, 0xcc, 0x03, 0x06, 0xd6, 0x40, 0x51, 0xf7, 0x43, 0x25, 0x20, 0x28,
-	0x68, 0xb5, 0xd5, 0x76, 0x5c, 0xb8, 0xb1, 0x0e, 0x50, 0xa5, 0x5d, 0x08, 0xe2, 0xc2, 0x61, 0x93,
-	0x97, 0x3c, 0xa5, 0x66, 0x59, 0x62, 0x62, 0x27, 0xd2, 0x84, 0xb8, 0xec, 0xc4, 0x0d, 0x21, 0xae,
-	0x5c, 0xf9, 0x43, 0xb8, 0x21, 0x71, 0x41, 0xe2, 0x1f, 0x40, 0x15, 0x7f, 0x08, 0x8a, 0x93, 0xb4,
-	0xb4, 0xd3, 0xaa, 0x21, 0x15, 0x4e, 0xf5, 0x7b, 0xea, 0xf3, 0xf7, 0xf3, 0xbe, 0x7e, 0x8e, 0xf1,
-	0x9a, 0x82, 0x38, 0x85, 0x98, 0xb9, 0x41, 0xa2, 0xf4, 0xe8, 0x97, 0xca, 0x38, 0xd2, 0x11, 0x59,
-	0x28, 0xc2, 0xda, 0x9a, 0x1f, 0x45, 0x7e, 0x00, 0x8c, 0x4b, 0xc1, 0x78, 0x18, 0x46, 0x9a, 0x6b,
-	0x11, 0x85, 0x2a, 0xff, 0x5b, 0x6d, 0xd7, 0x17, 0xba, 0x9f, 0x1c, 0x50, 0x37, 0x3a, 0x62, 0x3c,
-	0xf6, 0x23, 0x19, 0x47, 0xaf, 0xcc, 0xa2, 0xe9, 0x7a, 0x2c, 0xed, 0x30, 0x79, 0xe8, 0x67, 0x95,
-	0x8a, 0x71, 0x29, 0x03, 0xe1, 0x9a, 0x5a, 0x96, 0xb6, 0x78, 0x20, 0xfb, 0xbc, 0xc5, 0x7c, 0x08,
-	0x21, 0xe6, 0x1a, 0xbc, 0x7c, 0x37, 0xfb, 0x01, 0x5e, 0xec, 0xe6, 0xb2, 0xbd, 0x1d, 0x42, 0xf0,
-	0xbc, 0x3e, 0x96, 0x60, 0xa1, 0x3a, 0x6a, 0x2c, 0x3a, 0x66, 0x4d, 0x56, 0xf0, 0x85, 0x94, 0x07,
-	0x09, 0x58, 0x15, 0x93, 0xcc, 0x03, 0x7b, 0x0f, 0x5f, 0x2a, 0xca, 0x9e, 0x25, 0x10, 0x1f, 0x93,
-	0x1b, 0xb8, 0x9a, 0xf7, 0x56, 0xd4, 0x16, 0x51, 0xb6, 0x63, 0xc8, 0x8f, 0xca, 0x62, 0xb3, 0x26,
-	0x36, 0xae, 0x08, 0xcf, 0x9a, 0xab, 0xa3, 0xc6, 0x52, 0x9b, 0xd0, 0xd2, 0x83, 0x21, 0x85, 0x53,
-	0x11, 0x9e, 0x7d, 0x0d, 0x2f, 0x17, 0x09, 0x07, 0x94, 0x8c, 0x42, 0x05, 0xf6, 0x7b, 0x84, 0x57,
-	0x8a, 0x5c, 0x37, 0x06, 0xae, 0xc1, 0x81, 0xd7, 0x09, 0x28, 0x4d, 0xf6, 0x71, 0xe9, 0x9c, 0x11,
-	0x5f, 0x6a, 0x3f, 0xa6, 0x23, 0x8b, 0x68, 0x69, 0x91, 0x59, 0xec, 0xbb, 0x1e, 0x4d, 0x3b, 0x54,
-	0x1e, 0xfa, 0x34, 0xb3, 0x88, 0xfe, 0x61, 0x11, 0x2d, 0x2d, 0x2a, 0x49, 0x9c, 0x72, 0xd7, 0xac,
-	0xb9, 0x44, 0x2a, 0x88, 0xb5, 0x69, 0xe3, 0xa2, 0x53, 0x44, 0xf6, 0x97, 0x11, 0xd1, 0x0b, 0xe9,
-	0xfd, 0x4f, 0xa2, 0xdb, 0xf8, 0x72, 0x62, 0x14, 0xbd, 0x27, 0x02, 0x02, 0x4f, 0x59, 0x95, 0xfa,
-	0x5c, 0x63, 0xd1, 0x19, 0x4f, 0x9e, 0xc7, 0xe8, 0xf6, 0xb7, 0x05, 0x7c, 0xa5, 0xc8, 0x3c, 0x87,
-	0x38, 0x15, 0x2e, 0x90, 0x13, 0x84, 0xe7, 0x77, 0x85, 0xd2, 0xe4, 0xfa, 0x64, 0x8d, 0x39, 0xeb,
-	0x5a, 0x6f, 0x26, 0xcd, 0x64, 0x0a, 0xb6, 0x75, 0xf2, 0xe3, 0xd7, 0xc7, 0x0a, 0x21, 0x57, 0xcd,
-	0xac, 0xa7, 0xad, 0xf2, 0x46, 0x28, 0xf2, 0x01, 0xe1, 0x6a, 0x7e, 0xcc, 0x64, 0x7d, 0x12, 0x63,
-	0xec, 0xf8, 0x6b, 0xb3, 0xf1, 0xd6, 0xbe, 0x69, 0x50, 0x56, 0xed, 0x53, 0x28, 0x0f, 0x87, 0xae,
-	0xbf, 0x43, 0x78, 0xee, 0x29, 0x9c, 0xe9, 0xcb, 0x8c, 0x40, 0x6e, 0x19, 0x90, 0x75, 0xb2, 0x3a,
-	0x09, 0xc2, 0xde, 0x08, 0x8f, 0x9a, 0xeb, 0xf7, 0x96, 0x7c, 0x42, 0xb8, 0x9a, 0xcf, 0xdc, 0x69,
-	0x7b, 0xc6, 0x66, 0x71, 0x56, 0x54, 0x9b, 0x86, 0xea, 0x4e, 0x6d, 0x1a, 0xd5, 0xc8, 0xa9, 0x3d,
-	0x5c, 0xdd, 0x81, 0x00, 0x34, 0x9c, 0xe5, 0x95, 0x35, 0x99, 0x1e, 0x5e, 0xf3, 0xa2, 0xfd, 0xfb,
-	0x53, 0xdb, 0x0f, 0x31, 0x76, 0xb2, 0xcf, 0x22, 0x3c, 0x4a, 0x74, 0xff, 0xef, 0x35, 0x98, 0xd1,
-	0xb8, 0x67, 0xdf, 0x9d, 0xa2, 0xc1, 0x62, 0x23, 0xd0, 0xe4, 0x99, 0xc2, 0x67, 0x84, 0x97, 0x7b,
-	0x61, 0xca, 0x03, 0x91, 0x59, 0xdb, 0xe5, 0x6e, 0x1f, 0xfe, 0xf1, 0x14, 0x6c, 0x19, 0x44, 0x6a,
-	0x6f, 0x4e, 0x43, 0x14, 0x43, 0xa4, 0xa6, 0x9b, 0x31, 0x6d, 0x6f, 0x7f, 0x1d, 0x6c, 0xa0, 0xef,
-	0x83, 0x0d, 0xf4, 0x73, 0xb0, 0x81, 0x5e, 0x6e, 0x9d, 0xef, 0xa5, 0x70, 0x03, 0x01, 0xa1, 0x2e,
-	0x05, 0x0e, 0xaa, 0xe6, 0x61, 0xe8, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x3f, 0x7b, 0x15,
-	0xad, 0x06, 0x00, 0x00,
+	// 753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x4b, 0x6f, 0xd3, 0x4a,
+	0x14, 0xc7, 0x35, 0x49, 0x9b, 0xdb, 0x9e, 0xf4, 0xf6, 0x31, 0xea, 0xbd, 0xb2, 0xd2, 0x87, 0x7a,
+	0x7d, 0x0b, 0x94, 0xaa, 0xb5, 0x95, 0xb4, 0x20, 0xe8, 0x02, 0xa9, 0x2f, 0x50, 0xa4, 0xb2, 0xc0,
+	0x08, 0x09, 0xb1, 0x68, 0x35, 0xb5, 0x8f, 0x12, 0x53, 0xc7, 0x36, 0xf6, 0xd8, 0x52, 0x84, 0xd8,
+	0x74, 0xc5, 0x0e, 0x21, 0xb6, 0x6c, 0x59, 0xb3, 0xe1, 0x0b, 0xb0, 0x63, 0x89, 0xc4, 0x17, 0x40,
+	0x15, 0x5f, 0x03, 0x09, 0x79, 0x3c, 0xce, 0xab, 0x6d, 0x94, 0x4a, 0x81, 0x55, 0x67, 0x4e, 0x7d,
+	0xfe, 0xe7, 0x77, 0xfe, 0x33, 0xc7, 0x31, 0xcc, 0x87, 0x18, 0xc4, 0x18, 0xe8, 0xa6, 0x13, 0x85,
+	0xbc, 0xfd, 0x57, 0xf3, 0x03, 0x8f, 0x7b, 0xf4, 0x2f, 0xb9, 0x2d, 0xcd, 0xd7, 0x3c, 0xaf, 0xe6,
+	0xa0, 0xce, 0x7c, 0x5b, 0x67, 0xae, 0xeb, 0x71, 0xc6, 0x6d, 0xcf, 0x0d, 0xd3, 0xc7, 0x4a, 0x07,
+	0x35, 0x9b, 0xd7, 0xa3, 0x63, 0xcd, 0xf4, 0x1a, 0x3a, 0x0b, 0x6a, 0x9e, 0x1f, 0x78, 0xcf, 0xc5,
+	0x62, 0xdd, 0xb4, 0xf4, 0x78, 0x43, 0xf7, 0x4f, 0x6a, 0x49, 0x66, 0xa8, 0x33, 0xdf, 0x77, 0x6c,
+	0x53, 0xe4, 0xea, 0x71, 0x99, 0x39, 0x7e, 0x9d, 0x95, 0xf5, 0x1a, 0xba, 0x18, 0x30, 0x8e, 0x56,
+	0xaa, 0xa6, 0xde, 0x82, 0xf1, 0xdd, 0xb4, 0x6c, 0x75, 0x8f, 0x52, 0x18, 0xe1, 0x4d, 0x1f, 0x15,
+	0xb2, 0x44, 0x56, 0xc6, 0x0d, 0xb1, 0xa6, 0xb3, 0x30, 0x1a, 0x33, 0x27, 0x42, 0x25, 0x27, 0x82,
+	0xe9, 0x46, 0x3d, 0x84, 0x09, 0x99, 0xf6, 0x28, 0xc2, 0xa0, 0x49, 0xff, 0x85, 0x42, 0xda, 0x9b,
+	0xcc, 0x95, 0xbb, 0x44, 0xd1, 0x65, 0x8d, 0x2c, 0x59, 0xac, 0xa9, 0x0a, 0x39, 0xdb, 0x52, 0xf2,
+	0x4b, 0x64, 0xa5, 0x58, 0xa1, 0x5a, 0xe6, 0x41, 0x8b, 0xc2, 0xc8, 0xd9, 0x96, 0x3a, 0x03, 0x53,
+	0x32, 0x60, 0x60, 0xe8, 0x7b, 0x6e, 0x88, 0xea, 0x1b, 0x02, 0xb3, 0x32, 0xb6, 0x1b, 0x20, 0xe3,
+	0x68, 0xe0, 0x8b, 0x08, 0x43, 0x4e, 0x8f, 0x20, 0x73, 0x4e, 0x14, 0x2f, 0x56, 0xf6, 0xb5, 0xb6,
+	0x45, 0x5a, 0x66, 0x91, 0x58, 0x1c, 0x99, 0x96, 0x16, 0x6f, 0x68, 0xfe, 0x49, 0x4d, 0x4b, 0x2c,
+	0xd2, 0x3a, 0x2c, 0xd2, 0x32, 0x8b, 0x32, 0x12, 0x23, 0x53, 0x4d, 0x9a, 0x8b, 0xfc, 0x10, 0x03,
+	0x2e, 0xda, 0x18, 0x33, 0xe4, 0x4e, 0xfd, 0xdc, 0x26, 0x7a, 0xe2, 0x5b, 0x7f, 0x92, 0x68, 0x19,
+	0xfe, 0x8e, 0x44, 0x45, 0xeb, 0xbe, 0x8d, 0x8e, 0x15, 0x2a, 0xb9, 0xa5, 0xfc, 0xca, 0xb8, 0xd1,
+	0x1d, 0x1c, 0xc8, 0xe8, 0x4f, 0x79, 0x98, 0x97, 0x91, 0x87, 0xc8, 0x99, 0xc5, 0x38, 0xeb, 0xee,
+	0x25, 0x15, 0x21, 0xfd, 0x44, 0x68, 0x15, 0x0a, 0x0e, 0x3b, 0x46, 0x27, 0xe5, 0x28, 0x56, 0xca,
+	0xbd, 0xcf, 0x5d, 0x28, 0xad, 0x1d, 0x88, 0x9c, 0x7d, 0x97, 0x07, 0x4d, 0x43, 0x0a, 0xd0, 0xa7,
+	0x50, 0xec, 0xb8, 0xf2, 0x4a, 0x5e, 0xe8, 0xdd, 0x1e, 0x4c, 0x6f, 0xbb, 0x9d, 0x98, 0x8a, 0x76,
+	0x4a, 0x51, 0x15, 0x26, 0x02, 0x6c, 0x78, 0x31, 0xa6, 0x65, 0x95, 0x11, 0x61, 0x59, 0x57, 0x8c,
+	0xae, 0xc1, 0x4c, 0xba, 0xef, 0x90, 0x52, 0x46, 0xc5, 0x83, 0xe7, 0xff, 0x51, 0xba, 0x0b, 0xc5,
+	0x8e, 0x16, 0xe8, 0x34, 0xe4, 0x4f, 0xb0, 0x29, 0x07, 0x20, 0x59, 0x5e, 0x3c, 0x3b, 0x5b, 0xb9,
+	0x3b, 0xa4, 0x74, 0x0f, 0xa6, 0x7b, 0x69, 0xaf, 0x92, 0x5f, 0xf9, 0x39, 0x06, 0x93, 0xd2, 0x8b,
+	0xc7, 0x18, 0xc4, 0xb6, 0x89, 0xf4, 0x94, 0xc0, 0xc8, 0x81, 0x1d, 0x72, 0xfa, 0x4f, 0xaf, 0x5b,
+	0x62, 0x44, 0x4b, 0xd5, 0xa1, 0xdc, 0xc1, 0xa4, 0x82, 0xaa, 0x9c, 0x7e, 0xfb, 0xf1, 0x2e, 0x47,
+	0xe9, 0xb4, 0x78, 0x45, 0xc5, 0xe5, 0xec, 0x45, 0x16, 0xd2, 0xb7, 0x04, 0x0a, 0xe9, 0x74, 0xd2,
+	0x85, 0x5e, 0x8c, 0xae, 0xa9, 0x2d, 0x0d, 0x67, 0x24, 0xd4, 0xff, 0x04, 0xca, 0x9c, 0x7a, 0x0e,
+	0x65, 0xab, 0x35, 0x2c, 0xaf, 0x09, 0xe4, 0x1f, 0xe0, 0xa5, 0xbe, 0x0c, 0x09, 0xe4, 0x7f, 0x01,
+	0xb2, 0x40, 0xe7, 0x7a, 0x41, 0xf4, 0x97, 0xb6, 0xa5, 0x89, 0x93, 0x7b, 0x45, 0xdf, 0x13, 0x28,
+	0xa4, 0x77, 0xf6, 0xbc, 0x3d, 0x5d, 0x77, 0x79, 0x58, 0x54, 0x6b, 0x82, 0xea, 0x7a, 0xa9, 0x1f,
+	0x55, 0xdb, 0xa9, 0x8f, 0x04, 0x26, 0x53, 0x8c, 0x6c, 0xc0, 0xe8, 0xb5, 0x81, 0x46, 0x6f, 0x58,
+	0xb8, 0xba, 0xc0, 0xbd, 0x59, 0x59, 0xee, 0x83, 0xab, 0x37, 0x24, 0xc1, 0x16, 0x59, 0xa5, 0x87,
+	0x50, 0xd8, 0x43, 0x07, 0x39, 0x5e, 0x76, 0xba, 0x4a, 0x6f, 0xb8, 0xf5, 0x7b, 0x22, 0x0f, 0x6c,
+	0xb5, 0xef, 0x81, 0xb9, 0x00, 0x46, 0x32, 0xa5, 0xb8, 0x1d, 0xf1, 0xfa, 0xd5, 0x6b, 0xc8, 0x7e,
+	0xd4, 0x1b, 0xfd, 0xfa, 0x09, 0x44, 0x81, 0x75, 0x96, 0x54, 0xf8, 0x40, 0x60, 0xaa, 0xea, 0xc6,
+	0xcc, 0xb1, 0x13, 0x77, 0x77, 0x99, 0x59, 0xc7, 0xdf, 0x7c, 0x6f, 0x37, 0x05, 0xa2, 0xa6, 0xae,
+	0xf5, 0x43, 0xb4, 0x5b, 0x48, 0xeb, 0x66, 0xc2, 0xb4, 0xb3, 0xf3, 0xe5, 0x6c, 0x91, 0x7c, 0x3d,
+	0x5b, 0x24, 0xdf, 0xcf, 0x16, 0xc9, 0xb3, 0xcd, 0xc1, 0x3e, 0x49, 0x4c, 0xc7, 0x46, 0x97, 0x67,
+	0x05, 0x8e, 0x0b, 0xe2, 0x0b, 0x64, 0xe3, 0xd7, 0x00, 0x24, 0x50, 0xb3, 0xc2, 0x16, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -383,6 +480,8 @@ type ClusterServiceClient interface {
 	Get(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 	// Update updates a cluster
 	Update(ctx context.Context, in *ClusterUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
+	// UpdateMetadata merges labels and annotations into the metadata of a cluster
+	UpdateMetadata(ctx context.Context, in *ClusterMetadataUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 	// Delete deletes a cluster
 	Delete(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error)
 	// RotateAuth rotates the bearer token used for a cluster
@@ -435,6 +534,15 @@ func (c *clusterServiceClient) Update(ctx context.Context, in *ClusterUpdateRequ
 	return out, nil
 }
 
+func (c *clusterServiceClient) UpdateMetadata(ctx context.Context, in *ClusterMetadataUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
+	out := new(v1alpha1.Cluster)
+	err := c.cc.Invoke(ctx, "/cluster.ClusterService/UpdateMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterServiceClient) Delete(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error) {
 	out := new(ClusterResponse)
 	err := c.cc.Invoke(ctx, "/cluster.ClusterService/Delete", in, out, opts...)
@@ -472,6 +580,8 @@ type ClusterServiceServer interface {
 	Get(context.Context, *ClusterQuery) (*v1alpha1.Cluster, error)
 	// Update updates a cluster
 	Update(context.Context, *ClusterUpdateRequest) (*v1alpha1.Cluster, error)
+	// UpdateMetadata merges labels and annotations into the metadata of a cluster
+	UpdateMetadata(context.Context, *ClusterMetadataUpdateRequest) (*v1alpha1.Cluster, error)
 	// Delete deletes a cluster
 	Delete(context.Context, *ClusterQuery) (*ClusterResponse, error)
 	// RotateAuth rotates the bearer token used for a cluster
@@ -496,6 +606,9 @@ func (*UnimplementedClusterServiceServer) Get(ctx context.Context, req *ClusterQ
 func (*UnimplementedClusterServiceServer) Update(ctx context.Context, req *ClusterUpdateRequest) (*v1alpha1.Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (*UnimplementedClusterServiceServer) UpdateMetadata(ctx context.Context, req *ClusterMetadataUpdateRequest) (*v1alpha1.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetadata not implemented")
+}
 func (*UnimplementedClusterServiceServer) Delete(ctx context.Context, req *ClusterQuery) (*ClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -582,6 +695,24 @@ func _ClusterService_Update_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_UpdateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterMetadataUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).UpdateMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.ClusterService/UpdateMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).UpdateMetadata(ctx, req.(*ClusterMetadataUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClusterService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClusterQuery)
 	if err := dec(in); err != nil {
@@ -656,6 +787,10 @@ var _ClusterService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _ClusterService_Update_Handler,
 		},
+		{
+			MethodName: "UpdateMetadata",
+			Handler:    _ClusterService_UpdateMetadata_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _ClusterService_Delete_Handler,
@@ -903,6 +1038,101 @@ func (m *ClusterUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterMetadataUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterMetadataUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterMetadataUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RemoveAnnotations) > 0 {
+		for iNdEx := len(m.RemoveAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveAnnotations[iNdEx])
+			copy(dAtA[i:], m.RemoveAnnotations[iNdEx])
+			i = encodeVarintCluster(dAtA, i, uint64(len(m.RemoveAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RemoveLabels) > 0 {
+		for iNdEx := len(m.RemoveLabels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemoveLabels[iNdEx])
+			copy(dAtA[i:], m.RemoveLabels[iNdEx])
+			i = encodeVarintCluster(dAtA, i, uint64(len(m.RemoveLabels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCluster(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCluster(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCluster(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCluster(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCluster(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCluster(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCluster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCluster(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCluster(v)
 	base := offset
@@ -1015,6 +1245,50 @@ func (m *ClusterUpdateRequest) Size() (n int) {
 	return n
 }
 
+func (m *ClusterMetadataUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCluster(uint64(len(k))) + 1 + len(v) + sovCluster(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCluster(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCluster(uint64(len(k))) + 1 + len(v) + sovCluster(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCluster(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RemoveLabels) > 0 {
+		for _, s := range m.RemoveLabels {
+			l = len(s)
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if len(m.RemoveAnnotations) > 0 {
+		for _, s := range m.RemoveAnnotations {
+			l = len(s)
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCluster(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1600,6 +1874,411 @@ func (m *ClusterUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClusterMetadataUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterMetadataUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterMetadataUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ClusterID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCluster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCluster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCluster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCluster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCluster
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCluster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCluster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCluster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCluster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCluster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCluster
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCluster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveLabels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveLabels = append(m.RemoveLabels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveAnnotations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveAnnotations = append(m.RemoveAnnotations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCluster(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
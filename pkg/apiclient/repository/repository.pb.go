@@ -626,6 +626,179 @@ func (m *RepoResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RepoResponse proto.InternalMessageInfo
 
+// RepoAccessBatchQuery is a query for checking access to several repos concurrently
+type RepoAccessBatchQuery struct {
+	// The queries for checking access to each repo. The repos without credentials use the stored credentials matching their URL
+	Repos                []*RepoAccessQuery `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RepoAccessBatchQuery) Reset()         { *m = RepoAccessBatchQuery{} }
+func (m *RepoAccessBatchQuery) String() string { return proto.CompactTextString(m) }
+func (*RepoAccessBatchQuery) ProtoMessage()    {}
+func (*RepoAccessBatchQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d38260443475705, []int{7}
+}
+func (m *RepoAccessBatchQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoAccessBatchQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoAccessBatchQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoAccessBatchQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoAccessBatchQuery.Merge(m, src)
+}
+func (m *RepoAccessBatchQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoAccessBatchQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoAccessBatchQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoAccessBatchQuery proto.InternalMessageInfo
+
+func (m *RepoAccessBatchQuery) GetRepos() []*RepoAccessQuery {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+// RepoAccessResult is the result of checking access to a repo
+type RepoAccessResult struct {
+	// The URL to the repo
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// The project of the repo
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// Whether the repo is accessible
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The reason why the repo is not accessible
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoAccessResult) Reset()         { *m = RepoAccessResult{} }
+func (m *RepoAccessResult) String() string { return proto.CompactTextString(m) }
+func (*RepoAccessResult) ProtoMessage()    {}
+func (*RepoAccessResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d38260443475705, []int{8}
+}
+func (m *RepoAccessResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoAccessResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoAccessResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoAccessResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoAccessResult.Merge(m, src)
+}
+func (m *RepoAccessResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoAccessResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoAccessResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoAccessResult proto.InternalMessageInfo
+
+func (m *RepoAccessResult) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *RepoAccessResult) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *RepoAccessResult) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *RepoAccessResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// RepoAccessBatchResponse contains the results of checking access to several repos, in the order of the queries
+type RepoAccessBatchResponse struct {
+	Items                []*RepoAccessResult `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RepoAccessBatchResponse) Reset()         { *m = RepoAccessBatchResponse{} }
+func (m *RepoAccessBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*RepoAccessBatchResponse) ProtoMessage()    {}
+func (*RepoAccessBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d38260443475705, []int{9}
+}
+func (m *RepoAccessBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoAccessBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoAccessBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoAccessBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoAccessBatchResponse.Merge(m, src)
+}
+func (m *RepoAccessBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoAccessBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoAccessBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoAccessBatchResponse proto.InternalMessageInfo
+
+func (m *RepoAccessBatchResponse) GetItems() []*RepoAccessResult {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 // RepoCreateRequest is a request for creating repository config
 type RepoCreateRequest struct {
 	// Repository definition
@@ -633,7 +806,9 @@ type RepoCreateRequest struct {
 	// Whether to create in upsert mode
 	Upsert bool `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
 	// Whether to operate on credential set instead of repository
-	CredsOnly            bool     `protobuf:"varint,3,opt,name=credsOnly,proto3" json:"credsOnly,omitempty"`
+	CredsOnly bool `protobuf:"varint,3,opt,name=credsOnly,proto3" json:"credsOnly,omitempty"`
+	// Whether to verify that the credentials grant push access to the repository before creating it (only for Git repositories)
+	VerifyWriteAccess    bool     `protobuf:"varint,4,opt,name=verifyWriteAccess,proto3" json:"verifyWriteAccess,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -643,7 +818,7 @@ func (m *RepoCreateRequest) Reset()         { *m = RepoCreateRequest{} }
 func (m *RepoCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoCreateRequest) ProtoMessage()    {}
 func (*RepoCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d38260443475705, []int{7}
+	return fileDescriptor_8d38260443475705, []int{10}
 }
 func (m *RepoCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,6 +868,13 @@ func (m *RepoCreateRequest) GetCredsOnly() bool {
 	return false
 }
 
+func (m *RepoCreateRequest) GetVerifyWriteAccess() bool {
+	if m != nil {
+		return m.VerifyWriteAccess
+	}
+	return false
+}
+
 type RepoUpdateRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -704,7 +886,7 @@ func (m *RepoUpdateRequest) Reset()         { *m = RepoUpdateRequest{} }
 func (m *RepoUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoUpdateRequest) ProtoMessage()    {}
 func (*RepoUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d38260443475705, []int{8}
+	return fileDescriptor_8d38260443475705, []int{11}
 }
 func (m *RepoUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,6 +930,9 @@ func init() {
 	proto.RegisterType((*RepoQuery)(nil), "repository.RepoQuery")
 	proto.RegisterType((*RepoAccessQuery)(nil), "repository.RepoAccessQuery")
 	proto.RegisterType((*RepoResponse)(nil), "repository.RepoResponse")
+	proto.RegisterType((*RepoAccessBatchQuery)(nil), "repository.RepoAccessBatchQuery")
+	proto.RegisterType((*RepoAccessResult)(nil), "repository.RepoAccessResult")
+	proto.RegisterType((*RepoAccessBatchResponse)(nil), "repository.RepoAccessBatchResponse")
 	proto.RegisterType((*RepoCreateRequest)(nil), "repository.RepoCreateRequest")
 	proto.RegisterType((*RepoUpdateRequest)(nil), "repository.RepoUpdateRequest")
 }
@@ -757,94 +942,104 @@ func init() {
 }
 
 var fileDescriptor_8d38260443475705 = []byte{
-	// 1392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xd6, 0x26, 0x8d, 0x9b, 0x4c, 0x9a, 0xd6, 0x9d, 0x24, 0xed, 0xfe, 0xdc, 0x34, 0xcd, 0x6f,
-	0x5b, 0xa2, 0x34, 0x6a, 0xd7, 0x4d, 0x0a, 0xa2, 0x2a, 0x02, 0xc9, 0x4d, 0x4a, 0x6b, 0x11, 0x91,
-	0xb2, 0x6d, 0xa9, 0x84, 0x40, 0x68, 0xb2, 0x3e, 0xb1, 0xb7, 0xd9, 0xec, 0x4e, 0x67, 0xc6, 0x6e,
-	0x4d, 0xd5, 0x1b, 0x84, 0x10, 0x12, 0xdc, 0x20, 0x04, 0xe2, 0x0e, 0x2e, 0x90, 0x90, 0xe0, 0x12,
-	0x89, 0x67, 0xe0, 0x12, 0x89, 0x17, 0x40, 0x15, 0x0f, 0xc1, 0x25, 0x9a, 0x33, 0xeb, 0xf5, 0x3a,
-	0xf1, 0x9f, 0x44, 0x4d, 0x73, 0x37, 0x73, 0xce, 0xec, 0xf9, 0xbe, 0xf3, 0xcd, 0x99, 0x33, 0x63,
-	0x13, 0x47, 0x82, 0x68, 0x80, 0x28, 0x0a, 0xe0, 0xb1, 0x0c, 0x54, 0x2c, 0x9a, 0x99, 0xa1, 0xcb,
-	0x45, 0xac, 0x62, 0x4a, 0xda, 0x96, 0xc2, 0x4c, 0x35, 0x8e, 0xab, 0x21, 0x14, 0x19, 0x0f, 0x8a,
-	0x2c, 0x8a, 0x62, 0xc5, 0x54, 0x10, 0x47, 0xd2, 0xac, 0x2c, 0xac, 0x55, 0x03, 0x55, 0xab, 0x6f,
-	0xb8, 0x7e, 0xbc, 0x5d, 0x64, 0xa2, 0x1a, 0x73, 0x11, 0x3f, 0xc4, 0xc1, 0x65, 0xbf, 0x52, 0x6c,
-	0x5c, 0x2d, 0xf2, 0xad, 0xaa, 0xfe, 0x52, 0x16, 0x19, 0xe7, 0x61, 0xe0, 0xe3, 0xb7, 0xc5, 0xc6,
-	0x12, 0x0b, 0x79, 0x8d, 0x2d, 0x15, 0xab, 0x10, 0x81, 0x60, 0x0a, 0x2a, 0x49, 0xb4, 0x9b, 0x03,
-	0xa2, 0x21, 0xad, 0x81, 0xf4, 0x9d, 0x26, 0x99, 0xf0, 0x80, 0xc7, 0x25, 0xce, 0xe5, 0x7b, 0x75,
-	0x10, 0x4d, 0x4a, 0xc9, 0x11, 0xbd, 0xc8, 0xb6, 0xe6, 0xac, 0x85, 0x31, 0x0f, 0xc7, 0xb4, 0x40,
-	0x46, 0x05, 0x34, 0x02, 0x19, 0xc4, 0x91, 0x3d, 0x84, 0xf6, 0x74, 0x4e, 0x6d, 0x72, 0x94, 0x71,
-	0xfe, 0x2e, 0xdb, 0x06, 0x7b, 0x18, 0x5d, 0xad, 0x29, 0x9d, 0x25, 0x84, 0x71, 0x7e, 0x47, 0xc4,
-	0x0f, 0xc1, 0x57, 0xf6, 0x11, 0x74, 0x66, 0x2c, 0xce, 0x12, 0x39, 0x5a, 0xe2, 0xbc, 0x1c, 0x6d,
-	0xc6, 0x1a, 0x54, 0x35, 0x39, 0xb4, 0x40, 0xf5, 0x58, 0xdb, 0x38, 0x53, 0xb5, 0x04, 0x10, 0xc7,
-	0xce, 0xbf, 0x16, 0x99, 0x4c, 0xe8, 0xae, 0x82, 0x62, 0x41, 0x98, 0x90, 0xae, 0x92, 0x9c, 0x8c,
-	0xeb, 0xc2, 0x37, 0x11, 0xc6, 0x97, 0xd7, 0xdd, 0xb6, 0x3a, 0x6e, 0x4b, 0x1d, 0x1c, 0x7c, 0xec,
-	0x57, 0xdc, 0xc6, 0x55, 0x97, 0x6f, 0x55, 0x5d, 0xad, 0xb5, 0x9b, 0xd1, 0xda, 0x6d, 0x69, 0xed,
-	0x96, 0xda, 0xc6, 0xbb, 0x18, 0xd6, 0x4b, 0xc2, 0x67, 0xb3, 0x1d, 0xea, 0x97, 0xed, 0xf0, 0xce,
-	0x6c, 0xe9, 0x1c, 0x19, 0x37, 0x31, 0xca, 0x51, 0x05, 0x9e, 0xa0, 0x1c, 0x23, 0x5e, 0xd6, 0x44,
-	0x67, 0xc8, 0x58, 0x03, 0x84, 0x16, 0xb5, 0x5c, 0xb1, 0x47, 0xd0, 0xdf, 0x36, 0x38, 0x6f, 0x92,
-	0x7c, 0x6b, 0xa3, 0x3c, 0x90, 0x3c, 0x8e, 0x24, 0xd0, 0x8b, 0x64, 0x24, 0x50, 0xb0, 0x2d, 0x6d,
-	0x6b, 0x6e, 0x78, 0x61, 0x7c, 0x79, 0xd2, 0xcd, 0x6c, 0x6f, 0x22, 0xad, 0x67, 0x56, 0x38, 0x3e,
-	0x19, 0xd3, 0x9f, 0xf7, 0xde, 0x63, 0x87, 0x1c, 0xdb, 0x8c, 0x75, 0xaa, 0xb0, 0x29, 0x40, 0x1a,
-	0xd9, 0x47, 0xbd, 0x0e, 0xdb, 0xa0, 0x1c, 0x9d, 0xdf, 0x72, 0xe4, 0x04, 0x92, 0xf4, 0x7d, 0x90,
-	0xfd, 0xeb, 0xa9, 0x2e, 0x41, 0x44, 0x6d, 0x19, 0xd3, 0xb9, 0xf6, 0x71, 0x26, 0xe5, 0xe3, 0x58,
-	0x54, 0x12, 0x84, 0x74, 0x4e, 0x2f, 0x90, 0x09, 0x29, 0x6b, 0x77, 0x44, 0xd0, 0x60, 0x0a, 0xde,
-	0x81, 0x66, 0x52, 0x54, 0x9d, 0x46, 0x1d, 0x21, 0x88, 0x24, 0xf8, 0x75, 0x01, 0x28, 0xe3, 0xa8,
-	0x97, 0xce, 0xe9, 0x25, 0x72, 0x52, 0x85, 0x72, 0x25, 0x0c, 0x20, 0x52, 0x2b, 0x20, 0xd4, 0x2a,
-	0x53, 0xcc, 0xce, 0x61, 0x94, 0xdd, 0x0e, 0xba, 0x48, 0xf2, 0x1d, 0x46, 0x0d, 0x79, 0x14, 0x17,
-	0xef, 0xb2, 0xa7, 0x25, 0x3c, 0xd6, 0x59, 0xc2, 0x98, 0x23, 0x31, 0x36, 0xcc, 0x6f, 0x86, 0x8c,
-	0x41, 0xc4, 0x36, 0x42, 0x58, 0xf7, 0x03, 0x7b, 0x1c, 0xe9, 0xb5, 0x0d, 0xf4, 0x0a, 0x99, 0x34,
-	0x95, 0x5b, 0xd2, 0xaa, 0xa6, 0x79, 0x1e, 0xc3, 0x00, 0xdd, 0x5c, 0xba, 0xae, 0x52, 0x73, 0x79,
-	0xd5, 0x9e, 0x98, 0xb3, 0x16, 0x86, 0xbd, 0xac, 0x89, 0x5e, 0x23, 0xa7, 0xdb, 0xd3, 0x48, 0x2a,
-	0x16, 0x86, 0x58, 0xda, 0xe5, 0x55, 0xfb, 0x38, 0xae, 0xee, 0xe5, 0xa6, 0x6f, 0x91, 0x42, 0xea,
-	0xba, 0x19, 0x29, 0x10, 0x5c, 0x04, 0x12, 0x6e, 0x30, 0x09, 0xf7, 0x45, 0x68, 0x9f, 0x40, 0x52,
-	0x7d, 0x56, 0xd0, 0x29, 0x32, 0xc2, 0x45, 0xfc, 0xa4, 0x69, 0xe7, 0x71, 0xa9, 0x99, 0xe8, 0x33,
-	0xc4, 0x93, 0x12, 0x3a, 0x69, 0xce, 0x50, 0x32, 0xa5, 0xcb, 0x64, 0xaa, 0xea, 0xf3, 0xbb, 0x20,
-	0x1a, 0x81, 0x0f, 0x25, 0xdf, 0x8f, 0xeb, 0x11, 0x6a, 0x4e, 0x71, 0x59, 0x57, 0x1f, 0x75, 0x09,
-	0xc5, 0x1a, 0xbd, 0xad, 0x14, 0xbf, 0xc1, 0x64, 0xe0, 0x97, 0xea, 0xaa, 0x66, 0x4f, 0xa2, 0xb0,
-	0x5d, 0x3c, 0xf4, 0x3a, 0xb1, 0xeb, 0x12, 0x4a, 0x9f, 0xd4, 0x05, 0x3c, 0x88, 0xc5, 0x56, 0x18,
-	0xb3, 0x4a, 0xb9, 0x02, 0x91, 0x0a, 0x54, 0xd3, 0x9e, 0xc2, 0xaf, 0x7a, 0xfa, 0xb5, 0xd6, 0x1b,
-	0xc0, 0x04, 0x88, 0x7b, 0xf1, 0x16, 0x44, 0xf6, 0x34, 0xd2, 0xca, 0x9a, 0x74, 0x06, 0xad, 0x5a,
-	0x5b, 0xf7, 0x83, 0xb7, 0x5b, 0xf0, 0xf6, 0x29, 0x8c, 0xdc, 0xd5, 0xe7, 0x1c, 0x27, 0xc7, 0xf4,
-	0xa1, 0x69, 0x9d, 0x6a, 0xe7, 0x67, 0x8b, 0x9c, 0xd4, 0x86, 0x15, 0x01, 0x4c, 0x81, 0x07, 0x8f,
-	0xea, 0x20, 0x15, 0xfd, 0x30, 0x73, 0x8e, 0xc6, 0x97, 0x6f, 0xbf, 0x58, 0x83, 0xf3, 0xd2, 0x3e,
-	0x91, 0x9c, 0xc8, 0x53, 0x24, 0x57, 0xe7, 0x12, 0x84, 0x4a, 0xce, 0x7d, 0x32, 0xd3, 0xd5, 0xea,
-	0x0b, 0xa8, 0xc8, 0xf5, 0x28, 0x6c, 0xe2, 0x71, 0x1c, 0xf5, 0xda, 0x06, 0xe7, 0x91, 0x21, 0x7a,
-	0x9f, 0x57, 0x0e, 0x8b, 0xe8, 0xf2, 0x67, 0xa7, 0x0d, 0xa6, 0x31, 0x26, 0xe5, 0x40, 0xbf, 0xb2,
-	0xc8, 0x91, 0xb5, 0x40, 0x2a, 0x3a, 0x9d, 0x6d, 0x81, 0x69, 0xc3, 0x2b, 0xac, 0x1d, 0x14, 0x0b,
-	0x0d, 0xe2, 0x9c, 0xfb, 0xf4, 0xaf, 0x7f, 0xbe, 0x19, 0x3a, 0x45, 0xa7, 0xf0, 0xa2, 0x6f, 0x2c,
-	0xb5, 0x6f, 0xd5, 0x00, 0xe4, 0x17, 0x43, 0x16, 0xfd, 0xd2, 0x22, 0xc3, 0xb7, 0xa0, 0x27, 0x9b,
-	0x03, 0xd3, 0xc4, 0x39, 0x8f, 0x4c, 0xce, 0xd2, 0x33, 0xdd, 0x98, 0x14, 0x9f, 0xea, 0xd9, 0x33,
-	0xfa, 0x9d, 0x45, 0x46, 0x6f, 0x81, 0x7a, 0x20, 0x02, 0x05, 0x2f, 0x9f, 0xd2, 0x45, 0xa4, 0x74,
-	0x9e, 0xfe, 0xbf, 0x45, 0xe9, 0xb1, 0xc6, 0xbd, 0xdc, 0x8d, 0xd8, 0xb7, 0x16, 0xc9, 0x6b, 0x41,
-	0xbd, 0x8c, 0xef, 0x70, 0x76, 0x70, 0xa6, 0xdf, 0x0e, 0xd2, 0x1f, 0x2d, 0x32, 0xad, 0x97, 0xa1,
-	0x62, 0x87, 0x4f, 0xce, 0x41, 0x72, 0x33, 0xb4, 0xd0, 0x5b, 0x41, 0xfa, 0x11, 0x19, 0x35, 0xca,
-	0x6d, 0xf6, 0x24, 0x95, 0xef, 0x34, 0x6f, 0x4a, 0x67, 0x01, 0x03, 0x3b, 0x74, 0xae, 0x4f, 0xb5,
-	0x14, 0x85, 0x0e, 0x59, 0x21, 0xe3, 0x3a, 0xfc, 0xfa, 0x4a, 0xf9, 0x1e, 0xab, 0xee, 0x03, 0xe1,
-	0x12, 0x22, 0xcc, 0xd3, 0x0b, 0xfd, 0x10, 0x62, 0x3f, 0xb8, 0xac, 0x74, 0xd8, 0x6d, 0x93, 0x84,
-	0x7e, 0xd2, 0xd0, 0xff, 0xed, 0x84, 0x48, 0x5f, 0xa4, 0x85, 0x99, 0x6e, 0xae, 0xb4, 0x5b, 0xee,
-	0x29, 0x29, 0xa6, 0x21, 0xbe, 0xb6, 0xc8, 0xc4, 0x2d, 0x50, 0xed, 0xb7, 0x23, 0x3d, 0xd7, 0x25,
-	0x72, 0xf6, 0x5d, 0x59, 0x70, 0x7a, 0x2f, 0x48, 0x09, 0xbc, 0x81, 0x04, 0x5e, 0x73, 0xae, 0x74,
-	0x27, 0x60, 0x5e, 0x78, 0x18, 0xe7, 0xbe, 0xb7, 0x86, 0x54, 0x2a, 0x26, 0xc2, 0x75, 0x6b, 0x91,
-	0x36, 0x90, 0xd2, 0x6d, 0x08, 0xb7, 0x57, 0x6a, 0x4c, 0xa8, 0x9e, 0x52, 0xcf, 0x66, 0xcd, 0xed,
-	0xe5, 0x29, 0x09, 0x17, 0x49, 0x2c, 0xd0, 0xf9, 0x7e, 0x2a, 0xd4, 0x20, 0xdc, 0xf6, 0x0d, 0xcc,
-	0xf7, 0x16, 0xc9, 0x99, 0xfb, 0x85, 0x9e, 0xdd, 0x89, 0xd8, 0x71, 0xef, 0x1c, 0x60, 0x67, 0x78,
-	0xc5, 0xd4, 0xb5, 0xd3, 0xf5, 0xd0, 0x5d, 0xc7, 0xf6, 0xae, 0x9b, 0xe7, 0x0f, 0x16, 0xc9, 0xb7,
-	0x28, 0xb4, 0xbe, 0x3d, 0x3c, 0x92, 0xce, 0x60, 0x92, 0xf4, 0x17, 0x8b, 0x4c, 0x1b, 0xfc, 0xce,
-	0x0e, 0x71, 0x88, 0x34, 0x93, 0xaa, 0x77, 0xfa, 0xf4, 0x88, 0x84, 0xec, 0x4f, 0x16, 0xc9, 0x99,
-	0x0b, 0x7a, 0x37, 0xbb, 0x8e, 0x8b, 0xfb, 0x00, 0xd9, 0x2d, 0x99, 0x6a, 0x2c, 0xf4, 0x39, 0x93,
-	0x48, 0xe5, 0x59, 0x7b, 0xd7, 0x7f, 0xb5, 0x48, 0xbe, 0x45, 0xa7, 0xb7, 0x9c, 0x2f, 0x8b, 0xb0,
-	0xbb, 0x3f, 0xc2, 0xf4, 0x77, 0x8b, 0x4c, 0x1b, 0x2e, 0x03, 0x2b, 0xe0, 0x65, 0x51, 0x7e, 0x15,
-	0x29, 0xbb, 0x85, 0xf9, 0x41, 0xf7, 0x6c, 0x07, 0x71, 0x46, 0x72, 0xab, 0x10, 0x42, 0xef, 0x87,
-	0x80, 0xbd, 0xd3, 0x9c, 0xb6, 0x98, 0x79, 0xf3, 0xd6, 0x58, 0xec, 0xf7, 0xd6, 0xd0, 0x3b, 0x59,
-	0x23, 0x79, 0x03, 0x91, 0x51, 0x65, 0xdf, 0x60, 0xe7, 0xf7, 0x00, 0x46, 0x25, 0x99, 0x36, 0x48,
-	0x3b, 0x37, 0x61, 0xdf, 0x70, 0xc9, 0xa3, 0x65, 0x71, 0x0f, 0x8f, 0x96, 0xa7, 0xe4, 0xf8, 0xfb,
-	0x2c, 0x0c, 0xf4, 0xa6, 0x9a, 0x9f, 0xb9, 0xf4, 0xcc, 0xae, 0x4b, 0xa2, 0xfd, 0xf3, 0xb7, 0x0f,
-	0xe6, 0x32, 0x62, 0x5e, 0x72, 0xfa, 0xde, 0x95, 0x8d, 0x04, 0x2a, 0xd9, 0xbe, 0xcf, 0x2d, 0x32,
-	0xd9, 0x42, 0xc7, 0xa4, 0x5f, 0x8c, 0xc2, 0x35, 0xa4, 0xb0, 0xec, 0x2c, 0x0e, 0x4c, 0x7b, 0x07,
-	0x91, 0x1b, 0x37, 0xff, 0x78, 0x3e, 0x6b, 0xfd, 0xf9, 0x7c, 0xd6, 0xfa, 0xfb, 0xf9, 0xac, 0xf5,
-	0xc1, 0xeb, 0x7b, 0xfb, 0x67, 0xcb, 0xc7, 0x1f, 0xcc, 0x99, 0xff, 0xa0, 0x36, 0x72, 0xf8, 0x27,
-	0xd4, 0xd5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x0b, 0xd2, 0x3f, 0x69, 0x13, 0x00, 0x00,
+	// 1545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xc7, 0x4a, 0x16, 0x2d, 0x3d, 0x59, 0x36, 0x35, 0x92, 0xec, 0x35, 0x2d, 0xcb, 0xea, 0xca,
+	0x15, 0x64, 0xc1, 0x26, 0x2d, 0xba, 0x45, 0x0d, 0x17, 0x2d, 0xa0, 0x3f, 0xae, 0x4d, 0x54, 0xad,
+	0xdc, 0xb5, 0x5d, 0x03, 0x45, 0x8b, 0x62, 0xb4, 0x7c, 0x22, 0xd7, 0x5a, 0xed, 0x8e, 0x67, 0x86,
+	0xb4, 0x59, 0xc3, 0x97, 0x1e, 0x82, 0x00, 0xc9, 0x25, 0x08, 0x12, 0xe4, 0x96, 0x1c, 0x72, 0x4a,
+	0x8e, 0x09, 0xf2, 0x09, 0x72, 0xc8, 0x31, 0x40, 0x90, 0x7b, 0x60, 0xe4, 0x43, 0xe4, 0x18, 0xcc,
+	0xcc, 0x2e, 0x77, 0x49, 0x91, 0x94, 0x94, 0xc8, 0xba, 0xed, 0xbc, 0x37, 0xfb, 0xde, 0xef, 0xfd,
+	0xde, 0x7b, 0xb3, 0x6f, 0x16, 0x1c, 0x81, 0xbc, 0x89, 0xbc, 0xc4, 0x91, 0x45, 0xc2, 0x97, 0x11,
+	0x6f, 0x65, 0x1e, 0x8b, 0x8c, 0x47, 0x32, 0x22, 0x90, 0x4a, 0x0a, 0xb3, 0xb5, 0x28, 0xaa, 0x05,
+	0x58, 0xa2, 0xcc, 0x2f, 0xd1, 0x30, 0x8c, 0x24, 0x95, 0x7e, 0x14, 0x0a, 0xb3, 0xb3, 0xb0, 0x59,
+	0xf3, 0x65, 0xbd, 0xb1, 0x5d, 0xf4, 0xa2, 0xbd, 0x12, 0xe5, 0xb5, 0x88, 0xf1, 0xe8, 0xa9, 0x7e,
+	0xb8, 0xe1, 0x55, 0x4b, 0xcd, 0x5b, 0x25, 0xb6, 0x5b, 0x53, 0x6f, 0x8a, 0x12, 0x65, 0x2c, 0xf0,
+	0x3d, 0xfd, 0x6e, 0xa9, 0xb9, 0x42, 0x03, 0x56, 0xa7, 0x2b, 0xa5, 0x1a, 0x86, 0xc8, 0xa9, 0xc4,
+	0x6a, 0x6c, 0xed, 0xee, 0x01, 0xd6, 0x34, 0xac, 0x03, 0xe1, 0x3b, 0x2d, 0x98, 0x70, 0x91, 0x45,
+	0xab, 0x8c, 0x89, 0x7f, 0x34, 0x90, 0xb7, 0x08, 0x81, 0x53, 0x6a, 0x93, 0x6d, 0xcd, 0x5b, 0x4b,
+	0x63, 0xae, 0x7e, 0x26, 0x05, 0x18, 0xe5, 0xd8, 0xf4, 0x85, 0x1f, 0x85, 0xf6, 0x90, 0x96, 0xb7,
+	0xd7, 0xc4, 0x86, 0xd3, 0x94, 0xb1, 0xbf, 0xd3, 0x3d, 0xb4, 0x87, 0xb5, 0x2a, 0x59, 0x92, 0x39,
+	0x00, 0xca, 0xd8, 0x03, 0x1e, 0x3d, 0x45, 0x4f, 0xda, 0xa7, 0xb4, 0x32, 0x23, 0x71, 0x56, 0xe0,
+	0xf4, 0x2a, 0x63, 0x95, 0x70, 0x27, 0x52, 0x4e, 0x65, 0x8b, 0x61, 0xe2, 0x54, 0x3d, 0x2b, 0x19,
+	0xa3, 0xb2, 0x1e, 0x3b, 0xd4, 0xcf, 0xce, 0x4f, 0x16, 0x4c, 0xc5, 0x70, 0x37, 0x50, 0x52, 0x3f,
+	0x88, 0x41, 0xd7, 0x20, 0x27, 0xa2, 0x06, 0xf7, 0x8c, 0x85, 0xf1, 0xf2, 0x56, 0x31, 0x65, 0xa7,
+	0x98, 0xb0, 0xa3, 0x1f, 0xfe, 0xeb, 0x55, 0x8b, 0xcd, 0x5b, 0x45, 0xb6, 0x5b, 0x2b, 0x2a, 0xae,
+	0x8b, 0x19, 0xae, 0x8b, 0x09, 0xd7, 0xc5, 0xd5, 0x54, 0xf8, 0x50, 0x9b, 0x75, 0x63, 0xf3, 0xd9,
+	0x68, 0x87, 0x06, 0x45, 0x3b, 0xdc, 0x1d, 0x2d, 0x99, 0x87, 0x71, 0x63, 0xa3, 0x12, 0x56, 0xf1,
+	0x85, 0xa6, 0x63, 0xc4, 0xcd, 0x8a, 0xc8, 0x2c, 0x8c, 0x35, 0x91, 0x2b, 0x52, 0x2b, 0x55, 0x7b,
+	0x44, 0xeb, 0x53, 0x81, 0xf3, 0x27, 0xc8, 0x27, 0x89, 0x72, 0x51, 0xb0, 0x28, 0x14, 0x48, 0xae,
+	0xc1, 0x88, 0x2f, 0x71, 0x4f, 0xd8, 0xd6, 0xfc, 0xf0, 0xd2, 0x78, 0x79, 0xaa, 0x98, 0x49, 0x6f,
+	0x4c, 0xad, 0x6b, 0x76, 0x38, 0x1e, 0x8c, 0xa9, 0xd7, 0xfb, 0xe7, 0xd8, 0x81, 0x33, 0x3b, 0x91,
+	0x0a, 0x15, 0x77, 0x38, 0x0a, 0x43, 0xfb, 0xa8, 0xdb, 0x21, 0x3b, 0x28, 0x46, 0xe7, 0x8b, 0x1c,
+	0x9c, 0xd3, 0x20, 0x3d, 0x0f, 0xc5, 0xe0, 0x7a, 0x6a, 0x08, 0xe4, 0x61, 0x4a, 0x63, 0x7b, 0xad,
+	0x74, 0x8c, 0x0a, 0xf1, 0x3c, 0xe2, 0xd5, 0xd8, 0x43, 0x7b, 0x4d, 0xae, 0xc2, 0x84, 0x10, 0xf5,
+	0x07, 0xdc, 0x6f, 0x52, 0x89, 0x7f, 0xc5, 0x56, 0x5c, 0x54, 0x9d, 0x42, 0x65, 0xc1, 0x0f, 0x05,
+	0x7a, 0x0d, 0x8e, 0x9a, 0xc6, 0x51, 0xb7, 0xbd, 0x26, 0xd7, 0x61, 0x52, 0x06, 0x62, 0x3d, 0xf0,
+	0x31, 0x94, 0xeb, 0xc8, 0xe5, 0x06, 0x95, 0xd4, 0xce, 0x69, 0x2b, 0xfb, 0x15, 0x64, 0x19, 0xf2,
+	0x1d, 0x42, 0xe5, 0xf2, 0xb4, 0xde, 0xbc, 0x4f, 0xde, 0x2e, 0xe1, 0xb1, 0xce, 0x12, 0xd6, 0x31,
+	0x82, 0x91, 0xe9, 0xf8, 0x66, 0x61, 0x0c, 0x43, 0xba, 0x1d, 0xe0, 0x96, 0xe7, 0xdb, 0xe3, 0x1a,
+	0x5e, 0x2a, 0x20, 0x37, 0x61, 0xca, 0x54, 0xee, 0x2a, 0x63, 0x69, 0x48, 0xf6, 0x19, 0x6d, 0xa0,
+	0x97, 0x4a, 0xd5, 0x55, 0x5b, 0x5c, 0xd9, 0xb0, 0x27, 0xe6, 0xad, 0xa5, 0x61, 0x37, 0x2b, 0x22,
+	0xb7, 0xe1, 0x42, 0xba, 0x0c, 0x85, 0xa4, 0x41, 0xa0, 0x4b, 0xbb, 0xb2, 0x61, 0x9f, 0xd5, 0xbb,
+	0xfb, 0xa9, 0xc9, 0x9f, 0xa1, 0xd0, 0x56, 0xdd, 0x0d, 0x25, 0x72, 0xc6, 0x7d, 0x81, 0x6b, 0x54,
+	0xe0, 0x63, 0x1e, 0xd8, 0xe7, 0x34, 0xa8, 0x01, 0x3b, 0xc8, 0x34, 0x8c, 0x30, 0x1e, 0xbd, 0x68,
+	0xd9, 0x79, 0xbd, 0xd5, 0x2c, 0x54, 0x0f, 0xb1, 0xb8, 0x84, 0x26, 0x4d, 0x0f, 0xc5, 0x4b, 0x52,
+	0x86, 0xe9, 0x9a, 0xc7, 0x1e, 0x22, 0x6f, 0xfa, 0x1e, 0xae, 0x7a, 0x5e, 0xd4, 0x08, 0x35, 0xe7,
+	0x44, 0x6f, 0xeb, 0xa9, 0x23, 0x45, 0x20, 0xba, 0x46, 0xef, 0x4b, 0xc9, 0xd6, 0xa8, 0xf0, 0xbd,
+	0xd5, 0x86, 0xac, 0xdb, 0x53, 0x9a, 0xd8, 0x1e, 0x1a, 0x72, 0x07, 0xec, 0x86, 0xc0, 0xd5, 0xff,
+	0x35, 0x38, 0x3e, 0x89, 0xf8, 0x6e, 0x10, 0xd1, 0x6a, 0xa5, 0x8a, 0xa1, 0xf4, 0x65, 0xcb, 0x9e,
+	0xd6, 0x6f, 0xf5, 0xd5, 0x2b, 0xae, 0xb7, 0x91, 0x72, 0xe4, 0x8f, 0xa2, 0x5d, 0x0c, 0xed, 0x19,
+	0x0d, 0x2b, 0x2b, 0x52, 0x11, 0x24, 0xb5, 0xb6, 0xe5, 0xf9, 0x7f, 0x49, 0xdc, 0xdb, 0xe7, 0xb5,
+	0xe5, 0x9e, 0x3a, 0xe7, 0x2c, 0x9c, 0x51, 0x4d, 0x93, 0x74, 0xb5, 0x53, 0x81, 0xe9, 0xb4, 0x89,
+	0xd6, 0xa8, 0xf4, 0xea, 0xa6, 0x93, 0x56, 0x60, 0x44, 0xf7, 0x77, 0xdc, 0xed, 0x97, 0xb2, 0xdd,
+	0xde, 0xd5, 0x75, 0xae, 0xd9, 0xe9, 0x04, 0x90, 0x4f, 0x35, 0x2e, 0x8a, 0x46, 0x20, 0x7b, 0x36,
+	0x64, 0x26, 0x25, 0x43, 0x9d, 0x29, 0x99, 0x86, 0x91, 0x26, 0x0d, 0x7c, 0xd3, 0x8b, 0xa3, 0xae,
+	0x59, 0x28, 0x29, 0x72, 0x1e, 0xf1, 0xb8, 0x01, 0xcd, 0xc2, 0xf9, 0x1b, 0x5c, 0xe8, 0x02, 0xde,
+	0x3e, 0xa9, 0xca, 0x9d, 0x27, 0xd5, 0x6c, 0x6f, 0xec, 0x06, 0x61, 0x72, 0x64, 0x7d, 0x6f, 0xc1,
+	0xa4, 0xd2, 0xad, 0x73, 0xa4, 0x12, 0x5d, 0x7c, 0xd6, 0x40, 0x21, 0xc9, 0xbf, 0x33, 0xf0, 0xc7,
+	0xcb, 0xf7, 0x7f, 0xdd, 0x41, 0xef, 0xb6, 0x51, 0xc4, 0x44, 0x9c, 0x87, 0x5c, 0x83, 0x09, 0xe4,
+	0x32, 0x3e, 0xff, 0xe2, 0x95, 0xea, 0x5a, 0x8f, 0x63, 0x55, 0x6c, 0x85, 0x41, 0x2b, 0xa6, 0x22,
+	0x15, 0xa8, 0x53, 0xa5, 0x89, 0xdc, 0xdf, 0x69, 0x3d, 0xe1, 0xbe, 0x44, 0x13, 0x8b, 0xa6, 0x66,
+	0xd4, 0xdd, 0xaf, 0x70, 0x9e, 0x99, 0xb0, 0x1e, 0xb3, 0xea, 0x49, 0x85, 0x55, 0xfe, 0xfa, 0x22,
+	0x4c, 0xa6, 0xc2, 0xb8, 0x89, 0xc8, 0xbb, 0x16, 0x9c, 0xda, 0xf4, 0x85, 0x24, 0x33, 0xdd, 0xe9,
+	0xd0, 0x45, 0x54, 0xd8, 0x3c, 0x2e, 0x14, 0xca, 0x89, 0x73, 0xe5, 0xff, 0xdf, 0xfd, 0xf8, 0xfe,
+	0xd0, 0x79, 0x32, 0xad, 0xc7, 0xa3, 0xe6, 0x4a, 0x3a, 0x8b, 0xf8, 0x28, 0xde, 0x1e, 0xb2, 0xc8,
+	0x3b, 0x16, 0x0c, 0xdf, 0xc3, 0xbe, 0x68, 0x8e, 0x8d, 0x13, 0x67, 0x41, 0x23, 0xb9, 0x4c, 0x2e,
+	0xf5, 0x42, 0x52, 0x7a, 0xa9, 0x56, 0xaf, 0xc8, 0x87, 0x16, 0x8c, 0xde, 0x43, 0xa9, 0x13, 0xf7,
+	0xe6, 0x21, 0x5d, 0xd3, 0x90, 0x16, 0xc8, 0x6f, 0x12, 0x48, 0xcf, 0x95, 0xdf, 0x1b, 0xbd, 0x80,
+	0x7d, 0x60, 0x41, 0x5e, 0x11, 0xea, 0x66, 0x74, 0x27, 0x93, 0xc1, 0xd9, 0x41, 0x19, 0x24, 0x5f,
+	0x5a, 0x70, 0xb1, 0x1b, 0xd7, 0x5a, 0x2b, 0x19, 0x7f, 0x4e, 0x04, 0x60, 0x59, 0x03, 0xbc, 0x4e,
+	0x96, 0x13, 0x80, 0xf1, 0x29, 0x26, 0x4a, 0x2f, 0xd3, 0x29, 0xe5, 0x55, 0x27, 0xec, 0x4f, 0x2c,
+	0x98, 0x51, 0x2f, 0xeb, 0x44, 0x9f, 0x3c, 0xa7, 0x8e, 0x86, 0x3c, 0x4b, 0x0a, 0xfd, 0x13, 0x4f,
+	0xfe, 0x03, 0xa3, 0x86, 0xd8, 0x9d, 0xbe, 0xa0, 0xf2, 0x9d, 0xe2, 0x1d, 0xe1, 0x2c, 0x69, 0xc3,
+	0x0e, 0x99, 0x1f, 0x50, 0xe4, 0x25, 0xae, 0x4c, 0x56, 0x61, 0x5c, 0x99, 0xdf, 0x5a, 0xaf, 0x3c,
+	0xa2, 0xb5, 0x23, 0x78, 0xb8, 0xae, 0x3d, 0x2c, 0x92, 0xab, 0x83, 0x3c, 0x44, 0x9e, 0x7f, 0x43,
+	0x2a, 0xb3, 0x7b, 0x26, 0x08, 0x35, 0xbf, 0x92, 0x8b, 0xfb, 0x8e, 0xff, 0xe4, 0xfa, 0x51, 0x98,
+	0xed, 0xa5, 0x6a, 0x7f, 0x1a, 0x0f, 0x15, 0x14, 0x55, 0x2e, 0xde, 0xb3, 0x60, 0xe2, 0x1e, 0xca,
+	0xf4, 0xa2, 0x40, 0xae, 0xf4, 0xb0, 0x9c, 0xbd, 0x44, 0x14, 0x9c, 0xfe, 0x1b, 0xda, 0x00, 0xfe,
+	0xa8, 0x01, 0xfc, 0xde, 0xb9, 0xd9, 0x1b, 0x80, 0x19, 0xe7, 0xb5, 0x9d, 0xc7, 0xee, 0xa6, 0x86,
+	0x52, 0x35, 0x16, 0xee, 0x58, 0xcb, 0xa4, 0xa9, 0x21, 0xdd, 0xc7, 0x60, 0x6f, 0xbd, 0x4e, 0xb9,
+	0xec, 0x4b, 0xf5, 0x5c, 0x56, 0x9c, 0x6e, 0x6f, 0x83, 0x28, 0x6a, 0x10, 0x4b, 0x64, 0x71, 0x10,
+	0x0b, 0x75, 0x0c, 0xf6, 0x3c, 0xe3, 0xe6, 0x23, 0x0b, 0x72, 0xe6, 0x23, 0x4a, 0x2e, 0x77, 0x7b,
+	0xec, 0xf8, 0xb8, 0x1e, 0xe3, 0x81, 0xf6, 0x5b, 0x53, 0xd7, 0x4e, 0xcf, 0xb3, 0xe2, 0x8e, 0xfe,
+	0x2a, 0xa9, 0x33, 0xff, 0x63, 0x0b, 0xf2, 0x09, 0x84, 0xe4, 0xdd, 0x93, 0x03, 0xe9, 0x1c, 0x0c,
+	0x92, 0x7c, 0x66, 0xc1, 0x8c, 0xf1, 0xdf, 0x79, 0x42, 0x9c, 0x20, 0xcc, 0xb8, 0xea, 0x9d, 0x01,
+	0x67, 0x44, 0x0c, 0xf6, 0x53, 0x0b, 0x72, 0x66, 0xae, 0xd8, 0x8f, 0xae, 0x63, 0xde, 0x38, 0x46,
+	0x74, 0x2b, 0xa6, 0x1a, 0x0b, 0x03, 0x7a, 0x52, 0x43, 0x79, 0x95, 0x66, 0xfd, 0x73, 0x0b, 0xf2,
+	0x09, 0x9c, 0xfe, 0x74, 0xbe, 0x29, 0xc0, 0xc5, 0xa3, 0x01, 0x26, 0x5f, 0x59, 0x30, 0x63, 0xb0,
+	0x1c, 0x58, 0x01, 0x6f, 0x0a, 0xf2, 0xef, 0x34, 0xe4, 0x62, 0x61, 0xf1, 0xa0, 0xf1, 0xa0, 0x03,
+	0x38, 0x85, 0xdc, 0x06, 0x06, 0xd8, 0x7f, 0x7e, 0xb1, 0xbb, 0xc5, 0xed, 0x23, 0x66, 0xd1, 0x8c,
+	0x48, 0xcb, 0x83, 0x46, 0x24, 0x95, 0xc9, 0x3a, 0xe4, 0x8d, 0x8b, 0x0c, 0x2b, 0x47, 0x76, 0xb6,
+	0x70, 0x08, 0x67, 0x44, 0xc0, 0x8c, 0xf1, 0xd4, 0x9d, 0x84, 0x23, 0xbb, 0x8b, 0x67, 0xad, 0xe5,
+	0x43, 0xcc, 0x5a, 0x2f, 0xe1, 0xec, 0x3f, 0xd5, 0x85, 0x87, 0x26, 0xc3, 0x3b, 0x19, 0x74, 0xeb,
+	0x1a, 0xe0, 0x33, 0x9e, 0x4c, 0x9c, 0x81, 0xdf, 0xca, 0x66, 0xec, 0x2a, 0x4e, 0xdf, 0x5b, 0x16,
+	0x4c, 0x75, 0x7a, 0xd7, 0x77, 0x2a, 0x32, 0xdf, 0x1b, 0x42, 0x7a, 0x53, 0x2c, 0x2c, 0x0c, 0xd8,
+	0xd1, 0xfd, 0x2d, 0x75, 0x2e, 0xf7, 0x84, 0xd4, 0xc6, 0x62, 0x2d, 0x77, 0x00, 0xc9, 0x5c, 0x64,
+	0x7e, 0x29, 0x17, 0xb7, 0xb5, 0xe3, 0xb2, 0xb3, 0x7c, 0x20, 0xff, 0x5d, 0x8c, 0xac, 0xdd, 0xfd,
+	0xe6, 0xf5, 0x9c, 0xf5, 0xed, 0xeb, 0x39, 0xeb, 0x87, 0xd7, 0x73, 0xd6, 0xbf, 0xfe, 0x70, 0xb8,
+	0xff, 0xa9, 0x9e, 0xfe, 0x4d, 0x93, 0x46, 0xd7, 0xda, 0xce, 0xe9, 0x5f, 0x9f, 0xb7, 0x7e, 0x1e,
+	0x00, 0xf6, 0x01, 0x59, 0x4a, 0xdf, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -867,6 +1062,8 @@ type RepositoryServiceClient interface {
 	GetWrite(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
 	// ListRepositories gets a list of all configured repositories
 	ListRepositories(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error)
+	// ListRepositoriesByProject gets a list of all repositories which can be used by applications of the given project
+	ListRepositoriesByProject(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error)
 	// ListWriteRepositories gets a list of all configured write repositories
 	ListWriteRepositories(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error)
 	ListRefs(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*apiclient.Refs, error)
@@ -897,6 +1094,8 @@ type RepositoryServiceClient interface {
 	DeleteWriteRepository(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*RepoResponse, error)
 	// ValidateAccess validates access to a repository with given parameters
 	ValidateAccess(ctx context.Context, in *RepoAccessQuery, opts ...grpc.CallOption) (*RepoResponse, error)
+	// ValidateAccessBatch validates access to several repositories concurrently with given parameters, and returns the result for each repository
+	ValidateAccessBatch(ctx context.Context, in *RepoAccessBatchQuery, opts ...grpc.CallOption) (*RepoAccessBatchResponse, error)
 	// ValidateWriteAccess validates write access to a repository with given parameters
 	ValidateWriteAccess(ctx context.Context, in *RepoAccessQuery, opts ...grpc.CallOption) (*RepoResponse, error)
 }
@@ -946,6 +1145,15 @@ func (c *repositoryServiceClient) ListRepositories(ctx context.Context, in *Repo
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ListRepositoriesByProject(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error) {
+	out := new(v1alpha1.RepositoryList)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListRepositoriesByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) ListWriteRepositories(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error) {
 	out := new(v1alpha1.RepositoryList)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListWriteRepositories", in, out, opts...)
@@ -1093,6 +1301,15 @@ func (c *repositoryServiceClient) ValidateAccess(ctx context.Context, in *RepoAc
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ValidateAccessBatch(ctx context.Context, in *RepoAccessBatchQuery, opts ...grpc.CallOption) (*RepoAccessBatchResponse, error) {
+	out := new(RepoAccessBatchResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ValidateAccessBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) ValidateWriteAccess(ctx context.Context, in *RepoAccessQuery, opts ...grpc.CallOption) (*RepoResponse, error) {
 	out := new(RepoResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ValidateWriteAccess", in, out, opts...)
@@ -1112,6 +1329,8 @@ type RepositoryServiceServer interface {
 	GetWrite(context.Context, *RepoQuery) (*v1alpha1.Repository, error)
 	// ListRepositories gets a list of all configured repositories
 	ListRepositories(context.Context, *RepoQuery) (*v1alpha1.RepositoryList, error)
+	// ListRepositoriesByProject gets a list of all repositories which can be used by applications of the given project
+	ListRepositoriesByProject(context.Context, *RepoQuery) (*v1alpha1.RepositoryList, error)
 	// ListWriteRepositories gets a list of all configured write repositories
 	ListWriteRepositories(context.Context, *RepoQuery) (*v1alpha1.RepositoryList, error)
 	ListRefs(context.Context, *RepoQuery) (*apiclient.Refs, error)
@@ -1142,6 +1361,8 @@ type RepositoryServiceServer interface {
 	DeleteWriteRepository(context.Context, *RepoQuery) (*RepoResponse, error)
 	// ValidateAccess validates access to a repository with given parameters
 	ValidateAccess(context.Context, *RepoAccessQuery) (*RepoResponse, error)
+	// ValidateAccessBatch validates access to several repositories concurrently with given parameters, and returns the result for each repository
+	ValidateAccessBatch(context.Context, *RepoAccessBatchQuery) (*RepoAccessBatchResponse, error)
 	// ValidateWriteAccess validates write access to a repository with given parameters
 	ValidateWriteAccess(context.Context, *RepoAccessQuery) (*RepoResponse, error)
 }
@@ -1162,6 +1383,9 @@ func (*UnimplementedRepositoryServiceServer) GetWrite(ctx context.Context, req *
 func (*UnimplementedRepositoryServiceServer) ListRepositories(ctx context.Context, req *RepoQuery) (*v1alpha1.RepositoryList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRepositories not implemented")
 }
+func (*UnimplementedRepositoryServiceServer) ListRepositoriesByProject(ctx context.Context, req *RepoQuery) (*v1alpha1.RepositoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepositoriesByProject not implemented")
+}
 func (*UnimplementedRepositoryServiceServer) ListWriteRepositories(ctx context.Context, req *RepoQuery) (*v1alpha1.RepositoryList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWriteRepositories not implemented")
 }
@@ -1210,6 +1434,9 @@ func (*UnimplementedRepositoryServiceServer) DeleteWriteRepository(ctx context.C
 func (*UnimplementedRepositoryServiceServer) ValidateAccess(ctx context.Context, req *RepoAccessQuery) (*RepoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateAccess not implemented")
 }
+func (*UnimplementedRepositoryServiceServer) ValidateAccessBatch(ctx context.Context, req *RepoAccessBatchQuery) (*RepoAccessBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAccessBatch not implemented")
+}
 func (*UnimplementedRepositoryServiceServer) ValidateWriteAccess(ctx context.Context, req *RepoAccessQuery) (*RepoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateWriteAccess not implemented")
 }
@@ -1290,6 +1517,24 @@ func _RepositoryService_ListRepositories_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ListRepositoriesByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListRepositoriesByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/ListRepositoriesByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListRepositoriesByProject(ctx, req.(*RepoQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_ListWriteRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoQuery)
 	if err := dec(in); err != nil {
@@ -1578,6 +1823,24 @@ func _RepositoryService_ValidateAccess_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ValidateAccessBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoAccessBatchQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ValidateAccessBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/ValidateAccessBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ValidateAccessBatch(ctx, req.(*RepoAccessBatchQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_ValidateWriteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoAccessQuery)
 	if err := dec(in); err != nil {
@@ -1616,6 +1879,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListRepositories",
 			Handler:    _RepositoryService_ListRepositories_Handler,
 		},
+		{
+			MethodName: "ListRepositoriesByProject",
+			Handler:    _RepositoryService_ListRepositoriesByProject_Handler,
+		},
 		{
 			MethodName: "ListWriteRepositories",
 			Handler:    _RepositoryService_ListWriteRepositories_Handler,
@@ -1680,6 +1947,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateAccess",
 			Handler:    _RepositoryService_ValidateAccess_Handler,
 		},
+		{
+			MethodName: "ValidateAccessBatch",
+			Handler:    _RepositoryService_ValidateAccessBatch_Handler,
+		},
 		{
 			MethodName: "ValidateWriteAccess",
 			Handler:    _RepositoryService_ValidateWriteAccess_Handler,
@@ -2166,7 +2437,7 @@ func (m *RepoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RepoCreateRequest) Marshal() (dAtA []byte, err error) {
+func (m *RepoAccessBatchQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2176,12 +2447,12 @@ func (m *RepoCreateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoAccessBatchQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepoCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoAccessBatchQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2190,42 +2461,82 @@ func (m *RepoCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CredsOnly {
-		i--
-		if m.CredsOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Repos) > 0 {
+		for iNdEx := len(m.Repos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Repos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoAccessResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoAccessResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoAccessResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
-	if m.Upsert {
+	if m.Valid {
 		i--
-		if m.Upsert {
+		if m.Valid {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRepository(dAtA, i, uint64(size))
-		}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RepoUpdateRequest) Marshal() (dAtA []byte, err error) {
+func (m *RepoAccessBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2235,12 +2546,12 @@ func (m *RepoUpdateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoAccessBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepoUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoAccessBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2249,31 +2560,141 @@ func (m *RepoUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Repo != nil {
-		{
-			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintRepository(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRepository(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *RepoCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.VerifyWriteAccess {
+		i--
+		if m.VerifyWriteAccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CredsOnly {
+		i--
+		if m.CredsOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Upsert {
+		i--
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRepository(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *RepoAppsQuery) Size() (n int) {
 	if m == nil {
@@ -2495,6 +2916,69 @@ func (m *RepoResponse) Size() (n int) {
 	return n
 }
 
+func (m *RepoAccessBatchQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoAccessResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoAccessBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RepoCreateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2511,6 +2995,9 @@ func (m *RepoCreateRequest) Size() (n int) {
 	if m.CredsOnly {
 		n += 2
 	}
+	if m.VerifyWriteAccess {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3930,6 +4417,343 @@ func (m *RepoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RepoAccessBatchQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAccessBatchQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAccessBatchQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, &RepoAccessQuery{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAccessResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAccessResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAccessResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAccessBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAccessBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAccessBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &RepoAccessResult{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RepoCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4035,6 +4859,26 @@ func (m *RepoCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CredsOnly = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyWriteAccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyWriteAccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
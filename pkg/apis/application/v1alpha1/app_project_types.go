@@ -2,9 +2,12 @@ package v1alpha1
 
 import (
 	"fmt"
+	"path"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	globutil "github.com/gobwas/glob"
 	"github.com/google/go-cmp/cmp"
@@ -172,10 +175,17 @@ func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 //   - SourceRepos:
 //   - Rejects invalid wildcard formats like "!*"
 //   - Must be unique
+//   - SourcePaths:
+//   - Repository URLs must not be empty or negated, and must be unique
+//   - Each restriction must have at least one path, and paths must compile as valid glob patterns
 //   - Roles:
 //   - Role names must be unique and valid
 //   - Policies within a role must be unique and valid for the project/role
 //   - Groups within a role must be unique and have valid names
+//   - RoleBindings:
+//   - Binding names must be unique and valid
+//   - Each binding must reference an existing role and have at least one group
+//   - Groups within a binding must be unique and have valid names
 //   - SyncWindows:
 //   - Each window must have a unique identity hash
 //   - Each window must validate successfully
@@ -231,6 +241,29 @@ func (proj *AppProject) ValidateProject() error {
 		srcRepos[src] = true
 	}
 
+	srcPathRepos := make(map[string]bool)
+	for _, restriction := range proj.Spec.SourcePaths {
+		if restriction.RepoURL == "" || isDenyPattern(restriction.RepoURL) {
+			return status.Errorf(codes.InvalidArgument, "source path restriction has an invalid repository format, '%s'", restriction.RepoURL)
+		}
+		if _, ok := srcPathRepos[restriction.RepoURL]; ok {
+			return status.Errorf(codes.InvalidArgument, "source path restriction for repository '%s' already added", restriction.RepoURL)
+		}
+		if len(restriction.Paths) == 0 {
+			return status.Errorf(codes.InvalidArgument, "source path restriction for repository '%s' must have at least one path", restriction.RepoURL)
+		}
+		for _, p := range restriction.Paths {
+			pattern := strings.TrimPrefix(p, "!")
+			if pattern == "" {
+				return status.Errorf(codes.InvalidArgument, "source path has an invalid format, '%s'", p)
+			}
+			if _, err := globutil.Compile(normalizeSourcePath(pattern), '/'); err != nil {
+				return status.Errorf(codes.InvalidArgument, "source path has an invalid format, '%s'", p)
+			}
+		}
+		srcPathRepos[restriction.RepoURL] = true
+	}
+
 	roleNames := make(map[string]bool)
 	for _, role := range proj.Spec.Roles {
 		if _, ok := roleNames[role.Name]; ok {
@@ -262,6 +295,33 @@ func (proj *AppProject) ValidateProject() error {
 		roleNames[role.Name] = true
 	}
 
+	bindingNames := make(map[string]bool)
+	for _, binding := range proj.Spec.RoleBindings {
+		if _, ok := bindingNames[binding.Name]; ok {
+			return status.Errorf(codes.AlreadyExists, "role binding '%s' already exists", binding.Name)
+		}
+		if err := validateRoleName(binding.Name); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid role binding name '%s'. Must consist of alphanumeric characters, '-' or '_', and must start and end with an alphanumeric character", binding.Name)
+		}
+		if !roleNames[binding.Role] {
+			return status.Errorf(codes.InvalidArgument, "role binding '%s' references non-existent role '%s'", binding.Name, binding.Role)
+		}
+		if len(binding.Groups) == 0 {
+			return status.Errorf(codes.InvalidArgument, "role binding '%s' must have at least one group", binding.Name)
+		}
+		existingGroups := make(map[string]bool)
+		for _, group := range binding.Groups {
+			if _, ok := existingGroups[group]; ok {
+				return status.Errorf(codes.AlreadyExists, "group '%s' already exists for role binding '%s'", group, binding.Name)
+			}
+			if err := validateGroupName(group); err != nil {
+				return err
+			}
+			existingGroups[group] = true
+		}
+		bindingNames[binding.Name] = true
+	}
+
 	if proj.Spec.SyncWindows.HasWindows() {
 		existingWindows := make(map[uint64]bool)
 		for _, window := range proj.Spec.SyncWindows {
@@ -321,6 +381,23 @@ func (proj *AppProject) ValidateProject() error {
 	return nil
 }
 
+// GetRoleGroups returns the OIDC groups of the role, including the groups of role bindings which have not expired
+func (proj *AppProject) GetRoleGroups(role *ProjectRole) []string {
+	groups := append([]string{}, role.Groups...)
+	now := time.Now()
+	for _, binding := range proj.Spec.RoleBindings {
+		if binding.Role != role.Name || binding.IsExpired(now) {
+			continue
+		}
+		for _, group := range binding.Groups {
+			if !slices.Contains(groups, group) {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return groups
+}
+
 // RoleGroupExists checks if a group exists in the role
 func RoleGroupExists(role *ProjectRole) bool {
 	return len(role.Groups) != 0
@@ -389,7 +466,7 @@ func (proj *AppProject) ProjectPoliciesString() string {
 		projectPolicy := fmt.Sprintf("p, proj:%s:%s, projects, get, %s, allow", proj.Name, role.Name, proj.Name)
 		policies = append(policies, projectPolicy)
 		policies = append(policies, role.Policies...)
-		for _, groupName := range role.Groups {
+		for _, groupName := range proj.GetRoleGroups(&role) {
 			policies = append(policies, fmt.Sprintf("g, %s, proj:%s:%s", groupName, proj.Name, role.Name))
 		}
 	}
@@ -479,6 +556,87 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 	return anySourceMatched
 }
 
+// IsSourcePathPermitted validates if the path of the provided application's source is permitted by the source path
+// restrictions of the project which apply to its repository. Helm chart sources and sources which are only referenced
+// by other sources do not use a path and are always permitted.
+func (proj AppProject) IsSourcePathPermitted(src ApplicationSource) bool {
+	if len(proj.Spec.SourcePaths) == 0 || src.IsHelm() || (src.IsRef() && src.Path == "") {
+		return true
+	}
+	return proj.IsRepoPathPermitted(src.RepoURL, src.Path)
+}
+
+// IsRepoPathPermitted validates if the provided path, relative to the root of the provided repository, is permitted by
+// the source path restrictions of the project which apply to the repository. It is used for all paths read during
+// manifest generation, such as value files and the resources of kustomizations, not only the path of the source.
+func (proj AppProject) IsRepoPathPermitted(repoURL string, repoPath string) bool {
+	repoNormalized := git.NormalizeGitURL(repoURL)
+	normalizedPath := normalizeSourcePath(repoPath)
+
+	// every restriction which applies to the repository must permit the path
+	for _, restriction := range proj.Spec.SourcePaths {
+		if !globMatch(git.NormalizeGitURL(restriction.RepoURL), repoNormalized, false, '/') {
+			continue
+		}
+		if !isSourcePathMatched(restriction.Paths, normalizedPath) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetSourcePaths returns the source path restrictions of the project in the form used by manifest generation requests
+func (proj AppProject) GetSourcePaths() []*SourcePathRestriction {
+	if len(proj.Spec.SourcePaths) == 0 {
+		return nil
+	}
+	restrictions := make([]*SourcePathRestriction, 0, len(proj.Spec.SourcePaths))
+	for i := range proj.Spec.SourcePaths {
+		restrictions = append(restrictions, &proj.Spec.SourcePaths[i])
+	}
+	return restrictions
+}
+
+// isSourcePathMatched returns whether the path matches at least one of the allow patterns and none of the deny
+// patterns. If there are no allow patterns, every path which does not match a deny pattern is matched.
+func isSourcePathMatched(patterns []string, srcPath string) bool {
+	anyAllowPattern := false
+	anyAllowPatternMatched := false
+	for _, pattern := range patterns {
+		if isDenyPattern(pattern) {
+			if sourcePathMatch(pattern[1:], srcPath) {
+				return false
+			}
+			continue
+		}
+		anyAllowPattern = true
+		if sourcePathMatch(pattern, srcPath) {
+			anyAllowPatternMatched = true
+		}
+	}
+	return anyAllowPatternMatched || !anyAllowPattern
+}
+
+// sourcePathMatch matches a path against a glob pattern, in which '*' does not match '/' but '**' does. A pattern
+// ending with "/**" also matches the directory itself, e.g. "apps/team-a/**" matches "apps/team-a".
+func sourcePathMatch(pattern string, srcPath string) bool {
+	pattern = normalizeSourcePath(pattern)
+	if dir, ok := strings.CutSuffix(pattern, "/**"); ok && normalizeSourcePath(dir) == srcPath {
+		return true
+	}
+	return glob.Match(pattern, srcPath, '/')
+}
+
+// normalizeSourcePath cleans a path relative to the repository root, so that equivalent paths, e.g. "./apps/" and
+// "apps", are matched identically and relative path elements cannot escape the restrictions. The root is ".".
+func normalizeSourcePath(p string) string {
+	p = strings.TrimPrefix(path.Clean("/"+p), "/")
+	if p == "" {
+		return "."
+	}
+	return p
+}
+
 // IsDestinationPermitted validates if the provided application's destination is one of the allowed destinations for the project
 func (proj AppProject) IsDestinationPermitted(destCluster *Cluster, destNamespace string, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
 	if destCluster == nil {
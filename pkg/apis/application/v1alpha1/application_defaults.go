@@ -6,6 +6,12 @@ const (
 	DefaultSyncRetryMaxDuration time.Duration = 180000000000 // 3m0s
 	DefaultSyncRetryDuration    time.Duration = 5000000000   // 5s
 	DefaultSyncRetryFactor                    = int64(2)
+	// DefaultFlapDetectionWindow is the default period in which the transitions of an application to Degraded are counted
+	DefaultFlapDetectionWindow time.Duration = 3600000000000 // 1h0m0s
+	// DefaultSyncVerificationInterval is the default delay between attempts of a post-sync verification probe
+	DefaultSyncVerificationInterval time.Duration = 5000000000 // 5s
+	// DefaultSyncVerificationTimeout is the default timeout of a single attempt of a post-sync verification probe
+	DefaultSyncVerificationTimeout time.Duration = 10000000000 // 10s
 	// ResourcesFinalizerName is the finalizer value which we inject to finalize deletion of an application
 	ResourcesFinalizerName string = "resources-finalizer.argocd.argoproj.io"
 
@@ -18,9 +18,14 @@ package v1alpha1
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
 	"sort"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/robfig/cron/v3"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -78,11 +83,160 @@ type ApplicationSetSpec struct {
 	ApplyNestedSelectors         bool                            `json:"applyNestedSelectors,omitempty" protobuf:"bytes,8,name=applyNestedSelectors"`
 	IgnoreApplicationDifferences ApplicationSetIgnoreDifferences `json:"ignoreApplicationDifferences,omitempty" protobuf:"bytes,9,name=ignoreApplicationDifferences"`
 	TemplatePatch                *string                         `json:"templatePatch,omitempty" protobuf:"bytes,10,name=templatePatch"`
+	// UniqueAnnotations is a list of annotation keys of the generated Applications, e.g. holding hostnames or URLs, whose
+	// values must be unique across all generated Applications and existing Applications. A value may contain a
+	// comma-separated list of hostnames, each of which must be unique. Generated Applications with conflicting values
+	// are not created or updated.
+	UniqueAnnotations []string `json:"uniqueAnnotations,omitempty" protobuf:"bytes,11,name=uniqueAnnotations"`
+	// ProvenanceLabels enables labels on the generated Applications identifying the ApplicationSet, the generator and
+	// the parameters each Application was generated from.
+	ProvenanceLabels *ApplicationSetProvenanceLabels `json:"provenanceLabels,omitempty" protobuf:"bytes,12,opt,name=provenanceLabels"`
+	// IgnoreMissingTemplateKeys renders references of the template and the template patch to parameters that are not
+	// provided by a generator as empty strings instead of failing the generation. The missing parameters are reported in
+	// the ResourcesUpToDate condition of the ApplicationSet.
+	IgnoreMissingTemplateKeys bool `json:"ignoreMissingTemplateKeys,omitempty" protobuf:"bytes,13,opt,name=ignoreMissingTemplateKeys"`
+	// TemplatePatchScript is a CEL expression evaluated for each generated Application, after the template and the
+	// template patch are rendered. The expression has access to the parameters (params) and the rendered Application
+	// (app), and evaluates to a patch applied to the Application like templatePatch.
+	TemplatePatchScript *string `json:"templatePatchScript,omitempty" protobuf:"bytes,14,opt,name=templatePatchScript"`
+	// SyncWindows are windows of time in which the ApplicationSet controller is allowed or denied to create, update and
+	// delete the Applications of the ApplicationSet, and to promote the Applications of its RollingSync steps. Like the
+	// sync windows of projects, the changes are denied during deny windows, and outside of the allow windows if any. The
+	// denied changes are deferred until the windows allow them.
+	SyncWindows []ApplicationSetSyncWindow `json:"syncWindows,omitempty" protobuf:"bytes,15,rep,name=syncWindows"`
+}
+
+// ApplicationSetSyncWindow is a window of time in which the changes of the Applications of an ApplicationSet are
+// allowed or denied
+type ApplicationSetSyncWindow struct {
+	// Kind defines if the window allows or denies the changes
+	// +kubebuilder:validation:Enum=allow;deny
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Schedule is the time the window begins, specified in cron format
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Duration is the amount of time the window is open, e.g. 1h
+	Duration string `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// TimeZone of the schedule, UTC by default
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,4,opt,name=timeZone"`
+	// Description of the window, e.g. a ticket number
+	Description string `json:"description,omitempty" protobuf:"bytes,5,opt,name=description"`
+}
+
+// maxSyncWindowTransitions is the number of openings and closings of the sync windows of an ApplicationSet searched for
+// the next window allowing the changes
+const maxSyncWindowTransitions = 100
+
+// toSyncWindows returns the sync windows of the ApplicationSet as project sync windows, which apply to all the
+// Applications
+func (spec *ApplicationSetSpec) toSyncWindows() *SyncWindows {
+	windows := make(SyncWindows, 0, len(spec.SyncWindows))
+	for _, w := range spec.SyncWindows {
+		windows = append(windows, &SyncWindow{
+			Kind:        w.Kind,
+			Schedule:    w.Schedule,
+			Duration:    w.Duration,
+			TimeZone:    w.TimeZone,
+			Description: w.Description,
+		})
+	}
+	return &windows
+}
+
+// SyncWindowsAllowChanges returns whether the sync windows of the ApplicationSet allow the changes of its Applications
+// at the given time
+func (spec *ApplicationSetSpec) SyncWindowsAllowChanges(currentTime time.Time) (bool, error) {
+	return spec.toSyncWindows().canSync(false, currentTime)
+}
+
+// NextSyncWindow returns the time at which the sync windows of the ApplicationSet next allow the changes of its
+// Applications, or nil if they are allowed at the given time, or are not allowed in the foreseeable future
+func (spec *ApplicationSetSpec) NextSyncWindow(currentTime time.Time) (*time.Time, error) {
+	windows := spec.toSyncWindows()
+	allowed, err := windows.canSync(false, currentTime)
+	if err != nil || allowed {
+		return nil, err
+	}
+
+	t := currentTime
+	for i := 0; i < maxSyncWindowTransitions; i++ {
+		next, err := windows.nextTransition(t)
+		if err != nil || next == nil {
+			return nil, err
+		}
+		t = *next
+		// the windows are active after the minute they begin in
+		allowed, err := windows.canSync(false, t.Add(time.Second))
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			return &t, nil
+		}
+	}
+	return nil, nil
+}
+
+// nextTransition returns the first time after the given time at which one of the sync windows begins or ends
+func (w *SyncWindows) nextTransition(currentTime time.Time) (*time.Time, error) {
+	currentTime = currentTime.In(time.UTC)
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+	var next *time.Time
+	for _, window := range *w {
+		schedule, err := specParser.Parse(window.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse schedule '%s': %w", window.Schedule, err)
+		}
+		duration, err := time.ParseDuration(window.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse duration '%s': %w", window.Duration, err)
+		}
+
+		// The schedule is in the time zone of the window, the transitions are in UTC
+		offset := window.scheduleOffsetByTimeZone()
+		start := schedule.Next(currentTime.Add(offset - duration)).Add(-offset)
+		nextStart := schedule.Next(currentTime.Add(offset)).Add(-offset)
+		for _, transition := range []time.Time{start, start.Add(duration), nextStart} {
+			if transition.After(currentTime) && (next == nil || transition.Before(*next)) {
+				next = &transition
+			}
+		}
+	}
+	return next, nil
+}
+
+// ApplicationSetSyncWindowsStatus is the state of the sync windows of an ApplicationSet
+type ApplicationSetSyncWindowsStatus struct {
+	// Blocked is whether the sync windows currently deny the changes of the Applications of the ApplicationSet
+	Blocked bool `json:"blocked" protobuf:"varint,1,opt,name=blocked"`
+	// NextWindow is the time at which the sync windows next allow the changes, if they are blocked
+	NextWindow *metav1.Time `json:"nextWindow,omitempty" protobuf:"bytes,2,opt,name=nextWindow"`
+}
+
+// ApplicationSetProvenanceLabels configures the provenance labels of the generated Applications
+type ApplicationSetProvenanceLabels struct {
+	// Prefix is the prefix of the label keys, e.g. `example.com/`. Defaults to `applicationset.argoproj.io/`.
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,1,opt,name=prefix"`
+}
+
+// DefaultApplicationSetProvenanceLabelPrefix is the default prefix of the provenance labels of generated Applications
+const DefaultApplicationSetProvenanceLabelPrefix = "applicationset.argoproj.io/"
+
+// GetPrefix returns the prefix of the provenance label keys
+func (p *ApplicationSetProvenanceLabels) GetPrefix() string {
+	if p.Prefix == "" {
+		return DefaultApplicationSetProvenanceLabelPrefix
+	}
+	return p.Prefix
 }
 
 type ApplicationPreservedFields struct {
 	Annotations []string `json:"annotations,omitempty" protobuf:"bytes,1,name=annotations"`
 	Labels      []string `json:"labels,omitempty" protobuf:"bytes,2,name=labels"`
+	// Paths is a list of dot-separated paths within the Application spec (e.g. spec.source.targetRevision or
+	// spec.syncPolicy) whose current value in the cluster is kept when the Application is updated. They are set from the
+	// template when the Application is created, and afterwards only when the template is reapplied.
+	Paths []string `json:"paths,omitempty" protobuf:"bytes,3,name=paths"`
 }
 
 // ApplicationSetStrategy configures how generated Applications are updated in sequence.
@@ -92,14 +246,169 @@ type ApplicationSetStrategy struct {
 	// DeletionOrder allows specifying the order for deleting generated apps when progressive sync is enabled.
 	// accepts values "AllAtOnce" and "Reverse"
 	DeletionOrder string `json:"deletionOrder,omitempty" protobuf:"bytes,3,opt,name=deletionOrder"`
+	// Canary configures the Canary strategy, which syncs a share of the generated Applications first
+	Canary *ApplicationSetCanaryStrategy `json:"canary,omitempty" protobuf:"bytes,4,opt,name=canary"`
+}
+
+// ApplicationSetCanaryStrategy syncs a share of the generated Applications first, and the remaining Applications once
+// the canary Applications are Healthy and have soaked for the configured duration
+type ApplicationSetCanaryStrategy struct {
+	// Weight is the number or percentage of the generated Applications which are synced first. Defaults to 10%.
+	Weight *intstr.IntOrString `json:"weight,omitempty" protobuf:"bytes,1,opt,name=weight"`
+	// SoakDuration is the duration the canary Applications must stay Healthy before the remaining Applications are
+	// synced, e.g. "10m". Defaults to no soak time.
+	SoakDuration string `json:"soakDuration,omitempty" protobuf:"bytes,2,opt,name=soakDuration"`
+}
+
+// DefaultCanaryWeight is the share of the generated Applications which are synced first, if no weight is configured
+var DefaultCanaryWeight = intstr.FromString("10%")
+
+// GetWeight returns the number or percentage of the generated Applications which are synced first
+func (c *ApplicationSetCanaryStrategy) GetWeight() intstr.IntOrString {
+	if c.Weight == nil {
+		return DefaultCanaryWeight
+	}
+	return *c.Weight
 }
+
+// GetSoakDuration returns the duration the canary Applications must stay Healthy before the remaining Applications
+// are synced
+func (c *ApplicationSetCanaryStrategy) GetSoakDuration() (time.Duration, error) {
+	if c.SoakDuration == "" {
+		return 0, nil
+	}
+	soakDuration, err := time.ParseDuration(c.SoakDuration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid canary soakDuration %q: %w", c.SoakDuration, err)
+	}
+	return soakDuration, nil
+}
+
 type ApplicationSetRolloutStrategy struct {
 	Steps []ApplicationSetRolloutStep `json:"steps,omitempty" protobuf:"bytes,1,opt,name=steps"`
+	// RollbackOnFailure rolls back the Applications of a step to their previously healthy revisions and pauses the
+	// rollout, if an Application of the step stays Degraded for longer than the timeout
+	RollbackOnFailure *ApplicationSetRollbackOnFailure `json:"rollbackOnFailure,omitempty" protobuf:"bytes,2,opt,name=rollbackOnFailure"`
+	// MaxConcurrentPerCluster is the maximum number of ApplicationSets, including this one, which may roll out to a
+	// destination cluster at the same time. Only the ApplicationSets setting it are accounted for. Unlimited if unset.
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentPerCluster int32 `json:"maxConcurrentPerCluster,omitempty" protobuf:"varint,3,opt,name=maxConcurrentPerCluster"`
+}
+
+// ApplicationSetRollbackOnFailure configures the rollback of failed RollingSync steps
+type ApplicationSetRollbackOnFailure struct {
+	// Timeout is the duration an Application of a step may stay Degraded before the step is rolled back, e.g. "10m".
+	// Defaults to 5m.
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,1,opt,name=timeout"`
+}
+
+// DefaultRollbackOnFailureTimeout is the duration an Application may stay Degraded before its step is rolled back,
+// if no timeout is configured
+const DefaultRollbackOnFailureTimeout = 5 * time.Minute
+
+// GetTimeout returns the duration an Application of a step may stay Degraded before the step is rolled back
+func (r *ApplicationSetRollbackOnFailure) GetTimeout() (time.Duration, error) {
+	if r.Timeout == "" {
+		return DefaultRollbackOnFailureTimeout, nil
+	}
+	timeout, err := time.ParseDuration(r.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rollbackOnFailure timeout %q: %w", r.Timeout, err)
+	}
+	return timeout, nil
 }
 
 type ApplicationSetRolloutStep struct {
 	MatchExpressions []ApplicationMatchExpression `json:"matchExpressions,omitempty" protobuf:"bytes,1,opt,name=matchExpressions"`
 	MaxUpdate        *intstr.IntOrString          `json:"maxUpdate,omitempty" protobuf:"bytes,2,opt,name=maxUpdate"`
+	// Analysis must pass once the Applications of the step are Healthy, before the next step is synced
+	Analysis *ApplicationSetStepAnalysis `json:"analysis,omitempty" protobuf:"bytes,3,opt,name=analysis"`
+	// PauseAfter holds the Applications of the following steps in Waiting once the Applications of the step are Healthy,
+	// until the step is resumed, e.g. with `argocd appset resume APPSETNAME --step N`
+	PauseAfter bool `json:"pauseAfter,omitempty" protobuf:"varint,4,opt,name=pauseAfter"`
+	// Timeout is the duration an Application of the step may stay Pending or Progressing before its status is set to
+	// Failed, e.g. "15m". Applications of the step do not time out if it is empty.
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,5,opt,name=timeout"`
+	// FailurePolicy determines how the rollout proceeds once an Application of the step is Failed. Halt, the default,
+	// holds back the following steps until the Application is Healthy or its target revisions change. Continue
+	// proceeds with the following steps once the other Applications of the step are completed.
+	// +kubebuilder:validation:Enum=Halt;Continue
+	FailurePolicy ApplicationSetRolloutFailurePolicy `json:"failurePolicy,omitempty" protobuf:"bytes,6,opt,name=failurePolicy,casttype=ApplicationSetRolloutFailurePolicy"`
+}
+
+// ApplicationSetRolloutFailurePolicy determines how a RollingSync proceeds once an Application of a step is Failed
+type ApplicationSetRolloutFailurePolicy string
+
+const (
+	// ApplicationSetRolloutFailurePolicyHalt holds back the following steps while an Application of the step is Failed
+	ApplicationSetRolloutFailurePolicyHalt ApplicationSetRolloutFailurePolicy = "Halt"
+	// ApplicationSetRolloutFailurePolicyContinue proceeds with the following steps regardless of the Failed
+	// Applications of the step
+	ApplicationSetRolloutFailurePolicyContinue ApplicationSetRolloutFailurePolicy = "Continue"
+)
+
+// GetTimeout returns the duration an Application of the step may stay Pending or Progressing before it is Failed, or
+// 0 if the Applications of the step do not time out
+func (s *ApplicationSetRolloutStep) GetTimeout() (time.Duration, error) {
+	if s.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(s.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid step timeout %q: %w", s.Timeout, err)
+	}
+	return timeout, nil
+}
+
+// ApplicationSetStepAnalysis configures the analysis gating a RollingSync step. Exactly one of CronJob and Prometheus
+// must be set.
+type ApplicationSetStepAnalysis struct {
+	// CronJob is the name of a CronJob in the namespace of the ApplicationSet whose job template is run once the
+	// Applications of the step are Healthy. The analysis passes if the Job completes, and fails if the Job fails. The
+	// CronJob is usually suspended, so that it is only run by the ApplicationSet controller.
+	CronJob string `json:"cronJob,omitempty" protobuf:"bytes,1,opt,name=cronJob"`
+	// Prometheus configures a Prometheus query which is evaluated once the Applications of the step are Healthy
+	Prometheus *ApplicationSetPrometheusAnalysis `json:"prometheus,omitempty" protobuf:"bytes,2,opt,name=prometheus"`
+}
+
+// ApplicationSetPrometheusAnalysis configures a Prometheus query gating a RollingSync step. The analysis passes if the
+// query returns at least one sample and every sample is non-zero, and fails otherwise.
+type ApplicationSetPrometheusAnalysis struct {
+	// Address is the URL of the Prometheus server, e.g. http://prometheus.monitoring:9090
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Query is the PromQL query which is evaluated, e.g. sum(rate(http_requests_total{code=~"5.."}[5m])) < 1
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
+}
+
+// ApplicationSetStepAnalysisPhase is the phase of the analysis of a RollingSync step
+type ApplicationSetStepAnalysisPhase string
+
+const (
+	// ApplicationSetStepAnalysisRunning indicates that the analysis has not completed yet
+	ApplicationSetStepAnalysisRunning ApplicationSetStepAnalysisPhase = "Running"
+	// ApplicationSetStepAnalysisSuccessful indicates that the analysis passed, which allows the next step to be synced
+	ApplicationSetStepAnalysisSuccessful ApplicationSetStepAnalysisPhase = "Successful"
+	// ApplicationSetStepAnalysisFailed indicates that the analysis failed, which pauses the rollout until the target
+	// revisions change
+	ApplicationSetStepAnalysisFailed ApplicationSetStepAnalysisPhase = "Failed"
+)
+
+// ApplicationSetStepAnalysisStatus is the outcome of the analysis of the step of an Application
+type ApplicationSetStepAnalysisStatus struct {
+	// Name identifies the analysis run. It changes whenever the Applications of the step or their target revisions
+	// change, in which case the analysis is run again.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Phase is the phase of the analysis run
+	Phase ApplicationSetStepAnalysisPhase `json:"phase" protobuf:"bytes,2,opt,name=phase"`
+	// Message contains human-readable details about the analysis run
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// StartedAt is the time the analysis run was started
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,4,opt,name=startedAt"`
+}
+
+// IsCompleted returns true if the analysis run has either passed or failed
+func (s *ApplicationSetStepAnalysisStatus) IsCompleted() bool {
+	return s.Phase == ApplicationSetStepAnalysisSuccessful || s.Phase == ApplicationSetStepAnalysisFailed
 }
 
 type ApplicationMatchExpression struct {
@@ -141,6 +450,10 @@ type ApplicationSetSyncPolicy struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=create-only;create-update;create-delete;sync
 	ApplicationsSync *ApplicationsSyncPolicy `json:"applicationsSync,omitempty" protobuf:"bytes,2,opt,name=applicationsSync,casttype=ApplicationsSyncPolicy"`
+	// AdoptExistingApplications lets the ApplicationSet take ownership of existing Applications which are not managed by
+	// an ApplicationSet, if their name and destination match a generated Application. Otherwise, such Applications are
+	// left untouched and reported in the conditions of the ApplicationSet.
+	AdoptExistingApplications bool `json:"adoptExistingApplications,omitempty" protobuf:"varint,3,opt,name=adoptExistingApplications"`
 }
 
 // ApplicationSetIgnoreDifferences configures how the ApplicationSet controller will ignore differences in live
@@ -207,6 +520,21 @@ type ApplicationSetGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	// Transform allows to filter and derive the parameters of the generator before they are used in the template.
+	Transform *ApplicationSetGeneratorTransform `json:"transform,omitempty" protobuf:"bytes,11,name=transform"`
+
+	Terraform *TerraformGenerator `json:"terraform,omitempty" protobuf:"bytes,12,name=terraform"`
+
+	CloudInventory *CloudInventoryGenerator `json:"cloudInventory,omitempty" protobuf:"bytes,13,name=cloudInventory"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,14,name=kubernetesResource"`
+
+	OCI *OCIGenerator `json:"oci,omitempty" protobuf:"bytes,15,name=oci"`
+
+	// RequeueStrategy configures when the ApplicationSet is reconciled again after the generator generated parameters or
+	// failed to.
+	RequeueStrategy *ApplicationSetGeneratorRequeueStrategy `json:"requeueStrategy,omitempty" protobuf:"bytes,16,opt,name=requeueStrategy"`
 }
 
 // ApplicationSetNestedGenerator represents a generator nested within a combination-type generator (MatrixGenerator or
@@ -229,6 +557,17 @@ type ApplicationSetNestedGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	// Transform allows to filter and derive the parameters of the generator before they are used in the template.
+	Transform *ApplicationSetGeneratorTransform `json:"transform,omitempty" protobuf:"bytes,11,name=transform"`
+
+	Terraform *TerraformGenerator `json:"terraform,omitempty" protobuf:"bytes,12,name=terraform"`
+
+	CloudInventory *CloudInventoryGenerator `json:"cloudInventory,omitempty" protobuf:"bytes,13,name=cloudInventory"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,14,name=kubernetesResource"`
+
+	OCI *OCIGenerator `json:"oci,omitempty" protobuf:"bytes,15,name=oci"`
 }
 
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
@@ -248,6 +587,17 @@ type ApplicationSetTerminalGenerator struct {
 
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,8,name=selector"`
+
+	// Transform allows to filter and derive the parameters of the generator before they are used in the template.
+	Transform *ApplicationSetGeneratorTransform `json:"transform,omitempty" protobuf:"bytes,9,name=transform"`
+
+	Terraform *TerraformGenerator `json:"terraform,omitempty" protobuf:"bytes,10,name=terraform"`
+
+	CloudInventory *CloudInventoryGenerator `json:"cloudInventory,omitempty" protobuf:"bytes,11,name=cloudInventory"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,12,name=kubernetesResource"`
+
+	OCI *OCIGenerator `json:"oci,omitempty" protobuf:"bytes,13,name=oci"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -266,12 +616,104 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			ClusterDecisionResource: terminalGenerator.ClusterDecisionResource,
 			PullRequest:             terminalGenerator.PullRequest,
 			Plugin:                  terminalGenerator.Plugin,
+			Terraform:               terminalGenerator.Terraform,
+			CloudInventory:          terminalGenerator.CloudInventory,
+			KubernetesResource:      terminalGenerator.KubernetesResource,
+			OCI:                     terminalGenerator.OCI,
 			Selector:                terminalGenerator.Selector,
+			Transform:               terminalGenerator.Transform,
 		}
 	}
 	return nestedGenerators
 }
 
+const (
+	// DefaultApplicationSetGeneratorBackoffDuration is the delay of the requeue after the first failure of a generator
+	DefaultApplicationSetGeneratorBackoffDuration = 3 * time.Minute
+	// DefaultApplicationSetGeneratorBackoffFactor is the factor the delay of the requeue is multiplied by after each
+	// subsequent failure of a generator
+	DefaultApplicationSetGeneratorBackoffFactor = int64(2)
+	// DefaultApplicationSetGeneratorBackoffMaxDuration is the maximum delay of the requeue after failures of a generator
+	DefaultApplicationSetGeneratorBackoffMaxDuration = time.Hour
+)
+
+// ApplicationSetGeneratorRequeueStrategy configures when the ApplicationSet is reconciled again after its generator
+// generated parameters or failed to
+type ApplicationSetGeneratorRequeueStrategy struct {
+	// Backoff is the exponential backoff of the requeues after consecutive failures of the generator. The delay of the
+	// requeue after the first failure is the duration (default 3m), which is multiplied by the factor (default 2) after
+	// each subsequent failure, up to the maximum duration (default 1h). Without a backoff, the ApplicationSet is
+	// requeued after 3 minutes after each failure.
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,1,opt,name=backoff"`
+	// JitterPercent is the maximum percentage of the delay of the requeue which is randomly added to it, e.g. 20 delays
+	// a requeue of 3 minutes by up to 36 seconds, so that the ApplicationSets using the same API are not reconciled in
+	// lockstep.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	JitterPercent *int64 `json:"jitterPercent,omitempty" protobuf:"varint,2,opt,name=jitterPercent"`
+}
+
+// BackoffDuration returns the delay of the requeue after the given number of consecutive failures of the generator
+func (s *ApplicationSetGeneratorRequeueStrategy) BackoffDuration(failures int64) (time.Duration, error) {
+	duration := DefaultApplicationSetGeneratorBackoffDuration
+	factor := DefaultApplicationSetGeneratorBackoffFactor
+	maxDuration := DefaultApplicationSetGeneratorBackoffMaxDuration
+	var err error
+	if s != nil && s.Backoff != nil {
+		if s.Backoff.Duration != "" {
+			if duration, err = parseStringToDuration(s.Backoff.Duration); err != nil {
+				return 0, err
+			}
+		}
+		if s.Backoff.MaxDuration != "" {
+			if maxDuration, err = parseStringToDuration(s.Backoff.MaxDuration); err != nil {
+				return 0, err
+			}
+		}
+		if s.Backoff.Factor != nil {
+			factor = *s.Backoff.Factor
+		}
+	}
+	delay := float64(duration) * math.Pow(float64(factor), float64(max(failures-1, 0)))
+	if maxDuration > 0 {
+		delay = math.Min(float64(maxDuration), delay)
+	}
+	return time.Duration(delay), nil
+}
+
+// AddJitter returns the given delay of a requeue with a random jitter of up to JitterPercent of it added
+func (s *ApplicationSetGeneratorRequeueStrategy) AddJitter(delay time.Duration) time.Duration {
+	if s == nil || s.JitterPercent == nil || delay <= 0 {
+		return delay
+	}
+	maxJitter := int64(delay) * min(max(*s.JitterPercent, 0), 100) / 100
+	if maxJitter <= 0 {
+		return delay
+	}
+	return delay + time.Duration(rand.Int63n(maxJitter+1))
+}
+
+// ApplicationSetGeneratorTransform transforms the parameter sets of a generator using CEL expressions. The parameters of
+// a parameter set are available to the expressions as the `params` variable. The filter is evaluated first, then the
+// fields are evaluated in order and finally the parameters to remove are removed.
+type ApplicationSetGeneratorTransform struct {
+	// Filter is a CEL expression evaluating to a boolean. Parameter sets for which it evaluates to false are dropped.
+	Filter string `json:"filter,omitempty" protobuf:"bytes,1,opt,name=filter"`
+	// Fields are parameters which are derived from the parameter set. Each field can use the fields defined before it.
+	Fields []ApplicationSetGeneratorTransformField `json:"fields,omitempty" protobuf:"bytes,2,rep,name=fields"`
+	// Remove are names of parameters which are removed from the parameter set, e.g. after deriving a renamed parameter
+	// from them.
+	Remove []string `json:"remove,omitempty" protobuf:"bytes,3,rep,name=remove"`
+}
+
+// ApplicationSetGeneratorTransformField is a parameter which is derived from a parameter set using a CEL expression
+type ApplicationSetGeneratorTransformField struct {
+	// Name of the parameter. An existing parameter of the same name is overwritten.
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+	// Expression is a CEL expression computing the value of the parameter
+	Expression string `json:"expression" protobuf:"bytes,2,name=expression"`
+}
+
 // ListGenerator include items info
 type ListGenerator struct {
 	// +kubebuilder:validation:Optional
@@ -337,8 +779,29 @@ type MergeGenerator struct {
 	Generators []ApplicationSetNestedGenerator `json:"generators" protobuf:"bytes,1,name=generators"`
 	MergeKeys  []string                        `json:"mergeKeys" protobuf:"bytes,2,name=mergeKeys"`
 	Template   ApplicationSetTemplate          `json:"template,omitempty" protobuf:"bytes,3,name=template"`
+	// MergeStrategy is how the nested parameters of Go templates are merged. Possible values are replaceArrays (the
+	// default), deep, appendArrays and shallow.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=deep;shallow;replaceArrays;appendArrays
+	MergeStrategy MergeStrategy `json:"mergeStrategy,omitempty" protobuf:"bytes,4,opt,name=mergeStrategy,casttype=MergeStrategy"`
 }
 
+// MergeStrategy is how the Merge generator merges the nested parameters of Go templates
+type MergeStrategy string
+
+const (
+	// MergeStrategyReplaceArrays merges nested maps key by key, the arrays of latter generators replacing the
+	// arrays of the former ones
+	MergeStrategyReplaceArrays MergeStrategy = "replaceArrays"
+	// MergeStrategyDeep merges nested maps key by key and arrays element by element
+	MergeStrategyDeep MergeStrategy = "deep"
+	// MergeStrategyAppendArrays merges nested maps key by key, the arrays of latter generators being appended to the
+	// arrays of the former ones
+	MergeStrategyAppendArrays MergeStrategy = "appendArrays"
+	// MergeStrategyShallow replaces the top-level parameters of former generators with the ones of latter generators
+	MergeStrategyShallow MergeStrategy = "shallow"
+)
+
 // NestedMergeGenerator is a MergeGenerator nested under another combination-type generator (MatrixGenerator or
 // MergeGenerator). NestedMergeGenerator does not have an override template, because template overriding has no meaning
 // within the constituent generators of combination-type generators.
@@ -349,6 +812,9 @@ type MergeGenerator struct {
 type NestedMergeGenerator struct {
 	Generators ApplicationSetTerminalGenerators `json:"generators" protobuf:"bytes,1,name=generators"`
 	MergeKeys  []string                         `json:"mergeKeys" protobuf:"bytes,2,name=mergeKeys"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=deep;shallow;replaceArrays;appendArrays
+	MergeStrategy MergeStrategy `json:"mergeStrategy,omitempty" protobuf:"bytes,3,opt,name=mergeStrategy,casttype=MergeStrategy"`
 }
 
 // ToNestedMergeGenerator converts a JSON struct (from the K8s resource) to corresponding
@@ -372,8 +838,9 @@ func ToNestedMergeGenerator(j *apiextensionsv1.JSON) (*NestedMergeGenerator, err
 // no override template).
 func (g NestedMergeGenerator) ToMergeGenerator() *MergeGenerator {
 	return &MergeGenerator{
-		Generators: g.Generators.toApplicationSetNestedGenerators(),
-		MergeKeys:  g.MergeKeys,
+		Generators:    g.Generators.toApplicationSetNestedGenerators(),
+		MergeKeys:     g.MergeKeys,
+		MergeStrategy: g.MergeStrategy,
 	}
 }
 
@@ -390,6 +857,11 @@ type ClusterGenerator struct {
 
 	// returns the clusters a single 'clusters' value in the template
 	FlatList bool `json:"flatList,omitempty" protobuf:"bytes,4,name=flatList"`
+
+	// SelectorExpression is an expr expression (https://expr-lang.org) which must evaluate to true for a cluster to be
+	// selected, in addition to the selector. It is evaluated against the name, server, labels and annotations of the
+	// cluster, e.g. `labels.region matches "^(us|eu)-" && labels.tier != "sandbox"`.
+	SelectorExpression string `json:"selectorExpression,omitempty" protobuf:"bytes,5,name=selectorExpression"`
 }
 
 // DuckType defines a generator to match against clusters registered with ArgoCD.
@@ -406,6 +878,10 @@ type DuckTypeGenerator struct {
 	Template ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,5,name=template"`
 	// Values contains key/value pairs which are passed directly as parameters to the template
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,6,name=values"`
+	// StatusFields are parameters extracted from the status of the decision resource of each cluster, keyed by
+	// parameter name, as JSONPath expressions evaluated against the status, e.g. {.placementName}. A field which is
+	// missing from the status is an empty string.
+	StatusFields map[string]string `json:"statusFields,omitempty" protobuf:"bytes,7,name=statusFields"`
 }
 
 type GitGenerator struct {
@@ -623,6 +1099,9 @@ type PullRequestGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,10,name=values"`
 	// ContinueOnRepoNotFoundError is a flag to continue the ApplicationSet Pull Request generator parameters generation even if the repository is not found.
 	ContinueOnRepoNotFoundError bool `json:"continueOnRepoNotFoundError,omitempty" protobuf:"varint,11,opt,name=continueOnRepoNotFoundError"`
+	// CommentCommand is a command, e.g. /deploy-preview, which must be commented on a pull request before the pull request is
+	// considered. Comments are detected by the ApplicationSet webhook, which is only supported for GitHub and GitLab.
+	CommentCommand string `json:"commentCommand,omitempty" protobuf:"bytes,12,opt,name=commentCommand"`
 	// If you add a new SCM provider, update CustomApiUrl below.
 }
 
@@ -774,6 +1253,15 @@ type PullRequestGeneratorFilter struct {
 	BranchMatch       *string `json:"branchMatch,omitempty" protobuf:"bytes,1,opt,name=branchMatch"`
 	TargetBranchMatch *string `json:"targetBranchMatch,omitempty" protobuf:"bytes,2,opt,name=targetBranchMatch"`
 	TitleMatch        *string `json:"titleMatch,omitempty" protobuf:"bytes,3,op,name=titleMatch"`
+	// Stacked only considers pull requests targeting the branch of another open pull request if true, and only pull
+	// requests not targeting the branch of another open pull request if false.
+	Stacked *bool `json:"stacked,omitempty" protobuf:"varint,4,opt,name=stacked"`
+	// Draft only considers draft pull requests if true, and only pull requests which are not drafts if false.
+	Draft *bool `json:"draft,omitempty" protobuf:"varint,5,opt,name=draft"`
+	// AuthorMatch is a regular expression the author of the pull request must match.
+	AuthorMatch *string `json:"authorMatch,omitempty" protobuf:"bytes,6,opt,name=authorMatch"`
+	// Labels only considers pull requests having all the labels.
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,7,rep,name=labels"`
 }
 
 type PluginConfigMapRef struct {
@@ -802,6 +1290,169 @@ type PluginGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,5,name=values"`
 }
 
+// TerraformGenerator generates parameters from the outputs of Terraform or OpenTofu states. Exactly one backend must be
+// specified. One parameter set is generated per workspace whose name matches WorkspaceMatch.
+type TerraformGenerator struct {
+	// S3 reads the states from an S3 bucket, like the Terraform s3 backend.
+	S3 *TerraformGeneratorS3 `json:"s3,omitempty" protobuf:"bytes,1,opt,name=s3"`
+	// GCS reads the states from a Google Cloud Storage bucket, like the Terraform gcs backend.
+	GCS *TerraformGeneratorGCS `json:"gcs,omitempty" protobuf:"bytes,2,opt,name=gcs"`
+	// TerraformCloud reads the states of the workspaces of an HCP Terraform (Terraform Cloud) or Terraform Enterprise organization.
+	TerraformCloud *TerraformGeneratorTerraformCloud `json:"terraformCloud,omitempty" protobuf:"bytes,3,opt,name=terraformCloud"`
+	// WorkspaceMatch is a regex for the names of the workspaces to generate parameters for. For the S3 and GCS backends,
+	// only the default workspace is read if it is not specified. For Terraform Cloud, all workspaces are read.
+	WorkspaceMatch string `json:"workspaceMatch,omitempty" protobuf:"bytes,4,opt,name=workspaceMatch"`
+	// ExpandOutput is the name of an output which is a list. If specified, one parameter set is generated per element
+	// of the list instead of one per workspace, with the element available as the `item` parameter.
+	ExpandOutput string `json:"expandOutput,omitempty" protobuf:"bytes,5,opt,name=expandOutput"`
+	// RequeueAfterSeconds determines how long the ApplicationSet controller will wait before reading the states again.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,6,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,7,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,8,name=values"`
+}
+
+// TerraformGeneratorS3 defines the S3 bucket to read Terraform states from.
+type TerraformGeneratorS3 struct {
+	// Bucket is the name of the S3 bucket.
+	Bucket string `json:"bucket" protobuf:"bytes,1,opt,name=bucket"`
+	// Key is the path of the state of the default workspace in the bucket. Defaults to terraform.tfstate.
+	Key string `json:"key,omitempty" protobuf:"bytes,2,opt,name=key"`
+	// WorkspaceKeyPrefix is the prefix of the paths of the states of non-default workspaces. Defaults to env:.
+	WorkspaceKeyPrefix string `json:"workspaceKeyPrefix,omitempty" protobuf:"bytes,3,opt,name=workspaceKeyPrefix"`
+	// Region is the AWS region of the bucket. If not provided, the ApplicationSet controller will infer the current
+	// region from its environment.
+	Region string `json:"region,omitempty" protobuf:"bytes,4,opt,name=region"`
+	// Role is the AWS IAM role to assume with the static credentials to read the bucket.
+	Role string `json:"role,omitempty" protobuf:"bytes,5,opt,name=role"`
+	// Endpoint is the URL of an S3 compatible API to use instead of AWS S3. Buckets are addressed path-style. The URL
+	// must be allowed by the operator of the ApplicationSet controller.
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,6,opt,name=endpoint"`
+	// AccessKeyIDRef is a reference to the access key ID of static credentials. Required.
+	AccessKeyIDRef *SecretRef `json:"accessKeyIDRef,omitempty" protobuf:"bytes,7,opt,name=accessKeyIDRef"`
+	// SecretAccessKeyRef is a reference to the secret access key of static credentials. Required.
+	SecretAccessKeyRef *SecretRef `json:"secretAccessKeyRef,omitempty" protobuf:"bytes,8,opt,name=secretAccessKeyRef"`
+}
+
+// TerraformGeneratorGCS defines the Google Cloud Storage bucket to read Terraform states from.
+type TerraformGeneratorGCS struct {
+	// Bucket is the name of the bucket.
+	Bucket string `json:"bucket" protobuf:"bytes,1,opt,name=bucket"`
+	// Prefix is the path in the bucket of the directory containing the states, which are named <workspace>.tfstate.
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,2,opt,name=prefix"`
+	// CredentialsRef is a reference to a service account key in JSON format. Required.
+	CredentialsRef *SecretRef `json:"credentialsRef,omitempty" protobuf:"bytes,3,opt,name=credentialsRef"`
+}
+
+// TerraformGeneratorTerraformCloud defines the HCP Terraform (Terraform Cloud) or Terraform Enterprise organization
+// to read Terraform states from.
+type TerraformGeneratorTerraformCloud struct {
+	// Organization is the name of the organization.
+	Organization string `json:"organization" protobuf:"bytes,1,opt,name=organization"`
+	// API is the URL of Terraform Enterprise. Defaults to https://app.terraform.io. Other URLs must be allowed by the
+	// operator of the ApplicationSet controller.
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
+	// TokenRef is a reference to an API token with permission to read the state outputs of the workspaces.
+	TokenRef *SecretRef `json:"tokenRef" protobuf:"bytes,3,opt,name=tokenRef"`
+}
+
+// CloudInventoryGenerator generates parameters from the accounts of a cloud provider: AWS accounts of an organization,
+// Google Cloud projects or Azure subscriptions. Exactly one provider must be specified. One parameter set is generated
+// per active account having all the tags in Tags.
+type CloudInventoryGenerator struct {
+	// AWS lists the accounts of an AWS Organizations organization.
+	AWS *CloudInventoryGeneratorAWS `json:"aws,omitempty" protobuf:"bytes,1,opt,name=aws"`
+	// GCP lists the Google Cloud projects the credentials have access to.
+	GCP *CloudInventoryGeneratorGCP `json:"gcp,omitempty" protobuf:"bytes,2,opt,name=gcp"`
+	// Azure lists the Azure subscriptions the credentials have access to.
+	Azure *CloudInventoryGeneratorAzure `json:"azure,omitempty" protobuf:"bytes,3,opt,name=azure"`
+	// Tags filters the accounts by tags (labels for Google Cloud projects). Only the accounts having all the given tags
+	// with the given values are returned. An empty value matches any value of the tag.
+	Tags map[string]string `json:"tags,omitempty" protobuf:"bytes,4,opt,name=tags"`
+	// RequeueAfterSeconds determines how long the ApplicationSet controller will wait before listing the accounts again.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,5,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,6,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,7,name=values"`
+}
+
+// CloudInventoryGeneratorAWS defines the AWS Organizations organization to list the accounts of.
+type CloudInventoryGeneratorAWS struct {
+	// ParentID restricts the accounts to the direct children of the given organizational unit or root.
+	ParentID string `json:"parentID,omitempty" protobuf:"bytes,1,opt,name=parentID"`
+	// Region is the AWS region to use. If not provided, the ApplicationSet controller will infer the current region
+	// from its environment.
+	Region string `json:"region,omitempty" protobuf:"bytes,2,opt,name=region"`
+	// Role is the AWS IAM role to assume with the static credentials to list the accounts, e.g. a role of the
+	// management account.
+	Role string `json:"role,omitempty" protobuf:"bytes,3,opt,name=role"`
+	// AccessKeyIDRef is a reference to the access key ID of static credentials. Required.
+	AccessKeyIDRef *SecretRef `json:"accessKeyIDRef,omitempty" protobuf:"bytes,4,opt,name=accessKeyIDRef"`
+	// SecretAccessKeyRef is a reference to the secret access key of static credentials. Required.
+	SecretAccessKeyRef *SecretRef `json:"secretAccessKeyRef,omitempty" protobuf:"bytes,5,opt,name=secretAccessKeyRef"`
+}
+
+// CloudInventoryGeneratorGCP defines the Google Cloud projects to list.
+type CloudInventoryGeneratorGCP struct {
+	// Parent restricts the projects to the direct children of the given organization or folder, e.g.
+	// organizations/123 or folders/456.
+	Parent string `json:"parent,omitempty" protobuf:"bytes,1,opt,name=parent"`
+	// CredentialsRef is a reference to a service account key in JSON format. Required.
+	CredentialsRef *SecretRef `json:"credentialsRef,omitempty" protobuf:"bytes,2,opt,name=credentialsRef"`
+}
+
+// CloudInventoryGeneratorAzure defines the Azure subscriptions to list.
+type CloudInventoryGeneratorAzure struct {
+	// TenantID is the ID of the Microsoft Entra tenant of the service principal.
+	TenantID string `json:"tenantID,omitempty" protobuf:"bytes,1,opt,name=tenantID"`
+	// ClientID is the client ID of the service principal.
+	ClientID string `json:"clientID,omitempty" protobuf:"bytes,2,opt,name=clientID"`
+	// ClientSecretRef is a reference to the client secret of the service principal. Required.
+	ClientSecretRef *SecretRef `json:"clientSecretRef,omitempty" protobuf:"bytes,3,opt,name=clientSecretRef"`
+}
+
+// KubernetesResourceGenerator generates parameters from the Kubernetes resources of a kind, listed in the local cluster
+// or in the clusters registered with Argo CD. One parameter set is generated per resource.
+type KubernetesResourceGenerator struct {
+	// APIVersion is the API version of the resources, e.g. v1 or argoproj.io/v1alpha1.
+	APIVersion string `json:"apiVersion" protobuf:"bytes,1,opt,name=apiVersion"`
+	// Kind is the kind of the resources, e.g. Namespace. Secrets cannot be listed.
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace restricts namespaced resources to the given namespace. The resources of all namespaces are listed if
+	// empty.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// LabelSelector filters the resources by labels.
+	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,4,opt,name=labelSelector"`
+	// Clusters selects the clusters registered with Argo CD to list the resources in, by the labels of their secrets.
+	// The resources are listed in the local cluster if not provided.
+	Clusters *metav1.LabelSelector `json:"clusters,omitempty" protobuf:"bytes,5,opt,name=clusters"`
+	// Fields are parameters extracted from each resource, keyed by parameter name, as JSONPath expressions, e.g.
+	// {.spec.owner}. A field which is missing from a resource is an empty string.
+	Fields map[string]string `json:"fields,omitempty" protobuf:"bytes,6,opt,name=fields"`
+	// RequeueAfterSeconds determines how long the ApplicationSet controller will wait before listing the resources again.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,7,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,8,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,9,name=values"`
+}
+
+// OCIGenerator generates parameters from the tags of an OCI repository, e.g. the published versions of a Helm chart.
+// One parameter set is generated per tag.
+type OCIGenerator struct {
+	// RepoURL is the URL of the OCI repository, e.g. oci://ghcr.io/example/charts/guestbook.
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Constraint is a semantic version constraint the tags must satisfy, e.g. ">=1.2.0 <2.0.0". The tags which are not
+	// semantic versions are skipped if it is set.
+	Constraint string `json:"constraint,omitempty" protobuf:"bytes,2,opt,name=constraint"`
+	// Limit restricts the tags to the given number of highest semantic versions. All the tags are used if zero.
+	Limit int64 `json:"limit,omitempty" protobuf:"varint,3,opt,name=limit"`
+	// RequeueAfterSeconds determines how long the ApplicationSet controller will wait before listing the tags again.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,4,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,5,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,6,name=values"`
+}
+
 // ApplicationSetStatus defines the observed state of ApplicationSet
 type ApplicationSetStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -815,6 +1466,86 @@ type ApplicationSetStatus struct {
 	ResourcesCount int64 `json:"resourcesCount,omitempty" protobuf:"varint,4,opt,name=resourcesCount"`
 	// Health contains information about the applicationset's current health status based on the applicationset conditions
 	Health HealthStatus `json:"health,omitempty" protobuf:"bytes,5,opt,name=health"`
+	// StaleApplications is a list of names of the Applications managed by this application set whose spec deviates from the latest rendered template,
+	// e.g. because the ApplicationSet policy does not allow updates or the Application failed to be updated.
+	StaleApplications []string `json:"staleApplications,omitempty" protobuf:"bytes,6,rep,name=staleApplications"`
+	// StaleApplicationsCount is the total number of stale Applications. The count may be higher than the number of items in the StaleApplications
+	// field when the number of stale Applications exceeds the limit imposed by the controller (to avoid making the status field too large).
+	StaleApplicationsCount int64 `json:"staleApplicationsCount,omitempty" protobuf:"varint,7,opt,name=staleApplicationsCount"`
+	// GenerationHistory records the most recent generation runs which changed the set of generated Applications, latest first.
+	GenerationHistory []ApplicationSetGenerationRecord `json:"generationHistory,omitempty" protobuf:"bytes,8,rep,name=generationHistory"`
+	// Summary contains the number of Applications managed by this application set per health and sync status. Unlike the Resources field, it
+	// accounts for all the managed Applications.
+	Summary *ApplicationSetSummary `json:"summary,omitempty" protobuf:"bytes,9,opt,name=summary"`
+	// CachedParameters holds the parameters last successfully generated by each generator, which are used while the generator fails if the
+	// ApplicationSet controller runs with --use-cached-parameters-on-error.
+	CachedParameters []ApplicationSetCachedParameters `json:"cachedParameters,omitempty" protobuf:"bytes,10,rep,name=cachedParameters"`
+	// GeneratorFailures records the consecutive failures of the generators with a requeue backoff, which determine the
+	// delay of the next reconciliation of the ApplicationSet.
+	GeneratorFailures []ApplicationSetGeneratorFailures `json:"generatorFailures,omitempty" protobuf:"bytes,11,rep,name=generatorFailures"`
+	// SyncWindows is the state of the sync windows of the ApplicationSet, if it has any.
+	SyncWindows *ApplicationSetSyncWindowsStatus `json:"syncWindows,omitempty" protobuf:"bytes,12,opt,name=syncWindows"`
+}
+
+// ApplicationSetGeneratorFailures records the consecutive failures of a generator of an ApplicationSet
+type ApplicationSetGeneratorFailures struct {
+	// Generator is the index of the generator in the generators of the ApplicationSet, starting at 0
+	Generator int64 `json:"generator" protobuf:"varint,1,opt,name=generator"`
+	// Count is the number of consecutive failures of the generator
+	Count int64 `json:"count" protobuf:"varint,2,opt,name=count"`
+	// LastFailedAt is the time of the last failure of the generator
+	LastFailedAt metav1.Time `json:"lastFailedAt" protobuf:"bytes,3,opt,name=lastFailedAt"`
+}
+
+// ApplicationSetCachedParameters holds the parameters last successfully generated by a generator of an ApplicationSet
+type ApplicationSetCachedParameters struct {
+	// Generator is the index of the generator in the generators of the ApplicationSet, starting at 0
+	Generator int64 `json:"generator" protobuf:"varint,1,opt,name=generator"`
+	// GeneratorHash is the hash of the generator which generated the parameters. The parameters are only used while the generator is unchanged.
+	GeneratorHash string `json:"generatorHash" protobuf:"bytes,2,opt,name=generatorHash"`
+	// Hash is the hash of the parameter sets
+	Hash string `json:"hash" protobuf:"bytes,3,opt,name=hash"`
+	// GeneratedAt is the time the parameter sets were first generated
+	GeneratedAt metav1.Time `json:"generatedAt" protobuf:"bytes,4,opt,name=generatedAt"`
+	// ParameterSetCount is the number of parameter sets
+	ParameterSetCount int64 `json:"parameterSetCount" protobuf:"varint,5,opt,name=parameterSetCount"`
+	// Parameters is the gzip compressed JSON of the parameter sets
+	Parameters []byte `json:"parameters,omitempty" protobuf:"bytes,6,opt,name=parameters"`
+}
+
+// ApplicationSetSummary contains the number of Applications of an ApplicationSet per health and sync status
+type ApplicationSetSummary struct {
+	// Healthy is the number of Healthy Applications
+	Healthy int64 `json:"healthy,omitempty" protobuf:"varint,1,opt,name=healthy"`
+	// Degraded is the number of Degraded Applications
+	Degraded int64 `json:"degraded,omitempty" protobuf:"varint,2,opt,name=degraded"`
+	// Progressing is the number of Progressing Applications
+	Progressing int64 `json:"progressing,omitempty" protobuf:"varint,3,opt,name=progressing"`
+	// Missing is the number of Applications whose resources are Missing
+	Missing int64 `json:"missing,omitempty" protobuf:"varint,4,opt,name=missing"`
+	// OutOfSync is the number of OutOfSync Applications
+	OutOfSync int64 `json:"outOfSync,omitempty" protobuf:"varint,5,opt,name=outOfSync"`
+}
+
+// ApplicationSetGenerationRecord records a generation run of an ApplicationSet which added or removed Applications
+type ApplicationSetGenerationRecord struct {
+	// GeneratedAt is the time of the generation run
+	GeneratedAt metav1.Time `json:"generatedAt" protobuf:"bytes,1,opt,name=generatedAt"`
+	// ApplicationSetGeneration is the metadata.generation of the ApplicationSet rendered by the run. The change was caused by the inputs of the
+	// generators, e.g. clusters or Git files, rather than by the ApplicationSet spec if it equals the generation of the previous record.
+	ApplicationSetGeneration int64 `json:"applicationSetGeneration,omitempty" protobuf:"varint,2,opt,name=applicationSetGeneration"`
+	// ParameterSetCount is the number of parameter sets produced by the generators, each of which renders an Application
+	ParameterSetCount int64 `json:"parameterSetCount" protobuf:"varint,3,opt,name=parameterSetCount"`
+	// AddedApplications is a list of names of the Applications which were generated by the run but did not exist before
+	AddedApplications []string `json:"addedApplications,omitempty" protobuf:"bytes,4,rep,name=addedApplications"`
+	// AddedApplicationsCount is the total number of added Applications. The count may be higher than the number of items in the AddedApplications
+	// field when the number of added Applications exceeds the limit imposed by the controller (to avoid making the status field too large).
+	AddedApplicationsCount int64 `json:"addedApplicationsCount,omitempty" protobuf:"varint,5,opt,name=addedApplicationsCount"`
+	// RemovedApplications is a list of names of the Applications which existed before but were no longer generated by the run
+	RemovedApplications []string `json:"removedApplications,omitempty" protobuf:"bytes,6,rep,name=removedApplications"`
+	// RemovedApplicationsCount is the total number of removed Applications. The count may be higher than the number of items in the RemovedApplications
+	// field when the number of removed Applications exceeds the limit imposed by the controller (to avoid making the status field too large).
+	RemovedApplicationsCount int64 `json:"removedApplicationsCount,omitempty" protobuf:"varint,7,opt,name=removedApplicationsCount"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -856,6 +1587,8 @@ const (
 	ApplicationSetConditionParametersGenerated ApplicationSetConditionType = "ParametersGenerated"
 	ApplicationSetConditionResourcesUpToDate   ApplicationSetConditionType = "ResourcesUpToDate"
 	ApplicationSetConditionRolloutProgressing  ApplicationSetConditionType = "RolloutProgressing"
+	ApplicationSetConditionApplicationsAdopted ApplicationSetConditionType = "ApplicationsAdopted"
+	ApplicationSetConditionDeletionProtected   ApplicationSetConditionType = "DeletionProtected"
 )
 
 type ApplicationSetReasonType string
@@ -875,6 +1608,12 @@ const (
 	ApplicationSetReasonApplicationSetModified           = "ApplicationSetModified"
 	ApplicationSetReasonApplicationSetRolloutComplete    = "ApplicationSetRolloutComplete"
 	ApplicationSetReasonSyncApplicationError             = "SyncApplicationError"
+	ApplicationSetReasonMissingTemplateKeys              = "MissingTemplateKeys"
+	ApplicationSetReasonApplicationRolloutFailed         = "ApplicationRolloutFailed"
+	ApplicationSetReasonApplicationsAdopted              = "ApplicationsAdopted"
+	ApplicationSetReasonApplicationDeletionProtected     = "ApplicationDeletionProtected"
+	ApplicationSetReasonNoApplicationDeletionProtected   = "NoApplicationDeletionProtected"
+	ApplicationSetReasonInvalidSyncWindows               = "InvalidSyncWindows"
 )
 
 // Represents resource health status
@@ -889,6 +1628,13 @@ const (
 	ProgressiveSyncProgressing ProgressiveSyncStatusCode = "Progressing"
 	// Indicates that the application has reached an Healthy state in regards to the requested sync
 	ProgressiveSyncHealthy ProgressiveSyncStatusCode = "Healthy"
+	// Indicates that the step of the application failed, and a sync to its previously healthy revisions is being trigerred
+	ProgressiveSyncRollingBack ProgressiveSyncStatusCode = "RollingBack"
+	// Indicates that the step of the application failed and has been rolled back, which pauses the rollout until the
+	// target revisions change
+	ProgressiveSyncRolledBack ProgressiveSyncStatusCode = "RolledBack"
+	// Indicates that the application stayed Pending or Progressing for longer than the timeout of its step
+	ProgressiveSyncFailed ProgressiveSyncStatusCode = "Failed"
 )
 
 // ApplicationSetApplicationStatus contains details about each Application managed by the ApplicationSet
@@ -905,6 +1651,14 @@ type ApplicationSetApplicationStatus struct {
 	Step string `json:"step" protobuf:"bytes,5,opt,name=step"`
 	// TargetRevision tracks the desired revisions the Application should be synced to.
 	TargetRevisions []string `json:"targetRevisions" protobuf:"bytes,6,opt,name=targetrevisions"`
+	// LastHealthyTargetRevisions are the target revisions the Application was Healthy with before the current target
+	// revisions, which the Application is rolled back to if its step fails
+	LastHealthyTargetRevisions []string `json:"lastHealthyTargetRevisions,omitempty" protobuf:"bytes,7,opt,name=lastHealthyTargetRevisions"`
+	// StepAnalysis is the outcome of the analysis of the step of the Application, if the step has one
+	StepAnalysis *ApplicationSetStepAnalysisStatus `json:"stepAnalysis,omitempty" protobuf:"bytes,8,opt,name=stepAnalysis"`
+	// Resumed is true if the step of the Application pauses after its Applications are Healthy and was resumed, which
+	// allows the following steps to be synced. It is reset when the target revisions of the Application change.
+	Resumed bool `json:"resumed,omitempty" protobuf:"varint,9,opt,name=resumed"`
 }
 
 // ApplicationSetList contains a list of ApplicationSet
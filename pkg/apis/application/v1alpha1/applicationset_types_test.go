@@ -6,6 +6,7 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
@@ -320,3 +321,181 @@ func TestSCMProviderGeneratorGitlab_WillIncludeSharedProjects(t *testing.T) {
 	settings.IncludeSharedProjects = ptr.To(true)
 	assert.True(t, settings.WillIncludeSharedProjects())
 }
+
+func TestApplicationSetGeneratorRequeueStrategyBackoffDuration(t *testing.T) {
+	testCases := []struct {
+		name        string
+		strategy    *ApplicationSetGeneratorRequeueStrategy
+		failures    int64
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{
+			name:     "defaults",
+			strategy: &ApplicationSetGeneratorRequeueStrategy{Backoff: &Backoff{}},
+			failures: 1,
+			expected: 3 * time.Minute,
+		},
+		{
+			name:     "default factor",
+			strategy: &ApplicationSetGeneratorRequeueStrategy{Backoff: &Backoff{}},
+			failures: 3,
+			expected: 12 * time.Minute,
+		},
+		{
+			name:     "default maximum duration",
+			strategy: &ApplicationSetGeneratorRequeueStrategy{Backoff: &Backoff{}},
+			failures: 10,
+			expected: time.Hour,
+		},
+		{
+			name:     "custom backoff",
+			strategy: &ApplicationSetGeneratorRequeueStrategy{Backoff: &Backoff{Duration: "30", Factor: ptr.To(int64(3)), MaxDuration: "10m"}},
+			failures: 3,
+			expected: 270 * time.Second,
+		},
+		{
+			name:     "custom maximum duration",
+			strategy: &ApplicationSetGeneratorRequeueStrategy{Backoff: &Backoff{Duration: "30s", Factor: ptr.To(int64(3)), MaxDuration: "10m"}},
+			failures: 5,
+			expected: 10 * time.Minute,
+		},
+		{
+			name:        "invalid duration",
+			strategy:    &ApplicationSetGeneratorRequeueStrategy{Backoff: &Backoff{Duration: "soon"}},
+			failures:    1,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			delay, err := tc.strategy.BackoffDuration(tc.failures)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, delay)
+		})
+	}
+}
+
+func TestApplicationSetGeneratorRequeueStrategyAddJitter(t *testing.T) {
+	var noStrategy *ApplicationSetGeneratorRequeueStrategy
+	assert.Equal(t, time.Minute, noStrategy.AddJitter(time.Minute))
+	assert.Equal(t, time.Minute, (&ApplicationSetGeneratorRequeueStrategy{}).AddJitter(time.Minute))
+
+	strategy := &ApplicationSetGeneratorRequeueStrategy{JitterPercent: ptr.To(int64(20))}
+	assert.Equal(t, time.Duration(0), strategy.AddJitter(0))
+	for range 100 {
+		delay := strategy.AddJitter(time.Minute)
+		assert.GreaterOrEqual(t, delay, time.Minute)
+		assert.LessOrEqual(t, delay, 72*time.Second)
+	}
+
+	// the percentage is capped at 100
+	strategy = &ApplicationSetGeneratorRequeueStrategy{JitterPercent: ptr.To(int64(1000))}
+	for range 100 {
+		assert.LessOrEqual(t, strategy.AddJitter(time.Minute), 2*time.Minute)
+	}
+}
+
+func TestApplicationSetSpecSyncWindows(t *testing.T) {
+	t.Parallel()
+
+	// Friday
+	friday := func(hour, minute int) time.Time {
+		return time.Date(2026, 10, 16, hour, minute, 0, 0, time.UTC)
+	}
+	fridayNight := ApplicationSetSyncWindow{Kind: "deny", Schedule: "0 2 * * 5", Duration: "4h"}
+	officeHours := ApplicationSetSyncWindow{Kind: "allow", Schedule: "0 9 * * *", Duration: "8h"}
+
+	testCases := []struct {
+		name            string
+		windows         []ApplicationSetSyncWindow
+		currentTime     time.Time
+		expectedAllowed bool
+		expectedNext    *time.Time
+	}{
+		{
+			name:            "no windows",
+			currentTime:     friday(3, 0),
+			expectedAllowed: true,
+		},
+		{
+			name:            "during a deny window",
+			windows:         []ApplicationSetSyncWindow{fridayNight},
+			currentTime:     friday(3, 0),
+			expectedAllowed: false,
+			expectedNext:    ptr.To(friday(6, 0)),
+		},
+		{
+			name:            "outside of a deny window",
+			windows:         []ApplicationSetSyncWindow{fridayNight},
+			currentTime:     friday(6, 0),
+			expectedAllowed: true,
+		},
+		{
+			name:            "during an allow window",
+			windows:         []ApplicationSetSyncWindow{officeHours},
+			currentTime:     friday(10, 30),
+			expectedAllowed: true,
+		},
+		{
+			name:            "outside of an allow window",
+			windows:         []ApplicationSetSyncWindow{officeHours},
+			currentTime:     friday(20, 0),
+			expectedAllowed: false,
+			expectedNext:    ptr.To(friday(9, 0).AddDate(0, 0, 1)),
+		},
+		{
+			name: "deny window overlapping an allow window",
+			windows: []ApplicationSetSyncWindow{
+				{Kind: "allow", Schedule: "0 0 * * *", Duration: "12h"},
+				fridayNight,
+			},
+			currentTime:     friday(3, 0),
+			expectedAllowed: false,
+			expectedNext:    ptr.To(friday(6, 0)),
+		},
+		{
+			name: "deny window ending after an allow window",
+			windows: []ApplicationSetSyncWindow{
+				{Kind: "allow", Schedule: "0 1 * * *", Duration: "2h"},
+				fridayNight,
+			},
+			currentTime:     friday(2, 30),
+			expectedAllowed: false,
+			expectedNext:    ptr.To(friday(1, 0).AddDate(0, 0, 1)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := ApplicationSetSpec{SyncWindows: tc.windows}
+			allowed, err := spec.SyncWindowsAllowChanges(tc.currentTime)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAllowed, allowed)
+
+			next, err := spec.NextSyncWindow(tc.currentTime)
+			require.NoError(t, err)
+			if tc.expectedNext == nil {
+				assert.Nil(t, next)
+			} else {
+				require.NotNil(t, next)
+				assert.Equal(t, *tc.expectedNext, next.UTC())
+			}
+		})
+	}
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		t.Parallel()
+
+		spec := ApplicationSetSpec{SyncWindows: []ApplicationSetSyncWindow{{Kind: "deny", Schedule: "every friday", Duration: "1h"}}}
+		_, err := spec.SyncWindowsAllowChanges(friday(3, 0))
+		require.ErrorContains(t, err, "cannot parse schedule 'every friday'")
+	})
+}
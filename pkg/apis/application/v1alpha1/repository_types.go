@@ -118,6 +118,12 @@ type Repository struct {
 	InsecureOCIForceHttp bool `json:"insecureOCIForceHttp,omitempty" protobuf:"bytes,26,opt,name=insecureOCIForceHttp"` //nolint:revive //FIXME(var-naming)
 	// Depth specifies the depth for shallow clones. A value of 0 or omitting the field indicates a full clone.
 	Depth int64 `json:"depth,omitempty" protobuf:"bytes,27,opt,name=depth"`
+	// MaxConcurrentManifestGenerations limits the number of concurrent manifest generations for this repo per repo-server.
+	// It is set by the argocd.argoproj.io/manifest-generate-max-concurrency annotation of the repository secret.
+	MaxConcurrentManifestGenerations int64 `json:"maxConcurrentManifestGenerations,omitempty" protobuf:"bytes,28,opt,name=maxConcurrentManifestGenerations"`
+	// ManifestGenerationMemoryLimit is a soft memory budget, e.g. "1Gi", of every manifest generation subprocess for this repo.
+	// It is set by the argocd.argoproj.io/manifest-generate-memory-limit annotation of the repository secret.
+	ManifestGenerationMemoryLimit string `json:"manifestGenerationMemoryLimit,omitempty" protobuf:"bytes,29,opt,name=manifestGenerationMemoryLimit"`
 }
 
 // IsInsecure returns true if the repository has been configured to skip server verification or set to HTTP only
@@ -374,21 +380,23 @@ func (repo *Repository) StringForLogging() string {
 // Sanitized returns a copy of the Repository with sensitive information removed.
 func (repo *Repository) Sanitized() *Repository {
 	return &Repository{
-		Repo:                       repo.Repo,
-		Type:                       repo.Type,
-		Name:                       repo.Name,
-		Insecure:                   repo.IsInsecure(),
-		EnableLFS:                  repo.EnableLFS,
-		EnableOCI:                  repo.EnableOCI,
-		Proxy:                      repo.Proxy,
-		NoProxy:                    repo.NoProxy,
-		Project:                    repo.Project,
-		ForceHttpBasicAuth:         repo.ForceHttpBasicAuth,
-		InheritedCreds:             repo.InheritedCreds,
-		GithubAppId:                repo.GithubAppId,
-		GithubAppInstallationId:    repo.GithubAppInstallationId,
-		GitHubAppEnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
-		UseAzureWorkloadIdentity:   repo.UseAzureWorkloadIdentity,
+		Repo:                             repo.Repo,
+		Type:                             repo.Type,
+		Name:                             repo.Name,
+		Insecure:                         repo.IsInsecure(),
+		EnableLFS:                        repo.EnableLFS,
+		EnableOCI:                        repo.EnableOCI,
+		Proxy:                            repo.Proxy,
+		NoProxy:                          repo.NoProxy,
+		Project:                          repo.Project,
+		ForceHttpBasicAuth:               repo.ForceHttpBasicAuth,
+		InheritedCreds:                   repo.InheritedCreds,
+		GithubAppId:                      repo.GithubAppId,
+		GithubAppInstallationId:          repo.GithubAppInstallationId,
+		GitHubAppEnterpriseBaseURL:       repo.GitHubAppEnterpriseBaseURL,
+		UseAzureWorkloadIdentity:         repo.UseAzureWorkloadIdentity,
+		MaxConcurrentManifestGenerations: repo.MaxConcurrentManifestGenerations,
+		ManifestGenerationMemoryLimit:    repo.ManifestGenerationMemoryLimit,
 	}
 }
 
@@ -30,6 +30,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -1202,6 +1203,67 @@ type ApplicationStatus struct {
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
 	// SourceHydrator stores information about the current state of source hydration
 	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// ChildApplications contains an aggregated readiness summary of the child applications managed by this application
+	ChildApplications *ChildApplicationsSummary `json:"childApplications,omitempty" protobuf:"bytes,15,opt,name=childApplications"`
+}
+
+// ChildApplicationsSummary contains an aggregated readiness summary of the child applications managed by an
+// application, e.g. when using the app-of-apps pattern
+type ChildApplicationsSummary struct {
+	// Total is the number of child applications
+	Total int64 `json:"total" protobuf:"varint,1,opt,name=total"`
+	// Ready is the number of child applications which are healthy and synced
+	Ready int64 `json:"ready" protobuf:"varint,2,opt,name=ready"`
+	// Progressing is the number of child applications which are progressing
+	Progressing int64 `json:"progressing,omitempty" protobuf:"varint,3,opt,name=progressing"`
+	// Degraded is the number of child applications which are degraded
+	Degraded int64 `json:"degraded,omitempty" protobuf:"varint,4,opt,name=degraded"`
+	// Missing is the number of child applications which do not exist
+	Missing int64 `json:"missing,omitempty" protobuf:"varint,5,opt,name=missing"`
+	// OutOfSync is the number of child applications which are out of sync
+	OutOfSync int64 `json:"outOfSync,omitempty" protobuf:"varint,6,opt,name=outOfSync"`
+	// FirstFailingChild is the first child application which is degraded or missing
+	FirstFailingChild *ChildApplicationStatus `json:"firstFailingChild,omitempty" protobuf:"bytes,7,opt,name=firstFailingChild"`
+	// DeepestFailingResource is the failing resource which is the most levels of child applications away from this application
+	DeepestFailingResource *ChildApplicationResourceStatus `json:"deepestFailingResource,omitempty" protobuf:"bytes,8,opt,name=deepestFailingResource"`
+}
+
+// ChildApplicationStatus holds the health and sync status of a child application
+type ChildApplicationStatus struct {
+	// Namespace is the namespace of the child application
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// Name is the name of the child application
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// Health is the health status of the child application
+	Health health.HealthStatusCode `json:"health,omitempty" protobuf:"bytes,3,opt,name=health"`
+	// Sync is the sync status of the child application
+	Sync SyncStatusCode `json:"sync,omitempty" protobuf:"bytes,4,opt,name=sync"`
+	// Message is a human-readable message indicating details about the health of the child application
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+// ChildApplicationResourceStatus holds the health of a resource managed by a (possibly indirect) child application
+type ChildApplicationResourceStatus struct {
+	// ApplicationNamespace is the namespace of the application which manages the resource
+	ApplicationNamespace string `json:"applicationNamespace,omitempty" protobuf:"bytes,1,opt,name=applicationNamespace"`
+	// ApplicationName is the name of the application which manages the resource
+	ApplicationName string `json:"applicationName" protobuf:"bytes,2,opt,name=applicationName"`
+	Group           string `json:"group,omitempty" protobuf:"bytes,3,opt,name=group"`
+	Kind            string `json:"kind" protobuf:"bytes,4,opt,name=kind"`
+	Namespace       string `json:"namespace,omitempty" protobuf:"bytes,5,opt,name=namespace"`
+	Name            string `json:"name" protobuf:"bytes,6,opt,name=name"`
+	// Health is the health status of the resource
+	Health health.HealthStatusCode `json:"health,omitempty" protobuf:"bytes,7,opt,name=health"`
+	// Message is a human-readable message indicating details about the health of the resource
+	Message string `json:"message,omitempty" protobuf:"bytes,8,opt,name=message"`
+	// Depth is the number of child application levels between the application and the resource, starting with 1
+	// for resources of direct child applications
+	Depth int64 `json:"depth" protobuf:"varint,9,opt,name=depth"`
+}
+
+// IsFailing returns true if the child application is degraded or missing
+func (s *ChildApplicationStatus) IsFailing() bool {
+	return s.Health == health.HealthStatusDegraded || s.Health == health.HealthStatusMissing
 }
 
 // SourceHydratorStatus contains information about the current state of source hydration
@@ -1421,6 +1483,20 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// Termination contains information about the requested termination of the operation
+	Termination *OperationTermination `json:"termination,omitempty" protobuf:"bytes,9,opt,name=termination"`
+	// PendingPrune contains the resources whose pruning is waiting for confirmation
+	PendingPrune []SyncOperationResource `json:"pendingPrune,omitempty" protobuf:"bytes,10,rep,name=pendingPrune"`
+}
+
+// OperationTermination contains information about a requested termination of an operation
+type OperationTermination struct {
+	// Reason is the reason given for the termination
+	Reason string `json:"reason,omitempty" protobuf:"bytes,1,opt,name=reason"`
+	// Force indicates that running hooks are aborted without grace period and without waiting for their dependents
+	Force bool `json:"force,omitempty" protobuf:"bytes,2,opt,name=force"`
+	// InitiatedBy contains information about who requested the termination
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,3,opt,name=initiatedBy"`
 }
 
 type Info struct {
@@ -1477,6 +1553,8 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// Verification contains probes which are executed after a successful sync to verify the application end-to-end
+	Verification *SyncPolicyVerification `json:"verification,omitempty" protobuf:"bytes,5,opt,name=verification"`
 	// If you add a field here, be sure to update IsZero.
 }
 
@@ -1488,9 +1566,26 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 	return false
 }
 
+// GetFlapDetection returns the flap detection settings of the automated sync, or nil if automated sync or flap
+// detection are disabled
+func (p *SyncPolicy) GetFlapDetection() *SyncPolicyAutomatedFlapDetection {
+	if p == nil || !p.IsAutomatedSyncEnabled() {
+		return nil
+	}
+	return p.Automated.FlapDetection
+}
+
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.Verification == nil)
+}
+
+// GetVerification returns the post-sync verification settings, or nil if no probes are configured
+func (p *SyncPolicy) GetVerification() *SyncPolicyVerification {
+	if p == nil || p.Verification == nil || len(p.Verification.Probes) == 0 {
+		return nil
+	}
+	return p.Verification
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1567,6 +1662,141 @@ type SyncPolicyAutomated struct {
 	AllowEmpty bool `json:"allowEmpty,omitempty" protobuf:"bytes,3,opt,name=allowEmpty"`
 	// Enable allows apps to explicitly control automated sync
 	Enabled *bool `json:"enabled,omitempty" protobuf:"bytes,4,opt,name=enabled"`
+	// FlapDetection suspends automated sync while the application health is flapping between Healthy and Degraded
+	FlapDetection *SyncPolicyAutomatedFlapDetection `json:"flapDetection,omitempty" protobuf:"bytes,5,opt,name=flapDetection"`
+}
+
+// SyncPolicyAutomatedFlapDetection controls the suspension of automated sync for applications which repeatedly become
+// Degraded, e.g. because of a crash looping rollout, so that automated sync and self heal do not keep restarting it
+type SyncPolicyAutomatedFlapDetection struct {
+	// MaxDegradedTransitions is the number of times the application health may change to Degraded within the window
+	// before automated sync is suspended
+	// +kubebuilder:validation:Minimum=1
+	MaxDegradedTransitions int64 `json:"maxDegradedTransitions" protobuf:"varint,1,opt,name=maxDegradedTransitions"`
+	// Window is the period in which the transitions to Degraded are counted. Default unit is seconds, but could also be a duration (e.g. "10m", "1h"). Defaults to 1h
+	Window string `json:"window,omitempty" protobuf:"bytes,2,opt,name=window"`
+}
+
+// GetWindow returns the period in which the transitions to Degraded are counted
+func (f *SyncPolicyAutomatedFlapDetection) GetWindow() (time.Duration, error) {
+	if f.Window == "" {
+		return DefaultFlapDetectionWindow, nil
+	}
+	return parseStringToDuration(f.Window)
+}
+
+// SyncPolicyVerification controls the probes which are executed by the controller after the resources of an application
+// were synced successfully. The sync operation fails if any of the probes fails.
+type SyncPolicyVerification struct {
+	// Probes is the list of probes which need to succeed for the sync to be considered successful
+	Probes []SyncVerificationProbe `json:"probes,omitempty" protobuf:"bytes,1,rep,name=probes"`
+	// Rollback syncs the application back to the most recently deployed revision if the verification fails. Automated
+	// sync skips the rolled back revision until a new revision is available.
+	Rollback bool `json:"rollback,omitempty" protobuf:"bytes,2,opt,name=rollback"`
+}
+
+// SyncVerificationProbe is a single HTTP or gRPC request executed after a successful sync. Exactly one of HTTP and GRPC
+// has to be set.
+type SyncVerificationProbe struct {
+	// Name identifies the probe in the result of the sync operation
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// HTTP performs a HTTP GET request
+	HTTP *SyncVerificationHTTPProbe `json:"http,omitempty" protobuf:"bytes,2,opt,name=http"`
+	// GRPC performs a request using the gRPC health checking protocol
+	GRPC *SyncVerificationGRPCProbe `json:"grpc,omitempty" protobuf:"bytes,3,opt,name=grpc"`
+	// Retries is the number of additional attempts before the probe is considered failed
+	// +kubebuilder:validation:Minimum=0
+	Retries int64 `json:"retries,omitempty" protobuf:"varint,4,opt,name=retries"`
+	// Interval is the delay between attempts. Default unit is seconds, but could also be a duration (e.g. "10s", "1m"). Defaults to 5s
+	Interval string `json:"interval,omitempty" protobuf:"bytes,5,opt,name=interval"`
+	// Timeout is the timeout of a single attempt. Default unit is seconds, but could also be a duration (e.g. "10s", "1m"). Defaults to 10s
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,6,opt,name=timeout"`
+}
+
+// GetInterval returns the delay between attempts of the probe
+func (p *SyncVerificationProbe) GetInterval() (time.Duration, error) {
+	if p.Interval == "" {
+		return DefaultSyncVerificationInterval, nil
+	}
+	return parseStringToDuration(p.Interval)
+}
+
+// GetTimeout returns the timeout of a single attempt of the probe
+func (p *SyncVerificationProbe) GetTimeout() (time.Duration, error) {
+	if p.Timeout == "" {
+		return DefaultSyncVerificationTimeout, nil
+	}
+	return parseStringToDuration(p.Timeout)
+}
+
+// Validate verifies that exactly one of HTTP and GRPC is set and that the durations of the probe are valid
+func (p *SyncVerificationProbe) Validate() error {
+	switch {
+	case p.HTTP != nil && p.GRPC != nil:
+		return fmt.Errorf("probe %q: only one of http and grpc may be set", p.Name)
+	case p.HTTP == nil && p.GRPC == nil:
+		return fmt.Errorf("probe %q: one of http and grpc must be set", p.Name)
+	case p.HTTP != nil && p.HTTP.URL == "":
+		return fmt.Errorf("probe %q: http url must be set", p.Name)
+	case p.GRPC != nil && p.GRPC.Address == "":
+		return fmt.Errorf("probe %q: grpc address must be set", p.Name)
+	case p.Retries < 0:
+		return fmt.Errorf("probe %q: retries must not be negative", p.Name)
+	}
+	if _, err := p.GetInterval(); err != nil {
+		return fmt.Errorf("probe %q: invalid interval: %w", p.Name, err)
+	}
+	if _, err := p.GetTimeout(); err != nil {
+		return fmt.Errorf("probe %q: invalid timeout: %w", p.Name, err)
+	}
+	return nil
+}
+
+// Validate verifies that the probes have unique names and are valid
+func (v *SyncPolicyVerification) Validate() error {
+	if v == nil {
+		return nil
+	}
+	names := make(map[string]bool, len(v.Probes))
+	for i := range v.Probes {
+		probe := &v.Probes[i]
+		if probe.Name == "" {
+			return fmt.Errorf("probe %d: name must be set", i)
+		}
+		if names[probe.Name] {
+			return fmt.Errorf("probe %q: name must be unique", probe.Name)
+		}
+		names[probe.Name] = true
+		if err := probe.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SyncVerificationHTTPProbe is a HTTP GET request verifying the response status code
+type SyncVerificationHTTPProbe struct {
+	// URL is a Go template of the requested URL. The application is available as .app and the synced revision as
+	// .revision (.revisions for multi-source applications), e.g. http://{{.app.metadata.name}}.{{.app.spec.destination.namespace}}.svc/healthz
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// ExpectedStatus is the expected response status code. Any 2xx status code is accepted if not set
+	ExpectedStatus int64 `json:"expectedStatus,omitempty" protobuf:"varint,2,opt,name=expectedStatus"`
+	// InsecureSkipVerify disables the verification of the server certificate
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"bytes,3,opt,name=insecureSkipVerify"`
+}
+
+// SyncVerificationGRPCProbe is a request using the gRPC health checking protocol verifying the serving status
+type SyncVerificationGRPCProbe struct {
+	// Address is a Go template of the host:port of the gRPC server, see SyncVerificationHTTPProbe.URL for the available values
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Service is the service name of the health check request. The overall health of the server is checked if empty
+	Service string `json:"service,omitempty" protobuf:"bytes,2,opt,name=service"`
+	// ExpectedStatus is the expected serving status. Defaults to SERVING
+	ExpectedStatus string `json:"expectedStatus,omitempty" protobuf:"bytes,3,opt,name=expectedStatus"`
+	// TLS enables TLS for the connection to the server
+	TLS bool `json:"tls,omitempty" protobuf:"bytes,4,opt,name=tls"`
+	// InsecureSkipVerify disables the verification of the server certificate
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"bytes,5,opt,name=insecureSkipVerify"`
 }
 
 // SyncStrategy controls the manner in which a sync is performed
@@ -1693,6 +1923,41 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// Verification contains the results of the post-sync verification probes
+	Verification *SyncVerificationResult `json:"verification,omitempty" protobuf:"bytes,7,opt,name=verification"`
+	// ManifestsHash is the hash of the manifests this sync operation was performed with, used for comparing auto-sync
+	ManifestsHash string `json:"manifestsHash,omitempty" protobuf:"bytes,8,opt,name=manifestsHash"`
+}
+
+// SyncVerificationResult holds the results of the post-sync verification probes of a sync operation
+type SyncVerificationResult struct {
+	// Probes contains the result of each probe
+	Probes []SyncVerificationProbeResult `json:"probes,omitempty" protobuf:"bytes,1,rep,name=probes"`
+}
+
+// Failed returns true if any of the probes failed
+func (r *SyncVerificationResult) Failed() bool {
+	if r == nil {
+		return false
+	}
+	for _, probe := range r.Probes {
+		if !probe.Succeeded {
+			return true
+		}
+	}
+	return false
+}
+
+// SyncVerificationProbeResult holds the result of a single post-sync verification probe
+type SyncVerificationProbeResult struct {
+	// Name is the name of the probe
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Succeeded is true if the probe succeeded
+	Succeeded bool `json:"succeeded,omitempty" protobuf:"bytes,2,opt,name=succeeded"`
+	// Attempts is the number of times the probe was executed
+	Attempts int64 `json:"attempts,omitempty" protobuf:"varint,3,opt,name=attempts"`
+	// Message contains the outcome of the last attempt
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1755,6 +2020,39 @@ func (r ResourceResults) PruningRequired() (num int) {
 	return num
 }
 
+// OperationLogEntry holds the outcome of a completed operation of an application. Entries are retained by the
+// application controller after the operation state of the application has been replaced by a newer operation.
+type OperationLogEntry struct {
+	// ID is the sequence number of the operation in the operation log of the application
+	ID int64 `json:"id" protobuf:"varint,1,opt,name=id"`
+	// Operation is the operation that was performed
+	Operation Operation `json:"operation" protobuf:"bytes,2,opt,name=operation"`
+	// Phase is the final phase of the operation
+	Phase synccommon.OperationPhase `json:"phase" protobuf:"bytes,3,opt,name=phase"`
+	// Message holds the final message of the operation
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// Revisions holds the revisions the operation was performed to
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
+	// StartedAt contains time of operation start
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,6,opt,name=startedAt"`
+	// FinishedAt contains time of operation completion
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
+	// RetryCount contains the number of times the operation was retried
+	RetryCount int64 `json:"retryCount,omitempty" protobuf:"varint,8,opt,name=retryCount"`
+	// Resources holds the results of every resource the operation was performed on
+	Resources []*OperationLogResourceResult `json:"resources,omitempty" protobuf:"bytes,9,opt,name=resources"`
+}
+
+// OperationLogResourceResult holds the result of a single resource of an operation recorded in the operation log
+type OperationLogResourceResult struct {
+	ResourceResult `json:",inline" protobuf:"bytes,1,opt,name=resourceResult"`
+	// Duration is the approximate time it took for the resource to reach its final state. It is only populated when
+	// the operation was observed from start to finish by the same application controller instance.
+	Duration *metav1.Duration `json:"duration,omitempty" protobuf:"bytes,2,opt,name=duration"`
+	// Warnings contains the warnings returned by the Kubernetes API server while syncing the resource
+	Warnings []string `json:"warnings,omitempty" protobuf:"bytes,3,opt,name=warnings"`
+}
+
 // RevisionHistory contains history information about a previous sync
 type RevisionHistory struct {
 	// Revision holds the revision the sync was performed against
@@ -1840,6 +2138,12 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionChildApplicationWarning indicates that application has child applications which are degraded or missing
+	ApplicationConditionChildApplicationWarning = "ChildApplicationWarning"
+	// ApplicationConditionAutoSyncSuspendedWarning indicates that automated sync is suspended because the application health is flapping
+	ApplicationConditionAutoSyncSuspendedWarning = "AutoSyncSuspendedWarning"
+	// ApplicationConditionDeprecatedAPIInUseWarning indicates that application has resources which use API versions that are deprecated or removed in the Kubernetes version of the destination cluster
+	ApplicationConditionDeprecatedAPIInUseWarning = "DeprecatedAPIInUseWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -1886,6 +2190,9 @@ type AppHealthStatus struct {
 	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 	// LastTransitionTime is the time the HealthStatus was set or updated
 	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
+	// DegradedTransitionTimes are the times at which the application health recently changed to Degraded. They are
+	// only recorded if flap detection is enabled for automated sync, and only within the flap detection window.
+	DegradedTransitionTimes []metav1.Time `json:"degradedTransitionTimes,omitempty" protobuf:"bytes,4,rep,name=degradedTransitionTimes"`
 }
 
 // HealthStatus contains information about the currently observed health state of a resource
@@ -2755,6 +3062,33 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// RoleBindings bind groups of the SSO provider to roles of this project, in addition to the groups of the roles themselves
+	// +listType=map
+	// +listMapKey=name
+	RoleBindings []ProjectRoleBinding `json:"roleBindings,omitempty" protobuf:"bytes,15,rep,name=roleBindings"`
+	// SourcePaths restricts the paths within the source repositories which can be used for deployment
+	SourcePaths []SourcePathRestriction `json:"sourcePaths,omitempty" protobuf:"bytes,16,rep,name=sourcePaths"`
+	// SOPS configures the keys used to decrypt SOPS encrypted Helm value files of the applications in this project
+	SOPS *AppProjectSOPS `json:"sops,omitempty" protobuf:"bytes,17,opt,name=sops"`
+	// ResourceQuota limits the resources each application in this project may manage
+	ResourceQuota *AppProjectResourceQuota `json:"resourceQuota,omitempty" protobuf:"bytes,18,opt,name=resourceQuota"`
+}
+
+// AppProjectResourceQuota limits the number and the size of the resources managed by each application of a project.
+// Syncs of applications exceeding the quota fail.
+type AppProjectResourceQuota struct {
+	// MaxResources is the maximum number of resources an application may manage. No limit applies if zero.
+	MaxResources int64 `json:"maxResources,omitempty" protobuf:"varint,1,opt,name=maxResources"`
+	// MaxManifestSize is the maximum combined size of the manifests of the resources an application may manage,
+	// e.g. 10M. No limit applies if not set.
+	MaxManifestSize *resource.Quantity `json:"maxManifestSize,omitempty" protobuf:"bytes,2,opt,name=maxManifestSize"`
+}
+
+// AppProjectSOPS configures the decryption of SOPS encrypted Helm value files
+type AppProjectSOPS struct {
+	// SecretName is the name of a secret in the Argo CD namespace holding the keys. The secret must be labeled with
+	// argocd.argoproj.io/secret-type=sops. Each entry is passed to sops as environment variable, e.g. SOPS_AGE_KEY.
+	SecretName string `json:"secretName" protobuf:"bytes,1,opt,name=secretName"`
 }
 
 // ClusterResourceRestrictionItem is a cluster resource that is restricted by the project's whitelist or blacklist
@@ -2766,6 +3100,15 @@ type ClusterResourceRestrictionItem struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
 }
 
+// SourcePathRestriction restricts the paths within the repositories matching RepoURL which can be used as application source
+type SourcePathRestriction struct {
+	// RepoURL is a glob pattern of the URLs of the repositories the restriction applies to
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Paths contains glob patterns of the permitted paths within the repositories, relative to the repository root.
+	// Patterns prefixed with '!' deny the matching paths. If all patterns are deny patterns, all other paths are permitted.
+	Paths []string `json:"paths" protobuf:"bytes,2,rep,name=paths"`
+}
+
 // SyncWindows is a collection of sync windows in this project
 type SyncWindows []*SyncWindow
 
@@ -3017,11 +3360,15 @@ func (w *SyncWindows) Matches(app *Application) *SyncWindows {
 
 // CanSync returns true if a sync window currently allows a sync. isManual indicates whether the sync has been triggered manually.
 func (w *SyncWindows) CanSync(isManual bool) (bool, error) {
+	return w.canSync(isManual, time.Now())
+}
+
+func (w *SyncWindows) canSync(isManual bool, currentTime time.Time) (bool, error) {
 	if !w.HasWindows() {
 		return true, nil
 	}
 
-	active, err := w.Active()
+	active, err := w.active(currentTime)
 	if err != nil {
 		return false, fmt.Errorf("invalid sync windows: %w", err)
 	}
@@ -3038,7 +3385,7 @@ func (w *SyncWindows) CanSync(isManual bool) (bool, error) {
 		return true, nil
 	}
 
-	inactiveAllows, err := w.InactiveAllows()
+	inactiveAllows, err := w.inactiveAllows(currentTime)
 	if err != nil {
 		return false, fmt.Errorf("invalid sync windows: %w", err)
 	}
@@ -3247,6 +3594,30 @@ type ProjectRole struct {
 	Groups []string `json:"groups,omitempty" protobuf:"bytes,5,rep,name=groups"`
 }
 
+// ProjectRoleBinding binds a list of OIDC groups to a project role. Bindings are managed independently of the role
+// definition, so that group membership can be maintained by other teams, and may expire.
+type ProjectRoleBinding struct {
+	// Name is the unique name of the binding
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Role is the name of the project role the groups are bound to
+	Role string `json:"role" protobuf:"bytes,2,opt,name=role"`
+	// Groups are a list of OIDC group claims bound to the role
+	Groups []string `json:"groups" protobuf:"bytes,3,rep,name=groups"`
+	// ExpiresAt is the time after which the binding no longer grants the role. The binding never expires if not set.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty" protobuf:"bytes,4,opt,name=expiresAt"`
+	// CreatedBy records who requested the binding, for auditing purposes
+	CreatedBy string `json:"createdBy,omitempty" protobuf:"bytes,5,opt,name=createdBy"`
+	// CreatedAt records when the binding was requested, for auditing purposes
+	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,6,opt,name=createdAt"`
+	// Reason records why the binding was requested, for auditing purposes
+	Reason string `json:"reason,omitempty" protobuf:"bytes,7,opt,name=reason"`
+}
+
+// IsExpired returns whether the binding has expired at the given time
+func (b *ProjectRoleBinding) IsExpired(now time.Time) bool {
+	return b.ExpiresAt != nil && !now.Before(b.ExpiresAt.Time)
+}
+
 // JWTToken holds the issuedAt and expiresAt values of a token
 type JWTToken struct {
 	IssuedAt  int64  `json:"iat" protobuf:"int64,1,opt,name=iat"`
@@ -105,6 +105,106 @@ func TestAppProject_IsNegatedSourcePermitted(t *testing.T) {
 	}
 }
 
+func TestAppProject_IsSourcePathPermitted(t *testing.T) {
+	monorepo := "https://github.com/argoproj/monorepo.git"
+	testData := []struct {
+		name        string
+		sourcePaths []SourcePathRestriction
+		source      ApplicationSource
+		isPermitted bool
+	}{{
+		name:        "no restrictions",
+		source:      ApplicationSource{RepoURL: monorepo, Path: "apps/team-b"},
+		isPermitted: true,
+	}, {
+		name:        "allowed path",
+		sourcePaths: []SourcePathRestriction{{RepoURL: monorepo, Paths: []string{"apps/team-a/**"}}},
+		source:      ApplicationSource{RepoURL: monorepo, Path: "apps/team-a/frontend"},
+		isPermitted: true,
+	}, {
+		name:        "allowed directory itself",
+		sourcePaths: []SourcePathRestriction{{RepoURL: monorepo, Paths: []string{"apps/team-a/**"}}},
+		source:      ApplicationSource{RepoURL: monorepo, Path: "./apps/team-a/"},
+		isPermitted: true,
+	}, {
+		name:        "path outside of allowed paths",
+		sourcePaths: []SourcePathRestriction{{RepoURL: monorepo, Paths: []string{"apps/team-a/**"}}},
+		source:      ApplicationSource{RepoURL: monorepo, Path: "apps/team-b"},
+		isPermitted: false,
+	}, {
+		name:        "relative path escaping allowed path",
+		sourcePaths: []SourcePathRestriction{{RepoURL: monorepo, Paths: []string{"apps/team-a/**"}}},
+		source:      ApplicationSource{RepoURL: monorepo, Path: "apps/team-a/../team-b"},
+		isPermitted: false,
+	}, {
+		name:        "repository root",
+		sourcePaths: []SourcePathRestriction{{RepoURL: monorepo, Paths: []string{"apps/team-a/**"}}},
+		source:      ApplicationSource{RepoURL: monorepo},
+		isPermitted: false,
+	}, {
+		name:        "single path segment wildcard",
+		sourcePaths: []SourcePathRestriction{{RepoURL: monorepo, Paths: []string{"apps/*"}}},
+		source:      ApplicationSource{RepoURL: monorepo, Path: "apps/team-a/frontend"},
+		isPermitted: false,
+	}, {
+		name:        "denied path",
+		sourcePaths: []SourcePathRestriction{{RepoURL: monorepo, Paths: []string{"apps/**", "!apps/platform/**"}}},
+		source:      ApplicationSource{RepoURL: monorepo, Path: "apps/platform/ingress"},
+		isPermitted: false,
+	}, {
+		name:        "only deny patterns",
+		sourcePaths: []SourcePathRestriction{{RepoURL: monorepo, Paths: []string{"!apps/platform/**"}}},
+		source:      ApplicationSource{RepoURL: monorepo, Path: "apps/team-a"},
+		isPermitted: true,
+	}, {
+		name:        "restriction of other repository",
+		sourcePaths: []SourcePathRestriction{{RepoURL: "https://github.com/argoproj/other.git", Paths: []string{"apps/team-a/**"}}},
+		source:      ApplicationSource{RepoURL: monorepo, Path: "apps/team-b"},
+		isPermitted: true,
+	}, {
+		name:        "repository glob and normalized URL",
+		sourcePaths: []SourcePathRestriction{{RepoURL: "https://github.com/argoproj/*", Paths: []string{"apps/team-a/**"}}},
+		source:      ApplicationSource{RepoURL: "https://GITHUB.com/argoproj/monorepo", Path: "apps/team-b"},
+		isPermitted: false,
+	}, {
+		name: "all matching restrictions must permit the path",
+		sourcePaths: []SourcePathRestriction{
+			{RepoURL: "*", Paths: []string{"apps/**"}},
+			{RepoURL: monorepo, Paths: []string{"!apps/platform/**"}},
+		},
+		source:      ApplicationSource{RepoURL: monorepo, Path: "apps/platform"},
+		isPermitted: false,
+	}, {
+		name:        "helm chart",
+		sourcePaths: []SourcePathRestriction{{RepoURL: "*", Paths: []string{"apps/team-a/**"}}},
+		source:      ApplicationSource{RepoURL: "https://charts.example.com", Chart: "nginx"},
+		isPermitted: true,
+	}, {
+		name:        "ref only source",
+		sourcePaths: []SourcePathRestriction{{RepoURL: monorepo, Paths: []string{"apps/team-a/**"}}},
+		source:      ApplicationSource{RepoURL: monorepo, Ref: "values"},
+		isPermitted: true,
+	}}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			proj := AppProject{Spec: AppProjectSpec{SourceRepos: []string{"*"}, SourcePaths: data.sourcePaths}}
+			assert.Equal(t, data.isPermitted, proj.IsSourcePathPermitted(data.source))
+		})
+	}
+}
+
+func TestAppProject_IsRepoPathPermitted(t *testing.T) {
+	monorepo := "https://github.com/argoproj/monorepo.git"
+	proj := AppProject{Spec: AppProjectSpec{SourcePaths: []SourcePathRestriction{{RepoURL: monorepo, Paths: []string{"apps/team-a/**", "!apps/team-a/secrets/**"}}}}}
+
+	assert.True(t, proj.IsRepoPathPermitted(monorepo, "apps/team-a/values"))
+	assert.True(t, proj.IsRepoPathPermitted("https://github.com/argoproj/other.git", "apps/team-b"))
+	assert.False(t, proj.IsRepoPathPermitted(monorepo, "apps/team-b"))
+	assert.False(t, proj.IsRepoPathPermitted(monorepo, "apps/team-a/secrets"))
+	assert.False(t, proj.IsRepoPathPermitted(monorepo, "."))
+}
+
 func TestAppProject_IsDestinationPermitted(t *testing.T) {
 	t.Parallel()
 
@@ -626,6 +726,53 @@ func TestAppProject_RemoveGroupFromRole(t *testing.T) {
 	})
 }
 
+func TestAppProject_ValidateRoleBindings(t *testing.T) {
+	p := newTestProject()
+	p.Spec.RoleBindings = []ProjectRoleBinding{{Name: "team-a", Role: "my-role", Groups: []string{"team-a"}}}
+	require.NoError(t, p.ValidateProject())
+
+	badBindings := map[string][]ProjectRoleBinding{
+		"DuplicateName":   {{Name: "team-a", Role: "my-role", Groups: []string{"team-a"}}, {Name: "team-a", Role: "my-role", Groups: []string{"team-b"}}},
+		"InvalidName":     {{Name: "team a", Role: "my-role", Groups: []string{"team-a"}}},
+		"UnknownRole":     {{Name: "team-a", Role: "other-role", Groups: []string{"team-a"}}},
+		"NoGroups":        {{Name: "team-a", Role: "my-role"}},
+		"DuplicateGroup":  {{Name: "team-a", Role: "my-role", Groups: []string{"team-a", "team-a"}}},
+		"InvalidGroup":    {{Name: "team-a", Role: "my-role", Groups: []string{"team,a"}}},
+		"EmptyGroupValue": {{Name: "team-a", Role: "my-role", Groups: []string{""}}},
+	}
+	for name, bindings := range badBindings {
+		t.Run(name, func(t *testing.T) {
+			p := newTestProject()
+			p.Spec.RoleBindings = bindings
+			require.Error(t, p.ValidateProject())
+		})
+	}
+}
+
+func TestAppProject_GetRoleGroups(t *testing.T) {
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+	p := newTestProject()
+	p.Spec.Roles[0].Groups = []string{"admins"}
+	p.Spec.Roles = append(p.Spec.Roles, ProjectRole{Name: "other-role"})
+	p.Spec.RoleBindings = []ProjectRoleBinding{
+		{Name: "permanent", Role: "my-role", Groups: []string{"team-a", "admins"}},
+		{Name: "active", Role: "my-role", Groups: []string{"team-b"}, ExpiresAt: &future},
+		{Name: "expired", Role: "my-role", Groups: []string{"team-c"}, ExpiresAt: &past},
+		{Name: "other", Role: "other-role", Groups: []string{"team-d"}},
+	}
+
+	assert.Equal(t, []string{"admins", "team-a", "team-b"}, p.GetRoleGroups(&p.Spec.Roles[0]))
+	assert.Equal(t, []string{"team-d"}, p.GetRoleGroups(&p.Spec.Roles[1]))
+	assert.Equal(t, []string{"admins"}, p.Spec.Roles[0].Groups)
+
+	policies := p.ProjectPoliciesString()
+	assert.Contains(t, policies, "g, team-a, proj:my-proj:my-role")
+	assert.Contains(t, policies, "g, team-b, proj:my-proj:my-role")
+	assert.Contains(t, policies, "g, team-d, proj:my-proj:other-role")
+	assert.NotContains(t, policies, "team-c")
+}
+
 func newTestProject() *AppProject {
 	p := AppProject{
 		ObjectMeta: metav1.ObjectMeta{Name: "my-proj"},
@@ -657,6 +804,25 @@ func TestAppProject_ValidateSources(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestAppProject_ValidateSourcePaths(t *testing.T) {
+	p := newTestProject()
+	p.Spec.SourcePaths = []SourcePathRestriction{{RepoURL: "https://github.com/argoproj/*", Paths: []string{"apps/team-a/**", "!apps/team-a/secrets/**"}}}
+	require.NoError(t, p.ValidateProject())
+
+	badRestrictions := [][]SourcePathRestriction{
+		{{RepoURL: "", Paths: []string{"apps/**"}}},
+		{{RepoURL: "!https://github.com/argoproj/*", Paths: []string{"apps/**"}}},
+		{{RepoURL: "https://github.com/argoproj/*"}},
+		{{RepoURL: "https://github.com/argoproj/*", Paths: []string{"!"}}},
+		{{RepoURL: "https://github.com/argoproj/*", Paths: []string{"apps/[team"}}},
+		{{RepoURL: "foo", Paths: []string{"a"}}, {RepoURL: "foo", Paths: []string{"b"}}},
+	}
+	for _, restrictions := range badRestrictions {
+		p.Spec.SourcePaths = restrictions
+		require.Error(t, p.ValidateProject())
+	}
+}
+
 // TestAppProject_ValidateDestinations tests for an invalid destination
 func TestAppProject_ValidateDestinations(t *testing.T) {
 	p := newTestProject()
@@ -4875,3 +5041,33 @@ func TestSourceHydrator_Equals(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncPolicyVerification_Validate(t *testing.T) {
+	httpProbe := &SyncVerificationHTTPProbe{URL: "http://my-app.default.svc/healthz"}
+	grpcProbe := &SyncVerificationGRPCProbe{Address: "my-app.default.svc:9090"}
+	testCases := []struct {
+		name          string
+		probes        []SyncVerificationProbe
+		expectedError string
+	}{
+		{"valid", []SyncVerificationProbe{{Name: "http", HTTP: httpProbe}, {Name: "grpc", GRPC: grpcProbe, Interval: "1s", Timeout: "2s"}}, ""},
+		{"missing name", []SyncVerificationProbe{{HTTP: httpProbe}}, "probe 0: name must be set"},
+		{"duplicate name", []SyncVerificationProbe{{Name: "smoke", HTTP: httpProbe}, {Name: "smoke", GRPC: grpcProbe}}, `probe "smoke": name must be unique`},
+		{"http and grpc", []SyncVerificationProbe{{Name: "smoke", HTTP: httpProbe, GRPC: grpcProbe}}, `probe "smoke": only one of http and grpc may be set`},
+		{"neither http nor grpc", []SyncVerificationProbe{{Name: "smoke"}}, `probe "smoke": one of http and grpc must be set`},
+		{"missing url", []SyncVerificationProbe{{Name: "smoke", HTTP: &SyncVerificationHTTPProbe{}}}, `probe "smoke": http url must be set`},
+		{"negative retries", []SyncVerificationProbe{{Name: "smoke", HTTP: httpProbe, Retries: -1}}, `probe "smoke": retries must not be negative`},
+		{"invalid interval", []SyncVerificationProbe{{Name: "smoke", HTTP: httpProbe, Interval: "invalid"}}, `probe "smoke": invalid interval`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := (&SyncPolicyVerification{Probes: tc.probes}).Validate()
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+	require.NoError(t, (*SyncPolicyVerification)(nil).Validate())
+}
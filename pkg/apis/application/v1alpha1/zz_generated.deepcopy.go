@@ -49,6 +49,13 @@ func (in *AppHealthStatus) DeepCopyInto(out *AppHealthStatus) {
 		in, out := &in.LastTransitionTime, &out.LastTransitionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.DegradedTransitionTimes != nil {
+		in, out := &in.DegradedTransitionTimes, &out.DegradedTransitionTimes
+		*out = make([]v1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -123,6 +130,43 @@ func (in *AppProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppProjectResourceQuota) DeepCopyInto(out *AppProjectResourceQuota) {
+	*out = *in
+	if in.MaxManifestSize != nil {
+		in, out := &in.MaxManifestSize, &out.MaxManifestSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppProjectResourceQuota.
+func (in *AppProjectResourceQuota) DeepCopy() *AppProjectResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(AppProjectResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppProjectSOPS) DeepCopyInto(out *AppProjectSOPS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppProjectSOPS.
+func (in *AppProjectSOPS) DeepCopy() *AppProjectSOPS {
+	if in == nil {
+		return nil
+	}
+	out := new(AppProjectSOPS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 	*out = *in
@@ -194,6 +238,30 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.RoleBindings != nil {
+		in, out := &in.RoleBindings, &out.RoleBindings
+		*out = make([]ProjectRoleBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourcePaths != nil {
+		in, out := &in.SourcePaths, &out.SourcePaths
+		*out = make([]SourcePathRestriction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SOPS != nil {
+		in, out := &in.SOPS, &out.SOPS
+		*out = new(AppProjectSOPS)
+		**out = **in
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(AppProjectResourceQuota)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -382,6 +450,11 @@ func (in *ApplicationPreservedFields) DeepCopyInto(out *ApplicationPreservedFiel
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -435,6 +508,16 @@ func (in *ApplicationSetApplicationStatus) DeepCopyInto(out *ApplicationSetAppli
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastHealthyTargetRevisions != nil {
+		in, out := &in.LastHealthyTargetRevisions, &out.LastHealthyTargetRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StepAnalysis != nil {
+		in, out := &in.StepAnalysis, &out.StepAnalysis
+		*out = new(ApplicationSetStepAnalysisStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -448,6 +531,49 @@ func (in *ApplicationSetApplicationStatus) DeepCopy() *ApplicationSetApplication
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetCachedParameters) DeepCopyInto(out *ApplicationSetCachedParameters) {
+	*out = *in
+	in.GeneratedAt.DeepCopyInto(&out.GeneratedAt)
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetCachedParameters.
+func (in *ApplicationSetCachedParameters) DeepCopy() *ApplicationSetCachedParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetCachedParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetCanaryStrategy) DeepCopyInto(out *ApplicationSetCanaryStrategy) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetCanaryStrategy.
+func (in *ApplicationSetCanaryStrategy) DeepCopy() *ApplicationSetCanaryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetCanaryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetCondition) DeepCopyInto(out *ApplicationSetCondition) {
 	*out = *in
@@ -468,6 +594,33 @@ func (in *ApplicationSetCondition) DeepCopy() *ApplicationSetCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetGenerationRecord) DeepCopyInto(out *ApplicationSetGenerationRecord) {
+	*out = *in
+	in.GeneratedAt.DeepCopyInto(&out.GeneratedAt)
+	if in.AddedApplications != nil {
+		in, out := &in.AddedApplications, &out.AddedApplications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovedApplications != nil {
+		in, out := &in.RemovedApplications, &out.RemovedApplications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetGenerationRecord.
+func (in *ApplicationSetGenerationRecord) DeepCopy() *ApplicationSetGenerationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetGenerationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 	*out = *in
@@ -521,6 +674,36 @@ func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(ApplicationSetGeneratorTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Terraform != nil {
+		in, out := &in.Terraform, &out.Terraform
+		*out = new(TerraformGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudInventory != nil {
+		in, out := &in.CloudInventory, &out.CloudInventory
+		*out = new(CloudInventoryGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequeueStrategy != nil {
+		in, out := &in.RequeueStrategy, &out.RequeueStrategy
+		*out = new(ApplicationSetGeneratorRequeueStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -534,6 +717,91 @@ func (in *ApplicationSetGenerator) DeepCopy() *ApplicationSetGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetGeneratorFailures) DeepCopyInto(out *ApplicationSetGeneratorFailures) {
+	*out = *in
+	in.LastFailedAt.DeepCopyInto(&out.LastFailedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetGeneratorFailures.
+func (in *ApplicationSetGeneratorFailures) DeepCopy() *ApplicationSetGeneratorFailures {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetGeneratorFailures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetGeneratorRequeueStrategy) DeepCopyInto(out *ApplicationSetGeneratorRequeueStrategy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JitterPercent != nil {
+		in, out := &in.JitterPercent, &out.JitterPercent
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetGeneratorRequeueStrategy.
+func (in *ApplicationSetGeneratorRequeueStrategy) DeepCopy() *ApplicationSetGeneratorRequeueStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetGeneratorRequeueStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetGeneratorTransform) DeepCopyInto(out *ApplicationSetGeneratorTransform) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]ApplicationSetGeneratorTransformField, len(*in))
+		copy(*out, *in)
+	}
+	if in.Remove != nil {
+		in, out := &in.Remove, &out.Remove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetGeneratorTransform.
+func (in *ApplicationSetGeneratorTransform) DeepCopy() *ApplicationSetGeneratorTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetGeneratorTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetGeneratorTransformField) DeepCopyInto(out *ApplicationSetGeneratorTransformField) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetGeneratorTransformField.
+func (in *ApplicationSetGeneratorTransformField) DeepCopy() *ApplicationSetGeneratorTransformField {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetGeneratorTransformField)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ApplicationSetIgnoreDifferences) DeepCopyInto(out *ApplicationSetIgnoreDifferences) {
 	{
@@ -642,6 +910,31 @@ func (in *ApplicationSetNestedGenerator) DeepCopyInto(out *ApplicationSetNestedG
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(ApplicationSetGeneratorTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Terraform != nil {
+		in, out := &in.Terraform, &out.Terraform
+		*out = new(TerraformGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudInventory != nil {
+		in, out := &in.CloudInventory, &out.CloudInventory
+		*out = new(CloudInventoryGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -677,6 +970,38 @@ func (in ApplicationSetNestedGenerators) DeepCopy() ApplicationSetNestedGenerato
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetPrometheusAnalysis) DeepCopyInto(out *ApplicationSetPrometheusAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetPrometheusAnalysis.
+func (in *ApplicationSetPrometheusAnalysis) DeepCopy() *ApplicationSetPrometheusAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetPrometheusAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetProvenanceLabels) DeepCopyInto(out *ApplicationSetProvenanceLabels) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetProvenanceLabels.
+func (in *ApplicationSetProvenanceLabels) DeepCopy() *ApplicationSetProvenanceLabels {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetProvenanceLabels)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetResourceIgnoreDifferences) DeepCopyInto(out *ApplicationSetResourceIgnoreDifferences) {
 	*out = *in
@@ -703,6 +1028,22 @@ func (in *ApplicationSetResourceIgnoreDifferences) DeepCopy() *ApplicationSetRes
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRollbackOnFailure) DeepCopyInto(out *ApplicationSetRollbackOnFailure) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRollbackOnFailure.
+func (in *ApplicationSetRollbackOnFailure) DeepCopy() *ApplicationSetRollbackOnFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRollbackOnFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep) {
 	*out = *in
@@ -718,6 +1059,11 @@ func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(ApplicationSetStepAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -741,6 +1087,11 @@ func (in *ApplicationSetRolloutStrategy) DeepCopyInto(out *ApplicationSetRollout
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RollbackOnFailure != nil {
+		in, out := &in.RollbackOnFailure, &out.RollbackOnFailure
+		*out = new(ApplicationSetRollbackOnFailure)
+		**out = **in
+	}
 	return
 }
 
@@ -797,6 +1148,26 @@ func (in *ApplicationSetSpec) DeepCopyInto(out *ApplicationSetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.UniqueAnnotations != nil {
+		in, out := &in.UniqueAnnotations, &out.UniqueAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProvenanceLabels != nil {
+		in, out := &in.ProvenanceLabels, &out.ProvenanceLabels
+		*out = new(ApplicationSetProvenanceLabels)
+		**out = **in
+	}
+	if in.TemplatePatchScript != nil {
+		in, out := &in.TemplatePatchScript, &out.TemplatePatchScript
+		*out = new(string)
+		**out = **in
+	}
+	if in.SyncWindows != nil {
+		in, out := &in.SyncWindows, &out.SyncWindows
+		*out = make([]ApplicationSetSyncWindow, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -835,6 +1206,42 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 		}
 	}
 	in.Health.DeepCopyInto(&out.Health)
+	if in.StaleApplications != nil {
+		in, out := &in.StaleApplications, &out.StaleApplications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GenerationHistory != nil {
+		in, out := &in.GenerationHistory, &out.GenerationHistory
+		*out = make([]ApplicationSetGenerationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(ApplicationSetSummary)
+		**out = **in
+	}
+	if in.CachedParameters != nil {
+		in, out := &in.CachedParameters, &out.CachedParameters
+		*out = make([]ApplicationSetCachedParameters, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GeneratorFailures != nil {
+		in, out := &in.GeneratorFailures, &out.GeneratorFailures
+		*out = make([]ApplicationSetGeneratorFailures, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SyncWindows != nil {
+		in, out := &in.SyncWindows, &out.SyncWindows
+		*out = new(ApplicationSetSyncWindowsStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -848,6 +1255,47 @@ func (in *ApplicationSetStatus) DeepCopy() *ApplicationSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetStepAnalysis) DeepCopyInto(out *ApplicationSetStepAnalysis) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(ApplicationSetPrometheusAnalysis)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetStepAnalysis.
+func (in *ApplicationSetStepAnalysis) DeepCopy() *ApplicationSetStepAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetStepAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetStepAnalysisStatus) DeepCopyInto(out *ApplicationSetStepAnalysisStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetStepAnalysisStatus.
+func (in *ApplicationSetStepAnalysisStatus) DeepCopy() *ApplicationSetStepAnalysisStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetStepAnalysisStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetStrategy) DeepCopyInto(out *ApplicationSetStrategy) {
 	*out = *in
@@ -856,6 +1304,11 @@ func (in *ApplicationSetStrategy) DeepCopyInto(out *ApplicationSetStrategy) {
 		*out = new(ApplicationSetRolloutStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(ApplicationSetCanaryStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -869,6 +1322,22 @@ func (in *ApplicationSetStrategy) DeepCopy() *ApplicationSetStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetSummary) DeepCopyInto(out *ApplicationSetSummary) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetSummary.
+func (in *ApplicationSetSummary) DeepCopy() *ApplicationSetSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetSyncPolicy) DeepCopyInto(out *ApplicationSetSyncPolicy) {
 	*out = *in
@@ -885,7 +1354,43 @@ func (in *ApplicationSetSyncPolicy) DeepCopy() *ApplicationSetSyncPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ApplicationSetSyncPolicy)
+	out := new(ApplicationSetSyncPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetSyncWindow) DeepCopyInto(out *ApplicationSetSyncWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetSyncWindow.
+func (in *ApplicationSetSyncWindow) DeepCopy() *ApplicationSetSyncWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetSyncWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetSyncWindowsStatus) DeepCopyInto(out *ApplicationSetSyncWindowsStatus) {
+	*out = *in
+	if in.NextWindow != nil {
+		in, out := &in.NextWindow, &out.NextWindow
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetSyncWindowsStatus.
+func (in *ApplicationSetSyncWindowsStatus) DeepCopy() *ApplicationSetSyncWindowsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetSyncWindowsStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -986,6 +1491,31 @@ func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTermi
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(ApplicationSetGeneratorTransform)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Terraform != nil {
+		in, out := &in.Terraform, &out.Terraform
+		*out = new(TerraformGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudInventory != nil {
+		in, out := &in.CloudInventory, &out.CloudInventory
+		*out = new(CloudInventoryGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1437,6 +1967,11 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		copy(*out, *in)
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.ChildApplications != nil {
+		in, out := &in.ChildApplications, &out.ChildApplications
+		*out = new(ChildApplicationsSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1635,6 +2170,183 @@ func (in *ChartDetails) DeepCopy() *ChartDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildApplicationResourceStatus) DeepCopyInto(out *ChildApplicationResourceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildApplicationResourceStatus.
+func (in *ChildApplicationResourceStatus) DeepCopy() *ChildApplicationResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildApplicationResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildApplicationStatus) DeepCopyInto(out *ChildApplicationStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildApplicationStatus.
+func (in *ChildApplicationStatus) DeepCopy() *ChildApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildApplicationsSummary) DeepCopyInto(out *ChildApplicationsSummary) {
+	*out = *in
+	if in.FirstFailingChild != nil {
+		in, out := &in.FirstFailingChild, &out.FirstFailingChild
+		*out = new(ChildApplicationStatus)
+		**out = **in
+	}
+	if in.DeepestFailingResource != nil {
+		in, out := &in.DeepestFailingResource, &out.DeepestFailingResource
+		*out = new(ChildApplicationResourceStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildApplicationsSummary.
+func (in *ChildApplicationsSummary) DeepCopy() *ChildApplicationsSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildApplicationsSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInventoryGenerator) DeepCopyInto(out *CloudInventoryGenerator) {
+	*out = *in
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(CloudInventoryGeneratorAWS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(CloudInventoryGeneratorGCP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(CloudInventoryGeneratorAzure)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInventoryGenerator.
+func (in *CloudInventoryGenerator) DeepCopy() *CloudInventoryGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInventoryGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInventoryGeneratorAWS) DeepCopyInto(out *CloudInventoryGeneratorAWS) {
+	*out = *in
+	if in.AccessKeyIDRef != nil {
+		in, out := &in.AccessKeyIDRef, &out.AccessKeyIDRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.SecretAccessKeyRef != nil {
+		in, out := &in.SecretAccessKeyRef, &out.SecretAccessKeyRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInventoryGeneratorAWS.
+func (in *CloudInventoryGeneratorAWS) DeepCopy() *CloudInventoryGeneratorAWS {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInventoryGeneratorAWS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInventoryGeneratorAzure) DeepCopyInto(out *CloudInventoryGeneratorAzure) {
+	*out = *in
+	if in.ClientSecretRef != nil {
+		in, out := &in.ClientSecretRef, &out.ClientSecretRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInventoryGeneratorAzure.
+func (in *CloudInventoryGeneratorAzure) DeepCopy() *CloudInventoryGeneratorAzure {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInventoryGeneratorAzure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudInventoryGeneratorGCP) DeepCopyInto(out *CloudInventoryGeneratorGCP) {
+	*out = *in
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudInventoryGeneratorGCP.
+func (in *CloudInventoryGeneratorGCP) DeepCopy() *CloudInventoryGeneratorGCP {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudInventoryGeneratorGCP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -2019,6 +2731,13 @@ func (in *DuckTypeGenerator) DeepCopyInto(out *DuckTypeGenerator) {
 			(*out)[key] = val
 		}
 	}
+	if in.StatusFields != nil {
+		in, out := &in.StatusFields, &out.StatusFields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -2490,6 +3209,48 @@ func (in *KnownTypeField) DeepCopy() *KnownTypeField {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesResourceGenerator) DeepCopyInto(out *KubernetesResourceGenerator) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesResourceGenerator.
+func (in *KubernetesResourceGenerator) DeepCopy() *KubernetesResourceGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesResourceGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KustomizeGvk) DeepCopyInto(out *KustomizeGvk) {
 	*out = *in
@@ -2842,6 +3603,35 @@ func (in *NestedMergeGenerator) DeepCopy() *NestedMergeGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIGenerator) DeepCopyInto(out *OCIGenerator) {
+	*out = *in
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIGenerator.
+func (in *OCIGenerator) DeepCopy() *OCIGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCIMetadata) DeepCopyInto(out *OCIMetadata) {
 	*out = *in
@@ -2898,12 +3688,77 @@ func (in *OperationInitiator) DeepCopyInto(out *OperationInitiator) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationInitiator.
-func (in *OperationInitiator) DeepCopy() *OperationInitiator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationInitiator.
+func (in *OperationInitiator) DeepCopy() *OperationInitiator {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationInitiator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationLogEntry) DeepCopyInto(out *OperationLogEntry) {
+	*out = *in
+	in.Operation.DeepCopyInto(&out.Operation)
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*OperationLogResourceResult, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OperationLogResourceResult)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationLogEntry.
+func (in *OperationLogEntry) DeepCopy() *OperationLogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationLogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationLogResourceResult) DeepCopyInto(out *OperationLogResourceResult) {
+	*out = *in
+	in.ResourceResult.DeepCopyInto(&out.ResourceResult)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationLogResourceResult.
+func (in *OperationLogResourceResult) DeepCopy() *OperationLogResourceResult {
 	if in == nil {
 		return nil
 	}
-	out := new(OperationInitiator)
+	out := new(OperationLogResourceResult)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2922,6 +3777,16 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Termination != nil {
+		in, out := &in.Termination, &out.Termination
+		*out = new(OperationTermination)
+		**out = **in
+	}
+	if in.PendingPrune != nil {
+		in, out := &in.PendingPrune, &out.PendingPrune
+		*out = make([]SyncOperationResource, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2935,6 +3800,23 @@ func (in *OperationState) DeepCopy() *OperationState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationTermination) DeepCopyInto(out *OperationTermination) {
+	*out = *in
+	out.InitiatedBy = in.InitiatedBy
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationTermination.
+func (in *OperationTermination) DeepCopy() *OperationTermination {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationTermination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptionalArray) DeepCopyInto(out *OptionalArray) {
 	*out = *in
@@ -3175,6 +4057,35 @@ func (in *ProjectRole) DeepCopy() *ProjectRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRoleBinding) DeepCopyInto(out *ProjectRoleBinding) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRoleBinding.
+func (in *ProjectRoleBinding) DeepCopy() *ProjectRoleBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRoleBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestGenerator) DeepCopyInto(out *PullRequestGenerator) {
 	*out = *in
@@ -3342,6 +4253,26 @@ func (in *PullRequestGeneratorFilter) DeepCopyInto(out *PullRequestGeneratorFilt
 		*out = new(string)
 		**out = **in
 	}
+	if in.Stacked != nil {
+		in, out := &in.Stacked, &out.Stacked
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Draft != nil {
+		in, out := &in.Draft, &out.Draft
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuthorMatch != nil {
+		in, out := &in.AuthorMatch, &out.AuthorMatch
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4457,6 +5388,27 @@ func (in *SourceHydratorStatus) DeepCopy() *SourceHydratorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourcePathRestriction) DeepCopyInto(out *SourcePathRestriction) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourcePathRestriction.
+func (in *SourcePathRestriction) DeepCopy() *SourcePathRestriction {
+	if in == nil {
+		return nil
+	}
+	out := new(SourcePathRestriction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuccessfulHydrateOperation) DeepCopyInto(out *SuccessfulHydrateOperation) {
 	*out = *in
@@ -4575,6 +5527,11 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(SyncVerificationResult)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4631,6 +5588,11 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(SyncPolicyVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4652,6 +5614,11 @@ func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.FlapDetection != nil {
+		in, out := &in.FlapDetection, &out.FlapDetection
+		*out = new(SyncPolicyAutomatedFlapDetection)
+		**out = **in
+	}
 	return
 }
 
@@ -4665,6 +5632,45 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyAutomatedFlapDetection) DeepCopyInto(out *SyncPolicyAutomatedFlapDetection) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyAutomatedFlapDetection.
+func (in *SyncPolicyAutomatedFlapDetection) DeepCopy() *SyncPolicyAutomatedFlapDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyAutomatedFlapDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyVerification) DeepCopyInto(out *SyncPolicyVerification) {
+	*out = *in
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]SyncVerificationProbe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyVerification.
+func (in *SyncPolicyVerification) DeepCopy() *SyncPolicyVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSource) DeepCopyInto(out *SyncSource) {
 	*out = *in
@@ -4762,6 +5768,101 @@ func (in *SyncStrategyHook) DeepCopy() *SyncStrategyHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncVerificationGRPCProbe) DeepCopyInto(out *SyncVerificationGRPCProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncVerificationGRPCProbe.
+func (in *SyncVerificationGRPCProbe) DeepCopy() *SyncVerificationGRPCProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncVerificationGRPCProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncVerificationHTTPProbe) DeepCopyInto(out *SyncVerificationHTTPProbe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncVerificationHTTPProbe.
+func (in *SyncVerificationHTTPProbe) DeepCopy() *SyncVerificationHTTPProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncVerificationHTTPProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncVerificationProbe) DeepCopyInto(out *SyncVerificationProbe) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(SyncVerificationHTTPProbe)
+		**out = **in
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(SyncVerificationGRPCProbe)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncVerificationProbe.
+func (in *SyncVerificationProbe) DeepCopy() *SyncVerificationProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncVerificationProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncVerificationProbeResult) DeepCopyInto(out *SyncVerificationProbeResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncVerificationProbeResult.
+func (in *SyncVerificationProbeResult) DeepCopy() *SyncVerificationProbeResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncVerificationProbeResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncVerificationResult) DeepCopyInto(out *SyncVerificationResult) {
+	*out = *in
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]SyncVerificationProbeResult, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncVerificationResult.
+func (in *SyncVerificationResult) DeepCopy() *SyncVerificationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncVerificationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 	*out = *in
@@ -4865,3 +5966,115 @@ func (in *TagFilter) DeepCopy() *TagFilter {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformGenerator) DeepCopyInto(out *TerraformGenerator) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(TerraformGeneratorS3)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCS != nil {
+		in, out := &in.GCS, &out.GCS
+		*out = new(TerraformGeneratorGCS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerraformCloud != nil {
+		in, out := &in.TerraformCloud, &out.TerraformCloud
+		*out = new(TerraformGeneratorTerraformCloud)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformGenerator.
+func (in *TerraformGenerator) DeepCopy() *TerraformGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformGeneratorGCS) DeepCopyInto(out *TerraformGeneratorGCS) {
+	*out = *in
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformGeneratorGCS.
+func (in *TerraformGeneratorGCS) DeepCopy() *TerraformGeneratorGCS {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformGeneratorGCS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformGeneratorS3) DeepCopyInto(out *TerraformGeneratorS3) {
+	*out = *in
+	if in.AccessKeyIDRef != nil {
+		in, out := &in.AccessKeyIDRef, &out.AccessKeyIDRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.SecretAccessKeyRef != nil {
+		in, out := &in.SecretAccessKeyRef, &out.SecretAccessKeyRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformGeneratorS3.
+func (in *TerraformGeneratorS3) DeepCopy() *TerraformGeneratorS3 {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformGeneratorS3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerraformGeneratorTerraformCloud) DeepCopyInto(out *TerraformGeneratorTerraformCloud) {
+	*out = *in
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformGeneratorTerraformCloud.
+func (in *TerraformGeneratorTerraformCloud) DeepCopy() *TerraformGeneratorTerraformCloud {
+	if in == nil {
+		return nil
+	}
+	out := new(TerraformGeneratorTerraformCloud)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,12 @@
+package cluster
+
+const (
+	// API Group
+	Group string = "cluster.argoproj.io"
+
+	// Cluster constants
+	ClusterKind     string = "Cluster"
+	ClusterSingular string = "cluster"
+	ClusterPlural   string = "clusters"
+	ClusterFullName string = ClusterPlural + "." + Group
+)
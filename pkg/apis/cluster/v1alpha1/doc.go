@@ -0,0 +1,4 @@
+// Package v1alpha1 is the v1alpha1 version of the API of declaratively registered clusters.
+// +groupName=cluster.argoproj.io
+// +k8s:deepcopy-gen=package,register
+package v1alpha1
@@ -0,0 +1,91 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// ClusterConditionRegistered indicates whether the Cluster has been reconciled into a cluster secret
+	ClusterConditionRegistered = "Registered"
+
+	// ClusterReasonRegistered is the reason of the registered condition if the cluster secret is up to date
+	ClusterReasonRegistered = "Registered"
+	// ClusterReasonInvalidSpec is the reason of the registered condition if the spec of the Cluster is invalid
+	ClusterReasonInvalidSpec = "InvalidSpec"
+	// ClusterReasonConflict is the reason of the registered condition if the cluster is already registered otherwise
+	ClusterReasonConflict = "Conflict"
+	// ClusterReasonError is the reason of the registered condition if the cluster secret could not be reconciled
+	ClusterReasonError = "Error"
+)
+
+// ClusterList is list of Cluster resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Cluster `json:"items"`
+}
+
+// Cluster is the declarative registration of a cluster applications can be deployed to. It is reconciled into a
+// cluster secret, so clusters can be registered using GitOps instead of hand-crafting labeled secrets.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clusters
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Server",type=string,JSONPath=`.spec.server`
+// +kubebuilder:printcolumn:name="Connection",type=string,JSONPath=`.status.connectionState.status`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.serverVersion`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              ClusterSpec   `json:"spec"`
+	Status            ClusterStatus `json:"status,omitempty"`
+}
+
+// ClusterSpec is the specification of a Cluster
+type ClusterSpec struct {
+	// Server is the API server URL of the cluster
+	// +kubebuilder:validation:MinLength=1
+	Server string `json:"server"`
+	// Name of the cluster, which can be used as destination of applications. Defaults to the name of the Cluster.
+	Name string `json:"name,omitempty"`
+	// Config holds the connection settings of the cluster which are not sensitive, e.g. the CA certificate. The exec
+	// provider is not supported, as it would run commands in Argo CD.
+	Config appv1.ClusterConfig `json:"config,omitempty"`
+	// ConfigSecretRef references a key of a secret in the namespace of the Cluster holding sensitive connection
+	// settings, e.g. the bearer token, in the JSON format of Config. The secret must be labeled with
+	// argocd.argoproj.io/secret-type: cluster-config. The settings of the secret take precedence over the settings of
+	// Config.
+	ConfigSecretRef *appv1.SecretRef `json:"configSecretRef,omitempty"`
+	// Namespaces the applications can be deployed to. All namespaces are permitted if empty.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ClusterResources indicates if cluster level resources can be managed if Namespaces is set
+	ClusterResources bool `json:"clusterResources,omitempty"`
+	// Project the cluster is scoped to
+	Project string `json:"project,omitempty"`
+	// Shard of the application controller the cluster is assigned to
+	Shard *int64 `json:"shard,omitempty"`
+	// Labels of the cluster secret, which can be used by the cluster generator of ApplicationSets
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations of the cluster secret
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ClusterStatus contains the observed state of a Cluster
+type ClusterStatus struct {
+	// ObservedGeneration is the generation of the Cluster which has been reconciled
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// SecretName is the name of the cluster secret the Cluster is reconciled into
+	SecretName string `json:"secretName,omitempty"`
+	// ConnectionState is the state of the connection of Argo CD to the cluster
+	ConnectionState *appv1.ConnectionState `json:"connectionState,omitempty"`
+	// ServerVersion is the Kubernetes version of the cluster
+	ServerVersion string `json:"serverVersion,omitempty"`
+	// Conditions of the Cluster
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
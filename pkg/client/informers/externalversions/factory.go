@@ -9,6 +9,7 @@ import (
 
 	versioned "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	application "github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions/application"
+	cluster "github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions/cluster"
 	internalinterfaces "github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions/internalinterfaces"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -239,8 +240,13 @@ type SharedInformerFactory interface {
 	InformerFor(obj runtime.Object, newFunc internalinterfaces.NewInformerFunc) cache.SharedIndexInformer
 
 	Argoproj() application.Interface
+	Cluster() cluster.Interface
 }
 
 func (f *sharedInformerFactory) Argoproj() application.Interface {
 	return application.New(f, f.namespace, f.tweakListOptions)
 }
+
+func (f *sharedInformerFactory) Cluster() cluster.Interface {
+	return cluster.New(f, f.namespace, f.tweakListOptions)
+}
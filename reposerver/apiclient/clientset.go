@@ -11,7 +11,6 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/env"
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
-	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/timeout"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -44,10 +43,39 @@ type clientSet struct {
 	address        string
 	timeoutSeconds int
 	tlsConfig      TLSConfiguration
+	methodTimeouts map[string]time.Duration
+	hedgingDelay   time.Duration
+	hedgedMethods  []string
+	// hedgingInterceptor is built once and shared by all clients, it opens a new connection for each hedged request
+	hedgingInterceptor grpc.UnaryClientInterceptor
+}
+
+// ClientsetOpts configures optional behavior of a repo server Clientset
+type ClientsetOpts func(c *clientSet)
+
+// WithMethodTimeouts overrides the RPC call timeout for the given repo server methods, e.g. GenerateManifest
+func WithMethodTimeouts(methodTimeouts map[string]time.Duration) ClientsetOpts {
+	return func(c *clientSet) {
+		c.methodTimeouts = methodTimeouts
+	}
+}
+
+// WithHedging sends a second, hedged request for the given repo server methods if the first request has not completed
+// within the given delay. Each hedged request is sent over a new connection, so that it is balanced to a repo server
+// replica independently of the first request, and the first response received is used.
+func WithHedging(delay time.Duration, methods []string) ClientsetOpts {
+	return func(c *clientSet) {
+		c.hedgingDelay = delay
+		c.hedgedMethods = methods
+	}
 }
 
 func (c *clientSet) NewRepoServerClient() (utilio.Closer, RepoServerServiceClient, error) {
-	conn, err := NewConnection(c.address, c.timeoutSeconds, &c.tlsConfig)
+	var interceptors []grpc.UnaryClientInterceptor
+	if c.hedgingInterceptor != nil {
+		interceptors = append(interceptors, c.hedgingInterceptor)
+	}
+	conn, err := newConnection(c.address, c.timeoutSeconds, &c.tlsConfig, c.methodTimeouts, interceptors...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to open a new connection to repo server: %w", err)
 	}
@@ -55,14 +83,19 @@ func (c *clientSet) NewRepoServerClient() (utilio.Closer, RepoServerServiceClien
 }
 
 func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfiguration) (*grpc.ClientConn, error) {
+	return newConnection(address, timeoutSeconds, tlsConfig, nil)
+}
+
+func newConnection(address string, timeoutSeconds int, tlsConfig *TLSConfiguration, methodTimeouts map[string]time.Duration, extraInterceptors ...grpc.UnaryClientInterceptor) (*grpc.ClientConn, error) {
 	retryOpts := []grpc_retry.CallOption{
 		grpc_retry.WithMax(3),
 		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(1000 * time.Millisecond)),
 	}
 	unaryInterceptors := []grpc.UnaryClientInterceptor{grpc_retry.UnaryClientInterceptor(retryOpts...)}
-	if timeoutSeconds > 0 {
-		unaryInterceptors = append(unaryInterceptors, timeout.UnaryClientInterceptor(time.Duration(timeoutSeconds)*time.Second))
+	if timeoutSeconds > 0 || len(methodTimeouts) > 0 {
+		unaryInterceptors = append(unaryInterceptors, methodTimeoutUnaryClientInterceptor(time.Duration(timeoutSeconds)*time.Second, methodTimeouts))
 	}
+	unaryInterceptors = append(unaryInterceptors, extraInterceptors...)
 	opts := []grpc.DialOption{
 		grpc.WithStreamInterceptor(grpc_util.RetryOnlyForServerStreamInterceptor(retryOpts...)),
 		grpc.WithChainUnaryInterceptor(unaryInterceptors...),
@@ -91,6 +124,16 @@ func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfigurati
 }
 
 // NewRepoServerClientset creates new instance of repo server Clientset
-func NewRepoServerClientset(address string, timeoutSeconds int, tlsConfig TLSConfiguration) Clientset {
-	return &clientSet{address: address, timeoutSeconds: timeoutSeconds, tlsConfig: tlsConfig}
+func NewRepoServerClientset(address string, timeoutSeconds int, tlsConfig TLSConfiguration, opts ...ClientsetOpts) Clientset {
+	c := &clientSet{address: address, timeoutSeconds: timeoutSeconds, tlsConfig: tlsConfig}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.hedgingDelay > 0 && len(c.hedgedMethods) > 0 {
+		// the connections of hedged requests are not hedged themselves
+		c.hedgingInterceptor = hedgingUnaryClientInterceptor(c.hedgingDelay, c.hedgedMethods, func() (*grpc.ClientConn, error) {
+			return newConnection(address, timeoutSeconds, &c.tlsConfig, c.methodTimeouts)
+		})
+	}
+	return c
 }
@@ -0,0 +1,153 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// DefaultHedgedMethods are the repo server methods which are hedged by default. These are read-only methods used by
+// interactive UI and CLI operations, while hedging manifest generation would add significant load to the repo server.
+var DefaultHedgedMethods = []string{
+	"GetAppDetails",
+	"GetRevisionMetadata",
+	"GetRevisionChartDetails",
+	"GetOCIMetadata",
+	"ListApps",
+	"ListRefs",
+	"ListOCITags",
+	"ListPlugins",
+	"GetHelmCharts",
+}
+
+// ParseMethodTimeouts parses RPC call timeouts of repo server methods, keyed by the method name, e.g. GenerateManifest=3m
+func ParseMethodTimeouts(timeouts map[string]string) (map[string]time.Duration, error) {
+	methodTimeouts := make(map[string]time.Duration, len(timeouts))
+	for method, value := range timeouts {
+		if err := validateUnaryMethod(method); err != nil {
+			return nil, err
+		}
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout %q of repo server method %s: %w", value, method, err)
+		}
+		methodTimeouts[method] = timeout
+	}
+	return methodTimeouts, nil
+}
+
+// ValidateHedgedMethods returns an error if any of the given methods is not a unary repo server method
+func ValidateHedgedMethods(methods []string) error {
+	for _, method := range methods {
+		if err := validateUnaryMethod(method); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateUnaryMethod(method string) error {
+	for _, m := range _RepoServerService_serviceDesc.Methods {
+		if m.MethodName == method {
+			return nil
+		}
+	}
+	names := make([]string, 0, len(_RepoServerService_serviceDesc.Methods))
+	for _, m := range _RepoServerService_serviceDesc.Methods {
+		names = append(names, m.MethodName)
+	}
+	return fmt.Errorf("unknown repo server method %q, must be one of: %s", method, strings.Join(names, ", "))
+}
+
+// methodTimeoutUnaryClientInterceptor sets the deadline of unary calls to the timeout of the called method, or to the
+// default timeout if the method has no specific timeout. A timeout of zero disables the deadline.
+func methodTimeoutUnaryClientInterceptor(defaultTimeout time.Duration, methodTimeouts map[string]time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		timeout, ok := methodTimeouts[path.Base(method)]
+		if !ok {
+			timeout = defaultTimeout
+		}
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+type hedgedResult struct {
+	reply  any
+	err    error
+	hedged bool
+}
+
+// hedgingUnaryClientInterceptor sends a second request if a call of one of the given methods has not completed within
+// the given delay. The first successful response is used and the other request is canceled. Each hedged request is sent
+// over a new connection opened with dial and closed once the request completes: connections to the repo server Service
+// are balanced when they are opened, so that the hedged request is not bound to the replica of a long-lived connection.
+func hedgingUnaryClientInterceptor(delay time.Duration, methods []string, dial func() (*grpc.ClientConn, error)) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !slices.Contains(methods, path.Base(method)) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// each request receives its response into a separate message, so that the response of the request which is
+		// canceled does not overwrite the used one
+		results := make(chan hedgedResult, 2)
+		call := func(conn *grpc.ClientConn, hedged bool) {
+			res := reflect.New(reflect.TypeOf(reply).Elem()).Interface()
+			results <- hedgedResult{reply: res, err: invoker(ctx, method, req, res, conn, opts...), hedged: hedged}
+		}
+		go call(cc, false)
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case res := <-results:
+			return useHedgedResult(res, reply)
+		case <-timer.C:
+		}
+		go func() {
+			hedgeConn, err := dial()
+			if err != nil {
+				results <- hedgedResult{err: fmt.Errorf("failed to open connection for hedged request: %w", err), hedged: true}
+				return
+			}
+			defer utilio.Close(hedgeConn)
+			call(hedgeConn, true)
+		}()
+
+		pending := 2
+		var res hedgedResult
+		for ; pending > 0; pending-- {
+			res = <-results
+			if res.err == nil {
+				break
+			}
+		}
+		if res.err == nil && res.hedged {
+			log.Debugf("Using response of hedged request of %s sent after %v", method, delay)
+		}
+		return useHedgedResult(res, reply)
+	}
+}
+
+func useHedgedResult(res hedgedResult, reply any) error {
+	if res.err != nil {
+		return res.err
+	}
+	reflect.ValueOf(reply).Elem().Set(reflect.ValueOf(res.reply).Elem())
+	return nil
+}
@@ -0,0 +1,137 @@
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func newTestConn(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	conn, err := grpc.NewClient("localhost:0", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	return conn
+}
+
+func TestParseMethodTimeouts(t *testing.T) {
+	timeouts, err := ParseMethodTimeouts(map[string]string{"GenerateManifest": "3m", "ListRefs": "10s"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"GenerateManifest": 3 * time.Minute, "ListRefs": 10 * time.Second}, timeouts)
+
+	_, err = ParseMethodTimeouts(map[string]string{"GenerateManifests": "3m"})
+	require.ErrorContains(t, err, `unknown repo server method "GenerateManifests"`)
+	_, err = ParseMethodTimeouts(map[string]string{"GenerateManifest": "3"})
+	require.ErrorContains(t, err, "invalid timeout")
+	require.ErrorContains(t, ValidateHedgedMethods([]string{"GenerateManifestWithFiles"}), "unknown repo server method")
+	require.NoError(t, ValidateHedgedMethods(DefaultHedgedMethods))
+}
+
+func TestMethodTimeoutUnaryClientInterceptor(t *testing.T) {
+	interceptor := methodTimeoutUnaryClientInterceptor(time.Minute, map[string]time.Duration{"ListRefs": time.Second, "GenerateManifest": 0})
+	deadlineOf := func(method string) time.Duration {
+		var timeout time.Duration
+		err := interceptor(t.Context(), method, nil, nil, nil, func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			if deadline, ok := ctx.Deadline(); ok {
+				timeout = time.Until(deadline)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return timeout
+	}
+
+	assert.InDelta(t, time.Second, deadlineOf("/repository.RepoServerService/ListRefs"), float64(100*time.Millisecond))
+	assert.InDelta(t, time.Minute, deadlineOf("/repository.RepoServerService/GetAppDetails"), float64(100*time.Millisecond))
+	assert.Zero(t, deadlineOf("/repository.RepoServerService/GenerateManifest"))
+}
+
+func TestHedgingUnaryClientInterceptor(t *testing.T) {
+	primary := newTestConn(t)
+	defer primary.Close()
+	var hedgeConns []*grpc.ClientConn
+	dial := func() (*grpc.ClientConn, error) {
+		conn := newTestConn(t)
+		hedgeConns = append(hedgeConns, conn)
+		return conn, nil
+	}
+	const method = "/repository.RepoServerService/ListRefs"
+
+	newInvoker := func(primaryDelay time.Duration, primaryErr error, hedged *int) grpc.UnaryInvoker {
+		return func(ctx context.Context, _ string, _, reply any, cc *grpc.ClientConn, _ ...grpc.CallOption) error {
+			refs := reply.(*Refs)
+			if cc != primary {
+				*hedged++
+				if len(hedgeConns) == 0 || cc != hedgeConns[len(hedgeConns)-1] {
+					return errors.New("unexpected connection")
+				}
+				refs.Branches = []string{"hedged"}
+				return nil
+			}
+			select {
+			case <-time.After(primaryDelay):
+				refs.Branches = []string{"primary"}
+				return primaryErr
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	interceptor := hedgingUnaryClientInterceptor(50*time.Millisecond, []string{"ListRefs"}, dial)
+
+	t.Run("PrimaryCompletesBeforeDelay", func(t *testing.T) {
+		hedged := 0
+		refs := &Refs{}
+		require.NoError(t, interceptor(t.Context(), method, &ListRefsRequest{}, refs, primary, newInvoker(0, nil, &hedged)))
+		assert.Equal(t, []string{"primary"}, refs.Branches)
+		assert.Zero(t, hedged)
+	})
+
+	t.Run("HedgedRequestCompletesFirst", func(t *testing.T) {
+		hedged := 0
+		refs := &Refs{}
+		require.NoError(t, interceptor(t.Context(), method, &ListRefsRequest{}, refs, primary, newInvoker(time.Minute, nil, &hedged)))
+		assert.Equal(t, []string{"hedged"}, refs.Branches)
+		assert.Equal(t, 1, hedged)
+
+		// each hedged request uses a new connection, which is closed once the request completes
+		require.NoError(t, interceptor(t.Context(), method, &ListRefsRequest{}, refs, primary, newInvoker(time.Minute, nil, &hedged)))
+		assert.Equal(t, 2, hedged)
+		require.Len(t, hedgeConns, 2)
+		assert.NotSame(t, hedgeConns[0], hedgeConns[1])
+		assert.Eventually(t, func() bool {
+			return hedgeConns[0].GetState() == connectivity.Shutdown && hedgeConns[1].GetState() == connectivity.Shutdown
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("HedgedConnectionFails", func(t *testing.T) {
+		interceptor := hedgingUnaryClientInterceptor(50*time.Millisecond, []string{"ListRefs"}, func() (*grpc.ClientConn, error) {
+			return nil, errors.New("dial failed")
+		})
+		hedged := 0
+		refs := &Refs{}
+		require.NoError(t, interceptor(t.Context(), method, &ListRefsRequest{}, refs, primary, newInvoker(100*time.Millisecond, nil, &hedged)))
+		assert.Equal(t, []string{"primary"}, refs.Branches)
+	})
+
+	t.Run("PrimaryFailsBeforeDelay", func(t *testing.T) {
+		hedged := 0
+		err := interceptor(t.Context(), method, &ListRefsRequest{}, &Refs{}, primary, newInvoker(0, errors.New("failed"), &hedged))
+		require.EqualError(t, err, "failed")
+		assert.Zero(t, hedged)
+	})
+
+	t.Run("MethodNotHedged", func(t *testing.T) {
+		hedged := 0
+		refs := &Refs{}
+		require.NoError(t, interceptor(t.Context(), "/repository.RepoServerService/ListApps", &ListAppsRequest{}, refs, primary, newInvoker(100*time.Millisecond, nil, &hedged)))
+		assert.Equal(t, []string{"primary"}, refs.Branches)
+		assert.Zero(t, hedged)
+	})
+}
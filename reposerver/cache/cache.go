@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"math"
+	"math/rand"
 	"sort"
 	"strings"
 	"time"
@@ -33,6 +34,10 @@ type Cache struct {
 	repoCacheExpiration      time.Duration
 	revisionCacheExpiration  time.Duration
 	revisionCacheLockTimeout time.Duration
+	// appDetailsCacheExpirationJitter is the maximum random duration added to the expiration of each app details key,
+	// so that the details of many applications cached at the same time (e.g. after a cache flush) do not all expire at
+	// once.
+	appDetailsCacheExpirationJitter time.Duration
 }
 
 // ClusterRuntimeInfo holds cluster runtime information
@@ -57,17 +62,19 @@ type CachedManifestResponse struct {
 }
 
 func NewCache(cache *cacheutil.Cache, repoCacheExpiration time.Duration, revisionCacheExpiration time.Duration, revisionCacheLockTimeout time.Duration) *Cache {
-	return &Cache{cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout}
+	return &Cache{cache: cache, repoCacheExpiration: repoCacheExpiration, revisionCacheExpiration: revisionCacheExpiration, revisionCacheLockTimeout: revisionCacheLockTimeout}
 }
 
 func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...cacheutil.Options) func() (*Cache, error) {
 	var repoCacheExpiration time.Duration
 	var revisionCacheExpiration time.Duration
 	var revisionCacheLockTimeout time.Duration
+	var appDetailsCacheExpirationJitter time.Duration
 
 	cmd.Flags().DurationVar(&repoCacheExpiration, "repo-cache-expiration", env.ParseDurationFromEnv("ARGOCD_REPO_CACHE_EXPIRATION", 24*time.Hour, 0, math.MaxInt64), "Cache expiration for repo state, incl. app lists, app details, manifest generation, revision meta-data")
 	cmd.Flags().DurationVar(&revisionCacheExpiration, "revision-cache-expiration", env.ParseDurationFromEnv("ARGOCD_RECONCILIATION_TIMEOUT", 3*time.Minute, 0, math.MaxInt64), "Cache expiration for cached revision")
 	cmd.Flags().DurationVar(&revisionCacheLockTimeout, "revision-cache-lock-timeout", env.ParseDurationFromEnv("ARGOCD_REVISION_CACHE_LOCK_TIMEOUT", 10*time.Second, 0, math.MaxInt64), "Cache TTL for locks to prevent duplicate requests on revisions, set to 0 to disable")
+	cmd.Flags().DurationVar(&appDetailsCacheExpirationJitter, "app-details-cache-expiration-jitter", env.ParseDurationFromEnv("ARGOCD_APP_DETAILS_CACHE_EXPIRATION_JITTER", 5*time.Minute, 0, math.MaxInt64), "Maximum random duration added to the expiration of cached app details to avoid simultaneous expiry of popular keys")
 
 	repoFactory := cacheutil.AddCacheFlagsToCmd(cmd, opts...)
 
@@ -76,7 +83,9 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...cacheutil.Options) func() (*
 		if err != nil {
 			return nil, fmt.Errorf("error adding cache flags to cmd: %w", err)
 		}
-		return NewCache(cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout), nil
+		repoCache := NewCache(cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout)
+		repoCache.appDetailsCacheExpirationJitter = appDetailsCacheExpirationJitter
+		return repoCache, nil
 	}
 }
 
@@ -323,7 +332,9 @@ func (c *Cache) UnlockGitReferences(repo string, lockId string) error {
 
 // refSourceCommitSHAs is a list of resolved revisions for each ref source. This allows us to invalidate the cache
 // when someone pushes a commit to a source which is referenced from the main source (the one referred to by `revision`).
-func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, namespace string, trackingMethod string, appLabelKey string, appName string, info ClusterRuntimeInfo, refSourceCommitSHAs ResolvedRevisions, installationID string) string {
+// The source path restrictions of the project are part of the key, so manifests generated for a project with
+// different restrictions, or none, are never returned.
+func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, namespace string, trackingMethod string, appLabelKey string, appName string, info ClusterRuntimeInfo, refSourceCommitSHAs ResolvedRevisions, installationID string, sourcePaths []*appv1.SourcePathRestriction) string {
 	// TODO: this function is getting unwieldy. We should probably consolidate some of this stuff into a struct. For
 	//       example, revision could be part of ResolvedRevisions. And srcRefs is probably redundant now that
 	//       refSourceCommitSHAs has been added. We don't need to know the _target_ revisions of the referenced sources
@@ -333,6 +344,10 @@ func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs
 	if installationID != "" {
 		key = fmt.Sprintf("%s|%s", key, installationID)
 	}
+	if len(sourcePaths) > 0 {
+		sourcePathsJSON, _ := json.Marshal(sourcePaths)
+		key = fmt.Sprintf("%s|%d", key, hash.FNVa(string(sourcePathsJSON)))
+	}
 	return key
 }
 
@@ -360,14 +375,14 @@ func LogDebugManifestCacheKeyFields(message string, reason string, revision stri
 	}
 }
 
-func (c *Cache) SetNewRevisionManifests(newRevision string, revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, refSourceCommitSHAs ResolvedRevisions, installationID string) error {
-	oldKey := manifestCacheKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID)
-	newKey := manifestCacheKey(newRevision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID)
+func (c *Cache) SetNewRevisionManifests(newRevision string, revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, refSourceCommitSHAs ResolvedRevisions, installationID string, sourcePaths []*appv1.SourcePathRestriction) error {
+	oldKey := manifestCacheKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID, sourcePaths)
+	newKey := manifestCacheKey(newRevision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID, sourcePaths)
 	return c.cache.RenameItem(oldKey, newKey, c.repoCacheExpiration)
 }
 
-func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, res *CachedManifestResponse, refSourceCommitSHAs ResolvedRevisions, installationID string) error {
-	err := c.cache.GetItem(manifestCacheKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID), res)
+func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, res *CachedManifestResponse, refSourceCommitSHAs ResolvedRevisions, installationID string, sourcePaths []*appv1.SourcePathRestriction) error {
+	err := c.cache.GetItem(manifestCacheKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID, sourcePaths), res)
 	if err != nil {
 		return err
 	}
@@ -383,7 +398,7 @@ func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, s
 
 		LogDebugManifestCacheKeyFields("deleting manifests cache", "manifest hash did not match or cached response is empty", revision, appSrc, srcRefs, clusterInfo, namespace, trackingMethod, appLabelKey, appName, refSourceCommitSHAs)
 
-		err = c.DeleteManifests(revision, appSrc, srcRefs, clusterInfo, namespace, trackingMethod, appLabelKey, appName, refSourceCommitSHAs, installationID)
+		err = c.DeleteManifests(revision, appSrc, srcRefs, clusterInfo, namespace, trackingMethod, appLabelKey, appName, refSourceCommitSHAs, installationID, sourcePaths)
 		if err != nil {
 			return fmt.Errorf("unable to delete manifest after hash mismatch: %w", err)
 		}
@@ -403,7 +418,7 @@ func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, s
 	return nil
 }
 
-func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, res *CachedManifestResponse, refSourceCommitSHAs ResolvedRevisions, installationID string) error {
+func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, res *CachedManifestResponse, refSourceCommitSHAs ResolvedRevisions, installationID string, sourcePaths []*appv1.SourcePathRestriction) error {
 	// Generate and apply the cache entry hash, before writing
 	if res != nil {
 		res = res.shallowCopy()
@@ -415,7 +430,7 @@ func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, s
 	}
 
 	return c.cache.SetItem(
-		manifestCacheKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID),
+		manifestCacheKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID, sourcePaths),
 		res,
 		&cacheutil.CacheActionOpts{
 			Expiration: c.repoCacheExpiration,
@@ -423,9 +438,9 @@ func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, s
 		})
 }
 
-func (c *Cache) DeleteManifests(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace, trackingMethod, appLabelKey, appName string, refSourceCommitSHAs ResolvedRevisions, installationID string) error {
+func (c *Cache) DeleteManifests(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, clusterInfo ClusterRuntimeInfo, namespace, trackingMethod, appLabelKey, appName string, refSourceCommitSHAs ResolvedRevisions, installationID string, sourcePaths []*appv1.SourcePathRestriction) error {
 	return c.cache.SetItem(
-		manifestCacheKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID),
+		manifestCacheKey(revision, appSrc, srcRefs, namespace, trackingMethod, appLabelKey, appName, clusterInfo, refSourceCommitSHAs, installationID, sourcePaths),
 		"",
 		&cacheutil.CacheActionOpts{Delete: true})
 }
@@ -446,11 +461,20 @@ func (c *Cache) SetAppDetails(revision string, appSrc *appv1.ApplicationSource,
 		appDetailsCacheKey(revision, appSrc, srcRefs, trackingMethod, refSourceCommitSHAs),
 		res,
 		&cacheutil.CacheActionOpts{
-			Expiration: c.repoCacheExpiration,
+			Expiration: c.appDetailsExpiration(),
 			Delete:     res == nil,
 		})
 }
 
+// appDetailsExpiration returns the expiration of app details, increased by a random duration of at most
+// appDetailsCacheExpirationJitter
+func (c *Cache) appDetailsExpiration() time.Duration {
+	if c.appDetailsCacheExpirationJitter <= 0 || c.repoCacheExpiration <= 0 {
+		return c.repoCacheExpiration
+	}
+	return c.repoCacheExpiration + time.Duration(rand.Int63n(int64(c.appDetailsCacheExpirationJitter)))
+}
+
 func revisionMetadataKey(repoURL, revision string) string {
 	return fmt.Sprintf("revisionmetadata|%s|%s", repoURL, revision)
 }
@@ -500,20 +524,24 @@ func (c *Cache) GetGitFiles(repoURL, revision, pattern string) (map[string][]byt
 	return item, err
 }
 
-func gitDirectoriesKey(repoURL, revision string) string {
-	return fmt.Sprintf("gitdirs|%s|%s", repoURL, revision)
+func gitDirectoriesKey(repoURL, revision string, paths, excludedPaths []string) string {
+	key := fmt.Sprintf("gitdirs|%s|%s", repoURL, revision)
+	if len(paths) > 0 {
+		key = fmt.Sprintf("%s|%q|%q", key, paths, excludedPaths)
+	}
+	return key
 }
 
-func (c *Cache) SetGitDirectories(repoURL, revision string, directories []string) error {
+func (c *Cache) SetGitDirectories(repoURL, revision string, paths, excludedPaths []string, directories []string) error {
 	return c.cache.SetItem(
-		gitDirectoriesKey(repoURL, revision),
+		gitDirectoriesKey(repoURL, revision, paths, excludedPaths),
 		&directories,
 		&cacheutil.CacheActionOpts{Expiration: c.repoCacheExpiration})
 }
 
-func (c *Cache) GetGitDirectories(repoURL, revision string) ([]string, error) {
+func (c *Cache) GetGitDirectories(repoURL, revision string, paths, excludedPaths []string) ([]string, error) {
 	var item []string
-	err := c.cache.GetItem(gitDirectoriesKey(repoURL, revision), &item)
+	err := c.cache.GetItem(gitDirectoriesKey(repoURL, revision, paths, excludedPaths), &item)
 	return item, err
 }
 
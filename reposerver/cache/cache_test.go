@@ -92,49 +92,54 @@ func TestCache_GetManifests(t *testing.T) {
 	// cache miss
 	q := &apiclient.ManifestRequest{}
 	value := &CachedManifestResponse{}
-	err := cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+	err := cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "", nil)
 	require.ErrorIs(t, err, ErrCacheMiss)
 	// populate cache
 	res := &CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{SourceType: "my-source-type"}}
-	err = cache.SetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", res, nil, "")
+	err = cache.SetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", res, nil, "", nil)
 	require.NoError(t, err)
 	t.Run("expect cache miss because of changed revision", func(t *testing.T) {
-		err = cache.GetManifests("other-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		err = cache.GetManifests("other-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "", nil)
 		require.ErrorIs(t, err, ErrCacheMiss)
 	})
 	t.Run("expect cache miss because of changed path", func(t *testing.T) {
-		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{Path: "other-path"}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{Path: "other-path"}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "", nil)
 		require.ErrorIs(t, err, ErrCacheMiss)
 	})
 	t.Run("expect cache miss because of changed namespace", func(t *testing.T) {
-		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "other-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "other-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "", nil)
 		require.ErrorIs(t, err, ErrCacheMiss)
 	})
 	t.Run("expect cache miss because of changed app label key", func(t *testing.T) {
-		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "other-app-label-key", "my-app-label-value", value, nil, "")
+		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "other-app-label-key", "my-app-label-value", value, nil, "", nil)
 		require.ErrorIs(t, err, ErrCacheMiss)
 	})
 	t.Run("expect cache miss because of changed app label value", func(t *testing.T) {
-		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "other-app-label-value", value, nil, "")
+		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "other-app-label-value", value, nil, "", nil)
 		require.ErrorIs(t, err, ErrCacheMiss)
 	})
 	t.Run("expect cache miss because of changed referenced source", func(t *testing.T) {
-		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "other-app-label-value", value, map[string]string{"my-referenced-source": "my-referenced-revision"}, "")
+		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "other-app-label-value", value, map[string]string{"my-referenced-source": "my-referenced-revision"}, "", nil)
+		require.ErrorIs(t, err, ErrCacheMiss)
+	})
+	t.Run("expect cache miss because of source path restrictions", func(t *testing.T) {
+		sourcePaths := []*v1alpha1.SourcePathRestriction{{RepoURL: "*", Paths: []string{"apps/*"}}}
+		err = cache.GetManifests("my-revision", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "", sourcePaths)
 		require.ErrorIs(t, err, ErrCacheMiss)
 	})
 	t.Run("expect cache hit", func(t *testing.T) {
 		err = cache.SetManifests(
 			"my-revision1", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value",
-			&CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{SourceType: "my-source-type", Revision: "my-revision2"}}, nil, "")
+			&CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{SourceType: "my-source-type", Revision: "my-revision2"}}, nil, "", nil)
 		require.NoError(t, err)
 
-		err = cache.GetManifests("my-revision1", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "")
+		err = cache.GetManifests("my-revision1", &v1alpha1.ApplicationSource{}, q.RefSources, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", value, nil, "", nil)
 		require.NoError(t, err)
 
 		assert.Equal(t, "my-source-type", value.ManifestResponse.SourceType)
 		assert.Equal(t, "my-revision1", value.ManifestResponse.Revision)
 	})
-	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 8})
+	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 9})
 }
 
 func TestCache_GetAppDetails(t *testing.T) {
@@ -167,6 +172,23 @@ func TestAddCacheFlagsToCmd(t *testing.T) {
 	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
 	require.NoError(t, err)
 	assert.Equal(t, 24*time.Hour, cache.repoCacheExpiration)
+	assert.Equal(t, 5*time.Minute, cache.appDetailsCacheExpirationJitter)
+}
+
+func TestCache_AppDetailsExpiration(t *testing.T) {
+	cache := NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour, time.Minute, 10*time.Second)
+	assert.Equal(t, time.Hour, cache.appDetailsExpiration())
+
+	cache.appDetailsCacheExpirationJitter = time.Minute
+	for range 10 {
+		expiration := cache.appDetailsExpiration()
+		assert.GreaterOrEqual(t, expiration, time.Hour)
+		assert.Less(t, expiration, time.Hour+time.Minute)
+	}
+
+	// no expiration stays unset
+	cache.repoCacheExpiration = 0
+	assert.Equal(t, time.Duration(0), cache.appDetailsExpiration())
 }
 
 func TestCachedManifestResponse_HashBehavior(t *testing.T) {
@@ -197,7 +219,7 @@ func TestCachedManifestResponse_HashBehavior(t *testing.T) {
 		NumberOfConsecutiveFailures:     0,
 	}
 	q := &apiclient.ManifestRequest{}
-	err := repoCache.SetManifests(response.Revision, appSrc, q.RefSources, q, response.Namespace, "", appKey, appValue, store, nil, "")
+	err := repoCache.SetManifests(response.Revision, appSrc, q.RefSources, q, response.Namespace, "", appKey, appValue, store, nil, "", nil)
 	require.NoError(t, err)
 
 	// Get the cache entry of the set value directly from the in memory cache, and check the values
@@ -223,7 +245,7 @@ func TestCachedManifestResponse_HashBehavior(t *testing.T) {
 
 	// Retrieve the value using 'GetManifests' and confirm it works
 	retrievedVal := &CachedManifestResponse{}
-	err = repoCache.GetManifests(response.Revision, appSrc, q.RefSources, q, response.Namespace, "", appKey, appValue, retrievedVal, nil, "")
+	err = repoCache.GetManifests(response.Revision, appSrc, q.RefSources, q, response.Namespace, "", appKey, appValue, retrievedVal, nil, "", nil)
 	require.NoError(t, err)
 	assert.Equal(t, retrievedVal, store)
 
@@ -241,7 +263,7 @@ func TestCachedManifestResponse_HashBehavior(t *testing.T) {
 
 	// Retrieve the value using GetManifests and confirm it returns a cache miss
 	retrievedVal = &CachedManifestResponse{}
-	err = repoCache.GetManifests(response.Revision, appSrc, q.RefSources, q, response.Namespace, "", appKey, appValue, retrievedVal, nil, "")
+	err = repoCache.GetManifests(response.Revision, appSrc, q.RefSources, q, response.Namespace, "", appKey, appValue, retrievedVal, nil, "", nil)
 
 	assert.Equal(t, err, cacheutil.ErrCacheMiss)
 
@@ -663,7 +685,7 @@ func TestGetGitDirectories(t *testing.T) {
 	t.Run("GetGitDirectories cache miss", func(t *testing.T) {
 		fixtures := newFixtures()
 		t.Cleanup(fixtures.mockCache.StopRedisCallback)
-		directories, err := fixtures.cache.GetGitDirectories("test-repo", "test-revision")
+		directories, err := fixtures.cache.GetGitDirectories("test-repo", "test-revision", nil, nil)
 		require.ErrorIs(t, err, ErrCacheMiss)
 		assert.Empty(t, directories)
 		fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalGets: 1})
@@ -674,11 +696,11 @@ func TestGetGitDirectories(t *testing.T) {
 		cache := fixtures.cache
 		expectedItem := []string{"test/dir", "test/dir2"}
 		err := cache.cache.SetItem(
-			gitDirectoriesKey("test-repo", "test-revision"),
+			gitDirectoriesKey("test-repo", "test-revision", nil, nil),
 			expectedItem,
 			&cacheutil.CacheActionOpts{Expiration: 30 * time.Second})
 		require.NoError(t, err)
-		directories, err := fixtures.cache.GetGitDirectories("test-repo", "test-revision")
+		directories, err := fixtures.cache.GetGitDirectories("test-repo", "test-revision", nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedItem, directories)
 		fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalGets: 1, ExternalSets: 1})
@@ -690,11 +712,11 @@ func TestGetGitDirectories(t *testing.T) {
 		cache := fixtures.cache
 		expectedItem := []string{"test/dir", "test/dir2"}
 		err := cache.cache.SetItem(
-			gitDirectoriesKey("test-repo", "test-revision"),
+			gitDirectoriesKey("test-repo", "test-revision", nil, nil),
 			expectedItem,
 			&cacheutil.CacheActionOpts{Expiration: 30 * time.Second})
 		require.NoError(t, err)
-		directories, err := fixtures.cache.GetGitDirectories("test-repo", "test-revision")
+		directories, err := fixtures.cache.GetGitDirectories("test-repo", "test-revision", nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedItem, directories)
 		fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalGets: 1, ExternalSets: 1})
@@ -704,13 +726,28 @@ func TestGetGitDirectories(t *testing.T) {
 		fixtures := newFixtures()
 		t.Cleanup(fixtures.mockCache.StopRedisCallback)
 		expectedItem := []string{"test/dir", "test/dir2"}
-		err := fixtures.cache.SetGitDirectories("test-repo", "test-revision", expectedItem)
+		err := fixtures.cache.SetGitDirectories("test-repo", "test-revision", nil, nil, expectedItem)
 		require.NoError(t, err)
-		directories, err := fixtures.cache.GetGitDirectories("test-repo", "test-revision")
+		directories, err := fixtures.cache.GetGitDirectories("test-repo", "test-revision", nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, expectedItem, directories)
 		fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalGets: 1, ExternalSets: 1})
 	})
+
+	t.Run("SetGitDirectories with paths", func(t *testing.T) {
+		fixtures := newFixtures()
+		t.Cleanup(fixtures.mockCache.StopRedisCallback)
+		expectedItem := []string{"test/dir"}
+		err := fixtures.cache.SetGitDirectories("test-repo", "test-revision", []string{"test/*"}, []string{"test/dir2"}, expectedItem)
+		require.NoError(t, err)
+		directories, err := fixtures.cache.GetGitDirectories("test-repo", "test-revision", []string{"test/*"}, []string{"test/dir2"})
+		require.NoError(t, err)
+		assert.Equal(t, expectedItem, directories)
+		_, err = fixtures.cache.GetGitDirectories("test-repo", "test-revision", []string{"test/*"}, nil)
+		require.ErrorIs(t, err, ErrCacheMiss)
+		_, err = fixtures.cache.GetGitDirectories("test-repo", "test-revision", nil, nil)
+		require.ErrorIs(t, err, ErrCacheMiss)
+	})
 }
 
 func TestGetGitFiles(t *testing.T) {
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+// kustomizeOriginAnnotation is the annotation kustomize adds to the resources it renders if the originAnnotations build
+// metadata option is set in the kustomization
+const kustomizeOriginAnnotation = "config.kubernetes.io/origin"
+
+// helmSourcePrefix prefixes the comment Helm adds to each rendered manifest, naming the template it is rendered from
+const helmSourcePrefix = "# Source: "
+
+// manifestSources records the location, e.g. a file or a chart template, each rendered object originates from
+type manifestSources map[*unstructured.Unstructured]string
+
+// add records the source of the given objects. It is a no-op for nil sources.
+func (s manifestSources) add(source string, objs ...*unstructured.Unstructured) {
+	if s == nil {
+		return
+	}
+	for _, obj := range objs {
+		s[obj] = source
+	}
+}
+
+// relativeSource returns the path of the given file relative to the repository root, or the path itself if it is not
+// within the repository
+func relativeSource(repoRoot string, path string) string {
+	if rel, err := filepath.Rel(repoRoot, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.ToSlash(path)
+}
+
+// splitHelmOutput splits the output of helm template into objects, recording the chart template each object is
+// rendered from
+func splitHelmOutput(out string, sources manifestSources) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	for _, document := range splitYAMLDocuments(out) {
+		documentObjs, err := kube.SplitYAML([]byte(document))
+		objs = append(objs, documentObjs...)
+		if err != nil {
+			return objs, err
+		}
+		for _, line := range strings.Split(document, "\n") {
+			if source, ok := strings.CutPrefix(line, helmSourcePrefix); ok {
+				sources.add(strings.TrimSpace(source), documentObjs...)
+				break
+			}
+		}
+	}
+	return objs, nil
+}
+
+// splitYAMLDocuments splits a multi-document YAML stream at the document separators, i.e. lines starting with --- only
+// followed by spaces or a comment
+func splitYAMLDocuments(data string) []string {
+	var documents []string
+	var current strings.Builder
+	for _, line := range strings.SplitAfter(data, "\n") {
+		if rest, ok := strings.CutPrefix(line, "---"); ok {
+			if rest = strings.TrimSpace(rest); rest == "" || strings.HasPrefix(rest, "#") {
+				documents = append(documents, current.String())
+				current.Reset()
+				continue
+			}
+		}
+		current.WriteString(line)
+	}
+	return append(documents, current.String())
+}
+
+// addKustomizeSources records the sources of objects rendered by kustomize, which are only known if kustomize added
+// origin annotations. The paths in the annotations are relative to the kustomization.
+func addKustomizeSources(repoRoot string, appPath string, objs []*unstructured.Unstructured, sources manifestSources) {
+	for _, obj := range objs {
+		annotation, ok := obj.GetAnnotations()[kustomizeOriginAnnotation]
+		if !ok {
+			continue
+		}
+		var origin struct {
+			Path         string `json:"path"`
+			Repo         string `json:"repo"`
+			ConfiguredIn string `json:"configuredIn"`
+		}
+		if err := yaml.Unmarshal([]byte(annotation), &origin); err != nil {
+			continue
+		}
+		path := origin.Path
+		if path == "" {
+			path = origin.ConfiguredIn
+		}
+		switch {
+		case path == "":
+			continue
+		case origin.Repo != "":
+			sources.add(origin.Repo+"/"+path, obj)
+		default:
+			sources.add(relativeSource(repoRoot, filepath.Join(appPath, path)), obj)
+		}
+	}
+}
+
+// findDuplicateResources returns the resources with the same group, kind, namespace and name which are rendered more
+// than once, along with their sources. Objects without namespace are considered to be in the given namespace.
+func findDuplicateResources(objs []*unstructured.Unstructured, namespace string, sources manifestSources) []*apiclient.DuplicateResource {
+	objsByKey := make(map[kube.ResourceKey][]*unstructured.Unstructured)
+	var keys []kube.ResourceKey
+	for _, obj := range objs {
+		key := kube.GetResourceKey(obj)
+		if key.Name == "" {
+			continue
+		}
+		if key.Namespace == "" {
+			key.Namespace = namespace
+		}
+		if _, ok := objsByKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		objsByKey[key] = append(objsByKey[key], obj)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	var duplicates []*apiclient.DuplicateResource
+	for _, key := range keys {
+		keyObjs := objsByKey[key]
+		if len(keyObjs) < 2 {
+			continue
+		}
+		duplicate := &apiclient.DuplicateResource{
+			Group:     key.Group,
+			Kind:      key.Kind,
+			Namespace: key.Namespace,
+			Name:      key.Name,
+		}
+		for _, obj := range keyObjs {
+			if source, ok := sources[obj]; ok {
+				duplicate.Sources = append(duplicate.Sources, source)
+			}
+		}
+		duplicates = append(duplicates, duplicate)
+	}
+	return duplicates
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func newConfigMap(name string, namespace string, annotations map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.SetAnnotations(annotations)
+	return obj
+}
+
+func TestSplitHelmOutput(t *testing.T) {
+	out := `---
+# Source: guestbook/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: guestbook
+---
+# Source: guestbook/templates/configmaps.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: first
+--- # second config map
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: second
+`
+	sources := manifestSources{}
+	objs, err := splitHelmOutput(out, sources)
+	require.NoError(t, err)
+	require.Len(t, objs, 3)
+	assert.Equal(t, "guestbook", objs[0].GetName())
+	assert.Equal(t, "guestbook/templates/service.yaml", sources[objs[0]])
+	assert.Equal(t, "guestbook/templates/configmaps.yaml", sources[objs[1]])
+	assert.NotContains(t, sources, objs[2])
+}
+
+func TestAddKustomizeSources(t *testing.T) {
+	local := newConfigMap("local", "", map[string]string{kustomizeOriginAnnotation: "path: ../base/config.yaml\n"})
+	remote := newConfigMap("remote", "", map[string]string{kustomizeOriginAnnotation: "path: config.yaml\nrepo: https://github.com/argoproj/argocd-example-apps\nref: master\n"})
+	generated := newConfigMap("generated", "", map[string]string{kustomizeOriginAnnotation: "configuredIn: kustomization.yaml\nconfiguredBy:\n  apiVersion: builtin\n  kind: ConfigMapGenerator\n"})
+	unknown := newConfigMap("unknown", "", nil)
+
+	sources := manifestSources{}
+	addKustomizeSources("/repo", "/repo/apps/overlay", []*unstructured.Unstructured{local, remote, generated, unknown}, sources)
+	assert.Equal(t, "apps/base/config.yaml", sources[local])
+	assert.Equal(t, "https://github.com/argoproj/argocd-example-apps/config.yaml", sources[remote])
+	assert.Equal(t, "apps/overlay/kustomization.yaml", sources[generated])
+	assert.NotContains(t, sources, unknown)
+}
+
+func TestFindDuplicateResources(t *testing.T) {
+	first := newConfigMap("config", "", nil)
+	second := newConfigMap("config", "default", nil)
+	third := newConfigMap("config", "other", nil)
+	unnamed := newConfigMap("", "", nil)
+	unnamedCopy := newConfigMap("", "", nil)
+	sources := manifestSources{}
+	sources.add("app/first.yaml", first)
+	sources.add("app/second.yaml", second)
+
+	duplicates := findDuplicateResources([]*unstructured.Unstructured{first, second, third, unnamed, unnamedCopy}, "default", sources)
+	assert.Equal(t, []*apiclient.DuplicateResource{{
+		Kind:      "ConfigMap",
+		Namespace: "default",
+		Name:      "config",
+		Sources:   []string{"app/first.yaml", "app/second.yaml"},
+	}}, duplicates)
+
+	assert.Empty(t, findDuplicateResources([]*unstructured.Unstructured{first, third}, "default", nil))
+}
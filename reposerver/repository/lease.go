@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// CheckoutCoordinationNone disables coordination of repository checkouts between repo-server replicas
+	CheckoutCoordinationNone = "none"
+	// CheckoutCoordinationLease coordinates repository checkouts on a volume shared between repo-server replicas
+	// using per-repository lease files
+	CheckoutCoordinationLease = "lease"
+
+	leaseFileSuffix = ".lease"
+)
+
+// checkoutLease is the content of a lease file
+type checkoutLease struct {
+	Holder    string    `json:"holder"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// checkoutLeaser grants exclusive access to repository checkouts on a volume shared between multiple repo-server
+// replicas. A lease is stored in a file next to the checkout and is renewed by its holder until released. A lease which
+// has not been renewed in time, e.g. because its holder has crashed, is taken over by other replicas.
+type checkoutLeaser struct {
+	holder        string
+	duration      time.Duration
+	retryInterval time.Duration
+	now           func() time.Time
+}
+
+func newCheckoutLeaser(duration time.Duration) *checkoutLeaser {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "argocd-repo-server"
+	}
+	return &checkoutLeaser{
+		holder:        fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8]),
+		duration:      duration,
+		retryInterval: min(time.Second, duration/10),
+		now:           time.Now,
+	}
+}
+
+// Acquire blocks until the lease of the given checkout path is acquired and returns a closer releasing it. An error is
+// returned if the lease could not be acquired before the given context is done.
+func (l *checkoutLeaser) Acquire(ctx context.Context, path string) (io.Closer, error) {
+	leasePath := path + leaseFileSuffix
+	start := l.now()
+	for {
+		acquired, err := l.tryAcquire(leasePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire checkout lease %s: %w", leasePath, err)
+		}
+		if acquired {
+			if waited := l.now().Sub(start); waited > l.retryInterval {
+				log.WithField("path", path).Debugf("Acquired checkout lease after %v", waited)
+			}
+			return l.startRenewal(leasePath), nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to acquire checkout lease %s held by another replica within %v: %w", leasePath, l.now().Sub(start), ctx.Err())
+		case <-time.After(l.retryInterval):
+		}
+	}
+}
+
+// tryAcquire creates the lease file unless it is held by another replica. An expired lease is removed, so that it
+// can be acquired in the next attempt.
+func (l *checkoutLeaser) tryAcquire(leasePath string) (bool, error) {
+	data, err := l.marshal()
+	if err != nil {
+		return false, err
+	}
+	// the lease is written to a temporary file which is then linked to the lease path, so that the lease is created
+	// atomically along with its content and fails if the lease already exists
+	tmpPath := fmt.Sprintf("%s.%s.tmp", leasePath, l.holder)
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return false, err
+	}
+	err = os.Link(tmpPath, leasePath)
+	_ = os.Remove(tmpPath)
+	if err == nil {
+		return true, nil
+	}
+	if !os.IsExist(err) {
+		return false, err
+	}
+
+	current, raw, err := readLease(leasePath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if current != nil && l.now().Before(current.ExpiresAt) {
+		return false, nil
+	}
+	log.WithField("path", leasePath).Infof("Taking over expired checkout lease of %s", holderOf(current))
+	return false, l.takeOver(leasePath, raw)
+}
+
+// takeOver removes an expired lease file. The file is moved away before it is removed, so that concurrent take-overs
+// by multiple replicas do not remove a lease which has just been acquired by one of them.
+func (l *checkoutLeaser) takeOver(leasePath string, expired []byte) error {
+	stalePath := fmt.Sprintf("%s.%s", leasePath, l.holder)
+	if err := os.Rename(leasePath, stalePath); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer func() { _ = os.Remove(stalePath) }()
+	_, raw, err := readLease(stalePath)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(raw, expired) {
+		// the lease has been acquired by another replica in the meantime: put it back unless there is a newer one
+		if err := os.Link(stalePath, leasePath); err != nil && !os.IsExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// startRenewal renews the lease until the returned closer is called, which releases the lease
+func (l *checkoutLeaser) startRenewal(leasePath string) io.Closer {
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(l.duration / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := l.renew(leasePath); err != nil {
+					log.WithField("path", leasePath).Warnf("Failed to renew checkout lease: %v", err)
+				}
+			}
+		}
+	}()
+	return utilio.NewCloser(func() error {
+		close(stop)
+		wg.Wait()
+		current, _, err := readLease(leasePath)
+		if err != nil {
+			return fmt.Errorf("failed to release checkout lease %s: %w", leasePath, err)
+		}
+		if current == nil || current.Holder != l.holder {
+			return fmt.Errorf("failed to release checkout lease %s: lease is held by %s", leasePath, holderOf(current))
+		}
+		return os.Remove(leasePath)
+	})
+}
+
+// renew extends the expiration of a lease held by this replica. The lease file is replaced atomically, so that other
+// replicas never read a partially written lease.
+func (l *checkoutLeaser) renew(leasePath string) error {
+	current, _, err := readLease(leasePath)
+	if err != nil {
+		return err
+	}
+	if current == nil || current.Holder != l.holder {
+		return fmt.Errorf("lease has been taken over by %s", holderOf(current))
+	}
+	data, err := l.marshal()
+	if err != nil {
+		return err
+	}
+	tmpPath := fmt.Sprintf("%s.%s.tmp", leasePath, l.holder)
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, leasePath)
+}
+
+func (l *checkoutLeaser) marshal() ([]byte, error) {
+	return json.Marshal(checkoutLease{Holder: l.holder, ExpiresAt: l.now().Add(l.duration)})
+}
+
+// readLease returns the lease stored in the given file along with its raw content. A lease which cannot be parsed,
+// e.g. because its holder crashed while writing it, is returned as nil and considered expired.
+func readLease(leasePath string) (*checkoutLease, []byte, error) {
+	raw, err := os.ReadFile(leasePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	var lease checkoutLease
+	if err := json.Unmarshal(raw, &lease); err != nil {
+		return nil, raw, nil
+	}
+	return &lease, raw, nil
+}
+
+func holderOf(lease *checkoutLease) string {
+	if lease == nil {
+		return "<unknown>"
+	}
+	return lease.Holder
+}
+
+// ValidateCheckoutCoordination returns an error if the given checkout coordination mode is not supported
+func ValidateCheckoutCoordination(mode string) error {
+	switch mode {
+	case CheckoutCoordinationNone, CheckoutCoordinationLease:
+		return nil
+	default:
+		return fmt.Errorf("unsupported checkout coordination mode %q, must be one of: %s, %s", mode, CheckoutCoordinationNone, CheckoutCoordinationLease)
+	}
+}
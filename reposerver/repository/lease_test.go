@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+func newTestLeaser(holder string, duration time.Duration) *checkoutLeaser {
+	return &checkoutLeaser{holder: holder, duration: duration, retryInterval: 10 * time.Millisecond, now: time.Now}
+}
+
+func TestCheckoutLeaser_Exclusive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repo")
+	leaser1 := newTestLeaser("replica-1", time.Minute)
+	leaser2 := newTestLeaser("replica-2", time.Minute)
+
+	lease1, err := leaser1.Acquire(t.Context(), path)
+	require.NoError(t, err)
+
+	acquired := make(chan io.Closer)
+	go func() {
+		lease, err := leaser2.Acquire(t.Context(), path)
+		assert.NoError(t, err)
+		acquired <- lease
+	}()
+	select {
+	case <-acquired:
+		require.Fail(t, "lease acquired while held by another replica")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	require.NoError(t, lease1.Close())
+	// the pending acquisition of the second replica completes once the lease has been released
+	var lease2 io.Closer
+	select {
+	case lease2 = <-acquired:
+	case <-time.After(time.Second):
+		require.Fail(t, "lease not acquired after it has been released")
+	}
+	current, _, err := readLease(path + leaseFileSuffix)
+	require.NoError(t, err)
+	assert.Equal(t, "replica-2", current.Holder)
+	require.NoError(t, lease2.Close())
+
+	_, err = os.Stat(path + leaseFileSuffix)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCheckoutLeaser_Timeout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repo")
+	lease1, err := newTestLeaser("replica-1", time.Minute).Acquire(t.Context(), path)
+	require.NoError(t, err)
+	defer utilio.Close(lease1)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+	_, err = newTestLeaser("replica-2", time.Minute).Acquire(ctx, path)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestCheckoutLeaser_TakeOverExpired(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repo")
+	expired, err := json.Marshal(checkoutLease{Holder: "crashed-replica", ExpiresAt: time.Now().Add(-time.Second)})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path+leaseFileSuffix, expired, 0o600))
+
+	lease, done := lockQuickly(func() (io.Closer, error) {
+		return newTestLeaser("replica-1", time.Minute).Acquire(t.Context(), path)
+	})
+	require.True(t, done)
+	current, _, err := readLease(path + leaseFileSuffix)
+	require.NoError(t, err)
+	assert.Equal(t, "replica-1", current.Holder)
+	require.NoError(t, lease.Close())
+}
+
+func TestCheckoutLeaser_Renewal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repo")
+	lease, err := newTestLeaser("replica-1", 300*time.Millisecond).Acquire(t.Context(), path)
+	require.NoError(t, err)
+	defer utilio.Close(lease)
+
+	// the lease is renewed, so that it is not taken over although its initial duration has passed
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+	_, err = newTestLeaser("replica-2", 300*time.Millisecond).Acquire(ctx, path)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSharedRepositoryLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "repo")
+	lock := NewSharedRepositoryLock(time.Minute)
+	initializedTimes := 0
+	init := numberOfInits(&initializedTimes)
+
+	closer, err := lock.Lock(t.Context(), path, "1", true, init)
+	require.NoError(t, err)
+	current, _, err := readLease(path + leaseFileSuffix)
+	require.NoError(t, err)
+	assert.Equal(t, lock.leaser.holder, current.Holder)
+
+	// the lease is released once the repository is no longer in use
+	require.NoError(t, closer.Close())
+	_, err = os.Stat(path + leaseFileSuffix)
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, 1, initializedTimes)
+}
+
+func TestValidateCheckoutCoordination(t *testing.T) {
+	require.NoError(t, ValidateCheckoutCoordination(CheckoutCoordinationNone))
+	require.NoError(t, ValidateCheckoutCoordination(CheckoutCoordinationLease))
+	require.ErrorContains(t, ValidateCheckoutCoordination("nfs"), "unsupported checkout coordination mode")
+}
+
+func TestNewService_CheckoutPaths(t *testing.T) {
+	root := t.TempDir()
+	repoURL := "https://github.com/argoproj/argocd-example-apps"
+
+	// checkout paths are random unless hashed paths are enabled, even when checkouts are coordinated
+	service := NewService(nil, nil, RepoServerInitConstants{CheckoutCoordination: CheckoutCoordinationLease, CheckoutLeaseDuration: time.Minute}, nil, root)
+	assert.IsType(t, &utilio.RandomizedTempPaths{}, service.gitRepoPaths)
+	other := NewService(nil, nil, RepoServerInitConstants{CheckoutCoordination: CheckoutCoordinationLease, CheckoutLeaseDuration: time.Minute}, nil, root)
+	path, err := service.gitRepoPaths.GetPath(repoURL)
+	require.NoError(t, err)
+	otherPath, err := other.gitRepoPaths.GetPath(repoURL)
+	require.NoError(t, err)
+	assert.NotEqual(t, path, otherPath)
+
+	service = NewService(nil, nil, RepoServerInitConstants{CheckoutCoordination: CheckoutCoordinationLease, CheckoutLeaseDuration: time.Minute, CheckoutHashedPaths: true}, nil, root)
+	other = NewService(nil, nil, RepoServerInitConstants{CheckoutCoordination: CheckoutCoordinationLease, CheckoutLeaseDuration: time.Minute, CheckoutHashedPaths: true}, nil, root)
+	path, err = service.gitRepoPaths.GetPath(repoURL)
+	require.NoError(t, err)
+	otherPath, err = other.gitRepoPaths.GetPath(repoURL)
+	require.NoError(t, err)
+	assert.Equal(t, path, otherPath)
+}
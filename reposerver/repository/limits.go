@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/semaphore"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// repositorySemaphores limits the number of concurrent manifest generations per repository
+type repositorySemaphores struct {
+	lock        sync.Mutex
+	semByRepo   map[string]*semaphore.Weighted
+	limitByRepo map[string]int64
+}
+
+func newRepositorySemaphores() *repositorySemaphores {
+	return &repositorySemaphores{semByRepo: map[string]*semaphore.Weighted{}, limitByRepo: map[string]int64{}}
+}
+
+// Get returns the semaphore limiting manifest generations of the given repository or nil if the repository has no limit.
+// The semaphore is recreated if the limit of the repository has changed, in-flight operations keep releasing the old one.
+func (r *repositorySemaphores) Get(repo *v1alpha1.Repository) *semaphore.Weighted {
+	if r == nil || repo == nil {
+		return nil
+	}
+	key := git.NormalizeGitURL(repo.Repo)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if repo.MaxConcurrentManifestGenerations <= 0 {
+		delete(r.semByRepo, key)
+		delete(r.limitByRepo, key)
+		return nil
+	}
+	if sem, ok := r.semByRepo[key]; ok && r.limitByRepo[key] == repo.MaxConcurrentManifestGenerations {
+		return sem
+	}
+	sem := semaphore.NewWeighted(repo.MaxConcurrentManifestGenerations)
+	r.semByRepo[key] = sem
+	r.limitByRepo[key] = repo.MaxConcurrentManifestGenerations
+	return sem
+}
+
+// manifestGenerationMemoryLimit returns the memory limit of the manifest generation tools of the given repository in bytes
+func manifestGenerationMemoryLimit(repo *v1alpha1.Repository) (int64, error) {
+	if repo == nil || repo.ManifestGenerationMemoryLimit == "" {
+		return 0, nil
+	}
+	quantity, err := resource.ParseQuantity(repo.ManifestGenerationMemoryLimit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse manifest generation memory limit %q: %w", repo.ManifestGenerationMemoryLimit, err)
+	}
+	return quantity.Value(), nil
+}
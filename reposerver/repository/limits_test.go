@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestRepositorySemaphores_Get(t *testing.T) {
+	semaphores := newRepositorySemaphores()
+	assert.Nil(t, semaphores.Get(&v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd"}))
+
+	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd", MaxConcurrentManifestGenerations: 1}
+	sem := semaphores.Get(repo)
+	require.NotNil(t, sem)
+	assert.Same(t, sem, semaphores.Get(&v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd.git", MaxConcurrentManifestGenerations: 1}))
+	assert.True(t, sem.TryAcquire(1))
+	assert.False(t, sem.TryAcquire(1))
+
+	repo.MaxConcurrentManifestGenerations = 2
+	assert.NotSame(t, sem, semaphores.Get(repo))
+}
+
+func TestManifestGenerationMemoryLimit(t *testing.T) {
+	limit, err := manifestGenerationMemoryLimit(nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), limit)
+
+	limit, err = manifestGenerationMemoryLimit(&v1alpha1.Repository{ManifestGenerationMemoryLimit: "1Gi"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1<<30), limit)
+
+	_, err = manifestGenerationMemoryLimit(&v1alpha1.Repository{ManifestGenerationMemoryLimit: "invalid"})
+	assert.Error(t, err)
+}
@@ -1,9 +1,12 @@
 package repository
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
@@ -12,13 +15,23 @@ func NewRepositoryLock() *repositoryLock {
 	return &repositoryLock{stateByKey: map[string]*repositoryState{}}
 }
 
+// NewSharedRepositoryLock returns a repository lock which additionally acquires a lease of the repository, so that
+// repository checkouts on a volume shared with other repo-server replicas are never accessed concurrently
+func NewSharedRepositoryLock(leaseDuration time.Duration) *repositoryLock {
+	lock := NewRepositoryLock()
+	lock.leaser = newCheckoutLeaser(leaseDuration)
+	return lock
+}
+
 type repositoryLock struct {
 	lock       sync.Mutex
 	stateByKey map[string]*repositoryState
+	leaser     *checkoutLeaser
 }
 
-// Lock acquires lock unless lock is already acquired with the same commit and allowConcurrent is set to true
-func (r *repositoryLock) Lock(path string, revision string, allowConcurrent bool, init func() (io.Closer, error)) (io.Closer, error) {
+// Lock acquires lock unless lock is already acquired with the same commit and allowConcurrent is set to true. The given
+// context bounds the time spent waiting for the lease of a shared repository.
+func (r *repositoryLock) Lock(ctx context.Context, path string, revision string, allowConcurrent bool, init func() (io.Closer, error)) (io.Closer, error) {
 	r.lock.Lock()
 	state, ok := r.stateByKey[path]
 	if !ok {
@@ -52,7 +65,7 @@ func (r *repositoryLock) Lock(path string, revision string, allowConcurrent bool
 		state.cond.L.Lock()
 		if state.revision == "" {
 			// no in progress operation for that repo. Go ahead.
-			initCloser, err := init()
+			initCloser, err := r.initialize(ctx, path, init)
 			if err != nil {
 				state.cond.L.Unlock()
 				return nil, fmt.Errorf("failed to initialize repository resources: %w", err)
@@ -82,3 +95,22 @@ type repositoryState struct {
 	processCount    int
 	allowConcurrent bool
 }
+
+// initialize initializes the repository resources, after acquiring the lease of the repository if it is shared
+func (r *repositoryLock) initialize(ctx context.Context, path string, init func() (io.Closer, error)) (io.Closer, error) {
+	if r.leaser == nil {
+		return init()
+	}
+	lease, err := r.leaser.Acquire(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	initCloser, err := init()
+	if err != nil {
+		utilio.Close(lease)
+		return nil, err
+	}
+	return utilio.NewCloser(func() error {
+		return errors.Join(initCloser.Close(), lease.Close())
+	}), nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// maxManifestLimitOffenders is the maximum number of offending resources or kinds named in a ManifestLimitError
+const maxManifestLimitOffenders = 5
+
+// ManifestLimitError is returned if the manifests generated for an application exceed the limits configured for the
+// repo server. It names the resources contributing the most to exceeding the limit.
+type ManifestLimitError struct {
+	// Limit describes the exceeded limit
+	Limit string
+	// Offenders describes the resources contributing the most to exceeding the limit, in descending order
+	Offenders []string
+}
+
+func (e *ManifestLimitError) Error() string {
+	return fmt.Sprintf("%s; largest contributors: %s", e.Limit, strings.Join(e.Offenders, ", "))
+}
+
+// GRPCStatus returns the status the error is reported with to repo server clients
+func (e *ManifestLimitError) GRPCStatus() *status.Status {
+	return status.New(codes.ResourceExhausted, e.Error())
+}
+
+// manifestLimits enforces the maximum number and combined size of the manifests generated for an application. Zero
+// values disable the respective limit.
+type manifestLimits struct {
+	maxCount int
+	maxSize  int64
+
+	size  int64
+	sizes map[kube.ResourceKey]int64
+}
+
+// add accounts for a generated manifest of the given size, given all objects rendered so far. It returns an error as
+// soon as a limit is exceeded, so that generation stops before consuming more memory.
+func (l *manifestLimits) add(obj *unstructured.Unstructured, size int, renderedObjs []*unstructured.Unstructured) error {
+	if l.maxCount > 0 && len(renderedObjs) > l.maxCount {
+		return newManifestCountLimitError(l.maxCount, renderedObjs)
+	}
+	if l.maxSize <= 0 {
+		return nil
+	}
+	if l.sizes == nil {
+		l.sizes = make(map[kube.ResourceKey]int64)
+	}
+	l.size += int64(size)
+	l.sizes[kube.GetResourceKey(obj)] += int64(size)
+	if l.size > l.maxSize {
+		return newManifestSizeLimitError(l.maxSize, l.sizes)
+	}
+	return nil
+}
+
+// newManifestCountLimitError returns an error naming the kinds with the most rendered resources
+func newManifestCountLimitError(maxCount int, renderedObjs []*unstructured.Unstructured) *ManifestLimitError {
+	counts := make(map[string]int)
+	for _, obj := range renderedObjs {
+		counts[obj.GroupVersionKind().GroupKind().String()]++
+	}
+	kinds := make([]string, 0, len(counts))
+	for kind := range counts {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if counts[kinds[i]] != counts[kinds[j]] {
+			return counts[kinds[i]] > counts[kinds[j]]
+		}
+		return kinds[i] < kinds[j]
+	})
+	err := &ManifestLimitError{Limit: fmt.Sprintf("application generates more than %d manifests, which exceeds the limit of the repo server", maxCount)}
+	for _, kind := range kinds[:min(len(kinds), maxManifestLimitOffenders)] {
+		err.Offenders = append(err.Offenders, fmt.Sprintf("%s (%d resources)", kind, counts[kind]))
+	}
+	return err
+}
+
+// newManifestSizeLimitError returns an error naming the largest resources
+func newManifestSizeLimitError(maxSize int64, sizes map[kube.ResourceKey]int64) *ManifestLimitError {
+	keys := make([]kube.ResourceKey, 0, len(sizes))
+	for key := range sizes {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if sizes[keys[i]] != sizes[keys[j]] {
+			return sizes[keys[i]] > sizes[keys[j]]
+		}
+		return keys[i].String() < keys[j].String()
+	})
+	err := &ManifestLimitError{Limit: fmt.Sprintf("combined size of the generated manifests exceeds the limit of %s of the repo server", resource.NewQuantity(maxSize, resource.DecimalSI))}
+	for _, key := range keys[:min(len(keys), maxManifestLimitOffenders)] {
+		err.Offenders = append(err.Offenders, fmt.Sprintf("%s (%s)", key.String(), resource.NewQuantity(sizes[key], resource.DecimalSI)))
+	}
+	return err
+}
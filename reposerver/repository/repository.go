@@ -64,6 +64,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/kustomize"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/sops"
 	"github.com/argoproj/argo-cd/v3/util/versions"
 )
 
@@ -89,6 +90,7 @@ type Service struct {
 	repoLock                  *repositoryLock
 	cache                     *cache.Cache
 	parallelismLimitSemaphore *semaphore.Weighted
+	repoSemaphores            *repositorySemaphores
 	metricsServer             *metrics.MetricsServer
 	newOCIClient              func(repoURL string, creds oci.Creds, proxy string, noProxy string, mediaTypes []string, opts ...oci.ClientOpts) (oci.Client, error)
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error)
@@ -115,9 +117,16 @@ type RepoServerInitConstants struct {
 	OCIManifestMaxExtractedSize                  int64
 	DisableOCIManifestMaxExtractedSize           bool
 	DisableHelmManifestMaxExtractedSize          bool
+	ManifestMaxCount                             int
+	ManifestMaxSize                              int64
 	IncludeHiddenDirectories                     bool
 	CMPUseManifestGeneratePaths                  bool
 	EnableBuiltinGitConfig                       bool
+	CheckoutCoordination                         string
+	CheckoutLeaseDuration                        time.Duration
+	CheckoutHashedPaths                          bool
+	GitReferenceDir                              string
+	GitReferenceDissociate                       bool
 }
 
 var manifestGenerateLock = sync.NewKeyLock()
@@ -129,11 +138,19 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 		parallelismLimitSemaphore = semaphore.NewWeighted(initConstants.ParallelismLimit)
 	}
 	repoLock := NewRepositoryLock()
-	gitRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
+	var gitRandomizedPaths utilio.TempPaths = utilio.NewRandomizedTempPaths(rootDir)
+	if initConstants.CheckoutCoordination == CheckoutCoordinationLease {
+		repoLock = NewSharedRepositoryLock(initConstants.CheckoutLeaseDuration)
+	}
+	if initConstants.CheckoutHashedPaths {
+		// checkouts are shared with other replicas, so their paths must be the same on all replicas
+		gitRandomizedPaths = utilio.NewHashedTempPaths(rootDir)
+	}
 	helmRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	ociRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
+		repoSemaphores:            newRepositorySemaphores(),
 		repoLock:                  repoLock,
 		cache:                     cache,
 		metricsServer:             metricsServer,
@@ -247,7 +264,7 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
 
-	closer, err := s.repoLock.Lock(gitClient.Root(), commitSHA, true, func() (goio.Closer, error) {
+	closer, err := s.repoLock.Lock(ctx, gitClient.Root(), commitSHA, true, func() (goio.Closer, error) {
 		return s.checkoutRevision(gitClient, commitSHA, s.initConstants.SubmoduleEnabled, q.Repo.Depth)
 	})
 	if err != nil {
@@ -288,7 +305,9 @@ func (s *Service) ListPlugins(_ context.Context, _ *empty.Empty) (*apiclient.Plu
 }
 
 type operationSettings struct {
-	sem             *semaphore.Weighted
+	sem *semaphore.Weighted
+	// repoSem limits the concurrent operations of the repository, it is acquired before sem
+	repoSem         *semaphore.Weighted
 	noCache         bool
 	noRevisionCache bool
 	allowConcurrent bool
@@ -366,6 +385,14 @@ func (s *Service) runRepoOperation(
 	s.metricsServer.IncPendingRepoRequest(repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
+	if settings.repoSem != nil {
+		err = settings.repoSem.Acquire(ctx, 1)
+		if err != nil {
+			return err
+		}
+		defer settings.repoSem.Release(1)
+	}
+
 	if settings.sem != nil {
 		err = settings.sem.Acquire(ctx, 1)
 		if err != nil {
@@ -449,7 +476,7 @@ func (s *Service) runRepoOperation(
 			return &operationContext{chartPath, ""}, nil
 		})
 	}
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
+	closer, err := s.repoLock.Lock(ctx, gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
 		return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled, repo.Depth)
 	})
 	if err != nil {
@@ -587,6 +614,10 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	var res *apiclient.ManifestResponse
 	var err error
 
+	if !isSourcePathPermitted(*q.ApplicationSource, q.ProjectSourcePaths) {
+		return nil, status.Errorf(codes.PermissionDenied, "application path '%s' of repo %s is not permitted in project '%s'", q.ApplicationSource.Path, q.ApplicationSource.RepoURL, q.ProjectName)
+	}
+
 	// Skip this path for ref only sources
 	if q.HasMultipleSources && q.ApplicationSource.Path == "" && !q.ApplicationSource.IsOCI() && !q.ApplicationSource.IsHelm() && q.ApplicationSource.IsRef() {
 		log.Debugf("Skipping manifest generation for ref only source for application: %s and ref %s", q.AppName, q.ApplicationSource.Ref)
@@ -635,7 +666,7 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return nil
 	}
 
-	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
+	settings := operationSettings{sem: s.parallelismLimitSemaphore, repoSem: s.repoSemaphores.Get(q.Repo), noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
 	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature, cacheFn, operation, settings, q.HasMultipleSources, q.RefSources)
 
 	// if the tarDoneCh message is sent it means that the manifest
@@ -834,7 +865,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 							ch.errCh <- fmt.Errorf("cannot reference a different revision of the same repository (%s references %q which resolves to %q while the application references %q which resolves to %q)", refVar, refSourceMapping.TargetRevision, referencedCommitSHA, q.Revision, commitSHA)
 							return
 						}
-						closer, err := s.repoLock.Lock(gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
+						closer, err := s.repoLock.Lock(ctx, gitClient.Root(), referencedCommitSHA, true, func() (goio.Closer, error) {
 							return s.checkoutRevision(gitClient, referencedCommitSHA, s.initConstants.SubmoduleEnabled, q.Repo.Depth)
 						})
 						if err != nil {
@@ -875,7 +906,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			}
 		}
 
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths))
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths), WithManifestLimits(s.initConstants.ManifestMaxCount, s.initConstants.ManifestMaxSize))
 	}
 	refSourceCommitSHAs := make(map[string]string)
 	if len(repoRefs) > 0 {
@@ -896,7 +927,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			// Retrieve a new copy (if available) of the cached response: this ensures we are updating the latest copy of the cache,
 			// rather than a copy of the cache that occurred before (a potentially lengthy) manifest generation.
 			innerRes := &cache.CachedManifestResponse{}
-			cacheErr := s.cache.GetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.InstallationID)
+			cacheErr := s.cache.GetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.InstallationID, q.ProjectSourcePaths)
 			if cacheErr != nil && !errors.Is(cacheErr, cache.ErrCacheMiss) {
 				logCtx.Warnf("manifest cache get error %s: %v", appSourceCopy.String(), cacheErr)
 				ch.errCh <- cacheErr
@@ -914,7 +945,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			// Update the cache to include failure information
 			innerRes.NumberOfConsecutiveFailures++
 			innerRes.MostRecentError = err.Error()
-			cacheErr = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.InstallationID)
+			cacheErr = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.InstallationID, q.ProjectSourcePaths)
 
 			if cacheErr != nil {
 				logCtx.Warnf("manifest cache set error %s: %v", appSourceCopy.String(), cacheErr)
@@ -938,7 +969,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = opContext.verificationResult
-	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, q.InstallationID)
+	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, q.InstallationID, q.ProjectSourcePaths)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
 	}
@@ -955,7 +986,7 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 	cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifest API call", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
 
 	res := cache.CachedManifestResponse{}
-	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.InstallationID)
+	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.InstallationID, q.ProjectSourcePaths)
 	if err == nil {
 		// The cache contains an existing value
 
@@ -972,7 +1003,7 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "manifest hash did not match or cached response is empty", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
 
 						// We can now try again, so reset the cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.InstallationID)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.InstallationID, q.ProjectSourcePaths)
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
@@ -987,7 +1018,7 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "reset after paused generation count", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
 
 						// We can now try again, so reset the error cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.InstallationID)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.InstallationID, q.ProjectSourcePaths)
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
@@ -1008,7 +1039,7 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 					// Increment the number of returned cached responses and push that new value to the cache
 					// (if we have not already done so previously in this function)
 					res.NumberOfCachedResponsesReturned++
-					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.InstallationID)
+					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.InstallationID, q.ProjectSourcePaths)
 					if err != nil {
 						log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 					}
@@ -1135,6 +1166,68 @@ func getHelmDependencyRepos(appPath string) ([]*v1alpha1.Repository, error) {
 	return repos, nil
 }
 
+// getHelmLocalDependencies returns the directories of the dependencies of the chart in chartPath, and of their
+// dependencies, which are referenced with file:// repositories
+func getHelmLocalDependencies(chartPath string) ([]string, error) {
+	visited := map[string]bool{}
+	var dirs []string
+	var collect func(dir string) error
+	collect = func(dir string) error {
+		if visited[dir] {
+			return nil
+		}
+		visited[dir] = true
+		f, err := os.ReadFile(filepath.Join(dir, "Chart.yaml"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return fmt.Errorf("error reading helm chart from %s: %w", filepath.Join(dir, "Chart.yaml"), err)
+		}
+		d := &dependencies{}
+		if err = yaml.Unmarshal(f, d); err != nil {
+			return fmt.Errorf("error unmarshalling the helm chart while getting local helm dependencies: %w", err)
+		}
+		for _, r := range d.Dependencies {
+			depPath, ok := strings.CutPrefix(r.Repository, "file://")
+			if !ok {
+				continue
+			}
+			if !filepath.IsAbs(depPath) {
+				depPath = filepath.Join(dir, depPath)
+			}
+			depPath = filepath.Clean(depPath)
+			dirs = append(dirs, depPath)
+			if err := collect(depPath); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := collect(filepath.Clean(chartPath)); err != nil {
+		return nil, err
+	}
+	return dirs, nil
+}
+
+// checkHelmDependenciesPermitted returns an error if the chart in appPath depends on a local chart which is not
+// permitted by the source path restrictions of the project
+func checkHelmDependenciesPermitted(repoRoot string, appPath string, repoURL string, restrictions []*v1alpha1.SourcePathRestriction) error {
+	if len(restrictions) == 0 {
+		return nil
+	}
+	dirs, err := getHelmLocalDependencies(appPath)
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if err := checkRepoPathPermitted(repoRoot, repoURL, dir, true, restrictions); err != nil {
+			return fmt.Errorf("helm dependency is not permitted: %w", err)
+		}
+	}
+	return nil
+}
+
 func sanitizeRepoName(repoName string) string {
 	return strings.ReplaceAll(repoName, "/", "-")
 }
@@ -1170,6 +1263,76 @@ func isSourcePermitted(url string, repos []string) bool {
 	return p.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: url})
 }
 
+func isSourcePathPermitted(source v1alpha1.ApplicationSource, restrictions []*v1alpha1.SourcePathRestriction) bool {
+	return sourcePathsProject(restrictions).IsSourcePathPermitted(source)
+}
+
+// checkRepoPathPermitted returns an error if the given file or directory, which is read during manifest generation, is
+// not permitted by the source path restrictions of the project. Files are permitted if the directory which contains
+// them is permitted. If the path contains symlinks, the path they resolve to must be permitted as well.
+func checkRepoPathPermitted(repoRoot string, repoURL string, file string, isDir bool, restrictions []*v1alpha1.SourcePathRestriction) error {
+	if len(restrictions) == 0 {
+		return nil
+	}
+	if err := checkRepoRelPathPermitted(repoRoot, repoURL, file, isDir, restrictions); err != nil {
+		return err
+	}
+	resolvedFile, err := filepath.EvalSymlinks(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// files which do not exist are not read
+			return nil
+		}
+		return fmt.Errorf("error resolving symlinks of path '%s': %w", file, err)
+	}
+	resolvedRoot, err := filepath.EvalSymlinks(repoRoot)
+	if err != nil {
+		return fmt.Errorf("error resolving symlinks of repo %s: %w", repoURL, err)
+	}
+	return checkRepoRelPathPermitted(resolvedRoot, repoURL, resolvedFile, isDir, restrictions)
+}
+
+func checkRepoRelPathPermitted(repoRoot string, repoURL string, file string, isDir bool, restrictions []*v1alpha1.SourcePathRestriction) error {
+	relPath, err := filepath.Rel(repoRoot, file)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, "../") {
+		return status.Errorf(codes.PermissionDenied, "path '%s' is outside of repo %s", file, repoURL)
+	}
+	relPath = filepath.ToSlash(relPath)
+	if !isDir {
+		relPath = path.Dir(relPath)
+	}
+	if !sourcePathsProject(restrictions).IsRepoPathPermitted(repoURL, relPath) {
+		return status.Errorf(codes.PermissionDenied, "path '%s' of repo %s is not permitted in project", relPath, repoURL)
+	}
+	return nil
+}
+
+// checkKustomizePathsPermitted returns an error if the kustomization in appPath reads any local file or directory which
+// is not permitted by the source path restrictions of the project, e.g. a resource in another directory of the repo
+func checkKustomizePathsPermitted(repoRoot string, appPath string, repoURL string, restrictions []*v1alpha1.SourcePathRestriction) error {
+	if len(restrictions) == 0 {
+		return nil
+	}
+	paths, err := kustomize.LocalPaths(appPath)
+	if err != nil {
+		return fmt.Errorf("error getting paths of kustomization: %w", err)
+	}
+	for _, p := range paths {
+		if err := checkRepoPathPermitted(repoRoot, repoURL, p.Path, p.IsDir, restrictions); err != nil {
+			return fmt.Errorf("kustomization is not permitted: %w", err)
+		}
+	}
+	return nil
+}
+
+func sourcePathsProject(restrictions []*v1alpha1.SourcePathRestriction) v1alpha1.AppProject {
+	p := v1alpha1.AppProject{}
+	for _, restriction := range restrictions {
+		p.Spec.SourcePaths = append(p.Spec.SourcePaths, *restriction)
+	}
+	return p
+}
+
 // parseKubeVersion is an helper function to remove the non-semantic information supported by kubernetes
 // that may not be supported in all helm versions: https://github.com/helm/helm/pull/31091
 func parseKubeVersion(version string) (string, error) {
@@ -1184,7 +1347,7 @@ func parseKubeVersion(version string) (string, error) {
 	return kubeVersion.String(), nil
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths) ([]*unstructured.Unstructured, string, error) {
+func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths, sources manifestSources) ([]*unstructured.Unstructured, string, error) {
 	// We use the app name as Helm's release name property, which must not
 	// contain any underscore characters and must not exceed 53 characters.
 	// We are not interested in the fully qualified application name while
@@ -1196,6 +1359,17 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		return nil, "", fmt.Errorf("could not parse kubernetes version %s: %w", q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion), err)
 	}
 
+	memoryLimit, err := manifestGenerationMemoryLimit(q.Repo)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !q.ApplicationSource.IsHelm() {
+		if err := checkHelmDependenciesPermitted(repoRoot, appPath, q.ApplicationSource.RepoURL, q.ProjectSourcePaths); err != nil {
+			return nil, "", err
+		}
+	}
+
 	templateOpts := &helm.TemplateOpts{
 		Name:        appName,
 		Namespace:   q.ApplicationSource.GetNamespaceOrDefault(q.Namespace),
@@ -1204,11 +1378,13 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		Set:         map[string]string{},
 		SetString:   map[string]string{},
 		SetFile:     map[string]pathutil.ResolvedFilePath{},
+		MemoryLimit: memoryLimit,
 	}
 
 	appHelm := q.ApplicationSource.Helm
 	var version string
 	var passCredentials bool
+	var decryptedPaths map[string]pathutil.ResolvedFilePath
 	if appHelm != nil {
 		if appHelm.Version != "" {
 			version = appHelm.Version
@@ -1220,12 +1396,20 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			templateOpts.Namespace = appHelm.Namespace
 		}
 
-		resolvedValueFiles, err := getResolvedValueFiles(appPath, repoRoot, env, q.GetValuesFileSchemes(), appHelm.ValueFiles, q.RefSources, gitRepoPaths, appHelm.IgnoreMissingValueFiles)
+		appRepoURL := ""
+		if !q.ApplicationSource.IsHelm() {
+			appRepoURL = q.ApplicationSource.RepoURL
+		}
+		resolvedValueFiles, err := getResolvedValueFiles(appPath, repoRoot, env, q.GetValuesFileSchemes(), appHelm.ValueFiles, q.RefSources, gitRepoPaths, appHelm.IgnoreMissingValueFiles, appRepoURL, q.ProjectSourcePaths)
 		if err != nil {
 			return nil, "", fmt.Errorf("error resolving helm value files: %w", err)
 		}
 
-		templateOpts.Values = resolvedValueFiles
+		templateOpts.Values, decryptedPaths, err = decryptValueFiles(resolvedValueFiles, q.SopsEnv, isLocal)
+		if err != nil {
+			return nil, "", fmt.Errorf("error decrypting helm value files: %w", err)
+		}
+		defer cleanupDecryptedValueFiles(decryptedPaths)
 
 		if !appHelm.ValuesIsEmpty() {
 			rand, err := uuid.NewRandom()
@@ -1336,8 +1520,11 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			return nil, "", err
 		}
 	}
-	objs, err := kube.SplitYAML([]byte(out))
+	objs, err := splitHelmOutput(out, sources)
 
+	for decryptedPath, encryptedPath := range decryptedPaths {
+		command = strings.ReplaceAll(command, decryptedPath, string(encryptedPath))
+	}
 	redactedCommand := redactPaths(command, gitRepoPaths, templateOpts.ExtraValues)
 
 	return objs, redactedCommand, err
@@ -1369,6 +1556,8 @@ func getResolvedValueFiles(
 	refSources map[string]*v1alpha1.RefTarget,
 	gitRepoPaths utilio.TempPaths,
 	ignoreMissingValueFiles bool,
+	repoURL string,
+	sourcePaths []*v1alpha1.SourcePathRestriction,
 ) ([]pathutil.ResolvedFilePath, error) {
 	var resolvedValueFiles []pathutil.ResolvedFilePath
 	for _, rawValueFile := range rawValueFiles {
@@ -1383,12 +1572,22 @@ func getResolvedValueFiles(
 			if err != nil {
 				return nil, fmt.Errorf("error resolving value file path: %w", err)
 			}
+			refRepoRoot := gitRepoPaths.GetPathIfExists(git.NormalizeGitURL(referencedSource.Repo.Repo))
+			if err := checkRepoPathPermitted(refRepoRoot, referencedSource.Repo.Repo, string(resolvedPath), false, sourcePaths); err != nil {
+				return nil, fmt.Errorf("value file %s is not permitted: %w", rawValueFile, err)
+			}
 		} else {
 			// This will resolve val to an absolute path (or a URL)
 			resolvedPath, isRemote, err = pathutil.ResolveValueFilePathOrUrl(appPath, repoRoot, env.Envsubst(rawValueFile), allowedValueFilesSchemas)
 			if err != nil {
 				return nil, fmt.Errorf("error resolving value file path: %w", err)
 			}
+			// value files of Helm chart sources are part of the chart and do not use paths of a repository
+			if !isRemote && repoURL != "" {
+				if err := checkRepoPathPermitted(repoRoot, repoURL, string(resolvedPath), false, sourcePaths); err != nil {
+					return nil, fmt.Errorf("value file %s is not permitted: %w", rawValueFile, err)
+				}
+			}
 		}
 
 		if !isRemote {
@@ -1406,6 +1605,50 @@ func getResolvedValueFiles(
 	return resolvedValueFiles, nil
 }
 
+// decryptValueFiles decrypts the SOPS encrypted files among the given value files into temporary files. It returns the
+// value files to pass to Helm, in which the encrypted files are replaced by the decrypted ones, and a mapping of the
+// decrypted files to the encrypted ones. The keys of the project are used for decryption, except for local manifest
+// generation, which uses the environment of the current process.
+func decryptValueFiles(valueFiles []pathutil.ResolvedFilePath, sopsEnv map[string]string, isLocal bool) ([]pathutil.ResolvedFilePath, map[string]pathutil.ResolvedFilePath, error) {
+	var env []string
+	if isLocal {
+		env = os.Environ()
+		for name, value := range sopsEnv {
+			env = append(env, name+"="+value)
+		}
+	} else {
+		env = sops.Environ(sopsEnv)
+	}
+
+	result := make([]pathutil.ResolvedFilePath, len(valueFiles))
+	decrypted := map[string]pathutil.ResolvedFilePath{}
+	for i, valueFile := range valueFiles {
+		result[i] = valueFile
+		// remote value files are not decrypted, as they are read by Helm directly
+		if !sops.IsEncryptedFile(string(valueFile)) {
+			continue
+		}
+		if !isLocal && len(sopsEnv) == 0 {
+			cleanupDecryptedValueFiles(decrypted)
+			return nil, nil, fmt.Errorf("value file %s is encrypted: %w", filepath.Base(string(valueFile)), sops.ErrNoKeys)
+		}
+		decryptedPath, err := sops.Decrypt(string(valueFile), os.TempDir(), env)
+		if err != nil {
+			cleanupDecryptedValueFiles(decrypted)
+			return nil, nil, err
+		}
+		decrypted[decryptedPath] = valueFile
+		result[i] = pathutil.ResolvedFilePath(decryptedPath)
+	}
+	return result, decrypted, nil
+}
+
+func cleanupDecryptedValueFiles(decrypted map[string]pathutil.ResolvedFilePath) {
+	for p := range decrypted {
+		_ = os.Remove(p)
+	}
+}
+
 func getResolvedRefValueFile(
 	rawValueFile string,
 	env *v1alpha1.Env,
@@ -1458,6 +1701,7 @@ type (
 		cmpTarDoneCh                chan<- bool
 		cmpTarExcludedGlobs         []string
 		cmpUseManifestGeneratePaths bool
+		limits                      manifestLimits
 	}
 )
 
@@ -1494,10 +1738,19 @@ func WithCMPUseManifestGeneratePaths(enabled bool) GenerateManifestOpt {
 	}
 }
 
+// WithManifestLimits limits the number and the combined size in bytes of the generated manifests. Zero values
+// disable the respective limit.
+func WithManifestLimits(maxCount int, maxSize int64) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.limits = manifestLimits{maxCount: maxCount, maxSize: maxSize}
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, gitRepoPaths utilio.TempPaths, opts ...GenerateManifestOpt) (*apiclient.ManifestResponse, error) {
 	opt := newGenerateManifestOpt(opts...)
 	var targetObjs []*unstructured.Unstructured
+	sources := manifestSources{}
 
 	resourceTracking := argo.NewResourceTracking()
 
@@ -1517,7 +1770,7 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
 		var command string
-		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths)
+		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths, sources)
 		commands = append(commands, command)
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		var kustomizeBinary string
@@ -1530,11 +1783,21 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		if err != nil {
 			return nil, fmt.Errorf("could not parse kubernetes version %s: %w", q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion), err)
 		}
+		var memoryLimit int64
+		memoryLimit, err = manifestGenerationMemoryLimit(q.Repo)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkKustomizePathsPermitted(repoRoot, appPath, q.ApplicationSource.RepoURL, q.ProjectSourcePaths); err != nil {
+			return nil, err
+		}
 		k := kustomize.NewKustomizeApp(repoRoot, appPath, q.Repo.GetGitCreds(gitCredsStore), repoURL, kustomizeBinary, q.Repo.Proxy, q.Repo.NoProxy)
 		targetObjs, _, commands, err = k.Build(q.ApplicationSource.Kustomize, q.KustomizeOptions, env, &kustomize.BuildOpts{
 			KubeVersion: kubeVersion,
 			APIVersions: q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
+			MemoryLimit: memoryLimit,
 		})
+		addKustomizeSources(repoRoot, appPath, targetObjs, sources)
 	case v1alpha1.ApplicationSourceTypePlugin:
 		pluginName := ""
 		if q.ApplicationSource.Plugin != nil {
@@ -1551,13 +1814,14 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 			directory = &v1alpha1.ApplicationSourceDirectory{}
 		}
 		logCtx := log.WithField("application", q.AppName)
-		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity)
+		targetObjs, err = findManifests(logCtx, appPath, repoRoot, env, *directory, q.EnabledSourceTypes, maxCombinedManifestQuantity, sources)
 	}
 	if err != nil {
 		return nil, err
 	}
 
 	manifests := make([]string, 0)
+	var renderedObjs []*unstructured.Unstructured
 	for _, obj := range targetObjs {
 		if obj == nil {
 			continue
@@ -1582,6 +1846,10 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		default:
 			targets = []*unstructured.Unstructured{obj}
 		}
+		if source, ok := sources[obj]; ok && obj.IsList() {
+			sources.add(source, targets...)
+		}
+		renderedObjs = append(renderedObjs, targets...)
 
 		for _, target := range targets {
 			if q.AppLabelKey != "" && q.AppName != "" && !kube.IsCRD(target) {
@@ -1594,14 +1862,18 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 			if err != nil {
 				return nil, err
 			}
+			if err := opt.limits.add(target, len(manifestStr), renderedObjs); err != nil {
+				return nil, err
+			}
 			manifests = append(manifests, string(manifestStr))
 		}
 	}
 
 	return &apiclient.ManifestResponse{
-		Manifests:  manifests,
-		SourceType: string(appSourceType),
-		Commands:   commands,
+		Manifests:          manifests,
+		SourceType:         string(appSourceType),
+		Commands:           commands,
+		DuplicateResources: findDuplicateResources(renderedObjs, q.Namespace, sources),
 	}, nil
 }
 
@@ -1746,7 +2018,7 @@ func isNullList(obj *unstructured.Unstructured) bool {
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
 // findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity) ([]*unstructured.Unstructured, error) {
+func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, enabledManifestGeneration map[string]bool, maxCombinedManifestQuantity resource.Quantity, sources manifestSources) ([]*unstructured.Unstructured, error) {
 	// Validate the directory before loading any manifests to save memory.
 	potentiallyValidManifests, err := getPotentiallyValidManifests(logCtx, appPath, repoRoot, directory.Recurse, directory.Include, directory.Exclude, maxCombinedManifestQuantity)
 	if err != nil {
@@ -1758,6 +2030,7 @@ func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1al
 	for _, potentiallyValidManifest := range potentiallyValidManifests {
 		manifestPath := potentiallyValidManifest.path
 		manifestFileInfo := potentiallyValidManifest.fileInfo
+		numObjs := len(objs)
 
 		if strings.HasSuffix(manifestFileInfo.Name(), ".jsonnet") {
 			if !discovery.IsManifestGenerationEnabled(v1alpha1.ApplicationSourceTypeDirectory, enabledManifestGeneration) {
@@ -1791,6 +2064,7 @@ func findManifests(logCtx *log.Entry, appPath string, repoRoot string, env *v1al
 				return nil, err
 			}
 		}
+		sources.add(relativeSource(repoRoot, manifestPath), objs[numObjs:]...)
 	}
 	return objs, nil
 }
@@ -2273,7 +2547,7 @@ func populateHelmAppDetails(res *apiclient.RepoAppDetailsResponse, appPath strin
 	if q.Source.Helm != nil {
 		ignoreMissingValueFiles = q.Source.Helm.IgnoreMissingValueFiles
 	}
-	resolvedSelectedValueFiles, err := getResolvedValueFiles(appPath, repoRoot, &v1alpha1.Env{}, q.GetValuesFileSchemes(), selectedValueFiles, q.RefSources, gitRepoPaths, ignoreMissingValueFiles)
+	resolvedSelectedValueFiles, err := getResolvedValueFiles(appPath, repoRoot, &v1alpha1.Env{}, q.GetValuesFileSchemes(), selectedValueFiles, q.RefSources, gitRepoPaths, ignoreMissingValueFiles, "", nil)
 	if err != nil {
 		return fmt.Errorf("failed to resolve value files: %w", err)
 	}
@@ -2400,7 +2674,7 @@ func populatePluginAppDetails(ctx context.Context, res *apiclient.RepoAppDetails
 	return nil
 }
 
-func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
 	if !git.IsCommitSHA(q.Revision) && !git.IsTruncatedCommitSHA(q.Revision) {
 		return nil, fmt.Errorf("revision %s must be resolved", q.Revision)
 	}
@@ -2435,7 +2709,7 @@ func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServer
 	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
 
-	closer, err := s.repoLock.Lock(gitClient.Root(), q.Revision, true, func() (goio.Closer, error) {
+	closer, err := s.repoLock.Lock(ctx, gitClient.Root(), q.Revision, true, func() (goio.Closer, error) {
 		return s.checkoutRevision(gitClient, q.Revision, s.initConstants.SubmoduleEnabled, q.Repo.Depth)
 	})
 	if err != nil {
@@ -2570,7 +2844,8 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 	}
 	opts = append(opts,
 		git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)),
-		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig))
+		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig),
+		git.WithReferenceDir(s.initConstants.GitReferenceDir, s.initConstants.GitReferenceDissociate))
 	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
 }
 
@@ -2813,6 +3088,15 @@ func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositor
 	if err != nil {
 		return apiResp, fmt.Errorf("error testing repository connectivity: %w", err)
 	}
+	if q.WriteAccess {
+		if repo.Type != "git" {
+			return apiResp, status.Errorf(codes.InvalidArgument, "write access can only be verified for Git repositories, not %s repositories", repo.Type)
+		}
+		err = git.TestRepoWriteAccess(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.Proxy, repo.NoProxy)
+		if err != nil {
+			return apiResp, fmt.Errorf("error testing repository write access: %w", err)
+		}
+	}
 	return apiResp, nil
 }
 
@@ -2859,7 +3143,7 @@ func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevis
 	}, nil
 }
 
-func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error) {
+func (s *Service) GetGitFiles(ctx context.Context, request *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error) {
 	repo := request.GetRepo()
 	revision := request.GetRevision()
 	gitPath := request.GetPath()
@@ -2894,7 +3178,7 @@ func (s *Service) GetGitFiles(_ context.Context, request *apiclient.GitFilesRequ
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
 	// cache miss, generate the results
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
+	closer, err := s.repoLock.Lock(ctx, gitClient.Root(), revision, true, func() (goio.Closer, error) {
 		return s.checkoutRevision(gitClient, revision, request.GetSubmoduleEnabled(), repo.Depth)
 	})
 	if err != nil {
@@ -2947,7 +3231,7 @@ func verifyCommitSignature(verifyCommit bool, gitClient git.Client, revision str
 	return nil
 }
 
-func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error) {
+func (s *Service) GetGitDirectories(ctx context.Context, request *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error) {
 	repo := request.GetRepo()
 	revision := request.GetRevision()
 	noRevisionCache := request.GetNoRevisionCache()
@@ -2965,7 +3249,7 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 	}
 
 	// check the cache and return the results if present
-	if cachedPaths, err := s.cache.GetGitDirectories(repo.Repo, revision); err == nil {
+	if cachedPaths, err := s.cache.GetGitDirectories(repo.Repo, revision, request.GetPaths(), request.GetExcludedPaths()); err == nil {
 		log.Debugf("cache hit for repo: %s revision: %s", repo.Repo, revision)
 		return &apiclient.GitDirectoriesResponse{
 			Paths: cachedPaths,
@@ -2976,7 +3260,7 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
 	// cache miss, generate the results
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
+	closer, err := s.repoLock.Lock(ctx, gitClient.Root(), revision, true, func() (goio.Closer, error) {
 		return s.checkoutRevision(gitClient, revision, request.GetSubmoduleEnabled(), repo.Depth)
 	})
 	if err != nil {
@@ -3007,6 +3291,16 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 			return nil
 		}
 
+		if len(request.GetPaths()) > 0 {
+			slashPath := filepath.ToSlash(relativePath)
+			if !gitDirectoryMayMatch(slashPath, request.GetPaths()) {
+				return filepath.SkipDir // Skip the directories which cannot lead to a requested path
+			}
+			if !matchesAnyGitDirectoryPattern(slashPath, request.GetPaths()) || matchesAnyGitDirectoryPattern(slashPath, request.GetExcludedPaths()) {
+				return nil
+			}
+		}
+
 		paths = append(paths, relativePath)
 
 		return nil
@@ -3015,7 +3309,7 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 	}
 
 	log.Debugf("found %d git paths from %s", len(paths), repo.Repo)
-	err = s.cache.SetGitDirectories(repo.Repo, revision, paths)
+	err = s.cache.SetGitDirectories(repo.Repo, revision, request.GetPaths(), request.GetExcludedPaths(), paths)
 	if err != nil {
 		log.Warnf("error caching git directories for repo %s with revision %s: %v", repo.Repo, revision, err)
 	}
@@ -3025,11 +3319,44 @@ func (s *Service) GetGitDirectories(_ context.Context, request *apiclient.GitDir
 	}, nil
 }
 
+// gitDirectoryMayMatch returns whether the directory, or one of its subdirectories, may match one of the glob patterns.
+// Since '*' does not match the path separator, the pattern segments are matched one by one against the directory ones.
+func gitDirectoryMayMatch(dir string, patterns []string) bool {
+	dirSegments := strings.Split(dir, "/")
+	for _, pattern := range patterns {
+		patternSegments := strings.Split(pattern, "/")
+		if len(patternSegments) < len(dirSegments) {
+			continue
+		}
+		mayMatch := true
+		for i, dirSegment := range dirSegments {
+			if match, err := path.Match(patternSegments[i], dirSegment); err != nil || !match {
+				mayMatch = false
+				break
+			}
+		}
+		if mayMatch {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyGitDirectoryPattern returns whether the directory matches one of the glob patterns
+func matchesAnyGitDirectoryPattern(dir string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if match, err := path.Match(pattern, dir); err == nil && match {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateRevisionForPaths compares two git revisions and checks if the files in the given paths have changed
 // If no files were changed, it will store the already cached manifest to the key corresponding to the old revision, avoiding an unnecessary generation.
 // Example: cache has key "a1a1a1" with manifest "x", and the files for that manifest have not changed,
 // "x" will be stored again with the new revision "b2b2b2".
-func (s *Service) UpdateRevisionForPaths(_ context.Context, request *apiclient.UpdateRevisionForPathsRequest) (*apiclient.UpdateRevisionForPathsResponse, error) {
+func (s *Service) UpdateRevisionForPaths(ctx context.Context, request *apiclient.UpdateRevisionForPathsRequest) (*apiclient.UpdateRevisionForPathsResponse, error) {
 	logCtx := log.WithFields(log.Fields{"application": request.AppName, "appNamespace": request.Namespace})
 
 	repo := request.GetRepo()
@@ -3068,7 +3395,7 @@ func (s *Service) UpdateRevisionForPaths(_ context.Context, request *apiclient.U
 	s.metricsServer.IncPendingRepoRequest(repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
+	closer, err := s.repoLock.Lock(ctx, gitClient.Root(), revision, true, func() (goio.Closer, error) {
 		return s.checkoutRevision(gitClient, revision, false, 0)
 	})
 	if err != nil {
@@ -3129,7 +3456,7 @@ func (s *Service) updateCachedRevision(logCtx *log.Entry, oldRev string, newRev
 		}
 	}
 
-	err := s.cache.SetNewRevisionManifests(newRev, oldRev, request.ApplicationSource, request.RefSources, request, request.Namespace, request.TrackingMethod, request.AppLabelKey, request.AppName, repoRefs, request.InstallationID)
+	err := s.cache.SetNewRevisionManifests(newRev, oldRev, request.ApplicationSource, request.RefSources, request, request.Namespace, request.TrackingMethod, request.AppLabelKey, request.AppName, repoRefs, request.InstallationID, request.ProjectSourcePaths)
 	if err != nil {
 		if errors.Is(err, cache.ErrCacheMiss) {
 			logCtx.Debugf("manifest cache miss during comparison for application %s in repo %s from revision %s", request.AppName, request.GetRepo().Repo, oldRev)
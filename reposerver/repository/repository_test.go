@@ -32,6 +32,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -51,6 +53,7 @@ import (
 	helmmocks "github.com/argoproj/argo-cd/v3/util/helm/mocks"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	iomocks "github.com/argoproj/argo-cd/v3/util/io/mocks"
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
 	ocimocks "github.com/argoproj/argo-cd/v3/util/oci/mocks"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
@@ -239,6 +242,24 @@ func TestGenerateYamlManifestInDir(t *testing.T) {
 	assert.Len(t, res2.Manifests, 3)
 }
 
+func TestGenerateManifest_SourcePathNotPermitted(t *testing.T) {
+	service := newService(t, "../../manifests/base")
+
+	src := v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd.git", Path: "."}
+	q := apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		ApplicationSource:  &src,
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+		ProjectSourcePaths: []*v1alpha1.SourcePathRestriction{{RepoURL: "*", Paths: []string{"apps/**"}}},
+	}
+
+	_, err := service.GenerateManifest(t.Context(), &q)
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "application path '.' of repo https://github.com/argoproj/argo-cd.git is not permitted in project 'something'")
+}
+
 func Test_GenerateManifest_KustomizeWithVersionOverride(t *testing.T) {
 	t.Parallel()
 
@@ -348,6 +369,51 @@ func TestGenerateManifests_MissingSymlinkDestination(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGenerateManifests_DuplicateResources(t *testing.T) {
+	q := apiclient.ManifestRequest{
+		Repo: &v1alpha1.Repository{}, ApplicationSource: &v1alpha1.ApplicationSource{}, ProjectName: "something",
+		ProjectSourceRepos: []string{"*"}, Namespace: "default",
+	}
+	res, err := GenerateManifests(t.Context(), "./testdata/duplicate-resources", "./testdata", "", &q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil)
+	require.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	assert.Equal(t, []*apiclient.DuplicateResource{{
+		Kind:      "ConfigMap",
+		Namespace: "default",
+		Name:      "my-config",
+		Sources:   []string{"duplicate-resources/config.yaml", "duplicate-resources/copy.yaml"},
+	}}, res.DuplicateResources)
+}
+
+func TestGenerateManifests_ManifestLimits(t *testing.T) {
+	q := apiclient.ManifestRequest{
+		Repo: &v1alpha1.Repository{}, ApplicationSource: &v1alpha1.ApplicationSource{}, ProjectName: "something",
+		ProjectSourceRepos: []string{"*"}, Namespace: "default",
+	}
+
+	t.Run("within limits", func(t *testing.T) {
+		res, err := GenerateManifests(t.Context(), "./testdata/duplicate-resources", "./testdata", "", &q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil, WithManifestLimits(3, 10000))
+		require.NoError(t, err)
+		assert.Len(t, res.Manifests, 3)
+	})
+
+	t.Run("too many manifests", func(t *testing.T) {
+		_, err := GenerateManifests(t.Context(), "./testdata/duplicate-resources", "./testdata", "", &q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil, WithManifestLimits(2, 0))
+		var limitErr *ManifestLimitError
+		require.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, []string{"ConfigMap (3 resources)"}, limitErr.Offenders)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("manifests too large", func(t *testing.T) {
+		_, err := GenerateManifests(t.Context(), "./testdata/duplicate-resources", "./testdata", "", &q, false, &git.NoopCredsStore{}, resource.MustParse("0"), nil, WithManifestLimits(0, 200))
+		var limitErr *ManifestLimitError
+		require.ErrorAs(t, err, &limitErr)
+		assert.Contains(t, limitErr.Limit, "exceeds the limit of 200")
+		assert.Contains(t, limitErr.Offenders[0], "/ConfigMap/default/my-config")
+	})
+}
+
 func TestGenerateManifests_K8SAPIResetCache(t *testing.T) {
 	service := newService(t, "../../manifests/base")
 
@@ -362,7 +428,7 @@ func TestGenerateManifests_K8SAPIResetCache(t *testing.T) {
 
 	cachedFakeResponse := &apiclient.ManifestResponse{Manifests: []string{"Fake"}, Revision: mock.Anything}
 
-	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse}, nil, "")
+	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse}, nil, "", nil)
 	require.NoError(t, err)
 
 	res, err := service.GenerateManifest(t.Context(), &q)
@@ -387,7 +453,7 @@ func TestGenerateManifests_EmptyCache(t *testing.T) {
 		ProjectSourceRepos: []string{"*"},
 	}
 
-	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: nil}, nil, "")
+	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: nil}, nil, "", nil)
 	require.NoError(t, err)
 
 	res, err := service.GenerateManifest(t.Context(), &q)
@@ -829,7 +895,7 @@ func TestManifestGenErrorCacheByNumRequests(t *testing.T) {
 		assert.NotNil(t, manifestRequest)
 
 		cachedManifestResponse := &cache.CachedManifestResponse{}
-		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest.RefSources, manifestRequest, manifestRequest.Namespace, "", manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse, nil, "")
+		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest.RefSources, manifestRequest, manifestRequest.Namespace, "", manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse, nil, "", nil)
 		require.NoError(t, err)
 		return cachedManifestResponse
 	}
@@ -1956,9 +2022,9 @@ func TestService_newHelmClientResolveRevision(t *testing.T) {
 
 func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 	t.Run("No app name set and app specific file exists", func(t *testing.T) {
-		service := newService(t, ".")
 		runWithTempTestdata(t, "multi", func(t *testing.T, path string) {
 			t.Helper()
+			service := newService(t, ".")
 			details, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -1970,9 +2036,9 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 		})
 	})
 	t.Run("No app specific override", func(t *testing.T) {
-		service := newService(t, ".")
 		runWithTempTestdata(t, "single-global", func(t *testing.T, path string) {
 			t.Helper()
+			service := newService(t, ".")
 			details, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -1985,9 +2051,9 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 		})
 	})
 	t.Run("Only app specific override", func(t *testing.T) {
-		service := newService(t, ".")
 		runWithTempTestdata(t, "single-app-only", func(t *testing.T, path string) {
 			t.Helper()
+			service := newService(t, ".")
 			details, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -2000,9 +2066,9 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 		})
 	})
 	t.Run("App specific override", func(t *testing.T) {
-		service := newService(t, ".")
 		runWithTempTestdata(t, "multi", func(t *testing.T, path string) {
 			t.Helper()
+			service := newService(t, ".")
 			details, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -2015,9 +2081,9 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 		})
 	})
 	t.Run("App specific overrides containing non-mergeable field", func(t *testing.T) {
-		service := newService(t, ".")
 		runWithTempTestdata(t, "multi", func(t *testing.T, path string) {
 			t.Helper()
+			service := newService(t, ".")
 			details, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -2030,9 +2096,9 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 		})
 	})
 	t.Run("Broken app-specific overrides", func(t *testing.T) {
-		service := newService(t, ".")
 		runWithTempTestdata(t, "multi", func(t *testing.T, path string) {
 			t.Helper()
+			service := newService(t, ".")
 			_, err := service.GetAppDetails(t.Context(), &apiclient.RepoServerAppDetailsQuery{
 				Repo: &v1alpha1.Repository{},
 				Source: &v1alpha1.ApplicationSource{
@@ -2048,27 +2114,23 @@ func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
 // There are unit test that will use kustomize set and by that modify the
 // kustomization.yaml. For proper testing, we need to copy the testdata to a
 // temporary path, run the tests, and then throw the copy away again.
-func mkTempParameters(ctx context.Context, source string) string {
-	tempDir, err := os.MkdirTemp("./testdata", "app-parameters")
-	if err != nil {
-		panic(err)
-	}
-	cmd := exec.CommandContext(ctx, "cp", "-R", source, tempDir)
-	err = cmd.Run()
-	if err != nil {
-		os.RemoveAll(tempDir)
-		panic(err)
-	}
+func mkTempParameters(t *testing.T, source string) string {
+	t.Helper()
+	tempDir := t.TempDir()
+	err := exec.CommandContext(t.Context(), "cp", "-R", source, tempDir).Run()
+	require.NoError(t, err)
 	return tempDir
 }
 
 // Simple wrapper run a test with a temporary copy of the testdata, because
-// the test would modify the data when run.
+// the test would modify the data when run. The test runs in the temporary
+// directory, so that the copy is accessed with a path relative to the repository root.
 func runWithTempTestdata(t *testing.T, path string, runner func(t *testing.T, path string)) {
 	t.Helper()
-	tempDir := mkTempParameters(t.Context(), "./testdata/app-parameters")
-	runner(t, filepath.Join(tempDir, "app-parameters", path))
-	os.RemoveAll(tempDir)
+	source, err := filepath.Abs("./testdata/app-parameters")
+	require.NoError(t, err)
+	t.Chdir(mkTempParameters(t, source))
+	runner(t, filepath.Join("app-parameters", path))
 }
 
 func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
@@ -2133,9 +2195,9 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 	})
 
 	t.Run("Application specific override", func(t *testing.T) {
-		service := newService(t, ".")
 		runWithTempTestdata(t, "single-app-only", func(t *testing.T, path string) {
 			t.Helper()
+			service := newService(t, ".")
 			manifests, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
 				Repo: &v1alpha1.Repository{},
 				ApplicationSource: &v1alpha1.ApplicationSource{
@@ -2164,9 +2226,9 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 	})
 
 	t.Run("Multi-source with source as ref only does not generate manifests", func(t *testing.T) {
-		service := newService(t, ".")
 		runWithTempTestdata(t, "single-app-only", func(t *testing.T, _ string) {
 			t.Helper()
+			service := newService(t, ".")
 			manifests, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
 				Repo: &v1alpha1.Repository{},
 				ApplicationSource: &v1alpha1.ApplicationSource{
@@ -2186,9 +2248,9 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 	})
 
 	t.Run("Application specific override for other app", func(t *testing.T) {
-		service := newService(t, ".")
 		runWithTempTestdata(t, "single-app-only", func(t *testing.T, path string) {
 			t.Helper()
+			service := newService(t, ".")
 			manifests, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
 				Repo: &v1alpha1.Repository{},
 				ApplicationSource: &v1alpha1.ApplicationSource{
@@ -2217,9 +2279,9 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 	})
 
 	t.Run("Override info does not appear in cache key", func(t *testing.T) {
-		service := newService(t, ".")
 		runWithTempTestdata(t, "single-global", func(t *testing.T, path string) {
 			t.Helper()
+			service := newService(t, ".")
 			source := &v1alpha1.ApplicationSource{
 				Path: path,
 			}
@@ -2236,7 +2298,7 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 			// Try to pull from the cache with a `source` that does not include any overrides. Overrides should not be
 			// part of the cache key, because you can't get the overrides without a repo operation. And avoiding repo
 			// operations is the point of the cache.
-			err = service.cache.GetManifests(mock.Anything, source, v1alpha1.RefTargetRevisionMapping{}, &v1alpha1.ClusterInfo{}, "", "", "", "test", res, nil, "")
+			err = service.cache.GetManifests(mock.Anything, source, v1alpha1.RefTargetRevisionMapping{}, &v1alpha1.ClusterInfo{}, "", "", "", "test", res, nil, "", nil)
 			require.NoError(t, err)
 		})
 	})
@@ -2453,7 +2515,7 @@ func TestFindResources(t *testing.T) {
 				Recurse: true,
 				Include: tc.include,
 				Exclude: tc.exclude,
-			}, map[string]bool{}, resource.MustParse("0"))
+			}, map[string]bool{}, resource.MustParse("0"), nil)
 			require.NoError(t, err)
 			var names []string
 			for i := range objs {
@@ -2468,7 +2530,7 @@ func TestFindManifests_Exclude(t *testing.T) {
 	objs, err := findManifests(&log.Entry{}, "testdata/app-include-exclude", ".", nil, v1alpha1.ApplicationSourceDirectory{
 		Recurse: true,
 		Exclude: "subdir/deploymentSub.yaml",
-	}, map[string]bool{}, resource.MustParse("0"))
+	}, map[string]bool{}, resource.MustParse("0"), nil)
 
 	require.NoError(t, err)
 	require.Len(t, objs, 1)
@@ -2480,7 +2542,7 @@ func TestFindManifests_Exclude_NothingMatches(t *testing.T) {
 	objs, err := findManifests(&log.Entry{}, "testdata/app-include-exclude", ".", nil, v1alpha1.ApplicationSourceDirectory{
 		Recurse: true,
 		Exclude: "nothing.yaml",
-	}, map[string]bool{}, resource.MustParse("0"))
+	}, map[string]bool{}, resource.MustParse("0"), nil)
 
 	require.NoError(t, err)
 	require.Len(t, objs, 2)
@@ -2820,7 +2882,7 @@ func Test_findManifests(t *testing.T) {
 		err = os.Chmod(appDir, 0o000)
 		require.NoError(t, err)
 
-		manifests, err := findManifests(logCtx, appDir, appDir, nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, appDir, appDir, nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 
@@ -2832,20 +2894,20 @@ func Test_findManifests(t *testing.T) {
 	})
 
 	t.Run("no recursion when recursion is disabled", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 2)
 		require.NoError(t, err)
 	})
 
 	t.Run("recursion when recursion is enabled", func(t *testing.T) {
 		recurse := v1alpha1.ApplicationSourceDirectory{Recurse: true}
-		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, recurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/recurse", "./testdata/recurse", nil, recurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 4)
 		require.NoError(t, err)
 	})
 
 	t.Run("non-JSON/YAML is skipped", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/non-manifest-file", "./testdata/non-manifest-file", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/non-manifest-file", "./testdata/non-manifest-file", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.NoError(t, err)
 	})
@@ -2860,14 +2922,14 @@ func Test_findManifests(t *testing.T) {
 		t.Chdir(testDir)
 		require.NoError(t, fileutil.CreateSymlink(t, "a.json", "b.json"))
 		require.NoError(t, fileutil.CreateSymlink(t, "b.json", "a.json"))
-		manifests, err := findManifests(logCtx, "./testdata/circular-link", "./testdata/circular-link", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/circular-link", "./testdata/circular-link", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("out-of-bounds symlink should throw an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/out-of-bounds-link")
-		manifests, err := findManifests(logCtx, "./testdata/out-of-bounds-link", "./testdata/out-of-bounds-link", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/out-of-bounds-link", "./testdata/out-of-bounds-link", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
@@ -2877,13 +2939,13 @@ func Test_findManifests(t *testing.T) {
 		require.NoError(t, err)
 		appPath, err := filepath.Abs("./testdata/in-bounds-link/app")
 		require.NoError(t, err)
-		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("symlink to nowhere should be ignored", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/link-to-nowhere", "./testdata/link-to-nowhere", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/link-to-nowhere", "./testdata/link-to-nowhere", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.NoError(t, err)
 	})
@@ -2894,82 +2956,82 @@ func Test_findManifests(t *testing.T) {
 		appPath, err := filepath.Abs("./testdata/in-bounds-link/app")
 		require.NoError(t, err)
 		// The file is 35 bytes.
-		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("34"))
+		manifests, err := findManifests(logCtx, appPath, repoRoot, nil, noRecurse, nil, resource.MustParse("34"), nil)
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("group of files should be limited at precisely the sum of their size", func(t *testing.T) {
 		// There is a total of 10 files, each file being 10 bytes.
-		manifests, err := findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("365"))
+		manifests, err := findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("365"), nil)
 		assert.Len(t, manifests, 10)
 		require.NoError(t, err)
 
-		manifests, err = findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("364"))
+		manifests, err = findManifests(logCtx, "./testdata/several-files", "./testdata/several-files", nil, noRecurse, nil, resource.MustParse("364"), nil)
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("jsonnet isn't counted against size limit", func(t *testing.T) {
 		// Each file is 36 bytes. Only the 36-byte json file should be counted against the limit.
-		manifests, err := findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("36"))
+		manifests, err := findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("36"), nil)
 		assert.Len(t, manifests, 2)
 		require.NoError(t, err)
 
-		manifests, err = findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("35"))
+		manifests, err = findManifests(logCtx, "./testdata/jsonnet-and-json", "./testdata/jsonnet-and-json", nil, noRecurse, nil, resource.MustParse("35"), nil)
 		assert.Empty(t, manifests)
 		assert.ErrorIs(t, err, ErrExceededMaxCombinedManifestFileSize)
 	})
 
 	t.Run("partially valid YAML file throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/partially-valid-yaml")
-		manifests, err := findManifests(logCtx, "./testdata/partially-valid-yaml", "./testdata/partially-valid-yaml", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/partially-valid-yaml", "./testdata/partially-valid-yaml", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("invalid manifest throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/invalid-manifests")
-		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests", "./testdata/invalid-manifests", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests", "./testdata/invalid-manifests", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("invalid manifest containing '+argocd:skip-file-rendering' doesn't throw an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/invalid-manifests-skipped")
-		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests-skipped", "./testdata/invalid-manifests-skipped", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/invalid-manifests-skipped", "./testdata/invalid-manifests-skipped", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.NoError(t, err)
 	})
 
 	t.Run("irrelevant YAML gets skipped, relevant YAML gets parsed", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/irrelevant-yaml", "./testdata/irrelevant-yaml", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/irrelevant-yaml", "./testdata/irrelevant-yaml", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("multiple JSON objects in one file throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/json-list")
-		manifests, err := findManifests(logCtx, "./testdata/json-list", "./testdata/json-list", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/json-list", "./testdata/json-list", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("invalid JSON throws an error", func(t *testing.T) {
 		require.DirExists(t, "./testdata/invalid-json")
-		manifests, err := findManifests(logCtx, "./testdata/invalid-json", "./testdata/invalid-json", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/invalid-json", "./testdata/invalid-json", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Empty(t, manifests)
 		require.Error(t, err)
 	})
 
 	t.Run("valid JSON returns manifest and no error", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/valid-json", "./testdata/valid-json", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/valid-json", "./testdata/valid-json", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
 
 	t.Run("YAML with an empty document doesn't throw an error", func(t *testing.T) {
-		manifests, err := findManifests(logCtx, "./testdata/yaml-with-empty-document", "./testdata/yaml-with-empty-document", nil, noRecurse, nil, resource.MustParse("0"))
+		manifests, err := findManifests(logCtx, "./testdata/yaml-with-empty-document", "./testdata/yaml-with-empty-document", nil, noRecurse, nil, resource.MustParse("0"), nil)
 		assert.Len(t, manifests, 1)
 		require.NoError(t, err)
 	})
@@ -2987,6 +3049,28 @@ func TestTestRepoHelmOCI(t *testing.T) {
 	assert.ErrorContains(t, err, "OCI Helm repository URL should include hostname and port only")
 }
 
+func TestTestRepoWriteAccess(t *testing.T) {
+	service := newService(t, ".")
+	repoPath := t.TempDir()
+	initGitRepo(t, newGitRepoOptions{path: repoPath, addEmptyCommit: true})
+
+	t.Run("Git", func(t *testing.T) {
+		_, err := service.TestRepository(t.Context(), &apiclient.TestRepositoryRequest{
+			Repo:        &v1alpha1.Repository{Repo: "file://" + repoPath},
+			WriteAccess: true,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("UnreachableRepository", func(t *testing.T) {
+		_, err := service.TestRepository(t.Context(), &apiclient.TestRepositoryRequest{
+			Repo:        &v1alpha1.Repository{Repo: "file://" + filepath.Join(repoPath, "missing")},
+			WriteAccess: true,
+		})
+		require.Error(t, err)
+	})
+}
+
 func Test_getHelmDependencyRepos(t *testing.T) {
 	repo1 := "https://charts.bitnami.com/bitnami"
 	repo2 := "https://eventstore.github.io/EventStore.Charts"
@@ -3566,7 +3650,7 @@ func Test_getResolvedValueFiles(t *testing.T) {
 		tcc := tc
 		t.Run(tcc.name, func(t *testing.T) {
 			t.Parallel()
-			resolvedPaths, err := getResolvedValueFiles(path.Join(tempDir, "main-repo"), path.Join(tempDir, "main-repo"), tcc.env, []string{}, []string{tcc.rawPath}, tcc.refSources, paths, false)
+			resolvedPaths, err := getResolvedValueFiles(path.Join(tempDir, "main-repo"), path.Join(tempDir, "main-repo"), tcc.env, []string{}, []string{tcc.rawPath}, tcc.refSources, paths, false, "", nil)
 			if !tcc.expectedErr {
 				require.NoError(t, err)
 				require.Len(t, resolvedPaths, 1)
@@ -3579,6 +3663,151 @@ func Test_getResolvedValueFiles(t *testing.T) {
 	}
 }
 
+func Test_getResolvedValueFiles_SourcePaths(t *testing.T) {
+	tempDir := t.TempDir()
+	paths := utilio.NewRandomizedTempPaths(tempDir)
+	paths.Add(git.NormalizeGitURL("https://github.com/org/values"), path.Join(tempDir, "values"))
+	refSources := map[string]*v1alpha1.RefTarget{"$values": {Repo: v1alpha1.Repository{Repo: "https://github.com/org/values"}}}
+	restrictions := []*v1alpha1.SourcePathRestriction{
+		{RepoURL: "https://github.com/org/monorepo", Paths: []string{"apps/team-a/**"}},
+		{RepoURL: "https://github.com/org/values", Paths: []string{"team-a/**"}},
+	}
+	repoRoot := path.Join(tempDir, "monorepo")
+	appPath := path.Join(repoRoot, "apps", "team-a")
+
+	for _, rawPath := range []string{"values.yaml", "envs/prod/values.yaml", "$values/team-a/values.yaml"} {
+		_, err := getResolvedValueFiles(appPath, repoRoot, &v1alpha1.Env{}, []string{}, []string{rawPath}, refSources, paths, false, "https://github.com/org/monorepo", restrictions)
+		require.NoError(t, err, rawPath)
+	}
+	for _, rawPath := range []string{"../team-b/values.yaml", "/values.yaml", "$values/team-b/values.yaml", "$values/values.yaml"} {
+		_, err := getResolvedValueFiles(appPath, repoRoot, &v1alpha1.Env{}, []string{}, []string{rawPath}, refSources, paths, false, "https://github.com/org/monorepo", restrictions)
+		require.Error(t, err, rawPath)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), rawPath)
+	}
+
+	// value files of Helm chart sources are not restricted
+	_, err := getResolvedValueFiles(appPath, repoRoot, &v1alpha1.Env{}, []string{}, []string{"../team-b/values.yaml"}, refSources, paths, false, "", restrictions)
+	require.NoError(t, err)
+}
+
+func Test_checkRepoPathPermitted_Symlinks(t *testing.T) {
+	repoRoot := t.TempDir()
+	repoURL := "https://github.com/org/monorepo"
+	restrictions := []*v1alpha1.SourcePathRestriction{{RepoURL: repoURL, Paths: []string{"apps/team-a/**"}}}
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, "apps", "team-a"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, "secrets"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, "secrets", "values.yaml"), []byte("password: secret\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, "apps", "team-a", "values.yaml"), []byte("replicaCount: 1\n"), 0o600))
+	require.NoError(t, os.Symlink("../../secrets/values.yaml", filepath.Join(repoRoot, "apps", "team-a", "linked-values.yaml")))
+	require.NoError(t, os.Symlink("../../secrets", filepath.Join(repoRoot, "apps", "team-a", "linked-dir")))
+
+	require.NoError(t, checkRepoPathPermitted(repoRoot, repoURL, filepath.Join(repoRoot, "apps", "team-a", "values.yaml"), false, restrictions))
+	// paths which do not exist are not read
+	require.NoError(t, checkRepoPathPermitted(repoRoot, repoURL, filepath.Join(repoRoot, "apps", "team-a", "missing.yaml"), false, restrictions))
+
+	for _, file := range []string{"linked-values.yaml", filepath.Join("linked-dir", "values.yaml")} {
+		err := checkRepoPathPermitted(repoRoot, repoURL, filepath.Join(repoRoot, "apps", "team-a", file), false, restrictions)
+		require.Error(t, err, file)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), file)
+	}
+	err := checkRepoPathPermitted(repoRoot, repoURL, filepath.Join(repoRoot, "apps", "team-a", "linked-dir"), true, restrictions)
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func Test_checkKustomizePathsPermitted(t *testing.T) {
+	repoRoot := "../../util/kustomize/testdata/kustomization_yaml_components_monorepo"
+	appPath := filepath.Join(repoRoot, "envs/inseng-pdx-egert-sandbox/namespaces/inst-system/apps/hello-world")
+	repoURL := "https://github.com/org/monorepo"
+
+	err := checkKustomizePathsPermitted(repoRoot, appPath, repoURL, []*v1alpha1.SourcePathRestriction{{RepoURL: repoURL, Paths: []string{"envs/**", "kustomize/apps/**"}}})
+	require.NoError(t, err)
+
+	err = checkKustomizePathsPermitted(repoRoot, appPath, repoURL, []*v1alpha1.SourcePathRestriction{{RepoURL: repoURL, Paths: []string{"envs/**"}}})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "path 'kustomize/apps/hello-world/base' of repo https://github.com/org/monorepo is not permitted in project")
+
+	err = checkKustomizePathsPermitted(repoRoot, appPath, repoURL, nil)
+	require.NoError(t, err)
+}
+
+func Test_checkHelmDependenciesPermitted(t *testing.T) {
+	repoRoot := "./testdata"
+	appPath := filepath.Join(repoRoot, "helm-with-local-dependency")
+	repoURL := "https://github.com/org/charts"
+
+	dirs, err := getHelmLocalDependencies(appPath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(repoRoot, "simple-chart")}, dirs)
+
+	err = checkHelmDependenciesPermitted(repoRoot, appPath, repoURL, []*v1alpha1.SourcePathRestriction{{RepoURL: repoURL, Paths: []string{"helm-with-local-dependency", "simple-chart"}}})
+	require.NoError(t, err)
+
+	err = checkHelmDependenciesPermitted(repoRoot, appPath, repoURL, []*v1alpha1.SourcePathRestriction{{RepoURL: repoURL, Paths: []string{"helm-with-local-dependency"}}})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "path 'simple-chart' of repo https://github.com/org/charts is not permitted in project")
+
+	err = checkHelmDependenciesPermitted(repoRoot, appPath, repoURL, nil)
+	require.NoError(t, err)
+}
+
+func Test_decryptValueFiles(t *testing.T) {
+	// fake sops binary writing the value of SOPS_AGE_KEY to the file given with --output
+	binDir := t.TempDir()
+	script := "#!/bin/sh\nwhile [ \"$1\" != \"--output\" ]; do shift; done\necho \"key: $SOPS_AGE_KEY\" > \"$2\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "sops"), []byte(script), 0o755))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	dir := t.TempDir()
+	plain := pathutil.ResolvedFilePath(filepath.Join(dir, "values.yaml"))
+	require.NoError(t, os.WriteFile(string(plain), []byte("replicaCount: 1\n"), 0o600))
+	encrypted := pathutil.ResolvedFilePath(filepath.Join(dir, "secrets.yaml"))
+	require.NoError(t, os.WriteFile(string(encrypted), []byte("password: ENC[AES256_GCM,data:aaaa,type:str]\nsops:\n  mac: ENC[AES256_GCM,data:bbbb,type:str]\n"), 0o600))
+	remote := pathutil.ResolvedFilePath("https://example.com/values.yaml")
+
+	t.Run("decrypts encrypted files with the project keys", func(t *testing.T) {
+		valueFiles, decrypted, err := decryptValueFiles([]pathutil.ResolvedFilePath{plain, encrypted, remote}, map[string]string{"SOPS_AGE_KEY": "project-key"}, false)
+		require.NoError(t, err)
+		defer cleanupDecryptedValueFiles(decrypted)
+
+		require.Len(t, valueFiles, 3)
+		assert.Equal(t, plain, valueFiles[0])
+		assert.NotEqual(t, encrypted, valueFiles[1])
+		assert.Equal(t, remote, valueFiles[2])
+		assert.Equal(t, map[string]pathutil.ResolvedFilePath{string(valueFiles[1]): encrypted}, decrypted)
+		data, err := os.ReadFile(string(valueFiles[1]))
+		require.NoError(t, err)
+		assert.Equal(t, "key: project-key\n", string(data))
+
+		cleanupDecryptedValueFiles(decrypted)
+		assert.NoFileExists(t, string(valueFiles[1]))
+	})
+
+	t.Run("fails without project keys", func(t *testing.T) {
+		_, _, err := decryptValueFiles([]pathutil.ResolvedFilePath{plain, encrypted}, nil, false)
+		require.ErrorContains(t, err, "value file secrets.yaml is encrypted: no SOPS keys are configured")
+	})
+
+	t.Run("uses the environment for local manifest generation", func(t *testing.T) {
+		t.Setenv("SOPS_AGE_KEY", "local-key")
+		valueFiles, decrypted, err := decryptValueFiles([]pathutil.ResolvedFilePath{encrypted}, nil, true)
+		require.NoError(t, err)
+		defer cleanupDecryptedValueFiles(decrypted)
+		data, err := os.ReadFile(string(valueFiles[0]))
+		require.NoError(t, err)
+		assert.Equal(t, "key: local-key\n", string(data))
+	})
+
+	t.Run("ignores files which are not encrypted", func(t *testing.T) {
+		valueFiles, decrypted, err := decryptValueFiles([]pathutil.ResolvedFilePath{plain}, nil, false)
+		require.NoError(t, err)
+		assert.Equal(t, []pathutil.ResolvedFilePath{plain}, valueFiles)
+		assert.Empty(t, decrypted)
+	})
+}
+
 func TestErrorGetGitDirectories(t *testing.T) {
 	// test not using the cache
 	root := "./testdata/git-files-dirs"
@@ -3721,6 +3950,54 @@ func TestGetGitDirectoriesWithHiddenDirSupported(t *testing.T) {
 	})
 }
 
+func TestGetGitDirectoriesWithPaths(t *testing.T) {
+	root := "./testdata/git-files-dirs"
+	s, _, cacheMocks := newServiceWithOpt(t, func(gitClient *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, paths *iomocks.TempPaths) {
+		gitClient.EXPECT().Init().Return(nil)
+		gitClient.EXPECT().IsRevisionPresent(mock.Anything).Return(false)
+		gitClient.EXPECT().Fetch(mock.Anything, mock.Anything).Return(nil)
+		gitClient.EXPECT().Checkout(mock.Anything, mock.Anything).Once().Return("", nil)
+		gitClient.EXPECT().LsRemote("HEAD").Return("632039659e542ed7de0c170a4fcc1c571b288fc0", nil)
+		gitClient.EXPECT().Root().Return(root)
+		paths.EXPECT().GetPath(mock.Anything).Return(root, nil)
+		paths.EXPECT().GetPathIfExists(mock.Anything).Return(root)
+	}, root)
+	dirRequest := &apiclient.GitDirectoriesRequest{
+		Repo:             &v1alpha1.Repository{Repo: "a-url.com"},
+		SubmoduleEnabled: false,
+		Revision:         "HEAD",
+		Paths:            []string{"app/*", "app/*/bar"},
+		ExcludedPaths:    []string{"app/bar"},
+	}
+	directories, err := s.GetGitDirectories(t.Context(), dirRequest)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app/foo", "app/foo/bar"}, directories.GetPaths())
+
+	// do the same request again to use the cache
+	// we only allow CheckOut to be called once in the mock
+	directories, err = s.GetGitDirectories(t.Context(), dirRequest)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app/foo", "app/foo/bar"}, directories.GetPaths())
+	cacheMocks.mockCache.AssertCacheCalledTimes(t, &repositorymocks.CacheCallCounts{
+		ExternalSets: 1,
+		ExternalGets: 2,
+	})
+}
+
+func TestGitDirectoryMayMatch(t *testing.T) {
+	patterns := []string{"apps/*/overlays", "clusters/prod-*"}
+
+	assert.True(t, gitDirectoryMayMatch("apps", patterns))
+	assert.True(t, gitDirectoryMayMatch("apps/guestbook", patterns))
+	assert.True(t, gitDirectoryMayMatch("apps/guestbook/overlays", patterns))
+	assert.False(t, gitDirectoryMayMatch("apps/guestbook/base", patterns))
+	assert.False(t, gitDirectoryMayMatch("apps/guestbook/overlays/prod", patterns))
+	assert.True(t, gitDirectoryMayMatch("clusters/prod-eu", patterns))
+	assert.False(t, gitDirectoryMayMatch("clusters/staging", patterns))
+	assert.False(t, gitDirectoryMayMatch("docs", patterns))
+	assert.False(t, gitDirectoryMayMatch("apps", []string{"[apps"}))
+}
+
 func TestErrorGetGitFiles(t *testing.T) {
 	// test not using the cache
 	root := ""
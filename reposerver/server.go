@@ -66,12 +66,12 @@ func NewServer(metricsServer *metrics.MetricsServer, cache *reposervercache.Cach
 
 	serverLog := log.NewEntry(log.StandardLogger())
 	streamInterceptors := []grpc.StreamServerInterceptor{
-		logging.StreamServerInterceptor(grpc_util.InterceptorLogger(serverLog)),
+		logging.StreamServerInterceptor(grpc_util.InterceptorLogger(serverLog), logging.WithFieldsFromContext(grpc_util.CorrelationIDFields)),
 		serverMetrics.StreamServerInterceptor(),
 		recovery.StreamServerInterceptor(recovery.WithRecoveryHandler(grpc_util.LoggerRecoveryHandler(serverLog))),
 	}
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
-		logging.UnaryServerInterceptor(grpc_util.InterceptorLogger(serverLog)),
+		logging.UnaryServerInterceptor(grpc_util.InterceptorLogger(serverLog), logging.WithFieldsFromContext(grpc_util.CorrelationIDFields)),
 		serverMetrics.UnaryServerInterceptor(),
 		recovery.UnaryServerInterceptor(recovery.WithRecoveryHandler(grpc_util.LoggerRecoveryHandler(serverLog))),
 		grpc_util.ErrorSanitizerUnaryServerInterceptor(),
@@ -262,3 +262,50 @@ func (s *Server) DeleteToken(ctx context.Context, r *account.DeleteTokenRequest)
 	}
 	return &account.EmptyResponse{}, nil
 }
+
+// ListSessions returns the active login sessions of an account
+func (s *Server) ListSessions(ctx context.Context, r *account.ListSessionsRequest) (*account.SessionsList, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbac.ActionGet, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to list sessions of account %s: %w", r.Name, err)
+	}
+	if _, err := s.settingsMgr.GetAccount(r.Name); err != nil {
+		return nil, fmt.Errorf("failed to get account %s: %w", r.Name, err)
+	}
+	sessions, err := s.sessionMgr.GetSessions(ctx, r.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions of account %s: %w", r.Name, err)
+	}
+	resp := account.SessionsList{}
+	for _, sess := range sessions {
+		resp.Items = append(resp.Items, &account.Session{Id: sess.ID, IssuedAt: sess.IssuedAt, ExpiresAt: sess.ExpiresAt})
+	}
+	sort.Slice(resp.Items, func(i, j int) bool {
+		return resp.Items[i].IssuedAt > resp.Items[j].IssuedAt
+	})
+	return &resp, nil
+}
+
+// RevokeSession immediately revokes a login session of an account
+func (s *Server) RevokeSession(ctx context.Context, r *account.RevokeSessionRequest) (*account.EmptyResponse, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbac.ActionUpdate, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to revoke session of account %s: %w", r.Name, err)
+	}
+	if err := s.sessionMgr.RevokeSession(ctx, r.Name, r.Id); err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to revoke session: %v", err)
+	}
+	log.WithFields(log.Fields{"account": r.Name, "session": r.Id, "user": session.Username(ctx)}).Info("Revoked session")
+	return &account.EmptyResponse{}, nil
+}
+
+// RevokeSessions immediately revokes all login sessions of an account
+func (s *Server) RevokeSessions(ctx context.Context, r *account.RevokeSessionsRequest) (*account.RevokeSessionsResponse, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbac.ActionUpdate, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to revoke sessions of account %s: %w", r.Name, err)
+	}
+	count, err := s.sessionMgr.RevokeSessions(ctx, r.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions of account %s: %w", r.Name, err)
+	}
+	log.WithFields(log.Fields{"account": r.Name, "count": count, "user": session.Username(ctx)}).Info("Revoked all sessions")
+	return &account.RevokeSessionsResponse{Count: int64(count)}, nil
+}
@@ -309,6 +309,21 @@ func TestDeleteToken_SuccessfullyRemoved(t *testing.T) {
 	assert.Empty(t, acc.Tokens)
 }
 
+func TestRevokeSession_NotFound(t *testing.T) {
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx)
+
+	sessions, err := accountServer.ListSessions(ctx, &account.ListSessionsRequest{Name: "admin"})
+	require.NoError(t, err)
+	assert.Empty(t, sessions.Items)
+
+	_, err = accountServer.RevokeSession(ctx, &account.RevokeSessionRequest{Name: "admin", Id: "123"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = accountServer.ListSessions(ctx, &account.ListSessionsRequest{Name: "unknown"})
+	assert.Error(t, err)
+}
+
 func TestCanI_GetLogsAllow(t *testing.T) {
 	accountServer, _ := newTestAccountServer(t, t.Context(), func(_ *corev1.ConfigMap, _ *corev1.Secret) {
 	})
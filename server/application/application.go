@@ -18,12 +18,14 @@ import (
 	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
 	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
+	gitopssync "github.com/argoproj/gitops-engine/pkg/sync"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
 	"github.com/argoproj/pkg/v2/sync"
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
@@ -34,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -81,6 +84,8 @@ const (
 var (
 	ErrCacheMiss       = cacheutil.ErrCacheMiss
 	watchAPIBufferSize = env.ParseNumFromEnv(argocommon.EnvWatchAPIBufferSize, 1000, 0, math.MaxInt32)
+	// resourceActionParallelismLimit is the maximum number of resources a bulk resource action is run on concurrently
+	resourceActionParallelismLimit = env.ParseNumFromEnv(argocommon.EnvResourceActionParallelismLimit, 10, 1, math.MaxInt32)
 )
 
 // Server provides an Application service
@@ -89,6 +94,7 @@ type Server struct {
 	kubeclientset          kubernetes.Interface
 	appclientset           appclientset.Interface
 	appLister              applisters.ApplicationLister
+	sharedAppLister        applisters.ApplicationLister // does not deep copy the listed Applications, which must not be modified
 	appInformer            cache.SharedIndexInformer
 	appBroadcaster         Broadcaster
 	repoClientset          apiclient.Clientset
@@ -135,6 +141,7 @@ func NewServer(
 		ns:                     namespace,
 		appclientset:           &deepCopyAppClientset{appclientset},
 		appLister:              &deepCopyApplicationLister{appLister},
+		sharedAppLister:        appLister,
 		appInformer:            appInformer,
 		appBroadcaster:         appBroadcaster,
 		kubeclientset:          kubeclientset,
@@ -280,11 +287,15 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 	if err != nil {
 		return nil, fmt.Errorf("error parsing the selector: %w", err)
 	}
+	if err := validateExcludeFields(q.GetExcludeFields()); err != nil {
+		return nil, err
+	}
+	// The Applications are only deep copied once paginated
 	var apps []*v1alpha1.Application
 	if q.GetAppNamespace() == "" {
-		apps, err = s.appLister.List(selector)
+		apps, err = s.sharedAppLister.List(selector)
 	} else {
-		apps, err = s.appLister.Applications(q.GetAppNamespace()).List(selector)
+		apps, err = s.sharedAppLister.Applications(q.GetAppNamespace()).List(selector)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("error listing apps with selectors: %w", err)
@@ -302,7 +313,7 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 	// Filter applications by source repo URL
 	filteredApps = argo.FilterByRepoP(filteredApps, q.GetRepo())
 
-	newItems := make([]v1alpha1.Application, 0)
+	permittedApps := make([]*v1alpha1.Application, 0)
 	for _, a := range filteredApps {
 		// Skip any application that is neither in the control plane's namespace
 		// nor in the list of enabled namespaces.
@@ -310,24 +321,30 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 			continue
 		}
 		if s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns)) {
-			// Create a deep copy to ensure all metadata fields including annotations are preserved
-			appCopy := a.DeepCopy()
-			// Explicitly copy annotations in case DeepCopy does not preserve them
-			if a.Annotations != nil {
-				appCopy.Annotations = a.Annotations
-			}
-			newItems = append(newItems, *appCopy)
+			permittedApps = append(permittedApps, a)
 		}
 	}
 
-	// Sort found applications by name
-	sort.Slice(newItems, func(i, j int) bool {
-		return newItems[i].Name < newItems[j].Name
-	})
+	// Sort found applications by name, then namespace, to paginate them
+	sortApplications(permittedApps)
+
+	page, continueToken, remainingItemCount, err := paginateApplications(permittedApps, q.GetLimit(), q.GetContinue())
+	if err != nil {
+		return nil, err
+	}
+
+	newItems := make([]v1alpha1.Application, 0, len(page))
+	for _, a := range page {
+		appCopy := a.DeepCopy()
+		excludeApplicationFields(appCopy, q.GetExcludeFields())
+		newItems = append(newItems, *appCopy)
+	}
 
 	appList := v1alpha1.ApplicationList{
 		ListMeta: metav1.ListMeta{
-			ResourceVersion: s.appInformer.LastSyncResourceVersion(),
+			ResourceVersion:    s.appInformer.LastSyncResourceVersion(),
+			Continue:           continueToken,
+			RemainingItemCount: remainingItemCount,
 		},
 		Items: newItems,
 	}
@@ -341,6 +358,16 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	}
 	a := q.GetApplication()
 
+	if a.Spec.Project == "" {
+		nsSettings, err := s.settingsMgr.GetApplicationNamespaceSettings(s.appNamespaceOrDefault(a.Namespace))
+		if err != nil {
+			return nil, fmt.Errorf("error getting application namespace settings: %w", err)
+		}
+		if nsSettings != nil {
+			a.Spec.Project = nsSettings.DefaultProject
+		}
+	}
+
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionCreate, a.RBACName(s.ns)); err != nil {
 		return nil, err
 	}
@@ -555,6 +582,10 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			if err != nil {
 				return fmt.Errorf("error getting installation ID: %w", err)
 			}
+			sopsEnv, err := argo.GetSOPSEnv(s.settingsMgr, proj)
+			if err != nil {
+				return fmt.Errorf("error getting SOPS keys: %w", err)
+			}
 			trackingMethod, err := s.settingsMgr.GetTrackingMethod()
 			if err != nil {
 				return fmt.Errorf("error getting trackingMethod from settings: %w", err)
@@ -589,6 +620,8 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				EnabledSourceTypes:              enableGenerateManifests,
 				ProjectName:                     proj.Name,
 				ProjectSourceRepos:              proj.Spec.SourceRepos,
+				ProjectSourcePaths:              proj.GetSourcePaths(),
+				SopsEnv:                         sopsEnv,
 				HasMultipleSources:              a.Spec.HasMultipleSources(),
 				RefSources:                      refSources,
 				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
@@ -711,6 +744,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			EnabledSourceTypes:              enableGenerateManifests,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
+			ProjectSourcePaths:              proj.GetSourcePaths(),
 			AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 		}
 
@@ -1060,6 +1094,40 @@ func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdat
 	return &a.Spec, nil
 }
 
+// Move moves an application to another project. The application is updated in place rather than recreated, so its
+// history and the tracking of its resources are preserved.
+func (s *Server) Move(ctx context.Context, q *application.ApplicationMoveRequest) (*v1alpha1.Application, error) {
+	a, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionUpdate, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	sourceProject := a.Spec.GetProject()
+	targetProject := q.GetTargetProject()
+	if targetProject == "" {
+		return nil, status.Error(codes.InvalidArgument, "target project must be specified")
+	}
+	if targetProject == sourceProject {
+		return nil, status.Errorf(codes.InvalidArgument, "application %s already belongs to project %s", a.QualifiedName(), targetProject)
+	}
+	if a.Operation != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot move application %s while an operation is in progress", a.QualifiedName())
+	}
+
+	a.Spec.Project = targetProject
+	// the application must remain updatable after the move, create privileges in the target project are enforced
+	// while validating the application against the target project
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	a, err = s.validateAndUpdateApp(ctx, a, false, true, rbac.ActionUpdate, sourceProject)
+	if err != nil {
+		return nil, fmt.Errorf("error moving application: %w", err)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, fmt.Sprintf("moved application from project %s to project %s", sourceProject, targetProject))
+	return a, nil
+}
+
 // Patch patches an application
 func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchRequest) (*v1alpha1.Application, error) {
 	app, _, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
@@ -1094,6 +1162,11 @@ func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchReque
 		if err != nil {
 			return nil, fmt.Errorf("error calculating merge patch: %w", err)
 		}
+	case "strategic":
+		patchApp, err = strategicpatch.StrategicMergePatch(jsonApp, []byte(q.GetPatch()), v1alpha1.Application{})
+		if err != nil {
+			return nil, fmt.Errorf("error applying strategic merge patch: %w", err)
+		}
 	default:
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Patch type '%s' is not supported", q.GetPatchType()))
 	}
@@ -1241,6 +1314,10 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 			minVersion = 0
 		}
 	}
+	fieldFilter, err := newWatchFieldFilter(q.GetWatchFields())
+	if err != nil {
+		return err
+	}
 
 	// sendIfPermitted is a helper to send the application to the client's streaming channel if the
 	// caller has RBAC privileges permissions to view it
@@ -1250,6 +1327,17 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 			return
 		}
 		s.inferResourcesStatusHealth(&a)
+		if fieldFilter != nil {
+			filtered, changed, err := fieldFilter.filter(&a, eventType)
+			if err != nil {
+				logCtx.Warnf("Unable to filter the watched fields: %v", err)
+				return
+			}
+			if !changed {
+				return
+			}
+			a = *filtered
+		}
 		err := ws.Send(&v1alpha1.ApplicationWatchEvent{
 			Type:        eventType,
 			Application: a,
@@ -1260,7 +1348,16 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 		}
 	}
 
+	// When resumed from a resource version, the changes since then, including the deletions, are replayed by a watch
+	// of the API server
+	if q.GetResourceVersion() != "" && q.GetName() == "" && minVersion > 0 {
+		return s.resumeWatch(ws.Context(), q.GetResourceVersion(), selector, sendIfPermitted)
+	}
+
 	events := make(chan *v1alpha1.ApplicationWatchEvent, watchAPIBufferSize)
+	// Subscribe before listing the applications, so that no change is missed in between
+	unsubscribe := s.appBroadcaster.Subscribe(events)
+	defer unsubscribe()
 	// Mimic watch API behavior: send ADDED events if no resource version provided
 	// If watch API is executed for one application when emit event even if resource version is provided
 	// This is required since single app watch API is used for during operations like app syncing and it is
@@ -1277,8 +1374,6 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 			sendIfPermitted(*apps[i], watch.Added)
 		}
 	}
-	unsubscribe := s.appBroadcaster.Subscribe(events)
-	defer unsubscribe()
 	for {
 		select {
 		case event := <-events:
@@ -1359,6 +1454,14 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
 	}
 
+	conditions, err = argo.ValidateAppNamespaceDestination(ctx, s.appNamespaceOrDefault(app.Namespace), &app.Spec, s.settingsMgr, s.db)
+	if err != nil {
+		return fmt.Errorf("error validating application namespace destinations: %w", err)
+	}
+	if len(conditions) > 0 {
+		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
+	}
+
 	// Validate managed-by-url annotation
 	managedByURLConditions := argo.ValidateManagedByURL(app)
 	if len(managedByURLConditions) > 0 {
@@ -1785,6 +1888,110 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 	return res, nil
 }
 
+// OperationLog returns the log of completed operations of an application, most recent operation first
+func (s *Server) OperationLog(ctx context.Context, q *application.OperationLogQuery) (*application.OperationLogResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*v1alpha1.OperationLogEntry
+	err = s.cache.GetAppOperationLog(a.InstanceName(s.ns), &entries)
+	if err != nil && !errors.Is(err, servercache.ErrCacheMiss) {
+		return nil, fmt.Errorf("error getting operation log of application %s: %w", a.QualifiedName(), err)
+	}
+	res := &application.OperationLogResponse{Items: []*v1alpha1.OperationLogEntry{}}
+	for _, entry := range entries {
+		if q.GetId() != 0 && entry.ID != q.GetId() {
+			continue
+		}
+		if q.GetLimit() > 0 && int64(len(res.Items)) >= q.GetLimit() {
+			break
+		}
+		resources := make([]*v1alpha1.OperationLogResourceResult, 0, len(entry.Resources))
+		for _, result := range entry.Resources {
+			if isMatchingOperationLogResource(q, result) {
+				resources = append(resources, result)
+			}
+		}
+		entry.Resources = resources
+		res.Items = append(res.Items, entry)
+	}
+	return res, nil
+}
+
+// SyncPlan returns the order in which a sync of an application at a given revision applies its resources
+func (s *Server) SyncPlan(ctx context.Context, q *application.ApplicationSyncPlanQuery) (*application.ApplicationSyncPlanResponse, error) {
+	manifests, err := s.GetManifests(ctx, &application.ApplicationManifestQuery{
+		Name:            q.Name,
+		Revision:        q.Revision,
+		AppNamespace:    q.AppNamespace,
+		Project:         q.Project,
+		SourcePositions: q.SourcePositions,
+		Revisions:       q.Revisions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.appLister.Applications(s.appNamespaceOrDefault(q.GetAppNamespace())).Get(q.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting destination cluster: %w", err)
+	}
+	resFilter, err := s.settingsMgr.GetResourcesFilter()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources filter: %w", err)
+	}
+
+	targets := make([]*unstructured.Unstructured, 0, len(manifests.Manifests))
+	for _, manifest := range manifests.Manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+		}
+		gvk := obj.GroupVersionKind()
+		if resFilter.IsExcludedResource(gvk.Group, gvk.Kind, destCluster.Server) {
+			continue
+		}
+		targets = append(targets, obj)
+	}
+
+	res := &application.ApplicationSyncPlanResponse{Steps: []*application.SyncPlanStep{}}
+	for _, task := range gitopssync.PlanTasks(targets, a.Spec.Destination.Namespace, q.GetSkipHooks()) {
+		gvk := task.Object.GroupVersionKind()
+		name := task.Object.GetName()
+		if name == "" {
+			name = task.Object.GetGenerateName()
+		}
+		hookTypes := make([]string, 0, len(task.HookTypes))
+		for _, hookType := range task.HookTypes {
+			hookTypes = append(hookTypes, string(hookType))
+		}
+		res.Steps = append(res.Steps, &application.SyncPlanStep{
+			Phase:     ptr.To(string(task.Phase)),
+			Wave:      ptr.To(int64(task.Wave)),
+			HookTypes: hookTypes,
+			Group:     ptr.To(gvk.Group),
+			Version:   ptr.To(gvk.Version),
+			Kind:      ptr.To(gvk.Kind),
+			Namespace: ptr.To(task.Object.GetNamespace()),
+			Name:      ptr.To(name),
+		})
+	}
+	return res, nil
+}
+
+func isMatchingOperationLogResource(q *application.OperationLogQuery, res *v1alpha1.OperationLogResourceResult) bool {
+	return (q.GetResourceName() == "" || q.GetResourceName() == res.Name) &&
+		(q.GetNamespace() == "" || q.GetNamespace() == res.Namespace) &&
+		(q.GetGroup() == "" || q.GetGroup() == res.Group) &&
+		(q.GetKind() == "" || q.GetKind() == res.Kind) &&
+		(q.GetStatus() == "" || q.GetStatus() == string(res.Status))
+}
+
 func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
 	if q.PodName != nil {
 		podKind := "Pod"
@@ -2444,11 +2651,23 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 			return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
 		}
 		a.Status.OperationState.Phase = common.OperationTerminating
+		a.Status.OperationState.Termination = &v1alpha1.OperationTermination{
+			Reason:      termOpReq.GetReason(),
+			Force:       termOpReq.GetForce(),
+			InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
+		}
 		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
 		if err == nil {
 			s.waitSync(updated)
-			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, "terminated running operation")
-			return &application.OperationTerminateResponse{}, nil
+			action := "terminated running operation"
+			if termOpReq.GetForce() {
+				action = "forcefully terminated running operation"
+			}
+			if reason := termOpReq.GetReason(); reason != "" {
+				action = fmt.Sprintf("%s: %s", action, reason)
+			}
+			s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, action)
+			return &application.OperationTerminateResponse{OperationState: updated.Status.OperationState}, nil
 		}
 		if !apierrors.IsConflict(err) {
 			return nil, fmt.Errorf("error updating application: %w", err)
@@ -2596,49 +2815,139 @@ func (s *Server) RunResourceActionV2(ctx context.Context, q *application.Resourc
 		return nil, err
 	}
 
-	liveObjBytes, err := json.Marshal(liveObj)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling live object: %w", err)
-	}
-
 	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		return nil, fmt.Errorf("error getting resource overrides: %w", err)
 	}
 
-	luaVM := lua.VM{
-		ResourceOverrides: resourceOverrides,
+	proj, err := s.getAppProject(ctx, a, log.WithFields(applog.GetAppLogFields(a)))
+	if err != nil {
+		return nil, err
 	}
-	action, err := luaVM.GetResourceAction(liveObj, q.GetAction())
+
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
 	if err != nil {
-		return nil, fmt.Errorf("error getting Lua resource action: %w", err)
+		return nil, err
 	}
 
-	newObjects, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, q.GetResourceActionParameters())
+	err = s.executeResourceAction(ctx, config, proj, destCluster, resourceOverrides, liveObj, q.GetAction(), q.GetResourceActionParameters())
 	if err != nil {
-		return nil, fmt.Errorf("error executing Lua resource action: %w", err)
+		return nil, err
 	}
 
-	var app *v1alpha1.Application
-	// Only bother getting the app if we know we're going to need it for a resource permission check.
-	if len(newObjects) > 0 {
-		// No need for an RBAC check, we checked above that the user is allowed to run this action.
-		app, err = s.appLister.Applications(s.appNamespaceOrDefault(q.GetAppNamespace())).Get(q.GetName())
-		if err != nil {
-			return nil, err
-		}
+	if res == nil {
+		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
+	} else {
+		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s/%s", q.GetAction(), res.Group, res.Kind, res.Name))
+		s.logResourceEvent(ctx, res, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
 	}
+	return &application.ApplicationResponse{}, nil
+}
 
-	proj, err := s.getAppProject(ctx, a, log.WithFields(applog.GetAppLogFields(a)))
+// RunResourceActionBulk runs a resource action on all managed resources of an application of the requested kind which
+// match the label selector. The action is run on up to resourceActionParallelismLimit resources concurrently. Failures
+// of the action on single resources are reported in the results rather than failing the request.
+func (s *Server) RunResourceActionBulk(ctx context.Context, q *application.ResourceActionRunBulkRequest) (*application.ResourceActionRunBulkResponse, error) {
+	selector, err := labels.Parse(q.GetLabelSelector())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid label selector %q: %v", q.GetLabelSelector(), err)
+	}
+
+	actionRequest := fmt.Sprintf("%s/%s/%s/%s", rbac.ActionAction, q.GetGroup(), q.GetKind(), q.GetAction())
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, actionRequest, q.GetProject(), q.GetAppNamespace(), q.GetName())
 	if err != nil {
 		return nil, err
 	}
 
-	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db)
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
 	if err != nil {
 		return nil, err
 	}
 
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+
+	var resources []v1alpha1.ResourceStatus
+	for _, res := range a.Status.Resources {
+		if res.Group == q.GetGroup() && res.Kind == q.GetKind() && (q.GetNamespace() == "" || res.Namespace == q.GetNamespace()) {
+			resources = append(resources, res)
+		}
+	}
+
+	// results of resources which don't match the label selector remain nil
+	results := make([]*application.ResourceActionResult, len(resources))
+	var eg errgroup.Group
+	eg.SetLimit(resourceActionParallelismLimit)
+	for i, res := range resources {
+		eg.Go(func() error {
+			liveObj, err := s.kubectl.GetResource(ctx, config, res.GroupVersionKind(), res.Name, res.Namespace)
+			if err == nil && (liveObj == nil || !selector.Matches(labels.Set(liveObj.GetLabels()))) {
+				return nil
+			}
+			if err == nil {
+				err = s.executeResourceAction(ctx, config, proj, destCluster, resourceOverrides, liveObj, q.GetAction(), q.GetResourceActionParameters())
+			}
+			result := &application.ResourceActionResult{
+				Group:     ptr.To(res.Group),
+				Kind:      ptr.To(res.Kind),
+				Namespace: ptr.To(res.Namespace),
+				Name:      ptr.To(res.Name),
+			}
+			if err != nil {
+				result.Error = ptr.To(err.Error())
+			} else {
+				s.logResourceEvent(ctx, &v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{
+					Group: res.Group, Version: res.Version, Kind: res.Kind, Namespace: res.Namespace, Name: res.Name, UID: string(liveObj.GetUID()),
+				}}, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	response := &application.ResourceActionRunBulkResponse{}
+	succeeded := 0
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		if result.GetError() == "" {
+			succeeded++
+		}
+		response.Results = append(response.Results, result)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on %d of %d %s resources", q.GetAction(), succeeded, len(response.Results), q.GetKind()))
+	return response, nil
+}
+
+// executeResourceAction runs the resource action on the given live object and applies the resulting changes
+func (s *Server) executeResourceAction(ctx context.Context, config *rest.Config, proj *v1alpha1.AppProject, destCluster *v1alpha1.Cluster, resourceOverrides map[string]v1alpha1.ResourceOverride, liveObj *unstructured.Unstructured, actionName string, actionParameters []*application.ResourceActionParameters) error {
+	liveObjBytes, err := json.Marshal(liveObj)
+	if err != nil {
+		return fmt.Errorf("error marshaling live object: %w", err)
+	}
+
+	luaVM := lua.VM{
+		ResourceOverrides: resourceOverrides,
+	}
+	action, err := luaVM.GetResourceAction(liveObj, actionName)
+	if err != nil {
+		return fmt.Errorf("error getting Lua resource action: %w", err)
+	}
+
+	newObjects, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, actionParameters)
+	if err != nil {
+		return fmt.Errorf("error executing Lua resource action: %w", err)
+	}
+
 	// First, make sure all the returned resources are permitted, for each operation.
 	// Also perform create with dry-runs for all create-operation resources.
 	// This is performed separately to reduce the risk of only some of the resources being successfully created later.
@@ -2648,13 +2957,13 @@ func (s *Server) RunResourceActionV2(ctx context.Context, q *application.Resourc
 		newObj := impactedResource.UnstructuredObj
 		err := s.verifyResourcePermitted(destCluster, proj, newObj)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if impactedResource.K8SOperation == lua.CreateOperation {
 			createOptions := metav1.CreateOptions{DryRun: []string{"All"}}
 			_, err := s.kubectl.CreateResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), newObj, createOptions)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 	}
@@ -2668,7 +2977,7 @@ func (s *Server) RunResourceActionV2(ctx context.Context, q *application.Resourc
 		newObj := impactedResource.UnstructuredObj
 		newObjBytes, err := json.Marshal(newObj)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling new object: %w", err)
+			return fmt.Errorf("error marshaling new object: %w", err)
 		}
 
 		switch impactedResource.K8SOperation {
@@ -2676,23 +2985,16 @@ func (s *Server) RunResourceActionV2(ctx context.Context, q *application.Resourc
 		case lua.PatchOperation:
 			_, err := s.patchResource(ctx, config, liveObjBytes, newObjBytes, newObj)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		case lua.CreateOperation:
 			_, err := s.createResource(ctx, config, newObj)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 	}
-
-	if res == nil {
-		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
-	} else {
-		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s/%s", q.GetAction(), res.Group, res.Kind, res.Name))
-		s.logResourceEvent(ctx, res, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
-	}
-	return &application.ApplicationResponse{}, nil
+	return nil
 }
 
 func (s *Server) patchResource(ctx context.Context, config *rest.Config, liveObjBytes, newObjBytes []byte, newObj *unstructured.Unstructured) (*application.ApplicationResponse, error) {
@@ -2960,8 +3262,7 @@ func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationS
 		WithTracking(appLabelKey, argoSettings.TrackingMethod).
 		WithNoCache().
 		WithManager(argocommon.ArgoCDSSAManager).
-		WithServerSideDiff(true).
-		WithServerSideDryRunner(dryRunner).
+		WithDiffEngine(argodiff.NewServerSideDiffEngine(dryRunner)).
 		WithGVKParser(gvkParser).
 		WithIgnoreMutationWebhook(!resourceutil.HasAnnotationOption(a, argocommon.AnnotationCompareOptions, "IncludeMutationWebhook=true")).
 		Build()
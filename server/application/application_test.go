@@ -952,6 +952,17 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("OperationLog", func(t *testing.T) {
+		_, err := appServer.OperationLog(adminCtx, &application.OperationLogQuery{Name: ptr.To("test")})
+		require.NoError(t, err)
+		_, err = appServer.OperationLog(noRoleCtx, &application.OperationLogQuery{Name: ptr.To("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.OperationLog(adminCtx, &application.OperationLogQuery{Name: ptr.To("doest-not-exist")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.OperationLog(adminCtx, &application.OperationLogQuery{Name: ptr.To("doest-not-exist"), Project: ptr.To("test")})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("Sync", func(t *testing.T) {
 		_, err := appServer.Sync(adminCtx, &application.ApplicationSyncRequest{Name: ptr.To("test")})
 		require.NoError(t, err)
@@ -1322,6 +1333,61 @@ func TestListApps(t *testing.T) {
 	assert.Equal(t, []string{"abc", "bcd", "def"}, names)
 }
 
+func TestListAppsPaginated(t *testing.T) {
+	appServer := newTestAppServer(t, newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "bcd"
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "abc"
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "def"
+	}))
+
+	var names []string
+	var continueToken string
+	for {
+		res, err := appServer.List(t.Context(), &application.ApplicationQuery{Limit: ptr.To(int64(2)), Continue: ptr.To(continueToken)})
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(res.Items), 2)
+		for i := range res.Items {
+			names = append(names, res.Items[i].Name)
+		}
+		if res.Continue == "" {
+			assert.Nil(t, res.RemainingItemCount)
+			break
+		}
+		assert.Equal(t, ptr.To(int64(1)), res.RemainingItemCount)
+		continueToken = res.Continue
+	}
+	assert.Equal(t, []string{"abc", "bcd", "def"}, names)
+
+	_, err := appServer.List(t.Context(), &application.ApplicationQuery{Continue: ptr.To("not a token")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListAppsExcludeFields(t *testing.T) {
+	appServer := newTestAppServer(t, newTestApp(func(app *v1alpha1.Application) {
+		app.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "argocd-server"}}
+		app.Status.Resources = []v1alpha1.ResourceStatus{{Name: "guestbook"}}
+		app.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+	}))
+
+	res, err := appServer.List(t.Context(), &application.ApplicationQuery{ExcludeFields: []string{"metadata.managedFields", "status.resources"}})
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Empty(t, res.Items[0].ManagedFields)
+	assert.Empty(t, res.Items[0].Status.Resources)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, res.Items[0].Status.Sync.Status)
+
+	// the Applications of the informer are left unchanged
+	app, err := appServer.Get(t.Context(), &application.ApplicationQuery{Name: ptr.To("test-app")})
+	require.NoError(t, err)
+	assert.Len(t, app.ManagedFields, 1)
+	assert.Len(t, app.Status.Resources, 1)
+
+	_, err = appServer.List(t.Context(), &application.ApplicationQuery{ExcludeFields: []string{"spec"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestCoupleAppsListApps(t *testing.T) {
 	var objects []runtime.Object
 	ctx := t.Context()
@@ -1508,6 +1574,41 @@ func TestCreateApp(t *testing.T) {
 	assert.Equal(t, "default", app.Spec.Project)
 }
 
+func TestCreateApp_NamespaceSettings(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, map[string]string{
+		"application.namespaceSettings": `
+- namespace: other
+  defaultProject: default
+- namespace: def*
+  defaultProject: my-proj
+  destinations:
+  - server: https://cluster-api.example.com
+    namespace: allowed-*
+`,
+	})
+
+	t.Run("DefaultProject", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Spec.Project = ""
+		testApp.Spec.Destination.Namespace = "allowed-ns"
+		app, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp})
+		require.NoError(t, err)
+		assert.Equal(t, "my-proj", app.Spec.Project)
+	})
+
+	t.Run("DestinationNotPermitted", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Name = "not-permitted"
+		testApp.Spec.Destination.Namespace = "kube-system"
+		_, err := appServer.Create(t.Context(), &application.ApplicationCreateRequest{Application: testApp})
+		require.ErrorContains(t, err, "application destination server 'https://cluster-api.example.com' and namespace 'kube-system' do not match any of the allowed destinations of namespace 'default'")
+	})
+}
+
 func TestCreateAppWithDestName(t *testing.T) {
 	appServer := newTestAppServer(t)
 	testApp := newTestAppWithDestName()
@@ -2625,14 +2726,21 @@ func TestSyncAndTerminate(t *testing.T) {
 	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Update(t.Context(), app, metav1.UpdateOptions{})
 	require.NoError(t, err)
 
-	resp, err := appServer.TerminateOperation(ctx, &application.OperationTerminateRequest{Name: &app.Name})
+	resp, err := appServer.TerminateOperation(ctx, &application.OperationTerminateRequest{Name: &app.Name, Reason: ptr.To("hook is stuck"), Force: ptr.To(true)})
 	require.NoError(t, err)
-	assert.NotNil(t, resp)
+	require.NotNil(t, resp.OperationState)
+	assert.Equal(t, synccommon.OperationTerminating, resp.OperationState.Phase)
+	assert.Equal(t, &v1alpha1.OperationTermination{Reason: "hook is stuck", Force: true}, resp.OperationState.Termination)
 
 	app, err = appServer.Get(ctx, &application.ApplicationQuery{Name: &app.Name})
 	require.NoError(t, err)
 	assert.NotNil(t, app)
 	assert.Equal(t, synccommon.OperationTerminating, app.Status.OperationState.Phase)
+	assert.Equal(t, "hook is stuck", app.Status.OperationState.Termination.Reason)
+
+	events, err = appServer.kubeclientset.CoreV1().Events(appServer.ns).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, events.Items[len(events.Items)-1].Message, "forcefully terminated running operation: hook is stuck")
 }
 
 func TestSyncHelm(t *testing.T) {
@@ -2727,6 +2835,48 @@ func TestGetManifests_WithNoCache(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSyncPlan(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	manifests := []string{
+		`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","annotations":{"argocd.argoproj.io/sync-wave":"1"}}}`,
+		`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook","namespace":"guestbook"}}`,
+		`{"apiVersion":"batch/v1","kind":"Job","metadata":{"generateName":"migrate-","annotations":{"argocd.argoproj.io/hook":"PreSync"}}}`,
+	}
+	mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+	mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.MatchedBy(func(mr *apiclient.ManifestRequest) bool {
+		return mr.Revision == "v2"
+	})).Return(&apiclient.ManifestResponse{Manifests: manifests}, nil)
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+
+	res, err := appServer.SyncPlan(t.Context(), &application.ApplicationSyncPlanQuery{
+		Name:     &testApp.Name,
+		Revision: ptr.To("v2"),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, res.Steps, 3)
+	assert.Equal(t, "PreSync", res.Steps[0].GetPhase())
+	assert.Equal(t, []string{"PreSync"}, res.Steps[0].HookTypes)
+	assert.Equal(t, "migrate-", res.Steps[0].GetName())
+	assert.Equal(t, "Service", res.Steps[1].GetKind())
+	assert.Equal(t, int64(0), res.Steps[1].GetWave())
+	assert.Equal(t, "Deployment", res.Steps[2].GetKind())
+	assert.Equal(t, "apps", res.Steps[2].GetGroup())
+	assert.Equal(t, int64(1), res.Steps[2].GetWave())
+	assert.Equal(t, testApp.Spec.Destination.Namespace, res.Steps[2].GetNamespace())
+	assert.Empty(t, res.Steps[2].HookTypes)
+
+	res, err = appServer.SyncPlan(t.Context(), &application.ApplicationSyncPlanQuery{
+		Name:      &testApp.Name,
+		Revision:  ptr.To("v2"),
+		SkipHooks: ptr.To(true),
+	})
+	require.NoError(t, err)
+	assert.Len(t, res.Steps, 2)
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []v1alpha1.RevisionHistory{{
@@ -2845,6 +2995,97 @@ p, admin, applications, update, my-proj/test-app, allow
 	})
 }
 
+func TestMoveApp(t *testing.T) {
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+	movePolicy := `
+p, admin, applications, update, default/test-app, allow
+p, admin, applications, create, my-proj/test-app, allow
+p, admin, applications, update, my-proj/test-app, allow
+`
+
+	t.Run("move with proper permissions preserves history", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Status.History = v1alpha1.RevisionHistories{{ID: 1, Revision: "abc"}}
+		appServer := newTestAppServer(t, testApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(movePolicy)
+
+		movedApp, err := appServer.Move(ctx, &application.ApplicationMoveRequest{Name: &testApp.Name, TargetProject: ptr.To("my-proj")})
+		require.NoError(t, err)
+		assert.Equal(t, "my-proj", movedApp.Spec.Project)
+		assert.Equal(t, testApp.Status.History, movedApp.Status.History)
+	})
+
+	t.Run("cannot move without create privileges in target project", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, admin, applications, update, default/test-app, allow
+p, admin, applications, update, my-proj/test-app, allow
+`)
+		_, err := appServer.Move(ctx, &application.ApplicationMoveRequest{Name: &testApp.Name, TargetProject: ptr.To("my-proj")})
+		statusErr := grpc.UnwrapGRPCStatus(err)
+		require.NotNil(t, statusErr)
+		assert.Equal(t, codes.PermissionDenied, statusErr.Code())
+	})
+
+	t.Run("cannot move without update privileges in target project", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, admin, applications, update, default/test-app, allow
+p, admin, applications, create, my-proj/test-app, allow
+`)
+		_, err := appServer.Move(ctx, &application.ApplicationMoveRequest{Name: &testApp.Name, TargetProject: ptr.To("my-proj")})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("cannot move to the current project", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(movePolicy)
+		_, err := appServer.Move(ctx, &application.ApplicationMoveRequest{Name: &testApp.Name, TargetProject: ptr.To("default")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("cannot move while an operation is in progress", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		appServer := newTestAppServer(t, testApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(movePolicy)
+		_, err := appServer.Move(ctx, &application.ApplicationMoveRequest{Name: &testApp.Name, TargetProject: ptr.To("my-proj")})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("cannot move to a project not permitting the destination", func(t *testing.T) {
+		testApp := newTestApp()
+		restrictedProj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: "default"},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:  []string{"*"},
+				Destinations: []v1alpha1.ApplicationDestination{{Server: "https://other-cluster", Namespace: "*"}},
+			},
+		}
+		appServer := newTestAppServer(t, testApp, restrictedProj)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(`
+p, admin, applications, update, default/test-app, allow
+p, admin, applications, create, restricted/test-app, allow
+p, admin, applications, update, restricted/test-app, allow
+`)
+		_, err := appServer.Move(ctx, &application.ApplicationMoveRequest{Name: &testApp.Name, TargetProject: ptr.To("restricted")})
+		statusErr := grpc.UnwrapGRPCStatus(err)
+		require.NotNil(t, statusErr)
+		assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+	})
+}
+
 func TestAppJsonPatch(t *testing.T) {
 	testApp := newTestAppWithAnnotations()
 	ctx := t.Context()
@@ -2885,6 +3126,27 @@ func TestAppMergePatch(t *testing.T) {
 	assert.Equal(t, "foo", app.Spec.Source.Path)
 }
 
+func TestAppStrategicMergePatch(t *testing.T) {
+	testApp := newTestApp()
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+	appServer := newTestAppServer(t, testApp)
+	appServer.enf.SetDefaultRole("")
+
+	app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{
+		Name: &testApp.Name, Patch: ptr.To(`{"spec": { "source": { "path": "foo" } }}`), PatchType: ptr.To("strategic"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "foo", app.Spec.Source.Path)
+	assert.Equal(t, testApp.Spec.Source.RepoURL, app.Spec.Source.RepoURL)
+
+	_, err = appServer.Patch(ctx, &application.ApplicationPatchRequest{
+		Name: &testApp.Name, Patch: ptr.To(`{"spec": { "source": { "path": "bar" } }}`), PatchType: ptr.To("unknown"),
+	})
+	require.ErrorContains(t, err, "Patch type 'unknown' is not supported")
+}
+
 func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 	t.Run("Active", func(t *testing.T) {
 		testApp := newTestApp()
@@ -3438,6 +3700,62 @@ func TestInferResourcesStatusHealthWithAppInAnyNamespace(t *testing.T) {
 	assert.Nil(t, testApp.Status.Resources[1].Health)
 }
 
+func TestOperationLog(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	for _, entry := range []*v1alpha1.OperationLogEntry{{
+		Phase: synccommon.OperationSucceeded,
+		Resources: []*v1alpha1.OperationLogResourceResult{{
+			ResourceResult: v1alpha1.ResourceResult{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: synccommon.ResultCodeSynced},
+		}},
+	}, {
+		Phase: synccommon.OperationFailed,
+		Resources: []*v1alpha1.OperationLogResourceResult{{
+			ResourceResult: v1alpha1.ResourceResult{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: synccommon.ResultCodeSyncFailed},
+		}, {
+			ResourceResult: v1alpha1.ResourceResult{Kind: "Service", Namespace: "default", Name: "guestbook", Status: synccommon.ResultCodeSynced},
+			Warnings:       []string{"spec.ports is deprecated"},
+		}},
+	}} {
+		require.NoError(t, appStateCache.AppendAppOperationLog(testApp.Name, entry, 10))
+	}
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+
+	t.Run("AllOperations", func(t *testing.T) {
+		res, err := appServer.OperationLog(t.Context(), &application.OperationLogQuery{Name: ptr.To(testApp.Name)})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, int64(2), res.Items[0].ID)
+		assert.Equal(t, synccommon.OperationFailed, res.Items[0].Phase)
+		assert.Len(t, res.Items[0].Resources, 2)
+		assert.Equal(t, int64(1), res.Items[1].ID)
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		res, err := appServer.OperationLog(t.Context(), &application.OperationLogQuery{Name: ptr.To(testApp.Name), Limit: ptr.To(int64(1))})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, int64(2), res.Items[0].ID)
+	})
+
+	t.Run("ID", func(t *testing.T) {
+		res, err := appServer.OperationLog(t.Context(), &application.OperationLogQuery{Name: ptr.To(testApp.Name), Id: ptr.To(int64(1))})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, synccommon.OperationSucceeded, res.Items[0].Phase)
+	})
+
+	t.Run("ResourceFilters", func(t *testing.T) {
+		res, err := appServer.OperationLog(t.Context(), &application.OperationLogQuery{Name: ptr.To(testApp.Name), Kind: ptr.To("Deployment"), Status: ptr.To(string(synccommon.ResultCodeSyncFailed))})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		require.Len(t, res.Items[0].Resources, 1)
+		assert.Equal(t, "guestbook", res.Items[0].Resources[0].Name)
+		assert.Empty(t, res.Items[1].Resources)
+	})
+}
+
 func TestRunNewStyleResourceAction(t *testing.T) {
 	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
 
@@ -3638,6 +3956,99 @@ func TestRunOldStyleResourceAction(t *testing.T) {
 	})
 }
 
+func TestRunResourceActionBulk(t *testing.T) {
+	group := "apps"
+	kind := "Deployment"
+	action := "pause"
+
+	newDeployment := func(name string, tier string) *unstructured.Unstructured {
+		return kube.MustToUnstructured(&appsv1.Deployment{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    map[string]string{"tier": tier},
+			},
+		})
+	}
+	deployments := []*unstructured.Unstructured{
+		newDeployment("frontend-1", "frontend"),
+		newDeployment("frontend-2", "frontend"),
+		newDeployment("backend", "backend"),
+	}
+
+	newApp := func() *v1alpha1.Application {
+		testApp := newTestApp()
+		for _, deployment := range deployments {
+			testApp.Status.Resources = append(testApp.Status.Resources, v1alpha1.ResourceStatus{
+				Group:     group,
+				Kind:      kind,
+				Version:   "v1",
+				Name:      deployment.GetName(),
+				Namespace: testNamespace,
+			})
+		}
+		return testApp
+	}
+
+	t.Run("RunOnMatchingResources", func(t *testing.T) {
+		testApp := newApp()
+		appServer := newTestAppServer(t, testApp, deployments[0], deployments[1], deployments[2])
+
+		response, err := appServer.RunResourceActionBulk(t.Context(), &application.ResourceActionRunBulkRequest{
+			Name:          &testApp.Name,
+			AppNamespace:  &testApp.Namespace,
+			Group:         &group,
+			Kind:          &kind,
+			LabelSelector: ptr.To("tier=frontend"),
+			Action:        &action,
+		})
+		require.NoError(t, err)
+		require.Len(t, response.Results, 2)
+		for i, name := range []string{"frontend-1", "frontend-2"} {
+			assert.Equal(t, name, response.Results[i].GetName())
+			assert.Empty(t, response.Results[i].GetError())
+		}
+	})
+
+	t.Run("ReportFailuresPerResource", func(t *testing.T) {
+		testApp := newApp()
+		appServer := newTestAppServer(t, testApp, deployments[0], deployments[1], deployments[2])
+
+		response, err := appServer.RunResourceActionBulk(t.Context(), &application.ResourceActionRunBulkRequest{
+			Name:         &testApp.Name,
+			AppNamespace: &testApp.Namespace,
+			Group:        &group,
+			Kind:         &kind,
+			Action:       ptr.To("does-not-exist"),
+		})
+		require.NoError(t, err)
+		require.Len(t, response.Results, 3)
+		for _, result := range response.Results {
+			assert.Contains(t, result.GetError(), "error getting Lua resource action")
+		}
+	})
+
+	t.Run("InvalidLabelSelector", func(t *testing.T) {
+		testApp := newApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.RunResourceActionBulk(t.Context(), &application.ResourceActionRunBulkRequest{
+			Name:          &testApp.Name,
+			AppNamespace:  &testApp.Namespace,
+			Group:         &group,
+			Kind:          &kind,
+			LabelSelector: ptr.To("tier in ("),
+			Action:        &action,
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestIsApplicationPermitted(t *testing.T) {
 	t.Run("Incorrect project", func(t *testing.T) {
 		testApp := newTestApp()
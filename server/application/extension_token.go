@@ -0,0 +1,274 @@
+package application
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/golang-jwt/jwt/v5"
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// ExtensionResourceEndpoint is the path of the endpoint UI extensions use to read the live state of the resources
+	// of an application, authenticated by an extension token
+	ExtensionResourceEndpoint = "/api/v1/extension-resource"
+	// extensionTokenAudience fills the "aud" field of extension tokens, which distinguishes them from session tokens
+	extensionTokenAudience = "argocd-extension-resource"
+	// extensionTokenExpiry is the lifetime of extension tokens
+	extensionTokenExpiry = 5 * time.Minute
+	// extensionTokenKeyContext is mixed into the server signature to derive the key extension tokens are signed with,
+	// so extension tokens can never be used as session tokens and vice versa
+	extensionTokenKeyContext = "extension-token"
+)
+
+// extensionTokenClaims are the claims of a token scoped to the resources of a single application
+type extensionTokenClaims struct {
+	jwt.RegisteredClaims
+	// App is the qualified name of the application, i.e. <namespace>/<name>
+	App string `json:"app"`
+	// Project is the project of the application at the time the token was issued
+	Project string `json:"project"`
+}
+
+// extensionTokenKey derives the key extension tokens are signed with from the server signature
+func extensionTokenKey(argoCDSettings *settings.ArgoCDSettings) ([]byte, error) {
+	if len(argoCDSettings.ServerSignature) == 0 {
+		return nil, errors.New("server signature is not configured")
+	}
+	mac := hmac.New(sha256.New, argoCDSettings.ServerSignature)
+	mac.Write([]byte(extensionTokenKeyContext))
+	return mac.Sum(nil), nil
+}
+
+// newExtensionToken returns a signed token granting read-only access to the resources of the given application, along
+// with its expiry
+func newExtensionToken(argoCDSettings *settings.ArgoCDSettings, a *appv1.Application, subject string, now time.Time) (string, time.Time, error) {
+	key, err := extensionTokenKey(argoCDSettings)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := now.Add(extensionTokenExpiry)
+	claims := extensionTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    session.SessionManagerClaimsIssuer,
+			Audience:  jwt.ClaimStrings{extensionTokenAudience},
+			Subject:   subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		App:     a.Namespace + "/" + a.Name,
+		Project: a.Spec.GetProject(),
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error signing extension token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+// parseExtensionToken verifies the signature, audience and expiry of the given extension token and returns its claims
+func parseExtensionToken(argoCDSettings *settings.ArgoCDSettings, tokenString string) (*extensionTokenClaims, error) {
+	key, err := extensionTokenKey(argoCDSettings)
+	if err != nil {
+		return nil, err
+	}
+	claims := &extensionTokenClaims{}
+	_, err = jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		return key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(session.SessionManagerClaimsIssuer),
+		jwt.WithAudience(extensionTokenAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.App == "" {
+		return nil, errors.New("extension token does not name an application")
+	}
+	return claims, nil
+}
+
+// CreateExtensionToken returns a short-lived token UI extensions can use to read the live state of the resources of
+// the application from the destination cluster, without being handed the credentials of the cluster
+func (s *Server) CreateExtensionToken(ctx context.Context, q *application.ExtensionTokenRequest) (*application.ExtensionTokenResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	argoCDSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting settings: %w", err)
+	}
+	token, expiresAt, err := newExtensionToken(argoCDSettings, a, session.Username(ctx), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonExtensionTokenCreated, "created extension token")
+	return &application.ExtensionTokenResponse{Token: &token, ExpiresAt: ptr.To(expiresAt.Unix())}, nil
+}
+
+type extensionResourceHandler struct {
+	appLister         applisters.ApplicationLister
+	db                db.ArgoDB
+	appResourceTreeFn AppResourceTreeFn
+	namespace         string
+	enabledNamespaces []string
+	settingsMgr       *settings.SettingsManager
+	kubectl           kube.Kubectl
+}
+
+// NewExtensionResourceHandler returns a handler which serves the live state of the resources of the application an
+// extension token was issued for. Requests for resources which are not part of the application are rejected.
+func NewExtensionResourceHandler(appLister applisters.ApplicationLister, namespace string, enabledNamespaces []string, db db.ArgoDB, appResourceTree AppResourceTreeFn, settingsMgr *settings.SettingsManager, kubectl kube.Kubectl) http.Handler {
+	return &extensionResourceHandler{
+		appLister:         appLister,
+		db:                db,
+		appResourceTreeFn: appResourceTree,
+		namespace:         namespace,
+		enabledNamespaces: enabledNamespaces,
+		settingsMgr:       settingsMgr,
+		kubectl:           kubectl,
+	}
+}
+
+func (h *extensionResourceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	tokenString, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || tokenString == "" {
+		http.Error(w, "Missing extension token", http.StatusUnauthorized)
+		return
+	}
+	argoCDSettings, err := h.settingsMgr.GetSettings()
+	if err != nil {
+		log.Errorf("error getting settings: %s", err)
+		http.Error(w, "Failed to get settings", http.StatusInternalServerError)
+		return
+	}
+	claims, err := parseExtensionToken(argoCDSettings, tokenString)
+	if err != nil {
+		http.Error(w, "Invalid extension token", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	group := q.Get("group")
+	version := q.Get("version")
+	kind := q.Get("kind")
+	namespace := q.Get("namespace")
+	name := q.Get("name")
+	if kind == "" || name == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+
+	appNs, appName, _ := strings.Cut(claims.App, "/")
+	if !security.IsNamespaceEnabled(appNs, h.namespace, h.enabledNamespaces) {
+		http.Error(w, security.NamespaceNotPermittedError(appNs).Error(), http.StatusForbidden)
+		return
+	}
+
+	fieldLog := log.WithFields(log.Fields{
+		"application": claims.App, "project": claims.Project, "userName": claims.Subject,
+		"group": group, "kind": kind, "namespace": namespace, "name": name,
+	})
+
+	ctx := r.Context()
+	a, err := h.appLister.Applications(appNs).Get(appName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, "App not found", http.StatusNotFound)
+			return
+		}
+		fieldLog.Errorf("error getting app: %s", err)
+		http.Error(w, "Cannot get app", http.StatusInternalServerError)
+		return
+	}
+	// the token must not outlive a move of the application to another project
+	if a.Spec.GetProject() != claims.Project {
+		http.Error(w, "Application project has changed", http.StatusForbidden)
+		return
+	}
+
+	tree, err := h.appResourceTreeFn(ctx, a)
+	if err != nil {
+		fieldLog.Errorf("error getting app resource tree: %s", err)
+		http.Error(w, "Cannot get app resource tree", http.StatusInternalServerError)
+		return
+	}
+	res := tree.FindNode(group, kind, namespace, name)
+	if res == nil || res.UID == "" {
+		http.Error(w, "Resource doesn't belong to the app", http.StatusForbidden)
+		return
+	}
+	if version != "" {
+		res.Version = version
+	}
+
+	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, h.db)
+	if err != nil {
+		fieldLog.Errorf("error getting destination cluster: %s", err)
+		http.Error(w, "Cannot get destination cluster", http.StatusBadRequest)
+		return
+	}
+	config, err := destCluster.RESTConfig()
+	if err != nil {
+		fieldLog.Errorf("error getting cluster REST config: %s", err)
+		http.Error(w, "Cannot get cluster config", http.StatusBadRequest)
+		return
+	}
+
+	obj, err := h.kubectl.GetResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, "Resource not found", http.StatusNotFound)
+			return
+		}
+		fieldLog.Errorf("error getting resource: %s", err)
+		http.Error(w, "Cannot get resource", http.StatusBadGateway)
+		return
+	}
+	if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+		_, obj, err = diff.HideSecretData(nil, obj, h.settingsMgr.GetSensitiveAnnotations())
+		if err != nil {
+			http.Error(w, "Cannot hide secret data", http.StatusInternalServerError)
+			return
+		}
+	}
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		http.Error(w, "Cannot marshal resource", http.StatusInternalServerError)
+		return
+	}
+
+	fieldLog.Info("extension read resource")
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
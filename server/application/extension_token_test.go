@@ -0,0 +1,171 @@
+package application
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/assets"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/session"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newExtensionTokenTestServer(t *testing.T) (*Server, http.Handler) {
+	t.Helper()
+	deployment := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "guestbook", "namespace": "guestbook"},
+	}}
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "test"
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Group: "apps", Kind: "Deployment", Version: "v1", Name: "guestbook", Namespace: "guestbook"},
+		}
+	})
+	otherApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "other"
+		app.Spec.Project = "my-proj"
+	})
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:none")
+	}
+	appServer := newTestAppServerWithEnforcerConfigure(t, f, map[string]string{}, testApp, otherApp, deployment)
+	handler := NewExtensionResourceHandler(appServer.appLister, testNamespace, nil, appServer.db, appServer.getAppResources, appServer.settingsMgr, appServer.kubectl)
+	return appServer, handler
+}
+
+func getExtensionResource(handler http.Handler, token string, query string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, ExtensionResourceEndpoint+"?"+query, http.NoBody)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+func TestCreateExtensionToken(t *testing.T) {
+	appServer, _ := newExtensionTokenTestServer(t)
+	adminCtx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"groups": []string{"admin"}, "sub": "admin"})
+	noRoleCtx := context.WithValue(t.Context(), "claims", &jwt.RegisteredClaims{Subject: "no-role"})
+
+	t.Run("Issued", func(t *testing.T) {
+		res, err := appServer.CreateExtensionToken(adminCtx, &application.ExtensionTokenRequest{Name: ptr.To("test")})
+		require.NoError(t, err)
+		assert.InDelta(t, time.Now().Add(extensionTokenExpiry).Unix(), res.GetExpiresAt(), 5)
+
+		argoCDSettings, err := appServer.settingsMgr.GetSettings()
+		require.NoError(t, err)
+		claims, err := parseExtensionToken(argoCDSettings, res.GetToken())
+		require.NoError(t, err)
+		assert.Equal(t, testNamespace+"/test", claims.App)
+		assert.Equal(t, "default", claims.Project)
+		assert.Equal(t, "admin", claims.Subject)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		_, err := appServer.CreateExtensionToken(noRoleCtx, &application.ExtensionTokenRequest{Name: ptr.To("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error())
+	})
+
+	t.Run("NotASessionToken", func(t *testing.T) {
+		res, err := appServer.CreateExtensionToken(adminCtx, &application.ExtensionTokenRequest{Name: ptr.To("test")})
+		require.NoError(t, err)
+		sessionMgr := session.NewSessionManager(appServer.settingsMgr, nil, "", nil, session.NewUserStateStorage(nil))
+		_, _, err = sessionMgr.Parse(res.GetToken())
+		require.Error(t, err)
+	})
+}
+
+func TestExtensionResourceHandler(t *testing.T) {
+	appServer, handler := newExtensionTokenTestServer(t)
+	argoCDSettings, err := appServer.settingsMgr.GetSettings()
+	require.NoError(t, err)
+	newToken := func(t *testing.T, name string, project string) string {
+		t.Helper()
+		a := newTestApp(func(app *v1alpha1.Application) {
+			app.Name = name
+			app.Spec.Project = project
+		})
+		token, _, err := newExtensionToken(argoCDSettings, a, "admin", time.Now())
+		require.NoError(t, err)
+		return token
+	}
+	const deploymentQuery = "group=apps&kind=Deployment&namespace=guestbook&name=guestbook"
+
+	t.Run("ResourceOfApp", func(t *testing.T) {
+		w := getExtensionResource(handler, newToken(t, "test", "default"), deploymentQuery)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Contains(t, w.Body.String(), `"name":"guestbook"`)
+	})
+
+	t.Run("MissingToken", func(t *testing.T) {
+		w := getExtensionResource(handler, "", deploymentQuery)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("ExpiredToken", func(t *testing.T) {
+		a := newTestApp(func(app *v1alpha1.Application) {
+			app.Name = "test"
+		})
+		token, _, err := newExtensionToken(argoCDSettings, a, "admin", time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		w := getExtensionResource(handler, token, deploymentQuery)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("SessionToken", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(appServer.settingsMgr, nil, "", nil, session.NewUserStateStorage(nil))
+		token, err := sessionMgr.Create("admin", 0, "")
+		require.NoError(t, err)
+		w := getExtensionResource(handler, token, deploymentQuery)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("TokenOfOtherServer", func(t *testing.T) {
+		a := newTestApp(func(app *v1alpha1.Application) {
+			app.Name = "test"
+		})
+		token, _, err := newExtensionToken(&settings.ArgoCDSettings{ServerSignature: []byte("other")}, a, "admin", time.Now())
+		require.NoError(t, err)
+		w := getExtensionResource(handler, token, deploymentQuery)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("ResourceOfOtherApp", func(t *testing.T) {
+		w := getExtensionResource(handler, newToken(t, "other", "my-proj"), deploymentQuery)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("ProjectChanged", func(t *testing.T) {
+		w := getExtensionResource(handler, newToken(t, "test", "my-proj"), deploymentQuery)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("MissingParameters", func(t *testing.T) {
+		w := getExtensionResource(handler, newToken(t, "test", "default"), "group=apps&kind=Deployment")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("NotGet", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodDelete, ExtensionResourceEndpoint+"?"+deploymentQuery, http.NoBody)
+		req.Header.Set("Authorization", "Bearer "+newToken(t, "test", "default"))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
@@ -0,0 +1,110 @@
+package application
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// applicationListContinue is the position of the last Application of a page of a list, from which the next page is
+// listed. The Applications are ordered by name, then namespace.
+type applicationListContinue struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// excludableApplicationFields removes the fields of an Application which can be excluded from a list
+var excludableApplicationFields = map[string]func(app *v1alpha1.Application){
+	"metadata.managedFields": func(app *v1alpha1.Application) { app.ManagedFields = nil },
+	"status":                 func(app *v1alpha1.Application) { app.Status = v1alpha1.ApplicationStatus{} },
+	"status.resources":       func(app *v1alpha1.Application) { app.Status.Resources = nil },
+	"status.history":         func(app *v1alpha1.Application) { app.Status.History = nil },
+	"status.operationState":  func(app *v1alpha1.Application) { app.Status.OperationState = nil },
+}
+
+// validateExcludeFields checks that the given fields can be excluded from the listed Applications
+func validateExcludeFields(fields []string) error {
+	for _, field := range fields {
+		if _, ok := excludableApplicationFields[field]; !ok {
+			return status.Errorf(codes.InvalidArgument, "field %q cannot be excluded", field)
+		}
+	}
+	return nil
+}
+
+// excludeApplicationFields removes the given fields from the Application, which must be a copy
+func excludeApplicationFields(app *v1alpha1.Application, fields []string) {
+	for _, field := range fields {
+		excludableApplicationFields[field](app)
+	}
+}
+
+// sortApplications sorts the Applications by name, then namespace, which is the order of the pages of a list
+func sortApplications(apps []*v1alpha1.Application) {
+	sort.Slice(apps, func(i, j int) bool {
+		if apps[i].Name != apps[j].Name {
+			return apps[i].Name < apps[j].Name
+		}
+		return apps[i].Namespace < apps[j].Namespace
+	})
+}
+
+// paginateApplications returns the page of the sorted Applications following the given continue token, of at most
+// limit Applications. If Applications remain after the page, the continue token of the next page and the number of
+// remaining Applications are returned.
+func paginateApplications(apps []*v1alpha1.Application, limit int64, continueToken string) ([]*v1alpha1.Application, string, *int64, error) {
+	if limit < 0 {
+		return nil, "", nil, status.Errorf(codes.InvalidArgument, "invalid limit %d", limit)
+	}
+
+	if continueToken != "" {
+		from, err := decodeApplicationListContinue(continueToken)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		// the Applications created or deleted since the previous page are accounted for
+		start := sort.Search(len(apps), func(i int) bool {
+			return apps[i].Name > from.Name || apps[i].Name == from.Name && apps[i].Namespace > from.Namespace
+		})
+		apps = apps[start:]
+	}
+
+	if limit == 0 || int64(len(apps)) <= limit {
+		return apps, "", nil, nil
+	}
+
+	page := apps[:limit]
+	last := page[len(page)-1]
+	next, err := encodeApplicationListContinue(applicationListContinue{Name: last.Name, Namespace: last.Namespace})
+	if err != nil {
+		return nil, "", nil, err
+	}
+	return page, next, ptr.To(int64(len(apps)) - limit), nil
+}
+
+func encodeApplicationListContinue(c applicationListContinue) (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("error encoding the continue token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeApplicationListContinue(token string) (*applicationListContinue, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid continue token: %v", err)
+	}
+	c := &applicationListContinue{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid continue token: %v", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,110 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newPaginatedApps(names ...string) []*v1alpha1.Application {
+	var apps []*v1alpha1.Application
+	for _, name := range names {
+		apps = append(apps, &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"}})
+	}
+	return apps
+}
+
+func appNames(apps []*v1alpha1.Application) []string {
+	var names []string
+	for _, app := range apps {
+		names = append(names, app.Name)
+	}
+	return names
+}
+
+func TestSortApplications(t *testing.T) {
+	apps := []*v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "argocd"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "team"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "argocd"}},
+	}
+	sortApplications(apps)
+	assert.Equal(t, "argocd/a", apps[0].Namespace+"/"+apps[0].Name)
+	assert.Equal(t, "team/a", apps[1].Namespace+"/"+apps[1].Name)
+	assert.Equal(t, "argocd/b", apps[2].Namespace+"/"+apps[2].Name)
+}
+
+func TestPaginateApplications(t *testing.T) {
+	apps := newPaginatedApps("a", "b", "c", "d", "e")
+
+	t.Run("no limit", func(t *testing.T) {
+		page, next, remaining, err := paginateApplications(apps, 0, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, appNames(page))
+		assert.Empty(t, next)
+		assert.Nil(t, remaining)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		page, next, remaining, err := paginateApplications(apps, 2, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, appNames(page))
+		assert.Equal(t, ptr.To(int64(3)), remaining)
+
+		page, next, remaining, err = paginateApplications(apps, 2, next)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c", "d"}, appNames(page))
+		assert.Equal(t, ptr.To(int64(1)), remaining)
+
+		page, next, remaining, err = paginateApplications(apps, 2, next)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"e"}, appNames(page))
+		assert.Empty(t, next)
+		assert.Nil(t, remaining)
+	})
+
+	t.Run("the last Application of the previous page was deleted", func(t *testing.T) {
+		_, next, _, err := paginateApplications(apps, 2, "")
+		require.NoError(t, err)
+		page, _, _, err := paginateApplications(newPaginatedApps("a", "c", "d"), 2, next)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c", "d"}, appNames(page))
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		_, _, _, err := paginateApplications(apps, -1, "")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid continue token", func(t *testing.T) {
+		_, _, _, err := paginateApplications(apps, 2, "not a token")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestExcludeApplicationFields(t *testing.T) {
+	require.NoError(t, validateExcludeFields([]string{"metadata.managedFields", "status.history"}))
+	assert.Equal(t, codes.InvalidArgument, status.Code(validateExcludeFields([]string{"spec"})))
+
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "argocd-server"}}},
+		Status: v1alpha1.ApplicationStatus{
+			History: v1alpha1.RevisionHistories{{ID: 1}},
+			Sync:    v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+		},
+	}
+	excludeApplicationFields(app, []string{"metadata.managedFields", "status.history"})
+	assert.Empty(t, app.ManagedFields)
+	assert.Empty(t, app.Status.History)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, app.Status.Sync.Status)
+
+	excludeApplicationFields(app, []string{"status"})
+	assert.Equal(t, v1alpha1.ApplicationStatus{}, app.Status)
+}
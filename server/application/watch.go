@@ -0,0 +1,152 @@
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// watchIdentityFields are the fields always sent with the watched fields, to identify the Applications and resume the
+// watch
+var watchIdentityFields = [][]string{
+	{"metadata", "name"},
+	{"metadata", "namespace"},
+	{"metadata", "resourceVersion"},
+}
+
+// resumeWatch sends the changes of the Applications since the given resource version, received from a watch of the API
+// server which also replays the Applications deleted since then. If the resource version is too old to be resumed, an
+// OutOfRange error is returned, so that the client lists the Applications again.
+func (s *Server) resumeWatch(ctx context.Context, resourceVersion string, selector labels.Selector, send func(v1alpha1.Application, watch.EventType)) error {
+	watchNs := s.ns
+	if len(s.enabledNamespaces) > 0 {
+		watchNs = ""
+	}
+	w, err := s.appclientset.ArgoprojV1alpha1().Applications(watchNs).Watch(ctx, metav1.ListOptions{
+		ResourceVersion: resourceVersion,
+		LabelSelector:   selector.String(),
+	})
+	if err != nil {
+		return watchResumeError(resourceVersion, err)
+	}
+	defer w.Stop()
+	for {
+		select {
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				// the API server closes watches after a timeout, the client resumes from the last event received
+				return nil
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified, watch.Deleted:
+				if app, ok := event.Object.(*v1alpha1.Application); ok {
+					send(*app, event.Type)
+				}
+			case watch.Error:
+				return watchResumeError(resourceVersion, apierrors.FromObject(event.Object))
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func watchResumeError(resourceVersion string, err error) error {
+	if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+		return status.Errorf(codes.OutOfRange, "resource version %s is too old to resume the watch, the applications must be listed again: %v", resourceVersion, err)
+	}
+	return fmt.Errorf("error resuming the watch of apps from resource version %s: %w", resourceVersion, err)
+}
+
+// watchFieldFilter filters the events of a watch of Applications to the changes of the watched fields. The hashes of the
+// watched fields sent for each Application are kept to drop the modifications which do not change them.
+type watchFieldFilter struct {
+	fields [][]string
+	sent   map[string][sha256.Size]byte
+}
+
+// newWatchFieldFilter returns the filter of the given fields, or nil if all the fields are watched
+func newWatchFieldFilter(fields []string) (*watchFieldFilter, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	f := &watchFieldFilter{sent: map[string][sha256.Size]byte{}}
+	for _, field := range fields {
+		path := strings.Split(field, ".")
+		for _, segment := range path {
+			if segment == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid watch field %q", field)
+			}
+		}
+		f.fields = append(f.fields, path)
+	}
+	return f, nil
+}
+
+// filter returns the Application of the event with only the watched fields, and whether the event must be sent
+func (f *watchFieldFilter) filter(a *v1alpha1.Application, eventType watch.EventType) (*v1alpha1.Application, bool, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(a)
+	if err != nil {
+		return nil, false, fmt.Errorf("error converting the application: %w", err)
+	}
+	watched := selectFields(obj, f.fields)
+	data, err := json.Marshal(watched)
+	if err != nil {
+		return nil, false, fmt.Errorf("error encoding the watched fields: %w", err)
+	}
+
+	key := a.QualifiedName()
+	hash := sha256.Sum256(data)
+	switch eventType {
+	case watch.Deleted:
+		delete(f.sent, key)
+	case watch.Modified:
+		if sent, ok := f.sent[key]; ok && sent == hash {
+			return nil, false, nil
+		}
+		f.sent[key] = hash
+	default:
+		f.sent[key] = hash
+	}
+
+	for _, path := range watchIdentityFields {
+		copyField(obj, watched, path)
+	}
+	res := &v1alpha1.Application{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(watched, res); err != nil {
+		return nil, false, fmt.Errorf("error converting the watched fields: %w", err)
+	}
+	return res, true, nil
+}
+
+// selectFields returns the given fields of the object which are set
+func selectFields(obj map[string]any, fields [][]string) map[string]any {
+	res := map[string]any{}
+	for _, path := range fields {
+		copyField(obj, res, path)
+	}
+	return res
+}
+
+// copyField copies the field at the given path from the source to the destination object, if it is set. A path through
+// a value which is not an object is not set.
+func copyField(src, dst map[string]any, path []string) {
+	value, found, err := unstructured.NestedFieldNoCopy(src, path...)
+	if err != nil || !found {
+		return
+	}
+	_ = unstructured.SetNestedField(dst, value, path...)
+}
@@ -0,0 +1,173 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	apps "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+)
+
+// testWatchServer records the events sent to the client of a watch
+type testWatchServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*v1alpha1.ApplicationWatchEvent
+}
+
+func (s *testWatchServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testWatchServer) Send(event *v1alpha1.ApplicationWatchEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestNewWatchFieldFilter(t *testing.T) {
+	f, err := newWatchFieldFilter(nil)
+	require.NoError(t, err)
+	assert.Nil(t, f)
+
+	f, err = newWatchFieldFilter([]string{"spec", "status.sync.status"})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"spec"}, {"status", "sync", "status"}}, f.fields)
+
+	_, err = newWatchFieldFilter([]string{"status..status"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestWatchFieldFilter(t *testing.T) {
+	f, err := newWatchFieldFilter([]string{"status.sync.status", "metadata.labels", "metadata.name.invalid"})
+	require.NoError(t, err)
+
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd", ResourceVersion: "1", Labels: map[string]string{"team": "a"}},
+		Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+		Status: v1alpha1.ApplicationStatus{
+			Sync:      v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+			Resources: []v1alpha1.ResourceStatus{{Name: "guestbook-ui"}},
+		},
+	}
+
+	filtered, send, err := f.filter(app, watch.Added)
+	require.NoError(t, err)
+	assert.True(t, send)
+	assert.Equal(t, &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd", ResourceVersion: "1", Labels: map[string]string{"team": "a"}},
+		Status:     v1alpha1.ApplicationStatus{Sync: v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced}},
+	}, filtered)
+
+	// the changes of the fields which are not watched are dropped
+	app.ResourceVersion = "2"
+	app.Status.Resources = nil
+	_, send, err = f.filter(app, watch.Modified)
+	require.NoError(t, err)
+	assert.False(t, send)
+
+	// the changes of the watched fields are sent
+	app.ResourceVersion = "3"
+	app.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+	filtered, send, err = f.filter(app, watch.Modified)
+	require.NoError(t, err)
+	assert.True(t, send)
+	assert.Equal(t, "3", filtered.ResourceVersion)
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, filtered.Status.Sync.Status)
+
+	// the deletions are sent, and an application created again is sent
+	_, send, err = f.filter(app, watch.Deleted)
+	require.NoError(t, err)
+	assert.True(t, send)
+	_, send, err = f.filter(app, watch.Modified)
+	require.NoError(t, err)
+	assert.True(t, send)
+}
+
+func TestWatchApps(t *testing.T) {
+	appServer := newTestAppServer(t, newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "abc"
+		app.ResourceVersion = "10"
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "bcd"
+		app.ResourceVersion = "20"
+	}))
+	fakeAppsClientset := appServer.appclientset.(*deepCopyAppClientset).Interface.(*apps.Clientset)
+	// the watch only sends the initial events once the context is done
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	eventNames := func(events []*v1alpha1.ApplicationWatchEvent) []string {
+		var names []string
+		for _, event := range events {
+			names = append(names, string(event.Type)+" "+event.Application.Name)
+		}
+		return names
+	}
+
+	t.Run("sends all the applications", func(t *testing.T) {
+		ws := &testWatchServer{ctx: ctx}
+		require.NoError(t, appServer.Watch(&application.ApplicationQuery{}, ws))
+		assert.Equal(t, []string{"ADDED abc", "ADDED bcd"}, eventNames(ws.events))
+	})
+
+	t.Run("resumes from a resource version", func(t *testing.T) {
+		fakeWatch := watch.NewFake()
+		fakeAppsClientset.PrependWatchReactor("applications", func(action kubetesting.Action) (bool, watch.Interface, error) {
+			assert.Equal(t, "15", action.(kubetesting.WatchActionImpl).ListOptions.ResourceVersion)
+			return true, fakeWatch, nil
+		})
+		go func() {
+			fakeWatch.Modify(newTestApp(func(app *v1alpha1.Application) {
+				app.Name = "bcd"
+				app.ResourceVersion = "20"
+			}))
+			fakeWatch.Delete(newTestApp(func(app *v1alpha1.Application) {
+				app.Name = "cde"
+				app.ResourceVersion = "25"
+			}))
+			fakeWatch.Stop()
+		}()
+		ws := &testWatchServer{ctx: t.Context()}
+		require.NoError(t, appServer.Watch(&application.ApplicationQuery{ResourceVersion: ptr.To("15")}, ws))
+		assert.Equal(t, []string{"MODIFIED bcd", "DELETED cde"}, eventNames(ws.events))
+	})
+
+	t.Run("resource version too old to resume", func(t *testing.T) {
+		fakeWatch := watch.NewFake()
+		fakeAppsClientset.PrependWatchReactor("applications", func(kubetesting.Action) (bool, watch.Interface, error) {
+			return true, fakeWatch, nil
+		})
+		go fakeWatch.Error(&apierrors.NewResourceExpired("too old resource version").ErrStatus)
+		ws := &testWatchServer{ctx: t.Context()}
+		err := appServer.Watch(&application.ApplicationQuery{ResourceVersion: ptr.To("15")}, ws)
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+	})
+
+	t.Run("sends the watched fields", func(t *testing.T) {
+		ws := &testWatchServer{ctx: ctx}
+		require.NoError(t, appServer.Watch(&application.ApplicationQuery{Name: ptr.To("abc"), WatchFields: []string{"spec.destination.server"}}, ws))
+		require.Len(t, ws.events, 1)
+		assert.Equal(t, v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "abc", Namespace: testNamespace, ResourceVersion: "10"},
+			Spec:       v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Server: "https://cluster-api.example.com"}},
+		}, ws.events[0].Application)
+	})
+
+	t.Run("invalid watched fields", func(t *testing.T) {
+		ws := &testWatchServer{ctx: ctx}
+		err := appServer.Watch(&application.ApplicationQuery{WatchFields: []string{""}}, ws)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
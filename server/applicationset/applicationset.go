@@ -3,6 +3,7 @@ package applicationset
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -20,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -30,6 +32,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	appsetstatus "github.com/argoproj/argo-cd/v3/applicationset/status"
 	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
@@ -250,11 +253,13 @@ func (s *Server) Create(ctx context.Context, q *applicationset.ApplicationSetCre
 func (s *Server) generateApplicationSetApps(ctx context.Context, logEntry *log.Entry, appset v1alpha1.ApplicationSet) ([]v1alpha1.Application, error) {
 	argoCDDB := s.db
 
-	scmConfig := generators.NewSCMConfig(s.ScmRootCAPath, s.AllowedScmProviders, s.EnableScmProviders, s.EnableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), true)
+	scmConfig := generators.NewSCMConfig(s.ScmRootCAPath, s.AllowedScmProviders, s.EnableScmProviders, s.EnableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), true, false, 1, nil)
 	argoCDService := services.NewArgoCDService(s.db, s.GitSubmoduleEnabled, s.repoClientSet, s.EnableNewGitFileGlobbing)
-	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, s.ns, argoCDService, s.dynamicClient, scmConfig)
+	// The Kubernetes resource, Terraform and cloud inventory generators are disabled in the API server, which neither
+	// lists arbitrary resources nor reads from cloud providers
+	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, s.ns, argoCDService, s.dynamicClient, scmConfig, generators.KubernetesResourceConfig{}, generators.TerraformConfig{}, generators.CloudInventoryConfig{})
 
-	apps, _, err := appsettemplate.GenerateApplications(logEntry, appset, appSetGenerators, &appsetutils.Render{}, s.client)
+	apps, _, _, err := appsettemplate.GenerateApplications(ctx, logEntry, appset, appSetGenerators, &appsetutils.Render{}, s.client)
 	if err != nil {
 		return nil, fmt.Errorf("error generating applications: %w", err)
 	}
@@ -521,6 +526,40 @@ func (s *Server) getAppSetEnforceRBAC(ctx context.Context, action, namespace, na
 	return appset, nil
 }
 
+// GetCachedParameters returns the parameters last successfully generated by the generators of an applicationset
+func (s *Server) GetCachedParameters(ctx context.Context, q *applicationset.ApplicationSetGetQuery) (*applicationset.ApplicationSetCachedParametersResponse, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	appset, err := s.getAppSetEnforceRBAC(ctx, rbac.ActionGet, namespace, q.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &applicationset.ApplicationSetCachedParametersResponse{}
+	for _, cached := range appset.Status.CachedParameters {
+		paramSets, err := appsetutils.DecodeParameterSets(cached.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding the cached parameters of generator %d: %w", cached.Generator, err)
+		}
+		params := []map[string]any{}
+		for _, p := range paramSets {
+			params = append(params, p...)
+		}
+		data, err := json.Marshal(params)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling the cached parameters of generator %d: %w", cached.Generator, err)
+		}
+		generatedAt := cached.GeneratedAt
+		res.Generators = append(res.Generators, &applicationset.ApplicationSetGeneratorCachedParameters{
+			Generator:   cached.Generator,
+			Hash:        cached.Hash,
+			GeneratedAt: &generatedAt,
+			Parameters:  string(data),
+		})
+	}
+	return res, nil
+}
+
 // ListResourceEvents returns a list of event resources for an applicationset
 func (s *Server) ListResourceEvents(ctx context.Context, q *applicationset.ApplicationSetGetQuery) (*corev1.EventList, error) {
 	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
@@ -544,3 +583,41 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *applicationset.Appli
 	}
 	return list.DeepCopy(), nil
 }
+
+// Resume resumes the rollout of an applicationset after a RollingSync step which pauses after its applications are
+// healthy. The step is resumed by the ApplicationSet controller.
+func (s *Server) Resume(ctx context.Context, q *applicationset.ApplicationSetResumeRequest) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	appset, err := s.getAppSetEnforceRBAC(ctx, rbac.ActionUpdate, namespace, q.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := appset.Spec.Strategy
+	if strategy == nil || strategy.Type != "RollingSync" || strategy.RollingSync == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ApplicationSet %s does not use the RollingSync strategy", appset.Name)
+	}
+	steps := strategy.RollingSync.Steps
+	if q.Step < 1 || int(q.Step) > len(steps) {
+		return nil, status.Errorf(codes.InvalidArgument, "step %d does not exist, the RollingSync strategy of ApplicationSet %s has %d steps", q.Step, appset.Name, len(steps))
+	}
+	if !steps[q.Step-1].PauseAfter {
+		return nil, status.Errorf(codes.InvalidArgument, "step %d of ApplicationSet %s does not pause", q.Step, appset.Name)
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{common.AnnotationApplicationSetResumeStep: strconv.FormatInt(q.Step, 10)},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling ApplicationSet patch: %w", err)
+	}
+	res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Patch(ctx, appset.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching ApplicationSet: %w", err)
+	}
+	s.logAppSetEvent(ctx, appset, argo.EventReasonResourceUpdated, fmt.Sprintf("resumed the rollout after step %d", q.Step))
+	return res, nil
+}
@@ -19,6 +19,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	k8scache "k8s.io/client-go/tools/cache"
 
+	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -526,6 +527,62 @@ func TestDeleteAppSet(t *testing.T) {
 	})
 }
 
+func TestResumeAppSet(t *testing.T) {
+	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Spec.Strategy = &appsv1.ApplicationSetStrategy{
+			Type: "RollingSync",
+			RollingSync: &appsv1.ApplicationSetRolloutStrategy{
+				Steps: []appsv1.ApplicationSetRolloutStep{{PauseAfter: true}, {}},
+			},
+		}
+	})
+
+	t.Run("Resume paused step", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet)
+
+		res, err := appSetServer.Resume(t.Context(), &applicationset.ApplicationSetResumeRequest{Name: "AppSet1", Step: 1})
+		require.NoError(t, err)
+		assert.Equal(t, "1", res.Annotations[common.AnnotationApplicationSetResumeStep])
+	})
+
+	t.Run("Resume step which does not pause", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet)
+
+		_, err := appSetServer.Resume(t.Context(), &applicationset.ApplicationSetResumeRequest{Name: "AppSet1", Step: 2})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = step 2 of ApplicationSet AppSet1 does not pause")
+	})
+
+	t.Run("Resume missing step", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet)
+
+		_, err := appSetServer.Resume(t.Context(), &applicationset.ApplicationSetResumeRequest{Name: "AppSet1", Step: 3})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = step 3 does not exist, the RollingSync strategy of ApplicationSet AppSet1 has 2 steps")
+	})
+}
+
+func TestGetCachedParameters(t *testing.T) {
+	encoded, hash, err := appsetutils.EncodeParameterSets([][]map[string]any{{{"cluster": "dev"}, {"cluster": "prod"}}})
+	require.NoError(t, err)
+	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Status.CachedParameters = []appsv1.ApplicationSetCachedParameters{{
+			Generator:         0,
+			Hash:              hash,
+			ParameterSetCount: 2,
+			Parameters:        encoded,
+		}}
+	})
+	appSetServer := newTestAppSetServer(t, appSet)
+
+	res, err := appSetServer.GetCachedParameters(t.Context(), &applicationset.ApplicationSetGetQuery{Name: "AppSet1"})
+	require.NoError(t, err)
+	require.Len(t, res.Generators, 1)
+	assert.Equal(t, int64(0), res.Generators[0].Generator)
+	assert.Equal(t, hash, res.Generators[0].Hash)
+	assert.JSONEq(t, `[{"cluster":"dev"},{"cluster":"prod"}]`, res.Generators[0].Parameters)
+}
+
 func TestUpdateAppSet(t *testing.T) {
 	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
 		appset.Annotations = map[string]string{
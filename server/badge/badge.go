@@ -88,7 +88,27 @@ func replaceFirstGroupSubMatch(re *regexp.Regexp, str string, repl string) strin
 	return result + str[lastIndex:]
 }
 
-// ServeHTTP returns badge with health and sync status for application
+// aggregateStatus returns the health and sync status of the given applications: degraded if any application is not
+// healthy, out of sync if any application is not synced. The given defaults are returned if there are no applications.
+func aggregateStatus(apps []appv1.Application, health healthutil.HealthStatusCode, status appv1.SyncStatusCode) (healthutil.HealthStatusCode, appv1.SyncStatusCode) {
+	for _, a := range apps {
+		if a.Status.Sync.Status != appv1.SyncStatusCodeSynced {
+			status = appv1.SyncStatusCodeOutOfSync
+		}
+		if a.Status.Health.Status != healthutil.HealthStatusHealthy {
+			health = healthutil.HealthStatusDegraded
+		}
+	}
+	if health != healthutil.HealthStatusDegraded && len(apps) > 0 {
+		health = healthutil.HealthStatusHealthy
+	}
+	if status != appv1.SyncStatusCodeOutOfSync && len(apps) > 0 {
+		status = appv1.SyncStatusCodeSynced
+	}
+	return health, status
+}
+
+// ServeHTTP returns badge with health and sync status for application, project or application set
 // (or an error badge if wrong query or application name is given)
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	health := healthutil.HealthStatusUnknown
@@ -151,21 +171,28 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 		if apps, err := h.appClientset.ArgoprojV1alpha1().Applications(reqNs).List(context.Background(), metav1.ListOptions{}); err == nil {
-			applicationSet := argo.FilterByProjects(apps.Items, projects)
-			for _, a := range applicationSet {
-				if a.Status.Sync.Status != appv1.SyncStatusCodeSynced {
-					status = appv1.SyncStatusCodeOutOfSync
-				}
-				if a.Status.Health.Status != healthutil.HealthStatusHealthy {
-					health = healthutil.HealthStatusDegraded
+			health, status = aggregateStatus(argo.FilterByProjects(apps.Items, projects), health, status)
+		}
+	}
+	// Sample url: http://localhost:8080/api/badge?applicationset=guestbook
+	if appSetName, ok := r.URL.Query()["applicationset"]; ok && enabled && !notFound {
+		if !argo.IsValidAppName(appSetName[0]) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if appSet, err := h.appClientset.ArgoprojV1alpha1().ApplicationSets(reqNs).Get(context.Background(), appSetName[0], metav1.GetOptions{}); err == nil {
+			if apps, err := h.appClientset.ArgoprojV1alpha1().Applications(reqNs).List(context.Background(), metav1.ListOptions{}); err == nil {
+				var generatedApps []appv1.Application
+				for _, a := range apps.Items {
+					if metav1.IsControlledBy(&a, appSet) {
+						generatedApps = append(generatedApps, a)
+					}
 				}
+				health, status = aggregateStatus(generatedApps, health, status)
+				applicationName = appSetName[0]
 			}
-			if health != healthutil.HealthStatusDegraded && len(applicationSet) > 0 {
-				health = healthutil.HealthStatusHealthy
-			}
-			if status != appv1.SyncStatusCodeOutOfSync && len(applicationSet) > 0 {
-				status = appv1.SyncStatusCodeSynced
-			}
+		} else if errors.IsNotFound(err) {
+			notFound = true
 		}
 	}
 	// Sample url: http://localhost:8080/api/badge?name=123&revision=true
@@ -19,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 )
 
 func argoCDSecret() *corev1.Secret {
@@ -338,6 +339,75 @@ func TestHandlerFeatureIsEnabledKeepFullRevisionAndWidthIsEnabled(t *testing.T)
 	assert.Contains(t, response, "(aa29b85ababababababab)")
 }
 
+func TestHandlerFeatureApplicationSetIsEnabled(t *testing.T) {
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-appset", Namespace: "default", UID: "appset-uid"},
+	}
+	newApps := func(appCombo []string, owned []bool) []runtime.Object {
+		var objs []runtime.Object
+		for i, app := range createApplications(appCombo, make([]string, len(appCombo)), "default") {
+			if owned[i] {
+				app.OwnerReferences = []metav1.OwnerReference{{
+					APIVersion: "argoproj.io/v1alpha1", Kind: "ApplicationSet", Name: appSet.Name, UID: appSet.UID, Controller: ptr.To(true),
+				}}
+			}
+			objs = append(objs, app)
+		}
+		return objs
+	}
+	tests := []struct {
+		name           string
+		objs           []runtime.Object
+		query          string
+		health         string
+		status         string
+		healthColor    color.RGBA
+		statusColor    color.RGBA
+		expectedStatus int
+	}{
+		{"HealthySynced", newApps([]string{"Healthy:Synced", "Healthy:Synced"}, []bool{true, true}), "applicationset=test-appset", "Healthy", "Synced", Green, Green, http.StatusOK},
+		{"DegradedOutOfSync", newApps([]string{"Healthy:Synced", "Degraded:OutOfSync"}, []bool{true, true}), "applicationset=test-appset", "Degraded", "OutOfSync", Red, Orange, http.StatusOK},
+		{"IgnoresOtherApps", newApps([]string{"Healthy:Synced", "Degraded:OutOfSync"}, []bool{true, false}), "applicationset=test-appset", "Healthy", "Synced", Green, Green, http.StatusOK},
+		{"NoApps", nil, "applicationset=test-appset", "Unknown", "Unknown", Purple, Purple, http.StatusOK},
+		{"NotFound", nil, "applicationset=other", "Not Found", "", Purple, Purple, http.StatusOK},
+		{"InvalidName", nil, "applicationset=test$appset", "", "", Purple, Purple, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settingsMgr := settings.NewSettingsManager(t.Context(), fake.NewClientset(argoCDCm(), argoCDSecret()), "default")
+			handler := NewHandler(appclientset.NewSimpleClientset(append(tt.objs, appSet)...), settingsMgr, "default", []string{})
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/api/badge?"+tt.query, http.NoBody)
+			require.NoError(t, err)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.expectedStatus, rr.Result().StatusCode)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			response := rr.Body.String()
+			assert.Equal(t, toRGBString(tt.healthColor), leftRectColorPattern.FindStringSubmatch(response)[1])
+			assert.Equal(t, toRGBString(tt.statusColor), rightRectColorPattern.FindStringSubmatch(response)[1])
+			assert.Equal(t, tt.health, leftTextPattern.FindStringSubmatch(response)[1])
+			assert.Equal(t, tt.status, rightTextPattern.FindStringSubmatch(response)[1])
+			assert.NotContains(t, response, "test-appset")
+		})
+	}
+
+	t.Run("ShowName", func(t *testing.T) {
+		settingsMgr := settings.NewSettingsManager(t.Context(), fake.NewClientset(argoCDCm(), argoCDSecret()), "default")
+		handler := NewHandler(appclientset.NewSimpleClientset(appSet), settingsMgr, "default", []string{})
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/api/badge?applicationset=test-appset&showAppName=true", http.NoBody)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, "test-appset", titleTextPattern.FindStringSubmatch(rr.Body.String())[1])
+	})
+}
+
 func createApplicationFeatureProjectIsEnabled(healthStatus health.HealthStatusCode, syncStatus v1alpha1.SyncStatusCode, appName, projectName, namespace string) *v1alpha1.Application {
 	return &v1alpha1.Application{
 		ObjectMeta: metav1.ObjectMeta{Name: appName, Namespace: namespace},
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/rand"
 	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/singleflight"
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
@@ -20,6 +22,12 @@ type Cache struct {
 	cache                           *appstatecache.Cache
 	connectionStatusCacheExpiration time.Duration
 	oidcCacheExpiration             time.Duration
+	// cacheExpirationJitter is the maximum random duration added to the expiration of each key, so that keys
+	// populated at the same time (e.g. after a cache flush) do not all expire at once.
+	cacheExpirationJitter time.Duration
+	// loadGroup deduplicates concurrent loads of the same key, so that only one caller reaches the repo-server
+	// when a popular key is missing from the cache.
+	loadGroup singleflight.Group
 }
 
 func NewCache(
@@ -27,18 +35,21 @@ func NewCache(
 	connectionStatusCacheExpiration time.Duration,
 	oidcCacheExpiration time.Duration,
 ) *Cache {
-	return &Cache{cache, connectionStatusCacheExpiration, oidcCacheExpiration}
+	return &Cache{cache: cache, connectionStatusCacheExpiration: connectionStatusCacheExpiration, oidcCacheExpiration: oidcCacheExpiration}
 }
 
 func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...cacheutil.Options) func() (*Cache, error) {
 	var connectionStatusCacheExpiration time.Duration
 	var oidcCacheExpiration time.Duration
 	var loginAttemptsExpiration time.Duration
+	var cacheExpirationJitter time.Duration
 
 	cmd.Flags().DurationVar(&connectionStatusCacheExpiration, "connection-status-cache-expiration", env.ParseDurationFromEnv("ARGOCD_SERVER_CONNECTION_STATUS_CACHE_EXPIRATION", 1*time.Hour, 0, math.MaxInt64), "Cache expiration for cluster/repo connection status")
 	cmd.Flags().DurationVar(&oidcCacheExpiration, "oidc-cache-expiration", env.ParseDurationFromEnv("ARGOCD_SERVER_OIDC_CACHE_EXPIRATION", 3*time.Minute, 0, math.MaxInt64), "Cache expiration for OIDC state")
 	cmd.Flags().DurationVar(&loginAttemptsExpiration, "login-attempts-expiration", env.ParseDurationFromEnv("ARGOCD_SERVER_LOGIN_ATTEMPTS_EXPIRATION", 24*time.Hour, 0, math.MaxInt64), "Cache expiration for failed login attempts. DEPRECATED: this flag is unused and will be removed in a future version.")
 
+	cmd.Flags().DurationVar(&cacheExpirationJitter, "cache-expiration-jitter", env.ParseDurationFromEnv("ARGOCD_SERVER_CACHE_EXPIRATION_JITTER", 5*time.Minute, 0, math.MaxInt64), "Maximum random duration added to the expiration of cached connection states to avoid simultaneous expiry of popular keys")
+
 	fn := appstatecache.AddCacheFlagsToCmd(cmd, opts...)
 
 	return func() (*Cache, error) {
@@ -47,10 +58,27 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...cacheutil.Options) func() (*
 			return nil, err
 		}
 
-		return NewCache(cache, connectionStatusCacheExpiration, oidcCacheExpiration), nil
+		serverCache := NewCache(cache, connectionStatusCacheExpiration, oidcCacheExpiration)
+		serverCache.cacheExpirationJitter = cacheExpirationJitter
+		return serverCache, nil
 	}
 }
 
+// expirationWithJitter returns the given expiration increased by a random duration of at most cacheExpirationJitter.
+func (c *Cache) expirationWithJitter(expiration time.Duration) time.Duration {
+	if c.cacheExpirationJitter <= 0 || expiration <= 0 {
+		return expiration
+	}
+	return expiration + time.Duration(rand.Int63n(int64(c.cacheExpirationJitter)))
+}
+
+// Load calls loader at most once at a time per key: concurrent callers asking for the same key wait for the
+// in-flight call and share its result. It is meant to wrap cache misses that fall back to expensive calls.
+func (c *Cache) Load(key string, loader func() (any, error)) (any, error) {
+	res, err, _ := c.loadGroup.Do(key, loader)
+	return res, err
+}
+
 func (c *Cache) GetAppResourcesTree(appName string, res *appv1.ApplicationTree) error {
 	return c.cache.GetAppResourcesTree(appName, res)
 }
@@ -63,8 +91,12 @@ func (c *Cache) GetAppManagedResources(appName string, res *[]*appv1.ResourceDif
 	return c.cache.GetAppManagedResources(appName, res)
 }
 
+func (c *Cache) GetAppOperationLog(appName string, res *[]*appv1.OperationLogEntry) error {
+	return c.cache.GetAppOperationLog(appName, res)
+}
+
 func (c *Cache) SetRepoConnectionState(repo string, project string, state *appv1.ConnectionState) error {
-	return c.cache.SetItem(repoConnectionStateKey(repo, project), &state, c.connectionStatusCacheExpiration, state == nil)
+	return c.cache.SetItem(repoConnectionStateKey(repo, project), &state, c.expirationWithJitter(c.connectionStatusCacheExpiration), state == nil)
 }
 
 func repoConnectionStateKey(repo string, project string) string {
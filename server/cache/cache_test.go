@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -57,4 +59,60 @@ func TestAddCacheFlagsToCmd(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1*time.Hour, cache.connectionStatusCacheExpiration)
 	assert.Equal(t, 3*time.Minute, cache.oidcCacheExpiration)
+	assert.Equal(t, 5*time.Minute, cache.cacheExpirationJitter)
+}
+
+func TestCache_SetRepoConnectionStateWithJitter(t *testing.T) {
+	cache := newFixtures().Cache
+	cache.cacheExpirationJitter = 1 * time.Minute
+	for range 10 {
+		expiration := cache.expirationWithJitter(1 * time.Hour)
+		assert.GreaterOrEqual(t, expiration, 1*time.Hour)
+		assert.Less(t, expiration, 1*time.Hour+1*time.Minute)
+	}
+	// no expiration stays unset
+	assert.Equal(t, time.Duration(0), cache.expirationWithJitter(0))
+	require.NoError(t, cache.SetRepoConnectionState("my-repo", "", &ConnectionState{Status: "my-state"}))
+	value, err := cache.GetRepoConnectionState("my-repo", "")
+	require.NoError(t, err)
+	assert.Equal(t, ConnectionState{Status: "my-state"}, value)
+}
+
+func TestCache_Load(t *testing.T) {
+	cache := newFixtures().Cache
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	loader := func() (any, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]any, 5)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0], _ = cache.Load("key", loader)
+	}()
+	<-started
+	for i := 1; i < len(results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = cache.Load("key", loader)
+		}()
+	}
+	// give the waiting callers a chance to join the in-flight call
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, res := range results {
+		assert.Equal(t, "value", res)
+	}
 }
@@ -3,15 +3,22 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/url"
+	"slices"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -347,6 +354,77 @@ func (s *Server) Update(ctx context.Context, q *cluster.ClusterUpdateRequest) (*
 	return s.toAPIResponse(clust), nil
 }
 
+// reservedClusterLabels and reservedClusterAnnotations are managed by Argo CD and cannot be changed through the
+// metadata of a cluster
+var (
+	reservedClusterLabels      = []string{common.LabelKeySecretType}
+	reservedClusterAnnotations = []string{corev1.LastAppliedConfigAnnotation, common.AnnotationKeyManagedBy, appv1.AnnotationKeyRefresh}
+)
+
+// validateClusterMetadataUpdate verifies that the labels and annotations of the request are valid Kubernetes metadata
+// and do not touch keys reserved by Argo CD
+func validateClusterMetadataUpdate(q *cluster.ClusterMetadataUpdateRequest) error {
+	errs := metav1validation.ValidateLabels(q.Labels, field.NewPath("labels"))
+	errs = append(errs, apimachineryvalidation.ValidateAnnotations(q.Annotations, field.NewPath("annotations"))...)
+	for i, key := range q.RemoveLabels {
+		for _, msg := range validation.IsQualifiedName(key) {
+			errs = append(errs, field.Invalid(field.NewPath("removeLabels").Index(i), key, msg))
+		}
+	}
+	for i, key := range q.RemoveAnnotations {
+		for _, msg := range validation.IsQualifiedName(key) {
+			errs = append(errs, field.Invalid(field.NewPath("removeAnnotations").Index(i), key, msg))
+		}
+	}
+	for _, key := range reservedClusterLabels {
+		if _, ok := q.Labels[key]; ok || slices.Contains(q.RemoveLabels, key) {
+			errs = append(errs, field.Forbidden(field.NewPath("labels").Key(key), "label is managed by Argo CD"))
+		}
+	}
+	for _, key := range reservedClusterAnnotations {
+		if _, ok := q.Annotations[key]; ok || slices.Contains(q.RemoveAnnotations, key) {
+			errs = append(errs, field.Forbidden(field.NewPath("annotations").Key(key), "annotation is managed by Argo CD"))
+		}
+	}
+	if len(errs) > 0 {
+		return status.Error(codes.InvalidArgument, errs.ToAggregate().Error())
+	}
+	return nil
+}
+
+// UpdateMetadata merges labels and annotations into the metadata of a cluster. Unlike Update, it leaves labels and
+// annotations which are not part of the request untouched and does not require the cluster to be reachable.
+func (s *Server) UpdateMetadata(ctx context.Context, q *cluster.ClusterMetadataUpdateRequest) (*appv1.Cluster, error) {
+	c, err := s.getClusterAndVerifyAccess(ctx, &cluster.ClusterQuery{Id: q.Id}, rbac.ActionUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify access for updating cluster: %w", err)
+	}
+	if err := validateClusterMetadataUpdate(q); err != nil {
+		return nil, err
+	}
+
+	if c.Labels == nil {
+		c.Labels = map[string]string{}
+	}
+	maps.Copy(c.Labels, q.Labels)
+	for _, key := range q.RemoveLabels {
+		delete(c.Labels, key)
+	}
+	if c.Annotations == nil {
+		c.Annotations = map[string]string{}
+	}
+	maps.Copy(c.Annotations, q.Annotations)
+	for _, key := range q.RemoveAnnotations {
+		delete(c.Annotations, key)
+	}
+
+	clust, err := s.db.UpdateCluster(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update cluster in database: %w", err)
+	}
+	return s.toAPIResponse(clust), nil
+}
+
 // Delete deletes a cluster by server/name
 func (s *Server) Delete(ctx context.Context, q *cluster.ClusterQuery) (*cluster.ClusterResponse, error) {
 	c, err := s.getClusterWith403IfNotExist(ctx, q)
@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -434,6 +436,81 @@ func TestUpdateCluster_FieldsPathSet(t *testing.T) {
 	assert.Equal(t, "new-project", updated.Project)
 }
 
+func TestUpdateClusterMetadata(t *testing.T) {
+	newServerWithCluster := func(t *testing.T, updated **appv1.Cluster) *Server {
+		t.Helper()
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, "https://127.0.0.1").Return(&appv1.Cluster{
+			Name:        "minikube",
+			Server:      "https://127.0.0.1",
+			Labels:      map[string]string{"env": "qa", "team": "a"},
+			Annotations: map[string]string{"owner": "team-a"},
+		}, nil)
+		db.EXPECT().UpdateCluster(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, c *appv1.Cluster) (*appv1.Cluster, error) {
+			*updated = c
+			return c, nil
+		}).Maybe()
+		return NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	}
+	clusterID := &cluster.ClusterID{Value: "https://127.0.0.1"}
+
+	t.Run("Merged", func(t *testing.T) {
+		var updated *appv1.Cluster
+		server := newServerWithCluster(t, &updated)
+		_, err := server.UpdateMetadata(t.Context(), &cluster.ClusterMetadataUpdateRequest{
+			Id:                clusterID,
+			Labels:            map[string]string{"env": "prod", "region": "eu"},
+			RemoveLabels:      []string{"team"},
+			Annotations:       map[string]string{"example.com/note": "moved"},
+			RemoveAnnotations: []string{"absent"},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, map[string]string{"env": "prod", "region": "eu"}, updated.Labels)
+		assert.Equal(t, map[string]string{"owner": "team-a", "example.com/note": "moved"}, updated.Annotations)
+		assert.Equal(t, "minikube", updated.Name)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			request *cluster.ClusterMetadataUpdateRequest
+		}{
+			{name: "invalid label key", request: &cluster.ClusterMetadataUpdateRequest{Id: clusterID, Labels: map[string]string{"in valid": "a"}}},
+			{name: "invalid label value", request: &cluster.ClusterMetadataUpdateRequest{Id: clusterID, Labels: map[string]string{"env": "in valid"}}},
+			{name: "invalid annotation key", request: &cluster.ClusterMetadataUpdateRequest{Id: clusterID, Annotations: map[string]string{"in valid": "a"}}},
+			{name: "invalid label key to remove", request: &cluster.ClusterMetadataUpdateRequest{Id: clusterID, RemoveLabels: []string{"in valid"}}},
+			{name: "reserved label", request: &cluster.ClusterMetadataUpdateRequest{Id: clusterID, Labels: map[string]string{common.LabelKeySecretType: "repository"}}},
+			{name: "reserved label to remove", request: &cluster.ClusterMetadataUpdateRequest{Id: clusterID, RemoveLabels: []string{common.LabelKeySecretType}}},
+			{name: "reserved annotation", request: &cluster.ClusterMetadataUpdateRequest{Id: clusterID, Annotations: map[string]string{corev1.LastAppliedConfigAnnotation: "{}"}}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				var updated *appv1.Cluster
+				server := newServerWithCluster(t, &updated)
+				_, err := server.UpdateMetadata(t.Context(), tc.request)
+				require.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.Nil(t, updated)
+			})
+		}
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, "https://127.0.0.1").Return(&appv1.Cluster{Server: "https://127.0.0.1"}, nil)
+		enf := rbac.NewEnforcer(fake.NewClientset(test.NewFakeConfigMap()), test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+		_ = enf.SetBuiltinPolicy(`p, role:test, clusters, get, https://127.0.0.1, allow`)
+		enf.SetDefaultRole("role:test")
+		server := NewServer(db, enf, newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+		_, err := server.UpdateMetadata(t.Context(), &cluster.ClusterMetadataUpdateRequest{
+			Id:     clusterID,
+			Labels: map[string]string{"env": "prod"},
+		})
+		require.ErrorIs(t, err, common.PermissionDeniedAPIError)
+	})
+}
+
 func TestDeleteClusterByName(t *testing.T) {
 	testNamespace := "default"
 	clientset := getClientset(nil, testNamespace, &corev1.Secret{
@@ -0,0 +1,442 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	clusterv1 "github.com/argoproj/argo-cd/v3/pkg/apis/cluster/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	appinformer "github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions"
+	clusterlisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/cluster/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// registrationResyncPeriod is the period after which all Clusters are reconciled again, which refreshes the connection
+// state and version reported by their status
+const registrationResyncPeriod = time.Minute
+
+// secretNamePrefix is the prefix of the name of the cluster secret a Cluster is reconciled into
+const secretNamePrefix = "cluster-"
+
+// RegistrationLeaseName is the name of the lease which elects the replica of the API server running the controller
+const RegistrationLeaseName = "argocd-server-cluster-registration"
+
+// the durations of the leader election of the controller, variables so they can be shortened in tests
+var (
+	registrationLeaseDuration = 15 * time.Second
+	registrationRenewDeadline = 10 * time.Second
+	registrationRetryPeriod   = 2 * time.Second
+)
+
+// ClusterInfoGetter returns the cached information about the cluster with the given server URL
+type ClusterInfoGetter func(server string, info *appv1.ClusterInfo) error
+
+// registrationError is an error of the registration of a Cluster which is reported by its status and is not retried
+type registrationError struct {
+	reason  string
+	message string
+}
+
+func (e *registrationError) Error() string {
+	return e.message
+}
+
+func invalidSpecError(format string, args ...any) error {
+	return &registrationError{reason: clusterv1.ClusterReasonInvalidSpec, message: fmt.Sprintf(format, args...)}
+}
+
+func conflictError(format string, args ...any) error {
+	return &registrationError{reason: clusterv1.ClusterReasonConflict, message: fmt.Sprintf(format, args...)}
+}
+
+// RegistrationController reconciles the Cluster resources of the Argo CD namespace into cluster secrets and reports
+// the connection state and version of the clusters in their status
+type RegistrationController struct {
+	namespace      string
+	kubeclientset  kubernetes.Interface
+	appclientset   appclientset.Interface
+	settingsMgr    *settings.SettingsManager
+	getClusterInfo ClusterInfoGetter
+	informer       cache.SharedIndexInformer
+	lister         clusterlisters.ClusterNamespaceLister
+	queue          workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewRegistrationController returns a new RegistrationController
+func NewRegistrationController(namespace string, kubeclientset kubernetes.Interface, appclientset appclientset.Interface, settingsMgr *settings.SettingsManager, getClusterInfo ClusterInfoGetter) *RegistrationController {
+	factory := appinformer.NewSharedInformerFactoryWithOptions(appclientset, registrationResyncPeriod, appinformer.WithNamespace(namespace))
+	informer := factory.Cluster().V1alpha1().Clusters().Informer()
+	c := &RegistrationController{
+		namespace:      namespace,
+		kubeclientset:  kubeclientset,
+		appclientset:   appclientset,
+		settingsMgr:    settingsMgr,
+		getClusterInfo: getClusterInfo,
+		informer:       informer,
+		lister:         factory.Cluster().V1alpha1().Clusters().Lister().Clusters(namespace),
+		queue:          workqueue.NewTypedRateLimitingQueueWithConfig(workqueue.DefaultTypedControllerRateLimiter[string](), workqueue.TypedRateLimitingQueueConfig[string]{Name: "cluster_registration_queue"}),
+	}
+	enqueue := func(obj any) {
+		if key, err := cache.MetaNamespaceKeyFunc(obj); err == nil {
+			c.queue.Add(key)
+		}
+	}
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    enqueue,
+		UpdateFunc: func(_, obj any) { enqueue(obj) },
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to add event handler for clusters")
+	}
+	return c
+}
+
+// Run starts the informer and the workers of the controller and blocks until the context is done
+func (c *RegistrationController) Run(ctx context.Context, workers int) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	go c.informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
+		log.Error("Timed out waiting for cluster registration cache to sync")
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(func() {
+			for c.processNextItem(ctx) {
+			}
+		}, time.Second, ctx.Done())
+	}
+	<-ctx.Done()
+}
+
+// RunWithLeaderElection runs the controllers returned by newController in a single replica of the API server at a time
+// and blocks until the context is done. The replicas elect a leader with the registration lease, and a new controller
+// is started whenever this replica becomes the leader. The controller is stopped when the leadership is lost.
+func RunWithLeaderElection(ctx context.Context, namespace string, kubeclientset kubernetes.Interface, newController func() *RegistrationController) {
+	identity, err := os.Hostname()
+	if err != nil {
+		log.WithError(err).Error("Failed to get the identity for the cluster registration leader election")
+		return
+	}
+	runWithLeaderElection(ctx, namespace, kubeclientset, identity, func(ctx context.Context) {
+		newController().Run(ctx, 1)
+	})
+}
+
+func runWithLeaderElection(ctx context.Context, namespace string, kubeclientset kubernetes.Interface, identity string, run func(ctx context.Context)) {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Name: RegistrationLeaseName, Namespace: namespace},
+		Client:     kubeclientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+	}
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   registrationLeaseDuration,
+		RenewDeadline:   registrationRenewDeadline,
+		RetryPeriod:     registrationRetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            RegistrationLeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.WithField("identity", identity).Info("Acquired the cluster registration lease, starting the cluster registration controller")
+				run(ctx)
+			},
+			OnStoppedLeading: func() {
+				log.WithField("identity", identity).Info("Stopped leading the cluster registration")
+			},
+		},
+	})
+	if err != nil {
+		log.WithError(err).Error("Failed to create the cluster registration leader election")
+		return
+	}
+	// the elector returns when the leadership is lost, so keep trying to become the leader again
+	for ctx.Err() == nil {
+		elector.Run(ctx)
+	}
+}
+
+func (c *RegistrationController) processNextItem(ctx context.Context) bool {
+	key, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.reconcile(ctx, key); err != nil {
+		log.WithField("cluster", key).WithError(err).Warn("Failed to reconcile cluster registration")
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+// reconcile reconciles the Cluster with the given key into its cluster secret and updates its status
+func (c *RegistrationController) reconcile(ctx context.Context, key string) error {
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	cl, err := c.lister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// the cluster secret is garbage collected through its owner reference
+			return nil
+		}
+		return err
+	}
+
+	status := cl.Status.DeepCopy()
+	status.ObservedGeneration = cl.Generation
+	condition := metav1.Condition{
+		Type:               clusterv1.ClusterConditionRegistered,
+		Status:             metav1.ConditionTrue,
+		Reason:             clusterv1.ClusterReasonRegistered,
+		Message:            "cluster secret is up to date",
+		ObservedGeneration: cl.Generation,
+	}
+	secretName, reconcileErr := c.reconcileSecret(ctx, cl)
+	var regErr *registrationError
+	switch {
+	case reconcileErr == nil:
+		status.SecretName = secretName
+		var info appv1.ClusterInfo
+		if err := c.getClusterInfo(strings.TrimRight(cl.Spec.Server, "/"), &info); err == nil {
+			status.ConnectionState = &info.ConnectionState
+			status.ServerVersion = info.ServerVersion
+		}
+	case errors.As(reconcileErr, &regErr):
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = regErr.reason
+		condition.Message = regErr.message
+		status.SecretName = ""
+		status.ConnectionState = nil
+		status.ServerVersion = ""
+	default:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = clusterv1.ClusterReasonError
+		condition.Message = reconcileErr.Error()
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+
+	if !apiequality.Semantic.DeepEqual(cl.Status, *status) {
+		updated := cl.DeepCopy()
+		updated.Status = *status
+		if _, err := c.appclientset.ClusterV1alpha1().Clusters(c.namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error updating status of cluster %q: %w", cl.Name, err)
+		}
+	}
+	if regErr != nil {
+		return nil
+	}
+	return reconcileErr
+}
+
+// reconcileSecret creates or updates the cluster secret of the given Cluster and returns its name
+func (c *RegistrationController) reconcileSecret(ctx context.Context, cl *clusterv1.Cluster) (string, error) {
+	secretName := secretNamePrefix + cl.Name
+	if errs := validation.IsDNS1123Subdomain(secretName); len(errs) > 0 {
+		return "", invalidSpecError("invalid cluster secret name %q: %s", secretName, strings.Join(errs, ", "))
+	}
+	appCluster, err := c.toAppCluster(cl)
+	if err != nil {
+		return "", err
+	}
+
+	conflicting, err := c.getConflictingSecret(cl, secretName, appCluster.Server)
+	if err != nil {
+		return "", err
+	}
+	existing, err := c.kubeclientset.CoreV1().Secrets(c.namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("error getting cluster secret %q: %w", secretName, err)
+	}
+	if apierrors.IsNotFound(err) {
+		existing = nil
+	}
+	if existing != nil && !metav1.IsControlledBy(existing, cl) {
+		return "", conflictError("secret %q already exists and is not managed by the cluster", secretName)
+	}
+	if conflicting != "" {
+		// remove the secret of this Cluster, so the cluster is only registered once
+		if existing != nil {
+			if err := c.kubeclientset.CoreV1().Secrets(c.namespace).Delete(ctx, secretName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return "", fmt.Errorf("error deleting cluster secret %q: %w", secretName, err)
+			}
+		}
+		return "", conflictError("server %s is already registered by secret %q", appCluster.Server, conflicting)
+	}
+
+	desired := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            secretName,
+			Namespace:       c.namespace,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cl, clusterv1.ClusterSchemaGroupVersionKind)},
+		},
+	}
+	if existing != nil {
+		// keep a refresh requested for the cluster
+		if refresh, err := time.Parse(time.RFC3339, existing.Annotations[appv1.AnnotationKeyRefresh]); err == nil {
+			appCluster.RefreshRequestedAt = &metav1.Time{Time: refresh}
+		}
+	}
+	if err := db.ClusterToSecret(appCluster, desired); err != nil {
+		return "", invalidSpecError("invalid cluster: %v", err)
+	}
+
+	if existing == nil {
+		if _, err := c.kubeclientset.CoreV1().Secrets(c.namespace).Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return "", fmt.Errorf("error creating cluster secret %q: %w", secretName, err)
+		}
+		log.WithField("cluster", cl.Name).Infof("Created cluster secret %s", secretName)
+		return secretName, nil
+	}
+	if apiequality.Semantic.DeepEqual(existing.Data, desired.Data) &&
+		apiequality.Semantic.DeepEqual(existing.Labels, desired.Labels) &&
+		apiequality.Semantic.DeepEqual(existing.Annotations, desired.Annotations) &&
+		apiequality.Semantic.DeepEqual(existing.OwnerReferences, desired.OwnerReferences) {
+		return secretName, nil
+	}
+	updated := existing.DeepCopy()
+	updated.Data = desired.Data
+	updated.Labels = desired.Labels
+	updated.Annotations = desired.Annotations
+	updated.OwnerReferences = desired.OwnerReferences
+	if _, err := c.kubeclientset.CoreV1().Secrets(c.namespace).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return "", fmt.Errorf("error updating cluster secret %q: %w", secretName, err)
+	}
+	log.WithField("cluster", cl.Name).Infof("Updated cluster secret %s", secretName)
+	return secretName, nil
+}
+
+// toAppCluster validates the given Cluster and converts it into the cluster stored in the cluster secret
+func (c *RegistrationController) toAppCluster(cl *clusterv1.Cluster) (*appv1.Cluster, error) {
+	server := strings.TrimRight(cl.Spec.Server, "/")
+	if u, err := url.Parse(server); err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, invalidSpecError("server %q is not a valid URL", cl.Spec.Server)
+	}
+	if server == appv1.KubernetesInternalAPIServerAddr {
+		argoSettings, err := c.settingsMgr.GetSettings()
+		if err != nil {
+			return nil, fmt.Errorf("error getting settings: %w", err)
+		}
+		if !argoSettings.InClusterEnabled {
+			return nil, invalidSpecError("cannot register cluster: in-cluster has been disabled")
+		}
+	}
+
+	config := *cl.Spec.Config.DeepCopy()
+	if ref := cl.Spec.ConfigSecretRef; ref != nil {
+		secret, err := c.settingsMgr.GetSecretByName(ref.SecretName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, invalidSpecError("config secret %q not found", ref.SecretName)
+			}
+			return nil, fmt.Errorf("error getting config secret %q: %w", ref.SecretName, err)
+		}
+		// only secrets dedicated to the connection settings of Clusters can be referenced, so a Cluster cannot be used
+		// to read other secrets of the namespace
+		if secret.Labels[common.LabelKeySecretType] != common.LabelValueSecretTypeClusterConfig {
+			return nil, invalidSpecError("config secret %q must be labeled with %s: %s", ref.SecretName, common.LabelKeySecretType, common.LabelValueSecretTypeClusterConfig)
+		}
+		data, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, invalidSpecError("config secret %q has no key %q", ref.SecretName, ref.Key)
+		}
+		// settings of the secret take precedence over the ones of the spec
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, invalidSpecError("invalid config in key %q of secret %q: %v", ref.Key, ref.SecretName, err)
+		}
+	}
+
+	// the exec provider runs commands in the pods of Argo CD, so it can only be configured with cluster secrets
+	if config.ExecProviderConfig != nil {
+		return nil, invalidSpecError("the exec provider is not supported")
+	}
+
+	name := cl.Spec.Name
+	if name == "" {
+		name = cl.Name
+	}
+	return &appv1.Cluster{
+		Server:           server,
+		Name:             name,
+		Config:           config,
+		Namespaces:       cl.Spec.Namespaces,
+		ClusterResources: cl.Spec.ClusterResources,
+		Project:          cl.Spec.Project,
+		Shard:            cl.Spec.Shard,
+		Labels:           maps.Clone(cl.Spec.Labels),
+		Annotations:      copyAnnotations(cl.Spec.Annotations),
+	}, nil
+}
+
+func copyAnnotations(annotations map[string]string) map[string]string {
+	result := make(map[string]string, len(annotations))
+	maps.Copy(result, annotations)
+	return result
+}
+
+// getConflictingSecret returns the name of a cluster secret which registers the same server as the given Cluster, if
+// any. Secrets of other Clusters are only considered if these have been created before the given one, so only the
+// oldest Cluster of a server is registered.
+func (c *RegistrationController) getConflictingSecret(cl *clusterv1.Cluster, secretName string, server string) (string, error) {
+	secretsLister, err := c.settingsMgr.GetSecretsLister()
+	if err != nil {
+		return "", fmt.Errorf("error getting secrets lister: %w", err)
+	}
+	selector := labels.SelectorFromSet(labels.Set{common.LabelKeySecretType: common.LabelValueSecretTypeCluster})
+	secrets, err := secretsLister.Secrets(c.namespace).List(selector)
+	if err != nil {
+		return "", fmt.Errorf("error listing cluster secrets: %w", err)
+	}
+	for _, secret := range secrets {
+		if secret.Name == secretName || strings.TrimRight(string(secret.Data["server"]), "/") != server {
+			continue
+		}
+		if owner := metav1.GetControllerOf(secret); owner != nil && owner.Kind == clusterv1.ClusterSchemaGroupVersionKind.Kind && owner.UID != cl.UID {
+			other, err := c.lister.Get(owner.Name)
+			if err == nil && !isRegisteredBefore(other, cl) {
+				continue
+			}
+		}
+		return secret.Name, nil
+	}
+	return "", nil
+}
+
+// isRegisteredBefore returns whether the Cluster a has been created before the Cluster b
+func isRegisteredBefore(a *clusterv1.Cluster, b *clusterv1.Cluster) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,257 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	clusterv1 "github.com/argoproj/argo-cd/v3/pkg/apis/cluster/v1alpha1"
+	appfake "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func newRegistrationCluster(name string, server string) *clusterv1.Cluster {
+	return &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         test.FakeArgoCDNamespace,
+			UID:               types.UID("uid-" + name),
+			Generation:        1,
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec: clusterv1.ClusterSpec{Server: server},
+	}
+}
+
+func newClusterSecret(name string, server string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: test.FakeArgoCDNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeCluster},
+		},
+		Data: map[string][]byte{"server": []byte(server), "name": []byte(name)},
+	}
+}
+
+// reconcileCluster reconciles the given Cluster and returns it with its updated status together with its cluster secret
+func reconcileCluster(t *testing.T, cl *clusterv1.Cluster, objects ...runtime.Object) (*clusterv1.Cluster, *corev1.Secret) {
+	t.Helper()
+	kubeclientset := getClientset(nil, test.FakeArgoCDNamespace, objects...)
+	appclientset := appfake.NewSimpleClientset(cl)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeclientset, test.FakeArgoCDNamespace)
+	getClusterInfo := func(server string, info *appv1.ClusterInfo) error {
+		if server != "https://my-cluster" {
+			return errors.New("not found")
+		}
+		info.ConnectionState = appv1.ConnectionState{Status: appv1.ConnectionStatusSuccessful}
+		info.ServerVersion = "1.32"
+		return nil
+	}
+	c := NewRegistrationController(test.FakeArgoCDNamespace, kubeclientset, appclientset, settingsMgr, getClusterInfo)
+	require.NoError(t, c.informer.GetIndexer().Add(cl))
+
+	require.NoError(t, c.reconcile(t.Context(), test.FakeArgoCDNamespace+"/"+cl.Name))
+
+	updated, err := appclientset.ClusterV1alpha1().Clusters(test.FakeArgoCDNamespace).Get(t.Context(), cl.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	secret, err := kubeclientset.CoreV1().Secrets(test.FakeArgoCDNamespace).Get(t.Context(), "cluster-"+cl.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return updated, nil
+	}
+	require.NoError(t, err)
+	return updated, secret
+}
+
+func assertRegisteredCondition(t *testing.T, cl *clusterv1.Cluster, status metav1.ConditionStatus, reason string) {
+	t.Helper()
+	condition := meta.FindStatusCondition(cl.Status.Conditions, clusterv1.ClusterConditionRegistered)
+	require.NotNil(t, condition)
+	assert.Equal(t, status, condition.Status)
+	assert.Equal(t, reason, condition.Reason)
+	assert.Equal(t, int64(1), condition.ObservedGeneration)
+}
+
+func TestRegistrationController_CreateSecret(t *testing.T) {
+	cl := newRegistrationCluster("my-cluster", "https://my-cluster/")
+	cl.Spec.Namespaces = []string{"default"}
+	cl.Spec.Project = "my-project"
+	cl.Spec.Labels = map[string]string{"env": "prod"}
+	cl.Spec.Config.TLSClientConfig.Insecure = true
+
+	updated, secret := reconcileCluster(t, cl)
+
+	require.NotNil(t, secret)
+	assert.True(t, metav1.IsControlledBy(secret, cl))
+	assert.Equal(t, common.LabelValueSecretTypeCluster, secret.Labels[common.LabelKeySecretType])
+	assert.Equal(t, "prod", secret.Labels["env"])
+	assert.Equal(t, "https://my-cluster", string(secret.Data["server"]))
+	assert.Equal(t, "my-cluster", string(secret.Data["name"]))
+	assert.Equal(t, "default", string(secret.Data["namespaces"]))
+	assert.Equal(t, "my-project", string(secret.Data["project"]))
+	assert.JSONEq(t, `{"tlsClientConfig":{"insecure":true}}`, string(secret.Data["config"]))
+	assert.NotContains(t, cl.Spec.Labels, common.LabelKeySecretType, "the labels of the spec must not be modified")
+
+	assertRegisteredCondition(t, updated, metav1.ConditionTrue, clusterv1.ClusterReasonRegistered)
+	assert.Equal(t, "cluster-my-cluster", updated.Status.SecretName)
+	assert.Equal(t, int64(1), updated.Status.ObservedGeneration)
+	require.NotNil(t, updated.Status.ConnectionState)
+	assert.Equal(t, appv1.ConnectionStatusSuccessful, updated.Status.ConnectionState.Status)
+	assert.Equal(t, "1.32", updated.Status.ServerVersion)
+}
+
+func TestRegistrationController_ConfigSecretRef(t *testing.T) {
+	cl := newRegistrationCluster("my-cluster", "https://my-cluster")
+	cl.Spec.Config.TLSClientConfig.CAData = []byte("ca")
+	cl.Spec.ConfigSecretRef = &appv1.SecretRef{SecretName: "my-cluster-credentials", Key: "config"}
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster-credentials",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeClusterConfig},
+		},
+		Data: map[string][]byte{"config": []byte(`{"bearerToken":"token"}`)},
+	}
+
+	_, secret := reconcileCluster(t, cl, credentials)
+
+	require.NotNil(t, secret)
+	assert.JSONEq(t, `{"bearerToken":"token","tlsClientConfig":{"insecure":false,"caData":"Y2E="}}`, string(secret.Data["config"]))
+}
+
+func TestRegistrationController_ConfigSecretWithoutLabel(t *testing.T) {
+	cl := newRegistrationCluster("my-cluster", "https://my-cluster")
+	cl.Spec.ConfigSecretRef = &appv1.SecretRef{SecretName: "my-cluster-credentials", Key: "config"}
+	unlabeled := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster-credentials", Namespace: test.FakeArgoCDNamespace},
+		Data:       map[string][]byte{"config": []byte(`{"bearerToken":"token"}`)},
+	}
+
+	updated, secret := reconcileCluster(t, cl, unlabeled)
+
+	assert.Nil(t, secret)
+	assertRegisteredCondition(t, updated, metav1.ConditionFalse, clusterv1.ClusterReasonInvalidSpec)
+}
+
+func TestRegistrationController_ExecProviderConfig(t *testing.T) {
+	cl := newRegistrationCluster("my-cluster", "https://my-cluster")
+	cl.Spec.Config.ExecProviderConfig = &appv1.ExecProviderConfig{Command: "sh", Args: []string{"-c", "id"}}
+
+	updated, secret := reconcileCluster(t, cl)
+
+	assert.Nil(t, secret)
+	assertRegisteredCondition(t, updated, metav1.ConditionFalse, clusterv1.ClusterReasonInvalidSpec)
+}
+
+func TestRegistrationController_MissingConfigSecret(t *testing.T) {
+	cl := newRegistrationCluster("my-cluster", "https://my-cluster")
+	cl.Spec.ConfigSecretRef = &appv1.SecretRef{SecretName: "missing", Key: "config"}
+
+	updated, secret := reconcileCluster(t, cl)
+
+	assert.Nil(t, secret)
+	assertRegisteredCondition(t, updated, metav1.ConditionFalse, clusterv1.ClusterReasonInvalidSpec)
+}
+
+func TestRegistrationController_InvalidServer(t *testing.T) {
+	updated, secret := reconcileCluster(t, newRegistrationCluster("my-cluster", "my-cluster"))
+
+	assert.Nil(t, secret)
+	assertRegisteredCondition(t, updated, metav1.ConditionFalse, clusterv1.ClusterReasonInvalidSpec)
+	assert.Empty(t, updated.Status.SecretName)
+}
+
+func TestRegistrationController_ServerAlreadyRegistered(t *testing.T) {
+	updated, secret := reconcileCluster(t, newRegistrationCluster("my-cluster", "https://my-cluster"), newClusterSecret("existing", "https://my-cluster/"))
+
+	assert.Nil(t, secret)
+	assertRegisteredCondition(t, updated, metav1.ConditionFalse, clusterv1.ClusterReasonConflict)
+}
+
+func TestRegistrationController_UnmanagedSecret(t *testing.T) {
+	existing := newClusterSecret("cluster-my-cluster", "https://my-cluster")
+
+	updated, secret := reconcileCluster(t, newRegistrationCluster("my-cluster", "https://my-cluster"), existing)
+
+	require.NotNil(t, secret)
+	assert.Equal(t, existing.Data, secret.Data, "the unmanaged secret must not be modified")
+	assertRegisteredCondition(t, updated, metav1.ConditionFalse, clusterv1.ClusterReasonConflict)
+}
+
+func TestRegistrationController_UpdateSecret(t *testing.T) {
+	cl := newRegistrationCluster("my-cluster", "https://my-cluster")
+	cl.Spec.Name = "renamed"
+	refreshedAt := time.Now().UTC().Truncate(time.Second).Format(time.RFC3339)
+	existing := newClusterSecret("cluster-my-cluster", "https://my-cluster")
+	existing.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(cl, clusterv1.ClusterSchemaGroupVersionKind)}
+	existing.Annotations = map[string]string{appv1.AnnotationKeyRefresh: refreshedAt}
+
+	updated, secret := reconcileCluster(t, cl, existing)
+
+	require.NotNil(t, secret)
+	assert.Equal(t, "renamed", string(secret.Data["name"]))
+	assert.Equal(t, refreshedAt, secret.Annotations[appv1.AnnotationKeyRefresh])
+	assertRegisteredCondition(t, updated, metav1.ConditionTrue, clusterv1.ClusterReasonRegistered)
+}
+
+func TestRunWithLeaderElection(t *testing.T) {
+	registrationLeaseDuration, registrationRenewDeadline, registrationRetryPeriod = time.Second, 500*time.Millisecond, 100*time.Millisecond
+	t.Cleanup(func() {
+		registrationLeaseDuration, registrationRenewDeadline, registrationRetryPeriod = 15*time.Second, 10*time.Second, 2*time.Second
+	})
+	kubeclientset := fake.NewClientset()
+
+	var mutex sync.Mutex
+	running := map[string]bool{}
+	maxRunning := 0
+	start := func(identity string) context.CancelFunc {
+		ctx, cancel := context.WithCancel(t.Context())
+		go runWithLeaderElection(ctx, test.FakeArgoCDNamespace, kubeclientset, identity, func(ctx context.Context) {
+			mutex.Lock()
+			running[identity] = true
+			maxRunning = max(maxRunning, len(running))
+			mutex.Unlock()
+			<-ctx.Done()
+			mutex.Lock()
+			delete(running, identity)
+			mutex.Unlock()
+		})
+		return cancel
+	}
+	isRunning := func(identity string) func() bool {
+		return func() bool {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return running[identity]
+		}
+	}
+
+	cancelFirst := start("argocd-server-1")
+	require.Eventually(t, isRunning("argocd-server-1"), 5*time.Second, 10*time.Millisecond)
+	cancelSecond := start("argocd-server-2")
+	defer cancelSecond()
+	time.Sleep(500 * time.Millisecond)
+	assert.False(t, isRunning("argocd-server-2")(), "the controller must only run in the leader")
+
+	// the lease is released when the leader stops, so the other replica takes over
+	cancelFirst()
+	require.Eventually(t, isRunning("argocd-server-2"), 5*time.Second, 10*time.Millisecond)
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, 1, maxRunning)
+}
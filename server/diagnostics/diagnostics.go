@@ -0,0 +1,220 @@
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	metricsutil "github.com/argoproj/argo-cd/v3/util/metrics"
+	"github.com/argoproj/argo-cd/v3/util/profile"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/session"
+)
+
+// URLPrefix is the prefix of the diagnostics endpoints, e.g. /api/diagnostics/repo-server/pprof/heap
+const URLPrefix = "/api/diagnostics"
+
+const (
+	// ComponentServer is the name of the API server component
+	ComponentServer = "server"
+	// ComponentApplicationController is the name of the application controller component
+	ComponentApplicationController = "application-controller"
+	// ComponentRepoServer is the name of the repo server component
+	ComponentRepoServer = "repo-server"
+)
+
+// PodHeader is the response header containing the name of the pod whose diagnostics are returned
+const PodHeader = "X-Argocd-Diagnostics-Pod"
+
+// profileNameRegexp matches the names of the pprof profiles, e.g. heap or goroutine
+var profileNameRegexp = regexp.MustCompile(`^[a-z]+$`)
+
+// podEndpoint is the metrics endpoint of a single pod of a component
+type podEndpoint struct {
+	Pod  string `json:"pod"`
+	Host string `json:"-"`
+}
+
+// NewHandler returns a handler serving the runtime diagnostics and pprof profiles of the Argo CD components by proxying
+// the requests to the metrics servers of their pods. The addresses of the components, keyed by component name, are the
+// names and metrics ports of their Services, whose pods are resolved via EndpointSlices.
+func NewHandler(enf *rbac.Enforcer, kubeClientset kubernetes.Interface, namespace string, addresses map[string]string, clientOpts metricsutil.ClientOpts) *Handler {
+	return &Handler{
+		enf:           enf,
+		kubeClientset: kubeClientset,
+		namespace:     namespace,
+		addresses:     addresses,
+		clientOpts:    clientOpts,
+	}
+}
+
+// Handler serves the runtime diagnostics of the Argo CD components. Requests must be authenticated and are authorized
+// with the get action of the diagnostics RBAC resource on the component name.
+type Handler struct {
+	enf           *rbac.Enforcer
+	kubeClientset kubernetes.Interface
+	namespace     string
+	addresses     map[string]string
+	clientOpts    metricsutil.ClientOpts
+}
+
+// ServeHTTP serves /api/diagnostics/<component>/pods, /api/diagnostics/<component>/diagnostics and
+// /api/diagnostics/<component>/pprof/[<profile>]. The pod is selected with the pod query parameter and defaults to the
+// first ready pod of the component.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	component, endpoint, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, URLPrefix+"/"), "/")
+	address, ok := h.addresses[component]
+	if !ok {
+		http.Error(w, fmt.Sprintf("Unknown component %q", component), http.StatusNotFound)
+		return
+	}
+	path, err := debugPath(endpoint)
+	if err != nil && endpoint != "pods" {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err := h.enf.EnforceErr(r.Context().Value("claims"), rbac.ResourceDiagnostics, rbac.ActionGet, component); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	serverName, port, err := net.SplitHostPort(address)
+	if err != nil {
+		log.Warnf("Invalid diagnostics address %q of %s: %v", address, component, err)
+		http.Error(w, fmt.Sprintf("Invalid diagnostics address of %s", component), http.StatusInternalServerError)
+		return
+	}
+	endpoints, err := h.podEndpoints(r.Context(), serverName)
+	if err != nil {
+		log.Warnf("Failed to resolve the pods of %s: %v", component, err)
+		http.Error(w, fmt.Sprintf("Failed to resolve the pods of %s", component), http.StatusBadGateway)
+		return
+	}
+	if endpoint == "pods" {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(endpoints)
+		return
+	}
+
+	query := r.URL.Query()
+	pod := query.Get("pod")
+	query.Del("pod")
+	var target *podEndpoint
+	for i := range endpoints {
+		if pod == "" || endpoints[i].Pod == pod {
+			target = &endpoints[i]
+			break
+		}
+	}
+	if target == nil {
+		if pod == "" {
+			http.Error(w, fmt.Sprintf("No ready pods of %s found", component), http.StatusBadGateway)
+		} else {
+			http.Error(w, fmt.Sprintf("Pod %q of %s not found", pod, component), http.StatusNotFound)
+		}
+		return
+	}
+
+	log.WithFields(log.Fields{
+		common.SecurityField: common.SecurityMedium,
+		"user":               session.Username(r.Context()),
+		"component":          component,
+		"pod":                target.Pod,
+		"endpoint":           endpoint,
+		"query":              query.Encode(),
+	}).Info("Diagnostics of component requested")
+
+	url := fmt.Sprintf("%s://%s%s", h.clientOpts.Scheme(), net.JoinHostPort(target.Host, port), path)
+	if len(query) > 0 {
+		url += "?" + query.Encode()
+	}
+	client, err := h.clientOpts.NewClient(serverName)
+	if err != nil {
+		log.Warnf("Failed to create diagnostics client: %v", err)
+		http.Error(w, fmt.Sprintf("Failed to get diagnostics of %s", component), http.StatusInternalServerError)
+		return
+	}
+	req, err := h.clientOpts.NewRequest(r.Context(), url)
+	if err != nil {
+		log.Warnf("Failed to create diagnostics request: %v", err)
+		http.Error(w, fmt.Sprintf("Failed to get diagnostics of %s", component), http.StatusInternalServerError)
+		return
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Warnf("Failed to get diagnostics of %s pod %s: %v", component, target.Pod, err)
+		http.Error(w, fmt.Sprintf("Failed to get diagnostics of %s", component), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for _, header := range []string{"Content-Type", "Content-Disposition"} {
+		if value := resp.Header.Get(header); value != "" {
+			w.Header().Set(header, value)
+		}
+	}
+	w.Header().Set(PodHeader, target.Pod)
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+// podEndpoints returns the ready pod endpoints of the Service with the given host name, sorted by pod name. The host
+// is either the name of a Service in the namespace of the API server or <service>.<namespace>[.svc...].
+func (h *Handler) podEndpoints(ctx context.Context, host string) ([]podEndpoint, error) {
+	service, rest, _ := strings.Cut(host, ".")
+	namespace := h.namespace
+	if ns, _, _ := strings.Cut(rest, "."); ns != "" {
+		namespace = ns
+	}
+	list, err := h.kubeClientset.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + service,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoints of service %s/%s: %w", namespace, service, err)
+	}
+	endpoints := []podEndpoint{}
+	for _, slice := range list.Items {
+		for _, endpoint := range slice.Endpoints {
+			if endpoint.TargetRef == nil || endpoint.TargetRef.Kind != "Pod" || len(endpoint.Addresses) == 0 {
+				continue
+			}
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			endpoints = append(endpoints, podEndpoint{Pod: endpoint.TargetRef.Name, Host: endpoint.Addresses[0]})
+		}
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].Pod < endpoints[j].Pod
+	})
+	return endpoints, nil
+}
+
+// debugPath returns the path of the metrics server of a component serving the given diagnostics endpoint
+func debugPath(endpoint string) (string, error) {
+	switch {
+	case endpoint == "diagnostics":
+		return profile.DiagnosticsPath, nil
+	case endpoint == "pprof":
+		return "/debug/pprof/", nil
+	case strings.HasPrefix(endpoint, "pprof/") && profileNameRegexp.MatchString(strings.TrimPrefix(endpoint, "pprof/")):
+		return "/debug/" + endpoint, nil
+	}
+	return "", fmt.Errorf("unknown diagnostics endpoint %q", endpoint)
+}
@@ -0,0 +1,149 @@
+package diagnostics
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/assets"
+	metricsutil "github.com/argoproj/argo-cd/v3/util/metrics"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+func newEndpointSlice(service string, port string, pods ...string) *discoveryv1.EndpointSlice {
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      service + "-abcde",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+	portNumber, _ := strconv.Atoi(port)
+	slice.Ports = []discoveryv1.EndpointPort{{Port: ptr.To(int32(portNumber))}}
+	for _, pod := range pods {
+		slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{
+			Addresses: []string{"127.0.0.1"},
+			TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: pod},
+		})
+	}
+	return slice
+}
+
+func newTestHandler(t *testing.T, pods ...string) (*Handler, *[]string) {
+	t.Helper()
+	var requested []string
+	component := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write([]byte("profile"))
+	}))
+	t.Cleanup(component.Close)
+	_, port, err := net.SplitHostPort(strings.TrimPrefix(component.URL, "http://"))
+	require.NoError(t, err)
+	if len(pods) == 0 {
+		pods = []string{"argocd-repo-server-0"}
+	}
+
+	kubeclientset := fake.NewClientset(test.NewFakeConfigMap(), newEndpointSlice("argocd-repo-server", port, pods...))
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	require.NoError(t, enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	enf.SetClaimsEnforcerFunc(rbacpolicy.NewRBACPolicyEnforcer(enf, test.NewFakeProjLister()).EnforceClaims)
+
+	handler := NewHandler(enf, kubeclientset, test.FakeArgoCDNamespace, map[string]string{ComponentRepoServer: "argocd-repo-server:" + port}, metricsutil.ClientOpts{})
+	return handler, &requested
+}
+
+func serve(handler http.Handler, method string, path string, claims jwt.Claims) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, http.NoBody)
+	//nolint:staticcheck
+	req = req.WithContext(context.WithValue(req.Context(), "claims", claims))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler(t *testing.T) {
+	admin := jwt.RegisteredClaims{Subject: "admin"}
+
+	t.Run("Profile", func(t *testing.T) {
+		handler, requested := newTestHandler(t)
+		rec := serve(handler, http.MethodGet, URLPrefix+"/repo-server/pprof/heap?gc=1", admin)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "profile", rec.Body.String())
+		assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+		assert.Equal(t, []string{"/debug/pprof/heap?gc=1"}, *requested)
+		assert.Equal(t, "argocd-repo-server-0", rec.Header().Get(PodHeader))
+	})
+
+	t.Run("Pods", func(t *testing.T) {
+		handler, requested := newTestHandler(t, "argocd-repo-server-b", "argocd-repo-server-a")
+		rec := serve(handler, http.MethodGet, URLPrefix+"/repo-server/pods", admin)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `[{"pod":"argocd-repo-server-a"},{"pod":"argocd-repo-server-b"}]`, rec.Body.String())
+		assert.Empty(t, *requested)
+	})
+
+	t.Run("SelectedPod", func(t *testing.T) {
+		handler, requested := newTestHandler(t, "argocd-repo-server-a", "argocd-repo-server-b")
+		rec := serve(handler, http.MethodGet, URLPrefix+"/repo-server/pprof/heap?pod=argocd-repo-server-b&gc=1", admin)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "argocd-repo-server-b", rec.Header().Get(PodHeader))
+		assert.Equal(t, []string{"/debug/pprof/heap?gc=1"}, *requested)
+
+		rec = serve(handler, http.MethodGet, URLPrefix+"/repo-server/pprof/heap?pod=argocd-repo-server-c", admin)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Len(t, *requested, 1)
+	})
+
+	t.Run("Diagnostics", func(t *testing.T) {
+		handler, requested := newTestHandler(t)
+		rec := serve(handler, http.MethodGet, URLPrefix+"/repo-server/diagnostics", admin)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, []string{"/debug/diagnostics"}, *requested)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		handler, requested := newTestHandler(t)
+		rec := serve(handler, http.MethodGet, URLPrefix+"/repo-server/pprof/heap", jwt.RegisteredClaims{Subject: "nobody"})
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Empty(t, *requested)
+	})
+
+	t.Run("UnknownComponent", func(t *testing.T) {
+		handler, _ := newTestHandler(t)
+		rec := serve(handler, http.MethodGet, URLPrefix+"/dex/pprof/heap", admin)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("UnknownEndpoint", func(t *testing.T) {
+		handler, requested := newTestHandler(t)
+		rec := serve(handler, http.MethodGet, URLPrefix+"/repo-server/metrics", admin)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		rec = serve(handler, http.MethodGet, URLPrefix+"/repo-server/pprof/../metrics", admin)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Empty(t, *requested)
+	})
+
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		handler, _ := newTestHandler(t)
+		rec := serve(handler, http.MethodPost, URLPrefix+"/repo-server/pprof/heap", admin)
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
@@ -1,15 +1,21 @@
 package extension
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/felixge/httpsnoop"
@@ -29,7 +35,10 @@ import (
 )
 
 const (
-	URLPrefix                    = "/extensions"
+	URLPrefix = "/extensions"
+	// RegistrationURL is the URL of the endpoint registering proxy extensions
+	RegistrationURL              = "/api/v1/extensions"
+	maxRegistrationRequestSize   = 1 << 20
 	DefaultConnectionTimeout     = 2 * time.Second
 	DefaultKeepAlive             = 15 * time.Second
 	DefaultIdleConnectionTimeout = 60 * time.Second
@@ -156,6 +165,35 @@ type ExtensionConfig struct {
 	// the extension route. Mandatory field.
 	Name    string        `yaml:"name"`
 	Backend BackendConfig `yaml:"backend"`
+
+	// RBAC if provided, defines the additional permissions that the
+	// subject must have on the application to invoke the extension.
+	RBAC []RBACConfig `yaml:"rbac,omitempty"`
+}
+
+// RBACConfig defines a permission that the subject must have on the
+// application provided in the HeaderArgoCDApplicationName header in
+// order to invoke an extension.
+type RBACConfig struct {
+	// Resource is the application scoped RBAC resource to enforce.
+	// One of: applications, logs, exec. Mandatory field.
+	Resource string `yaml:"resource"`
+
+	// Action is the RBAC action to enforce on the resource, e.g.
+	// update. Mandatory field.
+	Action string `yaml:"action"`
+
+	// Methods if provided, restricts the permission to the requests
+	// with the given HTTP methods. By default, the permission is
+	// enforced for all requests.
+	Methods []string `yaml:"methods,omitempty"`
+}
+
+// namedExtensionConfig defines the configuration of an extension
+// provided in the 'extension.config.<name>' configmap key.
+type namedExtensionConfig struct {
+	BackendConfig `yaml:",inline"`
+	RBAC          []RBACConfig `yaml:"rbac,omitempty"`
 }
 
 // BackendConfig defines the backend service configurations that will
@@ -164,8 +202,8 @@ type ExtensionConfig struct {
 // external clusters. In this case, each cluster may have its own backend
 // service.
 type BackendConfig struct {
-	ProxyConfig
-	Services []ServiceConfig `yaml:"services"`
+	ProxyConfig `yaml:",inline"`
+	Services    []ServiceConfig `yaml:"services"`
 }
 
 // ServiceConfig provides the configuration for a backend service.
@@ -232,6 +270,22 @@ type ProxyConfig struct {
 	// connections between the API server and the extension server.
 	// Default: 30
 	MaxIdleConnections int `yaml:"maxIdleConnections"`
+
+	// RequestTimeout is the maximum amount of time a request to the
+	// extension server can take, including reading the response body.
+	// Default: no timeout
+	RequestTimeout time.Duration `yaml:"requestTimeout"`
+
+	// Retries is the number of times a request to the extension server
+	// failing with a connection error is retried. Only requests with
+	// idempotent methods and without body are retried.
+	// Default: 0
+	Retries int `yaml:"retries"`
+
+	// MaxResponseSize is the maximum size in bytes of the responses
+	// returned by the extension server. Larger responses are rejected.
+	// Default: no limit
+	MaxResponseSize int64 `yaml:"maxResponseSize"`
 }
 
 // SettingsGetter defines the contract to retrieve Argo CD Settings.
@@ -357,9 +411,17 @@ type Manager struct {
 	project     ProjectGetter
 	cluster     argo.ClusterGetter
 	rbac        RbacEnforcer
-	registry    ExtensionRegistry
 	metricsReg  ExtensionMetricsRegistry
 	userGetter  UserGetter
+
+	// registrationLock serializes the updates of the extension registry
+	registrationLock sync.Mutex
+	// lock protects the fields below
+	lock     sync.RWMutex
+	registry ExtensionRegistry
+	configs  map[string]ExtensionConfig
+	// registered contains the extensions registered with RegisterExtension
+	registered []ExtensionConfig
 }
 
 // ExtensionMetricsRegistry exposes operations to update http metrics in the Argo CD
@@ -426,12 +488,13 @@ func proxyKey(extName, cName, cServer string) ProxyKey {
 	}
 }
 
-func parseAndValidateConfig(s *settings.ArgoCDSettings) (*ExtensionConfigs, error) {
-	if len(s.ExtensionConfig) == 0 {
+func parseAndValidateConfig(s *settings.ArgoCDSettings, registered ...ExtensionConfig) (*ExtensionConfigs, error) {
+	if len(s.ExtensionConfig) == 0 && len(registered) == 0 {
 		return nil, errors.New("no extensions configurations found")
 	}
 
 	configs := ExtensionConfigs{}
+	configs.Extensions = append(configs.Extensions, registered...)
 	for extName, extConfig := range s.ExtensionConfig {
 		extConfigMap := map[string]any{}
 		err := yaml.Unmarshal([]byte(extConfig), &extConfigMap)
@@ -454,14 +517,15 @@ func parseAndValidateConfig(s *settings.ArgoCDSettings) (*ExtensionConfigs, erro
 			}
 			configs.Extensions = append(configs.Extensions, mainConfig.Extensions...)
 		} else {
-			backendConfig := BackendConfig{}
-			err = yaml.Unmarshal(parsedExtConfigBytes, &backendConfig)
+			namedConfig := namedExtensionConfig{}
+			err = yaml.Unmarshal(parsedExtConfigBytes, &namedConfig)
 			if err != nil {
 				return nil, fmt.Errorf("invalid parsed backend extension config for extension %s: %w", extName, err)
 			}
 			ext := ExtensionConfig{
 				Name:    extName,
-				Backend: backendConfig,
+				Backend: namedConfig.BackendConfig,
+				RBAC:    namedConfig.RBAC,
 			}
 			configs.Extensions = append(configs.Extensions, ext)
 		}
@@ -473,6 +537,10 @@ func parseAndValidateConfig(s *settings.ArgoCDSettings) (*ExtensionConfigs, erro
 	return &configs, nil
 }
 
+// rbacResources are the application scoped RBAC resources that can be
+// enforced when invoking an extension.
+var rbacResources = []string{rbac.ResourceApplications, rbac.ResourceLogs, rbac.ResourceExec}
+
 func validateConfigs(configs *ExtensionConfigs) error {
 	nameSafeRegex := regexp.MustCompile(`^[A-Za-z0-9-_]+$`)
 	exts := make(map[string]struct{})
@@ -487,6 +555,23 @@ func validateConfigs(configs *ExtensionConfigs) error {
 			return fmt.Errorf("duplicated extension found in the configs for %q", ext.Name)
 		}
 		exts[ext.Name] = struct{}{}
+		if ext.Backend.Retries < 0 {
+			return fmt.Errorf("extensions.backend.retries must not be negative for extension %s", ext.Name)
+		}
+		if ext.Backend.MaxResponseSize < 0 {
+			return fmt.Errorf("extensions.backend.maxResponseSize must not be negative for extension %s", ext.Name)
+		}
+		if ext.Backend.RequestTimeout < 0 {
+			return fmt.Errorf("extensions.backend.requestTimeout must not be negative for extension %s", ext.Name)
+		}
+		for _, r := range ext.RBAC {
+			if !slices.Contains(rbacResources, r.Resource) {
+				return fmt.Errorf("invalid extensions.rbac.resource %q for extension %s: must be one of %s", r.Resource, ext.Name, strings.Join(rbacResources, ", "))
+			}
+			if r.Action == "" {
+				return fmt.Errorf("extensions.rbac.action must be configured for extension %s", ext.Name)
+			}
+		}
 		svcTotal := len(ext.Backend.Services)
 		if svcTotal == 0 {
 			return fmt.Errorf("no backend service configured for extension %s", ext.Name)
@@ -526,8 +611,12 @@ func NewProxy(targetURL string, headers []Header, config ProxyConfig) (*httputil
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse proxy URL: %w", err)
 	}
+	var transport http.RoundTripper = newTransport(config)
+	if config.Retries > 0 {
+		transport = &retryTransport{transport: transport, retries: config.Retries}
+	}
 	proxy := &httputil.ReverseProxy{
-		Transport: newTransport(config),
+		Transport: transport,
 		Director: func(req *http.Request) {
 			req.Host = url.Host
 			req.URL.Scheme = url.Scheme
@@ -539,10 +628,80 @@ func NewProxy(targetURL string, headers []Header, config ProxyConfig) (*httputil
 				req.Header.Set(header.Name, header.Value)
 			}
 		},
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			log.Errorf("proxy extension error: %s %s: %s", req.Method, req.URL.Path, err)
+			switch {
+			case errors.Is(err, errResponseTooLarge):
+				http.Error(w, "Extension response too large", http.StatusBadGateway)
+			case errors.Is(err, context.DeadlineExceeded):
+				http.Error(w, "Extension request timed out", http.StatusGatewayTimeout)
+			default:
+				w.WriteHeader(http.StatusBadGateway)
+			}
+		},
+	}
+	if config.MaxResponseSize > 0 {
+		proxy.ModifyResponse = func(resp *http.Response) error {
+			return limitResponseSize(resp, config.MaxResponseSize)
+		}
 	}
 	return proxy, nil
 }
 
+var errResponseTooLarge = errors.New("extension response exceeds the maximum response size")
+
+// limitResponseSize returns errResponseTooLarge if the response is larger
+// than maxSize. Responses of unknown size are buffered, so that the error
+// is returned before the status of the response is sent to the client.
+func limitResponseSize(resp *http.Response, maxSize int64) error {
+	if resp.ContentLength > maxSize {
+		return errResponseTooLarge
+	}
+	if resp.ContentLength >= 0 {
+		return nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	_ = resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("error reading extension response: %w", err)
+	}
+	if int64(len(body)) > maxSize {
+		return errResponseTooLarge
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.TransferEncoding = nil
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	return nil
+}
+
+// retryTransport retries the idempotent requests without body failing
+// with a connection error.
+type retryTransport struct {
+	transport http.RoundTripper
+	retries   int
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if !isRetryable(req) {
+		return resp, err
+	}
+	for i := 0; i < t.retries && err != nil && req.Context().Err() == nil; i++ {
+		log.Debugf("retrying proxy extension request %s %s (%d/%d): %s", req.Method, req.URL.Path, i+1, t.retries, err)
+		resp, err = t.transport.RoundTrip(req)
+	}
+	return resp, err
+}
+
+func isRetryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return req.Body == nil || req.Body == http.NoBody
+	}
+	return false
+}
+
 // newTransport will build a new transport to be used in the proxy
 // applying default values if not defined in the given config.
 func newTransport(config ProxyConfig) *http.Transport {
@@ -581,7 +740,7 @@ func (m *Manager) RegisterExtensions() error {
 	if err != nil {
 		return fmt.Errorf("error getting settings: %w", err)
 	}
-	if len(settings.ExtensionConfig) == 0 {
+	if len(settings.ExtensionConfig) == 0 && len(m.registeredExtensions()) == 0 {
 		m.log.Infof("No extensions configured.")
 		return nil
 	}
@@ -597,11 +756,47 @@ func (m *Manager) RegisterExtensions() error {
 // iterate over the given configurations building a new extension registry.
 // At the end, it will update the manager with the newly created registry.
 func (m *Manager) UpdateExtensionRegistry(s *settings.ArgoCDSettings) error {
-	extConfigs, err := parseAndValidateConfig(s)
+	m.registrationLock.Lock()
+	defer m.registrationLock.Unlock()
+	return m.updateExtensionRegistry(s, m.registeredExtensions())
+}
+
+// RegisterExtension registers the given extension in addition to the
+// extensions configured in the Argo CD configmap. The extension is kept
+// registered when the extensions configurations are updated. An error
+// is returned if the extension is invalid or if an extension with the
+// same name is already configured.
+func (m *Manager) RegisterExtension(ext ExtensionConfig) error {
+	m.registrationLock.Lock()
+	defer m.registrationLock.Unlock()
+	s, err := m.settings.Get()
+	if err != nil {
+		return fmt.Errorf("error getting settings: %w", err)
+	}
+	registered := append(m.registeredExtensions(), ext)
+	err = m.updateExtensionRegistry(s, registered)
+	if err != nil {
+		return fmt.Errorf("error registering extension %q: %w", ext.Name, err)
+	}
+	m.lock.Lock()
+	m.registered = registered
+	m.lock.Unlock()
+	return nil
+}
+
+func (m *Manager) registeredExtensions() []ExtensionConfig {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return slices.Clone(m.registered)
+}
+
+func (m *Manager) updateExtensionRegistry(s *settings.ArgoCDSettings, registered []ExtensionConfig) error {
+	extConfigs, err := parseAndValidateConfig(s, registered...)
 	if err != nil {
 		return fmt.Errorf("error parsing extension config: %w", err)
 	}
 	extReg := make(map[string]ProxyRegistry)
+	configs := make(map[string]ExtensionConfig)
 	for _, ext := range extConfigs.Extensions {
 		proxyReg := NewProxyRegistry()
 		singleBackend := len(ext.Backend.Services) == 1
@@ -616,8 +811,12 @@ func (m *Manager) UpdateExtensionRegistry(s *settings.ArgoCDSettings) error {
 			}
 		}
 		extReg[ext.Name] = proxyReg
+		configs[ext.Name] = ext
 	}
+	m.lock.Lock()
 	m.registry = extReg
+	m.configs = configs
+	m.lock.Unlock()
 	return nil
 }
 
@@ -674,10 +873,12 @@ func appendProxy(registry ProxyRegistry,
 //     in HeaderArgoCDApplicationName and HeaderArgoCDProjectName.
 //   - enforce the subject has permission to invoke the extension identified by
 //     extName.
+//   - enforce the subject has the additional permissions on the application
+//     required by the extension for the given HTTP method.
 //   - enforce that the project has permission to access the destination cluster.
 //
 // If all validations are satisfied it will return the Application resource
-func (m *Manager) authorize(ctx context.Context, rr *RequestResources, extName string) (*v1alpha1.Application, error) {
+func (m *Manager) authorize(ctx context.Context, rr *RequestResources, extName string, method string) (*v1alpha1.Application, error) {
 	if m.rbac == nil {
 		return nil, errors.New("rbac enforcer not set in extension manager")
 	}
@@ -690,6 +891,17 @@ func (m *Manager) authorize(ctx context.Context, rr *RequestResources, extName s
 		return nil, fmt.Errorf("unauthorized to invoke extension %q: %w", extName, err)
 	}
 
+	if ext, ok := m.extensionConfig(extName); ok {
+		for _, r := range ext.RBAC {
+			if len(r.Methods) > 0 && !slices.ContainsFunc(r.Methods, func(rm string) bool { return strings.EqualFold(rm, method) }) {
+				continue
+			}
+			if err := m.rbac.EnforceErr(ctx.Value("claims"), r.Resource, r.Action, appRBACName); err != nil {
+				return nil, fmt.Errorf("unauthorized to invoke extension %q: %w", extName, err)
+			}
+		}
+	}
+
 	// just retrieve the app after checking if subject has access to it
 	app, err := m.application.Get(rr.ApplicationNamespace, rr.ApplicationName)
 	if err != nil {
@@ -748,10 +960,67 @@ func findProxy(registry ProxyRegistry, extName string, dest v1alpha1.Application
 // ProxyRegistry returns the proxy registry associated for the given
 // extension name.
 func (m *Manager) ProxyRegistry(name string) (ProxyRegistry, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
 	pReg, found := m.registry[name]
 	return pReg, found
 }
 
+// extensionConfig returns the configuration of the given registered
+// extension.
+func (m *Manager) extensionConfig(name string) (ExtensionConfig, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	ext, found := m.configs[name]
+	return ext, found
+}
+
+// RegisterExtensionHandler returns a handler func registering the proxy
+// extension given in the JSON request body. The user must have the
+// extensions create permission for the name of the extension.
+func (m *Manager) RegisterExtensionHandler() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+			http.Error(w, "Invalid content type", http.StatusUnsupportedMediaType)
+			return
+		}
+		var ext ExtensionConfig
+		decoder := yaml.NewDecoder(http.MaxBytesReader(w, r.Body, maxRegistrationRequestSize))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&ext); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "Extension registration request too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, fmt.Sprintf("Invalid extension: %s", err), http.StatusBadRequest)
+			return
+		}
+		if ext.Name == "" {
+			http.Error(w, "Invalid extension: name must be provided", http.StatusBadRequest)
+			return
+		}
+		if err := m.rbac.EnforceErr(r.Context().Value("claims"), rbac.ResourceExtensions, rbac.ActionCreate, ext.Name); err != nil {
+			m.log.Infof("unauthorized extension registration: %s", err)
+			http.Error(w, "Unauthorized extension registration", http.StatusForbidden)
+			return
+		}
+		if err := m.RegisterExtension(ext); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		m.log.WithFields(log.Fields{
+			"extension": ext.Name,
+			"user":      m.userGetter.GetUsername(r.Context()),
+		}).Info("registered proxy extension")
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
 // CallExtension returns a handler func responsible for forwarding requests to the
 // extension service. The request will be sanitized by removing sensitive headers.
 func (m *Manager) CallExtension() func(http.ResponseWriter, *http.Request) {
@@ -773,7 +1042,7 @@ func (m *Manager) CallExtension() func(http.ResponseWriter, *http.Request) {
 			http.Error(w, fmt.Sprintf("Invalid headers: %s", err), http.StatusBadRequest)
 			return
 		}
-		app, err := m.authorize(r.Context(), reqResources, extName)
+		app, err := m.authorize(r.Context(), reqResources, extName, r.Method)
 		if err != nil {
 			m.log.Infof("unauthorized extension request: %s", err)
 			http.Error(w, "Unauthorized extension request", http.StatusUnauthorized)
@@ -806,6 +1075,11 @@ func (m *Manager) CallExtension() func(http.ResponseWriter, *http.Request) {
 			"extension":                 extName,
 			"path":                      r.URL.Path,
 		}).Info("sending proxy extension request")
+		if ext, ok := m.extensionConfig(extName); ok && ext.Backend.RequestTimeout > 0 {
+			ctx, cancel := context.WithTimeout(r.Context(), ext.Backend.RequestTimeout)
+			defer cancel()
+			r = r.WithContext(ctx)
+		}
 		// httpsnoop package is used to properly wrap the responseWriter
 		// and avoid optional intefaces issue:
 		// https://github.com/felixge/httpsnoop#why-this-package-exists
@@ -174,6 +174,40 @@ func TestRegisterExtensions(t *testing.T) {
 			assert.NotNil(t, proxyRegistry)
 		}
 	})
+	t.Run("will register extension with the registration API successfully", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		settings := &settings.ArgoCDSettings{
+			ExtensionConfig: map[string]string{
+				"another-ext": getSingleExtensionConfigString(),
+			},
+		}
+		f.settingsGetterMock.EXPECT().Get().Return(settings, nil)
+		ext := extension.ExtensionConfig{
+			Name: "registered-ext",
+			Backend: extension.BackendConfig{
+				Services: []extension.ServiceConfig{{URL: "http://localhost:7777"}},
+			},
+		}
+
+		// when
+		err := f.manager.RegisterExtension(ext)
+		require.NoError(t, err)
+		err = f.manager.UpdateExtensionRegistry(settings)
+		require.NoError(t, err)
+
+		// then
+		for _, name := range []string{"registered-ext", "another-ext"} {
+			proxyRegistry, found := f.manager.ProxyRegistry(name)
+			assert.True(t, found)
+			assert.NotNil(t, proxyRegistry)
+		}
+		err = f.manager.RegisterExtension(extension.ExtensionConfig{Name: "another-ext", Backend: ext.Backend})
+		require.ErrorContains(t, err, "duplicated extension")
+		err = f.manager.RegisterExtension(extension.ExtensionConfig{Name: "invalid-ext"})
+		require.ErrorContains(t, err, "no backend service configured")
+	})
 	t.Run("will return error if extension config is invalid", func(t *testing.T) {
 		// given
 		t.Parallel()
@@ -206,6 +240,14 @@ func TestRegisterExtensions(t *testing.T) {
 				name:       "no header value",
 				configYaml: getExtensionConfigNoHeaderValue(),
 			},
+			{
+				name:       "negative retries",
+				configYaml: getExtensionConfigNegativeRetries(),
+			},
+			{
+				name:       "invalid rbac resource",
+				configYaml: getExtensionConfigInvalidRBACResource(),
+			},
 		}
 
 		// when
@@ -712,6 +754,121 @@ func TestCallExtension(t *testing.T) {
 		actual := strings.TrimSuffix(string(body), "\n")
 		assert.Equal(t, "Unauthorized extension request", actual)
 	})
+	t.Run("will enforce the additional permissions required by the extension", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "some-extension"
+		clusterURL := "some-url"
+		backendSrv := startBackendTestSrv("some data")
+		defer backendSrv.Close()
+		withRbac(f, true, true)
+		f.rbacMock.EXPECT().EnforceErr(mock.Anything, rbac.ResourceApplications, rbac.ActionUpdate, mock.Anything).Return(errors.New("no update permission")).Maybe()
+		withExtensionConfig(getExtensionConfigWithRBAC(extName, backendSrv.URL), f)
+		withMetrics(f)
+		withUser(f, "some-user-id", "some-user", []string{"group1", "group2"})
+		f.appGetterMock.EXPECT().Get(mock.Anything, mock.Anything).Return(getApp("", clusterURL, defaultProjectName), nil).Maybe()
+		withProject(getProjectWithDestinations(defaultProjectName, nil, []string{clusterURL}), f)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+		url := fmt.Sprintf("%s/extensions/%s/", ts.URL, extName)
+
+		// when
+		getResp, err := http.DefaultClient.Do(newExtensionRequest(t, http.MethodGet, url))
+		require.NoError(t, err)
+		postResp, err := http.DefaultClient.Do(newExtensionRequest(t, http.MethodPost, url))
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, http.StatusOK, getResp.StatusCode)
+		assert.Equal(t, http.StatusUnauthorized, postResp.StatusCode)
+	})
+	t.Run("will return 502 if the response exceeds the maximum response size", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "some-extension"
+		clusterURL := "some-url"
+		backendSrv := startBackendTestSrv(strings.Repeat("x", 100))
+		defer backendSrv.Close()
+		withRbac(f, true, true)
+		withExtensionConfig(getExtensionConfigWithProxyConfig(extName, backendSrv.URL, "maxResponseSize: 10"), f)
+		withMetrics(f)
+		withUser(f, "some-user-id", "some-user", []string{"group1", "group2"})
+		f.appGetterMock.EXPECT().Get(mock.Anything, mock.Anything).Return(getApp("", clusterURL, defaultProjectName), nil).Maybe()
+		withProject(getProjectWithDestinations(defaultProjectName, nil, []string{clusterURL}), f)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+
+		// when
+		resp, err := http.DefaultClient.Do(newExtensionRequest(t, http.MethodGet, fmt.Sprintf("%s/extensions/%s/", ts.URL, extName)))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	})
+	t.Run("will return 502 if a response of unknown size exceeds the maximum response size", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "some-extension"
+		clusterURL := "some-url"
+		backendSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			// flushing sends the response chunked, without content length
+			for i := 0; i < 10; i++ {
+				fmt.Fprint(w, strings.Repeat("x", 10))
+				w.(http.Flusher).Flush()
+			}
+		}))
+		defer backendSrv.Close()
+		withRbac(f, true, true)
+		withExtensionConfig(getExtensionConfigWithProxyConfig(extName, backendSrv.URL, "maxResponseSize: 50"), f)
+		withMetrics(f)
+		withUser(f, "some-user-id", "some-user", []string{"group1", "group2"})
+		f.appGetterMock.EXPECT().Get(mock.Anything, mock.Anything).Return(getApp("", clusterURL, defaultProjectName), nil).Maybe()
+		withProject(getProjectWithDestinations(defaultProjectName, nil, []string{clusterURL}), f)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+
+		// when
+		resp, err := http.DefaultClient.Do(newExtensionRequest(t, http.MethodGet, fmt.Sprintf("%s/extensions/%s/", ts.URL, extName)))
+
+		// then
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	})
+	t.Run("will return 504 if the request times out", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup()
+		extName := "some-extension"
+		clusterURL := "some-url"
+		done := make(chan struct{})
+		backendSrv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-done:
+			}
+		}))
+		defer backendSrv.Close()
+		defer close(done)
+		withRbac(f, true, true)
+		withExtensionConfig(getExtensionConfigWithProxyConfig(extName, backendSrv.URL, "requestTimeout: 100ms"), f)
+		withMetrics(f)
+		withUser(f, "some-user-id", "some-user", []string{"group1", "group2"})
+		f.appGetterMock.EXPECT().Get(mock.Anything, mock.Anything).Return(getApp("", clusterURL, defaultProjectName), nil).Maybe()
+		withProject(getProjectWithDestinations(defaultProjectName, nil, []string{clusterURL}), f)
+		ts := startTestServer(t, f)
+		defer ts.Close()
+
+		// when
+		resp, err := http.DefaultClient.Do(newExtensionRequest(t, http.MethodGet, fmt.Sprintf("%s/extensions/%s/", ts.URL, extName)))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusGatewayTimeout, resp.StatusCode)
+	})
 	t.Run("will return 400 if no extension name is provided", func(t *testing.T) {
 		// given
 		t.Parallel()
@@ -753,6 +910,34 @@ extensions:
 	return fmt.Sprintf(cfg, name, url)
 }
 
+func getExtensionConfigWithRBAC(name, url string) string {
+	cfg := `
+extensions:
+- name: %s
+  backend:
+    services:
+    - url: %s
+  rbac:
+  - resource: applications
+    action: update
+    methods:
+    - POST
+`
+	return fmt.Sprintf(cfg, name, url)
+}
+
+func getExtensionConfigWithProxyConfig(name, url, proxyConfig string) string {
+	cfg := `
+extensions:
+- name: %s
+  backend:
+    %s
+    services:
+    - url: %s
+`
+	return fmt.Sprintf(cfg, name, proxyConfig, url)
+}
+
 func getExtensionConfigWith2Backends(name, url1, clus1Name, clus1URL, url2, clus2Name, clus2URL string) string {
 	cfg := `
 extensions:
@@ -875,3 +1060,114 @@ extensions:
       - name: some-header-name
 `
 }
+
+func getExtensionConfigNegativeRetries() string {
+	return `
+extensions:
+- name: some-extension
+  backend:
+    retries: -1
+    services:
+    - url: https://httpbin.org
+`
+}
+
+func getExtensionConfigInvalidRBACResource() string {
+	return `
+extensions:
+- name: some-extension
+  backend:
+    services:
+    - url: https://httpbin.org
+  rbac:
+  - resource: clusters
+    action: get
+`
+}
+
+func TestRegisterExtensionHandler(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*extension.Manager, *mocks.RbacEnforcer, *httptest.Server) {
+		t.Helper()
+		settMock := &mocks.SettingsGetter{}
+		settMock.EXPECT().Get().Return(&settings.ArgoCDSettings{}, nil).Maybe()
+		rbacMock := &mocks.RbacEnforcer{}
+		userMock := &mocks.UserGetter{}
+		userMock.EXPECT().GetUsername(mock.Anything).Return("some-user").Maybe()
+		logger, _ := test.NewNullLogger()
+		m := extension.NewManager(logger.WithContext(t.Context()), "control-plane-ns", settMock, &mocks.ApplicationGetter{}, &mocks.ProjectGetter{}, &dbmocks.ArgoDB{}, rbacMock, userMock)
+		mux := http.NewServeMux()
+		mux.Handle(extension.RegistrationURL, http.HandlerFunc(m.RegisterExtensionHandler()))
+		ts := httptest.NewServer(mux)
+		t.Cleanup(ts.Close)
+		return m, rbacMock, ts
+	}
+	register := func(t *testing.T, ts *httptest.Server, contentType, body string) int {
+		t.Helper()
+		r, err := http.NewRequestWithContext(t.Context(), http.MethodPost, ts.URL+extension.RegistrationURL, strings.NewReader(body))
+		require.NoError(t, err)
+		r.Header.Set("Content-Type", contentType)
+		resp, err := http.DefaultClient.Do(r)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+	validExtension := `{"name": "some-extension", "backend": {"services": [{"url": "http://localhost:7777"}], "requestTimeout": "30s"}}`
+
+	t.Run("will register extension", func(t *testing.T) {
+		t.Parallel()
+		m, rbacMock, ts := setup(t)
+		rbacMock.EXPECT().EnforceErr(mock.Anything, rbac.ResourceExtensions, rbac.ActionCreate, "some-extension").Return(nil)
+
+		assert.Equal(t, http.StatusCreated, register(t, ts, "application/json", validExtension))
+		_, found := m.ProxyRegistry("some-extension")
+		assert.True(t, found)
+	})
+	t.Run("will reject registration without permission", func(t *testing.T) {
+		t.Parallel()
+		m, rbacMock, ts := setup(t)
+		rbacMock.EXPECT().EnforceErr(mock.Anything, rbac.ResourceExtensions, rbac.ActionCreate, "some-extension").Return(errors.New("no create permission"))
+
+		assert.Equal(t, http.StatusForbidden, register(t, ts, "application/json", validExtension))
+		_, found := m.ProxyRegistry("some-extension")
+		assert.False(t, found)
+	})
+	t.Run("will reject invalid registrations", func(t *testing.T) {
+		t.Parallel()
+		_, rbacMock, ts := setup(t)
+		rbacMock.EXPECT().EnforceErr(mock.Anything, rbac.ResourceExtensions, rbac.ActionCreate, mock.Anything).Return(nil).Maybe()
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, register(t, ts, "text/plain", validExtension))
+		assert.Equal(t, http.StatusBadRequest, register(t, ts, "application/json", `{"name": "some-extension", "unknown": true}`))
+		assert.Equal(t, http.StatusBadRequest, register(t, ts, "application/json", `{"backend": {"services": [{"url": "http://localhost:7777"}]}}`))
+		assert.Equal(t, http.StatusBadRequest, register(t, ts, "application/json", `{"name": "some-extension"}`))
+	})
+}
+
+func TestRegisterExtension_Concurrent(t *testing.T) {
+	settMock := &mocks.SettingsGetter{}
+	settMock.EXPECT().Get().Return(&settings.ArgoCDSettings{}, nil)
+	logger, _ := test.NewNullLogger()
+	m := extension.NewManager(logger.WithContext(t.Context()), "control-plane-ns", settMock, &mocks.ApplicationGetter{}, &mocks.ProjectGetter{}, &dbmocks.ArgoDB{}, &mocks.RbacEnforcer{}, &mocks.UserGetter{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := m.RegisterExtension(extension.ExtensionConfig{
+				Name:    fmt.Sprintf("ext-%d", i),
+				Backend: extension.BackendConfig{Services: []extension.ServiceConfig{{URL: "http://localhost:7777"}}},
+			})
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	// no registration is lost
+	for i := 0; i < 10; i++ {
+		_, found := m.ProxyRegistry(fmt.Sprintf("ext-%d", i))
+		assert.True(t, found, "extension ext-%d is not registered", i)
+	}
+}
@@ -21,6 +21,7 @@ type MetricsServer struct {
 	extensionRequestCounter  *prometheus.CounterVec
 	extensionRequestDuration *prometheus.HistogramVec
 	loginRequestCounter      *prometheus.CounterVec
+	rbacCompilationDuration  prometheus.Histogram
 	PrometheusRegistry       *prometheus.Registry
 }
 
@@ -62,6 +63,13 @@ var (
 		},
 		[]string{"status"},
 	)
+	rbacCompilationDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "argocd_rbac_policy_compilation_duration_seconds",
+			Help:    "Duration in seconds of the compilation of the RBAC policies of the API server.",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.25, .5, 1, 2, 5},
+		},
+	)
 	argoVersion = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "argocd_info",
@@ -88,6 +96,7 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 	registry.MustRegister(extensionRequestCounter)
 	registry.MustRegister(extensionRequestDuration)
 	registry.MustRegister(loginRequestCounter)
+	registry.MustRegister(rbacCompilationDuration)
 	registry.MustRegister(argoVersion)
 
 	kubectl.RegisterWithClientGo()
@@ -103,6 +112,7 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 		extensionRequestCounter:  extensionRequestCounter,
 		extensionRequestDuration: extensionRequestDuration,
 		loginRequestCounter:      loginRequestCounter,
+		rbacCompilationDuration:  rbacCompilationDuration,
 		PrometheusRegistry:       registry,
 	}
 }
@@ -129,3 +139,8 @@ func (m *MetricsServer) ObserveExtensionRequestDuration(extension string, durati
 func (m *MetricsServer) IncLoginRequestCounter(status string) {
 	m.loginRequestCounter.WithLabelValues(status).Inc()
 }
+
+// ObserveRBACPolicyCompilationDuration observes the duration of a compilation of the RBAC policies
+func (m *MetricsServer) ObserveRBACPolicyCompilationDuration(duration time.Duration) {
+	m.rbacCompilationDuration.Observe(duration.Seconds())
+}
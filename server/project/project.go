@@ -108,7 +108,7 @@ func (s *Server) createToken(ctx context.Context, q *project.ProjectTokenCreateR
 		return nil, status.Errorf(codes.NotFound, "project '%s' does not have role '%s'", q.Project, q.Role)
 	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionUpdate, q.Project); err != nil {
-		if !jwtutil.IsMember(jwtutil.Claims(ctx.Value("claims")), role.Groups, s.policyEnf.GetScopes()) {
+		if !jwtutil.IsMember(jwtutil.Claims(ctx.Value("claims")), prj.GetRoleGroups(role), s.policyEnf.GetScopes()) {
 			return nil, err
 		}
 	}
@@ -227,7 +227,7 @@ func (s *Server) deleteToken(ctx context.Context, q *project.ProjectTokenDeleteR
 		return &project.EmptyResponse{}, nil
 	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionUpdate, q.Project); err != nil {
-		if !jwtutil.IsMember(jwtutil.Claims(ctx.Value("claims")), role.Groups, s.policyEnf.GetScopes()) {
+		if !jwtutil.IsMember(jwtutil.Claims(ctx.Value("claims")), prj.GetRoleGroups(role), s.policyEnf.GetScopes()) {
 			return nil, err
 		}
 	}
@@ -413,7 +413,8 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	invalidDstCount := 0
 
 	for _, a := range argo.FilterByProjects(appsList.Items, []string{q.Project.Name}) {
-		if oldProj.IsSourcePermitted(a.Spec.GetSource()) && !q.Project.IsSourcePermitted(a.Spec.GetSource()) {
+		src := a.Spec.GetSource()
+		if oldProj.IsSourcePermitted(src) && oldProj.IsSourcePathPermitted(src) && (!q.Project.IsSourcePermitted(src) || !q.Project.IsSourcePathPermitted(src)) {
 			invalidSrcCount++
 		}
 
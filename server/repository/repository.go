@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,6 +25,7 @@ import (
 	servercache "github.com/argoproj/argo-cd/v3/server/cache"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
@@ -30,6 +33,21 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
+// repoValidationParallelismLimit is the maximum number of repositories a batch validation checks concurrently
+// maxRepoValidationBatchSize is the maximum number of repositories a single batch validation request may contain
+const maxRepoValidationBatchSize = 50
+
+var repoValidationParallelismLimit = env.ParseNumFromEnv(common.EnvRepoValidationParallelismLimit, 10, 1, maxRepoValidationBatchSize)
+
+// sharedLoadTimeout bounds the calls shared by concurrent callers through the server cache. Those calls run detached
+// from the context of the caller which started them, so that its cancellation does not fail the other callers.
+const sharedLoadTimeout = 60 * time.Second
+
+// detachedLoadContext returns the context on which a call shared by concurrent callers runs
+func detachedLoadContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), sharedLoadTimeout)
+}
+
 // Server provides a Repository service
 type Server struct {
 	db              db.ArgoDB
@@ -100,30 +118,36 @@ func (s *Server) getConnectionState(ctx context.Context, url string, project str
 			return connectionState
 		}
 	}
-	now := metav1.Now()
-	connectionState := v1alpha1.ConnectionState{
-		Status:     v1alpha1.ConnectionStatusSuccessful,
-		ModifiedAt: &now,
-	}
-	var err error
-	repo, err := s.db.GetRepository(ctx, url, project)
-	if err == nil {
-		err = s.testRepo(ctx, repo)
-	}
-	if err != nil {
-		connectionState.Status = v1alpha1.ConnectionStatusFailed
-		if errors.IsCredentialsConfigurationError(err) {
-			connectionState.Message = "Configuration error - please check the server logs"
-			log.Warnf("could not retrieve repo: %s", err.Error())
-		} else {
-			connectionState.Message = fmt.Sprintf("Unable to connect to repository: %v", err)
+	// Concurrent requests for the same repository share a single connection test
+	res, _ := s.cache.Load(fmt.Sprintf("repo|%s|%s|connection-state", url, project), func() (any, error) {
+		ctx, cancel := detachedLoadContext(ctx)
+		defer cancel()
+		now := metav1.Now()
+		connectionState := v1alpha1.ConnectionState{
+			Status:     v1alpha1.ConnectionStatusSuccessful,
+			ModifiedAt: &now,
 		}
-	}
-	err = s.cache.SetRepoConnectionState(url, project, &connectionState)
-	if err != nil {
-		log.Warnf("getConnectionState cache set error %s: %v", url, err)
-	}
-	return connectionState
+		var err error
+		repo, err := s.db.GetRepository(ctx, url, project)
+		if err == nil {
+			err = s.testRepo(ctx, repo, false)
+		}
+		if err != nil {
+			connectionState.Status = v1alpha1.ConnectionStatusFailed
+			if errors.IsCredentialsConfigurationError(err) {
+				connectionState.Message = "Configuration error - please check the server logs"
+				log.Warnf("could not retrieve repo: %s", err.Error())
+			} else {
+				connectionState.Message = fmt.Sprintf("Unable to connect to repository: %v", err)
+			}
+		}
+		err = s.cache.SetRepoConnectionState(url, project, &connectionState)
+		if err != nil {
+			log.Warnf("getConnectionState cache set error %s: %v", url, err)
+		}
+		return connectionState, nil
+	})
+	return res.(v1alpha1.ConnectionState)
 }
 
 // List returns list of repositories
@@ -193,6 +217,40 @@ func (s *Server) ListRepositories(ctx context.Context, q *repositorypkg.RepoQuer
 	return &v1alpha1.RepositoryList{Items: items}, nil
 }
 
+// ListRepositoriesByProject returns a list of the repositories which can be used by applications of the given project
+// and the state of their connections. These are the repositories scoped to the project and the global repositories
+// permitted by the source repositories of the project.
+func (s *Server) ListRepositoriesByProject(ctx context.Context, q *repositorypkg.RepoQuery) (*v1alpha1.RepositoryList, error) {
+	if q.AppProject == "" {
+		return nil, status.Error(codes.InvalidArgument, "project is required")
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.AppProject); err != nil {
+		return nil, err
+	}
+	proj, err := argo.GetAppProjectByName(ctx, q.AppProject, applisters.NewAppProjectLister(s.projLister.GetIndexer()), s.namespace, s.settings, s.db)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "project '%s' not found", q.AppProject)
+		}
+		return nil, err
+	}
+	repos, err := s.db.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var projectRepos []*v1alpha1.Repository
+	for _, repo := range repos {
+		if repo.Project == proj.Name || (repo.Project == "" && proj.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: repo.Repo})) {
+			projectRepos = append(projectRepos, repo)
+		}
+	}
+	items, err := s.prepareRepoList(ctx, rbac.ResourceRepositories, projectRepos, q.ForceRefresh)
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.RepositoryList{Items: items}, nil
+}
+
 // ListWriteRepositories returns a list of all configured repositories where the user has write access and the state of
 // their connections
 func (s *Server) ListWriteRepositories(ctx context.Context, q *repositorypkg.RepoQuery) (*v1alpha1.RepositoryList, error) {
@@ -396,15 +454,24 @@ func (s *Server) GetAppDetails(ctx context.Context, q *repositorypkg.RepoAppDeta
 		}
 	}
 
-	return repoClient.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
-		Repo:             repo,
-		Source:           q.Source,
-		Repos:            helmRepos,
-		KustomizeOptions: kustomizeSettings,
-		HelmOptions:      helmOptions,
-		AppName:          q.AppName,
-		RefSources:       refSources,
+	// Identical requests (e.g. many users opening the same application) share a single repo-server call
+	res, err := s.cache.Load(fmt.Sprintf("app|%s|%s/%s|details|%s", q.AppProject, appNs, appName, q.Source.String()), func() (any, error) {
+		ctx, cancel := detachedLoadContext(ctx)
+		defer cancel()
+		return repoClient.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
+			Repo:             repo,
+			Source:           q.Source,
+			Repos:            helmRepos,
+			KustomizeOptions: kustomizeSettings,
+			HelmOptions:      helmOptions,
+			AppName:          q.AppName,
+			RefSources:       refSources,
+		})
 	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*apiclient.RepoAppDetailsResponse), nil
 }
 
 // GetHelmCharts returns list of helm charts in the specified repository
@@ -454,7 +521,12 @@ func (s *Server) CreateRepository(ctx context.Context, q *repositorypkg.RepoCrea
 			repo.CopyCredentialsFrom(creds)
 		}
 
-		err = s.testRepo(ctx, repo)
+		err = s.testRepo(ctx, repo, q.VerifyWriteAccess)
+		if err != nil {
+			return nil, err
+		}
+	} else if q.VerifyWriteAccess {
+		err = s.testRepo(ctx, q.Repo, true)
 		if err != nil {
 			return nil, err
 		}
@@ -507,7 +579,7 @@ func (s *Server) CreateWriteRepository(ctx context.Context, q *repositorypkg.Rep
 		return nil, status.Errorf(codes.InvalidArgument, "missing credentials in request")
 	}
 
-	err := s.testRepo(ctx, q.Repo)
+	err := s.testRepo(ctx, q.Repo, q.VerifyWriteAccess)
 	if err != nil {
 		return nil, err
 	}
@@ -677,9 +749,43 @@ func getRepository(ctx context.Context, listRepositories func(context.Context, *
 // ValidateAccess checks whether access to a repository is possible with the
 // given URL and credentials.
 func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccessQuery) (*repositorypkg.RepoResponse, error) {
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionCreate, createRBACObject(q.Project, q.Repo)); err != nil {
+	if err := s.validateAccess(ctx, q); err != nil {
 		return nil, err
 	}
+	return &repositorypkg.RepoResponse{}, nil
+}
+
+// ValidateAccessBatch checks whether access to each of the given repositories is possible with their URL and
+// credentials. Up to repoValidationParallelismLimit repositories are checked concurrently, and the failure to access a
+// repository is returned in its result.
+func (s *Server) ValidateAccessBatch(ctx context.Context, q *repositorypkg.RepoAccessBatchQuery) (*repositorypkg.RepoAccessBatchResponse, error) {
+	if len(q.Repos) > maxRepoValidationBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d repositories can be validated at once, got %d", maxRepoValidationBatchSize, len(q.Repos))
+	}
+	results := make([]*repositorypkg.RepoAccessResult, len(q.Repos))
+	var eg errgroup.Group
+	eg.SetLimit(repoValidationParallelismLimit)
+	for i, repoQuery := range q.Repos {
+		eg.Go(func() error {
+			result := &repositorypkg.RepoAccessResult{Repo: repoQuery.GetRepo(), Project: repoQuery.GetProject(), Valid: true}
+			if err := s.validateAccess(ctx, repoQuery); err != nil {
+				result.Valid = false
+				result.Error = err.Error()
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	_ = eg.Wait()
+	return &repositorypkg.RepoAccessBatchResponse{Items: results}, nil
+}
+
+// validateAccess checks whether access to a repository is possible with the given URL and credentials, or with the
+// stored credentials matching its URL
+func (s *Server) validateAccess(ctx context.Context, q *repositorypkg.RepoAccessQuery) error {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionCreate, createRBACObject(q.Project, q.Repo)); err != nil {
+		return err
+	}
 
 	repo := &v1alpha1.Repository{
 		Repo:                       q.Repo,
@@ -708,17 +814,13 @@ func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccess
 	if !repo.HasCredentials() {
 		repoCreds, err := s.db.GetRepositoryCredentials(ctx, q.Repo)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if repoCreds != nil {
 			repo.CopyCredentialsFrom(repoCreds)
 		}
 	}
-	err := s.testRepo(ctx, repo)
-	if err != nil {
-		return nil, err
-	}
-	return &repositorypkg.RepoResponse{}, nil
+	return s.testRepo(ctx, repo, false)
 }
 
 // ValidateWriteAccess checks whether write access to a repository is possible with the
@@ -753,14 +855,16 @@ func (s *Server) ValidateWriteAccess(ctx context.Context, q *repositorypkg.RepoA
 		UseAzureWorkloadIdentity:   q.UseAzureWorkloadIdentity,
 	}
 
-	err := s.testRepo(ctx, repo)
+	err := s.testRepo(ctx, repo, false)
 	if err != nil {
 		return nil, err
 	}
 	return &repositorypkg.RepoResponse{}, nil
 }
 
-func (s *Server) testRepo(ctx context.Context, repo *v1alpha1.Repository) error {
+// testRepo checks that the repository is accessible with its credentials, and that they grant push access to it if
+// writeAccess is true
+func (s *Server) testRepo(ctx context.Context, repo *v1alpha1.Repository, writeAccess bool) error {
 	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
 	if err != nil {
 		return fmt.Errorf("failed to connect to repo-server: %w", err)
@@ -768,7 +872,8 @@ func (s *Server) testRepo(ctx context.Context, repo *v1alpha1.Repository) error
 	defer utilio.Close(conn)
 
 	_, err = repoClient.TestRepository(ctx, &apiclient.TestRepositoryRequest{
-		Repo: repo,
+		Repo:        repo,
+		WriteAccess: writeAccess,
 	})
 	return err
 }
@@ -284,6 +284,41 @@ func TestRepositoryServer(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Test_validateAccessBatch", func(t *testing.T) {
+		repoServerClient := &mocks.RepoServerServiceClient{}
+		repoServerClient.EXPECT().TestRepository(mock.Anything, mock.MatchedBy(func(req *apiclient.TestRepositoryRequest) bool {
+			return req.Repo.Repo == "https://unreachable"
+		})).Return(nil, errors.New("repository not found"))
+		repoServerClient.EXPECT().TestRepository(mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: repoServerClient}
+
+		s := NewServer(&repoServerClientset, argoDB, enforcer, nil, appLister, projInformer, testNamespace, settingsMgr, false)
+		res, err := s.ValidateAccessBatch(t.Context(), &repository.RepoAccessBatchQuery{
+			Repos: []*repository.RepoAccessQuery{
+				{Repo: "https://test"},
+				{Repo: "https://unreachable", Project: "default"},
+				{Repo: "https://test-2", Username: "admin", Password: "password"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*repository.RepoAccessResult{
+			{Repo: "https://test", Valid: true},
+			{Repo: "https://unreachable", Project: "default", Error: "repository not found"},
+			{Repo: "https://test-2", Valid: true},
+		}, res.Items)
+	})
+
+	t.Run("Test_validateAccessBatchTooLarge", func(t *testing.T) {
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}}
+		s := NewServer(&repoServerClientset, argoDB, enforcer, nil, appLister, projInformer, testNamespace, settingsMgr, false)
+		repos := make([]*repository.RepoAccessQuery, maxRepoValidationBatchSize+1)
+		for i := range repos {
+			repos[i] = &repository.RepoAccessQuery{Repo: "https://test"}
+		}
+		_, err := s.ValidateAccessBatch(t.Context(), &repository.RepoAccessBatchQuery{Repos: repos})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	t.Run("Test_Get", func(t *testing.T) {
 		repoServerClient := &mocks.RepoServerServiceClient{}
 		repoServerClient.EXPECT().TestRepository(mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
@@ -456,6 +491,29 @@ func TestRepositoryServer(t *testing.T) {
 		assert.Equal(t, "repo", repo.Repo)
 	})
 
+	t.Run("Test_CreateRepositoryVerifyWriteAccess", func(t *testing.T) {
+		repoServerClient := &mocks.RepoServerServiceClient{}
+		repoServerClient.EXPECT().TestRepository(mock.Anything, mock.MatchedBy(func(q *apiclient.TestRepositoryRequest) bool {
+			return q.WriteAccess
+		})).Return(nil, status.Error(codes.Unknown, "error testing repository write access: authorization failed"))
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: repoServerClient}
+
+		db := &dbmocks.ArgoDB{}
+		for _, project := range []string{"", "proj"} {
+			s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+			_, err := s.CreateRepository(t.Context(), &repository.RepoCreateRequest{
+				Repo: &appsv1.Repository{
+					Repo:     "test",
+					Username: "test",
+					Project:  project,
+				},
+				VerifyWriteAccess: true,
+			})
+			require.ErrorContains(t, err, "authorization failed")
+		}
+		db.AssertNotCalled(t, "CreateRepository", mock.Anything, mock.Anything)
+	})
+
 	t.Run("Test_CreateRepositoryWithUpsert", func(t *testing.T) {
 		repoServerClient := &mocks.RepoServerServiceClient{}
 		repoServerClient.EXPECT().TestRepository(mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
@@ -504,6 +562,63 @@ func TestRepositoryServer(t *testing.T) {
 	})
 }
 
+func TestRepositoryServerListRepositoriesByProject(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(&argocdCM, &argocdSecret)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeclientset, testNamespace)
+	teamAProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: testNamespace},
+		Spec:       appsv1.AppProjectSpec{SourceRepos: []string{"https://github.com/team-a/*"}},
+	}
+	appLister, projLister := newAppAndProjLister(teamAProj)
+
+	newServer := func(t *testing.T) *Server {
+		t.Helper()
+		repoServerClient := &mocks.RepoServerServiceClient{}
+		repoServerClient.EXPECT().TestRepository(mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil).Maybe()
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: repoServerClient}
+		enforcer := newEnforcer(kubeclientset)
+		require.NoError(t, enforcer.SetUserPolicy(`p, role:team-a, projects, get, team-a, allow
+p, role:team-a, repositories, get, team-a/*, allow
+p, role:team-a, repositories, get, https://github.com/team-a/app, allow
+g, role:default, role:team-a`))
+		enforcer.SetDefaultRole("role:default")
+
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().ListRepositories(mock.Anything).Return([]*appsv1.Repository{
+			{Repo: "https://github.com/team-a/app"},
+			{Repo: "https://github.com/team-a/restricted"},
+			{Repo: "https://github.com/team-b/app"},
+			{Repo: "https://git.example.com/team-a", Project: "team-a"},
+			{Repo: "https://git.example.com/team-b", Project: "team-b"},
+		}, nil).Maybe()
+		db.EXPECT().GetProjectRepositories("team-a").Return([]*appsv1.Repository{{Repo: "https://git.example.com/team-a", Project: "team-a"}}, nil).Maybe()
+		db.EXPECT().GetProjectClusters(mock.Anything, "team-a").Return(nil, nil).Maybe()
+		db.EXPECT().GetRepository(mock.Anything, mock.Anything, mock.Anything).Return(&appsv1.Repository{}, nil).Maybe()
+		return NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+	}
+
+	t.Run("Test_ProjectAndPermittedGlobalRepositories", func(t *testing.T) {
+		resp, err := newServer(t).ListRepositoriesByProject(t.Context(), &repository.RepoQuery{AppProject: "team-a"})
+		require.NoError(t, err)
+		var urls []string
+		for _, repo := range resp.Items {
+			urls = append(urls, repo.Repo)
+		}
+		assert.Equal(t, []string{"https://github.com/team-a/app", "https://git.example.com/team-a"}, urls)
+	})
+
+	t.Run("Test_WithoutProjectPrivileges", func(t *testing.T) {
+		resp, err := newServer(t).ListRepositoriesByProject(t.Context(), &repository.RepoQuery{AppProject: "team-b"})
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Test_WithoutProject", func(t *testing.T) {
+		_, err := newServer(t).ListRepositoriesByProject(t.Context(), &repository.RepoQuery{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestRepositoryServerListApps(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset(&argocdCM, &argocdSecret)
 	settingsMgr := settings.NewSettingsManager(t.Context(), kubeclientset, testNamespace)
@@ -1155,3 +1270,15 @@ func TestDeleteRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestDetachedLoadContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	loadCtx, loadCancel := detachedLoadContext(ctx)
+	defer loadCancel()
+	cancel()
+
+	require.NoError(t, loadCtx.Err())
+	deadline, ok := loadCtx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(sharedLoadTimeout), deadline, time.Second)
+}
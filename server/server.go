@@ -96,6 +96,7 @@ import (
 	servercache "github.com/argoproj/argo-cd/v3/server/cache"
 	"github.com/argoproj/argo-cd/v3/server/certificate"
 	"github.com/argoproj/argo-cd/v3/server/cluster"
+	"github.com/argoproj/argo-cd/v3/server/diagnostics"
 	"github.com/argoproj/argo-cd/v3/server/extension"
 	"github.com/argoproj/argo-cd/v3/server/gpgkey"
 	"github.com/argoproj/argo-cd/v3/server/logout"
@@ -122,6 +123,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
+	metricsutil "github.com/argoproj/argo-cd/v3/util/metrics"
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
 	"github.com/argoproj/argo-cd/v3/util/notification/k8s"
 	settings_notif "github.com/argoproj/argo-cd/v3/util/notification/settings"
@@ -221,6 +223,7 @@ type ArgoCDServerOpts struct {
 	ListenHost              string
 	MetricsPort             int
 	MetricsHost             string
+	MetricsServerOpts       metricsutil.ServerOpts
 	Namespace               string
 	DexServerAddr           string
 	DexTLSConfig            *dexutil.DexTLSConfig
@@ -243,6 +246,15 @@ type ArgoCDServerOpts struct {
 	EnableK8sEvent          []string
 	HydratorEnabled         bool
 	SyncWithReplaceAllowed  bool
+	// ClusterRegistrationEnabled enables the reconciliation of Cluster resources into cluster secrets
+	ClusterRegistrationEnabled bool
+	// DiagnosticsAddresses are the Service names and metrics ports of the components, keyed by component name, whose
+	// runtime diagnostics are served by the API server
+	DiagnosticsAddresses map[string]string
+	// DiagnosticsMetricsClientOpts configures how the metrics endpoints of the components are requested
+	DiagnosticsMetricsClientOpts metricsutil.ClientOpts
+	// ReadOnly rejects all API requests which would change any state, and disables the terminal and the webhooks
+	ReadOnly bool
 }
 
 type ApplicationSetOpts struct {
@@ -555,6 +567,12 @@ func (server *ArgoCDServer) Init(ctx context.Context) {
 	go server.appsetInformer.Run(ctx.Done())
 	go server.configMapInformer.Run(ctx.Done())
 	go server.secretInformer.Run(ctx.Done())
+	if server.ClusterRegistrationEnabled {
+		// the controller runs in the replica of the API server which is elected as leader
+		go cluster.RunWithLeaderElection(ctx, server.Namespace, server.KubeClientset, func() *cluster.RegistrationController {
+			return cluster.NewRegistrationController(server.Namespace, server.KubeClientset, server.AppClientset, server.settingsMgr, server.Cache.GetClusterInfo)
+		})
+	}
 }
 
 // Run runs the API Server
@@ -570,6 +588,8 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 		}
 	}()
 	metricsServ := metrics.NewMetricsServer(server.MetricsHost, server.MetricsPort)
+	server.enf.SetCompilationObserver(metricsServ.ObserveRBACPolicyCompilationDuration)
+	server.MetricsServerOpts.ConfigureServer(metricsServ.Server)
 	if server.RedisClient != nil {
 		cacheutil.CollectMetrics(server.RedisClient, metricsServ, server.userStateStorage.GetLockObject())
 	}
@@ -655,7 +675,7 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	go server.watchSettings()
 	go server.rbacPolicyLoader(ctx)
 	go func() { server.checkServeErr("tcpm", tcpm.Serve()) }()
-	go func() { server.checkServeErr("metrics", metricsServ.Serve(listeners.Metrics)) }()
+	go func() { server.checkServeErr("metrics", metricsutil.Serve(metricsServ.Server, listeners.Metrics)) }()
 	if !cache.WaitForCacheSync(ctx.Done(), server.projInformer.HasSynced, server.appInformer.HasSynced) {
 		log.Fatal("Timed out waiting for project cache to sync")
 	}
@@ -955,6 +975,7 @@ func (server *ArgoCDServer) newGRPCServer(prometheusRegistry *prometheus.Registr
 		logging.StreamServerInterceptor(grpc_util.InterceptorLogger(server.log)),
 		serverMetrics.StreamServerInterceptor(),
 		grpc_auth.StreamServerInterceptor(server.Authenticate),
+		readOnlyStreamServerInterceptor(server.ReadOnly),
 		grpc_util.UserAgentStreamServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
 		grpc_util.PayloadStreamServerInterceptor(server.log, true, func(_ context.Context, c interceptors.CallMeta) bool {
 			return !sensitiveMethods[c.FullMethod()]
@@ -968,6 +989,7 @@ func (server *ArgoCDServer) newGRPCServer(prometheusRegistry *prometheus.Registr
 		logging.UnaryServerInterceptor(grpc_util.InterceptorLogger(server.log)),
 		serverMetrics.UnaryServerInterceptor(),
 		grpc_auth.UnaryServerInterceptor(server.Authenticate),
+		readOnlyUnaryServerInterceptor(server.ReadOnly),
 		grpc_util.UserAgentUnaryServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
 		grpc_util.PayloadUnaryServerInterceptor(server.log, true, func(_ context.Context, c interceptors.CallMeta) bool {
 			return !sensitiveMethods[c.FullMethod()]
@@ -1210,12 +1232,20 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	}
 	mux.Handle("/api/", handler)
 
-	terminalOpts := application.TerminalOptions{DisableAuth: server.DisableAuth, Enf: server.enf}
+	if !server.ReadOnly {
+		terminalOpts := application.TerminalOptions{DisableAuth: server.DisableAuth, Enf: server.enf}
+
+		terminal := application.NewHandler(server.appLister, server.Namespace, server.ApplicationNamespaces, server.db, appResourceTreeFn, server.settings.ExecShells, server.sessionMgr, &terminalOpts).
+			WithFeatureFlagMiddleware(server.settingsMgr.GetSettings)
+		th := util_session.WithAuthMiddleware(server.DisableAuth, server.settings.IsSSOConfigured(), server.ssoClientApp, server.sessionMgr, terminal)
+		mux.Handle("/terminal", th)
+	}
 
-	terminal := application.NewHandler(server.appLister, server.Namespace, server.ApplicationNamespaces, server.db, appResourceTreeFn, server.settings.ExecShells, server.sessionMgr, &terminalOpts).
-		WithFeatureFlagMiddleware(server.settingsMgr.GetSettings)
-	th := util_session.WithAuthMiddleware(server.DisableAuth, server.settings.IsSSOConfigured(), server.ssoClientApp, server.sessionMgr, terminal)
-	mux.Handle("/terminal", th)
+	extensionResourceHandler := application.NewExtensionResourceHandler(server.appLister, server.Namespace, server.ApplicationNamespaces, server.db, appResourceTreeFn, server.settingsMgr, kubeutil.NewKubectl())
+	mux.Handle(application.ExtensionResourceEndpoint, extensionResourceHandler)
+
+	diagnosticsHandler := diagnostics.NewHandler(server.enf, server.KubeClientset, server.Namespace, server.DiagnosticsAddresses, server.DiagnosticsMetricsClientOpts)
+	mux.Handle(diagnostics.URLPrefix+"/", util_session.WithAuthMiddleware(server.DisableAuth, server.settings.IsSSOConfigured(), server.ssoClientApp, server.sessionMgr, diagnosticsHandler))
 
 	// Proxy extension is currently an alpha feature and is disabled
 	// by default.
@@ -1248,10 +1278,12 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	server.registerDexHandlers(mux)
 
 	// Webhook handler for git events (Note: cache timeouts are hardcoded because API server does not write to cache and not really using them)
-	argoDB := db.NewDB(server.Namespace, server.settingsMgr, server.KubeClientset)
-	acdWebhookHandler := webhook.NewHandler(server.Namespace, server.ApplicationNamespaces, server.WebhookParallelism, server.AppClientset, server.appLister, server.settings, server.settingsMgr, server.RepoServerCache, server.Cache, argoDB, server.settingsMgr.GetMaxWebhookPayloadSize())
+	if !server.ReadOnly {
+		argoDB := db.NewDB(server.Namespace, server.settingsMgr, server.KubeClientset)
+		acdWebhookHandler := webhook.NewHandler(server.Namespace, server.ApplicationNamespaces, server.WebhookParallelism, server.AppClientset, server.appLister, server.settings, server.settingsMgr, server.RepoServerCache, server.Cache, argoDB, server.settingsMgr.GetMaxWebhookPayloadSize())
 
-	mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
+		mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
+	}
 
 	// Serve cli binaries directly from API server
 	registerDownloadHandlers(mux, "/download")
@@ -1299,6 +1331,10 @@ func registerExtensions(mux *http.ServeMux, a *ArgoCDServer, metricsReg HTTPMetr
 	authMiddleware := a.sessionMgr.AuthMiddlewareFunc(a.DisableAuth, a.settings.IsSSOConfigured(), a.ssoClientApp)
 	// auth middleware ensures that requests to all extensions are authenticated first
 	mux.Handle(extension.URLPrefix+"/", authMiddleware(extHandler))
+	if !a.ReadOnly {
+		registrationHandler := http.HandlerFunc(a.extensionManager.RegisterExtensionHandler())
+		mux.Handle(extension.RegistrationURL, authMiddleware(registrationHandler))
+	}
 
 	a.extensionManager.AddMetricsRegistry(metricsReg)
 
@@ -1308,6 +1344,12 @@ func registerExtensions(mux *http.ServeMux, a *ArgoCDServer, metricsReg HTTPMetr
 	}
 }
 
+// RegisterExtension registers the given proxy extension in addition to
+// the extensions configured in the argocd-cm configmap.
+func (server *ArgoCDServer) RegisterExtension(ext extension.ExtensionConfig) error {
+	return server.extensionManager.RegisterExtension(ext)
+}
+
 var extensionsPattern = regexp.MustCompile(`^extension(.*)\.js$`)
 
 func (server *ArgoCDServer) serveExtensions(extensionsSharedPath string, w http.ResponseWriter) {
@@ -1663,6 +1705,59 @@ func (bf *bug21955Workaround) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	bf.handler.ServeHTTP(w, r)
 }
 
+// readOnlyMethodPrefixes are the prefixes of the names of the API methods which do not change any state
+var readOnlyMethodPrefixes = []string{
+	"CanI",
+	"Generate",
+	"Get",
+	"List",
+	"ManagedResources",
+	"OperationLog",
+	"PodLogs",
+	"ResourceTree",
+	"RevisionChartDetails",
+	"RevisionMetadata",
+	"ServerSideDiff",
+	"SyncPlan",
+	"Version",
+	"Watch",
+}
+
+// isReadOnlyMethod returns true if the API method with the given full name does not change any state. Requests which
+// ask for an application refresh are not read-only, since the refresh is requested by annotating the application.
+func isReadOnlyMethod(fullMethod string, req any) bool {
+	if req, ok := req.(interface{ GetRefresh() string }); ok && req.GetRefresh() != "" {
+		return false
+	}
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// readOnlyUnaryServerInterceptor rejects requests to API methods which change any state if the server is read-only
+func readOnlyUnaryServerInterceptor(readOnly bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if readOnly && !isReadOnlyMethod(info.FullMethod, req) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s is not permitted: the API server is read-only", info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// readOnlyStreamServerInterceptor rejects streams of API methods which change any state if the server is read-only
+func readOnlyStreamServerInterceptor(readOnly bool) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if readOnly && !isReadOnlyMethod(info.FullMethod, nil) {
+			return status.Errorf(codes.PermissionDenied, "%s is not permitted: the API server is read-only", info.FullMethod)
+		}
+		return handler(srv, ss)
+	}
+}
+
 func bug21955WorkaroundInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 	switch req := req.(type) {
 	case *repositorypkg.RepoQuery:
@@ -18,10 +18,14 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
 	dynfake "k8s.io/client-go/dynamic/fake"
@@ -29,6 +33,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	apps "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
@@ -1710,3 +1715,24 @@ func Test_StaticAssetsDir_no_symlink_traversal(t *testing.T) {
 	resp = w.Result()
 	assert.Equal(t, http.StatusOK, resp.StatusCode, "should have been able to access the normal file")
 }
+
+func Test_readOnlyUnaryServerInterceptor(t *testing.T) {
+	handler := func(_ context.Context, _ any) (any, error) {
+		return "ok", nil
+	}
+	call := func(readOnly bool, method string, req any) error {
+		_, err := readOnlyUnaryServerInterceptor(readOnly)(t.Context(), req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	require.NoError(t, call(true, "/application.ApplicationService/Get", &applicationpkg.ApplicationQuery{}))
+	require.NoError(t, call(true, "/application.ApplicationService/ResourceTree", &applicationpkg.ResourcesQuery{}))
+	require.NoError(t, call(true, "/version.VersionService/Version", nil))
+
+	err := call(true, "/application.ApplicationService/Sync", &applicationpkg.ApplicationSyncRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = call(true, "/application.ApplicationService/Get", &applicationpkg.ApplicationQuery{Refresh: ptr.To("normal")})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.NoError(t, call(false, "/application.ApplicationService/Sync", &applicationpkg.ApplicationSyncRequest{}))
+}
@@ -2,11 +2,9 @@ package session
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/argoproj/argo-cd/v3/util/settings"
 
-	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -41,7 +39,7 @@ func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsMan
 
 // Create generates a JWT token signed by Argo CD intended for web/CLI logins of the admin user
 // using username/password
-func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*session.SessionResponse, error) {
+func (s *Server) Create(ctx context.Context, q *session.SessionCreateRequest) (*session.SessionResponse, error) {
 	if s.limitLoginAttempts != nil {
 		closer, err := s.limitLoginAttempts()
 		if err != nil {
@@ -64,20 +62,12 @@ func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*se
 		s.mgr.IncLoginRequestCounter(failure)
 		return nil, err
 	}
-	uniqueId, err := uuid.NewRandom()
-	if err != nil {
-		s.mgr.IncLoginRequestCounter(failure)
-		return nil, err
-	}
 	argoCDSettings, err := s.settingsMgr.GetSettings()
 	if err != nil {
 		s.mgr.IncLoginRequestCounter(failure)
 		return nil, err
 	}
-	jwtToken, err := s.mgr.Create(
-		fmt.Sprintf("%s:%s", q.Username, settings.AccountCapabilityLogin),
-		int64(argoCDSettings.UserSessionDuration.Seconds()),
-		uniqueId.String())
+	jwtToken, err := s.mgr.CreateLoginSession(ctx, q.Username, int64(argoCDSettings.UserSessionDuration.Seconds()))
 	if err != nil {
 		s.mgr.IncLoginRequestCounter(failure)
 		return nil, err
@@ -126,6 +126,23 @@ export const ApplicationCreatePanel = (props: {
         comboSwitchedFromPanel.current = false;
     }, []);
 
+    // repositories are only suggested once a project is selected, so that repositories of other projects are not exposed
+    const [reposInfo, setReposInfo] = React.useState<models.Repository[]>([]);
+    React.useEffect(() => {
+        if (!app.spec.project) {
+            setReposInfo([]);
+            return;
+        }
+        let cancelled = false;
+        services.repos
+            .listByProject(app.spec.project)
+            .catch(() => [] as models.Repository[])
+            .then(repos => !cancelled && setReposInfo(repos));
+        return () => {
+            cancelled = true;
+        };
+    }, [app.spec.project]);
+
     React.useEffect(() => {
         return () => {
             debouncedOnAppChanged.cancel();
@@ -193,11 +210,10 @@ export const ApplicationCreatePanel = (props: {
             load={() =>
                 Promise.all([
                     services.projects.list('items.metadata.name').then(projects => projects.map(proj => proj.metadata.name).sort()),
-                    services.clusters.list().then(clusters => clusters.sort()),
-                    services.repos.list()
-                ]).then(([projects, clusters, reposInfo]) => ({projects, clusters, reposInfo}))
+                    services.clusters.list().then(clusters => clusters.sort())
+                ]).then(([projects, clusters]) => ({projects, clusters}))
             }>
-            {({projects, clusters, reposInfo}) => {
+            {({projects, clusters}) => {
                 const repos = reposInfo.map(info => info.repo).sort();
                 const repoInfo = reposInfo.find(info => info.repo === app.spec.source.repoURL);
                 if (repoInfo) {
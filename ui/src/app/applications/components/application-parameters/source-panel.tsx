@@ -86,7 +86,7 @@ export const SourcePanel = (props: {
     }
 
     return (
-        <DataLoader key='add-new-source' load={() => Promise.all([services.repos.list()]).then(([reposInfo]) => ({reposInfo}))}>
+        <DataLoader key='add-new-source' load={() => Promise.all([services.repos.listByProject(props.appCurrent.spec.project)]).then(([reposInfo]) => ({reposInfo}))}>
             {({reposInfo}) => {
                 const repos = reposInfo.map(info => info.repo).sort();
                 return (
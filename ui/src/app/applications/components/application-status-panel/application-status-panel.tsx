@@ -98,8 +98,8 @@ const ProgressiveSyncStatus = ({application}: {application: models.Application})
                 return {appSet};
             }}>
             {({appSet}: {appSet: models.ApplicationSet}) => {
-                // Hide panel if: Progressive Sync disabled, no permission, or not RollingSync strategy
-                if (!appSet || !appSet.status?.applicationStatus || appSet?.spec?.strategy?.type !== 'RollingSync') {
+                // Hide panel if: Progressive Sync disabled, no permission, or not RollingSync or Canary strategy
+                if (!appSet || !appSet.status?.applicationStatus || !['RollingSync', 'Canary'].includes(appSet?.spec?.strategy?.type)) {
                     return null;
                 }
 
@@ -112,7 +112,7 @@ const ProgressiveSyncStatus = ({application}: {application: models.Application})
                         <div className='application-status-panel__item'>
                             {sectionHeader({
                                 title: 'PROGRESSIVE SYNC',
-                                helpContent: 'Shows the current status of progressive sync for applications managed by an ApplicationSet with RollingSync strategy.'
+                                helpContent: 'Shows the current status of progressive sync for applications managed by an ApplicationSet with RollingSync or Canary strategy.'
                             })}
                             <div className='application-status-panel__item-value'>
                                 <i className='fa fa-clock' style={{color: COLORS.sync.out_of_sync}} /> Waiting
@@ -129,7 +129,7 @@ const ProgressiveSyncStatus = ({application}: {application: models.Application})
                     <div className='application-status-panel__item'>
                         {sectionHeader({
                             title: 'PROGRESSIVE SYNC',
-                            helpContent: 'Shows the current status of progressive sync for applications managed by an ApplicationSet with RollingSync strategy.'
+                            helpContent: 'Shows the current status of progressive sync for applications managed by an ApplicationSet with RollingSync or Canary strategy.'
                         })}
                         <div className='application-status-panel__item-value' style={{color: getProgressiveSyncStatusColor(appResource.status)}}>
                             {getProgressiveSyncStatusIcon({status: appResource.status})}&nbsp;{appResource.status}
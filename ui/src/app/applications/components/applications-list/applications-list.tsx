@@ -4,8 +4,8 @@ import * as React from 'react';
 import * as ReactDOM from 'react-dom';
 import {Key, KeybindingContext, KeybindingProvider} from 'argo-ui/v2';
 import {RouteComponentProps} from 'react-router';
-import {combineLatest, from, merge, Observable} from 'rxjs';
-import {bufferTime, delay, filter, map, mergeMap, repeat, retryWhen} from 'rxjs/operators';
+import {combineLatest, defer, from, merge, Observable} from 'rxjs';
+import {bufferTime, delay, filter, map, mergeMap, repeat, retryWhen, tap} from 'rxjs/operators';
 import {AddAuthToToolbar, ClusterCtx, DataLoader, EmptyState, Page, Paginate, Spinner} from '../../../shared/components';
 import {AuthSettingsCtx, Consumer, Context, ContextApis} from '../../../shared/context';
 import * as models from '../../../shared/models';
@@ -51,18 +51,19 @@ const APP_FIELDS = [
     'status.resources'
 ];
 const APP_LIST_FIELDS = ['metadata.resourceVersion', ...APP_FIELDS.map(field => `items.${field}`)];
-const APP_WATCH_FIELDS = ['result.type', ...APP_FIELDS.map(field => `result.application.${field}`)];
+const APP_WATCH_FIELDS = ['result.type', 'result.application.metadata.resourceVersion', ...APP_FIELDS.map(field => `result.application.${field}`)];
 
 function loadApplications(projects: string[], appNamespace: string, objectListKind: string): Observable<models.AbstractApplication[]> {
-    return from(services.applications.list(projects, objectListKind, {appNamespace, fields: APP_LIST_FIELDS})).pipe(
+    // the watch is resumed from the last event received, and the applications are listed again if it cannot be resumed
+    return defer(() => from(services.applications.list(projects, objectListKind, {appNamespace, fields: APP_LIST_FIELDS}))).pipe(
         mergeMap(applicationsList => {
             const applications = applicationsList.items;
+            let resourceVersion = applicationsList.metadata.resourceVersion;
             return merge(
                 from([applications]),
-                services.applications
-                    .watch(objectListKind, {projects, resourceVersion: applicationsList.metadata.resourceVersion}, {fields: APP_WATCH_FIELDS})
+                defer(() => services.applications.watch(objectListKind, {projects, resourceVersion, watchFields: APP_FIELDS}, {fields: APP_WATCH_FIELDS}))
+                    .pipe(tap(appChange => (resourceVersion = appChange.application.metadata.resourceVersion || resourceVersion)))
                     .pipe(repeat())
-                    .pipe(retryWhen(errors => errors.pipe(delay(WATCH_RETRY_TIMEOUT))))
                     // batch events to avoid constant re-rendering and improve UI performance
                     .pipe(bufferTime(EVENTS_BUFFER_TIMEOUT))
                     .pipe(
@@ -90,7 +91,8 @@ function loadApplications(projects: string[], appNamespace: string, objectListKi
                     .pipe(filter(item => item.updated))
                     .pipe(map(item => item.applications))
             );
-        })
+        }),
+        retryWhen(errors => errors.pipe(delay(WATCH_RETRY_TIMEOUT)))
     );
 }
 
@@ -1141,6 +1141,10 @@ export const ResourceResultIcon = ({resource}: {resource: appModels.ResourceResu
             case appModels.ResultCodes.PruneSkipped:
                 icon = 'fa-heart';
                 break;
+            case appModels.ResultCodes.PruneConfirmationRequired:
+                color = COLORS.sync_result.pruned;
+                icon = 'fa-hand-paper';
+                break;
         }
         let title: string = resource.message;
         if (resource.message) {
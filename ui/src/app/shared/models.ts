@@ -133,13 +133,14 @@ export interface SyncOperationResult {
     revisions: string[];
 }
 
-export type ResultCode = 'Synced' | 'SyncFailed' | 'Pruned' | 'PruneSkipped';
+export type ResultCode = 'Synced' | 'SyncFailed' | 'Pruned' | 'PruneSkipped' | 'PruneConfirmationRequired';
 
 export const ResultCodes = {
     Synced: 'Synced',
     SyncFailed: 'SyncFailed',
     Pruned: 'Pruned',
-    PruneSkipped: 'PruneSkipped'
+    PruneSkipped: 'PruneSkipped',
+    PruneConfirmationRequired: 'PruneConfirmationRequired'
 };
 
 export interface ResourceResult {
@@ -1119,7 +1120,7 @@ export const AppDeletionConfirmedAnnotation = 'argocd.argoproj.io/deletion-appro
 
 export interface ApplicationSetSpec {
     strategy?: {
-        type: 'AllAtOnce' | 'RollingSync';
+        type: 'AllAtOnce' | 'RollingSync' | 'Canary';
         rollingSync?: {
             steps: Array<{
                 matchExpressions: Array<{
@@ -1128,8 +1129,20 @@ export interface ApplicationSetSpec {
                     values: string[];
                 }>;
                 maxUpdate: number;
+                analysis?: {
+                    cronJob?: string;
+                    prometheus?: {
+                        address: string;
+                        query: string;
+                    };
+                };
+                pauseAfter?: boolean;
             }>;
         };
+        canary?: {
+            weight?: number | string;
+            soakDuration?: string;
+        };
     };
 }
 
@@ -1155,6 +1168,14 @@ export interface ApplicationSetResource {
     labels?: {[key: string]: string};
 }
 
+export interface ApplicationSetSummary {
+    healthy?: number;
+    degraded?: number;
+    progressing?: number;
+    missing?: number;
+    outOfSync?: number;
+}
+
 export interface ApplicationSet extends AbstractApplication {
     spec: ApplicationSetSpec;
     status?: {
@@ -1166,7 +1187,15 @@ export interface ApplicationSet extends AbstractApplication {
             lastTransitionTime?: string;
             step?: string;
             targetRevisions?: string[];
+            stepAnalysis?: {
+                name: string;
+                phase: 'Running' | 'Successful' | 'Failed';
+                message?: string;
+                startedAt?: string;
+            };
+            resumed?: boolean;
         }>;
         resources?: ApplicationSetResource[];
+        summary?: ApplicationSetSummary;
     };
 }
@@ -207,7 +207,7 @@ export class ApplicationsService {
 
     public watch(
         objectListKind: string,
-        query?: {name?: string; resourceVersion?: string; projects?: string[]; appNamespace?: string},
+        query?: {name?: string; resourceVersion?: string; projects?: string[]; appNamespace?: string; watchFields?: string[]},
         options?: QueryOptions
     ): Observable<models.ApplicationWatchEvent> {
         const search = new URLSearchParams();
@@ -233,6 +233,9 @@ export class ApplicationsService {
                 query?.projects?.forEach(project => search.append('projects', project));
             }
         }
+        if (isApplication) {
+            query?.watchFields?.forEach(field => search.append('watchFields', field));
+        }
         const searchStr = search.toString();
         const url = `/stream${endpoint}${(searchStr && '?' + searchStr) || ''}`;
         return requests
@@ -68,6 +68,13 @@ export class RepositoriesService {
             .then(list => list.items || []);
     }
 
+    public listByProject(project: string): Promise<models.Repository[]> {
+        return requests
+            .get(`/projects/${encodeURIComponent(project)}/repositories`)
+            .then(res => res.body as models.RepositoryList)
+            .then(list => list.items || []);
+    }
+
     public listWrite(): Promise<models.Repository[]> {
         return requests
             .get(`/write-repositories`)
@@ -610,10 +610,27 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 	return conditions
 }
 
+func sourcePathNotPermittedCondition(source argoappv1.ApplicationSource, proj *argoappv1.AppProject) argoappv1.ApplicationCondition {
+	return argoappv1.ApplicationCondition{
+		Type:    argoappv1.ApplicationConditionInvalidSpecError,
+		Message: fmt.Sprintf("application path '%s' of repo %s is not permitted in project '%s'", source.Path, source.RepoURL, proj.Name),
+	}
+}
+
 // ValidatePermissions ensures that the referenced cluster has been added to Argo CD and the app source repo and destination namespace/cluster are permitted in app project
 func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
 	conditions := make([]argoappv1.ApplicationCondition, 0)
 
+	if spec.SyncPolicy != nil {
+		if err := spec.SyncPolicy.Verification.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid sync verification: %v", err),
+			})
+			return conditions, nil
+		}
+	}
+
 	switch {
 	case spec.SourceHydrator != nil:
 		condition := validateSourceHydrator(spec.SourceHydrator)
@@ -626,6 +643,8 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
 				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.SourceHydrator.GetDrySource().RepoURL, proj.Name),
 			})
+		} else if !proj.IsSourcePathPermitted(spec.SourceHydrator.GetDrySource()) {
+			conditions = append(conditions, sourcePathNotPermittedCondition(spec.SourceHydrator.GetDrySource(), proj))
 		}
 	case spec.HasMultipleSources():
 		for _, source := range spec.Sources {
@@ -640,6 +659,8 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 					Type:    argoappv1.ApplicationConditionInvalidSpecError,
 					Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, proj.Name),
 				})
+			} else if !proj.IsSourcePathPermitted(source) {
+				conditions = append(conditions, sourcePathNotPermittedCondition(source, proj))
 			}
 		}
 	default:
@@ -653,6 +674,8 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
 				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.GetSource().RepoURL, proj.Name),
 			})
+		} else if !proj.IsSourcePathPermitted(spec.GetSource()) {
+			conditions = append(conditions, sourcePathNotPermittedCondition(spec.GetSource(), proj))
 		}
 	}
 
@@ -683,6 +706,41 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 	return conditions, nil
 }
 
+// ValidateAppNamespaceDestination ensures that the application destination is permitted by the settings of the
+// application namespace, in addition to the destinations permitted by the application project
+func ValidateAppNamespaceDestination(ctx context.Context, appNamespace string, spec *argoappv1.ApplicationSpec, settingsMgr *settings.SettingsManager, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
+	nsSettings, err := settingsMgr.GetApplicationNamespaceSettings(appNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application namespace settings: %w", err)
+	}
+	if nsSettings == nil || len(nsSettings.Destinations) == 0 {
+		return nil, nil
+	}
+	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
+	if err != nil {
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: err.Error(),
+		}}, nil
+	}
+	nsProj := argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{Destinations: nsSettings.Destinations}}
+	permitted, err := nsProj.IsDestinationPermitted(destCluster, spec.Destination.Namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+	if permitted {
+		return nil, nil
+	}
+	server := destCluster.Server
+	if spec.Destination.Name != "" {
+		server = destCluster.Name
+	}
+	return []argoappv1.ApplicationCondition{{
+		Type:    argoappv1.ApplicationConditionInvalidSpecError,
+		Message: fmt.Sprintf("application destination server '%s' and namespace '%s' do not match any of the allowed destinations of namespace '%s'", server, spec.Destination.Namespace, appNamespace),
+	}}, nil
+}
+
 // APIResourcesToStrings converts list of API Resources list into string list
 func APIResourcesToStrings(resources []kube.APIResourceInfo, includeKinds bool) []string {
 	resMap := map[string]bool{}
@@ -799,6 +857,15 @@ func verifyGenerateManifests(
 		return conditions // Can't perform the next check without settings.
 	}
 
+	sopsEnv, err := GetSOPSEnv(settingsMgr, proj)
+	if err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("Error getting SOPS keys: %v", err),
+		})
+		return conditions
+	}
+
 	for _, source := range sources {
 		repoRes, err := db.GetRepository(ctx, source.RepoURL, proj.Name)
 		if err != nil {
@@ -879,6 +946,8 @@ func verifyGenerateManifests(
 			RefSources:                      refSources,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
+			ProjectSourcePaths:              proj.GetSourcePaths(),
+			SopsEnv:                         sopsEnv,
 			AnnotationManifestGeneratePaths: app.GetAnnotation(argoappv1.AnnotationKeyManifestGeneratePaths),
 			InstallationID:                  installationID,
 		}
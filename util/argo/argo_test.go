@@ -304,6 +304,22 @@ func TestValidatePermissionsEmptyDestination(t *testing.T) {
 	assert.ElementsMatch(t, conditions, []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: "Destination server missing from app spec"}})
 }
 
+func TestValidatePermissionsInvalidSyncVerification(t *testing.T) {
+	conditions, err := ValidatePermissions(t.Context(), &argoappv1.ApplicationSpec{
+		Source: &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		SyncPolicy: &argoappv1.SyncPolicy{Verification: &argoappv1.SyncPolicyVerification{Probes: []argoappv1.SyncVerificationProbe{{
+			Name: "smoke",
+			HTTP: &argoappv1.SyncVerificationHTTPProbe{URL: "http://my-app.default.svc"},
+			GRPC: &argoappv1.SyncVerificationGRPCProbe{Address: "my-app.default.svc:9090"},
+		}}}},
+	}, &argoappv1.AppProject{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []argoappv1.ApplicationCondition{{
+		Type:    argoappv1.ApplicationConditionInvalidSpecError,
+		Message: `invalid sync verification: probe "smoke": only one of http and grpc may be set`,
+	}}, conditions)
+}
+
 func TestValidateChartWithoutRevision(t *testing.T) {
 	appSpec := &argoappv1.ApplicationSpec{
 		Source: &argoappv1.ApplicationSource{RepoURL: "https://charts.helm.sh/incubator/", Chart: "myChart", TargetRevision: ""},
@@ -784,6 +800,45 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "application repo http://some/where is not permitted")
 	})
 
+	t.Run("Application source path is not permitted in project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Path:           "apps/team-b",
+				TargetRevision: "HEAD",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		}
+		proj := argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"*"},
+				SourcePaths: []argoappv1.SourcePathRestriction{{RepoURL: "http://some/where", Paths: []string{"apps/team-a/**"}}},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(cluster, nil).Maybe()
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, "application path 'apps/team-b' of repo http://some/where is not permitted in project 'team-a'", conditions[0].Message)
+
+		spec.Source.Path = "apps/team-a/frontend"
+		conditions, err = ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Empty(t, conditions)
+	})
+
 	t.Run("Application destination is not permitted in project", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
 			Source: &argoappv1.ApplicationSource{
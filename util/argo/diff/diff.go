@@ -23,7 +23,8 @@ import (
 
 // DiffConfigBuilder is used as a safe way to create valid DiffConfigs.
 type DiffConfigBuilder struct {
-	diffConfig *diffConfig
+	diffConfig          *diffConfig
+	serverSideDryRunner diff.ServerSideDryRunner
 }
 
 // NewDiffConfigBuilder create a new DiffConfigBuilder instance.
@@ -31,6 +32,7 @@ func NewDiffConfigBuilder() *DiffConfigBuilder {
 	return &DiffConfigBuilder{
 		diffConfig: &diffConfig{
 			ignoreMutationWebhook: true,
+			diffEngine:            NewClientSideDiffEngine(),
 		},
 	}
 }
@@ -100,13 +102,34 @@ func (b *DiffConfigBuilder) WithManager(manager string) *DiffConfigBuilder {
 	return b
 }
 
+// WithDiffEngine defines the engine calculating the diffs. Defaults to the client-side
+// diff engine.
+func (b *DiffConfigBuilder) WithDiffEngine(engine DiffEngine) *DiffConfigBuilder {
+	b.diffConfig.diffEngine = engine
+	return b
+}
+
+// WithServerSideDryRunner sets the dry runner of the server-side diff engine.
+//
+// Deprecated: use WithDiffEngine with NewServerSideDiffEngine instead.
 func (b *DiffConfigBuilder) WithServerSideDryRunner(ssdr diff.ServerSideDryRunner) *DiffConfigBuilder {
-	b.diffConfig.serverSideDryRunner = ssdr
+	b.serverSideDryRunner = ssdr
+	if _, ok := b.diffConfig.diffEngine.(*serverSideDiffEngine); ok {
+		b.diffConfig.diffEngine = NewServerSideDiffEngine(ssdr)
+	}
 	return b
 }
 
+// WithServerSideDiff selects the server-side diff engine, using the dry runner set with
+// WithServerSideDryRunner, or the client-side diff engine.
+//
+// Deprecated: use WithDiffEngine instead.
 func (b *DiffConfigBuilder) WithServerSideDiff(ssd bool) *DiffConfigBuilder {
-	b.diffConfig.serverSideDiff = ssd
+	if ssd {
+		b.diffConfig.diffEngine = NewServerSideDiffEngine(b.serverSideDryRunner)
+	} else {
+		b.diffConfig.diffEngine = NewClientSideDiffEngine()
+	}
 	return b
 }
 
@@ -161,8 +184,8 @@ type DiffConfig interface {
 	// calculating the structured merge diff.
 	Manager() string
 
-	ServerSideDiff() bool
-	ServerSideDryRunner() diff.ServerSideDryRunner
+	// DiffEngine returns the engine calculating the diffs.
+	DiffEngine() DiffEngine
 	IgnoreMutationWebhook() bool
 
 	IgnoreNormalizerOpts() normalizers.IgnoreNormalizerOpts
@@ -182,8 +205,7 @@ type diffConfig struct {
 	gvkParser             *k8smanagedfields.GvkParser
 	structuredMergeDiff   bool
 	manager               string
-	serverSideDiff        bool
-	serverSideDryRunner   diff.ServerSideDryRunner
+	diffEngine            DiffEngine
 	ignoreMutationWebhook bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
 }
@@ -236,12 +258,8 @@ func (c *diffConfig) Manager() string {
 	return c.manager
 }
 
-func (c *diffConfig) ServerSideDryRunner() diff.ServerSideDryRunner {
-	return c.serverSideDryRunner
-}
-
-func (c *diffConfig) ServerSideDiff() bool {
-	return c.serverSideDiff
+func (c *diffConfig) DiffEngine() DiffEngine {
+	return c.diffEngine
 }
 
 func (c *diffConfig) IgnoreMutationWebhook() bool {
@@ -270,7 +288,10 @@ func (c *diffConfig) Validate() error {
 			return fmt.Errorf("%s: StateCache must be set when retrieving from cache", msg)
 		}
 	}
-	if c.serverSideDiff && c.serverSideDryRunner == nil {
+	if c.diffEngine == nil {
+		return fmt.Errorf("%s: DiffEngine can not be nil", msg)
+	}
+	if e, ok := c.diffEngine.(*serverSideDiffEngine); ok && e.dryRunner == nil {
 		return fmt.Errorf("%s: serverSideDryRunner must be set when using server side diff", msg)
 	}
 	return nil
@@ -314,8 +335,6 @@ func StateDiffs(lives, configs []*unstructured.Unstructured, diffConfig DiffConf
 		diff.WithStructuredMergeDiff(diffConfig.StructuredMergeDiff()),
 		diff.WithGVKParser(diffConfig.GVKParser()),
 		diff.WithManager(diffConfig.Manager()),
-		diff.WithServerSideDiff(diffConfig.ServerSideDiff()),
-		diff.WithServerSideDryRunner(diffConfig.ServerSideDryRunner()),
 		diff.WithIgnoreMutationWebhook(diffConfig.IgnoreMutationWebhook()),
 	}
 
@@ -325,20 +344,20 @@ func StateDiffs(lives, configs []*unstructured.Unstructured, diffConfig DiffConf
 
 	useCache, cachedDiff := diffConfig.DiffFromCache(diffConfig.AppName())
 	if useCache && cachedDiff != nil {
-		cached, err := diffArrayCached(normResults.Targets, normResults.Lives, cachedDiff, diffOpts...)
+		cached, err := diffArrayCached(diffConfig.DiffEngine(), normResults.Targets, normResults.Lives, cachedDiff, diffOpts...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to calculate diff from cache: %w", err)
 		}
 		return cached, nil
 	}
-	array, err := diff.DiffArray(normResults.Targets, normResults.Lives, diffOpts...)
+	array, err := diffArray(diffConfig.DiffEngine(), normResults.Targets, normResults.Lives, diffOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate diff: %w", err)
 	}
 	return array, nil
 }
 
-func diffArrayCached(configArray []*unstructured.Unstructured, liveArray []*unstructured.Unstructured, cachedDiff []*v1alpha1.ResourceDiff, opts ...diff.Option) (*diff.DiffResultList, error) {
+func diffArrayCached(engine DiffEngine, configArray []*unstructured.Unstructured, liveArray []*unstructured.Unstructured, cachedDiff []*v1alpha1.ResourceDiff, opts ...diff.Option) (*diff.DiffResultList, error) {
 	numItems := len(configArray)
 	if len(liveArray) != numItems {
 		return nil, errors.New("left and right arrays have mismatched lengths")
@@ -372,7 +391,7 @@ func diffArrayCached(configArray []*unstructured.Unstructured, liveArray []*unst
 				Modified:       cachedDiff.Modified,
 			}
 		} else {
-			res, err := diff.Diff(configArray[i], liveArray[i], opts...)
+			res, err := engine.Diff(configArray[i], liveArray[i], opts...)
 			if err != nil {
 				return nil, err
 			}
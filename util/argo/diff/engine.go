@@ -0,0 +1,106 @@
+package diff
+
+import (
+	"errors"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// DiffEngineClientSide is the name of the engine calculating the diff locally, with a three-way merge or, if
+	// enabled, a structured merge diff.
+	DiffEngineClientSide = "client-side"
+	// DiffEngineServerSide is the name of the engine calculating the diff from a server-side apply dry-run against
+	// the destination cluster, so that defaults and changes of admission webhooks are part of the predicted state.
+	DiffEngineServerSide = "server-side"
+)
+
+// DiffEngine calculates the diff between the normalized desired state and the live state of a resource.
+type DiffEngine interface {
+	// Name returns the name of the engine.
+	Name() string
+	// Diff returns the diff between the config and the live state of a resource. Either of them may be nil.
+	Diff(config, live *unstructured.Unstructured, opts ...diff.Option) (*diff.DiffResult, error)
+}
+
+// ApplicationDiffEngine returns the name of the engine calculating the diff of the application. The engine is
+// selected with the DiffEngine compare option of the application, or else with its ServerSideDiff compare option.
+// Returns defaultEngine if the application selects none.
+func ApplicationDiffEngine(app *v1alpha1.Application, defaultEngine string) string {
+	for _, engine := range []string{DiffEngineServerSide, DiffEngineClientSide} {
+		if resourceutil.HasAnnotationOption(app, common.AnnotationCompareOptions, "DiffEngine="+engine) {
+			return engine
+		}
+	}
+	if resourceutil.HasAnnotationOption(app, common.AnnotationCompareOptions, "ServerSideDiff=false") {
+		return DiffEngineClientSide
+	}
+	if resourceutil.HasAnnotationOption(app, common.AnnotationCompareOptions, "ServerSideDiff=true") {
+		return DiffEngineServerSide
+	}
+	return defaultEngine
+}
+
+type clientSideDiffEngine struct{}
+
+// NewClientSideDiffEngine returns a DiffEngine calculating the diff locally.
+func NewClientSideDiffEngine() DiffEngine {
+	return clientSideDiffEngine{}
+}
+
+func (clientSideDiffEngine) Name() string {
+	return DiffEngineClientSide
+}
+
+func (clientSideDiffEngine) Diff(config, live *unstructured.Unstructured, opts ...diff.Option) (*diff.DiffResult, error) {
+	return diff.Diff(config, live, append(opts, diff.WithServerSideDiff(false))...)
+}
+
+type serverSideDiffEngine struct {
+	dryRunner diff.ServerSideDryRunner
+}
+
+// NewServerSideDiffEngine returns a DiffEngine calculating the diff from server-side apply dry-runs made with the
+// given dry runner.
+func NewServerSideDiffEngine(dryRunner diff.ServerSideDryRunner) DiffEngine {
+	return &serverSideDiffEngine{dryRunner: dryRunner}
+}
+
+func (e *serverSideDiffEngine) Name() string {
+	return DiffEngineServerSide
+}
+
+func (e *serverSideDiffEngine) Diff(config, live *unstructured.Unstructured, opts ...diff.Option) (*diff.DiffResult, error) {
+	if e.dryRunner == nil {
+		return nil, errors.New("server-side diff engine requires a dry runner")
+	}
+	return diff.Diff(config, live, append(opts, diff.WithServerSideDiff(true), diff.WithServerSideDryRunner(e.dryRunner))...)
+}
+
+// diffArray calculates the diffs of the pairs of config and live states with the given engine.
+func diffArray(engine DiffEngine, configArray, liveArray []*unstructured.Unstructured, opts ...diff.Option) (*diff.DiffResultList, error) {
+	numItems := len(configArray)
+	if len(liveArray) != numItems {
+		return nil, errors.New("left and right arrays have mismatched lengths")
+	}
+
+	diffResultList := diff.DiffResultList{
+		Diffs: make([]diff.DiffResult, numItems),
+	}
+	for i := 0; i < numItems; i++ {
+		dr, err := engine.Diff(configArray[i], liveArray[i], opts...)
+		if err != nil {
+			return nil, err
+		}
+		diffResultList.Diffs[i] = *dr
+		if dr.Modified {
+			diffResultList.Modified = true
+		}
+	}
+	return &diffResultList, nil
+}
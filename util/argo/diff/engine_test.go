@@ -0,0 +1,145 @@
+package diff_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argo "github.com/argoproj/argo-cd/v3/util/argo/diff"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+)
+
+// fakeDryRunner mimics a mutating admission webhook by setting the data of the applied object
+type fakeDryRunner struct {
+	data map[string]any
+}
+
+func (f *fakeDryRunner) Run(_ context.Context, obj *unstructured.Unstructured, _ string) (string, error) {
+	predicted := obj.DeepCopy()
+	for k, v := range f.data {
+		_ = unstructured.SetNestedField(predicted.Object, v, "data", k)
+	}
+	b, err := json.Marshal(predicted)
+	return string(b), err
+}
+
+func newConfigMap(data map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":      "my-config",
+			"namespace": "default",
+		},
+		"data": data,
+	}}
+}
+
+func TestStateDiffs_DiffEngine(t *testing.T) {
+	diffConfig := func(t *testing.T, engine argo.DiffEngine) argo.DiffConfig {
+		t.Helper()
+		diffConfig, err := argo.NewDiffConfigBuilder().
+			WithDiffSettings([]v1alpha1.ResourceIgnoreDifferences{}, map[string]v1alpha1.ResourceOverride{}, false, normalizers.IgnoreNormalizerOpts{}).
+			WithNoCache().
+			WithIgnoreMutationWebhook(false).
+			WithDiffEngine(engine).
+			Build()
+		require.NoError(t, err)
+		return diffConfig
+	}
+	config := newConfigMap(map[string]any{"foo": "bar"})
+	live := newConfigMap(map[string]any{"foo": "bar", "mutated": "true"})
+
+	t.Run("client-side engine diffs locally", func(t *testing.T) {
+		result, err := argo.StateDiffs([]*unstructured.Unstructured{live.DeepCopy()}, []*unstructured.Unstructured{config.DeepCopy()}, diffConfig(t, argo.NewClientSideDiffEngine()))
+		require.NoError(t, err)
+		require.Len(t, result.Diffs, 1)
+		assert.False(t, result.Modified)
+	})
+	t.Run("server-side engine predicts mutations with a dry-run", func(t *testing.T) {
+		engine := argo.NewServerSideDiffEngine(&fakeDryRunner{data: map[string]any{"mutated": "true"}})
+		result, err := argo.StateDiffs([]*unstructured.Unstructured{live.DeepCopy()}, []*unstructured.Unstructured{config.DeepCopy()}, diffConfig(t, engine))
+		require.NoError(t, err)
+		require.Len(t, result.Diffs, 1)
+		assert.False(t, result.Modified)
+		assert.Contains(t, string(result.Diffs[0].PredictedLive), "mutated")
+	})
+	t.Run("server-side engine detects drift", func(t *testing.T) {
+		engine := argo.NewServerSideDiffEngine(&fakeDryRunner{data: map[string]any{"mutated": "false"}})
+		result, err := argo.StateDiffs([]*unstructured.Unstructured{live.DeepCopy()}, []*unstructured.Unstructured{config.DeepCopy()}, diffConfig(t, engine))
+		require.NoError(t, err)
+		assert.True(t, result.Modified)
+	})
+}
+
+func TestDiffConfigBuilder_ServerSideDiffEngineRequiresDryRunner(t *testing.T) {
+	_, err := argo.NewDiffConfigBuilder().
+		WithDiffSettings([]v1alpha1.ResourceIgnoreDifferences{}, map[string]v1alpha1.ResourceOverride{}, false, normalizers.IgnoreNormalizerOpts{}).
+		WithNoCache().
+		WithDiffEngine(argo.NewServerSideDiffEngine(nil)).
+		Build()
+	require.ErrorContains(t, err, "serverSideDryRunner must be set")
+}
+
+func TestDiffConfigBuilder_DeprecatedServerSideDiff(t *testing.T) {
+	newBuilder := func() *argo.DiffConfigBuilder {
+		return argo.NewDiffConfigBuilder().
+			WithDiffSettings([]v1alpha1.ResourceIgnoreDifferences{}, map[string]v1alpha1.ResourceOverride{}, false, normalizers.IgnoreNormalizerOpts{}).
+			WithNoCache()
+	}
+	dryRunner := &fakeDryRunner{}
+
+	//nolint:staticcheck // the deprecated options are still supported
+	config, err := newBuilder().WithServerSideDiff(true).WithServerSideDryRunner(dryRunner).Build()
+	require.NoError(t, err)
+	assert.Equal(t, argo.DiffEngineServerSide, config.DiffEngine().Name())
+
+	//nolint:staticcheck // the deprecated options are still supported
+	config, err = newBuilder().WithServerSideDryRunner(dryRunner).WithServerSideDiff(true).Build()
+	require.NoError(t, err)
+	assert.Equal(t, argo.DiffEngineServerSide, config.DiffEngine().Name())
+
+	//nolint:staticcheck // the deprecated options are still supported
+	config, err = newBuilder().WithServerSideDryRunner(dryRunner).WithServerSideDiff(false).Build()
+	require.NoError(t, err)
+	assert.Equal(t, argo.DiffEngineClientSide, config.DiffEngine().Name())
+
+	//nolint:staticcheck // the deprecated options are still supported
+	_, err = newBuilder().WithServerSideDiff(true).Build()
+	require.ErrorContains(t, err, "serverSideDryRunner must be set")
+}
+
+func TestApplicationDiffEngine(t *testing.T) {
+	app := func(compareOptions string) *v1alpha1.Application {
+		return &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{common.AnnotationCompareOptions: compareOptions},
+		}}
+	}
+	testCases := []struct {
+		name           string
+		compareOptions string
+		defaultEngine  string
+		expected       string
+	}{
+		{"default", "", argo.DiffEngineClientSide, argo.DiffEngineClientSide},
+		{"default server-side", "", argo.DiffEngineServerSide, argo.DiffEngineServerSide},
+		{"diff engine option", "DiffEngine=server-side", argo.DiffEngineClientSide, argo.DiffEngineServerSide},
+		{"diff engine option overrides default", "DiffEngine=client-side", argo.DiffEngineServerSide, argo.DiffEngineClientSide},
+		{"diff engine option overrides server-side diff option", "DiffEngine=client-side,ServerSideDiff=true", argo.DiffEngineClientSide, argo.DiffEngineClientSide},
+		{"server-side diff option", "ServerSideDiff=true", argo.DiffEngineClientSide, argo.DiffEngineServerSide},
+		{"server-side diff option disabled", "ServerSideDiff=false", argo.DiffEngineServerSide, argo.DiffEngineClientSide},
+		{"unknown diff engine", "DiffEngine=unknown", argo.DiffEngineClientSide, argo.DiffEngineClientSide},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, argo.ApplicationDiffEngine(app(tc.compareOptions), tc.defaultEngine))
+		})
+	}
+}
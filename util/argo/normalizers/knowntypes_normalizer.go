@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	log "github.com/sirupsen/logrus"
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -22,12 +21,53 @@ var knownTypes = map[string]func() any{}
 type knownTypeField struct {
 	fieldPath  []string
 	newFieldFn func() any
+	// builtIn is true for fields configured by defaultKnownTypeFields. Built-in fields that fail to normalize are skipped
+	// instead of failing the diff since the CRD version installed in the cluster might not match the expected schema.
+	builtIn bool
 }
 
 type knownTypesNormalizer struct {
 	typeFields map[schema.GroupKind][]knownTypeField
 }
 
+// monitoringKnownTypeFields are the fields shared by the workloads managed by the Prometheus Operator
+var monitoringKnownTypeFields = []v1alpha1.KnownTypeField{
+	{Field: "spec.resources", Type: "core/v1/ResourceRequirements"},
+	{Field: "spec.containers", Type: "core/v1/Container"},
+	{Field: "spec.initContainers", Type: "core/v1/Container"},
+	{Field: "spec.storage.emptyDir.sizeLimit", Type: "core/Quantity"},
+	{Field: "spec.storage.volumeClaimTemplate.spec", Type: "core/v1/PersistentVolumeClaimSpec"},
+}
+
+// defaultKnownTypeFields configures the known type fields of popular CRDs. The defaults of a group kind are ignored if
+// the group kind is configured using resource.customizations.knownTypeFields.
+var defaultKnownTypeFields = map[schema.GroupKind][]v1alpha1.KnownTypeField{
+	{Group: application.Group, Kind: "Rollout"}: {
+		{Field: "spec.template.spec", Type: "core/v1/PodSpec"},
+	},
+	{Group: "monitoring.coreos.com", Kind: "Prometheus"}:      monitoringKnownTypeFields,
+	{Group: "monitoring.coreos.com", Kind: "PrometheusAgent"}: monitoringKnownTypeFields,
+	{Group: "monitoring.coreos.com", Kind: "Alertmanager"}:    monitoringKnownTypeFields,
+	{Group: "monitoring.coreos.com", Kind: "ThanosRuler"}:     monitoringKnownTypeFields,
+	{Group: "cert-manager.io", Kind: "Certificate"}: {
+		{Field: "spec.duration", Type: "meta/v1/Duration"},
+		{Field: "spec.renewBefore", Type: "meta/v1/Duration"},
+	},
+	{Group: "cert-manager.io", Kind: "CertificateRequest"}: {
+		{Field: "spec.duration", Type: "meta/v1/Duration"},
+	},
+	{Group: "external-secrets.io", Kind: "ExternalSecret"}: {
+		{Field: "spec.refreshInterval", Type: "meta/v1/Duration"},
+	},
+	{Group: "external-secrets.io", Kind: "ClusterExternalSecret"}: {
+		{Field: "spec.refreshTime", Type: "meta/v1/Duration"},
+		{Field: "spec.externalSecretSpec.refreshInterval", Type: "meta/v1/Duration"},
+	},
+	{Group: "external-secrets.io", Kind: "PushSecret"}: {
+		{Field: "spec.refreshInterval", Type: "meta/v1/Duration"},
+	},
+}
+
 // Register some non-code-generated types here. The bulk of them are in corev1_known_types.go
 func init() {
 	knownTypes["core/Quantity"] = func() any {
@@ -48,7 +88,7 @@ func NewKnownTypesNormalizer(overrides map[string]v1alpha1.ResourceOverride) (*k
 		}
 		gk := schema.GroupKind{Group: group, Kind: kind}
 		for _, f := range override.KnownTypeFields {
-			if err := normalizer.addKnownField(gk, f.Field, f.Type); err != nil {
+			if err := normalizer.addKnownField(gk, f.Field, f.Type, false); err != nil {
 				log.Warnf("Failed to configure known field normalizer: %v", err)
 			}
 		}
@@ -58,18 +98,19 @@ func NewKnownTypesNormalizer(overrides map[string]v1alpha1.ResourceOverride) (*k
 }
 
 func (n *knownTypesNormalizer) ensureDefaultCRDsConfigured() {
-	rolloutGK := schema.GroupKind{Group: application.Group, Kind: "Rollout"}
-	if _, ok := n.typeFields[rolloutGK]; !ok {
-		n.typeFields[rolloutGK] = []knownTypeField{{
-			fieldPath: []string{"spec", "template", "spec"},
-			newFieldFn: func() any {
-				return &corev1.PodSpec{}
-			},
-		}}
+	for gk, fields := range defaultKnownTypeFields {
+		if _, ok := n.typeFields[gk]; ok {
+			continue
+		}
+		for _, f := range fields {
+			if err := n.addKnownField(gk, f.Field, f.Type, true); err != nil {
+				log.Warnf("Failed to configure default known field normalizer: %v", err)
+			}
+		}
 	}
 }
 
-func (n *knownTypesNormalizer) addKnownField(gk schema.GroupKind, fieldPath string, typePath string) error {
+func (n *knownTypesNormalizer) addKnownField(gk schema.GroupKind, fieldPath string, typePath string, builtIn bool) error {
 	newFieldFn, ok := knownTypes[typePath]
 	if !ok {
 		return fmt.Errorf("type '%s' is not supported", typePath)
@@ -77,6 +118,7 @@ func (n *knownTypesNormalizer) addKnownField(gk schema.GroupKind, fieldPath stri
 	n.typeFields[gk] = append(n.typeFields[gk], knownTypeField{
 		fieldPath:  strings.Split(fieldPath, "."),
 		newFieldFn: newFieldFn,
+		builtIn:    builtIn,
 	})
 	return nil
 }
@@ -155,6 +197,10 @@ func (n *knownTypesNormalizer) Normalize(un *unstructured.Unstructured) error {
 		for _, field := range fields {
 			err := normalize(un.Object, field, field.fieldPath)
 			if err != nil {
+				if field.builtIn {
+					log.Debugf("Skipping normalization of field '%s' of %s/%s: %v", strings.Join(field.fieldPath, "."), un.GetKind(), un.GetName(), err)
+					continue
+				}
 				return err
 			}
 		}
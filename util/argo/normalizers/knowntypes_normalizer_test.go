@@ -258,6 +258,95 @@ func TestRolloutPreConfigured(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestDefaultKnownTypeFields(t *testing.T) {
+	normalizer, err := NewKnownTypesNormalizer(map[string]v1alpha1.ResourceOverride{})
+	require.NoError(t, err)
+
+	t.Run("Prometheus", func(t *testing.T) {
+		prometheus := mustUnmarshalYAML(`
+apiVersion: monitoring.coreos.com/v1
+kind: Prometheus
+metadata:
+  name: prometheus
+spec:
+  resources:
+    requests:
+      memory: 1024Mi
+      cpu: 0.5
+  storage:
+    volumeClaimTemplate:
+      spec:
+        resources:
+          requests:
+            storage: 10240Mi
+`)
+		require.NoError(t, normalizer.Normalize(prometheus))
+
+		memory, _, err := unstructured.NestedString(prometheus.Object, "spec", "resources", "requests", "memory")
+		require.NoError(t, err)
+		assert.Equal(t, "1Gi", memory)
+		cpu, _, err := unstructured.NestedString(prometheus.Object, "spec", "resources", "requests", "cpu")
+		require.NoError(t, err)
+		assert.Equal(t, "500m", cpu)
+		storage, _, err := unstructured.NestedString(prometheus.Object, "spec", "storage", "volumeClaimTemplate", "spec", "resources", "requests", "storage")
+		require.NoError(t, err)
+		assert.Equal(t, "10Gi", storage)
+	})
+
+	t.Run("ExternalSecret", func(t *testing.T) {
+		externalSecret := mustUnmarshalYAML(`
+apiVersion: external-secrets.io/v1
+kind: ExternalSecret
+metadata:
+  name: secret
+spec:
+  refreshInterval: 1h
+`)
+		require.NoError(t, normalizer.Normalize(externalSecret))
+
+		refreshInterval, _, err := unstructured.NestedString(externalSecret.Object, "spec", "refreshInterval")
+		require.NoError(t, err)
+		assert.Equal(t, "1h0m0s", refreshInterval)
+	})
+
+	t.Run("InvalidValueIsSkipped", func(t *testing.T) {
+		cert := mustUnmarshalYAML(`
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: my-cert
+spec:
+  duration: 1y
+  renewBefore: 360h
+`)
+		require.NoError(t, normalizer.Normalize(cert))
+
+		duration, _, err := unstructured.NestedString(cert.Object, "spec", "duration")
+		require.NoError(t, err)
+		assert.Equal(t, "1y", duration)
+		renewBefore, _, err := unstructured.NestedString(cert.Object, "spec", "renewBefore")
+		require.NoError(t, err)
+		assert.Equal(t, "360h0m0s", renewBefore)
+	})
+}
+
+func TestDefaultKnownTypeFields_Overridden(t *testing.T) {
+	normalizer, err := NewKnownTypesNormalizer(map[string]v1alpha1.ResourceOverride{
+		"cert-manager.io/Certificate": {
+			KnownTypeFields: []v1alpha1.KnownTypeField{{
+				Type:  "meta/v1/Duration",
+				Field: "spec.duration",
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	fields := normalizer.typeFields[schema.GroupKind{Group: "cert-manager.io", Kind: "Certificate"}]
+	require.Len(t, fields, 1)
+	assert.Equal(t, []string{"spec", "duration"}, fields[0].fieldPath)
+	assert.False(t, fields[0].builtIn)
+}
+
 func TestOverrideKeyWithoutGroup(t *testing.T) {
 	normalizer, err := NewKnownTypesNormalizer(map[string]v1alpha1.ResourceOverride{
 		"ConfigMap": {
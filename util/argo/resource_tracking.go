@@ -65,6 +65,14 @@ func (rt *resourceTracking) getAppInstanceValue(un *unstructured.Unstructured, i
 	return value
 }
 
+// IsTrackedByOtherInstallation returns true if the resource carries a tracking annotation which was set by an Argo CD
+// installation with an installation ID other than the given one. Such resources are never considered to be part of an
+// application of this installation.
+func IsTrackedByOtherInstallation(un *unstructured.Unstructured, installationID string) bool {
+	annotations := un.GetAnnotations()
+	return annotations[common.AnnotationKeyAppInstance] != "" && annotations[common.AnnotationInstallationID] != installationID
+}
+
 // GetAppName retrieve application name base on tracking method
 func (rt *resourceTracking) GetAppName(un *unstructured.Unstructured, key string, trackingMethod v1alpha1.TrackingMethod, instanceID string) string {
 	retrieveAppInstanceValue := func() string {
@@ -76,6 +84,9 @@ func (rt *resourceTracking) GetAppName(un *unstructured.Unstructured, key string
 	}
 	switch trackingMethod {
 	case v1alpha1.TrackingMethodLabel:
+		if installationID, ok := un.GetAnnotations()[common.AnnotationInstallationID]; ok && installationID != instanceID {
+			return ""
+		}
 		label, err := kube.GetAppInstanceLabel(un, key)
 		if err != nil {
 			return ""
@@ -123,21 +134,24 @@ func UnstructuredToAppInstanceValue(un *unstructured.Unstructured, appName, name
 
 // SetAppInstance set label/annotation base on tracking method
 func (rt *resourceTracking) SetAppInstance(un *unstructured.Unstructured, key, val, namespace string, trackingMethod v1alpha1.TrackingMethod, instanceID string) error {
+	setInstallationIDAnnotation := func() error {
+		if instanceID != "" {
+			return kube.SetAppInstanceAnnotation(un, common.AnnotationInstallationID, instanceID)
+		}
+		return kube.RemoveAnnotation(un, common.AnnotationInstallationID)
+	}
 	setAppInstanceAnnotation := func() error {
 		appInstanceValue := UnstructuredToAppInstanceValue(un, val, namespace)
-		if instanceID != "" {
-			if err := kube.SetAppInstanceAnnotation(un, common.AnnotationInstallationID, instanceID); err != nil {
-				return err
-			}
-		} else {
-			if err := kube.RemoveAnnotation(un, common.AnnotationInstallationID); err != nil {
-				return err
-			}
+		if err := setInstallationIDAnnotation(); err != nil {
+			return err
 		}
 		return kube.SetAppInstanceAnnotation(un, common.AnnotationKeyAppInstance, rt.BuildAppInstanceValue(appInstanceValue))
 	}
 	switch trackingMethod {
 	case v1alpha1.TrackingMethodLabel:
+		if err := setInstallationIDAnnotation(); err != nil {
+			return err
+		}
 		err := kube.SetAppInstanceLabel(un, key, val)
 		if err != nil {
 			return fmt.Errorf("failed to set app instance label: %w", err)
@@ -177,6 +191,9 @@ func (rt *resourceTracking) RemoveAppInstance(un *unstructured.Unstructured, tra
 		if err := kube.RemoveLabel(un, common.LabelKeyAppInstance); err != nil {
 			return err
 		}
+		if err := kube.RemoveAnnotation(un, common.AnnotationInstallationID); err != nil {
+			return err
+		}
 		return nil
 	case v1alpha1.TrackingMethodAnnotation:
 		if err := kube.RemoveAnnotation(un, common.AnnotationKeyAppInstance); err != nil {
@@ -31,6 +31,46 @@ func TestSetAppInstanceLabel(t *testing.T) {
 	assert.Equal(t, "my-app", app)
 }
 
+func TestSetAppInstanceLabelWithInstallationID(t *testing.T) {
+	yamlBytes, err := os.ReadFile("testdata/svc.yaml")
+	require.NoError(t, err)
+
+	var obj unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &obj)
+	require.NoError(t, err)
+
+	resourceTracking := NewResourceTracking()
+
+	err = resourceTracking.SetAppInstance(&obj, common.LabelKeyAppInstance, "my-app", "", v1alpha1.TrackingMethodLabel, "instance-a")
+	require.NoError(t, err)
+	assert.Equal(t, "instance-a", obj.GetAnnotations()[common.AnnotationInstallationID])
+	assert.Equal(t, "my-app", resourceTracking.GetAppName(&obj, common.LabelKeyAppInstance, v1alpha1.TrackingMethodLabel, "instance-a"))
+	// resources of other installations are not tracked
+	assert.Empty(t, resourceTracking.GetAppName(&obj, common.LabelKeyAppInstance, v1alpha1.TrackingMethodLabel, "instance-b"))
+	assert.Empty(t, resourceTracking.GetAppName(&obj, common.LabelKeyAppInstance, v1alpha1.TrackingMethodLabel, ""))
+
+	err = resourceTracking.SetAppInstance(&obj, common.LabelKeyAppInstance, "my-app", "", v1alpha1.TrackingMethodLabel, "")
+	require.NoError(t, err)
+	assert.NotContains(t, obj.GetAnnotations(), common.AnnotationInstallationID)
+}
+
+func TestIsTrackedByOtherInstallation(t *testing.T) {
+	newObj := func(annotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+	trackingID := "my-app:/Service:default/my-svc"
+
+	assert.False(t, IsTrackedByOtherInstallation(newObj(nil), "instance-a"))
+	assert.False(t, IsTrackedByOtherInstallation(newObj(map[string]string{common.AnnotationInstallationID: "instance-b"}), "instance-a"))
+	assert.False(t, IsTrackedByOtherInstallation(newObj(map[string]string{common.AnnotationKeyAppInstance: trackingID}), ""))
+	assert.False(t, IsTrackedByOtherInstallation(newObj(map[string]string{common.AnnotationKeyAppInstance: trackingID, common.AnnotationInstallationID: "instance-a"}), "instance-a"))
+	assert.True(t, IsTrackedByOtherInstallation(newObj(map[string]string{common.AnnotationKeyAppInstance: trackingID, common.AnnotationInstallationID: "instance-b"}), "instance-a"))
+	assert.True(t, IsTrackedByOtherInstallation(newObj(map[string]string{common.AnnotationKeyAppInstance: trackingID, common.AnnotationInstallationID: "instance-b"}), ""))
+	assert.True(t, IsTrackedByOtherInstallation(newObj(map[string]string{common.AnnotationKeyAppInstance: trackingID}), "instance-a"))
+}
+
 func TestSetAppInstanceAnnotation(t *testing.T) {
 	yamlBytes, err := os.ReadFile("testdata/svc.yaml")
 	require.NoError(t, err)
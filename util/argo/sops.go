@@ -0,0 +1,34 @@
+package argo
+
+import (
+	"fmt"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/sops"
+)
+
+// GetSOPSEnv returns the environment of the sops process decrypting the Helm value files of the applications in the
+// given project, which holds the entries of the secret configured in the project. It returns nil if the project does
+// not configure SOPS keys.
+func GetSOPSEnv(settingsMgr *settings.SettingsManager, proj *argoappv1.AppProject) (map[string]string, error) {
+	if proj.Spec.SOPS == nil || proj.Spec.SOPS.SecretName == "" {
+		return nil, nil
+	}
+	secret, err := settingsMgr.GetSecretByName(proj.Spec.SOPS.SecretName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting SOPS keys secret %q of project '%s': %w", proj.Spec.SOPS.SecretName, proj.Name, err)
+	}
+	if secret.Labels[common.LabelKeySecretType] != common.LabelValueSecretTypeSOPS {
+		return nil, fmt.Errorf("SOPS keys secret %q of project '%s' must be labeled with %s=%s", secret.Name, proj.Name, common.LabelKeySecretType, common.LabelValueSecretTypeSOPS)
+	}
+	env := make(map[string]string, len(secret.Data))
+	for name, value := range secret.Data {
+		if err := sops.ValidateEnvName(name); err != nil {
+			return nil, fmt.Errorf("invalid key in SOPS keys secret %q of project '%s': %w", secret.Name, proj.Name, err)
+		}
+		env[name] = string(value)
+	}
+	return env, nil
+}
@@ -0,0 +1,74 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func sopsSecret(name string, labels map[string]string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: test.FakeArgoCDNamespace, Labels: labels},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func TestGetSOPSEnv(t *testing.T) {
+	sopsLabels := map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeSOPS}
+	kubeClient := fake.NewClientset(
+		sopsSecret("sops-keys", sopsLabels, map[string]string{"SOPS_AGE_KEY": "AGE-SECRET-KEY-1"}),
+		sopsSecret("unlabeled", nil, map[string]string{"SOPS_AGE_KEY": "AGE-SECRET-KEY-1"}),
+		sopsSecret("invalid-key", sopsLabels, map[string]string{"age.key": "AGE-SECRET-KEY-1"}),
+		sopsSecret("path-override", sopsLabels, map[string]string{"PATH": "/tmp"}),
+	)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, test.FakeArgoCDNamespace)
+
+	project := func(sops *argoappv1.AppProjectSOPS) *argoappv1.AppProject {
+		return &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}, Spec: argoappv1.AppProjectSpec{SOPS: sops}}
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		env, err := GetSOPSEnv(settingsMgr, project(nil))
+		require.NoError(t, err)
+		assert.Nil(t, env)
+	})
+
+	t.Run("secret entries", func(t *testing.T) {
+		env, err := GetSOPSEnv(settingsMgr, project(&argoappv1.AppProjectSOPS{SecretName: "sops-keys"}))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"SOPS_AGE_KEY": "AGE-SECRET-KEY-1"}, env)
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		_, err := GetSOPSEnv(settingsMgr, project(&argoappv1.AppProjectSOPS{SecretName: "missing"}))
+		require.ErrorContains(t, err, `error getting SOPS keys secret "missing" of project 'default'`)
+	})
+
+	t.Run("secret without label", func(t *testing.T) {
+		_, err := GetSOPSEnv(settingsMgr, project(&argoappv1.AppProjectSOPS{SecretName: "unlabeled"}))
+		require.ErrorContains(t, err, "must be labeled with argocd.argoproj.io/secret-type=sops")
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := GetSOPSEnv(settingsMgr, project(&argoappv1.AppProjectSOPS{SecretName: "invalid-key"}))
+		require.ErrorContains(t, err, `"age.key" is not a valid environment variable name`)
+	})
+
+	t.Run("inherited variable", func(t *testing.T) {
+		_, err := GetSOPSEnv(settingsMgr, project(&argoappv1.AppProjectSOPS{SecretName: "path-override"}))
+		require.ErrorContains(t, err, "environment variable PATH cannot be overridden")
+	})
+}
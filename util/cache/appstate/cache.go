@@ -2,6 +2,7 @@ package appstate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -19,7 +20,8 @@ var (
 )
 
 const (
-	clusterInfoCacheExpiration = 10 * time.Minute
+	clusterInfoCacheExpiration  = 10 * time.Minute
+	operationLogCacheExpiration = 7 * 24 * time.Hour
 )
 
 type Cache struct {
@@ -79,6 +81,10 @@ func appResourcesTreeKey(appName string, shard int64) string {
 	return key
 }
 
+func appOperationLogKey(appName string) string {
+	return "app|operation-log|" + appName
+}
+
 func clusterInfoKey(server string) string {
 	return "cluster|info|" + server
 }
@@ -129,3 +135,31 @@ func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	err := c.GetItem(clusterInfoKey(server), &res)
 	return err
 }
+
+// GetAppOperationLog returns the operation log of the given application, most recent operation first
+func (c *Cache) GetAppOperationLog(appName string, res *[]*appv1.OperationLogEntry) error {
+	return c.GetItem(appOperationLogKey(appName), &res)
+}
+
+// AppendAppOperationLog adds the given entry to the operation log of the application and keeps at most limit entries.
+// The entry is assigned the next sequence number of the log.
+func (c *Cache) AppendAppOperationLog(appName string, entry *appv1.OperationLogEntry, limit int) error {
+	var entries []*appv1.OperationLogEntry
+	if err := c.GetAppOperationLog(appName, &entries); err != nil && !errors.Is(err, ErrCacheMiss) {
+		return err
+	}
+	entry.ID = 1
+	if len(entries) > 0 {
+		entry.ID = entries[0].ID + 1
+	}
+	entries = append([]*appv1.OperationLogEntry{entry}, entries...)
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return c.SetItem(appOperationLogKey(appName), entries, operationLogCacheExpiration, false)
+}
+
+// DeleteAppOperationLog removes the operation log of the given application
+func (c *Cache) DeleteAppOperationLog(appName string) error {
+	return c.SetItem(appOperationLogKey(appName), []*appv1.OperationLogEntry(nil), operationLogCacheExpiration, true)
+}
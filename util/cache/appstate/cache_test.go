@@ -77,6 +77,32 @@ func TestCache_GetClusterInfo(t *testing.T) {
 	assert.Equal(t, &ClusterInfo{ServerVersion: "0.24.0"}, res)
 }
 
+func TestCache_AppendAppOperationLog(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	var entries []*OperationLogEntry
+	err := cache.GetAppOperationLog("my-appname", &entries)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache, exceeding the limit
+	for _, msg := range []string{"first", "second", "third"} {
+		err = cache.AppendAppOperationLog("my-appname", &OperationLogEntry{Message: msg}, 2)
+		require.NoError(t, err)
+	}
+	// cache hit, most recent entry first
+	err = cache.GetAppOperationLog("my-appname", &entries)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, int64(3), entries[0].ID)
+	assert.Equal(t, "third", entries[0].Message)
+	assert.Equal(t, int64(2), entries[1].ID)
+	assert.Equal(t, "second", entries[1].Message)
+	// delete
+	err = cache.DeleteAppOperationLog("my-appname")
+	require.NoError(t, err)
+	err = cache.GetAppOperationLog("my-appname", &entries)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
 func TestAddCacheFlagsToCmd(t *testing.T) {
 	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
 	require.NoError(t, err)
@@ -68,6 +68,12 @@ func GetFlag(key, fallback string) string {
 	return fallback
 }
 
+// HasFlag returns true if the flag with the given key is set in ARGOCD_OPTS
+func HasFlag(key string) bool {
+	_, ok := flags[key]
+	return ok
+}
+
 func GetBoolFlag(key string) bool {
 	return GetFlag(key, "false") == "true"
 }
@@ -112,7 +112,7 @@ func (db *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		},
 	}
 
-	err = clusterToSecret(c, clusterSecret)
+	err = ClusterToSecret(c, clusterSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -316,7 +316,7 @@ func (db *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		}
 		return nil, err
 	}
-	if err := clusterToSecret(c, clusterSecret); err != nil {
+	if err := ClusterToSecret(c, clusterSecret); err != nil {
 		return nil, err
 	}
 
@@ -347,8 +347,8 @@ func (db *db) DeleteCluster(ctx context.Context, server string) error {
 	return db.settingsMgr.ResyncInformers()
 }
 
-// clusterToSecret converts a cluster object to string data for serialization to a secret
-func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
+// ClusterToSecret converts a cluster object to string data for serialization to a secret
+func ClusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	data := make(map[string][]byte)
 	data["server"] = []byte(strings.TrimRight(c.Server, "/"))
 	if c.Name == "" {
@@ -106,7 +106,7 @@ func TestClusterToSecret(t *testing.T) {
 		Namespaces:  []string{"default"},
 	}
 	s := &corev1.Secret{}
-	err := clusterToSecret(cluster, s)
+	err := ClusterToSecret(cluster, s)
 	require.NoError(t, err)
 
 	assert.Equal(t, []byte(cluster.Server), s.Data["server"])
@@ -127,7 +127,7 @@ func TestClusterToSecret_LastAppliedConfigurationRejected(t *testing.T) {
 		Namespaces:  []string{"default"},
 	}
 	s := &corev1.Secret{}
-	err := clusterToSecret(cluster, s)
+	err := ClusterToSecret(cluster, s)
 	require.Error(t, err)
 	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -407,6 +409,21 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 	}
 	repository.Depth = depth
 
+	if val, ok := secret.Annotations[common.AnnotationKeyManifestGenerateMaxConcurrency]; ok {
+		maxConcurrency, err := strconv.ParseInt(val, 10, 64)
+		if err != nil || maxConcurrency < 0 {
+			return repository, fmt.Errorf("invalid value '%s' of annotation %s", val, common.AnnotationKeyManifestGenerateMaxConcurrency)
+		}
+		repository.MaxConcurrentManifestGenerations = maxConcurrency
+	}
+
+	if val, ok := secret.Annotations[common.AnnotationKeyManifestGenerateMemoryLimit]; ok {
+		if _, err := resource.ParseQuantity(val); err != nil {
+			return repository, fmt.Errorf("invalid value '%s' of annotation %s: %w", val, common.AnnotationKeyManifestGenerateMemoryLimit, err)
+		}
+		repository.ManifestGenerationMemoryLimit = val
+	}
+
 	return repository, nil
 }
 
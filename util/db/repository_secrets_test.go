@@ -1245,3 +1245,29 @@ func TestCreateReadAndWriteRepoCredsSecretForSameURL(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, common.LabelValueSecretTypeRepoCredsWrite, writeSecret.Labels[common.LabelKeySecretType])
 }
+
+func TestSecretToRepository_ManifestGenerationLimits(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "repo",
+			Annotations: map[string]string{
+				common.AnnotationKeyManifestGenerateMaxConcurrency: "2",
+				common.AnnotationKeyManifestGenerateMemoryLimit:    "512Mi",
+			},
+		},
+		Data: map[string][]byte{"url": []byte("git@github.com:argoproj/argo-cd.git")},
+	}
+	repository, err := secretToRepository(secret)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), repository.MaxConcurrentManifestGenerations)
+	assert.Equal(t, "512Mi", repository.ManifestGenerationMemoryLimit)
+
+	secret.Annotations[common.AnnotationKeyManifestGenerateMaxConcurrency] = "-1"
+	_, err = secretToRepository(secret)
+	require.ErrorContains(t, err, common.AnnotationKeyManifestGenerateMaxConcurrency)
+
+	secret.Annotations[common.AnnotationKeyManifestGenerateMaxConcurrency] = "2"
+	secret.Annotations[common.AnnotationKeyManifestGenerateMemoryLimit] = "a lot"
+	_, err = secretToRepository(secret)
+	require.ErrorContains(t, err, common.AnnotationKeyManifestGenerateMemoryLimit)
+}
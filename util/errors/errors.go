@@ -11,6 +11,12 @@ import (
 const (
 	// ErrorGeneric is returned for generic error
 	ErrorGeneric = 20
+	// ErrorSyncFailed is returned if a sync operation did not succeed
+	ErrorSyncFailed = 21
+	// ErrorWaitTimeout is returned if waiting for an application timed out
+	ErrorWaitTimeout = 22
+	// ErrorDegradedAfterSync is returned if an application is degraded after a successful sync operation
+	ErrorDegradedAfterSync = 23
 )
 
 type Handler struct {
@@ -24,6 +24,8 @@ var (
 	timeout      time.Duration
 	fatalTimeout time.Duration
 	Unredacted   = Redact(nil)
+	// memoryCheckInterval is the interval in which the memory of commands with a memory limit is checked
+	memoryCheckInterval = 250 * time.Millisecond
 )
 
 type ExecRunOpts struct {
@@ -35,6 +37,8 @@ type ExecRunOpts struct {
 	SkipErrorLogging bool
 	// CaptureStderr determines whether to capture stderr in addition to stdout
 	CaptureStderr bool
+	// MemoryLimit is the soft limit of the resident memory of the command in bytes, 0 means no limit
+	MemoryLimit int64
 }
 
 func init() {
@@ -63,7 +67,7 @@ func RunWithRedactor(cmd *exec.Cmd, redactor func(text string) string) (string,
 }
 
 func RunWithExecRunOpts(cmd *exec.Cmd, opts ExecRunOpts) (string, error) {
-	cmdOpts := CmdOpts{Timeout: timeout, FatalTimeout: fatalTimeout, Redactor: opts.Redactor, TimeoutBehavior: opts.TimeoutBehavior, SkipErrorLogging: opts.SkipErrorLogging, CaptureStderr: opts.CaptureStderr}
+	cmdOpts := CmdOpts{Timeout: timeout, FatalTimeout: fatalTimeout, Redactor: opts.Redactor, TimeoutBehavior: opts.TimeoutBehavior, SkipErrorLogging: opts.SkipErrorLogging, CaptureStderr: opts.CaptureStderr, MemoryLimit: opts.MemoryLimit}
 	span := tracing.NewLoggingTracer(log.NewLogrusLogger(log.NewWithCurrentConfig())).StartSpan(fmt.Sprintf("exec %v", cmd.Args[0]))
 	span.SetBaggageItem("dir", cmd.Dir)
 	if cmdOpts.Redactor != nil {
@@ -146,6 +150,9 @@ type CmdOpts struct {
 	SkipErrorLogging bool
 	// CaptureStderr defines whether to capture stderr in addition to stdout
 	CaptureStderr bool
+	// MemoryLimit is the soft limit of the resident memory of the command in bytes, 0 means no limit. The memory is
+	// checked periodically and the command is killed once it exceeds the limit.
+	MemoryLimit int64
 }
 
 var DefaultCmdOpts = CmdOpts{
@@ -198,6 +205,13 @@ func RunCommandExt(cmd *exec.Cmd, opts CmdOpts) (string, error) {
 	done := make(chan error)
 	go func() { done <- cmd.Wait() }()
 
+	memoryExceeded := make(chan int64, 1)
+	if opts.MemoryLimit > 0 {
+		stopWatching := make(chan struct{})
+		defer close(stopWatching)
+		go watchMemory(cmd.Process, opts.MemoryLimit, memoryExceeded, stopWatching)
+	}
+
 	// Start timers for timeout
 	timeout := DefaultCmdOpts.Timeout
 	fatalTimeout := DefaultCmdOpts.FatalTimeout
@@ -262,6 +276,11 @@ func RunCommandExt(cmd *exec.Cmd, opts CmdOpts) (string, error) {
 		return strings.TrimSuffix(output, "\n"), err
 	case err := <-done:
 		if err != nil {
+			select {
+			case used := <-memoryExceeded:
+				err = fmt.Errorf("killed after exceeding the memory limit of %d bytes, using %d bytes", opts.MemoryLimit, used)
+			default:
+			}
 			output := stdout.String()
 			if opts.CaptureStderr {
 				output += stderr.String()
@@ -283,6 +302,30 @@ func RunCommandExt(cmd *exec.Cmd, opts CmdOpts) (string, error) {
 	return strings.TrimSuffix(output, "\n"), nil
 }
 
+// watchMemory periodically checks the resident memory of the process and kills it once it exceeds the limit. The used
+// memory is sent to the exceeded channel when the process is killed.
+func watchMemory(process *os.Process, limit int64, exceeded chan<- int64, stop <-chan struct{}) {
+	ticker := time.NewTicker(memoryCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			used, err := processMemory(process.Pid)
+			if err != nil {
+				// the process has already exited or its memory cannot be measured
+				return
+			}
+			if used > limit {
+				exceeded <- used
+				_ = process.Kill()
+				return
+			}
+		}
+	}
+}
+
 func RunCommand(name string, opts CmdOpts, arg ...string) (string, error) {
 	return RunCommandExt(exec.CommandContext(context.Background(), name, arg...), opts)
 }
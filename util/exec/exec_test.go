@@ -3,6 +3,7 @@ package exec
 import (
 	"os/exec"
 	"regexp"
+	"runtime"
 	"syscall"
 	"testing"
 	"time"
@@ -46,6 +47,19 @@ func TestHideUsernamePassword(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestRunCommandExt_MemoryLimit(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("measuring the memory of processes is only supported on linux")
+	}
+
+	out, err := RunCommandExt(exec.CommandContext(t.Context(), "echo", "hello"), CmdOpts{MemoryLimit: 1 << 30})
+	require.NoError(t, err)
+	assert.Equal(t, "hello", out)
+
+	_, err = RunCommandExt(exec.CommandContext(t.Context(), "sleep", "5"), CmdOpts{MemoryLimit: 1})
+	assert.ErrorContains(t, err, "killed after exceeding the memory limit of 1 bytes")
+}
+
 // This tests a cmd that properly handles a SIGTERM signal
 func TestRunWithExecRunOpts(t *testing.T) {
 	t.Setenv("ARGOCD_EXEC_TIMEOUT", "200ms")
@@ -0,0 +1,10 @@
+//go:build !linux
+
+package exec
+
+import "errors"
+
+// processMemory returns the resident set size of the process in bytes
+func processMemory(_ int) (int64, error) {
+	return 0, errors.New("measuring the memory of processes is only supported on linux")
+}
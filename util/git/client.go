@@ -187,6 +187,10 @@ type nativeGitClient struct {
 	noProxy string
 	// git configuration environment variables
 	gitConfigEnv []string
+	// directory containing the reference repositories and bundles used to initialize repositories
+	referenceDir string
+	// whether to copy the objects of the reference repository instead of borrowing them
+	dissociateReference bool
 }
 
 type runOpts struct {
@@ -243,6 +247,16 @@ func WithBuiltinGitConfig(enable bool) ClientOpts {
 	}
 }
 
+// WithReferenceDir sets the directory containing the reference repositories and bundles used to speed up the
+// initialization of repositories. If dissociate is true, the objects borrowed from a reference repository are copied
+// after the first fetch, so that the repository does not depend on the reference repository anymore.
+func WithReferenceDir(referenceDir string, dissociate bool) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.referenceDir = referenceDir
+		c.dissociateReference = dissociate
+	}
+}
+
 // WithEventHandlers sets the git client event handlers
 func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	return func(c *nativeGitClient) {
@@ -450,7 +464,79 @@ func (m *nativeGitClient) Init() error {
 		Name: git.DefaultRemoteName,
 		URLs: []string{m.repoURL},
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	return m.initFromReference()
+}
+
+// referencePath returns the path of the reference repository of the repository in the reference directory, i.e.
+// <referenceDir>/<host>/<path>, or an empty string if no reference directory is configured.
+func (m *nativeGitClient) referencePath() string {
+	if m.referenceDir == "" {
+		return ""
+	}
+	normalized := NormalizeGitURL(m.repoURL)
+	if !strings.Contains(normalized, "://") {
+		normalized = "ssh://" + normalized
+	}
+	repoURL, err := url.Parse(normalized)
+	if err != nil || repoURL.Hostname() == "" {
+		return ""
+	}
+	referencePath := filepath.Join(m.referenceDir, repoURL.Hostname(), filepath.FromSlash(repoURL.Path))
+	if !strings.HasPrefix(referencePath, filepath.Clean(m.referenceDir)+string(filepath.Separator)) {
+		return ""
+	}
+	return referencePath
+}
+
+// initFromReference initializes the repository using the reference repository or the bundle of the repository in the
+// reference directory, if any. Failures are logged and ignored since the objects are fetched from the remote anyway.
+func (m *nativeGitClient) initFromReference() error {
+	referencePath := m.referencePath()
+	if referencePath == "" {
+		return nil
+	}
+	ctx := context.Background()
+	if info, err := os.Stat(referencePath + ".bundle"); err == nil && !info.IsDir() {
+		log.Infof("Initializing %s from bundle %s", m.repoURL, referencePath+".bundle")
+		if _, err := m.runCmd(ctx, "fetch", referencePath+".bundle", "+refs/heads/*:refs/remotes/origin/*", "+refs/tags/*:refs/tags/*"); err != nil {
+			log.Warnf("Failed to fetch bundle %s: %v", referencePath+".bundle", err)
+		}
+		return nil
+	}
+	objectsDir := ""
+	// the reference repository is either a regular or a bare repository
+	for _, dir := range []string{filepath.Join(referencePath, ".git", "objects"), filepath.Join(referencePath, "objects")} {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			objectsDir = dir
+			break
+		}
+	}
+	if objectsDir == "" {
+		return nil
+	}
+	log.Infof("Initializing %s using reference repository %s", m.repoURL, referencePath)
+	return os.WriteFile(m.alternatesPath(), []byte(objectsDir+"\n"), 0o644)
+}
+
+func (m *nativeGitClient) alternatesPath() string {
+	return filepath.Join(m.root, ".git", "objects", "info", "alternates")
+}
+
+// dissociate copies the objects borrowed from the reference repository and stops using it
+func (m *nativeGitClient) dissociate(ctx context.Context) error {
+	if !m.dissociateReference {
+		return nil
+	}
+	if _, err := os.Stat(m.alternatesPath()); os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := m.runCmd(ctx, "repack", "-a", "-d"); err != nil {
+		return fmt.Errorf("failed to copy the objects of the reference repository: %w", err)
+	}
+	return os.Remove(m.alternatesPath())
 }
 
 // IsLFSEnabled returns true if the repository is LFS enabled
@@ -496,6 +582,9 @@ func (m *nativeGitClient) Fetch(revision string, depth int64) error {
 	ctx := context.Background()
 
 	err := m.fetch(ctx, revision, depth)
+	if err == nil {
+		err = m.dissociate(ctx)
+	}
 
 	// When we have LFS support enabled, check for large files and fetch them too.
 	if err == nil && m.IsLFSEnabled() {
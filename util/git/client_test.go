@@ -98,6 +98,54 @@ func Test_nativeGitClient_Fetch_Prune(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_nativeGitClient_InitFromReference(t *testing.T) {
+	ctx := t.Context()
+	sourceDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+	sha, err := outputCmd(ctx, sourceDir, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	commitSHA := strings.TrimSpace(string(sha))
+
+	newClient := func(t *testing.T, referenceDir string) *nativeGitClient {
+		t.Helper()
+		client, err := NewClientExt("https://example.com/org/repo.git", t.TempDir(), NopCreds{}, true, false, "", "", WithReferenceDir(referenceDir, true))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+		return client.(*nativeGitClient)
+	}
+
+	t.Run("ReferenceRepository", func(t *testing.T) {
+		referenceDir := t.TempDir()
+		require.NoError(t, runCmd(ctx, referenceDir, "git", "clone", "--bare", sourceDir, filepath.Join(referenceDir, "example.com", "org", "repo")))
+
+		client := newClient(t, referenceDir)
+		assert.FileExists(t, client.alternatesPath())
+		assert.True(t, client.IsRevisionPresent(commitSHA))
+
+		// the objects referenced by the fetched refs are copied from the reference repository
+		require.NoError(t, runCmd(ctx, client.Root(), "git", "update-ref", "refs/remotes/origin/main", commitSHA))
+		require.NoError(t, client.dissociate(ctx))
+		assert.NoFileExists(t, client.alternatesPath())
+		assert.True(t, client.IsRevisionPresent(commitSHA))
+	})
+
+	t.Run("Bundle", func(t *testing.T) {
+		referenceDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(referenceDir, "example.com", "org"), 0o755))
+		require.NoError(t, runCmd(ctx, sourceDir, "git", "bundle", "create", filepath.Join(referenceDir, "example.com", "org", "repo.bundle"), "--all"))
+
+		client := newClient(t, referenceDir)
+		assert.NoFileExists(t, client.alternatesPath())
+		assert.True(t, client.IsRevisionPresent(commitSHA))
+	})
+
+	t.Run("NoReference", func(t *testing.T) {
+		client := newClient(t, t.TempDir())
+		assert.NoFileExists(t, client.alternatesPath())
+		assert.False(t, client.IsRevisionPresent(commitSHA))
+	})
+}
+
 func Test_IsAnnotatedTag(t *testing.T) {
 	tempDir := t.TempDir()
 	ctx := t.Context()
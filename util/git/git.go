@@ -1,10 +1,14 @@
 package git
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"regexp"
 	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/utils/ioutil"
 )
 
 // EnsurePrefix idempotently ensures that a base string has a given prefix.
@@ -105,3 +109,23 @@ func TestRepo(repo string, creds Creds, insecure bool, enableLfs bool, proxy str
 	}
 	return nil
 }
+
+// TestRepoWriteAccess tests if the given credentials grant push access to a repo. Git servers authorize pushes when
+// the receive-pack service is requested, so the references advertised by the service are requested without pushing
+// anything.
+func TestRepoWriteAccess(repo string, creds Creds, insecure bool, proxy string, noProxy string) (err error) {
+	auth, err := newAuth(repo, creds)
+	if err != nil {
+		return fmt.Errorf("unable to initialize git auth: %w", err)
+	}
+	session, err := newReceivePackSession(repo, auth, insecure, creds, proxy, noProxy)
+	if err != nil {
+		return fmt.Errorf("unable to initialize git client: %w", err)
+	}
+	defer ioutil.CheckClose(session, &err)
+	// pushing to empty repositories is allowed
+	if _, err := session.AdvertisedReferences(); err != nil && !errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return fmt.Errorf("unable to verify push access to repository: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package git
 import (
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -308,6 +309,27 @@ func TestLsRemote(t *testing.T) {
 }
 
 // Running this test requires git-lfs to be installed on your machine.
+func TestTestRepoWriteAccess(t *testing.T) {
+	t.Run("EmptyRepository", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, runCmd(t.Context(), dir, "git", "init", "--bare"))
+		require.NoError(t, TestRepoWriteAccess("file://"+dir, NopCreds{}, false, "", ""))
+	})
+
+	t.Run("PushDenied", func(t *testing.T) {
+		var service string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			service = r.URL.Query().Get("service")
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		err := TestRepoWriteAccess(server.URL+"/org/repo.git", NewHTTPSCreds("user", "read-only-token", "", "", "", true, nil, false), true, "", "")
+		require.ErrorContains(t, err, "unable to verify push access to repository")
+		assert.Equal(t, "git-receive-pack", service)
+	})
+}
+
 func TestLFSClient(t *testing.T) {
 	// temporary disable LFS test
 	// TODO(alexmt): dockerize tests in and enabled it
@@ -25,6 +25,15 @@ func newUploadPackSession(url string, auth transport.AuthMethod, insecure bool,
 	return c.NewUploadPackSession(ep, auth)
 }
 
+func newReceivePackSession(url string, auth transport.AuthMethod, insecure bool, creds Creds, proxy string, noProxy string) (transport.ReceivePackSession, error) {
+	c, ep, err := newClient(url, insecure, creds, proxy, noProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.NewReceivePackSession(ep, auth)
+}
+
 func newClient(url string, insecure bool, creds Creds, proxy string, noProxy string) (transport.Transport, *transport.Endpoint, error) {
 	ep, err := transport.NewEndpoint(url)
 	if err != nil {
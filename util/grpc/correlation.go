@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"google.golang.org/grpc/metadata"
+)
+
+// CorrelationIDMetadataKey is the metadata key carrying the correlation ID of the operation which issued a gRPC call,
+// e.g. the reconciliation of an ApplicationSet
+const CorrelationIDMetadataKey = "x-correlation-id"
+
+// CorrelationIDFields returns the correlation ID of an incoming gRPC call as logging fields. It is meant to be used
+// with logging.WithFieldsFromContext, so the logs of a call can be correlated with the logs of its caller.
+func CorrelationIDFields(ctx context.Context) logging.Fields {
+	values := metadata.ValueFromIncomingContext(ctx, CorrelationIDMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return nil
+	}
+	return logging.Fields{"correlationId", values[0]}
+}
@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCorrelationIDFields(t *testing.T) {
+	assert.Nil(t, CorrelationIDFields(t.Context()))
+
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(CorrelationIDMetadataKey, ""))
+	assert.Nil(t, CorrelationIDFields(ctx))
+
+	ctx = metadata.NewIncomingContext(t.Context(), metadata.Pairs(CorrelationIDMetadataKey, "abc"))
+	assert.Equal(t, logging.Fields{"correlationId", "abc"}, CorrelationIDFields(ctx))
+}
@@ -23,13 +23,13 @@ import (
 
 // A thin wrapper around the "helm" command, adding logging and error translation.
 type Cmd struct {
-	helmHome        string
-	WorkDir         string
-	IsLocal         bool
-	IsHelmOci       bool
-	proxy           string
-	noProxy         string
-	runWithRedactor func(cmd *exec.Cmd, redactor func(text string) string) (string, error)
+	helmHome    string
+	WorkDir     string
+	IsLocal     bool
+	IsHelmOci   bool
+	proxy       string
+	noProxy     string
+	runWithOpts func(cmd *exec.Cmd, opts executil.ExecRunOpts) (string, error)
 }
 
 func NewCmd(workDir string, version string, proxy string, noProxy string) (*Cmd, error) {
@@ -42,15 +42,15 @@ func NewCmd(workDir string, version string, proxy string, noProxy string) (*Cmd,
 }
 
 func NewCmdWithVersion(workDir string, isHelmOci bool, proxy string, noProxy string) (*Cmd, error) {
-	return newCmdWithVersion(workDir, isHelmOci, proxy, noProxy, executil.RunWithRedactor)
+	return newCmdWithVersion(workDir, isHelmOci, proxy, noProxy, executil.RunWithExecRunOpts)
 }
 
-func newCmdWithVersion(workDir string, isHelmOci bool, proxy string, noProxy string, runWithRedactor func(cmd *exec.Cmd, redactor func(text string) string) (string, error)) (*Cmd, error) {
+func newCmdWithVersion(workDir string, isHelmOci bool, proxy string, noProxy string, runWithOpts func(cmd *exec.Cmd, opts executil.ExecRunOpts) (string, error)) (*Cmd, error) {
 	tmpDir, err := os.MkdirTemp("", "helm")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create temporary directory for helm: %w", err)
 	}
-	return &Cmd{WorkDir: workDir, helmHome: tmpDir, IsHelmOci: isHelmOci, proxy: proxy, noProxy: noProxy, runWithRedactor: runWithRedactor}, err
+	return &Cmd{WorkDir: workDir, helmHome: tmpDir, IsHelmOci: isHelmOci, proxy: proxy, noProxy: noProxy, runWithOpts: runWithOpts}, err
 }
 
 var redactor = func(text string) string {
@@ -58,6 +58,11 @@ var redactor = func(text string) string {
 }
 
 func (c Cmd) run(ctx context.Context, args ...string) (string, string, error) {
+	return c.runWithMemoryLimit(ctx, 0, args...)
+}
+
+// runWithMemoryLimit runs helm with the given args and kills it once its memory exceeds the limit in bytes, if set
+func (c Cmd) runWithMemoryLimit(ctx context.Context, memoryLimit int64, args ...string) (string, string, error) {
 	cmd := exec.CommandContext(ctx, "helm", args...)
 	cmd.Dir = c.WorkDir
 	cmd.Env = os.Environ()
@@ -71,7 +76,7 @@ func (c Cmd) run(ctx context.Context, args ...string) (string, string, error) {
 
 	cmd.Env = proxy.UpsertEnv(cmd, c.proxy, c.noProxy)
 
-	out, err := c.runWithRedactor(cmd, redactor)
+	out, err := c.runWithOpts(cmd, executil.ExecRunOpts{Redactor: redactor, MemoryLimit: memoryLimit})
 	fullCommand := executil.GetCommandArgsToLog(cmd)
 	if err != nil {
 		return out, fullCommand, fmt.Errorf("failed to get command args to log: %w", err)
@@ -366,6 +371,8 @@ type TemplateOpts struct {
 	SkipCrds             bool
 	SkipSchemaValidation bool
 	SkipTests            bool
+	// MemoryLimit is the soft limit of the memory of the helm process in bytes, 0 means no limit
+	MemoryLimit int64
 }
 
 func cleanSetParameters(val string) string {
@@ -441,7 +448,7 @@ func (c *Cmd) template(chartPath string, opts *TemplateOpts) (string, string, er
 		args = append(args, "--skip-tests")
 	}
 
-	out, command, err := c.run(context.Background(), args...)
+	out, command, err := c.runWithMemoryLimit(context.Background(), opts.MemoryLimit, args...)
 	if err != nil {
 		msg := err.Error()
 		if strings.Contains(msg, "--api-versions") {
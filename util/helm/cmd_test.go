@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
 )
 
 func Test_cmd_redactor(t *testing.T) {
@@ -117,7 +119,7 @@ func TestRegistryLogin(t *testing.T) {
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			c, err := newCmdWithVersion(".", false, "", "", func(cmd *exec.Cmd, _ func(_ string) string) (string, error) {
+			c, err := newCmdWithVersion(".", false, "", "", func(cmd *exec.Cmd, _ executil.ExecRunOpts) (string, error) {
 				if tc.execErr != nil {
 					return "", tc.execErr
 				}
@@ -157,7 +159,7 @@ func TestRegistryLogout(t *testing.T) {
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			c, err := newCmdWithVersion(".", false, "", "", func(cmd *exec.Cmd, _ func(_ string) string) (string, error) {
+			c, err := newCmdWithVersion(".", false, "", "", func(cmd *exec.Cmd, _ executil.ExecRunOpts) (string, error) {
 				if tc.execErr != nil {
 					return "", tc.execErr
 				}
@@ -1,6 +1,8 @@
 package io
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"path/filepath"
 	"sync"
 
@@ -70,3 +72,27 @@ func (p *RandomizedTempPaths) GetPaths() map[string]string {
 	}
 	return paths
 }
+
+// HashedTempPaths generates and memoizes paths derived from the hash of the key. Unlike RandomizedTempPaths, all
+// instances using the same root agree on the path of a key, which allows sharing the paths between processes. Since
+// the paths are predictable, the root must not be accessible to untrusted processes.
+type HashedTempPaths struct {
+	*RandomizedTempPaths
+}
+
+func NewHashedTempPaths(root string) *HashedTempPaths {
+	return &HashedTempPaths{RandomizedTempPaths: NewRandomizedTempPaths(root)}
+}
+
+// GetPath generates a path for the given key or returns previously generated one.
+func (p *HashedTempPaths) GetPath(key string) (string, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if val, ok := p.paths[key]; ok {
+		return val, nil
+	}
+	sum := sha256.Sum256([]byte(key))
+	repoPath := filepath.Join(p.root, hex.EncodeToString(sum[:]))
+	p.paths[key] = repoPath
+	return repoPath, nil
+}
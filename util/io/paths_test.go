@@ -61,3 +61,17 @@ func TestGetPaths_no_race(t *testing.T) {
 		paths.GetPaths()
 	}()
 }
+
+func TestHashedTempPaths_SameURLsDifferentInstances(t *testing.T) {
+	paths1 := NewHashedTempPaths(os.TempDir())
+	res1, err := paths1.GetPath("https://localhost/test.txt")
+	require.NoError(t, err)
+	paths2 := NewHashedTempPaths(os.TempDir())
+	res2, err := paths2.GetPath("https://localhost/test.txt")
+	require.NoError(t, err)
+	assert.Equal(t, res1, res2)
+	res3, err := paths2.GetPath("https://localhost/test2.txt")
+	require.NoError(t, err)
+	assert.NotEqual(t, res1, res3)
+	assert.Equal(t, res1, paths2.GetPathIfExists("https://localhost/test.txt"))
+}
@@ -35,6 +35,8 @@ type Image = string
 type BuildOpts struct {
 	KubeVersion string
 	APIVersions []string
+	// MemoryLimit is the soft limit of the memory of the kustomize process in bytes, 0 means no limit
+	MemoryLimit int64
 }
 
 // Kustomize provides wrapper functionality around the `kustomize` command.
@@ -395,7 +397,11 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 	cmd.Env = proxy.UpsertEnv(cmd, k.proxy, k.noProxy)
 	cmd.Dir = k.repoRoot
 	commands = append(commands, executil.GetCommandArgsToLog(cmd))
-	out, err := executil.Run(cmd)
+	var memoryLimit int64
+	if buildOpts != nil {
+		memoryLimit = buildOpts.MemoryLimit
+	}
+	out, err := executil.RunWithExecRunOpts(cmd, executil.ExecRunOpts{MemoryLimit: memoryLimit})
 	if err != nil {
 		return nil, nil, nil, err
 	}
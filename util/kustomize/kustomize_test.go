@@ -675,3 +675,19 @@ func Test_getImageParameters_sorted(t *testing.T) {
 	params := getImageParameters(apps)
 	assert.Equal(t, []string{"nginx:1.15.5", "nginx:1.15.6"}, params)
 }
+
+func TestLocalPaths(t *testing.T) {
+	repoRoot := "./testdata/kustomization_yaml_components_monorepo"
+	appPath := filepath.Join(repoRoot, "envs/inseng-pdx-egert-sandbox/namespaces/inst-system/apps/hello-world")
+	base := filepath.Join(repoRoot, "kustomize/apps/hello-world/base")
+
+	paths, err := LocalPaths(appPath)
+	require.NoError(t, err)
+	assert.Equal(t, []LocalPath{
+		{Path: appPath, IsDir: true},
+		{Path: base, IsDir: true},
+		{Path: filepath.Join(base, "deployment.yaml")},
+		{Path: filepath.Join(base, "service.yaml")},
+		{Path: filepath.Join(base, "serviceaccount.yaml")},
+	}, paths)
+}
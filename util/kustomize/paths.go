@@ -0,0 +1,127 @@
+package kustomize
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// LocalPath is a file or directory which is read when building a kustomization
+type LocalPath struct {
+	Path  string
+	IsDir bool
+}
+
+// kustomizationPaths contains the fields of a kustomization which reference files or directories
+type kustomizationPaths struct {
+	Resources             []string                      `json:"resources"`
+	Bases                 []string                      `json:"bases"`
+	Components            []string                      `json:"components"`
+	Crds                  []string                      `json:"crds"`
+	Generators            []string                      `json:"generators"`
+	Transformers          []string                      `json:"transformers"`
+	Validators            []string                      `json:"validators"`
+	PatchesStrategicMerge []string                      `json:"patchesStrategicMerge"`
+	Patches               []kustomizationPathEntry      `json:"patches"`
+	PatchesJSON6902       []kustomizationPathEntry      `json:"patchesJson6902"`
+	Replacements          []kustomizationPathEntry      `json:"replacements"`
+	ConfigMapGenerator    []kustomizationGeneratorEntry `json:"configMapGenerator"`
+	SecretGenerator       []kustomizationGeneratorEntry `json:"secretGenerator"`
+}
+
+type kustomizationPathEntry struct {
+	Path string `json:"path"`
+}
+
+type kustomizationGeneratorEntry struct {
+	Files []string `json:"files"`
+	Envs  []string `json:"envs"`
+	Env   string   `json:"env"`
+}
+
+// LocalPaths returns the local files and directories which are read when building the kustomization in the given
+// directory, including the kustomizations of referenced directories. Entries which do not exist locally, such as
+// remote resources and inline patches, are not returned.
+func LocalPaths(dir string) ([]LocalPath, error) {
+	visited := map[string]bool{}
+	var paths []LocalPath
+	if err := collectLocalPaths(filepath.Clean(dir), visited, &paths); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+func collectLocalPaths(dir string, visited map[string]bool, paths *[]LocalPath) error {
+	if visited[dir] {
+		return nil
+	}
+	visited[dir] = true
+	*paths = append(*paths, LocalPath{Path: dir, IsDir: true})
+
+	kustomizationFile := findKustomizeFile(dir)
+	if kustomizationFile == "" {
+		return nil
+	}
+	data, err := os.ReadFile(filepath.Join(dir, kustomizationFile))
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", kustomizationFile, err)
+	}
+	var kustomization kustomizationPaths
+	if err := yaml.Unmarshal(data, &kustomization); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", kustomizationFile, err)
+	}
+
+	for _, entry := range kustomization.entries() {
+		if entry == "" || strings.Contains(entry, "://") {
+			continue
+		}
+		entryPath := entry
+		if !filepath.IsAbs(entryPath) {
+			entryPath = filepath.Join(dir, entry)
+		}
+		info, err := os.Stat(entryPath)
+		if err != nil {
+			// kustomize treats entries which do not exist locally as remote resources or inline content
+			continue
+		}
+		if !info.IsDir() {
+			*paths = append(*paths, LocalPath{Path: entryPath})
+			continue
+		}
+		if err := collectLocalPaths(entryPath, visited, paths); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// entries returns the values of all fields of the kustomization which may reference local files or directories
+func (k *kustomizationPaths) entries() []string {
+	var entries []string
+	entries = append(entries, k.Resources...)
+	entries = append(entries, k.Bases...)
+	entries = append(entries, k.Components...)
+	entries = append(entries, k.Crds...)
+	entries = append(entries, k.Generators...)
+	entries = append(entries, k.Transformers...)
+	entries = append(entries, k.Validators...)
+	entries = append(entries, k.PatchesStrategicMerge...)
+	for _, entry := range append(append(k.Patches, k.PatchesJSON6902...), k.Replacements...) {
+		entries = append(entries, entry.Path)
+	}
+	for _, generator := range append(k.ConfigMapGenerator, k.SecretGenerator...) {
+		for _, file := range generator.Files {
+			// files may be given as <key>=<path>
+			if _, filePath, ok := strings.Cut(file, "="); ok {
+				file = filePath
+			}
+			entries = append(entries, file)
+		}
+		entries = append(entries, generator.Envs...)
+		entries = append(entries, generator.Env)
+	}
+	return entries
+}
@@ -26,13 +26,34 @@ type ContextRef struct {
 	Name   string `json:"name"`
 	Server string `json:"server"`
 	User   string `json:"user"`
+	// Defaults are the defaults of CLI flags applied when using the context
+	Defaults *ContextDefaults `json:"defaults,omitempty"`
+}
+
+// ContextDefaults are the defaults of CLI flags applied when using a context. Flags which are set explicitly take
+// precedence.
+type ContextDefaults struct {
+	// Project is the default of the --project flag
+	Project string `json:"project,omitempty"`
+	// AppNamespace is the default of the --app-namespace flag
+	AppNamespace string `json:"app-namespace,omitempty"`
+	// GRPCWeb is the default of the --grpc-web flag
+	GRPCWeb bool `json:"grpc-web,omitempty"`
+	// Output is the default of the --output flag of the commands supporting the output format
+	Output string `json:"output,omitempty"`
+}
+
+// IsEmpty returns true if no defaults are set
+func (d *ContextDefaults) IsEmpty() bool {
+	return d == nil || *d == ContextDefaults{}
 }
 
 // Context is the resolved Server and User objects resolved
 type Context struct {
-	Name   string
-	Server Server
-	User   User
+	Name     string
+	Server   Server
+	User     User
+	Defaults *ContextDefaults
 }
 
 // Server contains Argo CD server information
@@ -148,9 +169,10 @@ func (l *LocalConfig) ResolveContext(name string) (*Context, error) {
 			return nil, err
 		}
 		return &Context{
-			Name:   ctx.Name,
-			Server: *server,
-			User:   *user,
+			Name:     ctx.Name,
+			Server:   *server,
+			User:     *user,
+			Defaults: ctx.Defaults,
 		}, nil
 	}
 	return nil, fmt.Errorf("Context '%s' undefined", name)
@@ -228,9 +250,14 @@ func (l *LocalConfig) RemoveToken(serverName string) bool {
 	return false
 }
 
+// UpsertContext adds or updates the given context. The defaults of an existing context are kept if the given context
+// has none, so logging in again does not reset them.
 func (l *LocalConfig) UpsertContext(context ContextRef) {
 	for i, c := range l.Contexts {
 		if c.Name == context.Name {
+			if context.Defaults == nil {
+				context.Defaults = c.Defaults
+			}
 			l.Contexts[i] = context
 			return
 		}
@@ -238,6 +265,20 @@ func (l *LocalConfig) UpsertContext(context ContextRef) {
 	l.Contexts = append(l.Contexts, context)
 }
 
+// SetContextDefaults sets the defaults of the given context. Returns false if the context does not exist.
+func (l *LocalConfig) SetContextDefaults(name string, defaults *ContextDefaults) bool {
+	for i, c := range l.Contexts {
+		if c.Name == name {
+			if defaults.IsEmpty() {
+				defaults = nil
+			}
+			l.Contexts[i].Defaults = defaults
+			return true
+		}
+	}
+	return false
+}
+
 // Returns true if context was removed successfully
 func (l *LocalConfig) RemoveContext(serverName string) (string, bool) {
 	for i, c := range l.Contexts {
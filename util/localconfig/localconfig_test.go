@@ -202,3 +202,16 @@ func TestGetPromptsEnabled_useCLIOpts_true_forcePromptsEnabled_false(t *testing.
 
 	assert.False(t, GetPromptsEnabled(true))
 }
+
+func TestUpsertContext_KeepsDefaults(t *testing.T) {
+	localConfig := LocalConfig{Contexts: []ContextRef{{Name: "argocd", Server: "argocd", User: "argocd"}}}
+	require.True(t, localConfig.SetContextDefaults("argocd", &ContextDefaults{Project: "my-project"}))
+	assert.False(t, localConfig.SetContextDefaults("unknown", &ContextDefaults{Project: "my-project"}))
+
+	localConfig.UpsertContext(ContextRef{Name: "argocd", Server: "argocd", User: "other"})
+
+	assert.Equal(t, []ContextRef{{Name: "argocd", Server: "argocd", User: "other", Defaults: &ContextDefaults{Project: "my-project"}}}, localConfig.Contexts)
+
+	require.True(t, localConfig.SetContextDefaults("argocd", &ContextDefaults{}))
+	assert.Nil(t, localConfig.Contexts[0].Defaults)
+}
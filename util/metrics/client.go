@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+// ClientOpts configures how a client requests metrics endpoints which are secured with ServerOpts. The zero value
+// requests metrics via plain HTTP without authentication. Like the server side, the files are read whenever a client or
+// request is created, so that they can be rotated without restarting the component.
+type ClientOpts struct {
+	// TLS specifies whether metrics are requested via HTTPS
+	TLS bool
+	// CAPath is the path of the CA certificates used to verify the certificates of the metrics endpoints. The system
+	// CAs are used if empty.
+	CAPath string
+	// ClientCertPath is the path of the TLS client certificate presented to the metrics endpoints
+	ClientCertPath string
+	// ClientKeyPath is the path of the private key of the TLS client certificate
+	ClientKeyPath string
+	// BearerTokenPath is the path of a file containing the token sent as bearer token to the metrics endpoints
+	BearerTokenPath string
+}
+
+// AddClientFlags adds the flags configuring how metrics endpoints are requested to the given command. The flags are
+// named with the given prefix and default to the values of environment variables with the given prefix, e.g.
+// --diagnostics-metrics-ca-path and ARGOCD_SERVER_DIAGNOSTICS_METRICS_CA_PATH for the prefixes diagnostics and
+// ARGOCD_SERVER_DIAGNOSTICS.
+func AddClientFlags(command *cobra.Command, flagPrefix string, envPrefix string) *ClientOpts {
+	opts := &ClientOpts{}
+	command.Flags().BoolVar(&opts.TLS, flagPrefix+"-metrics-tls", env.ParseBoolFromEnv(envPrefix+"_METRICS_TLS", false), "Request metrics endpoints via HTTPS")
+	command.Flags().StringVar(&opts.CAPath, flagPrefix+"-metrics-ca-path", env.StringFromEnv(envPrefix+"_METRICS_CA_PATH", ""), "Path of the CA certificates used to verify the TLS certificates of metrics endpoints")
+	command.Flags().StringVar(&opts.ClientCertPath, flagPrefix+"-metrics-client-cert-path", env.StringFromEnv(envPrefix+"_METRICS_CLIENT_CERT_PATH", ""), "Path of the TLS client certificate used to authenticate to metrics endpoints")
+	command.Flags().StringVar(&opts.ClientKeyPath, flagPrefix+"-metrics-client-key-path", env.StringFromEnv(envPrefix+"_METRICS_CLIENT_KEY_PATH", ""), "Path of the private key of the TLS client certificate used to authenticate to metrics endpoints")
+	command.Flags().StringVar(&opts.BearerTokenPath, flagPrefix+"-metrics-bearer-token-path", env.StringFromEnv(envPrefix+"_METRICS_BEARER_TOKEN_PATH", ""), "Path of a file containing the bearer token used to authenticate to metrics endpoints")
+	return opts
+}
+
+// Validate returns an error if the options are inconsistent
+func (o ClientOpts) Validate() error {
+	if (o.ClientCertPath == "") != (o.ClientKeyPath == "") {
+		return errors.New("both the TLS client certificate and key must be specified to authenticate to metrics endpoints")
+	}
+	if (o.CAPath != "" || o.ClientCertPath != "") && !o.TLS {
+		return errors.New("metrics endpoints must be requested via HTTPS to verify their certificates or to present a client certificate")
+	}
+	return nil
+}
+
+// Scheme returns the URL scheme used to request metrics endpoints
+func (o ClientOpts) Scheme() string {
+	if o.TLS {
+		return "https"
+	}
+	return "http"
+}
+
+// NewClient returns an HTTP client requesting metrics endpoints according to the options. The certificates of the
+// endpoints are verified for the given server name, so that endpoints can be requested by their IP address while
+// presenting a certificate issued for the name of their Service.
+func (o ClientOpts) NewClient(serverName string) (*http.Client, error) {
+	if !o.TLS {
+		return &http.Client{}, nil
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: serverName}
+	if o.CAPath != "" {
+		pool, err := loadCertPool(o.CAPath)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if o.ClientCertPath != "" {
+		cert, err := tls.LoadX509KeyPair(o.ClientCertPath, o.ClientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load metrics TLS client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: config}}, nil
+}
+
+// NewRequest returns a GET request of the given metrics endpoint URL, which is authenticated by the bearer token if
+// configured
+func (o ClientOpts) NewRequest(ctx context.Context, url string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics request: %w", err)
+	}
+	if o.BearerTokenPath != "" {
+		token, err := os.ReadFile(o.BearerTokenPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read metrics bearer token: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+	return req, nil
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
+)
+
+func TestClientOpts_Validate(t *testing.T) {
+	require.NoError(t, ClientOpts{}.Validate())
+	require.NoError(t, ClientOpts{BearerTokenPath: "token"}.Validate())
+	require.NoError(t, ClientOpts{TLS: true, CAPath: "ca.crt", ClientCertPath: "tls.crt", ClientKeyPath: "tls.key"}.Validate())
+	require.ErrorContains(t, ClientOpts{TLS: true, ClientCertPath: "tls.crt"}.Validate(), "both the TLS client certificate and key must be specified")
+	require.ErrorContains(t, ClientOpts{CAPath: "ca.crt"}.Validate(), "must be requested via HTTPS")
+}
+
+func TestClientOpts_NewClient(t *testing.T) {
+	dir := t.TempDir()
+	cert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{Hosts: []string{"argocd-metrics"}, Organization: "Argo CD", IsCA: true, ValidFor: time.Hour})
+	require.NoError(t, err)
+	certPEM, keyPEM := tlsutil.EncodeX509KeyPair(*cert)
+	serverOpts := ServerOpts{
+		TLSCertPath:     filepath.Join(dir, "tls.crt"),
+		TLSKeyPath:      filepath.Join(dir, "tls.key"),
+		BearerTokenPath: filepath.Join(dir, "token"),
+	}
+	require.NoError(t, os.WriteFile(serverOpts.TLSCertPath, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(serverOpts.TLSKeyPath, keyPEM, 0o600))
+	require.NoError(t, os.WriteFile(serverOpts.BearerTokenPath, []byte("secret\n"), 0o600))
+
+	server := &http.Server{Handler: newTestHandler(), ReadHeaderTimeout: time.Second}
+	serverOpts.ConfigureServer(server)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = Serve(server, listener) }()
+	defer server.Close()
+
+	get := func(opts ClientOpts, serverName string) (int, error) {
+		client, err := opts.NewClient(serverName)
+		require.NoError(t, err)
+		req, err := opts.NewRequest(t.Context(), opts.Scheme()+"://"+listener.Addr().String()+"/metrics")
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+
+	opts := ClientOpts{TLS: true, CAPath: serverOpts.TLSCertPath, BearerTokenPath: serverOpts.BearerTokenPath}
+	code, err := get(opts, "argocd-metrics")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+
+	code, err = get(ClientOpts{TLS: true, CAPath: serverOpts.TLSCertPath}, "argocd-metrics")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, code)
+
+	_, err = get(opts, "argocd-repo-server")
+	require.ErrorContains(t, err, "certificate")
+}
@@ -0,0 +1,169 @@
+package metrics
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+// healthzPath is served by the metrics endpoints of some components and used by liveness and readiness probes, which
+// cannot authenticate, so it is never protected
+const healthzPath = "/healthz"
+
+// ServerOpts configures TLS and authentication of a metrics endpoint. The zero value serves metrics via plain HTTP
+// without authentication. Certificates, CAs and tokens are read from the files on every TLS handshake or request
+// respectively, so that they can be rotated without restarting the component.
+type ServerOpts struct {
+	// TLSCertPath is the path of the certificate used to serve metrics via HTTPS
+	TLSCertPath string
+	// TLSKeyPath is the path of the private key of the certificate used to serve metrics via HTTPS
+	TLSKeyPath string
+	// ClientCAPath is the path of the CA certificates which are used to authenticate clients presenting a certificate
+	ClientCAPath string
+	// BearerTokenPath is the path of a file containing a token which authenticates clients sending it as bearer token
+	BearerTokenPath string
+}
+
+// AddServerFlags adds the flags configuring TLS and authentication of the metrics endpoint to the given command. The
+// flags default to the values of environment variables with the given prefix, e.g. ARGOCD_SERVER_METRICS_TLS_CERT_PATH
+// for the prefix ARGOCD_SERVER.
+func AddServerFlags(command *cobra.Command, envPrefix string) *ServerOpts {
+	opts := &ServerOpts{}
+	command.Flags().StringVar(&opts.TLSCertPath, "metrics-tls-cert-path", env.StringFromEnv(envPrefix+"_METRICS_TLS_CERT_PATH", ""), "Path of the TLS certificate used to serve metrics via HTTPS")
+	command.Flags().StringVar(&opts.TLSKeyPath, "metrics-tls-key-path", env.StringFromEnv(envPrefix+"_METRICS_TLS_KEY_PATH", ""), "Path of the TLS private key used to serve metrics via HTTPS")
+	command.Flags().StringVar(&opts.ClientCAPath, "metrics-client-ca-path", env.StringFromEnv(envPrefix+"_METRICS_CLIENT_CA_PATH", ""), "Path of the CA certificates used to authenticate metrics clients by their TLS client certificate. Requires metrics to be served via HTTPS")
+	command.Flags().StringVar(&opts.BearerTokenPath, "metrics-bearer-token-path", env.StringFromEnv(envPrefix+"_METRICS_BEARER_TOKEN_PATH", ""), "Path of a file containing the bearer token used to authenticate metrics clients")
+	return opts
+}
+
+// Validate returns an error if the options are inconsistent
+func (o ServerOpts) Validate() error {
+	if (o.TLSCertPath == "") != (o.TLSKeyPath == "") {
+		return errors.New("both the TLS certificate and key must be specified to serve metrics via HTTPS")
+	}
+	if o.ClientCAPath != "" && !o.TLSEnabled() {
+		return errors.New("the TLS certificate and key must be specified to authenticate metrics clients by their TLS client certificate")
+	}
+	return nil
+}
+
+// TLSEnabled returns whether metrics are served via HTTPS
+func (o ServerOpts) TLSEnabled() bool {
+	return o.TLSCertPath != "" && o.TLSKeyPath != ""
+}
+
+// AuthEnabled returns whether clients have to authenticate to scrape metrics
+func (o ServerOpts) AuthEnabled() bool {
+	return o.ClientCAPath != "" || o.BearerTokenPath != ""
+}
+
+// ApplyTLSConfig configures the given TLS config to serve the certificate of the options and to verify client
+// certificates. Client certificates are verified if given, but not required, so that probes without certificates are
+// still able to connect. Requests without a valid client certificate are rejected by the handler returned by
+// WrapHandler instead.
+func (o ServerOpts) ApplyTLSConfig(config *tls.Config) {
+	config.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(o.TLSCertPath, o.TLSKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load metrics TLS certificate: %w", err)
+		}
+		return &cert, nil
+	}
+	if o.ClientCAPath == "" {
+		return
+	}
+	config.ClientAuth = tls.VerifyClientCertIfGiven
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		pool, err := loadCertPool(o.ClientCAPath)
+		if err != nil {
+			return nil, err
+		}
+		clientConfig := config.Clone()
+		clientConfig.GetConfigForClient = nil
+		clientConfig.ClientCAs = pool
+		return clientConfig, nil
+	}
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load metrics CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("invalid metrics CA data in %s", path)
+	}
+	return pool, nil
+}
+
+// WrapHandler returns a handler which rejects requests of clients which are not authenticated by either a verified
+// client certificate or the bearer token
+func (o ServerOpts) WrapHandler(handler http.Handler) http.Handler {
+	if !o.AuthEnabled() {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != healthzPath && !o.authenticated(r) {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (o ServerOpts) authenticated(r *http.Request) bool {
+	if o.ClientCAPath != "" && r.TLS != nil && len(r.TLS.VerifiedChains) > 0 {
+		return true
+	}
+	if o.BearerTokenPath == "" {
+		return false
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return false
+	}
+	expected, err := os.ReadFile(o.BearerTokenPath)
+	if err != nil {
+		log.Warnf("Failed to read metrics bearer token: %v", err)
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(strings.TrimSpace(string(expected)))) == 1
+}
+
+// ConfigureServer configures the given metrics server to use TLS and to authenticate clients according to the options
+func (o ServerOpts) ConfigureServer(server *http.Server) {
+	server.Handler = o.WrapHandler(server.Handler)
+	if o.TLSEnabled() {
+		server.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		o.ApplyTLSConfig(server.TLSConfig)
+	}
+}
+
+// ListenAndServe serves metrics via HTTPS if the given server has been configured to use TLS, otherwise via HTTP
+func ListenAndServe(server *http.Server) error {
+	if server.TLSConfig != nil {
+		return server.ListenAndServeTLS("", "")
+	}
+	return server.ListenAndServe()
+}
+
+// Serve serves metrics on the given listener via HTTPS if the given server has been configured to use TLS, otherwise
+// via HTTP
+func Serve(server *http.Server, listener net.Listener) error {
+	if server.TLSConfig != nil {
+		return server.ServeTLS(listener, "", "")
+	}
+	return server.Serve(listener)
+}
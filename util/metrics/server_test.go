@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
+)
+
+func newTestHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("metrics"))
+	})
+	mux.HandleFunc(healthzPath, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	return mux
+}
+
+func TestServerOpts_Validate(t *testing.T) {
+	require.NoError(t, ServerOpts{}.Validate())
+	require.NoError(t, ServerOpts{TLSCertPath: "tls.crt", TLSKeyPath: "tls.key", ClientCAPath: "ca.crt"}.Validate())
+	require.NoError(t, ServerOpts{BearerTokenPath: "token"}.Validate())
+	require.ErrorContains(t, ServerOpts{TLSCertPath: "tls.crt"}.Validate(), "both the TLS certificate and key must be specified")
+	require.ErrorContains(t, ServerOpts{ClientCAPath: "ca.crt"}.Validate(), "must be specified to authenticate metrics clients")
+}
+
+func TestServerOpts_WrapHandler(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("secret\n"), 0o600))
+
+	serve := func(opts ServerOpts, path string, modify func(r *http.Request)) int {
+		r := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+		if modify != nil {
+			modify(r)
+		}
+		w := httptest.NewRecorder()
+		opts.WrapHandler(newTestHandler()).ServeHTTP(w, r)
+		return w.Code
+	}
+	withToken := func(token string) func(r *http.Request) {
+		return func(r *http.Request) {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	withClientCert := func(r *http.Request) {
+		r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{}}}}
+	}
+
+	assert.Equal(t, http.StatusOK, serve(ServerOpts{}, "/metrics", nil))
+
+	tokenOpts := ServerOpts{BearerTokenPath: tokenPath}
+	assert.Equal(t, http.StatusUnauthorized, serve(tokenOpts, "/metrics", nil))
+	assert.Equal(t, http.StatusUnauthorized, serve(tokenOpts, "/metrics", withToken("wrong")))
+	assert.Equal(t, http.StatusUnauthorized, serve(tokenOpts, "/metrics", withClientCert))
+	assert.Equal(t, http.StatusOK, serve(tokenOpts, "/metrics", withToken("secret")))
+	assert.Equal(t, http.StatusOK, serve(tokenOpts, healthzPath, nil))
+
+	clientCAOpts := ServerOpts{TLSCertPath: "tls.crt", TLSKeyPath: "tls.key", ClientCAPath: "ca.crt"}
+	assert.Equal(t, http.StatusUnauthorized, serve(clientCAOpts, "/metrics", nil))
+	assert.Equal(t, http.StatusUnauthorized, serve(clientCAOpts, "/metrics", withToken("secret")))
+	assert.Equal(t, http.StatusOK, serve(clientCAOpts, "/metrics", withClientCert))
+}
+
+func TestServerOpts_ConfigureServer(t *testing.T) {
+	dir := t.TempDir()
+	cert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{Hosts: []string{"127.0.0.1"}, Organization: "Argo CD", IsCA: true, ValidFor: time.Hour})
+	require.NoError(t, err)
+	certPEM, keyPEM := tlsutil.EncodeX509KeyPair(*cert)
+	opts := ServerOpts{
+		TLSCertPath:     filepath.Join(dir, "tls.crt"),
+		TLSKeyPath:      filepath.Join(dir, "tls.key"),
+		BearerTokenPath: filepath.Join(dir, "token"),
+	}
+	require.NoError(t, os.WriteFile(opts.TLSCertPath, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(opts.TLSKeyPath, keyPEM, 0o600))
+	require.NoError(t, os.WriteFile(opts.BearerTokenPath, []byte("secret"), 0o600))
+
+	server := &http.Server{Handler: newTestHandler(), ReadHeaderTimeout: time.Second}
+	opts.ConfigureServer(server)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = Serve(server, listener) }()
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(certPEM))
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}}}
+	get := func(path string, token string) int {
+		req, err := http.NewRequest(http.MethodGet, "https://"+listener.Addr().String()+path, http.NoBody)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, get("/metrics", "secret"))
+	assert.Equal(t, http.StatusUnauthorized, get("/metrics", ""))
+	assert.Equal(t, http.StatusOK, get(healthzPath, ""))
+}
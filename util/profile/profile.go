@@ -1,15 +1,29 @@
 package profile
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/pprof"
 	"os"
+	"runtime"
+	"sync"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/env"
 )
 
+// DiagnosticsPath is the path of the endpoint serving the runtime diagnostics of a component
+const DiagnosticsPath = "/debug/diagnostics"
+
 var enableProfilerFilePath = env.StringFromEnv("ARGOCD_ENABLE_PROFILER_FILE_PATH", "/home/argocd/params/profiler.enabled")
 
+var (
+	startTime = time.Now()
+
+	statsLock sync.RWMutex
+	stats     = map[string]func() any{}
+)
+
 func wrapHandler(handler http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if data, err := os.ReadFile(enableProfilerFilePath); err == nil && string(data) == "true" {
@@ -20,11 +34,81 @@ func wrapHandler(handler http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
-// RegisterProfiler adds pprof endpoints to mux.
+// RegisterProfiler adds pprof and runtime diagnostics endpoints to mux.
 func RegisterProfiler(mux *http.ServeMux) {
 	mux.HandleFunc("/debug/pprof/", wrapHandler(pprof.Index))
 	mux.HandleFunc("/debug/pprof/cmdline", wrapHandler(pprof.Cmdline))
 	mux.HandleFunc("/debug/pprof/profile", wrapHandler(pprof.Profile))
 	mux.HandleFunc("/debug/pprof/symbol", wrapHandler(pprof.Symbol))
 	mux.HandleFunc("/debug/pprof/trace", wrapHandler(pprof.Trace))
+	RegisterDiagnostics(mux)
+}
+
+// RegisterDiagnostics adds the runtime diagnostics endpoint to mux. It is only required for muxes the pprof endpoints
+// are registered to otherwise, such as http.DefaultServeMux.
+func RegisterDiagnostics(mux *http.ServeMux) {
+	mux.HandleFunc(DiagnosticsPath, wrapHandler(serveDiagnostics))
+}
+
+// RegisterStats adds component specific statistics, e.g. of caches, to the runtime diagnostics. The given function is
+// called whenever the diagnostics are requested and its result is reported under the given name.
+func RegisterStats(name string, fn func() any) {
+	statsLock.Lock()
+	defer statsLock.Unlock()
+	stats[name] = fn
+}
+
+// Diagnostics are the runtime diagnostics of a component
+type Diagnostics struct {
+	GoVersion    string         `json:"goVersion"`
+	Uptime       string         `json:"uptime"`
+	NumCPU       int            `json:"numCPU"`
+	GOMAXPROCS   int            `json:"gomaxprocs"`
+	NumGoroutine int            `json:"numGoroutine"`
+	HeapAlloc    uint64         `json:"heapAlloc"`
+	HeapInuse    uint64         `json:"heapInuse"`
+	HeapObjects  uint64         `json:"heapObjects"`
+	Sys          uint64         `json:"sys"`
+	NumGC        uint32         `json:"numGC"`
+	PauseTotal   string         `json:"pauseTotal"`
+	LastGC       *time.Time     `json:"lastGC,omitempty"`
+	Stats        map[string]any `json:"stats,omitempty"`
+}
+
+// GetDiagnostics returns the runtime diagnostics of the current process
+func GetDiagnostics() Diagnostics {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	diagnostics := Diagnostics{
+		GoVersion:    runtime.Version(),
+		Uptime:       time.Since(startTime).Round(time.Second).String(),
+		NumCPU:       runtime.NumCPU(),
+		GOMAXPROCS:   runtime.GOMAXPROCS(0),
+		NumGoroutine: runtime.NumGoroutine(),
+		HeapAlloc:    mem.HeapAlloc,
+		HeapInuse:    mem.HeapInuse,
+		HeapObjects:  mem.HeapObjects,
+		Sys:          mem.Sys,
+		NumGC:        mem.NumGC,
+		PauseTotal:   time.Duration(mem.PauseTotalNs).String(),
+	}
+	if mem.LastGC > 0 {
+		lastGC := time.Unix(0, int64(mem.LastGC)).UTC()
+		diagnostics.LastGC = &lastGC
+	}
+
+	statsLock.RLock()
+	defer statsLock.RUnlock()
+	if len(stats) > 0 {
+		diagnostics.Stats = make(map[string]any, len(stats))
+		for name, fn := range stats {
+			diagnostics.Stats[name] = fn()
+		}
+	}
+	return diagnostics
+}
+
+func serveDiagnostics(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(GetDiagnostics())
 }
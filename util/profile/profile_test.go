@@ -1,6 +1,7 @@
 package profile
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -52,3 +53,36 @@ func TestRegisterProfile_FileExist(t *testing.T) {
 	_ = f.Close()
 	_ = os.Remove(f.Name())
 }
+
+func TestRegisterProfile_Diagnostics(t *testing.T) {
+	mux := http.NewServeMux()
+	RegisterProfiler(mux)
+	RegisterStats("cache", func() any { return map[string]int{"items": 3} })
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	f, err := os.CreateTemp(t.TempDir(), "test")
+	require.NoError(t, err)
+	_, err = f.WriteString("true")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	oldVal := enableProfilerFilePath
+	enableProfilerFilePath = f.Name()
+	defer func() { enableProfilerFilePath = oldVal }()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL+DiagnosticsPath, http.NoBody)
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var diagnostics Diagnostics
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&diagnostics))
+	require.Positive(t, diagnostics.NumGoroutine)
+	require.Positive(t, diagnostics.HeapAlloc)
+	require.Equal(t, map[string]any{"items": float64(3)}, diagnostics.Stats["cache"])
+}
@@ -2,9 +2,11 @@ package rbac
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/csv"
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -39,6 +41,14 @@ const (
 	ConfigMapMatchModeKey     = "policy.matchMode"
 	GlobMatchMode             = "glob"
 	RegexMatchMode            = "regex"
+	// GlobRegexMatchMode matches policy tokens as globs, except for tokens prefixed with RegexPatternPrefix which are
+	// matched as regular expressions
+	GlobRegexMatchMode = "glob-regex"
+	// RegexPatternPrefix marks a policy token as a regular expression in the glob-regex match mode
+	RegexPatternPrefix = "regex:"
+
+	// maxRegexPatternLength is the maximum length of a regular expression of a policy token in the glob-regex match mode
+	maxRegexPatternLength = 256
 
 	defaultRBACSyncPeriod = 10 * time.Minute
 )
@@ -47,6 +57,7 @@ const (
 type CasbinEnforcer interface {
 	EnableLog(bool)
 	Enforce(rvals ...any) (bool, error)
+	EnforceEx(rvals ...any) (bool, []string, error)
 	LoadPolicy() error
 	EnableEnforce(bool)
 	AddFunction(name string, function govaluate.ExpressionFunction)
@@ -69,6 +80,7 @@ const (
 	ResourceLogs              = "logs"
 	ResourceExec              = "exec"
 	ResourceExtensions        = "extensions"
+	ResourceDiagnostics       = "diagnostics"
 
 	// please add new items to Actions
 	ActionGet      = "get"
@@ -96,6 +108,7 @@ var (
 		ResourceLogs,
 		ResourceExec,
 		ResourceExtensions,
+		ResourceDiagnostics,
 	}
 	Actions = []string{
 		ActionGet,
@@ -137,14 +150,26 @@ type Enforcer struct {
 	model              model.Model
 	defaultRole        string
 	matchMode          string
+	// policyResourceVersion is the resource version of the RBAC ConfigMap the user defined policy is loaded from
+	policyResourceVersion string
+	// compilationObserver is notified about the duration of each compilation of an enforcer
+	compilationObserver func(duration time.Duration)
 }
 
 // cachedEnforcer holds the Casbin enforcer instances and optional custom project policy
 type cachedEnforcer struct {
 	enforcer CasbinEnforcer
+	project  string
 	policy   string
 }
 
+// enforcerCacheKey returns the key of the cached enforcer for the given project and project policy. The key includes
+// the resource version of the RBAC ConfigMap, so enforcers compiled for previous versions of the policy are not used.
+func (e *Enforcer) enforcerCacheKey(project string, policy string) string {
+	policyHash := sha256.Sum256([]byte(policy))
+	return fmt.Sprintf("%s/%s/%x", e.policyResourceVersion, project, policyHash[:8])
+}
+
 func (e *Enforcer) invalidateCache(actions ...func()) {
 	e.lock.Lock()
 	defer e.lock.Unlock()
@@ -167,30 +192,39 @@ func (e *Enforcer) getCasbinEnforcer(project string, policy string) CasbinEnforc
 func (e *Enforcer) tryGetCasbinEnforcer(project string, policy string) (CasbinEnforcer, error) {
 	e.lock.Lock()
 	defer e.lock.Unlock()
-	var cached *cachedEnforcer
-	val, ok := e.enforcerCache.Get(project)
-	if ok {
-		if c, ok := val.(*cachedEnforcer); ok && c.policy == policy {
-			cached = c
+	key := e.enforcerCacheKey(project, policy)
+	if val, ok := e.enforcerCache.Get(key); ok {
+		if c, ok := val.(*cachedEnforcer); ok && c.project == project && c.policy == policy {
+			return c.enforcer, nil
 		}
 	}
-	if cached != nil {
-		return cached.enforcer, nil
-	}
-	matchFunc := globMatchFunc
-	if e.matchMode == RegexMatchMode {
-		matchFunc = util.RegexMatchFunc
+	enforcer, err := e.compileEnforcer(project, policy)
+	if err != nil {
+		return nil, err
 	}
+	e.enforcerCache.SetDefault(key, &cachedEnforcer{enforcer: enforcer, project: project, policy: policy})
+	return enforcer, nil
+}
 
+// compileEnforcer compiles an enforcer for the given optional project and project policy. It falls back to an
+// enforcer without the project policy if the project policy is invalid. The caller must hold the lock.
+func (e *Enforcer) compileEnforcer(project string, policy string) (CasbinEnforcer, error) {
+	start := time.Now()
 	var err error
 	var enforcer CasbinEnforcer
+	var matchFunc govaluate.ExpressionFunction
 	if policy != "" {
-		if enforcer, err = newEnforcerSafe(matchFunc, e.model, newAdapter(e.adapter.builtinPolicy, e.adapter.userDefinedPolicy, policy)); err != nil {
+		if matchFunc, err = e.matchFunc(e.adapter.builtinPolicy, e.adapter.userDefinedPolicy, policy); err == nil {
+			enforcer, err = newEnforcerSafe(matchFunc, e.model, newAdapter(e.adapter.builtinPolicy, e.adapter.userDefinedPolicy, policy))
+		}
+		if err != nil {
 			// fallback to default policy if project policy is invalid
 			log.Errorf("Failed to load project '%s' policy", project)
-			enforcer, err = newEnforcerSafe(matchFunc, e.model, e.adapter)
+			if matchFunc, err = e.matchFunc(e.adapter.builtinPolicy, e.adapter.userDefinedPolicy); err == nil {
+				enforcer, err = newEnforcerSafe(matchFunc, e.model, e.adapter)
+			}
 		}
-	} else {
+	} else if matchFunc, err = e.matchFunc(e.adapter.builtinPolicy, e.adapter.userDefinedPolicy); err == nil {
 		enforcer, err = newEnforcerSafe(matchFunc, e.model, e.adapter)
 	}
 	if err != nil {
@@ -200,7 +234,9 @@ func (e *Enforcer) tryGetCasbinEnforcer(project string, policy string) (CasbinEn
 	enforcer.AddFunction("globOrRegexMatch", matchFunc)
 	enforcer.EnableLog(e.enableLog)
 	enforcer.EnableEnforce(e.enabled)
-	e.enforcerCache.SetDefault(project, &cachedEnforcer{enforcer: enforcer, policy: policy})
+	if e.compilationObserver != nil {
+		e.compilationObserver(time.Since(start))
+	}
 	return enforcer, nil
 }
 
@@ -297,17 +333,115 @@ func globMatchFunc(args ...any) (any, error) {
 	return glob.Match(pattern, val), nil
 }
 
-// SetMatchMode set match mode on runtime, glob match or regex match
+// matchFunc returns the match func of the current match mode. The regular expressions of the given policies are
+// compiled upfront in the glob-regex match mode.
+func (e *Enforcer) matchFunc(policies ...string) (govaluate.ExpressionFunction, error) {
+	switch e.matchMode {
+	case RegexMatchMode:
+		return util.RegexMatchFunc, nil
+	case GlobRegexMatchMode:
+		regexes, err := compilePolicyRegexes(policies...)
+		if err != nil {
+			return nil, err
+		}
+		return newGlobRegexMatchFunc(regexes), nil
+	default:
+		return globMatchFunc, nil
+	}
+}
+
+// newGlobRegexMatchFunc returns a match func which matches patterns prefixed with RegexPatternPrefix using the given
+// precompiled regular expressions and all other patterns as globs
+func newGlobRegexMatchFunc(regexes map[string]*regexp.Regexp) govaluate.ExpressionFunction {
+	return func(args ...any) (any, error) {
+		if len(args) < 2 {
+			return false, nil
+		}
+		val, ok := args[0].(string)
+		if !ok {
+			return false, nil
+		}
+		pattern, ok := args[1].(string)
+		if !ok {
+			return false, nil
+		}
+		expr, isRegex := strings.CutPrefix(pattern, RegexPatternPrefix)
+		if !isRegex {
+			return glob.Match(pattern, val), nil
+		}
+		re, ok := regexes[expr]
+		if !ok {
+			return false, fmt.Errorf("regular expression %q of the policy was not compiled", expr)
+		}
+		return re.MatchString(val), nil
+	}
+}
+
+// compilePolicyRegexes compiles the regular expressions of the policy tokens prefixed with RegexPatternPrefix. The
+// expressions are anchored, so they need to match the whole value.
+func compilePolicyRegexes(policies ...string) (map[string]*regexp.Regexp, error) {
+	regexes := map[string]*regexp.Regexp{}
+	for _, policy := range policies {
+		for _, line := range strings.Split(policy, "\n") {
+			line = strings.TrimSpace(line)
+			if !strings.Contains(line, RegexPatternPrefix) || strings.HasPrefix(line, "#") {
+				continue
+			}
+			reader := csv.NewReader(strings.NewReader(line))
+			reader.TrimLeadingSpace = true
+			tokens, err := reader.Read()
+			if err != nil {
+				return nil, err
+			}
+			if len(tokens) < 1 || tokens[0] != "p" {
+				continue
+			}
+			// only the resource, action and object are matched using the match func
+			for _, token := range tokens[2:min(len(tokens), 5)] {
+				expr, isRegex := strings.CutPrefix(token, RegexPatternPrefix)
+				if !isRegex {
+					continue
+				}
+				if _, ok := regexes[expr]; ok {
+					continue
+				}
+				if len(expr) > maxRegexPatternLength {
+					return nil, fmt.Errorf("regular expression %q exceeds the maximum length of %d characters", expr, maxRegexPatternLength)
+				}
+				re, err := regexp.Compile("^(?:" + expr + ")$")
+				if err != nil {
+					return nil, fmt.Errorf("invalid regular expression %q: %w", expr, err)
+				}
+				regexes[expr] = re
+			}
+		}
+	}
+	return regexes, nil
+}
+
+// SetMatchMode set match mode on runtime, glob match, regex match or glob match with regex patterns
 func (e *Enforcer) SetMatchMode(mode string) {
 	e.invalidateCache(func() {
-		if mode == RegexMatchMode {
-			e.matchMode = RegexMatchMode
-		} else {
-			e.matchMode = GlobMatchMode
-		}
+		e.setMatchMode(mode)
 	})
 }
 
+func (e *Enforcer) setMatchMode(mode string) {
+	switch mode {
+	case RegexMatchMode, GlobRegexMatchMode:
+		e.matchMode = mode
+	default:
+		e.matchMode = GlobMatchMode
+	}
+}
+
+// SetCompilationObserver sets a function which is notified about the duration of each compilation of the policies
+func (e *Enforcer) SetCompilationObserver(observer func(duration time.Duration)) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.compilationObserver = observer
+}
+
 // SetDefaultRole sets a default role to use during enforcement. Will fall back to this role if
 // normal enforcement fails
 func (e *Enforcer) SetDefaultRole(roleName string) {
@@ -327,6 +461,23 @@ func (e *Enforcer) Enforce(rvals ...any) bool {
 	return enforce(e.getCasbinEnforcer("", ""), e.defaultRole, e.claimsEnforcerFunc, rvals...)
 }
 
+// EnforceEx is like Enforce for a subject given as string, but additionally returns the policy rule which decided the
+// result. The rule is empty if no rule matched the request.
+func (e *Enforcer) EnforceEx(subject string, rvals ...any) (bool, []string) {
+	enf := e.getCasbinEnforcer("", "")
+	// check the default role
+	if e.defaultRole != "" {
+		if ok, rule, err := enf.EnforceEx(append([]any{e.defaultRole}, rvals...)...); ok && err == nil {
+			return true, rule
+		}
+	}
+	ok, rule, err := enf.EnforceEx(append([]any{subject}, rvals...)...)
+	if err != nil {
+		return false, nil
+	}
+	return ok, rule
+}
+
 // EnforceErr is a convenience helper to wrap a failed enforcement with a detailed error about the request
 func (e *Enforcer) EnforceErr(rvals ...any) error {
 	if !e.Enforce(rvals...) {
@@ -517,15 +668,46 @@ func PolicyCSV(data map[string]string) string {
 	return strBuilder.String()
 }
 
-// syncUpdate updates the enforcer
+// syncUpdate updates the enforcer. Updates of a resource version of the ConfigMap which is already loaded are ignored.
 func (e *Enforcer) syncUpdate(cm *corev1.ConfigMap, onUpdated func(cm *corev1.ConfigMap) error) error {
+	e.lock.Lock()
+	loaded := cm.ResourceVersion != "" && cm.ResourceVersion == e.policyResourceVersion
+	e.lock.Unlock()
+	if loaded {
+		return nil
+	}
 	e.SetDefaultRole(cm.Data[ConfigMapPolicyDefaultKey])
-	e.SetMatchMode(cm.Data[ConfigMapMatchModeKey])
 	policyCSV := PolicyCSV(cm.Data)
 	if err := onUpdated(cm); err != nil {
 		return err
 	}
-	return e.SetUserPolicy(policyCSV)
+	return e.setConfigMapPolicy(cm.Data[ConfigMapMatchModeKey], policyCSV, cm.ResourceVersion)
+}
+
+// setConfigMapPolicy sets the match mode and user policy loaded from the given resource version of the RBAC ConfigMap.
+// The enforcers of the projects which were in use before the update are compiled right away, rather than by the first
+// request of each project after the update.
+func (e *Enforcer) setConfigMapPolicy(matchMode string, policy string, resourceVersion string) error {
+	var inUse []*cachedEnforcer
+	e.invalidateCache(func() {
+		for _, item := range e.enforcerCache.Items() {
+			if c, ok := item.Object.(*cachedEnforcer); ok && c.project != "" {
+				inUse = append(inUse, c)
+			}
+		}
+		e.setMatchMode(matchMode)
+		e.adapter.userDefinedPolicy = policy
+		e.policyResourceVersion = resourceVersion
+	})
+	if err := e.LoadPolicy(); err != nil {
+		return err
+	}
+	for _, c := range inUse {
+		if _, err := e.tryGetCasbinEnforcer(c.project, c.policy); err != nil {
+			log.Warnf("Failed to compile policy of project '%s': %v", c.project, err)
+		}
+	}
+	return nil
 }
 
 // ValidatePolicy verifies a policy string is acceptable to casbin
@@ -534,6 +716,9 @@ func ValidatePolicy(policy string) error {
 	if err != nil {
 		return fmt.Errorf("policy syntax error: %s", policy)
 	}
+	if _, err := compilePolicyRegexes(policy); err != nil {
+		return fmt.Errorf("policy syntax error: %w", err)
+	}
 
 	// Check for referential integrity
 	if err := CheckUserDefinedRoleReferentialIntegrity(casbinEnforcer); err != nil {
@@ -2,6 +2,7 @@ package rbac
 
 import (
 	"context"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -190,6 +191,33 @@ func TestDefaultRole(t *testing.T) {
 	assert.True(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
 }
 
+// TestEnforceEx tests that the policy rule deciding the result is returned
+func TestEnforceEx(t *testing.T) {
+	kubeclientset := fake.NewClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.syncUpdate(fakeConfigMap(), noOpUpdate))
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	_ = enf.SetUserPolicy(`
+p, alice, applications, *, foo/*, allow
+p, alice, applications, delete, foo/bar, deny
+`)
+
+	ok, rule := enf.EnforceEx("alice", "applications", "get", "foo/bar")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"alice", "applications", "*", "foo/*", "allow"}, rule)
+	ok, rule = enf.EnforceEx("alice", "applications", "delete", "foo/bar")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"alice", "applications", "delete", "foo/bar", "deny"}, rule)
+	ok, rule = enf.EnforceEx("bob", "applications", "get", "foo/bar")
+	assert.False(t, ok)
+	assert.Empty(t, rule)
+
+	enf.SetDefaultRole("role:readonly")
+	ok, rule = enf.EnforceEx("bob", "applications", "get", "foo/bar")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"role:readonly", "applications", "get", "*/*", "allow"}, rule)
+}
+
 // TestURLAsObjectName tests the ability to have a URL as an object name
 func TestURLAsObjectName(t *testing.T) {
 	kubeclientset := fake.NewClientset()
@@ -266,6 +294,41 @@ func TestUpdatePolicy(t *testing.T) {
 	assert.False(t, enf.Enforce("bob", "applications", "get", "foo/obj"))
 }
 
+func TestSyncUpdatePolicyCache(t *testing.T) {
+	kubeclientset := fake.NewClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	compilations := 0
+	enf.SetCompilationObserver(func(_ time.Duration) {
+		compilations++
+	})
+	projectPolicy := "p, proj:my-proj:my-role, applications, get, my-proj/*, allow"
+
+	cm := fakeConfigMap()
+	cm.ResourceVersion = "1"
+	cm.Data[ConfigMapPolicyCSVKey] = "p, alice, *, get, foo/obj, allow"
+	require.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	assert.Equal(t, 1, compilations)
+	assert.True(t, enf.EnforceRuntimePolicy("my-proj", projectPolicy, "proj:my-proj:my-role", "applications", "get", "my-proj/app"))
+	assert.Equal(t, 2, compilations)
+
+	// an update of a loaded resource version doesn't recompile the policies
+	require.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	assert.True(t, enf.Enforce("alice", "applications", "get", "foo/obj"))
+	assert.True(t, enf.EnforceRuntimePolicy("my-proj", projectPolicy, "proj:my-proj:my-role", "applications", "get", "my-proj/app"))
+	assert.Equal(t, 2, compilations)
+
+	// an update to a new resource version compiles the global policy and the project policy in use right away
+	cm = cm.DeepCopy()
+	cm.ResourceVersion = "2"
+	cm.Data[ConfigMapPolicyCSVKey] = "p, bob, *, get, foo/obj, allow"
+	require.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	assert.Equal(t, 4, compilations)
+	assert.False(t, enf.Enforce("alice", "applications", "get", "foo/obj"))
+	assert.True(t, enf.Enforce("bob", "applications", "get", "foo/obj"))
+	assert.True(t, enf.EnforceRuntimePolicy("my-proj", projectPolicy, "proj:my-proj:my-role", "applications", "get", "my-proj/app"))
+	assert.Equal(t, 4, compilations)
+}
+
 func TestNoPolicy(t *testing.T) {
 	cm := fakeConfigMap()
 	kubeclientset := fake.NewClientset(cm)
@@ -461,6 +524,78 @@ p, alice, clusters, get, "https://github.com/argo[a-z]{4}/argo-[a-z]+.git", allo
 	assert.False(t, enf.Enforce("alice", "clusters", "get", "https://github.com/argoproj/1argo-cd.git"))
 }
 
+func TestGlobRegexMatchMode(t *testing.T) {
+	cm := fakeConfigMap()
+	cm.Data[ConfigMapMatchModeKey] = GlobRegexMatchMode
+	kubeclientset := fake.NewClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	policy := `
+p, alice, applications, get, "regex:team-(a|b)-[0-9]+/.*", allow
+p, alice, applications, sync, team-a-*/*, allow
+p, alice, applications, sync, "regex:team-a-[0-9]+/prod-.*", deny
+`
+	require.NoError(t, enf.SetUserPolicy(policy))
+
+	assert.True(t, enf.Enforce("alice", "applications", "get", "team-a-1/guestbook"))
+	assert.True(t, enf.Enforce("alice", "applications", "get", "team-b-42/guestbook"))
+	assert.False(t, enf.Enforce("alice", "applications", "get", "team-c-1/guestbook"))
+	// regular expressions are anchored
+	assert.False(t, enf.Enforce("alice", "applications", "get", "my-team-a-1/guestbook"))
+	assert.False(t, enf.Enforce("alice", "applications", "get", "team-a-x/guestbook"))
+	// globs are still supported
+	assert.True(t, enf.Enforce("alice", "applications", "sync", "team-a-1/guestbook"))
+	assert.False(t, enf.Enforce("alice", "applications", "sync", "team-a-1/prod-guestbook"))
+}
+
+func TestGlobRegexMatchModeInvalidRegex(t *testing.T) {
+	cm := fakeConfigMap()
+	cm.Data[ConfigMapMatchModeKey] = GlobRegexMatchMode
+	kubeclientset := fake.NewClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+
+	err := enf.SetUserPolicy(`p, alice, applications, get, "regex:team-(a/.*", allow`)
+	require.ErrorContains(t, err, "invalid regular expression")
+
+	err = enf.SetUserPolicy(`p, alice, applications, get, "regex:` + strings.Repeat("a", maxRegexPatternLength+1) + `", allow`)
+	require.ErrorContains(t, err, "exceeds the maximum length")
+}
+
+func TestGlobMatchModeIgnoresRegexPrefix(t *testing.T) {
+	kubeclientset := fake.NewClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	require.NoError(t, enf.syncUpdate(fakeConfigMap(), noOpUpdate))
+	require.NoError(t, enf.SetUserPolicy(`p, alice, applications, get, "regex:team-.*", allow`))
+
+	assert.False(t, enf.Enforce("alice", "applications", "get", "team-a"))
+	assert.True(t, enf.Enforce("alice", "applications", "get", "regex:team-.*"))
+}
+
+func TestGlobRegexMatchFunc(t *testing.T) {
+	matchFunc := newGlobRegexMatchFunc(map[string]*regexp.Regexp{"arg/[0-9]+": regexp.MustCompile("^(?:arg/[0-9]+)$")})
+
+	ok, _ := matchFunc("arg1")
+	assert.False(t, ok.(bool))
+
+	ok, _ = matchFunc("arg/123", "arg/*")
+	assert.True(t, ok.(bool))
+
+	ok, _ = matchFunc("arg/123", "regex:arg/[0-9]+")
+	assert.True(t, ok.(bool))
+
+	ok, _ = matchFunc("arg/abc", "regex:arg/[0-9]+")
+	assert.False(t, ok.(bool))
+
+	_, err := matchFunc("arg/abc", "regex:arg/.*")
+	require.Error(t, err)
+}
+
+func TestValidatePolicyRegex(t *testing.T) {
+	require.NoError(t, ValidatePolicy(`p, alice, applications, get, "regex:team-[a-z]+/.*", allow`))
+	require.ErrorContains(t, ValidatePolicy(`p, alice, applications, get, "regex:team-[a-z+/.*", allow`), "invalid regular expression")
+}
+
 func TestGlobMatchFunc(t *testing.T) {
 	ok, _ := globMatchFunc("arg1")
 	assert.False(t, ok.(bool))
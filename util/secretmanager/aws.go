@@ -0,0 +1,56 @@
+package secretmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+// AWSConfig configures AWS Secrets Manager. The credentials are taken from the environment of the pod, e.g. using
+// IAM roles for service accounts.
+type AWSConfig struct {
+	// Region of the secrets, defaults to the region of the environment
+	Region string `json:"region,omitempty"`
+	// Role is the ARN of a role to assume for reading the secrets
+	Role string `json:"role,omitempty"`
+}
+
+// AWSProvider fetches secrets from AWS Secrets Manager
+type AWSProvider struct {
+	client secretsmanageriface.SecretsManagerAPI
+}
+
+// NewAWSProvider returns a new AWSProvider
+func NewAWSProvider(config *AWSConfig) (*AWSProvider, error) {
+	awsConfig := &aws.Config{}
+	if config.Region != "" {
+		awsConfig.Region = aws.String(config.Region)
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+	if config.Role != "" {
+		sess = sess.Copy(&aws.Config{Credentials: stscreds.NewCredentials(sess, config.Role)})
+	}
+	return &AWSProvider{client: secretsmanager.New(sess)}, nil
+}
+
+// GetSecret returns the current version of the secret with the given name or ARN. If a key is given, the secret must
+// hold a JSON object.
+func (p *AWSProvider) GetSecret(ctx context.Context, name string, key string) (string, error) {
+	out, err := p.client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(name)})
+	if err != nil {
+		return "", fmt.Errorf("error getting AWS secret %s: %w", name, err)
+	}
+	secret := aws.StringValue(out.SecretString)
+	if out.SecretString == nil {
+		secret = string(out.SecretBinary)
+	}
+	return valueOfKey(secret, key)
+}
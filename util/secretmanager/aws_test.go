@@ -0,0 +1,43 @@
+package secretmanager
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+	secrets map[string]*secretsmanager.GetSecretValueOutput
+}
+
+func (f *fakeSecretsManager) GetSecretValueWithContext(_ aws.Context, input *secretsmanager.GetSecretValueInput, _ ...request.Option) (*secretsmanager.GetSecretValueOutput, error) {
+	out, ok := f.secrets[aws.StringValue(input.SecretId)]
+	if !ok {
+		return nil, &secretsmanager.ResourceNotFoundException{Message_: aws.String("secret not found")}
+	}
+	return out, nil
+}
+
+func TestAWSProvider(t *testing.T) {
+	provider := &AWSProvider{client: &fakeSecretsManager{secrets: map[string]*secretsmanager.GetSecretValueOutput{
+		"argocd": {SecretString: aws.String(`{"webhook":"webhook-secret"}`)},
+		"binary": {SecretBinary: []byte("binary-secret")},
+	}}}
+
+	value, err := provider.GetSecret(t.Context(), "argocd", "webhook")
+	require.NoError(t, err)
+	assert.Equal(t, "webhook-secret", value)
+
+	value, err = provider.GetSecret(t.Context(), "binary", "")
+	require.NoError(t, err)
+	assert.Equal(t, "binary-secret", value)
+
+	_, err = provider.GetSecret(t.Context(), "missing", "")
+	require.ErrorContains(t, err, "error getting AWS secret missing")
+}
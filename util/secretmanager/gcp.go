@@ -0,0 +1,97 @@
+package secretmanager
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	// gcpSecretManagerEndpoint is the endpoint of the Google Cloud Secret Manager API
+	gcpSecretManagerEndpoint = "https://secretmanager.googleapis.com"
+	// gcpCloudPlatformScope is the OAuth scope required to access secrets
+	gcpCloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// GCPConfig configures Google Cloud Secret Manager. The credentials are taken from the environment of the pod, e.g.
+// using Workload Identity.
+type GCPConfig struct {
+	// Project the secrets referenced by their name only belong to
+	Project string `json:"project,omitempty"`
+}
+
+// GCPProvider fetches secrets from Google Cloud Secret Manager
+type GCPProvider struct {
+	config   *GCPConfig
+	endpoint string
+	// newClient returns the HTTP client authenticating requests to the Secret Manager API
+	newClient func(ctx context.Context) (*http.Client, error)
+}
+
+// NewGCPProvider returns a new GCPProvider
+func NewGCPProvider(config *GCPConfig) *GCPProvider {
+	return &GCPProvider{
+		config:   config,
+		endpoint: gcpSecretManagerEndpoint,
+		newClient: func(ctx context.Context) (*http.Client, error) {
+			return google.DefaultClient(ctx, gcpCloudPlatformScope)
+		},
+	}
+}
+
+// GetSecret returns the secret with the given name, which is either the full resource name of the secret, e.g.
+// projects/my-project/secrets/my-secret, optionally followed by /versions/<version>, or the name of a secret in the
+// configured project. The latest version is used if no version is given. If a key is given, the secret must hold a
+// JSON object.
+func (p *GCPProvider) GetSecret(ctx context.Context, name string, key string) (string, error) {
+	resourceName := name
+	if !strings.HasPrefix(resourceName, "projects/") {
+		if p.config.Project == "" {
+			return "", fmt.Errorf("GCP secret %s is not a full resource name and no project is configured", name)
+		}
+		resourceName = fmt.Sprintf("projects/%s/secrets/%s", p.config.Project, resourceName)
+	}
+	if !strings.Contains(resourceName, "/versions/") {
+		resourceName += "/versions/latest"
+	}
+
+	client, err := p.newClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error creating GCP client: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/%s:access", p.endpoint, resourceName), http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error getting GCP secret %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("error getting GCP secret %s: %w", name, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error getting GCP secret %s: status %d: %s", name, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	var res struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return "", fmt.Errorf("error decoding GCP secret %s: %w", name, err)
+	}
+	secret, err := base64.StdEncoding.DecodeString(res.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("error decoding GCP secret %s: %w", name, err)
+	}
+	return valueOfKey(string(secret), key)
+}
@@ -0,0 +1,48 @@
+package secretmanager
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGCPProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/projects/my-project/secrets/argocd/versions/latest:access":
+			// {"webhook":"webhook-secret"}
+			_, _ = w.Write([]byte(`{"payload":{"data":"eyJ3ZWJob29rIjoid2ViaG9vay1zZWNyZXQifQ=="}}`))
+		case "/v1/projects/other/secrets/token/versions/3:access":
+			// token-v3
+			_, _ = w.Write([]byte(`{"payload":{"data":"dG9rZW4tdjM="}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewGCPProvider(&GCPConfig{Project: "my-project"})
+	provider.endpoint = server.URL
+	provider.newClient = func(_ context.Context) (*http.Client, error) {
+		return server.Client(), nil
+	}
+
+	value, err := provider.GetSecret(t.Context(), "argocd", "webhook")
+	require.NoError(t, err)
+	assert.Equal(t, "webhook-secret", value)
+
+	value, err = provider.GetSecret(t.Context(), "projects/other/secrets/token/versions/3", "")
+	require.NoError(t, err)
+	assert.Equal(t, "token-v3", value)
+
+	_, err = provider.GetSecret(t.Context(), "missing", "")
+	require.ErrorContains(t, err, "status 404")
+
+	provider.config.Project = ""
+	_, err = provider.GetSecret(t.Context(), "argocd", "")
+	require.ErrorContains(t, err, "no project is configured")
+}
@@ -0,0 +1,338 @@
+// Package secretmanager resolves references to secrets held by external secret managers, such as HashiCorp Vault,
+// AWS Secrets Manager and Google Cloud Secret Manager, so sensitive settings do not need to be stored in Kubernetes
+// secrets.
+//
+// A reference has the format $ref:<provider>:<name>[#<key>], e.g. $ref:vault:secret/data/argocd#oidc.clientSecret.
+package secretmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// RefPrefix is the prefix of a reference to a secret held by an external secret manager
+	RefPrefix = "$ref:"
+
+	// ProviderVault is the provider name of HashiCorp Vault
+	ProviderVault = "vault"
+	// ProviderAWS is the provider name of AWS Secrets Manager
+	ProviderAWS = "aws"
+	// ProviderGCP is the provider name of Google Cloud Secret Manager
+	ProviderGCP = "gcp"
+
+	// DefaultRefreshInterval is the default interval after which resolved secrets are fetched again
+	DefaultRefreshInterval = 5 * time.Minute
+	// initialFailureBackoff is the delay after which a secret is fetched again after fetching it failed for the first
+	// time. The delay doubles with every further failure, up to the refresh interval.
+	initialFailureBackoff = 10 * time.Second
+)
+
+// referenceRegexp matches the references contained in a text, e.g. in a YAML document. A reference ends at whitespace,
+// quotes and YAML/JSON delimiters.
+var referenceRegexp = regexp.MustCompile(`\$ref:[a-z0-9]+:[^\s"'` + "`" + `,{}\[\]]+`)
+
+// Reference is a reference to a secret held by an external secret manager
+type Reference struct {
+	// Provider is the name of the provider holding the secret, e.g. vault
+	Provider string
+	// Name identifies the secret within the provider, e.g. the path of a Vault secret or the ARN of an AWS secret
+	Name string
+	// Key selects a value of the secret. If empty, the whole secret is used.
+	Key string
+}
+
+// IsReference returns whether the given value is a reference to a secret held by an external secret manager
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, RefPrefix)
+}
+
+// ParseReference parses a reference with the format $ref:<provider>:<name>[#<key>]
+func ParseReference(value string) (*Reference, error) {
+	if !IsReference(value) {
+		return nil, fmt.Errorf("%q is not a secret reference: it must start with %s", value, RefPrefix)
+	}
+	provider, name, ok := strings.Cut(strings.TrimPrefix(value, RefPrefix), ":")
+	if !ok || provider == "" || name == "" {
+		return nil, fmt.Errorf("invalid secret reference %q: the format is %s<provider>:<name>[#<key>]", value, RefPrefix)
+	}
+	ref := &Reference{Provider: provider, Name: name}
+	if i := strings.LastIndex(name, "#"); i >= 0 {
+		ref.Name = name[:i]
+		ref.Key = name[i+1:]
+		if ref.Name == "" || ref.Key == "" {
+			return nil, fmt.Errorf("invalid secret reference %q: the format is %s<provider>:<name>[#<key>]", value, RefPrefix)
+		}
+	}
+	return ref, nil
+}
+
+// FindReferences returns the distinct references contained in the given text
+func FindReferences(text string) []string {
+	if !strings.Contains(text, RefPrefix) {
+		return nil
+	}
+	var refs []string
+	seen := map[string]bool{}
+	for _, ref := range referenceRegexp.FindAllString(text, -1) {
+		if !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// Provider fetches secrets from an external secret manager
+type Provider interface {
+	// GetSecret returns the value with the given key of the secret with the given name. If the key is empty, the
+	// whole secret is returned.
+	GetSecret(ctx context.Context, name string, key string) (string, error)
+}
+
+// valueOfKey returns the value with the given key of a secret holding a JSON object, or the whole secret if the key is
+// empty
+func valueOfKey(secret string, key string) (string, error) {
+	if key == "" {
+		return secret, nil
+	}
+	var values map[string]any
+	if err := json.Unmarshal([]byte(secret), &values); err != nil {
+		return "", fmt.Errorf("cannot get key %q: secret is not a JSON object", key)
+	}
+	return valueOfMapKey(values, key)
+}
+
+func valueOfMapKey(values map[string]any, key string) (string, error) {
+	value, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("key %q does not exist in secret", key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling value of key %q: %w", key, err)
+	}
+	return string(data), nil
+}
+
+type cachedSecret struct {
+	value     string
+	fetchedAt time.Time
+}
+
+type failedFetch struct {
+	err      error
+	failures int
+	retryAt  time.Time
+}
+
+// Resolver resolves references using the configured providers. Resolved secrets are cached and fetched again after
+// the refresh interval, so rotated secrets are picked up. If fetching a cached secret fails, the cached value is used
+// until the secret can be fetched again. Failures are cached too, and the secret is only fetched again after a backoff.
+type Resolver struct {
+	providers       map[string]Provider
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mutex sync.Mutex
+	cache map[string]cachedSecret
+	// pending are the references which have been looked up but not fetched yet
+	pending map[string]bool
+	// failures are the references which could not be fetched the last time
+	failures map[string]failedFetch
+}
+
+// NewResolver returns a new Resolver fetching secrets from the given providers, keyed by provider name
+func NewResolver(providers map[string]Provider, refreshInterval time.Duration) *Resolver {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+	return &Resolver{
+		providers:       providers,
+		refreshInterval: refreshInterval,
+		now:             time.Now,
+		cache:           map[string]cachedSecret{},
+		pending:         map[string]bool{},
+		failures:        map[string]failedFetch{},
+	}
+}
+
+// Lookup returns the last known value of the secret the given reference points to, without fetching it, so it never
+// blocks on a secret manager. References without a known value are fetched by the next Refresh.
+func (r *Resolver) Lookup(value string) (string, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if cached, ok := r.cache[value]; ok {
+		return cached.value, true
+	}
+	if _, failed := r.failures[value]; !failed {
+		r.pending[value] = true
+	}
+	return "", false
+}
+
+// Resolve returns the value of the secret the given reference points to, fetching it if it is not cached
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	r.mutex.Lock()
+	cached, ok := r.cache[value]
+	failure, failed := r.failures[value]
+	r.mutex.Unlock()
+	if ok && (r.now().Sub(cached.fetchedAt) < r.refreshInterval || failed && r.now().Before(failure.retryAt)) {
+		return cached.value, nil
+	}
+	if failed && r.now().Before(failure.retryAt) {
+		return "", failure.err
+	}
+
+	secret, err := r.fetch(ctx, value)
+	if err != nil {
+		if ok {
+			log.Warnf("Failed to refresh secret %s, using cached value: %v", value, err)
+			return cached.value, nil
+		}
+		return "", err
+	}
+	return secret, nil
+}
+
+// Refresh fetches the secrets which have been looked up but not fetched yet, and the cached secrets whose refresh
+// interval has elapsed, and returns whether any of them changed. Secrets which could not be fetched are only fetched
+// again once their backoff has elapsed.
+func (r *Resolver) Refresh(ctx context.Context) bool {
+	r.mutex.Lock()
+	now := r.now()
+	due := map[string]bool{}
+	for value := range r.pending {
+		due[value] = true
+	}
+	for value, cached := range r.cache {
+		if now.Sub(cached.fetchedAt) >= r.refreshInterval {
+			due[value] = true
+		}
+	}
+	for value, failure := range r.failures {
+		due[value] = !now.Before(failure.retryAt)
+	}
+	previous := map[string]cachedSecret{}
+	for value := range due {
+		if cached, ok := r.cache[value]; ok {
+			previous[value] = cached
+		}
+	}
+	clear(r.pending)
+	r.mutex.Unlock()
+
+	changed := false
+	for value, isDue := range due {
+		if !isDue {
+			continue
+		}
+		secret, err := r.fetch(ctx, value)
+		if err != nil {
+			log.Warnf("Failed to refresh secret %s: %v", value, err)
+			continue
+		}
+		if cached, ok := previous[value]; !ok || secret != cached.value {
+			if ok {
+				log.Infof("Secret %s has been rotated", value)
+			}
+			changed = true
+		}
+	}
+	return changed
+}
+
+// fetch fetches the secret the given reference points to and caches it
+func (r *Resolver) fetch(ctx context.Context, value string) (string, error) {
+	ref, err := ParseReference(value)
+	if err != nil {
+		return "", err
+	}
+	provider, ok := r.providers[ref.Provider]
+	if !ok {
+		return "", fmt.Errorf("secret manager %q referenced by %s is not configured", ref.Provider, value)
+	}
+	secret, err := provider.GetSecret(ctx, ref.Name, ref.Key)
+	if err != nil {
+		err = fmt.Errorf("error getting secret %s: %w", value, err)
+		r.mutex.Lock()
+		failures := r.failures[value].failures + 1
+		r.failures[value] = failedFetch{err: err, failures: failures, retryAt: r.now().Add(r.failureBackoff(failures))}
+		r.mutex.Unlock()
+		return "", err
+	}
+	r.mutex.Lock()
+	r.cache[value] = cachedSecret{value: secret, fetchedAt: r.now()}
+	delete(r.failures, value)
+	r.mutex.Unlock()
+	return secret, nil
+}
+
+// failureBackoff returns the delay after which a secret is fetched again after the given number of failures
+func (r *Resolver) failureBackoff(failures int) time.Duration {
+	backoff := initialFailureBackoff
+	for i := 1; i < failures && backoff < r.refreshInterval; i++ {
+		backoff *= 2
+	}
+	return min(backoff, r.refreshInterval)
+}
+
+// Config is the configuration of the external secret managers
+type Config struct {
+	// RefreshInterval is the interval after which resolved secrets are fetched again, e.g. 5m
+	RefreshInterval string `json:"refreshInterval,omitempty"`
+	// Vault configures HashiCorp Vault
+	Vault *VaultConfig `json:"vault,omitempty"`
+	// AWS configures AWS Secrets Manager
+	AWS *AWSConfig `json:"aws,omitempty"`
+	// GCP configures Google Cloud Secret Manager
+	GCP *GCPConfig `json:"gcp,omitempty"`
+}
+
+// NewResolverFromConfig returns a new Resolver for the secret managers of the given configuration
+func NewResolverFromConfig(config *Config) (*Resolver, error) {
+	refreshInterval := DefaultRefreshInterval
+	if config.RefreshInterval != "" {
+		interval, err := time.ParseDuration(config.RefreshInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid refresh interval %q: %w", config.RefreshInterval, err)
+		}
+		refreshInterval = interval
+	}
+	providers := map[string]Provider{}
+	var errs []error
+	if config.Vault != nil {
+		provider, err := NewVaultProvider(config.Vault)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			providers[ProviderVault] = provider
+		}
+	}
+	if config.AWS != nil {
+		provider, err := NewAWSProvider(config.AWS)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			providers[ProviderAWS] = provider
+		}
+	}
+	if config.GCP != nil {
+		providers[ProviderGCP] = NewGCPProvider(config.GCP)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return NewResolver(providers, refreshInterval), nil
+}
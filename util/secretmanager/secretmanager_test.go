@@ -0,0 +1,187 @@
+package secretmanager
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProvider struct {
+	secrets map[string]string
+	err     error
+	calls   int
+}
+
+func (p *fakeProvider) GetSecret(_ context.Context, name string, key string) (string, error) {
+	p.calls++
+	if p.err != nil {
+		return "", p.err
+	}
+	secret, ok := p.secrets[name]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return valueOfKey(secret, key)
+}
+
+func TestParseReference(t *testing.T) {
+	ref, err := ParseReference("$ref:vault:secret/data/argocd#oidc.clientSecret")
+	require.NoError(t, err)
+	assert.Equal(t, Reference{Provider: "vault", Name: "secret/data/argocd", Key: "oidc.clientSecret"}, *ref)
+
+	ref, err = ParseReference("$ref:aws:arn:aws:secretsmanager:us-east-1:123456789012:secret:argocd")
+	require.NoError(t, err)
+	assert.Equal(t, Reference{Provider: "aws", Name: "arn:aws:secretsmanager:us-east-1:123456789012:secret:argocd"}, *ref)
+
+	for _, value := range []string{"$oidc.clientSecret", "$ref:vault", "$ref::secret", "$ref:vault:", "$ref:vault:secret#", "$ref:vault:#key"} {
+		_, err := ParseReference(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestFindReferences(t *testing.T) {
+	text := `name: Okta
+clientSecret: $ref:vault:secret/data/argocd#oidc
+extra: "$ref:gcp:projects/p/secrets/s"
+again: $ref:vault:secret/data/argocd#oidc
+other: $dex.clientSecret`
+	assert.Equal(t, []string{"$ref:vault:secret/data/argocd#oidc", "$ref:gcp:projects/p/secrets/s"}, FindReferences(text))
+	assert.Empty(t, FindReferences("clientSecret: $oidc.clientSecret"))
+}
+
+func TestValueOfKey(t *testing.T) {
+	value, err := valueOfKey(`{"password":"secret","port":8080}`, "password")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", value)
+	value, err = valueOfKey(`{"password":"secret","port":8080}`, "port")
+	require.NoError(t, err)
+	assert.Equal(t, "8080", value)
+	value, err = valueOfKey("plain", "")
+	require.NoError(t, err)
+	assert.Equal(t, "plain", value)
+	_, err = valueOfKey("plain", "password")
+	require.ErrorContains(t, err, "not a JSON object")
+	_, err = valueOfKey(`{"password":"secret"}`, "username")
+	require.ErrorContains(t, err, `key "username" does not exist`)
+}
+
+func TestResolver(t *testing.T) {
+	provider := &fakeProvider{secrets: map[string]string{"argocd": `{"webhook":"v1"}`}}
+	resolver := NewResolver(map[string]Provider{"fake": provider}, time.Minute)
+	now := time.Now()
+	resolver.now = func() time.Time { return now }
+
+	value, err := resolver.Resolve(t.Context(), "$ref:fake:argocd#webhook")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", value)
+
+	t.Run("Cached", func(t *testing.T) {
+		provider.secrets["argocd"] = `{"webhook":"v2"}`
+		value, err := resolver.Resolve(t.Context(), "$ref:fake:argocd#webhook")
+		require.NoError(t, err)
+		assert.Equal(t, "v1", value)
+		assert.Equal(t, 1, provider.calls)
+		assert.False(t, resolver.Refresh(t.Context()))
+	})
+
+	t.Run("Rotated", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		assert.True(t, resolver.Refresh(t.Context()))
+		value, err := resolver.Resolve(t.Context(), "$ref:fake:argocd#webhook")
+		require.NoError(t, err)
+		assert.Equal(t, "v2", value)
+	})
+
+	t.Run("StaleOnError", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		provider.err = errors.New("unavailable")
+		assert.False(t, resolver.Refresh(t.Context()))
+		value, err := resolver.Resolve(t.Context(), "$ref:fake:argocd#webhook")
+		require.NoError(t, err)
+		assert.Equal(t, "v2", value)
+		_, err = resolver.Resolve(t.Context(), "$ref:fake:other")
+		require.ErrorContains(t, err, "unavailable")
+	})
+
+	t.Run("UnknownProvider", func(t *testing.T) {
+		_, err := resolver.Resolve(t.Context(), "$ref:vault:secret/data/argocd#key")
+		require.ErrorContains(t, err, `secret manager "vault" referenced by $ref:vault:secret/data/argocd#key is not configured`)
+	})
+}
+
+func TestResolver_Lookup(t *testing.T) {
+	provider := &fakeProvider{secrets: map[string]string{"argocd": `{"webhook":"v1"}`}}
+	resolver := NewResolver(map[string]Provider{"fake": provider}, time.Minute)
+
+	_, ok := resolver.Lookup("$ref:fake:argocd#webhook")
+	assert.False(t, ok)
+	assert.Zero(t, provider.calls, "looking up a secret must not fetch it")
+
+	assert.True(t, resolver.Refresh(t.Context()))
+	value, ok := resolver.Lookup("$ref:fake:argocd#webhook")
+	assert.True(t, ok)
+	assert.Equal(t, "v1", value)
+	assert.Equal(t, 1, provider.calls)
+	assert.False(t, resolver.Refresh(t.Context()))
+	assert.Equal(t, 1, provider.calls)
+}
+
+func TestResolver_FailureBackoff(t *testing.T) {
+	provider := &fakeProvider{secrets: map[string]string{}}
+	resolver := NewResolver(map[string]Provider{"fake": provider}, time.Minute)
+	now := time.Now()
+	resolver.now = func() time.Time { return now }
+
+	_, ok := resolver.Lookup("$ref:fake:argocd#webhook")
+	assert.False(t, ok)
+	assert.False(t, resolver.Refresh(t.Context()))
+	assert.Equal(t, 1, provider.calls)
+
+	// failures are cached until the backoff has elapsed
+	_, ok = resolver.Lookup("$ref:fake:argocd#webhook")
+	assert.False(t, ok)
+	assert.False(t, resolver.Refresh(t.Context()))
+	_, err := resolver.Resolve(t.Context(), "$ref:fake:argocd#webhook")
+	require.ErrorContains(t, err, "not found")
+	assert.Equal(t, 1, provider.calls)
+
+	now = now.Add(initialFailureBackoff)
+	assert.False(t, resolver.Refresh(t.Context()))
+	assert.Equal(t, 2, provider.calls)
+	now = now.Add(initialFailureBackoff)
+	assert.False(t, resolver.Refresh(t.Context()), "the backoff doubles with every failure")
+	assert.Equal(t, 2, provider.calls)
+
+	provider.secrets["argocd"] = `{"webhook":"v1"}`
+	now = now.Add(initialFailureBackoff)
+	assert.True(t, resolver.Refresh(t.Context()))
+	value, ok := resolver.Lookup("$ref:fake:argocd#webhook")
+	assert.True(t, ok)
+	assert.Equal(t, "v1", value)
+
+	assert.Equal(t, 10*time.Second, resolver.failureBackoff(1))
+	assert.Equal(t, 40*time.Second, resolver.failureBackoff(3))
+	assert.Equal(t, time.Minute, resolver.failureBackoff(10))
+}
+
+func TestNewResolverFromConfig(t *testing.T) {
+	resolver, err := NewResolverFromConfig(&Config{
+		RefreshInterval: "10m",
+		Vault:           &VaultConfig{Address: "https://vault:8200"},
+		GCP:             &GCPConfig{Project: "my-project"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, resolver.refreshInterval)
+	assert.Contains(t, resolver.providers, ProviderVault)
+	assert.Contains(t, resolver.providers, ProviderGCP)
+	assert.NotContains(t, resolver.providers, ProviderAWS)
+
+	_, err = NewResolverFromConfig(&Config{RefreshInterval: "often"})
+	require.ErrorContains(t, err, "invalid refresh interval")
+	_, err = NewResolverFromConfig(&Config{Vault: &VaultConfig{}})
+	require.ErrorContains(t, err, "vault address is not configured")
+}
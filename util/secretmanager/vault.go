@@ -0,0 +1,230 @@
+package secretmanager
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultVaultKubernetesMountPath is the default path the Kubernetes auth method is mounted at
+	defaultVaultKubernetesMountPath = "kubernetes"
+	// defaultServiceAccountTokenPath is the path of the token of the service account of the pod
+	defaultServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// vaultTokenEnv is the environment variable holding the Vault token if no auth method is configured
+	vaultTokenEnv = "VAULT_TOKEN"
+)
+
+// VaultConfig configures HashiCorp Vault
+type VaultConfig struct {
+	// Address of the Vault server, e.g. https://vault.example.com:8200
+	Address string `json:"address"`
+	// Namespace of Vault Enterprise the secrets are read from
+	Namespace string `json:"namespace,omitempty"`
+	// CACert is the PEM encoded CA certificate of the Vault server
+	CACert string `json:"caCert,omitempty"`
+	// InsecureSkipVerify disables the verification of the TLS certificate of the Vault server
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// Auth configures how Argo CD authenticates to Vault. The token is read from the VAULT_TOKEN environment variable
+	// if no auth method is configured.
+	Auth VaultAuthConfig `json:"auth,omitempty"`
+}
+
+// VaultAuthConfig configures how Argo CD authenticates to Vault
+type VaultAuthConfig struct {
+	// TokenPath is the path of a file holding the Vault token
+	TokenPath string `json:"tokenPath,omitempty"`
+	// Kubernetes configures the Kubernetes auth method, which logs in using the token of the service account of the pod
+	Kubernetes *VaultKubernetesAuthConfig `json:"kubernetes,omitempty"`
+}
+
+// VaultKubernetesAuthConfig configures the Kubernetes auth method of Vault
+type VaultKubernetesAuthConfig struct {
+	// Role to log in with
+	Role string `json:"role"`
+	// MountPath is the path the auth method is mounted at, defaults to kubernetes
+	MountPath string `json:"mountPath,omitempty"`
+	// ServiceAccountTokenPath is the path of the service account token used to log in
+	ServiceAccountTokenPath string `json:"serviceAccountTokenPath,omitempty"`
+}
+
+// VaultProvider fetches secrets from the KV secrets engine (version 1 or 2) of HashiCorp Vault
+type VaultProvider struct {
+	config *VaultConfig
+	client *http.Client
+
+	mutex        sync.Mutex
+	token        string
+	tokenExpires time.Time
+}
+
+// NewVaultProvider returns a new VaultProvider
+func NewVaultProvider(config *VaultConfig) (*VaultProvider, error) {
+	if config.Address == "" {
+		return nil, errors.New("vault address is not configured")
+	}
+	if config.Auth.Kubernetes != nil && config.Auth.Kubernetes.Role == "" {
+		return nil, errors.New("vault kubernetes auth role is not configured")
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify}
+	if config.CACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(config.CACert)) {
+			return nil, errors.New("invalid vault CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &VaultProvider{
+		config: config,
+		client: &http.Client{Transport: transport, Timeout: 30 * time.Second},
+	}, nil
+}
+
+// GetSecret returns the value with the given key of the secret at the given path, e.g. secret/data/argocd for the KV
+// version 2 secrets engine mounted at secret. The key may only be omitted if the secret has a single value.
+func (p *VaultProvider) GetSecret(ctx context.Context, path string, key string) (string, error) {
+	token, err := p.getToken(ctx, false)
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		Data map[string]any `json:"data"`
+	}
+	status, err := p.do(ctx, http.MethodGet, "/v1/"+strings.TrimPrefix(path, "/"), token, nil, &res)
+	if status == http.StatusForbidden && p.config.Auth.Kubernetes != nil {
+		// the token may have been revoked before it expired
+		if token, err = p.getToken(ctx, true); err != nil {
+			return "", err
+		}
+		status, err = p.do(ctx, http.MethodGet, "/v1/"+strings.TrimPrefix(path, "/"), token, nil, &res)
+	}
+	if status == http.StatusNotFound {
+		return "", fmt.Errorf("vault secret %s not found", path)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	data := res.Data
+	// the KV version 2 secrets engine nests the values of a secret next to its metadata
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	if key == "" {
+		if len(data) != 1 {
+			return "", fmt.Errorf("vault secret %s has %d keys: the key must be specified", path, len(data))
+		}
+		for k := range data {
+			key = k
+		}
+	}
+	return valueOfMapKey(data, key)
+}
+
+// getToken returns the Vault token, logging in with the Kubernetes auth method if configured
+func (p *VaultProvider) getToken(ctx context.Context, forceLogin bool) (string, error) {
+	kubernetesAuth := p.config.Auth.Kubernetes
+	if kubernetesAuth == nil {
+		if p.config.Auth.TokenPath != "" {
+			token, err := os.ReadFile(p.config.Auth.TokenPath)
+			if err != nil {
+				return "", fmt.Errorf("error reading vault token: %w", err)
+			}
+			return strings.TrimSpace(string(token)), nil
+		}
+		token := os.Getenv(vaultTokenEnv)
+		if token == "" {
+			return "", fmt.Errorf("no vault auth method is configured and %s is not set", vaultTokenEnv)
+		}
+		return token, nil
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if !forceLogin && p.token != "" && time.Now().Before(p.tokenExpires) {
+		return p.token, nil
+	}
+	tokenPath := kubernetesAuth.ServiceAccountTokenPath
+	if tokenPath == "" {
+		tokenPath = defaultServiceAccountTokenPath
+	}
+	jwt, err := os.ReadFile(tokenPath)
+	if err != nil {
+		return "", fmt.Errorf("error reading service account token: %w", err)
+	}
+	mountPath := kubernetesAuth.MountPath
+	if mountPath == "" {
+		mountPath = defaultVaultKubernetesMountPath
+	}
+	body, err := json.Marshal(map[string]string{"role": kubernetesAuth.Role, "jwt": strings.TrimSpace(string(jwt))})
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	if _, err := p.do(ctx, http.MethodPost, "/v1/auth/"+strings.Trim(mountPath, "/")+"/login", "", body, &res); err != nil {
+		return "", fmt.Errorf("error logging in to vault: %w", err)
+	}
+	if res.Auth.ClientToken == "" {
+		return "", errors.New("error logging in to vault: no token returned")
+	}
+	p.token = res.Auth.ClientToken
+	// renew the token before it expires
+	p.tokenExpires = time.Now().Add(time.Duration(res.Auth.LeaseDuration) * time.Second * 9 / 10)
+	return p.token, nil
+}
+
+// do sends a request to the Vault API and decodes the response into out. It returns the status code of the response.
+func (p *VaultProvider) do(ctx context.Context, method string, path string, token string, body []byte, out any) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(p.config.Address, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if p.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.config.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var res struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.Unmarshal(data, &res)
+		return resp.StatusCode, fmt.Errorf("vault returned status %d: %s", resp.StatusCode, strings.Join(res.Errors, ", "))
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return resp.StatusCode, fmt.Errorf("error decoding vault response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,91 @@
+package secretmanager
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newVaultServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/kubernetes/login":
+			var body map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			if body["role"] != "argocd" || body["jwt"] != "sa-token" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"k8s-token","lease_duration":3600}}`))
+			return
+		}
+		if token := r.Header.Get("X-Vault-Token"); token != "k8s-token" && token != "static-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/argocd":
+			_, _ = w.Write([]byte(`{"data":{"data":{"oidc":"oidc-secret","webhook":"webhook-secret"},"metadata":{"version":2}}}`))
+		case "/v1/kv/argocd":
+			_, _ = w.Write([]byte(`{"data":{"token":"kv1-secret"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+}
+
+func TestVaultProvider_KubernetesAuth(t *testing.T) {
+	server := newVaultServer(t)
+	defer server.Close()
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("sa-token\n"), 0o600))
+
+	provider, err := NewVaultProvider(&VaultConfig{
+		Address: server.URL,
+		Auth:    VaultAuthConfig{Kubernetes: &VaultKubernetesAuthConfig{Role: "argocd", ServiceAccountTokenPath: tokenPath}},
+	})
+	require.NoError(t, err)
+
+	value, err := provider.GetSecret(t.Context(), "secret/data/argocd", "oidc")
+	require.NoError(t, err)
+	assert.Equal(t, "oidc-secret", value)
+
+	value, err = provider.GetSecret(t.Context(), "kv/argocd", "")
+	require.NoError(t, err)
+	assert.Equal(t, "kv1-secret", value, "the only key of a secret is used if no key is given")
+
+	_, err = provider.GetSecret(t.Context(), "secret/data/argocd", "")
+	require.ErrorContains(t, err, "the key must be specified")
+	_, err = provider.GetSecret(t.Context(), "secret/data/missing", "key")
+	require.ErrorContains(t, err, "vault secret secret/data/missing not found")
+}
+
+func TestVaultProvider_TokenAuth(t *testing.T) {
+	server := newVaultServer(t)
+	defer server.Close()
+
+	provider, err := NewVaultProvider(&VaultConfig{Address: server.URL})
+	require.NoError(t, err)
+
+	t.Setenv("VAULT_TOKEN", "")
+	_, err = provider.GetSecret(t.Context(), "secret/data/argocd", "webhook")
+	require.ErrorContains(t, err, "VAULT_TOKEN is not set")
+
+	t.Setenv("VAULT_TOKEN", "static-token")
+	value, err := provider.GetSecret(t.Context(), "secret/data/argocd", "webhook")
+	require.NoError(t, err)
+	assert.Equal(t, "webhook-secret", value)
+
+	t.Setenv("VAULT_TOKEN", "invalid")
+	_, err = provider.GetSecret(t.Context(), "secret/data/argocd", "webhook")
+	require.ErrorContains(t, err, "vault returned status 403: permission denied")
+}
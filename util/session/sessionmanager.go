@@ -177,6 +177,128 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 	return mgr.signClaims(claims)
 }
 
+// CreateLoginSession creates a new login session token for the given local account and records the session. If the
+// account exceeds the maximum number of concurrent sessions, its oldest sessions are revoked.
+func (mgr *SessionManager) CreateLoginSession(ctx context.Context, account string, secondsBeforeExpiry int64) (string, error) {
+	uniqueId, err := uuid.NewRandom()
+	if err != nil {
+		return "", err
+	}
+	id := uniqueId.String()
+	token, err := mgr.Create(fmt.Sprintf("%s:%s", account, settings.AccountCapabilityLogin), secondsBeforeExpiry, id)
+	if err != nil {
+		return "", err
+	}
+	if err := mgr.addSession(ctx, account, newSession(id, secondsBeforeExpiry)); err != nil {
+		return "", fmt.Errorf("failed to record session: %w", err)
+	}
+	return token, nil
+}
+
+// newSession returns a login session for a token with the given id which is issued now
+func newSession(id string, secondsBeforeExpiry int64) Session {
+	now := time.Now()
+	session := Session{ID: id, IssuedAt: now.Unix(), IssuedAtNano: now.UnixNano()}
+	if secondsBeforeExpiry > 0 {
+		session.ExpiresAt = now.Add(time.Duration(secondsBeforeExpiry) * time.Second).Unix()
+	}
+	return session
+}
+
+// addSession records the login session, replacing the session of the token it renews, and revokes the oldest sessions
+// of the account exceeding the maximum number of concurrent sessions
+func (mgr *SessionManager) addSession(ctx context.Context, account string, session Session) error {
+	if err := mgr.storage.AddSession(ctx, account, session); err != nil {
+		return err
+	}
+	if session.RenewedID != "" {
+		if err := mgr.storage.RemoveSession(ctx, account, session.RenewedID); err != nil {
+			return err
+		}
+	}
+	argoCDSettings, err := mgr.settingsMgr.GetSettings()
+	if err != nil {
+		return err
+	}
+	if argoCDSettings.MaxConcurrentUserSessions <= 0 {
+		return nil
+	}
+	sessions, err := mgr.storage.GetSessions(ctx, account)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(sessions)-argoCDSettings.MaxConcurrentUserSessions; i++ {
+		log.Infof("Revoking session %s of account %s exceeding the maximum of %d concurrent sessions", sessions[i].ID, account, argoCDSettings.MaxConcurrentUserSessions)
+		if err := mgr.revokeSession(ctx, account, sessions[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSessions returns the active login sessions of the given local account, ordered from oldest to newest
+func (mgr *SessionManager) GetSessions(ctx context.Context, account string) ([]Session, error) {
+	return mgr.storage.GetSessions(ctx, account)
+}
+
+// RevokeSession immediately revokes the login session with the given id of the given local account
+func (mgr *SessionManager) RevokeSession(ctx context.Context, account string, id string) error {
+	sessions, err := mgr.storage.GetSessions(ctx, account)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if session.ID == id {
+			return mgr.revokeSession(ctx, account, session)
+		}
+	}
+	return fmt.Errorf("account %s does not have active session with id %s", account, id)
+}
+
+// RevokeSessions immediately revokes all login sessions of the given local account and returns the number of revoked
+// sessions. Login tokens of the account which were issued before are rejected as well, even if they were not recorded
+// as a session.
+func (mgr *SessionManager) RevokeSessions(ctx context.Context, account string) (int, error) {
+	err := mgr.settingsMgr.UpdateAccount(account, func(acc *settings.Account) error {
+		revokedAt := time.Now().UTC().Truncate(time.Second)
+		acc.SessionsRevokedAt = &revokedAt
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	sessions, err := mgr.storage.GetSessions(ctx, account)
+	if err != nil {
+		return 0, err
+	}
+	for _, session := range sessions {
+		if err := mgr.revokeSession(ctx, account, session); err != nil {
+			return 0, err
+		}
+	}
+	return len(sessions), nil
+}
+
+func (mgr *SessionManager) revokeSession(ctx context.Context, account string, session Session) error {
+	// information about the revocation is kept as long as the token is valid
+	var expiringAt time.Duration
+	if session.ExpiresAt > 0 {
+		expiringAt = time.Until(time.Unix(session.ExpiresAt, 0))
+	}
+	if err := mgr.storage.RevokeToken(ctx, session.ID, expiringAt); err != nil {
+		return err
+	}
+	// the token renewed by the session remains valid until it expires
+	if session.RenewedID != "" {
+		if renewedExpiringAt := time.Until(time.Unix(session.RenewedExpiresAt, 0)); renewedExpiringAt > 0 {
+			if err := mgr.storage.RevokeToken(ctx, session.RenewedID, renewedExpiringAt); err != nil {
+				return err
+			}
+		}
+	}
+	return mgr.storage.RemoveSession(ctx, account, session.ID)
+}
+
 func (mgr *SessionManager) CollectMetrics(registry MetricsRegistry) {
 	mgr.metricsRegistry = registry
 	if mgr.metricsRegistry == nil {
@@ -285,6 +407,10 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		return nil, "", errors.New("account password has changed since token issued")
 	}
 
+	if capability == settings.AccountCapabilityLogin && account.SessionsRevokedAt != nil && issuedAt.Before(*account.SessionsRevokedAt) {
+		return nil, "", errors.New("token is revoked, please re-login")
+	}
+
 	newToken := ""
 	if exp, err := jwtutil.ExpirationTime(claims); err == nil {
 		tokenExpDuration := exp.Sub(issuedAt)
@@ -294,6 +420,12 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 			if uniqueId, err := uuid.NewRandom(); err == nil {
 				if val, err := mgr.Create(fmt.Sprintf("%s:%s", subject, settings.AccountCapabilityLogin), int64(tokenExpDuration.Seconds()), uniqueId.String()); err == nil {
 					newToken = val
+					session := newSession(uniqueId.String(), int64(tokenExpDuration.Seconds()))
+					session.RenewedID = id
+					session.RenewedExpiresAt = exp.Unix()
+					if err := mgr.addSession(context.Background(), subject, session); err != nil {
+						log.Warnf("Failed to record session of account %s: %v", subject, err)
+					}
 				}
 			}
 		}
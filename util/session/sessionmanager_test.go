@@ -152,6 +152,118 @@ func TestSessionManager_AdminToken_Revoked(t *testing.T) {
 	assert.EqualError(t, err, "token is revoked, please re-login")
 }
 
+func TestSessionManager_LoginSessions(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	kubeClient := getKubeClient(t, "pass", true)
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(t.Context(), "argocd-cm", metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data["users.session.max.concurrent"] = "2"
+	_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(t.Context(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	var tokens []string
+	for i := 0; i < 3; i++ {
+		token, err := mgr.CreateLoginSession(t.Context(), "admin", int64(time.Hour.Seconds()))
+		require.NoError(t, err)
+		tokens = append(tokens, token)
+	}
+
+	// the oldest session exceeds the limit and is revoked
+	_, _, err = mgr.Parse(tokens[0])
+	require.EqualError(t, err, "token is revoked, please re-login")
+	sessions, err := mgr.GetSessions(t.Context(), "admin")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+
+	require.NoError(t, mgr.RevokeSession(t.Context(), "admin", sessions[0].ID))
+	require.Error(t, mgr.RevokeSession(t.Context(), "admin", sessions[0].ID))
+	_, _, err = mgr.Parse(tokens[1])
+	require.EqualError(t, err, "token is revoked, please re-login")
+
+	count, err := mgr.RevokeSessions(t.Context(), "admin")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	_, _, err = mgr.Parse(tokens[2])
+	require.EqualError(t, err, "token is revoked, please re-login")
+	sessions, err = mgr.GetSessions(t.Context(), "admin")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
+
+func TestSessionManager_RenewedLoginSession(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	// the token expires within the renewal period and is renewed when it is used
+	token, err := mgr.CreateLoginSession(t.Context(), "admin", int64(time.Minute.Seconds()))
+	require.NoError(t, err)
+	claims, newToken, err := mgr.Parse(token)
+	require.NoError(t, err)
+	require.NotEmpty(t, newToken)
+
+	sessions, err := mgr.GetSessions(t.Context(), "admin")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	// parsing the new token would renew it again
+	newClaims := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(newToken, newClaims)
+	require.NoError(t, err)
+	assert.Equal(t, jwtutil.StringField(newClaims, "jti"), sessions[0].ID)
+	mapClaims, err := jwtutil.MapClaims(claims)
+	require.NoError(t, err)
+	assert.Equal(t, jwtutil.StringField(mapClaims, "jti"), sessions[0].RenewedID)
+
+	// revoking the renewed session revokes the token it renewed as well
+	require.NoError(t, mgr.RevokeSession(t.Context(), "admin", sessions[0].ID))
+	_, _, err = mgr.Parse(newToken)
+	require.EqualError(t, err, "token is revoked, please re-login")
+	_, _, err = mgr.Parse(token)
+	require.EqualError(t, err, "token is revoked, please re-login")
+}
+
+func TestSessionManager_RevokeSessions_UntrackedToken(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	// a login token which was issued without recording a session
+	issuedAt := time.Now().Add(-time.Minute)
+	token, err := mgr.signClaims(jwt.RegisteredClaims{
+		IssuedAt:  jwt.NewNumericDate(issuedAt),
+		Issuer:    SessionManagerClaimsIssuer,
+		NotBefore: jwt.NewNumericDate(issuedAt),
+		ExpiresAt: jwt.NewNumericDate(issuedAt.Add(time.Hour)),
+		Subject:   fmt.Sprintf("admin:%s", settings.AccountCapabilityLogin),
+		ID:        "untracked",
+	})
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+
+	count, err := mgr.RevokeSessions(t.Context(), "admin")
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Eventually(t, func() bool {
+		_, _, err = mgr.Parse(token)
+		return err != nil && err.Error() == "token is revoked, please re-login"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// new sessions are not affected
+	newToken, err := mgr.CreateLoginSession(t.Context(), "admin", int64(time.Hour.Seconds()))
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(newToken)
+	require.NoError(t, err)
+}
+
 func TestSessionManager_AdminToken_Deactivated(t *testing.T) {
 	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", false), "argocd")
 	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
@@ -2,6 +2,8 @@ package session
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -15,8 +17,39 @@ import (
 const (
 	revokedTokenPrefix = "revoked-token|"
 	newRevokedTokenKey = "new-revoked-token"
+	sessionsKeyPrefix  = "sessions|"
+	// sessionsSequenceField is the field of the sessions hash which counts the recorded sessions, session ids are UUIDs
+	// and never collide with it
+	sessionsSequenceField = "sequence"
 )
 
+// Session contains information about a login session of a local account
+type Session struct {
+	// ID is the unique identifier (jti) of the session token
+	ID string `json:"id"`
+	// IssuedAt is the unix time the session token was issued at
+	IssuedAt int64 `json:"issuedAt"`
+	// IssuedAtNano is the unix time in nanoseconds the session token was issued at, used to order sessions
+	IssuedAtNano int64 `json:"issuedAtNano,omitempty"`
+	// Sequence is the position in which the session was recorded for the account, used to order sessions which were
+	// issued at the same time
+	Sequence int64 `json:"sequence,omitempty"`
+	// ExpiresAt is the unix time the session token expires at, 0 if it never expires
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+	// RenewedID is the unique identifier of the token which was renewed by the session token and is still valid
+	RenewedID string `json:"renewedId,omitempty"`
+	// RenewedExpiresAt is the unix time the renewed token expires at
+	RenewedExpiresAt int64 `json:"renewedExpiresAt,omitempty"`
+}
+
+// before returns true if the session was issued before the given session
+func (s Session) before(other Session) bool {
+	if s.IssuedAtNano != other.IssuedAtNano {
+		return s.IssuedAtNano < other.IssuedAtNano
+	}
+	return s.Sequence < other.Sequence
+}
+
 type userStateStorage struct {
 	attempts            map[string]LoginAttempts
 	redis               *redis.Client
@@ -130,6 +163,91 @@ func (storage *userStateStorage) RevokeToken(ctx context.Context, id string, exp
 	return storage.redis.Publish(ctx, newRevokedTokenKey, id).Err()
 }
 
+func (storage *userStateStorage) AddSession(ctx context.Context, account string, session Session) error {
+	if storage.redis == nil {
+		return nil
+	}
+	key := sessionsKeyPrefix + account
+	sequence, err := storage.redis.HIncrBy(ctx, key, sessionsSequenceField, 1).Result()
+	if err != nil {
+		return err
+	}
+	session.Sequence = sequence
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	if err := storage.redis.HSet(ctx, key, session.ID, string(data)).Err(); err != nil {
+		return err
+	}
+	if session.ExpiresAt == 0 {
+		return storage.redis.Persist(ctx, key).Err()
+	}
+	// the sessions of an account expire together with the session expiring last
+	ttl, err := storage.redis.TTL(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	if ttl < 0 {
+		// the key has no expiration either because it was just created or because it holds a session which never expires,
+		// the sequence field is not a session
+		count, err := storage.redis.HLen(ctx, key).Result()
+		if err != nil || count > 2 {
+			return err
+		}
+	}
+	if expiringAt := time.Until(time.Unix(session.ExpiresAt, 0)); ttl < expiringAt {
+		return storage.redis.Expire(ctx, key, expiringAt).Err()
+	}
+	return nil
+}
+
+func (storage *userStateStorage) GetSessions(ctx context.Context, account string) ([]Session, error) {
+	if storage.redis == nil {
+		return nil, nil
+	}
+	key := sessionsKeyPrefix + account
+	values, err := storage.redis.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	var sessions []Session
+	var inactive []string
+	for id, value := range values {
+		if id == sessionsSequenceField {
+			continue
+		}
+		var session Session
+		if err := json.Unmarshal([]byte(value), &session); err != nil {
+			log.Warnf("Failed to unmarshal session '%s' of account '%s': %v", id, account, err)
+			inactive = append(inactive, id)
+			continue
+		}
+		if (session.ExpiresAt > 0 && session.ExpiresAt <= now) || storage.IsTokenRevoked(id) {
+			inactive = append(inactive, id)
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	if len(inactive) > 0 {
+		if err := storage.redis.HDel(ctx, key, inactive...).Err(); err != nil {
+			log.Warnf("Failed to remove inactive sessions of account '%s': %v", account, err)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].before(sessions[j])
+	})
+	return sessions, nil
+}
+
+func (storage *userStateStorage) RemoveSession(ctx context.Context, account string, id string) error {
+	if storage.redis == nil {
+		return nil
+	}
+	return storage.redis.HDel(ctx, sessionsKeyPrefix+account, id).Err()
+}
+
 func (storage *userStateStorage) IsTokenRevoked(id string) bool {
 	storage.lock.RLock()
 	defer storage.lock.RUnlock()
@@ -150,6 +268,12 @@ type UserStateStorage interface {
 	RevokeToken(ctx context.Context, id string, expiringAt time.Duration) error
 	// IsTokenRevoked checks if given token is revoked
 	IsTokenRevoked(id string) bool
+	// AddSession records a login session of the given local account
+	AddSession(ctx context.Context, account string, session Session) error
+	// GetSessions returns the active login sessions of the given local account, ordered from oldest to newest
+	GetSessions(ctx context.Context, account string) ([]Session, error)
+	// RemoveSession removes the login session with given id of the given local account
+	RemoveSession(ctx context.Context, account string, id string) error
 	// GetLockObject returns a lock used by the storage
 	GetLockObject() *sync.RWMutex
 }
@@ -17,19 +17,22 @@ import (
 )
 
 const (
-	accountsKeyPrefix          = "accounts"
-	accountPasswordSuffix      = "password"
-	accountPasswordMtimeSuffix = "passwordMtime"
-	accountEnabledSuffix       = "enabled"
-	accountTokensSuffix        = "tokens"
+	accountsKeyPrefix              = "accounts"
+	accountPasswordSuffix          = "password"
+	accountPasswordMtimeSuffix     = "passwordMtime"
+	accountSessionsRevokedAtSuffix = "sessionsRevokedAt"
+	accountEnabledSuffix           = "enabled"
+	accountTokensSuffix            = "tokens"
 
 	// Admin superuser password storage
 	// settingAdminPasswordHashKey designates the key for a root password hash inside a Kubernetes secret.
 	settingAdminPasswordHashKey = "admin.password"
 	// settingAdminPasswordMtimeKey designates the key for a root password mtime inside a Kubernetes secret.
 	settingAdminPasswordMtimeKey = "admin.passwordMtime"
-	settingAdminEnabledKey       = "admin.enabled"
-	settingAdminTokensKey        = "admin.tokens"
+	// settingAdminSessionsRevokedAtKey designates the key for the time all login sessions of the admin user were revoked.
+	settingAdminSessionsRevokedAtKey = "admin.sessionsRevokedAt"
+	settingAdminEnabledKey           = "admin.enabled"
+	settingAdminTokensKey            = "admin.tokens"
 )
 
 type AccountCapability string
@@ -52,9 +55,11 @@ type Token struct {
 type Account struct {
 	PasswordHash  string
 	PasswordMtime *time.Time
-	Enabled       bool
-	Capabilities  []AccountCapability
-	Tokens        []Token
+	// SessionsRevokedAt is the time all login sessions of the account were revoked at, login tokens issued before are invalid
+	SessionsRevokedAt *time.Time
+	Enabled           bool
+	Capabilities      []AccountCapability
+	Tokens            []Token
 }
 
 // FormatPasswordMtime return the formatted password modify time or empty string of password modify time is nil.
@@ -65,6 +70,14 @@ func (a *Account) FormatPasswordMtime() string {
 	return a.PasswordMtime.Format(time.RFC3339)
 }
 
+// FormatSessionsRevokedAt return the formatted time all login sessions were revoked at or empty string if they never were.
+func (a *Account) FormatSessionsRevokedAt() string {
+	if a.SessionsRevokedAt == nil {
+		return ""
+	}
+	return a.SessionsRevokedAt.Format(time.RFC3339)
+}
+
 // FormatCapabilities returns comma separate list of user capabilities.
 func (a *Account) FormatCapabilities() string {
 	var items []string
@@ -186,11 +199,13 @@ func saveAccount(secret *corev1.Secret, cm *corev1.ConfigMap, name string, accou
 	if name == common.ArgoCDAdminUsername {
 		updateAccountSecret(secret, settingAdminPasswordHashKey, account.PasswordHash, "")
 		updateAccountSecret(secret, settingAdminPasswordMtimeKey, account.FormatPasswordMtime(), "")
+		updateAccountSecret(secret, settingAdminSessionsRevokedAtKey, account.FormatSessionsRevokedAt(), "")
 		updateAccountSecret(secret, settingAdminTokensKey, string(tokens), "[]")
 		updateAccountMap(cm, settingAdminEnabledKey, strconv.FormatBool(account.Enabled), "true")
 	} else {
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordSuffix), account.PasswordHash, "")
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordMtimeSuffix), account.FormatPasswordMtime(), "")
+		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountSessionsRevokedAtSuffix), account.FormatSessionsRevokedAt(), "")
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTokensSuffix), string(tokens), "[]")
 		updateAccountMap(cm, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountEnabledSuffix), strconv.FormatBool(account.Enabled), "true")
 		updateAccountMap(cm, fmt.Sprintf("%s.%s", accountsKeyPrefix, name), account.FormatCapabilities(), "")
@@ -208,6 +223,11 @@ func parseAdminAccount(secret *corev1.Secret, cm *corev1.ConfigMap) (*Account, e
 			adminAccount.PasswordMtime = &mTime
 		}
 	}
+	if adminSessionsRevokedAtBytes, ok := secret.Data[settingAdminSessionsRevokedAtKey]; ok {
+		if revokedAt, err := time.Parse(time.RFC3339, string(adminSessionsRevokedAtBytes)); err == nil {
+			adminAccount.SessionsRevokedAt = &revokedAt
+		}
+	}
 
 	adminAccount.Tokens = make([]Token, 0)
 	if tokensStr, ok := secret.Data[settingAdminTokensKey]; ok && len(tokensStr) != 0 {
@@ -302,6 +322,13 @@ func parseAccounts(secret *corev1.Secret, cm *corev1.ConfigMap) (map[string]Acco
 			}
 			account.PasswordMtime = &mTime
 		}
+		if sessionsRevokedAt, ok := secret.Data[fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountSessionsRevokedAtSuffix)]; ok {
+			revokedAt, err := time.Parse(time.RFC3339, string(sessionsRevokedAt))
+			if err != nil {
+				return nil, err
+			}
+			account.SessionsRevokedAt = &revokedAt
+		}
 		if tokensStr, ok := secret.Data[fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTokensSuffix)]; ok {
 			account.Tokens = make([]Token, 0)
 			if len(tokensStr) != 0 {
@@ -0,0 +1,157 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/secretmanager"
+)
+
+const (
+	// secretManagerConfigKey is the key to configure the external secret managers referenced with $ref: values
+	secretManagerConfigKey = "secretmanager.config"
+	// secretManagerTimeout is the timeout for fetching the secrets referenced by the settings
+	secretManagerTimeout = 30 * time.Second
+	// secretReferencesRefreshInterval is the interval in which rotated secrets of external secret managers are detected
+	secretReferencesRefreshInterval = time.Minute
+)
+
+// getSecretResolver returns the resolver of references to secrets held by the external secret managers configured in
+// argocd-cm. The resolver is only created again if the configuration changes, so the resolved secrets stay cached.
+func (mgr *SettingsManager) getSecretResolver(argoCDCM *corev1.ConfigMap) (*secretmanager.Resolver, error) {
+	rawConfig, ok := argoCDCM.Data[secretManagerConfigKey]
+	if !ok {
+		return nil, fmt.Errorf("no secret managers are configured in %s", secretManagerConfigKey)
+	}
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	if mgr.secretResolver != nil && mgr.secretResolverConfig == rawConfig {
+		return mgr.secretResolver, nil
+	}
+	var config secretmanager.Config
+	if err := yaml.Unmarshal([]byte(rawConfig), &config); err != nil {
+		return nil, fmt.Errorf("error unmarshalling %s: %w", secretManagerConfigKey, err)
+	}
+	newResolver := mgr.newSecretResolver
+	if newResolver == nil {
+		newResolver = secretmanager.NewResolverFromConfig
+	}
+	resolver, err := newResolver(&config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", secretManagerConfigKey, err)
+	}
+	mgr.secretResolver = resolver
+	mgr.secretResolverConfig = rawConfig
+	return resolver, nil
+}
+
+// resolveSecretReferences resolves the $ref: references to secrets held by external secret managers. References in the
+// values of argocd-cm are added to the secret values of the settings, so they are replaced like references to keys of
+// secrets, and secret values which are references are replaced by the secrets they point to. Only the last known
+// values of the secrets are used, so getting the settings never waits for a secret manager. References without a known
+// value are replaced by empty values, so they are never used as secrets themselves, and recorded as unresolved. They
+// are fetched in the background, and subscribers are notified once they are resolved.
+func (mgr *SettingsManager) resolveSecretReferences(settings *ArgoCDSettings, argoCDCM *corev1.ConfigMap) {
+	var refs []string
+	for _, value := range argoCDCM.Data {
+		for _, ref := range secretmanager.FindReferences(value) {
+			if !slices.Contains(refs, ref) {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	secretRefs := map[string]string{}
+	for key, value := range settings.Secrets {
+		if value = strings.TrimSpace(value); secretmanager.IsReference(value) {
+			secretRefs[key] = value
+			if !slices.Contains(refs, value) {
+				refs = append(refs, value)
+			}
+		}
+	}
+	if len(refs) == 0 {
+		return
+	}
+
+	if settings.Secrets == nil {
+		settings.Secrets = map[string]string{}
+	}
+	resolver, err := mgr.getSecretResolver(argoCDCM)
+	if err != nil {
+		log.Warnf("Unable to resolve secret references: %v", err)
+	}
+	resolved := make(map[string]string, len(refs))
+	unresolved := map[string]bool{}
+	lookedUp := false
+	for _, ref := range refs {
+		// references are looked up without the leading $, like keys of secrets
+		key := strings.TrimPrefix(ref, "$")
+		if resolver == nil {
+			unresolved[key] = true
+			settings.Secrets[key] = ""
+			continue
+		}
+		value, ok := resolver.Lookup(ref)
+		if ok {
+			resolved[ref] = value
+		} else {
+			unresolved[key] = true
+			lookedUp = true
+		}
+		settings.Secrets[key] = value
+	}
+	for key, ref := range secretRefs {
+		if _, ok := resolved[ref]; !ok {
+			unresolved[key] = true
+		}
+		settings.Secrets[key] = resolved[ref]
+	}
+	if len(unresolved) > 0 {
+		settings.unresolvedSecretReferences = unresolved
+	}
+	if lookedUp {
+		select {
+		case mgr.secretReferencesLookedUp <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// watchSecretReferences fetches the secrets of external secret managers which have been looked up but are not known
+// yet, and periodically fetches the resolved secrets again. It calls onChanged if any of them changed, so subscribers
+// of the settings pick up resolved and rotated secrets.
+func (mgr *SettingsManager) watchSecretReferences(ctx context.Context, onChanged func()) {
+	ticker := time.NewTicker(secretReferencesRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-mgr.secretReferencesLookedUp:
+		}
+		if mgr.refreshSecretReferences(ctx) {
+			onChanged()
+		}
+	}
+}
+
+// refreshSecretReferences fetches the secrets which are due and returns whether any of them changed
+func (mgr *SettingsManager) refreshSecretReferences(ctx context.Context) bool {
+	mgr.mutex.Lock()
+	resolver := mgr.secretResolver
+	mgr.mutex.Unlock()
+	if resolver == nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(ctx, secretManagerTimeout)
+	defer cancel()
+	return resolver.Refresh(ctx)
+}
@@ -40,8 +40,10 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/settings/oidc"
 	"github.com/argoproj/argo-cd/v3/util"
 	"github.com/argoproj/argo-cd/v3/util/crypto"
+	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/kube"
 	"github.com/argoproj/argo-cd/v3/util/password"
+	"github.com/argoproj/argo-cd/v3/util/secretmanager"
 	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
 )
 
@@ -99,12 +101,17 @@ type ArgoCDSettings struct {
 	WebhookAzureDevOpsPassword string `json:"webhookAzureDevOpsPassword,omitempty"`
 	// Secrets holds all secrets in argocd-secret as a map[string]string
 	Secrets map[string]string `json:"secrets,omitempty"`
+	// unresolvedSecretReferences holds the keys of Secrets which reference secrets of external secret managers that
+	// have not been resolved yet
+	unresolvedSecretReferences map[string]bool
 	// KustomizeBuildOptions is a string of kustomize build parameters
 	KustomizeBuildOptions string `json:"kustomizeBuildOptions,omitempty"`
 	// Indicates if anonymous user is enabled or not
 	AnonymousUserEnabled bool `json:"anonymousUserEnabled,omitempty"`
 	// Specifies token expiration duration
 	UserSessionDuration time.Duration `json:"userSessionDuration,omitempty"`
+	// Specifies the maximum number of concurrent login sessions of a local account, 0 means no limit
+	MaxConcurrentUserSessions int `json:"maxConcurrentUserSessions,omitempty"`
 	// UiCssURL local or remote path to user-defined CSS to customize ArgoCD UI
 	UiCssURL string `json:"uiCssURL,omitempty"` //nolint:revive //FIXME(var-naming)
 	// Content of UI Banner
@@ -162,6 +169,17 @@ type GlobalProjectSettings struct {
 	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty"`
 }
 
+// ApplicationNamespaceSettings holds the default project and the allowed destinations of the Applications created in
+// the namespaces matching the Namespace glob pattern
+type ApplicationNamespaceSettings struct {
+	// Namespace is a glob pattern matching the namespaces of the Applications
+	Namespace string `json:"namespace"`
+	// DefaultProject is the project of the Applications created without project
+	DefaultProject string `json:"defaultProject,omitempty"`
+	// Destinations restricts the destinations of the Applications in addition to the destinations of their project
+	Destinations []v1alpha1.ApplicationDestination `json:"destinations,omitempty"`
+}
+
 // Help settings
 type Help struct {
 	// the URL for getting chat help, this will typically be your Slack channel for support
@@ -475,6 +493,8 @@ const (
 	resourceInclusionsKey = "resource.inclusions"
 	// resourceIgnoreResourceUpdatesEnabledKey is the key to a boolean determining whether the resourceIgnoreUpdates feature is enabled
 	resourceIgnoreResourceUpdatesEnabledKey = "resource.ignoreResourceUpdatesEnabled"
+	// resourceIgnoreResourceUpdatesFieldManagersKey is the key to a comma separated list of field managers whose updates of resources are ignored
+	resourceIgnoreResourceUpdatesFieldManagersKey = "resource.ignoreResourceUpdatesFieldManagers"
 	// resourceSensitiveAnnotationsKey is the key to list of annotations to mask in secret resource
 	resourceSensitiveAnnotationsKey = "resource.sensitive.mask.annotations"
 	// resourceCustomLabelKey is the key to a custom label to show in node info, if present
@@ -493,6 +513,8 @@ const (
 	anonymousUserEnabledKey = "users.anonymous.enabled"
 	// userSessionDurationKey is the key which specifies token expiration duration
 	userSessionDurationKey = "users.session.duration"
+	// userSessionMaxConcurrentKey is the key which specifies the maximum number of concurrent sessions of a local account
+	userSessionMaxConcurrentKey = "users.session.max.concurrent"
 	// diffOptions is the key where diff options are configured
 	resourceCompareOptionsKey = "resource.compareoptions"
 	// settingUICSSURLKey designates the key for user-defined CSS URL for UI customization
@@ -511,6 +533,8 @@ const (
 	settingsSourceHydratorCommitMessageTemplateKey = "sourceHydrator.commitMessageTemplate"
 	// globalProjectsKey designates the key for global project settings
 	globalProjectsKey = "globalProjects"
+	// applicationNamespaceSettingsKey designates the key for the settings of the Applications per namespace
+	applicationNamespaceSettingsKey = "application.namespaceSettings"
 	// initialPasswordSecretName is the name of the secret that will hold the initial admin password
 	initialPasswordSecretName = "argocd-initial-admin-secret"
 	// initialPasswordSecretField is the name of the field in initialPasswordSecretName to store the password
@@ -588,6 +612,15 @@ type SettingsManager struct {
 	tlsCertCacheSecretVersion string
 	// clusterInformer provides optimized cluster lookups using informer transforms
 	clusterInformer *ClusterInformer
+	// secretResolver resolves references to secrets held by external secret managers, it is created from
+	// secretResolverConfig
+	secretResolver       *secretmanager.Resolver
+	secretResolverConfig string
+	// newSecretResolver creates the secret resolver from the configuration of the external secret managers
+	newSecretResolver func(config *secretmanager.Config) (*secretmanager.Resolver, error)
+	// secretReferencesLookedUp is signalled when references without a known value have been looked up, so they are
+	// fetched in the background
+	secretReferencesLookedUp chan struct{}
 }
 
 type incompleteSettingsError struct {
@@ -985,6 +1018,22 @@ func (mgr *SettingsManager) GetIsIgnoreResourceUpdatesEnabled() (bool, error) {
 	return strconv.ParseBool(argoCDCM.Data[resourceIgnoreResourceUpdatesEnabledKey])
 }
 
+// GetIgnoreResourceUpdatesFieldManagers returns the field managers whose updates of resources should not trigger a
+// refresh of the application
+func (mgr *SettingsManager) GetIgnoreResourceUpdatesFieldManagers() ([]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving config map: %w", err)
+	}
+	var managers []string
+	for _, manager := range strings.Split(argoCDCM.Data[resourceIgnoreResourceUpdatesFieldManagersKey], ",") {
+		if manager = strings.TrimSpace(manager); manager != "" {
+			managers = append(managers, manager)
+		}
+	}
+	return managers, nil
+}
+
 // GetResourceOverrides loads Resource Overrides from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.ResourceOverride, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -1319,6 +1368,7 @@ func (mgr *SettingsManager) GetSettings() (*ArgoCDSettings, error) {
 	if err := mgr.updateSettingsFromSecret(&settings, argoCDSecret, secrets); err != nil {
 		errs = append(errs, err)
 	}
+	mgr.resolveSecretReferences(&settings, argoCDCM)
 	if len(errs) > 0 {
 		return &settings, errors.Join(errs...)
 	}
@@ -1429,6 +1479,7 @@ func (mgr *SettingsManager) initialize(ctx context.Context) error {
 	if err != nil {
 		log.Error(err)
 	}
+	go mgr.watchSecretReferences(ctx, tryNotify)
 	mgr.secrets = v1listers.NewSecretLister(secretsInformer.GetIndexer())
 	mgr.secretsInformer = secretsInformer
 	mgr.configmaps = v1listers.NewConfigMapLister(cmInformer.GetIndexer())
@@ -1500,6 +1551,13 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.Conf
 			settings.UserSessionDuration = *val
 		}
 	}
+	if maxConcurrentStr, ok := argoCDCM.Data[userSessionMaxConcurrentKey]; ok {
+		if val, err := strconv.Atoi(maxConcurrentStr); err != nil || val < 0 {
+			log.Warnf("Failed to parse '%s' key: invalid value '%s'", userSessionMaxConcurrentKey, maxConcurrentStr)
+		} else {
+			settings.MaxConcurrentUserSessions = val
+		}
+	}
 	settings.PasswordPattern = argoCDCM.Data[settingsPasswordPatternKey]
 	if settings.PasswordPattern == "" {
 		settings.PasswordPattern = common.PasswordPatten
@@ -1557,9 +1615,14 @@ func ValidateExternalURL(u string) error {
 func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, argoCDSecret *corev1.Secret, secrets []*corev1.Secret) error {
 	var errs []error
 	secretKey, ok := argoCDSecret.Data[settingServerSignatureKey]
-	if ok {
+	switch {
+	case ok && secretmanager.IsReference(strings.TrimSpace(string(secretKey))):
+		// the key signing the JWT tokens is needed as soon as the server starts, so it cannot wait for an external
+		// secret manager and must not be replaced by a generated key either
+		errs = append(errs, errServerSignatureReference)
+	case ok:
 		settings.ServerSignature = secretKey
-	} else {
+	default:
 		errs = append(errs, &incompleteSettingsError{message: "server.secretkey is missing"})
 	}
 
@@ -1734,6 +1797,8 @@ func NewSettingsManager(ctx context.Context, clientset kubernetes.Interface, nam
 		namespace:     namespace,
 		mutex:         &sync.Mutex{},
 		tlsCertParser: tls.X509KeyPair,
+
+		secretReferencesLookedUp: make(chan struct{}, 1),
 	}
 	for i := range opts {
 		opts[i](mgr)
@@ -1815,6 +1880,18 @@ func (a *ArgoCDSettings) OIDCConfig() *OIDCConfig {
 	return config.toExported()
 }
 
+// HasUnresolvedSecretReference returns whether any of the given values references a secret of an external secret
+// manager which has not been resolved yet. The values are replaced by empty strings until the secret is resolved, so
+// they must not be used to verify requests.
+func (a *ArgoCDSettings) HasUnresolvedSecretReference(values ...string) bool {
+	for _, value := range values {
+		if key, ok := strings.CutPrefix(strings.TrimSpace(value), "$"); ok && a.unresolvedSecretReferences[key] {
+			return true
+		}
+	}
+	return false
+}
+
 // GetWebhookGitHubSecret returns the resolved GitHub webhook secret
 func (a *ArgoCDSettings) GetWebhookGitHubSecret() string {
 	return ReplaceStringSecret(a.WebhookGitHubSecret, a.Secrets)
@@ -2123,6 +2200,9 @@ func (mgr *SettingsManager) notifySubscribers(newSettings *ArgoCDSettings) {
 	}
 }
 
+// errServerSignatureReference is returned if server.secretkey references a secret of an external secret manager
+var errServerSignatureReference = errors.New("server.secretkey must not reference a secret of an external secret manager")
+
 func isIncompleteSettingsError(err error) bool {
 	var incompleteSettingsErr *incompleteSettingsError
 	return errors.As(err, &incompleteSettingsErr)
@@ -2172,7 +2252,7 @@ func (mgr *SettingsManager) InitializeSettings(insecureModeEnabled bool) (*ArgoC
 	}
 
 	cdSettings, err := mgr.GetSettings()
-	if err != nil && !isIncompleteSettingsError(err) {
+	if err != nil && (!isIncompleteSettingsError(err) || errors.Is(err, errServerSignatureReference)) {
 		return nil, err
 	}
 	if cdSettings == nil {
@@ -2287,6 +2367,29 @@ func (mgr *SettingsManager) GetGlobalProjectsSettings() ([]GlobalProjectSettings
 	return globalProjectSettings, nil
 }
 
+// GetApplicationNamespaceSettings returns the settings of the Applications in the given namespace from argocd-cm ConfigMap.
+// The first settings matching the namespace are returned, or nil if none matches.
+func (mgr *SettingsManager) GetApplicationNamespaceSettings(namespace string) (*ApplicationNamespaceSettings, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	value := argoCDCM.Data[applicationNamespaceSettingsKey]
+	if value == "" {
+		return nil, nil
+	}
+	var namespaceSettings []ApplicationNamespaceSettings
+	if err := yaml.Unmarshal([]byte(value), &namespaceSettings); err != nil {
+		return nil, fmt.Errorf("error unmarshalling application namespace settings: %w", err)
+	}
+	for i := range namespaceSettings {
+		if glob.Match(namespaceSettings[i].Namespace, namespace) {
+			return &namespaceSettings[i], nil
+		}
+	}
+	return nil, nil
+}
+
 func (mgr *SettingsManager) GetNamespace() string {
 	return mgr.namespace
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -22,6 +23,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	testutil "github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/secretmanager"
 	"github.com/argoproj/argo-cd/v3/util/test"
 )
 
@@ -293,6 +295,20 @@ func TestGetIsIgnoreResourceUpdatesEnabledFalse(t *testing.T) {
 	assert.False(t, ignoreResourceUpdatesEnabled)
 }
 
+func TestGetIgnoreResourceUpdatesFieldManagers(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), nil)
+	managers, err := settingsManager.GetIgnoreResourceUpdatesFieldManagers()
+	require.NoError(t, err)
+	assert.Empty(t, managers)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		"resource.ignoreResourceUpdatesFieldManagers": "metrics-annotator, label-syncer,",
+	})
+	managers, err = settingsManager.GetIgnoreResourceUpdatesFieldManagers()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"metrics-annotator", "label-syncer"}, managers)
+}
+
 func TestGetResourceOverrides(t *testing.T) {
 	ignoreStatus := v1alpha1.ResourceOverride{IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{
 		JSONPointers: []string{"/status"},
@@ -2302,3 +2318,80 @@ func TestSettingsManager_GetAllowedNodeLabels(t *testing.T) {
 		})
 	}
 }
+
+type fakeSecretProvider map[string]string
+
+func (p fakeSecretProvider) GetSecret(_ context.Context, name string, key string) (string, error) {
+	value, ok := p[name+"#"+key]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return value, nil
+}
+
+func TestGetSettings_SecretManagerReferences(t *testing.T) {
+	provider := fakeSecretProvider{"argocd#clientSecret": "oidc-secret", "argocd#github": "github-secret"}
+	_, settingsManager := fixtures(t.Context(), map[string]string{
+		"url":                  "https://argocd.example.com",
+		"oidc.config":          "name: Okta\nissuer: https://dev-123456.oktapreview.com\nclientID: aaaabbbbccccddddeee\nclientSecret: $ref:vault:argocd#clientSecret\n",
+		secretManagerConfigKey: "vault:\n  address: https://vault.example.com\n",
+	}, func(secret *corev1.Secret) {
+		secret.Data["server.secretkey"] = nil
+		secret.Data[settingsWebhookGitHubSecretKey] = []byte("$ref:vault:argocd#github")
+		secret.Data[settingsWebhookGitLabSecretKey] = []byte("$ref:vault:argocd#missing")
+	})
+	var configs []*secretmanager.Config
+	settingsManager.newSecretResolver = func(config *secretmanager.Config) (*secretmanager.Resolver, error) {
+		configs = append(configs, config)
+		return secretmanager.NewResolver(map[string]secretmanager.Provider{secretmanager.ProviderVault: provider}, time.Hour), nil
+	}
+
+	// getting the settings does not wait for the secret manager, the references are resolved in the background
+	settings, err := settingsManager.GetSettings()
+	require.NoError(t, err)
+	assert.Empty(t, settings.GetWebhookGitHubSecret(), "unresolved references must not be used as secrets")
+	assert.True(t, settings.HasUnresolvedSecretReference(settings.WebhookGitHubSecret))
+
+	require.Eventually(t, func() bool {
+		settings, err = settingsManager.GetSettings()
+		require.NoError(t, err)
+		return settings.GetWebhookGitHubSecret() == "github-secret"
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "oidc-secret", settings.OIDCConfig().ClientSecret)
+	assert.False(t, settings.HasUnresolvedSecretReference(settings.WebhookGitHubSecret))
+	assert.Empty(t, settings.GetWebhookGitLabSecret(), "unresolved references must not be used as secrets")
+	assert.True(t, settings.HasUnresolvedSecretReference(settings.WebhookGitLabSecret))
+
+	// the resolver and the resolved secrets are cached as long as the configuration does not change
+	settings, err = settingsManager.GetSettings()
+	require.NoError(t, err)
+	assert.Equal(t, "oidc-secret", settings.OIDCConfig().ClientSecret)
+	require.Len(t, configs, 1)
+	assert.Equal(t, "https://vault.example.com", configs[0].Vault.Address)
+}
+
+func TestGetSettings_SecretManagerNotConfigured(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), nil, func(secret *corev1.Secret) {
+		secret.Data["server.secretkey"] = nil
+		secret.Data[settingsWebhookGitHubSecretKey] = []byte("$ref:vault:argocd#github")
+	})
+
+	settings, err := settingsManager.GetSettings()
+	require.NoError(t, err)
+
+	assert.Empty(t, settings.GetWebhookGitHubSecret())
+	assert.True(t, settings.HasUnresolvedSecretReference(settings.WebhookGitHubSecret))
+	assert.False(t, settings.HasUnresolvedSecretReference(settings.WebhookGitLabSecret))
+}
+
+func TestGetSettings_ServerSignatureReference(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), nil, func(secret *corev1.Secret) {
+		secret.Data["server.secretkey"] = []byte("$ref:vault:argocd#secretkey")
+	})
+
+	_, err := settingsManager.GetSettings()
+	require.ErrorIs(t, err, errServerSignatureReference)
+
+	_, err = settingsManager.InitializeSettings(true)
+	require.ErrorIs(t, err, errServerSignatureReference, "the referenced key must not be replaced by a generated one")
+}
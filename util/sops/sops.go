@@ -0,0 +1,105 @@
+package sops
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+)
+
+// metadataKey is the top-level key holding the SOPS metadata of an encrypted file
+const metadataKey = "sops"
+
+// inheritedEnv contains the variables of the environment of the current process which are passed to sops
+var inheritedEnv = []string{"PATH", "HOME", "TMPDIR"}
+
+var envNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ErrNoKeys is returned when decrypting a file without any keys configured
+var ErrNoKeys = errors.New("no SOPS keys are configured")
+
+// IsEncrypted returns whether the given YAML or JSON document has been encrypted with SOPS
+func IsEncrypted(data []byte) bool {
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return false
+	}
+	metadata, ok := doc[metadataKey].(map[string]any)
+	if !ok {
+		return false
+	}
+	_, ok = metadata["mac"]
+	return ok
+}
+
+// IsEncryptedFile returns whether the file at the given path is a YAML or JSON document encrypted with SOPS. Files which
+// cannot be read are considered not to be encrypted.
+func IsEncryptedFile(path string) bool {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	return IsEncrypted(data)
+}
+
+// ValidateEnvName returns an error if the given name cannot be used as environment variable holding SOPS keys
+func ValidateEnvName(name string) error {
+	if !envNameRegexp.MatchString(name) {
+		return fmt.Errorf("%q is not a valid environment variable name", name)
+	}
+	if slices.Contains(inheritedEnv, name) {
+		return fmt.Errorf("environment variable %s cannot be overridden", name)
+	}
+	return nil
+}
+
+// Environ returns the environment of the sops process from the given keys. Only the variables required to run sops are
+// inherited from the current process, so keys configured for the repo-server itself are not available.
+func Environ(keys map[string]string) []string {
+	var env []string
+	for _, name := range inheritedEnv {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		env = append(env, name+"="+keys[name])
+	}
+	return env
+}
+
+// Decrypt decrypts the SOPS encrypted file at path into a new file in dir, using the given environment for the sops
+// process, and returns the path of the decrypted file. The decrypted content is written to the file directly, so it
+// never appears in the output of the command.
+func Decrypt(path string, dir string, env []string) (string, error) {
+	out, err := os.CreateTemp(dir, "sops-*"+filepath.Ext(path))
+	if err != nil {
+		return "", fmt.Errorf("error creating file for decrypted values: %w", err)
+	}
+	_ = out.Close()
+
+	inputType := "yaml"
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		inputType = "json"
+	}
+	cmd := exec.Command("sops", "--decrypt", "--input-type", inputType, "--output-type", inputType, "--output", out.Name(), path)
+	cmd.Env = env
+	if _, err = executil.Run(cmd); err != nil {
+		_ = os.Remove(out.Name())
+		return "", fmt.Errorf("error decrypting %s with sops: %w", filepath.Base(path), err)
+	}
+	return out.Name(), nil
+}
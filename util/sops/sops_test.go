@@ -0,0 +1,98 @@
+package sops
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const encryptedValues = `replicaCount: ENC[AES256_GCM,data:Ng==,iv:aaaa,tag:bbbb,type:int]
+sops:
+  age:
+  - recipient: age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p
+  lastmodified: "2025-01-01T00:00:00Z"
+  mac: ENC[AES256_GCM,data:cccc,iv:dddd,tag:eeee,type:str]
+  version: 3.9.0
+`
+
+func TestIsEncrypted(t *testing.T) {
+	assert.True(t, IsEncrypted([]byte(encryptedValues)))
+	assert.True(t, IsEncrypted([]byte(`{"password": "ENC[...]", "sops": {"mac": "ENC[...]"}}`)))
+	assert.False(t, IsEncrypted([]byte("replicaCount: 1\n")))
+	assert.False(t, IsEncrypted([]byte("sops: enabled\n")))
+	assert.False(t, IsEncrypted([]byte("sops:\n  version: 3.9.0\n")))
+	assert.False(t, IsEncrypted([]byte("- not\n- a map\n")))
+	assert.False(t, IsEncrypted([]byte("{")))
+}
+
+func TestIsEncryptedFile(t *testing.T) {
+	dir := t.TempDir()
+	encrypted := filepath.Join(dir, "secrets.yaml")
+	require.NoError(t, os.WriteFile(encrypted, []byte(encryptedValues), 0o600))
+	plain := filepath.Join(dir, "values.yaml")
+	require.NoError(t, os.WriteFile(plain, []byte("replicaCount: 1\n"), 0o600))
+
+	assert.True(t, IsEncryptedFile(encrypted))
+	assert.False(t, IsEncryptedFile(plain))
+	assert.False(t, IsEncryptedFile(filepath.Join(dir, "missing.yaml")))
+	assert.False(t, IsEncryptedFile("https://example.com/values.yaml"))
+}
+
+func TestValidateEnvName(t *testing.T) {
+	require.NoError(t, ValidateEnvName("SOPS_AGE_KEY"))
+	require.NoError(t, ValidateEnvName("AWS_ACCESS_KEY_ID"))
+	require.Error(t, ValidateEnvName("age.key"))
+	require.Error(t, ValidateEnvName("1KEY"))
+	require.Error(t, ValidateEnvName("PATH"))
+	require.Error(t, ValidateEnvName("HOME"))
+}
+
+func TestEnviron(t *testing.T) {
+	t.Setenv("PATH", "/usr/bin")
+	t.Setenv("SOPS_AGE_KEY", "repo-server-key")
+
+	env := Environ(map[string]string{"SOPS_AGE_KEY": "project-key", "AWS_REGION": "eu-west-1"})
+
+	assert.Contains(t, env, "PATH=/usr/bin")
+	assert.Contains(t, env, "SOPS_AGE_KEY=project-key")
+	assert.Contains(t, env, "AWS_REGION=eu-west-1")
+	assert.NotContains(t, env, "SOPS_AGE_KEY=repo-server-key")
+}
+
+func TestDecrypt(t *testing.T) {
+	// fake sops binary writing the value of SOPS_AGE_KEY to the file given with --output
+	binDir := t.TempDir()
+	script := "#!/bin/sh\nwhile [ \"$1\" != \"--output\" ]; do shift; done\necho \"key: $SOPS_AGE_KEY\" > \"$2\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "sops"), []byte(script), 0o755))
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	dir := t.TempDir()
+	encrypted := filepath.Join(dir, "secrets.yaml")
+	require.NoError(t, os.WriteFile(encrypted, []byte(encryptedValues), 0o600))
+
+	decrypted, err := Decrypt(encrypted, dir, Environ(map[string]string{"SOPS_AGE_KEY": "project-key"}))
+	require.NoError(t, err)
+	assert.Equal(t, ".yaml", filepath.Ext(decrypted))
+	data, err := os.ReadFile(decrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "key: project-key\n", string(data))
+}
+
+func TestDecryptError(t *testing.T) {
+	binDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "sops"), []byte("#!/bin/sh\necho 'no key found' >&2\nexit 128\n"), 0o755))
+	t.Setenv("PATH", binDir)
+
+	dir := t.TempDir()
+	encrypted := filepath.Join(dir, "secrets.yaml")
+	require.NoError(t, os.WriteFile(encrypted, []byte(encryptedValues), 0o600))
+
+	_, err := Decrypt(encrypted, dir, Environ(nil))
+	require.ErrorContains(t, err, "error decrypting secrets.yaml with sops")
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "the file for the decrypted values must be removed")
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	bb "github.com/ktrysmt/go-bitbucket"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
 	alpha1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -472,10 +473,14 @@ func (a *ArgoCDWebhookHandler) storePreviouslyCachedManifests(app *v1alpha1.Appl
 	if err != nil {
 		return fmt.Errorf("error getting ref sources: %w", err)
 	}
+	proj, err := a.appClientset.ArgoprojV1alpha1().AppProjects(a.ns).Get(context.Background(), app.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting project: %w", err)
+	}
 	source := app.Spec.GetSource()
 	cache.LogDebugManifestCacheKeyFields("moving manifests cache", "webhook app revision changed", change.shaBefore, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, nil)
 
-	if err := a.repoCache.SetNewRevisionManifests(change.shaAfter, change.shaBefore, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, nil, installationID); err != nil {
+	if err := a.repoCache.SetNewRevisionManifests(change.shaAfter, change.shaBefore, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, nil, installationID, proj.GetSourcePaths()); err != nil {
 		return fmt.Errorf("error setting new revision manifests: %w", err)
 	}
 
@@ -626,6 +631,17 @@ func isHeadTouched(ctx context.Context, bbClient *bb.Client, owner, repoSlug, re
 	return bbRepo.Mainbranch.Name == revision, nil
 }
 
+// secretUnresolved rejects the request if the secret it is verified with references a secret of an external secret
+// manager which has not been resolved yet, as the request cannot be verified without it
+func (a *ArgoCDWebhookHandler) secretUnresolved(w http.ResponseWriter, values ...string) bool {
+	if !a.settings.HasUnresolvedSecretReference(values...) {
+		return false
+	}
+	log.WithField(common.SecurityField, common.SecurityHigh).Warn("Webhook secret references a secret of an external secret manager which has not been resolved yet, rejecting webhook event")
+	http.Error(w, "Webhook secret is not available yet", http.StatusServiceUnavailable)
+	return true
+}
+
 func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 	var payload any
 	var err error
@@ -634,32 +650,50 @@ func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 
 	switch {
 	case r.Header.Get("X-Vss-Activityid") != "":
+		if a.secretUnresolved(w, a.settings.WebhookAzureDevOpsUsername, a.settings.WebhookAzureDevOpsPassword) {
+			return
+		}
 		payload, err = a.azuredevops.Parse(r, azuredevops.GitPushEventType)
 		if errors.Is(err, azuredevops.ErrBasicAuthVerificationFailed) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("Azure DevOps webhook basic auth verification failed")
 		}
 	// Gogs needs to be checked before GitHub since it carries both Gogs and (incompatible) GitHub headers
 	case r.Header.Get("X-Gogs-Event") != "":
+		if a.secretUnresolved(w, a.settings.WebhookGogsSecret) {
+			return
+		}
 		payload, err = a.gogs.Parse(r, gogs.PushEvent)
 		if errors.Is(err, gogs.ErrHMACVerificationFailed) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("Gogs webhook HMAC verification failed")
 		}
 	case r.Header.Get("X-GitHub-Event") != "":
+		if a.secretUnresolved(w, a.settings.WebhookGitHubSecret) {
+			return
+		}
 		payload, err = a.github.Parse(r, github.PushEvent, github.PingEvent)
 		if errors.Is(err, github.ErrHMACVerificationFailed) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("GitHub webhook HMAC verification failed")
 		}
 	case r.Header.Get("X-Gitlab-Event") != "":
+		if a.secretUnresolved(w, a.settings.WebhookGitLabSecret) {
+			return
+		}
 		payload, err = a.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.SystemHookEvents)
 		if errors.Is(err, gitlab.ErrGitLabTokenVerificationFailed) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("GitLab webhook token verification failed")
 		}
 	case r.Header.Get("X-Hook-UUID") != "":
+		if a.secretUnresolved(w, a.settings.WebhookBitbucketUUID) {
+			return
+		}
 		payload, err = a.bitbucket.Parse(r, bitbucket.RepoPushEvent)
 		if errors.Is(err, bitbucket.ErrUUIDVerificationFailed) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("BitBucket webhook UUID verification failed")
 		}
 	case r.Header.Get("X-Event-Key") != "":
+		if a.secretUnresolved(w, a.settings.WebhookBitbucketServerSecret) {
+			return
+		}
 		payload, err = a.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.DiagnosticsPingEvent)
 		if errors.Is(err, bitbucketserver.ErrHMACVerificationFailed) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("BitBucket webhook HMAC verification failed")
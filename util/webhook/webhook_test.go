@@ -28,7 +28,9 @@ import (
 	"github.com/go-playground/webhooks/v6/gitlab"
 	gogsclient "github.com/gogits/go-gogs-client"
 	"github.com/jarcoal/httpmock"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
 	kubetesting "k8s.io/client-go/testing"
 
 	argov1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
@@ -164,6 +166,33 @@ func TestGitHubCommitEvent(t *testing.T) {
 	hook.Reset()
 }
 
+func TestGitHubCommitEvent_UnresolvedSecretReference(t *testing.T) {
+	labels := map[string]string{"app.kubernetes.io/part-of": "argocd"}
+	kubeClient := fake.NewClientset(
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "argocd-cm", Namespace: "argocd", Labels: labels}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: "argocd", Labels: labels}, Data: map[string][]byte{
+			"server.secretkey":      []byte("test"),
+			"webhook.github.secret": []byte("$ref:vault:argocd#github"),
+		}},
+	)
+	// the informers of the settings manager must not log into the global hook of other tests when they are cancelled
+	argoSettings, err := settings.NewSettingsManager(context.Background(), kubeClient, "argocd").GetSettings() //nolint:usetesting
+	require.NoError(t, err)
+	require.Empty(t, argoSettings.GetWebhookGitHubSecret())
+
+	h := newMockHandler(nil, []string{}, int64(50)*1024*1024, &mocks.ArgoDB{}, argoSettings)
+	req := httptest.NewRequest(http.MethodPost, "/api/webhook", http.NoBody)
+	req.Header.Set("X-GitHub-Event", "push")
+	eventJSON, err := os.ReadFile("testdata/github-commit-event.json")
+	require.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	close(h.queue)
+	h.Wait()
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code, "requests must not be accepted without verification while the secret is unresolved")
+}
+
 func TestAzureDevOpsCommitEvent(t *testing.T) {
 	hook := test.NewGlobal()
 	h := NewMockHandler(nil, []string{})